@@ -0,0 +1,111 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationReleaseStatusSpec defines the desired state of ApplicationReleaseStatus.
+type ApplicationReleaseStatusSpec struct {
+	// Application is the name of the application whose Releases are summarized
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Application string `json:"application"`
+}
+
+// ApplicationReleaseStatusStatus defines the observed state of ApplicationReleaseStatus.
+type ApplicationReleaseStatusStatus struct {
+	// Environments contains the summary of the Releases of the application for each of its ReleasePlans
+	// +optional
+	Environments []EnvironmentReleaseStatus `json:"environments,omitempty"`
+
+	// LastUpdateTime is the time when the summary was last updated
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// EnvironmentReleaseStatus defines the summary of the Releases of an application to one of its targets.
+type EnvironmentReleaseStatus struct {
+	// CurrentSnapshot is the name of the Snapshot released by the last successful Release
+	// +optional
+	CurrentSnapshot string `json:"currentSnapshot,omitempty"`
+
+	// LastFailedRelease references the last Release that failed
+	// +optional
+	LastFailedRelease *ReleaseReference `json:"lastFailedRelease,omitempty"`
+
+	// LastSucceededRelease references the last Release that succeeded
+	// +optional
+	LastSucceededRelease *ReleaseReference `json:"lastSucceededRelease,omitempty"`
+
+	// PendingReleases contains the names of the Releases that didn't finish yet
+	// +optional
+	PendingReleases []string `json:"pendingReleases,omitempty"`
+
+	// ReleasePlan is the name of the ReleasePlan releasing the application to the target
+	// +required
+	ReleasePlan string `json:"releasePlan"`
+
+	// Target is the namespace the ReleasePlan releases the application to
+	// +optional
+	Target string `json:"target,omitempty"`
+}
+
+// ReleaseReference defines a reference to a finished Release.
+type ReleaseReference struct {
+	// CompletionTime is the time when the Release was completed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Name is the name of the Release
+	// +required
+	Name string `json:"name"`
+
+	// Snapshot is the name of the Snapshot released by the Release
+	// +optional
+	Snapshot string `json:"snapshot,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:shortName=ars,categories=appstudio
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Application",type=string,JSONPath=`.spec.application`
+//+kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=`.status.lastUpdateTime`
+
+// ApplicationReleaseStatus is the Schema for the applicationreleasestatuses API. It summarizes the Releases of an
+// application for each of its targets, so dashboards can watch a single object per application.
+type ApplicationReleaseStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationReleaseStatusSpec   `json:"spec,omitempty"`
+	Status ApplicationReleaseStatusStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ApplicationReleaseStatusList contains a list of ApplicationReleaseStatus
+type ApplicationReleaseStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationReleaseStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ApplicationReleaseStatus{}, &ApplicationReleaseStatusList{})
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CRDs", func() {
+	DescribeTable("should register the short names and the appstudio category",
+		func(plural string, shortNames []string) {
+			found := false
+			for _, crd := range testEnv.CRDs {
+				if crd.Spec.Names.Plural != plural {
+					continue
+				}
+
+				found = true
+				Expect(crd.Spec.Names.ShortNames).To(Equal(shortNames))
+				Expect(crd.Spec.Names.Categories).To(Equal([]string{"appstudio"}))
+			}
+			Expect(found).To(BeTrue())
+		},
+		Entry("for ApplicationReleaseStatuses", "applicationreleasestatuses", []string{"ars"}),
+		Entry("for Releases", "releases", []string{"rel"}),
+		Entry("for ReleasePipelineCatalogs", "releasepipelinecatalogs", []string{"rpc"}),
+		Entry("for ReleasePlans", "releaseplans", []string{"rp"}),
+		Entry("for ReleasePlanAdmissions", "releaseplanadmissions", []string{"rpa"}),
+		Entry("for ReleasePlanAdmissionDefaults", "releaseplanadmissiondefaults", []string{"rpad"}),
+		Entry("for ReleaseServiceConfigs", "releaseserviceconfigs", []string{"rsc"}),
+	)
+})
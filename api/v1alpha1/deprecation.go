@@ -0,0 +1,113 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/konflux-ci/operator-toolkit/conditions"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// deprecatedFieldsUsedConditionType is the type used to flag resources setting fields that are deprecated and
+	// won't be supported in v1beta1
+	deprecatedFieldsUsedConditionType conditions.ConditionType = "DeprecatedFieldsUsed"
+)
+
+const (
+	// DeprecatedFieldsReason is the reason set when a resource sets deprecated fields
+	DeprecatedFieldsReason conditions.ConditionReason = "DeprecatedFields"
+
+	// NoDeprecatedFieldsReason is the reason set when a resource no longer sets deprecated fields
+	NoDeprecatedFieldsReason conditions.ConditionReason = "NoDeprecatedFields"
+)
+
+// DeprecatedField describes a deprecated field set in a resource and the field replacing it in v1beta1.
+type DeprecatedField struct {
+	// Field identifies the deprecated field in the metrics, without list indexes or map keys chosen by the users
+	Field string
+
+	// Path is the path of the deprecated field in the resource
+	Path string
+
+	// Replacement is the path of the field to use instead
+	Replacement string
+}
+
+// GetWarning returns the warning shown to the users setting the deprecated field.
+func (f DeprecatedField) GetWarning() string {
+	return fmt.Sprintf("%s is deprecated and won't be supported in v1beta1, use %s instead", f.Path, f.Replacement)
+}
+
+// GetDeprecationWarnings returns the warnings of the given deprecated fields, so they can be returned as admission
+// warnings.
+func GetDeprecationWarnings(fields []DeprecatedField) []string {
+	var warnings []string
+	for _, field := range fields {
+		warnings = append(warnings, field.GetWarning())
+	}
+
+	return warnings
+}
+
+// getDeprecatedBundleFields returns the deprecated bundle field of the given Pipeline reference, if set. The given path
+// is the path of the Pipeline in the resource and the field identifies it in the metrics.
+func getDeprecatedBundleFields(pipelineRef tektonutils.PipelineRef, field, path string) []DeprecatedField {
+	if pipelineRef.Bundle == "" {
+		return nil
+	}
+
+	return []DeprecatedField{{
+		Field:       field + ".pipelineRef.bundle",
+		Path:        path + ".pipelineRef.bundle",
+		Replacement: path + ".pipelineRef.resolver",
+	}}
+}
+
+// getDeprecatedFieldsMessage returns the message of the DeprecatedFieldsUsed condition for the given fields.
+func getDeprecatedFieldsMessage(fields []DeprecatedField) string {
+	return strings.Join(GetDeprecationWarnings(fields), "; ")
+}
+
+// isDeprecatedFieldsUsedReported checks whether the DeprecatedFieldsUsed condition in the given conditions reports
+// the given deprecated fields. Resources that never set deprecated fields don't need the condition.
+func isDeprecatedFieldsUsedReported(resourceConditions []metav1.Condition, fields []DeprecatedField) bool {
+	condition := meta.FindStatusCondition(resourceConditions, deprecatedFieldsUsedConditionType.String())
+	if len(fields) == 0 {
+		return condition == nil || condition.Status == metav1.ConditionFalse
+	}
+
+	return condition != nil && condition.Status == metav1.ConditionTrue &&
+		condition.Message == getDeprecatedFieldsMessage(fields)
+}
+
+// setDeprecatedFieldsUsedCondition sets the DeprecatedFieldsUsed condition in the given conditions, listing the given
+// deprecated fields in its message.
+func setDeprecatedFieldsUsedCondition(resourceConditions *[]metav1.Condition, fields []DeprecatedField) {
+	if len(fields) == 0 {
+		conditions.SetCondition(resourceConditions, deprecatedFieldsUsedConditionType, metav1.ConditionFalse,
+			NoDeprecatedFieldsReason)
+		return
+	}
+
+	conditions.SetConditionWithMessage(resourceConditions, deprecatedFieldsUsedConditionType, metav1.ConditionTrue,
+		DeprecatedFieldsReason, getDeprecatedFieldsMessage(fields))
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/konflux-ci/release-service/metadata"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Deprecation", func() {
+	When("GetDeprecationWarnings is called", func() {
+		It("should return a warning for each field pointing to its replacement", func() {
+			Expect(GetDeprecationWarnings([]DeprecatedField{
+				{Field: "spec.environment", Path: "spec.environment", Replacement: "spec.environments"},
+			})).To(Equal([]string{
+				"spec.environment is deprecated and won't be supported in v1beta1, use spec.environments instead",
+			}))
+		})
+
+		It("should return no warnings if there are no fields", func() {
+			Expect(GetDeprecationWarnings(nil)).To(BeEmpty())
+		})
+	})
+
+	When("GetDeprecatedFields is called", func() {
+		It("should return the strategy annotation of Releases", func() {
+			release := &Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{metadata.StrategyAnnotation: "hotfix"},
+				},
+			}
+			Expect(release.GetDeprecatedFields()).To(ConsistOf(HaveField("Replacement", "spec.strategy")))
+			Expect((&Release{}).GetDeprecatedFields()).To(BeEmpty())
+		})
+
+		It("should return the bundle reference of ReleasePlans", func() {
+			releasePlan := &ReleasePlan{
+				Spec: ReleasePlanSpec{
+					Pipeline: &tektonutils.ParameterizedPipeline{
+						Pipeline: tektonutils.Pipeline{
+							PipelineRef: tektonutils.PipelineRef{Bundle: "quay.io/some/bundle#pipeline"},
+						},
+					},
+				},
+			}
+			Expect(releasePlan.GetDeprecatedFields()).To(ConsistOf(
+				HaveField("Path", "spec.pipeline.pipelineRef.bundle")))
+			Expect((&ReleasePlan{}).GetDeprecatedFields()).To(BeEmpty())
+		})
+
+		It("should return the environment and bundle references of ReleasePlanAdmissions", func() {
+			bundleRef := tektonutils.PipelineRef{Bundle: "quay.io/some/bundle#pipeline"}
+			releasePlanAdmission := &ReleasePlanAdmission{
+				Spec: ReleasePlanAdmissionSpec{
+					Environment: "production",
+					Pipeline:    &tektonutils.Pipeline{PipelineRef: bundleRef},
+					Strategies: []ReleaseStrategy{
+						{Name: "hotfix", Pipeline: &tektonutils.Pipeline{PipelineRef: bundleRef}},
+					},
+					Verification: &ReleaseVerification{
+						Pipeline: &tektonutils.Pipeline{PipelineRef: bundleRef},
+					},
+				},
+			}
+
+			fields := releasePlanAdmission.GetDeprecatedFields()
+			Expect(fields).To(HaveLen(4))
+			Expect(fields).To(ContainElement(DeprecatedField{
+				Field:       "spec.strategies.pipeline.pipelineRef.bundle",
+				Path:        "spec.strategies[0].pipeline.pipelineRef.bundle",
+				Replacement: "spec.strategies[0].pipeline.pipelineRef.resolver",
+			}))
+			Expect(fields).To(ContainElement(HaveField("Path", "spec.environment")))
+			Expect(fields).To(ContainElement(HaveField("Path", "spec.verification.pipeline.pipelineRef.bundle")))
+		})
+	})
+
+	When("the DeprecatedFieldsUsed condition is set", func() {
+		var (
+			fields  []DeprecatedField
+			release *Release
+		)
+
+		BeforeEach(func() {
+			fields = []DeprecatedField{{Field: "foo", Path: "foo", Replacement: "bar"}}
+			release = &Release{}
+		})
+
+		It("should not require the condition if no deprecated field was ever set", func() {
+			Expect(release.HasDeprecatedFieldsReported(nil)).To(BeTrue())
+			Expect(release.HasDeprecatedFieldsReported(fields)).To(BeFalse())
+		})
+
+		It("should list the deprecated fields in the condition message", func() {
+			release.MarkDeprecatedFieldsUsed(fields)
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, deprecatedFieldsUsedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(DeprecatedFieldsReason.String()))
+			Expect(condition.Message).To(ContainSubstring("foo is deprecated"))
+			Expect(release.HasDeprecatedFieldsReported(fields)).To(BeTrue())
+			Expect(release.HasDeprecatedFieldsReported(nil)).To(BeFalse())
+		})
+
+		It("should set the condition to false once the deprecated fields are removed", func() {
+			release.MarkDeprecatedFieldsUsed(fields)
+			release.MarkDeprecatedFieldsUsed(nil)
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, deprecatedFieldsUsedConditionType.String())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(NoDeprecatedFieldsReason.String()))
+			Expect(release.HasDeprecatedFieldsReported(nil)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,151 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reasons defines the stable set of reasons the release-service sets in the conditions and validation causes
+// of its resources when something fails. The reasons, unlike the condition messages, are part of the API, so consumers
+// can rely on them to react to failures without parsing human-readable text.
+package reasons
+
+import "github.com/konflux-ci/operator-toolkit/conditions"
+
+// Category groups the reasons by the party that is expected to act on them.
+type Category string
+
+const (
+	// ConfigurationCategory groups the failures caused by missing or invalid resources that users have to fix
+	ConfigurationCategory Category = "Configuration"
+
+	// PipelineCategory groups the failures reported by the Release Pipelines
+	PipelineCategory Category = "Pipeline"
+
+	// PlatformCategory groups the failures caused by the limits or the state of the cluster
+	PlatformCategory Category = "Platform"
+)
+
+const (
+	// AdmissionBlocked is the reason set when the ReleasePlanAdmission matching the Release doesn't allow it to proceed
+	AdmissionBlocked conditions.ConditionReason = "AdmissionBlocked"
+
+	// Failed is the reason set when a failure that doesn't match any of the other reasons occurs
+	Failed conditions.ConditionReason = "Failed"
+
+	// PipelineFailed is the reason set when a Release PipelineRun fails
+	PipelineFailed conditions.ConditionReason = "PipelineFailed"
+
+	// PipelineResolutionFailed is the reason set when a Release Pipeline or its tasks can't be resolved
+	PipelineResolutionFailed conditions.ConditionReason = "PipelineResolutionFailed"
+
+	// QueueTimeout is the reason set when the managed Release PipelineRun doesn't start before the queue deadline
+	QueueTimeout conditions.ConditionReason = "QueueTimeout"
+
+	// QuotaExceeded is the reason set when the resources needed to process a Release exceed a quota of the cluster
+	QuotaExceeded conditions.ConditionReason = "QuotaExceeded"
+
+	// ReleasePlanAdmissionMissing is the reason set when no ReleasePlanAdmission matches the ReleasePlan of the Release
+	ReleasePlanAdmissionMissing conditions.ConditionReason = "ReleasePlanAdmissionMissing"
+
+	// ReleasePlanMissing is the reason set when the ReleasePlan referenced by the Release can't be found
+	ReleasePlanMissing conditions.ConditionReason = "ReleasePlanMissing"
+
+	// SnapshotMissing is the reason set when the Snapshot referenced by the Release can't be found
+	SnapshotMissing conditions.ConditionReason = "SnapshotMissing"
+
+	// Timeout is the reason set when a Release PipelineRun doesn't complete in time
+	Timeout conditions.ConditionReason = "Timeout"
+
+	// ValidationTimeout is the reason set when a Release can't be validated before the validation deadline
+	ValidationTimeout conditions.ConditionReason = "ValidationTimeout"
+
+	// VerificationFailed is the reason set when the artifacts released by a Release don't pass its verification
+	// Pipeline
+	VerificationFailed conditions.ConditionReason = "VerificationFailed"
+)
+
+// Info describes a reason.
+type Info struct {
+	// Category is the category of the reason
+	Category Category
+
+	// Description is a human-readable description of the reason
+	Description string
+
+	// Retryable indicates whether retrying the failed operation without changing any resource could succeed
+	Retryable bool
+}
+
+// Table maps every reason to its description. Consumers should treat reasons not present in this table as Failed.
+var Table = map[conditions.ConditionReason]Info{
+	AdmissionBlocked: {
+		Category:    ConfigurationCategory,
+		Description: "The ReleasePlanAdmission matching the Release doesn't allow it to proceed",
+	},
+	Failed: {
+		Category:    PlatformCategory,
+		Description: "The operation failed for a reason not covered by any other reason",
+		Retryable:   true,
+	},
+	PipelineFailed: {
+		Category:    PipelineCategory,
+		Description: "A Release PipelineRun failed",
+		Retryable:   true,
+	},
+	PipelineResolutionFailed: {
+		Category:    ConfigurationCategory,
+		Description: "A Release Pipeline or one of its tasks couldn't be resolved",
+	},
+	QueueTimeout: {
+		Category:    PlatformCategory,
+		Description: "The managed Release PipelineRun didn't start before the queue deadline",
+		Retryable:   true,
+	},
+	QuotaExceeded: {
+		Category:    PlatformCategory,
+		Description: "The resources needed to process the Release exceed a quota of the cluster",
+		Retryable:   true,
+	},
+	ReleasePlanAdmissionMissing: {
+		Category:    ConfigurationCategory,
+		Description: "No ReleasePlanAdmission matches the ReleasePlan of the Release",
+	},
+	ReleasePlanMissing: {
+		Category:    ConfigurationCategory,
+		Description: "The ReleasePlan referenced by the Release can't be found",
+	},
+	SnapshotMissing: {
+		Category:    ConfigurationCategory,
+		Description: "The Snapshot referenced by the Release can't be found",
+	},
+	Timeout: {
+		Category:    PipelineCategory,
+		Description: "A Release PipelineRun didn't complete in time",
+		Retryable:   true,
+	},
+	ValidationTimeout: {
+		Category:    PlatformCategory,
+		Description: "The Release couldn't be validated before the validation deadline",
+		Retryable:   true,
+	},
+	VerificationFailed: {
+		Category:    PipelineCategory,
+		Description: "The released artifacts didn't pass the verification Pipeline",
+	},
+}
+
+// Lookup returns the Info of the given reason and whether the reason is part of the Table.
+func Lookup(reason string) (Info, bool) {
+	info, found := Table[conditions.ConditionReason(reason)]
+	return info, found
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reasons
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reasons", func() {
+	When("Lookup is called", func() {
+		It("should return the information of known reasons", func() {
+			info, found := Lookup(ReleasePlanMissing.String())
+			Expect(found).To(BeTrue())
+			Expect(info.Category).To(Equal(ConfigurationCategory))
+			Expect(info.Retryable).To(BeFalse())
+		})
+
+		It("should return false for unknown reasons", func() {
+			_, found := Lookup("Unknown")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	When("the Table is used", func() {
+		It("should describe every reason", func() {
+			for reason, info := range Table {
+				Expect(info.Category).NotTo(BeEmpty(), reason.String())
+				Expect(info.Description).NotTo(BeEmpty(), reason.String())
+			}
+		})
+
+		It("should mark the transient failures as retryable", func() {
+			Expect(Table[QuotaExceeded].Retryable).To(BeTrue())
+			Expect(Table[Timeout].Retryable).To(BeTrue())
+			Expect(Table[QueueTimeout].Retryable).To(BeTrue())
+			Expect(Table[ValidationTimeout].Retryable).To(BeTrue())
+			Expect(Table[AdmissionBlocked].Retryable).To(BeFalse())
+		})
+	})
+})
@@ -1,34 +1,138 @@
 package v1alpha1
 
-import "github.com/konflux-ci/operator-toolkit/conditions"
+import (
+	"github.com/konflux-ci/operator-toolkit/conditions"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
+)
 
 const (
+	// approvedConditionType is the type used to track whether a Release requiring a two-person review was approved
+	approvedConditionType conditions.ConditionType = "Approved"
+
+	// deployedConditionType is the type used to track the deployment of a released Snapshot to its Environments
+	deployedConditionType conditions.ConditionType = "Deployed"
+
+	// inReleaseWindowConditionType is the type used to track whether a Release started within a release window
+	inReleaseWindowConditionType conditions.ConditionType = "InReleaseWindow"
+
 	// managedProcessedConditionType is the type used to track the status of a Release Managed Pipeline processing
 	managedProcessedConditionType conditions.ConditionType = "ManagedPipelineProcessed"
 
+	// partiallyReleasedConditionType is the type used to flag Releases whose managed Pipeline only released some of
+	// the components
+	partiallyReleasedConditionType conditions.ConditionType = "PartiallyReleased"
+
+	// planDeletedConditionType is the type used to flag finished Releases whose ReleasePlan was deleted
+	planDeletedConditionType conditions.ConditionType = "PlanDeleted"
+
 	// postActionsExecutedConditionType is the type used to track the status of Release post-actions
 	postActionsExecutedConditionType conditions.ConditionType = "PostActionsExecuted"
 
 	// tenantProcessedConditionType is the type used to track the status of a Release Tenant Pipeline processing
 	tenantProcessedConditionType conditions.ConditionType = "TenantPipelineProcessed"
 
+	// snapshotStaleConditionType is the type used to flag Releases whose Snapshot exceeds the maximum age allowed
+	snapshotStaleConditionType conditions.ConditionType = "SnapshotStale"
+
+	// snapshotTestedConditionType is the type used to track whether the integration tests of a Release Snapshot passed
+	snapshotTestedConditionType conditions.ConditionType = "SnapshotTested"
+
+	// stalledConditionType is the type used to track whether a Release PipelineRun stopped progressing
+	stalledConditionType conditions.ConditionType = "Stalled"
+
+	// queuedConditionType is the type used to track whether a Release waits for other Releases to run its managed
+	// Pipeline
+	queuedConditionType conditions.ConditionType = "Queued"
+
+	// quarantinedConditionType is the type used to flag Releases that are no longer reconciled after repeatedly
+	// causing panics
+	quarantinedConditionType conditions.ConditionType = "Quarantined"
+
 	// releasedConditionType is the type used to track the status of a Release
 	releasedConditionType conditions.ConditionType = "Released"
 
 	// validatedConditionType is the type used to track the status of a Release validation
 	validatedConditionType conditions.ConditionType = "Validated"
+
+	// verifiedConditionType is the type used to track the status of the verification of the released artifacts
+	verifiedConditionType conditions.ConditionType = "Verified"
 )
 
+// SnapshotTestSucceededConditionType is the type of the Snapshot condition used by the integration service to report
+// the result of the Snapshot integration tests
+const SnapshotTestSucceededConditionType = "AppStudioTestSucceeded"
+
 const (
-	// FailedReason is the reason set when a failure occurs
-	FailedReason conditions.ConditionReason = "Failed"
+	// AwaitingApprovalReason is the reason set when a Release waits to be approved by a user other than its author
+	AwaitingApprovalReason conditions.ConditionReason = "AwaitingApproval"
+
+	// AwaitingCapacityReason is the reason set when a Release waits for capacity to run its managed pipeline in a
+	// saturated managed namespace
+	AwaitingCapacityReason conditions.ConditionReason = "AwaitingCapacity"
+
+	// AwaitingDependenciesReason is the reason set when a Release waits for the services its managed pipeline depends
+	// on to be healthy
+	AwaitingDependenciesReason conditions.ConditionReason = "AwaitingDependencies"
+
+	// AwaitingReleaseWindowReason is the reason set when a Release waits for a release window to open
+	AwaitingReleaseWindowReason conditions.ConditionReason = "AwaitingReleaseWindow"
+
+	// AwaitingTestResultsReason is the reason set when a Release waits for the integration tests of its Snapshot
+	AwaitingTestResultsReason conditions.ConditionReason = "AwaitingTestResults"
+
+	// ComponentsFailedReason is the reason set when the managed Pipeline fails to release some of the components
+	ComponentsFailedReason conditions.ConditionReason = "ComponentsFailed"
+
+	// EmergencyBypassReason is the reason set when a gate is bypassed by the emergency bypass of a Release
+	EmergencyBypassReason conditions.ConditionReason = "EmergencyBypass"
+
+	// FailedReason is the reason set when a failure occurs. More specific reasons are defined in the reasons package
+	FailedReason = reasons.Failed
+
+	// HealthyReason is the reason set when a dependency health check succeeds
+	HealthyReason conditions.ConditionReason = "Healthy"
+
+	// InsufficientCapacityReason is the reason set when a Release waits for the ResourceQuotas of the managed namespace
+	// to have room for the resources its managed pipeline needs
+	InsufficientCapacityReason conditions.ConditionReason = "InsufficientCapacity"
+
+	// PlanDeletedReason is the reason set when the ReleasePlan of a finished Release is deleted
+	PlanDeletedReason conditions.ConditionReason = "PlanDeleted"
+
+	// PreemptedReason is the reason set when a queued Release gives up its place in the queue to an urgent Release
+	PreemptedReason conditions.ConditionReason = "Preempted"
 
 	// ProgressingReason is the reason set when a phase is progressing
 	ProgressingReason conditions.ConditionReason = "Progressing"
 
+	// QueuedReason is the reason set when a Release waits for another Release to finish
+	QueuedReason conditions.ConditionReason = "Queued"
+
+	// QuarantinedReason is the reason set when a Release is quarantined after repeatedly causing panics
+	QuarantinedReason conditions.ConditionReason = "Quarantined"
+
 	// SkippedReason is the reason set when a phase is skipped
 	SkippedReason conditions.ConditionReason = "Skipped"
 
+	// StaleSnapshotReason is the reason set when the Snapshot of a Release exceeds the maximum age allowed
+	StaleSnapshotReason conditions.ConditionReason = "StaleSnapshot"
+
+	// StalledReason is the reason set when a Release PipelineRun stops progressing
+	StalledReason conditions.ConditionReason = "Stalled"
+
+	// SupersededReason is the reason set when an automated Release is skipped because a newer automated Release
+	// releases the same components
+	SupersededReason conditions.ConditionReason = "Superseded"
+
 	// SucceededReason is the reason set when a phase succeeds
 	SucceededReason conditions.ConditionReason = "Succeeded"
+
+	// UnhealthyReason is the reason set when a dependency health check fails
+	UnhealthyReason conditions.ConditionReason = "Unhealthy"
 )
+
+// getDependencyHealthyConditionType returns the type used to track whether the dependency checked by the dependency
+// health check with the given name is healthy (e.g. PyxisHealthy).
+func getDependencyHealthyConditionType(name string) conditions.ConditionType {
+	return conditions.ConditionType(name + "Healthy")
+}
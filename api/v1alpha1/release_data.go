@@ -0,0 +1,282 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// DataLimitDepth is the name of the limit on the nesting depth of the data
+	DataLimitDepth = "depth"
+
+	// DataLimitSize is the name of the limit on the size of the data
+	DataLimitSize = "size"
+
+	// DefaultDataMaxDepth is the maximum nesting depth of the data used when the RELEASE_DATA_MAX_DEPTH environment
+	// variable is not set
+	DefaultDataMaxDepth = 32
+
+	// DefaultDataMaxSize is the maximum size in bytes of the data used when the RELEASE_DATA_MAX_SIZE environment
+	// variable is not set
+	DefaultDataMaxSize = 256 * 1024
+)
+
+// ReleaseData describes the commonly used keys of the Release data. Unknown keys are still allowed in the data, but
+// the keys described here are validated when the Release is applied (see config/crd/patches/data_in_releases.yaml).
+type ReleaseData struct {
+	// ChangeRequest references the change record approving the Release in an external change management system
+	// +optional
+	ChangeRequest *ReleaseDataChangeRequest `json:"changeRequest,omitempty"`
+
+	// Mapping defines how the Snapshot components are mapped to their destination repositories
+	// +optional
+	Mapping *ReleaseDataMapping `json:"mapping,omitempty"`
+
+	// Pyxis defines the Pyxis instance the managed Release Pipeline should use
+	// +optional
+	Pyxis *ReleaseDataPyxis `json:"pyxis,omitempty"`
+
+	// Sensitive lists the paths of the values in the data that must not appear in the controller logs, the events or
+	// the support bundles of the Release (e.g. "mapping.defaults.token")
+	// +optional
+	Sensitive []string `json:"sensitive,omitempty"`
+
+	// Sign defines how the released content is signed by the managed Release Pipeline
+	// +optional
+	Sign *ReleaseDataSign `json:"sign,omitempty"`
+}
+
+// ReleaseDataChangeRequest references the change record approving a Release in an external change management system.
+type ReleaseDataChangeRequest struct {
+	// System is the name of the change management system holding the change record (e.g. servicenow)
+	// +required
+	System string `json:"system"`
+
+	// ID is the identifier of the change record within the change management system
+	// +required
+	ID string `json:"id"`
+
+	// URL is the address of the change record
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// Validate checks that the change request specifies the system and ID of the change record and that its URL, if any,
+// is an absolute http or https URL. An error is returned otherwise.
+func (c *ReleaseDataChangeRequest) Validate() error {
+	if c.System == "" || c.ID == "" {
+		return fmt.Errorf("the change request must specify the system and id of the change record")
+	}
+
+	if c.URL != "" {
+		changeRecordUrl, err := url.Parse(c.URL)
+		if err != nil || (changeRecordUrl.Scheme != "http" && changeRecordUrl.Scheme != "https") || changeRecordUrl.Host == "" {
+			return fmt.Errorf("the change request url %s is not an absolute http or https URL", c.URL)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseDataMapping defines how the Snapshot components are mapped to their destination repositories.
+type ReleaseDataMapping struct {
+	// Components is the list of components to map
+	// +optional
+	Components []ReleaseDataComponent `json:"components,omitempty"`
+
+	// Defaults holds the values applied to every component unless overridden. Its content is not validated
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Defaults *runtime.RawExtension `json:"defaults,omitempty"`
+}
+
+// ReleaseDataComponent defines the destination of a single Snapshot component. Other keys than the ones described
+// here are allowed as they are interpreted by the managed Release Pipeline.
+type ReleaseDataComponent struct {
+	// Name is the name of the component within the Snapshot
+	// +required
+	Name string `json:"name"`
+
+	// Repository is the repository the component is pushed to
+	// +required
+	Repository string `json:"repository"`
+
+	// Tags is a list of tags to push the component with
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ReleaseDataPyxis defines the Pyxis instance the managed Release Pipeline should use.
+type ReleaseDataPyxis struct {
+	// Server is the Pyxis server to use
+	// +kubebuilder:validation:Enum=production;production-internal;stage;stage-internal
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// Secret is the name of the Secret holding the Pyxis credentials
+	// +optional
+	Secret string `json:"secret,omitempty"`
+}
+
+// ReleaseDataSign defines how the released content is signed by the managed Release Pipeline.
+type ReleaseDataSign struct {
+	// ConfigMapName is the name of the ConfigMap holding the signing configuration
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// CosignSecretName is the name of the Secret holding the cosign signing key
+	// +optional
+	CosignSecretName string `json:"cosignSecretName,omitempty"`
+
+	// PipelineImage is the image used to run the signing pipeline
+	// +optional
+	PipelineImage string `json:"pipelineImage,omitempty"`
+
+	// Request is the type of signing request to submit
+	// +optional
+	Request string `json:"request,omitempty"`
+}
+
+// GetData returns the known keys of the Release data. An error is returned if the data can't be parsed.
+func (r *Release) GetData() (*ReleaseData, error) {
+	data := &ReleaseData{}
+	if r.Spec.Data == nil || len(r.Spec.Data.Raw) == 0 {
+		return data, nil
+	}
+
+	if err := json.Unmarshal(r.Spec.Data.Raw, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// GetDataSensitiveValues returns the values of the Release data found at the paths listed in its sensitive key. The
+// scalar values nested in objects and lists are returned as well. Paths not found in the data are ignored. An error is
+// returned if the data can't be parsed.
+func (r *Release) GetDataSensitiveValues() ([]string, error) {
+	data, err := r.GetData()
+	if err != nil || len(data.Sensitive) == 0 {
+		return nil, err
+	}
+
+	var rawData interface{}
+	if err := json.Unmarshal(r.Spec.Data.Raw, &rawData); err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for _, path := range data.Sensitive {
+		value := rawData
+		for _, key := range strings.Split(path, ".") {
+			switch typedValue := value.(type) {
+			case map[string]interface{}:
+				value = typedValue[key]
+			case []interface{}:
+				index, err := strconv.Atoi(key)
+				if err != nil || index < 0 || index >= len(typedValue) {
+					value = nil
+				} else {
+					value = typedValue[index]
+				}
+			default:
+				value = nil
+			}
+		}
+		values = appendScalarValues(values, value)
+	}
+
+	return values, nil
+}
+
+// CheckDataLimits checks the size and nesting depth of the given data against the limits set in the
+// RELEASE_DATA_MAX_SIZE and RELEASE_DATA_MAX_DEPTH environment variables, using the defaults when they are not set to
+// a positive integer. If a limit is exceeded, its name and an error describing it are returned. Malformed data is not
+// reported, as it is rejected by the API server.
+func CheckDataLimits(data *runtime.RawExtension) (string, error) {
+	if data == nil || len(data.Raw) == 0 {
+		return "", nil
+	}
+
+	maxSize := getDataLimit("RELEASE_DATA_MAX_SIZE", DefaultDataMaxSize)
+	if len(data.Raw) > maxSize {
+		return DataLimitSize, fmt.Errorf("the data is %d bytes long, which exceeds the maximum of %d bytes",
+			len(data.Raw), maxSize)
+	}
+
+	maxDepth := getDataLimit("RELEASE_DATA_MAX_DEPTH", DefaultDataMaxDepth)
+	decoder := json.NewDecoder(bytes.NewReader(data.Raw))
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", nil
+		}
+
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+			if depth > maxDepth {
+				return DataLimitDepth, fmt.Errorf("the data is nested more than %d levels deep", maxDepth)
+			}
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+}
+
+// appendScalarValues appends the given data value to the given list if it's a scalar, formatted as it appears in the
+// data. The scalar values nested in objects and lists are appended instead otherwise.
+func appendScalarValues(values []string, value interface{}) []string {
+	switch typedValue := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		for _, nestedValue := range typedValue {
+			values = appendScalarValues(values, nestedValue)
+		}
+	case []interface{}:
+		for _, nestedValue := range typedValue {
+			values = appendScalarValues(values, nestedValue)
+		}
+	case string:
+		values = append(values, typedValue)
+	default:
+		values = append(values, fmt.Sprint(typedValue))
+	}
+
+	return values
+}
+
+// getDataLimit returns the value of the given environment variable if it's set to a positive integer. The given default
+// value is returned otherwise.
+func getDataLimit(name string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+
+	return value
+}
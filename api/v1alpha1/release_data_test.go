@@ -0,0 +1,173 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("Release data", func() {
+
+	When("GetData method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return empty data when the Release has no data", func() {
+			data, err := release.GetData()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(&ReleaseData{}))
+		})
+
+		It("should return the known keys of the data ignoring the unknown ones", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"mapping": {
+					"components": [{"name": "foo", "repository": "quay.io/foo", "tags": ["latest"], "public": true}],
+					"defaults": {"pushSourceContainer": true}
+				},
+				"pyxis": {"server": "stage", "secret": "pyxis"},
+				"sign": {"configMapName": "signing-config"},
+				"other": "value"
+			}`)}
+
+			data, err := release.GetData()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data.Mapping.Components).To(Equal([]ReleaseDataComponent{
+				{Name: "foo", Repository: "quay.io/foo", Tags: []string{"latest"}},
+			}))
+			Expect(string(data.Mapping.Defaults.Raw)).To(MatchJSON(`{"pushSourceContainer": true}`))
+			Expect(data.Pyxis).To(Equal(&ReleaseDataPyxis{Server: "stage", Secret: "pyxis"}))
+			Expect(data.Sign).To(Equal(&ReleaseDataSign{ConfigMapName: "signing-config"}))
+		})
+
+		It("should return the change request", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"changeRequest": {"system": "servicenow", "id": "CHG0001", "url": "https://example.com/CHG0001"}
+			}`)}
+
+			data, err := release.GetData()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data.ChangeRequest).To(Equal(&ReleaseDataChangeRequest{
+				System: "servicenow",
+				ID:     "CHG0001",
+				URL:    "https://example.com/CHG0001",
+			}))
+		})
+
+		It("should fail when a known key has an unexpected type", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"mapping": {"components": "foo"}}`)}
+
+			_, err := release.GetData()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("GetDataSensitiveValues method is called", func() {
+		It("should return no values when the data doesn't mark any value as sensitive", func() {
+			release := &Release{}
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": "foo"}`)}
+
+			values, err := release.GetDataSensitiveValues()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(BeEmpty())
+		})
+
+		It("should return the values found at the sensitive paths", func() {
+			release := &Release{}
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"mapping": {"defaults": {"token": "foo", "retries": 3}},
+				"credentials": [{"password": "bar"}],
+				"sensitive": ["mapping.defaults", "credentials.0.password", "missing.path"]
+			}`)}
+
+			values, err := release.GetDataSensitiveValues()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(ConsistOf("foo", "3", "bar"))
+		})
+	})
+
+	When("Validate method is called for a change request", func() {
+		It("should succeed when the change request is complete", func() {
+			changeRequest := &ReleaseDataChangeRequest{System: "servicenow", ID: "CHG0001", URL: "https://example.com/CHG0001"}
+			Expect(changeRequest.Validate()).To(Succeed())
+		})
+
+		It("should succeed when the change request has no URL", func() {
+			changeRequest := &ReleaseDataChangeRequest{System: "servicenow", ID: "CHG0001"}
+			Expect(changeRequest.Validate()).To(Succeed())
+		})
+
+		It("should fail when the system or ID is missing", func() {
+			Expect((&ReleaseDataChangeRequest{System: "servicenow"}).Validate()).NotTo(Succeed())
+			Expect((&ReleaseDataChangeRequest{ID: "CHG0001"}).Validate()).NotTo(Succeed())
+		})
+
+		It("should fail when the URL is not an absolute http or https URL", func() {
+			changeRequest := &ReleaseDataChangeRequest{System: "servicenow", ID: "CHG0001", URL: "ftp://example.com/CHG0001"}
+			Expect(changeRequest.Validate()).NotTo(Succeed())
+
+			changeRequest.URL = "CHG0001"
+			Expect(changeRequest.Validate()).NotTo(Succeed())
+		})
+	})
+
+	When("CheckDataLimits function is called", func() {
+		It("should return no error when there is no data", func() {
+			limit, err := CheckDataLimits(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeEmpty())
+		})
+
+		It("should return no error when the data is within the limits", func() {
+			limit, err := CheckDataLimits(&runtime.RawExtension{Raw: []byte(`{"foo": [{"bar": "baz"}]}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeEmpty())
+		})
+
+		It("should return the size limit when the data is too big", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_SIZE", "10")
+
+			limit, err := CheckDataLimits(&runtime.RawExtension{Raw: []byte(`{"foo": "0123456789"}`)})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exceeds the maximum of 10 bytes"))
+			Expect(limit).To(Equal(DataLimitSize))
+		})
+
+		It("should return the depth limit when the data is nested too deep", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_DEPTH", "2")
+
+			limit, err := CheckDataLimits(&runtime.RawExtension{Raw: []byte(`{"foo": [{"bar": "baz"}]}`)})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("nested more than 2 levels deep"))
+			Expect(limit).To(Equal(DataLimitDepth))
+		})
+
+		It("should use the defaults when the limits are not positive integers", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_DEPTH", "foo")
+			GinkgoT().Setenv("RELEASE_DATA_MAX_SIZE", "-1")
+
+			limit, err := CheckDataLimits(&runtime.RawExtension{Raw: []byte(`{"foo": [{"bar": "baz"}]}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,132 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ReleaseSchedule defines when Releases are allowed to start.
+type ReleaseSchedule struct {
+	// TimeZone is the IANA time zone the release windows are defined in. UTC is used if not set
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows is the list of time ranges in which Releases are allowed to start
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Windows []ReleaseWindow `json:"windows"`
+}
+
+// ReleaseWindow defines a time range of the day in which Releases are allowed to start.
+type ReleaseWindow struct {
+	// Days is the list of weekdays the window applies to. The window applies to every day if not set
+	// +kubebuilder:validation:items:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// End is the time of the day, in the HH:MM format, when the window closes
+	// +kubebuilder:validation:Pattern=^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$
+	// +required
+	End string `json:"end"`
+
+	// Start is the time of the day, in the HH:MM format, when the window opens
+	// +kubebuilder:validation:Pattern=^([01][0-9]|2[0-3]):[0-5][0-9]$
+	// +required
+	Start string `json:"start"`
+}
+
+// GetNextOpening returns the time when Releases are allowed to start again after the given time. If a release window
+// is open at the given time, the given time is returned. An error is returned if the time zone or any of the windows
+// is not valid, or if no window opens within a week.
+func (s *ReleaseSchedule) GetNextOpening(now time.Time) (time.Time, error) {
+	location := time.UTC
+	if s.TimeZone != "" {
+		var err error
+		location, err = time.LoadLocation(s.TimeZone)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	now = now.In(location)
+
+	var nextOpening time.Time
+	for offset := 0; offset <= 7; offset++ {
+		day := time.Date(now.Year(), now.Month(), now.Day()+offset, 0, 0, 0, 0, location)
+
+		for _, window := range s.Windows {
+			if !window.appliesTo(day.Weekday()) {
+				continue
+			}
+
+			start, end, err := window.getBounds(day)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			if !now.Before(start) && now.Before(end) {
+				return now, nil
+			}
+			if start.After(now) && (nextOpening.IsZero() || start.Before(nextOpening)) {
+				nextOpening = start
+			}
+		}
+
+		if !nextOpening.IsZero() {
+			return nextOpening, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("no release window opens within a week")
+}
+
+// appliesTo returns whether the window applies to the given weekday.
+func (w *ReleaseWindow) appliesTo(weekday time.Weekday) bool {
+	return len(w.Days) == 0 || slices.Contains(w.Days, weekday.String())
+}
+
+// getBounds returns the times when the window opens and closes in the given day.
+func (w *ReleaseWindow) getBounds(day time.Time) (time.Time, time.Time, error) {
+	start, err := getTimeOfDay(day, w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end, err := getTimeOfDay(day, w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("the release window %s-%s doesn't end after it starts", w.Start, w.End)
+	}
+
+	return start, end, nil
+}
+
+// getTimeOfDay returns the time of the given day matching the given time of the day in the HH:MM format.
+func getTimeOfDay(day time.Time, timeOfDay string) (time.Time, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(timeOfDay, "%d:%d", &hour, &minute); err != nil {
+		return time.Time{}, fmt.Errorf("invalid time of the day '%s'", timeOfDay)
+	}
+
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location()), nil
+}
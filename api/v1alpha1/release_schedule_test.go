@@ -0,0 +1,125 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReleaseSchedule type", func() {
+	When("GetNextOpening method is called", func() {
+		// 2024-01-01 is a Monday
+		monday := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+		It("should return the given time if a window is open", func() {
+			schedule := &ReleaseSchedule{
+				Windows: []ReleaseWindow{
+					{Days: []string{"Monday"}, Start: "09:00", End: "17:00"},
+				},
+			}
+
+			nextOpening, err := schedule.GetNextOpening(monday)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nextOpening.Equal(monday)).To(BeTrue())
+		})
+
+		It("should return the start of a window opening later the same day", func() {
+			schedule := &ReleaseSchedule{
+				Windows: []ReleaseWindow{
+					{Start: "18:00", End: "20:00"},
+					{Start: "12:00", End: "13:00"},
+				},
+			}
+
+			nextOpening, err := schedule.GetNextOpening(monday)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nextOpening).To(Equal(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return the start of the window of the next matching weekday", func() {
+			schedule := &ReleaseSchedule{
+				Windows: []ReleaseWindow{
+					{Days: []string{"Wednesday"}, Start: "09:00", End: "17:00"},
+				},
+			}
+
+			nextOpening, err := schedule.GetNextOpening(monday)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nextOpening).To(Equal(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return the start of the same window next week if it already closed", func() {
+			schedule := &ReleaseSchedule{
+				Windows: []ReleaseWindow{
+					{Days: []string{"Monday"}, Start: "08:00", End: "10:00"},
+				},
+			}
+
+			nextOpening, err := schedule.GetNextOpening(monday)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nextOpening).To(Equal(time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC)))
+		})
+
+		It("should use the time zone of the schedule", func() {
+			schedule := &ReleaseSchedule{
+				TimeZone: "America/New_York",
+				Windows: []ReleaseWindow{
+					{Start: "09:00", End: "17:00"},
+				},
+			}
+
+			// 10:30 UTC is 05:30 in New York, so the window opens at 14:00 UTC
+			nextOpening, err := schedule.GetNextOpening(monday)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nextOpening.Equal(time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should fail if the time zone is not valid", func() {
+			schedule := &ReleaseSchedule{
+				TimeZone: "Invalid/TimeZone",
+				Windows: []ReleaseWindow{
+					{Start: "09:00", End: "17:00"},
+				},
+			}
+
+			_, err := schedule.GetNextOpening(monday)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if a window doesn't end after it starts", func() {
+			schedule := &ReleaseSchedule{
+				Windows: []ReleaseWindow{
+					{Start: "17:00", End: "09:00"},
+				},
+			}
+
+			_, err := schedule.GetNextOpening(monday)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't end after it starts"))
+		})
+
+		It("should fail if no window is defined", func() {
+			schedule := &ReleaseSchedule{}
+
+			_, err := schedule.GetNextOpening(monday)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -17,16 +17,32 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/konflux-ci/operator-toolkit/conditions"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
 
 	"github.com/konflux-ci/release-service/metadata"
 	"github.com/konflux-ci/release-service/metrics"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/strings/slices"
+)
+
+const (
+	// encryptedField is the name of the field used in the Release data to hold an envelope encrypted value.
+	encryptedField = "encrypted"
+
+	// secretKeyRefField is the name of the field used in the Release data to reference a key of a Secret.
+	secretKeyRefField = "secretKeyRef"
 )
 
 // ReleaseSpec defines the desired state of Release.
@@ -41,15 +57,66 @@ type ReleaseSpec struct {
 	// +required
 	ReleasePlan string `json:"releasePlan"`
 
-	// Data is an unstructured key used for providing data for the managed Release Pipeline
+	// Data is an unstructured key used for providing data for the managed Release Pipeline. Values can be replaced
+	// by a secretKeyRef pointing to a key of a Secret in the Release namespace so sensitive values are not stored in
+	// plaintext. Referenced values are provided to the managed Release Pipeline in the release-data-secrets workspace.
+	// The commonly used keys are described by the ReleaseData type
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
 	Data *runtime.RawExtension `json:"data,omitempty"`
 
+	// DependsOn references a Release that has to finish successfully before this Release is processed
+	// +optional
+	DependsOn *ReleaseDependency `json:"dependsOn,omitempty"`
+
 	// GracePeriodDays is the number of days a Release should be kept
 	// This value is used to define the Release ExpirationTime
 	// +optional
 	GracePeriodDays int `json:"gracePeriodDays,omitempty"`
+
+	// IdempotencyKey is a key identifying the request that created this Release. Releases with a key are named after
+	// it, so creating a Release with the same key as an existing Release in the namespace will fail, reporting the name
+	// of the existing Release
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+
+	// Priority is the priority of the Release. Urgent Releases can preempt queued normal Releases of the same
+	// application to the same target if the ReleasePlanAdmission allows preemption
+	// +kubebuilder:validation:Enum=urgent;normal
+	// +kubebuilder:default:=normal
+	// +optional
+	Priority ReleasePriority `json:"priority,omitempty"`
+
+	// Strategy is the name of the ReleasePlanAdmission strategy whose managed Pipeline should be used for this
+	// particular Release. The Pipeline defined in the ReleasePlanAdmission pipeline field is used if not set
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+}
+
+// ReleasePriority defines the priority of a Release.
+type ReleasePriority string
+
+const (
+	// ReleasePriorityNormal is the priority of regular Releases
+	ReleasePriorityNormal ReleasePriority = "normal"
+
+	// ReleasePriorityUrgent is the priority of Releases that shouldn't wait behind normal Releases, like hotfixes
+	ReleasePriorityUrgent ReleasePriority = "urgent"
+)
+
+// ReleaseDependency defines the Release another Release depends on.
+type ReleaseDependency struct {
+	// Release is the name of the Release this Release depends on. It must be in the same namespace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Release string `json:"release"`
+
+	// PropagateResults indicates whether the artifacts of the Release this Release depends on should be added
+	// to the data of this Release before processing it
+	// +optional
+	PropagateResults bool `json:"propagateResults,omitempty"`
 }
 
 // ReleaseStatus defines the observed state of Release.
@@ -68,10 +135,22 @@ type ReleaseStatus struct {
 	// +optional
 	Collectors *runtime.RawExtension `json:"collectors,omitempty"`
 
+	// Components contains the outcome of the release of each component, as reported by the managed Release Pipeline
+	// +optional
+	Components []ComponentStatus `json:"components,omitempty"`
+
 	// Conditions represent the latest available observations for the release
 	// +optional
 	Conditions []metav1.Condition `json:"conditions"`
 
+	// Deployment contains information about the deployment of the released Snapshot
+	// +optional
+	Deployment DeploymentInfo `json:"deployment,omitempty"`
+
+	// Latency contains the time elapsed between the released Snapshot becoming releasable and the Release progress
+	// +optional
+	Latency LatencyInfo `json:"latency,omitempty"`
+
 	// ManagedProcessing contains information about the release managed processing
 	// +optional
 	ManagedProcessing PipelineInfo `json:"managedProcessing,omitempty"`
@@ -80,6 +159,24 @@ type ReleaseStatus struct {
 	// +optional
 	PostActionsExecution PipelineInfo `json:"postActionsExecution,omitempty"`
 
+	// Provenance contains the Pipelines as Code annotations of the released Snapshot describing the source it was
+	// built from (e.g. the repository and commit)
+	// +optional
+	Provenance map[string]string `json:"provenance,omitempty"`
+
+	// Summary contains a human-readable summary of the Release state derived from its conditions
+	// +optional
+	Summary ReleaseSummary `json:"summary,omitempty"`
+
+	// SupportBundle contains the namespaced name of the ConfigMap holding the support bundle of the Release, which
+	// collects the resources, events and logs related to it for troubleshooting
+	// +optional
+	SupportBundle string `json:"supportBundle,omitempty"`
+
+	// Targets contains the processing status of each of the ReleasePlanAdmissions a fan-out Release is released to
+	// +optional
+	Targets []TargetInfo `json:"targets,omitempty"`
+
 	// TenantProcessing contains information about the release tenant processing
 	// +optional
 	TenantProcessing PipelineInfo `json:"tenantProcessing,omitempty"`
@@ -88,6 +185,10 @@ type ReleaseStatus struct {
 	// +optional
 	Validation ValidationInfo `json:"validation,omitempty"`
 
+	// Verification contains information about the verification of the released artifacts
+	// +optional
+	Verification PipelineInfo `json:"verification,omitempty"`
+
 	// Target references where this release is intended to be released to
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
@@ -105,6 +206,10 @@ type ReleaseStatus struct {
 	// +optional
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
+	// ScheduledTime is the time when a Release waiting for a release window is expected to start
+	// +optional
+	ScheduledTime *metav1.Time `json:"scheduledTime,omitempty"`
+
 	// ExpirationTime is the time when a Release can be purged
 	// +optional
 	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
@@ -112,6 +217,10 @@ type ReleaseStatus struct {
 
 // AttributionInfo defines the observed state of the release attribution.
 type AttributionInfo struct {
+	// Approver is the username of the user who approved the release when a two-person review is required
+	// +optional
+	Approver string `json:"approver,omitempty"`
+
 	// Author is the username that the release is attributed to
 	// +optional
 	Author string `json:"author,omitempty"`
@@ -121,17 +230,115 @@ type AttributionInfo struct {
 	StandingAuthorization bool `json:"standingAuthorization,omitempty"`
 }
 
+// ComponentPhase is the outcome of the release of a component.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type ComponentPhase string
+
+const (
+	// ComponentPhaseSucceeded is the phase of a component that was released
+	ComponentPhaseSucceeded ComponentPhase = "Succeeded"
+
+	// ComponentPhaseFailed is the phase of a component that failed to be released
+	ComponentPhaseFailed ComponentPhase = "Failed"
+)
+
+// ComponentStatus defines the observed state of the release of a Snapshot component.
+type ComponentStatus struct {
+	// Message is the message reported by the managed Release Pipeline for the component, e.g. why it failed
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Name is the name of the component
+	// +required
+	Name string `json:"name"`
+
+	// Phase is the outcome of the release of the component
+	// +required
+	Phase ComponentPhase `json:"phase"`
+}
+
+// ComputeUsage defines the compute resources used by a Release PipelineRun.
+type ComputeUsage struct {
+	// CPUMillicoreSeconds is the sum of the CPU millicores requested by each task multiplied by the seconds it ran
+	// +optional
+	CPUMillicoreSeconds int64 `json:"cpuMillicoreSeconds,omitempty"`
+
+	// EstimatedCost is the price of the compute resources according to the cost rates of the ReleaseServiceConfig
+	// +optional
+	EstimatedCost string `json:"estimatedCost,omitempty"`
+
+	// MemoryMiBSeconds is the sum of the memory MiB requested by each task multiplied by the seconds it ran
+	// +optional
+	MemoryMiBSeconds int64 `json:"memoryMiBSeconds,omitempty"`
+}
+
+// DeploymentInfo defines the observed state of the deployment of a released Snapshot.
+type DeploymentInfo struct {
+	// CompletionTime is the time when the deployment was completed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// SnapshotEnvironmentBindings contains the namespaced names of the SnapshotEnvironmentBindings created or updated
+	// to deploy the released Snapshot
+	// +optional
+	SnapshotEnvironmentBindings []string `json:"snapshotEnvironmentBindings,omitempty"`
+
+	// StartTime is the time when the deployment started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// LatencyInfo defines the observed latency between the released Snapshot becoming releasable and the Release.
+type LatencyInfo struct {
+	// SnapshotReleasableTime is the time when the released Snapshot became releasable, which is when its
+	// integration tests passed or, if it didn't report them, when it was created
+	// +optional
+	SnapshotReleasableTime *metav1.Time `json:"snapshotReleasableTime,omitempty"`
+
+	// SnapshotToReleaseStart is the time elapsed between the Snapshot becoming releasable and the Release starting
+	// +optional
+	SnapshotToReleaseStart *metav1.Duration `json:"snapshotToReleaseStart,omitempty"`
+
+	// SnapshotToReleased is the time elapsed between the Snapshot becoming releasable and the Release succeeding
+	// +optional
+	SnapshotToReleased *metav1.Duration `json:"snapshotToReleased,omitempty"`
+}
+
 // PipelineInfo defines the observed state of a release pipeline processing.
 type PipelineInfo struct {
 	// CompletionTime is the time when the Release processing was completed
 	// +optional
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
+	// ComputeUsage contains the compute resources requested by the tasks of the PipelineRun over the time they ran and
+	// their estimated cost, recorded once the PipelineRun finishes
+	// +optional
+	ComputeUsage *ComputeUsage `json:"computeUsage,omitempty"`
+
+	// ExcludedNodes contains the names of the nodes the tasks of the PipelineRun are kept away from because a previous
+	// PipelineRun failed on them
+	// +optional
+	ExcludedNodes []string `json:"excludedNodes,omitempty"`
+
+	// LogsConfigMap contains the namespaced name of the ConfigMap holding the tail of the logs of the failed tasks
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-.a-z0-9]*[a-z0-9])?$
+	// +optional
+	LogsConfigMap string `json:"logsConfigMap,omitempty"`
+
 	// PipelineRun contains the namespaced name of the managed Release PipelineRun executed as part of this release
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
 	PipelineRun string `json:"pipelineRun,omitempty"`
 
+	// Progress contains the coarse task-level progress of the PipelineRun, updated periodically while it runs
+	// +optional
+	Progress *PipelineProgress `json:"progress,omitempty"`
+
+	// Retries is the number of times the PipelineRun was created again after being detected as stalled or failing
+	// because of its nodes
+	// +optional
+	Retries int `json:"retries,omitempty"`
+
 	// RoleBinding contains the namespaced name of the roleBinding created for the managed Release PipelineRun
 	// executed as part of this release
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
@@ -143,8 +350,119 @@ type PipelineInfo struct {
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 }
 
+// PipelineProgress defines the task-level progress of a release pipeline processing.
+type PipelineProgress struct {
+	// CompletedTasks is the number of tasks of the PipelineRun that finished running
+	// +required
+	CompletedTasks int `json:"completedTasks"`
+
+	// LastUpdateTime is the time when the progress was last updated
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// TotalTasks is the number of tasks of the PipelineRun
+	// +required
+	TotalTasks int `json:"totalTasks"`
+}
+
+// ReleasePhase is the overall phase of a Release.
+// +kubebuilder:validation:Enum=Pending;Queued;Progressing;Stalled;Succeeded;Failed;Superseded
+type ReleasePhase string
+
+const (
+	// ReleasePhasePending is the phase of a Release that didn't start yet
+	ReleasePhasePending ReleasePhase = "Pending"
+
+	// ReleasePhaseQueued is the phase of a Release waiting for another Release to finish or for capacity in the managed
+	// namespace to run its managed Pipeline
+	ReleasePhaseQueued ReleasePhase = "Queued"
+
+	// ReleasePhaseProgressing is the phase of a Release being processed
+	ReleasePhaseProgressing ReleasePhase = "Progressing"
+
+	// ReleasePhaseStalled is the phase of a Release whose PipelineRun stopped progressing or that was quarantined
+	ReleasePhaseStalled ReleasePhase = "Stalled"
+
+	// ReleasePhaseSucceeded is the phase of a Release that finished successfully
+	ReleasePhaseSucceeded ReleasePhase = "Succeeded"
+
+	// ReleasePhaseFailed is the phase of a Release that failed
+	ReleasePhaseFailed ReleasePhase = "Failed"
+
+	// ReleasePhaseSuperseded is the phase of an automated Release skipped in favor of a newer one
+	ReleasePhaseSuperseded ReleasePhase = "Superseded"
+)
+
+// ReleaseSummary defines a human-readable summary of the Release state.
+type ReleaseSummary struct {
+	// Message is a human-readable message describing the current state of the Release
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Phase is the overall phase of the Release
+	// +optional
+	Phase ReleasePhase `json:"phase,omitempty"`
+}
+
+// TargetPhase is the outcome of the release to one of the targets of a fan-out Release.
+// +kubebuilder:validation:Enum=Pending;Progressing;Succeeded;Failed
+type TargetPhase string
+
+const (
+	// TargetPhasePending is the phase of a target waiting for the gates of its ReleasePlanAdmission to pass
+	TargetPhasePending TargetPhase = "Pending"
+
+	// TargetPhaseProgressing is the phase of a target whose managed Pipeline is running
+	TargetPhaseProgressing TargetPhase = "Progressing"
+
+	// TargetPhaseSucceeded is the phase of a target whose managed Pipeline succeeded
+	TargetPhaseSucceeded TargetPhase = "Succeeded"
+
+	// TargetPhaseFailed is the phase of a target whose managed Pipeline failed or couldn't be created
+	TargetPhaseFailed TargetPhase = "Failed"
+)
+
+// TargetInfo defines the observed state of the release to one of the targets of a fan-out Release.
+type TargetInfo struct {
+	// CompletionTime is the time when the managed Pipeline of the target finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message is a human-readable message describing the outcome of the target, e.g. why it failed
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Phase is the outcome of the release to the target
+	// +required
+	Phase TargetPhase `json:"phase"`
+
+	// PipelineRun contains the namespaced name of the managed Release PipelineRun executed for the target
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	PipelineRun string `json:"pipelineRun,omitempty"`
+
+	// ReleasePlanAdmission contains the namespaced name of the ReleasePlanAdmission of the target
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-.a-z0-9]*[a-z0-9])?$
+	// +required
+	ReleasePlanAdmission string `json:"releasePlanAdmission"`
+
+	// RoleBinding contains the namespaced name of the roleBinding created for the managed Release PipelineRun of the
+	// target
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	RoleBinding string `json:"roleBinding,omitempty"`
+
+	// StartTime is the time when the managed Pipeline of the target was created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
 // ValidationInfo defines the observed state of the release validation.
 type ValidationInfo struct {
+	// Causes contains the structured causes of the validation failure, if any
+	// +optional
+	Causes []ValidationCause `json:"causes,omitempty"`
+
 	// FailedPostValidation indicates whether the Release was marked as invalid after being initially marked as valid
 	FailedPostValidation bool `json:"failedPostValidation,omitempty"`
 
@@ -154,11 +472,13 @@ type ValidationInfo struct {
 }
 
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:shortName=rel
+// +kubebuilder:resource:shortName=rel,categories=appstudio
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Snapshot",type=string,JSONPath=`.spec.snapshot`
 // +kubebuilder:printcolumn:name="ReleasePlan",type=string,JSONPath=`.spec.releasePlan`
 // +kubebuilder:printcolumn:name="Release status",type=string,JSONPath=`.status.conditions[?(@.type=="Released")].reason`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.summary.phase`
+// +kubebuilder:printcolumn:name="Summary",type=string,JSONPath=`.status.summary.message`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Release is the Schema for the releases API
@@ -170,17 +490,86 @@ type Release struct {
 	Status ReleaseStatus `json:"status,omitempty"`
 }
 
+// GetDataSecretKeyRefs returns the Secret keys referenced in the Release data indexed by the path of the reference
+// within the data (e.g. "mapping.defaults.token"). An error is returned if the data can't be parsed, a reference lacks
+// the Secret name or key or its path is not a valid Secret key.
+func (r *Release) GetDataSecretKeyRefs() (map[string]corev1.SecretKeySelector, error) {
+	secretKeyRefs := map[string]corev1.SecretKeySelector{}
+	if r.Spec.Data == nil || len(r.Spec.Data.Raw) == 0 {
+		return secretKeyRefs, nil
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(r.Spec.Data.Raw, &data); err != nil {
+		return nil, err
+	}
+
+	return secretKeyRefs, collectSecretKeyRefs(data, "", secretKeyRefs)
+}
+
+// GetDataEncryptedValues returns the encrypted values found in the Release data indexed by their path within the data
+// (e.g. "mapping.defaults.token"). An error is returned if the data can't be parsed, an encrypted value is not a
+// non-empty string or its path is not a valid Secret key.
+func (r *Release) GetDataEncryptedValues() (map[string]string, error) {
+	encryptedValues := map[string]string{}
+	if r.Spec.Data == nil || len(r.Spec.Data.Raw) == 0 {
+		return encryptedValues, nil
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(r.Spec.Data.Raw, &data); err != nil {
+		return nil, err
+	}
+
+	return encryptedValues, walkDataReferences(data, "", encryptedField, func(path string, ref interface{}) error {
+		encryptedValue, ok := ref.(string)
+		if !ok || encryptedValue == "" {
+			return fmt.Errorf("the %s value in %s must be a non-empty string", encryptedField, path)
+		}
+
+		encryptedValues[path] = encryptedValue
+		return nil
+	})
+}
+
+// GetDeprecatedFields returns the deprecated fields set in the Release.
+func (r *Release) GetDeprecatedFields() []DeprecatedField {
+	var fields []DeprecatedField
+	if _, found := r.GetAnnotations()[metadata.StrategyAnnotation]; found {
+		field := fmt.Sprintf("metadata.annotations[%s]", metadata.StrategyAnnotation)
+		fields = append(fields, DeprecatedField{Field: field, Path: field, Replacement: "spec.strategy"})
+	}
+
+	return fields
+}
+
+// HasDeprecatedFieldsReported checks whether the DeprecatedFieldsUsed condition of the Release reports the given
+// deprecated fields.
+func (r *Release) HasDeprecatedFieldsReported(fields []DeprecatedField) bool {
+	return isDeprecatedFieldsUsedReported(r.Status.Conditions, fields)
+}
+
 // HasEveryPostActionExecutionFinished checks whether the Release post-actions execution has finished,
 // regardless of the result.
 func (r *Release) HasEveryPostActionExecutionFinished() bool {
 	return r.hasPhaseFinished(postActionsExecutedConditionType)
 }
 
+// HasDeploymentFinished checks whether the deployment of the released Snapshot has finished, regardless of the result.
+func (r *Release) HasDeploymentFinished() bool {
+	return r.hasPhaseFinished(deployedConditionType)
+}
+
 // HasManagedPipelineProcessingFinished checks whether the Release Managed Pipeline processing has finished, regardless of the result.
 func (r *Release) HasManagedPipelineProcessingFinished() bool {
 	return r.hasPhaseFinished(managedProcessedConditionType)
 }
 
+// HasSnapshotReleasableTime checks whether the time when the released Snapshot became releasable was recorded.
+func (r *Release) HasSnapshotReleasableTime() bool {
+	return r.Status.Latency.SnapshotReleasableTime != nil
+}
+
 // HasTenantPipelineProcessingFinished checks whether the Release Tenant Pipeline processing has finished, regardless of the result.
 func (r *Release) HasTenantPipelineProcessingFinished() bool {
 	return r.hasPhaseFinished(tenantProcessedConditionType)
@@ -191,6 +580,12 @@ func (r *Release) HasReleaseFinished() bool {
 	return r.hasPhaseFinished(releasedConditionType)
 }
 
+// HasVerificationFinished checks whether the verification of the released artifacts has finished, regardless of the
+// result.
+func (r *Release) HasVerificationFinished() bool {
+	return r.hasPhaseFinished(verifiedConditionType)
+}
+
 // IsAttributed checks whether the Release was marked as attributed.
 func (r *Release) IsAttributed() bool {
 	return r.Status.Attribution.Author != ""
@@ -201,6 +596,21 @@ func (r *Release) IsAutomated() bool {
 	return r.Status.Automated
 }
 
+// IsDependencyHealthy checks whether the last run of the dependency health check with the given name succeeded.
+func (r *Release) IsDependencyHealthy(name string) bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, getDependencyHealthyConditionType(name).String())
+}
+
+// IsDeployed checks whether the released Snapshot was deployed to all its Environments.
+func (r *Release) IsDeployed() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, deployedConditionType.String())
+}
+
+// IsDeploying checks whether the released Snapshot is being deployed.
+func (r *Release) IsDeploying() bool {
+	return r.isPhaseProgressing(deployedConditionType)
+}
+
 // IsEveryPostActionExecuted checks whether the Release post-actions were successfully executed.
 func (r *Release) IsEveryPostActionExecuted() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, postActionsExecutedConditionType.String())
@@ -211,6 +621,24 @@ func (r *Release) IsEachPostActionExecuting() bool {
 	return r.isPhaseProgressing(postActionsExecutedConditionType)
 }
 
+// IsEmergencyBypassActive checks whether the Release has an emergency bypass authorized by the author webhook that
+// didn't expire yet.
+func (r *Release) IsEmergencyBypassActive() bool {
+	annotations := r.GetAnnotations()
+	if annotations[metadata.EmergencyBypassAnnotation] == "" || annotations[metadata.EmergencyBypassUserAnnotation] == "" {
+		return false
+	}
+
+	expiration, err := time.Parse(time.RFC3339, annotations[metadata.EmergencyBypassExpirationAnnotation])
+
+	return err == nil && time.Now().Before(expiration)
+}
+
+// IsFailed checks whether the Release finished without being released, excluding Releases that were superseded.
+func (r *Release) IsFailed() bool {
+	return r.HasReleaseFinished() && !r.IsReleased() && !r.IsSuperseded()
+}
+
 // IsManagedPipelineProcessed checks whether the Release Managed Pipeline was successfully processed.
 func (r *Release) IsManagedPipelineProcessed() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, managedProcessedConditionType.String())
@@ -231,21 +659,261 @@ func (r *Release) IsTenantPipelineProcessing() bool {
 	return r.isPhaseProgressing(tenantProcessedConditionType)
 }
 
+// IsPartiallyReleased checks whether the managed Release Pipeline reported that only some of the components were
+// released.
+func (r *Release) IsPartiallyReleased() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, partiallyReleasedConditionType.String())
+}
+
+// IsPlanDeleted checks whether the Release was flagged as finished before its ReleasePlan was deleted.
+func (r *Release) IsPlanDeleted() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, planDeletedConditionType.String())
+}
+
+// IsQuarantined checks whether the Release was quarantined after repeatedly causing panics.
+func (r *Release) IsQuarantined() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, quarantinedConditionType.String())
+}
+
+// GetQueuedTime returns the time the Release was queued or nil if the Release is not queued.
+func (r *Release) GetQueuedTime() *metav1.Time {
+	condition := meta.FindStatusCondition(r.Status.Conditions, queuedConditionType.String())
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return nil
+	}
+
+	return &condition.LastTransitionTime
+}
+
+// GetManagedPipelineWaitStartTime returns the time the Release started waiting for its managed pipeline to start, which
+// is when its tenant pipeline processing succeeded or was skipped, or nil if that hasn't happened yet.
+func (r *Release) GetManagedPipelineWaitStartTime() *metav1.Time {
+	condition := meta.FindStatusCondition(r.Status.Conditions, tenantProcessedConditionType.String())
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return nil
+	}
+
+	return &condition.LastTransitionTime
+}
+
+// GetComponents returns the names of the Snapshot components listed in the components annotation of the Release. If
+// no components are listed, the whole Snapshot is released. An error is returned if any of the names is not a valid
+// component name.
+func (r *Release) GetComponents() ([]string, error) {
+	return r.getNamesFromAnnotation(metadata.ComponentsAnnotation, "component")
+}
+
+// GetFailedComponents returns the names of the components the managed Release Pipeline reported as failed.
+func (r *Release) GetFailedComponents() []string {
+	var components []string
+	for _, component := range r.Status.Components {
+		if component.Phase == ComponentPhaseFailed {
+			components = append(components, component.Name)
+		}
+	}
+
+	return components
+}
+
+// GetSkippedTasks returns the names of the managed Pipeline tasks listed in the skip-tasks annotation of the Release.
+// An error is returned if any of the names is not a valid task name.
+func (r *Release) GetSkippedTasks() ([]string, error) {
+	return r.getNamesFromAnnotation(metadata.SkipTasksAnnotation, "task")
+}
+
+// GetTarget returns the status of the target of the fan-out Release with the given ReleasePlanAdmission namespaced
+// name or nil if the Release wasn't released to it.
+func (r *Release) GetTarget(releasePlanAdmission string) *TargetInfo {
+	for i := range r.Status.Targets {
+		if r.Status.Targets[i].ReleasePlanAdmission == releasePlanAdmission {
+			return &r.Status.Targets[i]
+		}
+	}
+
+	return nil
+}
+
+// GetTargetsOutcome checks whether the targets of the fan-out Release satisfy the given FanOutPolicy, requiring all of
+// them to succeed by default. A message listing the failed targets is also returned.
+func (r *Release) GetTargetsOutcome(policy FanOutPolicy) (bool, string) {
+	var failedTargets []string
+	for _, target := range r.Status.Targets {
+		if target.Phase != TargetPhaseSucceeded {
+			failedTargets = append(failedTargets, fmt.Sprintf("%s (%s)", target.ReleasePlanAdmission, target.Message))
+		}
+	}
+
+	if len(failedTargets) == 0 {
+		return true, ""
+	}
+
+	message := fmt.Sprintf("%d of %d targets failed: %s", len(failedTargets), len(r.Status.Targets),
+		strings.Join(failedTargets, ", "))
+	if policy == FanOutPolicyAny {
+		return len(failedTargets) < len(r.Status.Targets), message
+	}
+
+	return false, message
+}
+
+// HasEveryTargetFinished checks whether the managed Pipelines of all the targets of the fan-out Release finished,
+// regardless of the result.
+func (r *Release) HasEveryTargetFinished() bool {
+	for _, target := range r.Status.Targets {
+		if target.Phase == TargetPhasePending || target.Phase == TargetPhaseProgressing {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsFanOut checks whether the Release is released to multiple targets, each of them with its own managed Pipeline.
+func (r *Release) IsFanOut() bool {
+	return len(r.Status.Targets) > 0
+}
+
+// HasInsufficientCapacity checks whether the Release waits for the managed namespace to have room for the resources
+// its managed pipeline needs.
+func (r *Release) HasInsufficientCapacity() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, queuedConditionType.String())
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason == InsufficientCapacityReason.String()
+}
+
+// IsAwaitingDependencies checks whether the Release waits for the services its managed pipeline depends on to be healthy.
+func (r *Release) IsAwaitingDependencies() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, queuedConditionType.String())
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason == AwaitingDependenciesReason.String()
+}
+
+// IsAwaitingCapacity checks whether the Release waits for capacity to run its managed pipeline.
+func (r *Release) IsAwaitingCapacity() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, queuedConditionType.String())
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason == AwaitingCapacityReason.String()
+}
+
+// IsQueued checks whether the Release is waiting for another Release of the same application to the same target to
+// finish or for capacity in the managed namespace before running its managed Pipeline.
+func (r *Release) IsQueued() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, queuedConditionType.String())
+}
+
+// IsUrgent checks whether the Release has the urgent priority.
+func (r *Release) IsUrgent() bool {
+	return r.Spec.Priority == ReleasePriorityUrgent
+}
+
 // IsReleased checks whether the Release has finished successfully.
 func (r *Release) IsReleased() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, releasedConditionType.String())
 }
 
+// IsSuperseded checks whether the Release was skipped in favor of a newer Release of the same components.
+func (r *Release) IsSuperseded() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, releasedConditionType.String())
+	return condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == SupersededReason.String()
+}
+
 // IsReleasing checks whether the Release is in progress.
 func (r *Release) IsReleasing() bool {
 	return r.isPhaseProgressing(releasedConditionType)
 }
 
+// IsApproved checks whether the Release was approved by a user other than its author.
+func (r *Release) IsApproved() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, approvedConditionType.String())
+}
+
+// IsAwaitingApproval checks whether the Release is waiting to be approved by a user other than its author.
+func (r *Release) IsAwaitingApproval() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, approvedConditionType.String())
+
+	return condition != nil && condition.Status == metav1.ConditionFalse
+}
+
+// IsAwaitingTestResults checks whether the Release is waiting for the integration tests of its Snapshot.
+func (r *Release) IsAwaitingTestResults() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, snapshotTestedConditionType.String())
+
+	return condition != nil && condition.Status == metav1.ConditionFalse &&
+		condition.Reason == AwaitingTestResultsReason.String()
+}
+
+// IsAwaitingReleaseWindow checks whether the Release is waiting for a release window to open.
+func (r *Release) IsAwaitingReleaseWindow() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, inReleaseWindowConditionType.String())
+
+	return condition != nil && condition.Status == metav1.ConditionFalse
+}
+
+// IsInReleaseWindow checks whether the Release started within a release window.
+func (r *Release) IsInReleaseWindow() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, inReleaseWindowConditionType.String())
+}
+
+// IsSnapshotStale checks whether the Release Snapshot was flagged as exceeding the maximum age allowed.
+func (r *Release) IsSnapshotStale() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, snapshotStaleConditionType.String())
+}
+
+// IsSnapshotTested checks whether the integration tests of the Release Snapshot passed.
+func (r *Release) IsSnapshotTested() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, snapshotTestedConditionType.String())
+}
+
+// IsStalled checks whether a Release PipelineRun was detected as stalled.
+func (r *Release) IsStalled() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, stalledConditionType.String())
+}
+
 // IsValid checks whether the Release validation has finished successfully.
 func (r *Release) IsValid() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, validatedConditionType.String())
 }
 
+// IsVerificationFailed checks whether the released artifacts didn't pass their verification.
+func (r *Release) IsVerificationFailed() bool {
+	return r.HasVerificationFinished() && !meta.IsStatusConditionTrue(r.Status.Conditions, verifiedConditionType.String())
+}
+
+// IsVerifying checks whether the released artifacts are being verified.
+func (r *Release) IsVerifying() bool {
+	return r.isPhaseProgressing(verifiedConditionType)
+}
+
+// MarkDeployed marks the released Snapshot as deployed.
+func (r *Release) MarkDeployed() {
+	if !r.IsDeploying() || r.HasDeploymentFinished() {
+		return
+	}
+
+	r.Status.Deployment.CompletionTime = &metav1.Time{Time: time.Now()}
+	conditions.SetCondition(&r.Status.Conditions, deployedConditionType, metav1.ConditionTrue, SucceededReason)
+}
+
+// MarkDeploying marks the released Snapshot as being deployed.
+func (r *Release) MarkDeploying(message string) {
+	if r.HasDeploymentFinished() {
+		return
+	}
+
+	if !r.IsDeploying() {
+		r.Status.Deployment.StartTime = &metav1.Time{Time: time.Now()}
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, deployedConditionType, metav1.ConditionFalse, ProgressingReason, message)
+}
+
+// MarkDeploymentFailed marks the deployment of the released Snapshot as failed.
+func (r *Release) MarkDeploymentFailed(message string) {
+	if !r.IsDeploying() || r.HasDeploymentFinished() {
+		return
+	}
+
+	r.Status.Deployment.CompletionTime = &metav1.Time{Time: time.Now()}
+	conditions.SetConditionWithMessage(&r.Status.Conditions, deployedConditionType, metav1.ConditionFalse, FailedReason, message)
+}
+
 // MarkManagedPipelineProcessed marks the Release Managed Pipeline as processed.
 func (r *Release) MarkManagedPipelineProcessed() {
 	if !r.IsManagedPipelineProcessing() || r.HasManagedPipelineProcessingFinished() {
@@ -254,6 +922,7 @@ func (r *Release) MarkManagedPipelineProcessed() {
 
 	r.Status.ManagedProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
 	conditions.SetCondition(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.ManagedProcessing.StartTime,
@@ -261,6 +930,7 @@ func (r *Release) MarkManagedPipelineProcessed() {
 		SucceededReason.String(),
 		r.Status.Target,
 		metadata.ManagedPipelineType,
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
@@ -272,6 +942,7 @@ func (r *Release) MarkTenantPipelineProcessed() {
 
 	r.Status.TenantProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
 	conditions.SetCondition(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.TenantProcessing.StartTime,
@@ -279,6 +950,7 @@ func (r *Release) MarkTenantPipelineProcessed() {
 		SucceededReason.String(),
 		r.Status.Target,
 		metadata.TenantPipelineType,
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
@@ -293,6 +965,7 @@ func (r *Release) MarkManagedPipelineProcessing() {
 	}
 
 	conditions.SetCondition(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.updateSummary()
 
 	go metrics.RegisterNewReleasePipelineProcessing(
 		r.Status.ManagedProcessing.StartTime,
@@ -314,6 +987,7 @@ func (r *Release) MarkTenantPipelineProcessing() {
 	}
 
 	conditions.SetCondition(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.updateSummary()
 
 	go metrics.RegisterNewReleasePipelineProcessing(
 		r.Status.TenantProcessing.StartTime,
@@ -326,37 +1000,53 @@ func (r *Release) MarkTenantPipelineProcessing() {
 
 // MarkManagedPipelineProcessingFailed marks the Release Managed Pipeline processing as failed.
 func (r *Release) MarkManagedPipelineProcessingFailed(message string) {
+	r.MarkManagedPipelineProcessingFailedWithReason(FailedReason, message)
+}
+
+// MarkManagedPipelineProcessingFailedWithReason marks the Release Managed Pipeline processing as failed using the
+// given reason, which should be one of the reasons defined in the reasons package.
+func (r *Release) MarkManagedPipelineProcessingFailedWithReason(reason conditions.ConditionReason, message string) {
 	if !r.IsManagedPipelineProcessing() || r.HasManagedPipelineProcessingFinished() {
 		return
 	}
 
 	r.Status.ManagedProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
+	conditions.SetConditionWithMessage(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionFalse, reason, message)
+	r.updateSummary()
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.ManagedProcessing.StartTime,
 		r.Status.ManagedProcessing.CompletionTime,
-		FailedReason.String(),
+		reason.String(),
 		r.Status.Target,
 		metadata.ManagedPipelineType,
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
 // MarkTenantPipelineProcessingFailed marks the Release Tenant Pipeline processing as failed.
 func (r *Release) MarkTenantPipelineProcessingFailed(message string) {
+	r.MarkTenantPipelineProcessingFailedWithReason(FailedReason, message)
+}
+
+// MarkTenantPipelineProcessingFailedWithReason marks the Release Tenant Pipeline processing as failed using the
+// given reason, which should be one of the reasons defined in the reasons package.
+func (r *Release) MarkTenantPipelineProcessingFailedWithReason(reason conditions.ConditionReason, message string) {
 	if !r.IsTenantPipelineProcessing() || r.HasTenantPipelineProcessingFinished() {
 		return
 	}
 
 	r.Status.TenantProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
+	conditions.SetConditionWithMessage(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionFalse, reason, message)
+	r.updateSummary()
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.TenantProcessing.StartTime,
 		r.Status.TenantProcessing.CompletionTime,
-		FailedReason.String(),
+		reason.String(),
 		r.Status.Target,
 		metadata.TenantPipelineType,
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
@@ -367,6 +1057,7 @@ func (r *Release) MarkManagedPipelineProcessingSkipped() {
 	}
 
 	conditions.SetCondition(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.updateSummary()
 }
 
 // MarkTenantPipelineProcessingSkipped marks the Release Tenant Pipeline processing as skipped.
@@ -376,6 +1067,7 @@ func (r *Release) MarkTenantPipelineProcessingSkipped() {
 	}
 
 	conditions.SetCondition(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.updateSummary()
 }
 
 // MarkPostActionsExecuted marks the Release post-actions as executed.
@@ -386,11 +1078,13 @@ func (r *Release) MarkPostActionsExecuted() {
 
 	r.Status.PostActionsExecution.CompletionTime = &metav1.Time{Time: time.Now()}
 	conditions.SetCondition(&r.Status.Conditions, postActionsExecutedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
 
 	go metrics.RegisterCompletedReleasePostActionsExecuted(
 		r.Status.PostActionsExecution.StartTime,
 		r.Status.PostActionsExecution.CompletionTime,
 		SucceededReason.String(),
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
@@ -405,6 +1099,7 @@ func (r *Release) MarkPostActionsExecuting(message string) {
 	}
 
 	conditions.SetConditionWithMessage(&r.Status.Conditions, postActionsExecutedConditionType, metav1.ConditionFalse, ProgressingReason, message)
+	r.updateSummary()
 
 	go metrics.RegisterNewReleasePostActionsExecution()
 }
@@ -417,14 +1112,111 @@ func (r *Release) MarkPostActionsExecutionFailed(message string) {
 
 	r.Status.PostActionsExecution.CompletionTime = &metav1.Time{Time: time.Now()}
 	conditions.SetConditionWithMessage(&r.Status.Conditions, postActionsExecutedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.updateSummary()
 
 	go metrics.RegisterCompletedReleasePostActionsExecuted(
 		r.Status.PostActionsExecution.StartTime,
 		r.Status.PostActionsExecution.CompletionTime,
 		FailedReason.String(),
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
+// MarkAwaitingCapacity marks the Release as waiting for capacity to run its managed pipeline in a saturated managed
+// namespace.
+func (r *Release) MarkAwaitingCapacity(message string) {
+	if r.HasReleaseFinished() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, queuedConditionType, metav1.ConditionTrue, AwaitingCapacityReason, message)
+	r.updateSummary()
+}
+
+// MarkAwaitingDependencies marks the Release as waiting for the services its managed pipeline depends on to be healthy.
+func (r *Release) MarkAwaitingDependencies(message string) {
+	if r.HasReleaseFinished() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, queuedConditionType, metav1.ConditionTrue, AwaitingDependenciesReason, message)
+	r.updateSummary()
+}
+
+// MarkDependencyHealthy marks the dependency checked by the dependency health check with the given name as healthy.
+func (r *Release) MarkDependencyHealthy(name string) {
+	conditions.SetCondition(&r.Status.Conditions, getDependencyHealthyConditionType(name), metav1.ConditionTrue, HealthyReason)
+}
+
+// MarkDependencyUnhealthy marks the dependency checked by the dependency health check with the given name as unhealthy.
+func (r *Release) MarkDependencyUnhealthy(name, message string) {
+	conditions.SetConditionWithMessage(&r.Status.Conditions, getDependencyHealthyConditionType(name), metav1.ConditionFalse,
+		UnhealthyReason, message)
+}
+
+// MarkInsufficientCapacity marks the Release as waiting for the managed namespace to have room for the resources its
+// managed pipeline needs.
+func (r *Release) MarkInsufficientCapacity(message string) {
+	if r.HasReleaseFinished() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, queuedConditionType, metav1.ConditionTrue, InsufficientCapacityReason, message)
+	r.updateSummary()
+}
+
+// MarkDequeued marks the Release as no longer waiting for other Releases to run its managed Pipeline.
+func (r *Release) MarkDequeued() {
+	if !r.IsQueued() {
+		return
+	}
+
+	conditions.SetCondition(&r.Status.Conditions, queuedConditionType, metav1.ConditionFalse, SucceededReason)
+	r.updateSummary()
+}
+
+// MarkPreempted marks the Release as queued again after an urgent Release took its place in the queue.
+func (r *Release) MarkPreempted(message string) {
+	if r.HasReleaseFinished() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, queuedConditionType, metav1.ConditionTrue, PreemptedReason, message)
+	r.updateSummary()
+}
+
+// MarkPartiallyReleased marks the Release as partially released, reporting in the message which components failed.
+func (r *Release) MarkPartiallyReleased(message string) {
+	conditions.SetConditionWithMessage(&r.Status.Conditions, partiallyReleasedConditionType, metav1.ConditionTrue, ComponentsFailedReason, message)
+}
+
+// MarkPlanDeleted flags the Release as finished before the given ReleasePlan was deleted, so operations depending on
+// it are skipped. Only finished Releases can be flagged.
+func (r *Release) MarkPlanDeleted(releasePlan string) {
+	if !r.HasReleaseFinished() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, planDeletedConditionType, metav1.ConditionTrue,
+		PlanDeletedReason, fmt.Sprintf("the ReleasePlan %s was deleted", releasePlan))
+}
+
+// MarkQueued marks the Release as waiting for another Release of the same application to the same target to finish.
+func (r *Release) MarkQueued(message string) {
+	if r.HasReleaseFinished() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, queuedConditionType, metav1.ConditionTrue, QueuedReason, message)
+	r.updateSummary()
+}
+
+// MarkQuarantined marks the Release as quarantined, reporting why it's no longer reconciled.
+func (r *Release) MarkQuarantined(message string) {
+	conditions.SetConditionWithMessage(&r.Status.Conditions, quarantinedConditionType, metav1.ConditionTrue, QuarantinedReason, message)
+	r.updateSummary()
+}
+
 // MarkReleased marks the Release as released.
 func (r *Release) MarkReleased() {
 	if !r.IsReleasing() || r.HasReleaseFinished() {
@@ -433,6 +1225,12 @@ func (r *Release) MarkReleased() {
 
 	r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
 	conditions.SetCondition(&r.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
+
+	if r.HasSnapshotReleasableTime() {
+		r.Status.Latency.SnapshotToReleased = snapshotLatency(r.Status.Latency.SnapshotReleasableTime, r.Status.CompletionTime)
+		go metrics.RegisterSnapshotToReleased(r.Status.Latency.SnapshotReleasableTime, r.Status.CompletionTime, r.Status.Target)
+	}
 
 	go metrics.RegisterCompletedRelease(
 		r.Status.StartTime,
@@ -443,6 +1241,7 @@ func (r *Release) MarkReleased() {
 		r.Status.Target,
 		r.getPhaseReason(tenantProcessedConditionType),
 		r.getPhaseReason(validatedConditionType),
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
@@ -457,6 +1256,7 @@ func (r *Release) MarkReleasing(message string) {
 	}
 
 	conditions.SetConditionWithMessage(&r.Status.Conditions, releasedConditionType, metav1.ConditionFalse, ProgressingReason, message)
+	r.updateSummary()
 
 	go metrics.RegisterNewRelease()
 }
@@ -467,8 +1267,10 @@ func (r *Release) MarkReleaseFailed(message string) {
 		return
 	}
 
+	reason := r.getFailureReason()
 	r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, releasedConditionType, metav1.ConditionFalse, FailedReason, message)
+	conditions.SetConditionWithMessage(&r.Status.Conditions, releasedConditionType, metav1.ConditionFalse, reason, message)
+	r.updateSummary()
 
 	go metrics.RegisterCompletedRelease(
 		r.Status.StartTime,
@@ -476,20 +1278,188 @@ func (r *Release) MarkReleaseFailed(message string) {
 		r.getPhaseReason(postActionsExecutedConditionType),
 		r.getPhaseReason(tenantProcessedConditionType),
 		r.getPhaseReason(managedProcessedConditionType),
-		FailedReason.String(),
+		reason.String(),
+		r.Status.Target,
+		r.getPhaseReason(validatedConditionType),
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
+	)
+}
+
+// MarkSuperseded marks the Release as finished without being released as a newer Release releases the same components.
+func (r *Release) MarkSuperseded(message string) {
+	if !r.IsReleasing() || r.HasReleaseFinished() {
+		return
+	}
+
+	r.MarkDequeued()
+	r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	conditions.SetConditionWithMessage(&r.Status.Conditions, releasedConditionType, metav1.ConditionFalse, SupersededReason, message)
+	r.updateSummary()
+
+	go metrics.RegisterCompletedRelease(
+		r.Status.StartTime,
+		r.Status.CompletionTime,
+		r.getPhaseReason(managedProcessedConditionType),
+		r.getPhaseReason(postActionsExecutedConditionType),
+		SupersededReason.String(),
 		r.Status.Target,
+		r.getPhaseReason(tenantProcessedConditionType),
 		r.getPhaseReason(validatedConditionType),
+		r.GetAnnotations()[metadata.TraceParentAnnotation],
 	)
 }
 
+// MarkDeprecatedFieldsUsed marks the Release as setting the given deprecated fields, or as no longer setting any if
+// none is given.
+func (r *Release) MarkDeprecatedFieldsUsed(fields []DeprecatedField) {
+	setDeprecatedFieldsUsedCondition(&r.Status.Conditions, fields)
+}
+
+// MarkApproved marks the Release as approved by the given user.
+func (r *Release) MarkApproved(approver string) {
+	if r.IsApproved() {
+		return
+	}
+
+	r.Status.Attribution.Approver = approver
+	conditions.SetCondition(&r.Status.Conditions, approvedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
+}
+
+// MarkAwaitingApproval marks the Release as waiting to be approved by a user other than its author.
+func (r *Release) MarkAwaitingApproval(message string) {
+	if r.HasReleaseFinished() || r.IsApproved() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, approvedConditionType, metav1.ConditionFalse,
+		AwaitingApprovalReason, message)
+	r.updateSummary()
+}
+
+// MarkAwaitingTestResults marks the Release as waiting for the integration tests of its Snapshot.
+func (r *Release) MarkAwaitingTestResults() {
+	if r.HasReleaseFinished() || r.IsSnapshotTested() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, snapshotTestedConditionType, metav1.ConditionFalse,
+		AwaitingTestResultsReason, "the Snapshot has no integration test results yet")
+	r.updateSummary()
+}
+
+// MarkAwaitingReleaseWindow marks the Release as waiting for a release window that opens at the given time.
+func (r *Release) MarkAwaitingReleaseWindow(scheduledTime time.Time) {
+	if r.HasReleaseFinished() || r.IsInReleaseWindow() {
+		return
+	}
+
+	r.Status.ScheduledTime = &metav1.Time{Time: scheduledTime}
+	conditions.SetConditionWithMessage(&r.Status.Conditions, inReleaseWindowConditionType, metav1.ConditionFalse,
+		AwaitingReleaseWindowReason, fmt.Sprintf("the Release is queued until %s", scheduledTime.UTC().Format(time.RFC3339)))
+	r.updateSummary()
+}
+
+// MarkInReleaseWindow marks the Release as started within a release window.
+func (r *Release) MarkInReleaseWindow() {
+	if r.IsInReleaseWindow() {
+		return
+	}
+
+	r.Status.ScheduledTime = nil
+	conditions.SetCondition(&r.Status.Conditions, inReleaseWindowConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
+}
+
+// MarkReleaseWindowBypassed marks the release window of the Release as bypassed by its emergency bypass.
+func (r *Release) MarkReleaseWindowBypassed(message string) {
+	if r.IsInReleaseWindow() {
+		return
+	}
+
+	r.Status.ScheduledTime = nil
+	conditions.SetConditionWithMessage(&r.Status.Conditions, inReleaseWindowConditionType, metav1.ConditionTrue,
+		EmergencyBypassReason, message)
+	r.updateSummary()
+}
+
+// MarkSnapshotTested marks the integration tests of the Release Snapshot as passed.
+func (r *Release) MarkSnapshotTested() {
+	if r.IsSnapshotTested() {
+		return
+	}
+
+	conditions.SetCondition(&r.Status.Conditions, snapshotTestedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
+}
+
+// MarkSnapshotTestsBypassed marks the integration tests of the Release Snapshot as bypassed by its emergency bypass.
+func (r *Release) MarkSnapshotTestsBypassed(message string) {
+	if r.IsSnapshotTested() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, snapshotTestedConditionType, metav1.ConditionTrue,
+		EmergencyBypassReason, message)
+	r.updateSummary()
+}
+
+// MarkSnapshotTestsFailed marks the integration tests of the Release Snapshot as failed.
+func (r *Release) MarkSnapshotTestsFailed(message string) {
+	if r.IsSnapshotTested() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, snapshotTestedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.updateSummary()
+}
+
+// MarkSnapshotStale flags the Release Snapshot as exceeding the maximum age allowed. This doesn't prevent the Release
+// from being processed.
+func (r *Release) MarkSnapshotStale(message string) {
+	conditions.SetConditionWithMessage(&r.Status.Conditions, snapshotStaleConditionType, metav1.ConditionTrue, StaleSnapshotReason, message)
+}
+
+// MarkStalled marks the Release as stalled, including in the message the diagnostics of the stalled PipelineRun.
+func (r *Release) MarkStalled(message string) {
+	if r.HasReleaseFinished() {
+		return
+	}
+
+	conditions.SetConditionWithMessage(&r.Status.Conditions, stalledConditionType, metav1.ConditionTrue, StalledReason, message)
+	r.updateSummary()
+}
+
+// MarkUnquarantined marks the Release as no longer quarantined once it can be reconciled again.
+func (r *Release) MarkUnquarantined() {
+	if !r.IsQuarantined() {
+		return
+	}
+
+	conditions.SetCondition(&r.Status.Conditions, quarantinedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.updateSummary()
+}
+
+// MarkUnstalled marks the Release as no longer stalled once its PipelineRun progresses again.
+func (r *Release) MarkUnstalled() {
+	if !r.IsStalled() {
+		return
+	}
+
+	conditions.SetCondition(&r.Status.Conditions, stalledConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.updateSummary()
+}
+
 // MarkValidated marks the Release as validated.
 func (r *Release) MarkValidated() {
 	if r.IsValid() {
 		return
 	}
 
+	r.Status.Validation.Causes = nil
 	r.Status.Validation.Time = &metav1.Time{Time: time.Now()}
 	conditions.SetCondition(&r.Status.Conditions, validatedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
 
 	go metrics.RegisterValidatedRelease(
 		r.Status.StartTime,
@@ -501,21 +1471,96 @@ func (r *Release) MarkValidated() {
 
 // MarkValidationFailed marks the Release validation as failed.
 func (r *Release) MarkValidationFailed(message string) {
+	r.MarkValidationFailedWithCauses(ValidationCause{
+		Message: message,
+		Reason:  metav1.CauseType(FailedReason),
+	})
+}
+
+// MarkValidationFailedWithCauses marks the Release validation as failed, registering the given causes in the status
+// and their human-readable description in the condition message.
+func (r *Release) MarkValidationFailedWithCauses(causes ...ValidationCause) {
 	if r.IsValid() {
 		r.Status.Validation.FailedPostValidation = true
 	}
 
+	var messages []string
+	for i := range causes {
+		messages = append(messages, causes[i].String())
+	}
+	message := strings.Join(messages, "; ")
+
+	r.Status.Validation.Causes = causes
+
+	// The condition gets the reason of the first cause matching a known reason, so consumers can tell validation
+	// failures apart without going through the causes
+	reason := FailedReason
+	for i := range causes {
+		if _, found := reasons.Lookup(string(causes[i].Reason)); found {
+			reason = conditions.ConditionReason(causes[i].Reason)
+			break
+		}
+	}
+
 	r.Status.Validation.Time = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, validatedConditionType, metav1.ConditionFalse, FailedReason, message)
+	conditions.SetConditionWithMessage(&r.Status.Conditions, validatedConditionType, metav1.ConditionFalse, reason, message)
+	r.updateSummary()
 
 	go metrics.RegisterValidatedRelease(
 		r.Status.StartTime,
 		r.Status.Validation.Time,
-		FailedReason.String(),
+		reason.String(),
 		r.Status.Target,
 	)
 }
 
+// MarkVerificationFailed marks the verification of the released artifacts as failed.
+func (r *Release) MarkVerificationFailed(message string) {
+	if !r.IsVerifying() || r.HasVerificationFinished() {
+		return
+	}
+
+	r.Status.Verification.CompletionTime = &metav1.Time{Time: time.Now()}
+	conditions.SetConditionWithMessage(&r.Status.Conditions, verifiedConditionType, metav1.ConditionFalse,
+		reasons.VerificationFailed, message)
+	r.updateSummary()
+}
+
+// MarkVerificationSkipped marks the verification of the released artifacts as skipped.
+func (r *Release) MarkVerificationSkipped() {
+	if r.HasVerificationFinished() {
+		return
+	}
+
+	conditions.SetCondition(&r.Status.Conditions, verifiedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.updateSummary()
+}
+
+// MarkVerified marks the released artifacts as verified.
+func (r *Release) MarkVerified() {
+	if !r.IsVerifying() || r.HasVerificationFinished() {
+		return
+	}
+
+	r.Status.Verification.CompletionTime = &metav1.Time{Time: time.Now()}
+	conditions.SetCondition(&r.Status.Conditions, verifiedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.updateSummary()
+}
+
+// MarkVerifying marks the released artifacts as being verified.
+func (r *Release) MarkVerifying() {
+	if r.HasVerificationFinished() {
+		return
+	}
+
+	if !r.IsVerifying() {
+		r.Status.Verification.StartTime = &metav1.Time{Time: time.Now()}
+	}
+
+	conditions.SetCondition(&r.Status.Conditions, verifiedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.updateSummary()
+}
+
 // SetAutomated marks the Release as automated.
 func (r *Release) SetAutomated() {
 	if r.IsAutomated() {
@@ -531,6 +1576,90 @@ func (r *Release) SetExpirationTime(expireDays time.Duration) {
 	r.Status.ExpirationTime = &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
 }
 
+// SetSnapshotReleasableTime records the time when the released Snapshot became releasable and, if the Release
+// already started, the time elapsed until it did. Releases started while waiting for the Snapshot to become
+// releasable are considered to start right away.
+func (r *Release) SetSnapshotReleasableTime(releasableTime time.Time) {
+	if r.HasSnapshotReleasableTime() {
+		return
+	}
+
+	r.Status.Latency.SnapshotReleasableTime = &metav1.Time{Time: releasableTime}
+	if r.Status.StartTime == nil {
+		return
+	}
+
+	r.Status.Latency.SnapshotToReleaseStart = snapshotLatency(r.Status.Latency.SnapshotReleasableTime, r.Status.StartTime)
+	go metrics.RegisterSnapshotToReleaseStart(r.Status.Latency.SnapshotReleasableTime, r.Status.StartTime, r.Status.Target)
+}
+
+// snapshotLatency returns the time elapsed between the given Snapshot releasable time and the given one, which is
+// never negative as Releases can be created before their Snapshots become releasable.
+func snapshotLatency(releasableTime, until *metav1.Time) *metav1.Duration {
+	return &metav1.Duration{Duration: max(0, until.Sub(releasableTime.Time))}
+}
+
+// collectSecretKeyRefs walks the given data value adding every Secret key reference found to the given map. Objects
+// containing only a secretKeyRef field are considered references and are indexed by their path in the data.
+func collectSecretKeyRefs(value interface{}, path string, secretKeyRefs map[string]corev1.SecretKeySelector) error {
+	return walkDataReferences(value, path, secretKeyRefField, func(path string, ref interface{}) error {
+		raw, err := json.Marshal(ref)
+		if err != nil {
+			return err
+		}
+
+		secretKeyRef := corev1.SecretKeySelector{}
+		if err = json.Unmarshal(raw, &secretKeyRef); err != nil {
+			return fmt.Errorf("invalid %s in %s: %w", secretKeyRefField, path, err)
+		}
+		if secretKeyRef.Name == "" || secretKeyRef.Key == "" {
+			return fmt.Errorf("the %s in %s must specify the Secret name and key", secretKeyRefField, path)
+		}
+
+		secretKeyRefs[path] = secretKeyRef
+		return nil
+	})
+}
+
+// walkDataReferences walks the given data value calling the given function for every object containing only the given
+// field, passing the path of the object in the data and the value of the field. As these values end up stored in a
+// Secret under their path, an error is returned if the path is not a valid Secret key.
+func walkDataReferences(value interface{}, path, field string, visit func(path string, ref interface{}) error) error {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		if ref, found := typedValue[field]; found && len(typedValue) == 1 {
+			if errs := validation.IsConfigMapKey(path); len(errs) > 0 {
+				return fmt.Errorf("the %s in %s can't be stored in a Secret: %s", field, path, strings.Join(errs, ", "))
+			}
+
+			return visit(path, ref)
+		}
+
+		for key, nestedValue := range typedValue {
+			if err := walkDataReferences(nestedValue, joinDataPath(path, key), field, visit); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, nestedValue := range typedValue {
+			if err := walkDataReferences(nestedValue, joinDataPath(path, strconv.Itoa(i)), field, visit); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// joinDataPath returns the path of the given key within the data value located at the given path.
+func joinDataPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
 // getPhaseReason returns the current reason for the given ConditionType or empty string if no condition is found.
 func (r *Release) getPhaseReason(conditionType conditions.ConditionType) string {
 	var reason string
@@ -557,6 +1686,50 @@ func (r *Release) hasPhaseFinished(conditionType conditions.ConditionType) bool
 	}
 }
 
+// getFailedPhaseCondition returns the condition of the first failed Release phase or nil if no phase failed.
+func (r *Release) getFailedPhaseCondition() *metav1.Condition {
+	for _, conditionType := range []conditions.ConditionType{validatedConditionType, snapshotTestedConditionType,
+		tenantProcessedConditionType, managedProcessedConditionType, verifiedConditionType,
+		postActionsExecutedConditionType} {
+		condition := meta.FindStatusCondition(r.Status.Conditions, conditionType.String())
+		if condition == nil || condition.Status != metav1.ConditionFalse {
+			continue
+		}
+		if _, found := reasons.Lookup(condition.Reason); found {
+			return condition
+		}
+	}
+
+	return nil
+}
+
+// getFailureMessage returns the message of the first failed Release phase, falling back to the message of the Released
+// condition if no phase failed with a message.
+func (r *Release) getFailureMessage() string {
+	condition := r.getFailedPhaseCondition()
+	if condition != nil && condition.Message != "" {
+		return condition.Message
+	}
+
+	condition = meta.FindStatusCondition(r.Status.Conditions, releasedConditionType.String())
+	if condition != nil && condition.Message != "" {
+		return condition.Message
+	}
+
+	return "Release failed"
+}
+
+// getFailureReason returns the reason of the first failed Release phase, falling back to the generic Failed reason if
+// no phase failed.
+func (r *Release) getFailureReason() conditions.ConditionReason {
+	condition := r.getFailedPhaseCondition()
+	if condition == nil {
+		return FailedReason
+	}
+
+	return conditions.ConditionReason(condition.Reason)
+}
+
 // isPhaseProgressing checks whether a Release phase (e.g. deployment or processing) is progressing.
 func (r *Release) isPhaseProgressing(conditionType conditions.ConditionType) bool {
 	condition := meta.FindStatusCondition(r.Status.Conditions, conditionType.String())
@@ -571,6 +1744,73 @@ func (r *Release) isPhaseProgressing(conditionType conditions.ConditionType) boo
 	}
 }
 
+// getNamesFromAnnotation returns the comma-separated names listed in the given annotation of the Release, removing
+// duplicates. An error mentioning the given kind of the names is returned if any of them is not a valid name.
+func (r *Release) getNamesFromAnnotation(annotation, kind string) ([]string, error) {
+	value := strings.TrimSpace(r.GetAnnotations()[annotation])
+	if value == "" {
+		return nil, nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid %s name '%s' in the %s annotation: %s", kind, name, annotation,
+				strings.Join(errs, ", "))
+		}
+
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// updateSummary updates the Release summary based on its current conditions.
+func (r *Release) updateSummary() {
+	switch {
+	case r.IsReleased():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseSucceeded, Message: "Release succeeded"}
+	case r.IsSuperseded():
+		condition := meta.FindStatusCondition(r.Status.Conditions, releasedConditionType.String())
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseSuperseded, Message: condition.Message}
+	case r.HasReleaseFinished():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseFailed, Message: r.getFailureMessage()}
+	case !r.IsReleasing():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhasePending, Message: "Waiting for the Release to be processed"}
+	case r.IsAwaitingApproval():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhasePending, Message: "Waiting for the Release to be approved by a second reviewer"}
+	case r.IsAwaitingReleaseWindow():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhasePending, Message: fmt.Sprintf("Queued until the release window opens at %s",
+			r.Status.ScheduledTime.UTC().Format(time.RFC3339))}
+	case r.IsAwaitingTestResults():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseProgressing, Message: "Waiting for the Snapshot integration tests to pass"}
+	case r.IsQuarantined():
+		condition := meta.FindStatusCondition(r.Status.Conditions, quarantinedConditionType.String())
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseStalled, Message: condition.Message}
+	case r.IsStalled():
+		condition := meta.FindStatusCondition(r.Status.Conditions, stalledConditionType.String())
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseStalled, Message: condition.Message}
+	case r.IsQueued():
+		condition := meta.FindStatusCondition(r.Status.Conditions, queuedConditionType.String())
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseQueued, Message: condition.Message}
+	case r.IsVerifying():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseProgressing, Message: "Verifying the released artifacts"}
+	case r.IsEachPostActionExecuting():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseProgressing, Message: "Executing the post-actions"}
+	case r.IsManagedPipelineProcessing():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseProgressing, Message: "Running the managed Release Pipeline"}
+	case r.IsTenantPipelineProcessing():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseProgressing, Message: "Running the tenant Release Pipeline"}
+	case r.IsValid():
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseProgressing, Message: "Release validated"}
+	default:
+		r.Status.Summary = ReleaseSummary{Phase: ReleasePhaseProgressing, Message: "Validating the Release"}
+	}
+}
+
 // +kubebuilder:object:root=true
 
 // ReleaseList contains a list of Release
@@ -20,16 +20,153 @@ import (
 	"time"
 
 	"github.com/konflux-ci/operator-toolkit/conditions"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
+	"github.com/konflux-ci/release-service/metadata"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var _ = Describe("Release type", func() {
 
+	When("GetDataSecretKeyRefs method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return an empty map when the Release has no data", func() {
+			secretKeyRefs, err := release.GetDataSecretKeyRefs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretKeyRefs).To(BeEmpty())
+		})
+
+		It("should return the references found in the data indexed by their path", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"mapping": {"token": {"secretKeyRef": {"name": "foo", "key": "bar"}}},
+				"repositories": [{"url": "quay.io/foo", "password": {"secretKeyRef": {"name": "baz", "key": "qux"}}}],
+				"plain": "value"
+			}`)}
+
+			secretKeyRefs, err := release.GetDataSecretKeyRefs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretKeyRefs).To(HaveLen(2))
+			Expect(secretKeyRefs).To(HaveKeyWithValue("mapping.token", corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "foo"},
+				Key:                  "bar",
+			}))
+			Expect(secretKeyRefs).To(HaveKeyWithValue("repositories.0.password", corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "baz"},
+				Key:                  "qux",
+			}))
+		})
+
+		It("should ignore objects containing other fields along with the secretKeyRef", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"token": {"secretKeyRef": {"name": "foo", "key": "bar"}, "other": "value"}
+			}`)}
+
+			secretKeyRefs, err := release.GetDataSecretKeyRefs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretKeyRefs).To(BeEmpty())
+		})
+
+		It("should fail when a reference doesn't specify the Secret key", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": {"secretKeyRef": {"name": "foo"}}}`)}
+
+			_, err := release.GetDataSecretKeyRefs()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must specify the Secret name and key"))
+		})
+
+		It("should fail when the path of a reference is not a valid Secret key", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"my token": {"secretKeyRef": {"name": "foo", "key": "bar"}}}`)}
+
+			_, err := release.GetDataSecretKeyRefs()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't be stored in a Secret"))
+		})
+	})
+
+	When("GetDataEncryptedValues method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return an empty map when the Release has no data", func() {
+			encryptedValues, err := release.GetDataEncryptedValues()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encryptedValues).To(BeEmpty())
+		})
+
+		It("should return the encrypted values found in the data indexed by their path", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"mapping": {"token": {"encrypted": "envelope:v1:foo"}},
+				"repositories": [{"url": "quay.io/foo", "password": {"encrypted": "envelope:v1:bar"}}],
+				"other": {"encrypted": "envelope:v1:baz", "other": "value"},
+				"plain": "value"
+			}`)}
+
+			encryptedValues, err := release.GetDataEncryptedValues()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encryptedValues).To(Equal(map[string]string{
+				"mapping.token":           "envelope:v1:foo",
+				"repositories.0.password": "envelope:v1:bar",
+			}))
+		})
+
+		It("should fail when an encrypted value is not a string", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": {"encrypted": {"value": "foo"}}}`)}
+
+			_, err := release.GetDataEncryptedValues()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be a non-empty string"))
+		})
+
+		It("should fail when the path of an encrypted value is not a valid Secret key", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"my token": {"encrypted": "envelope:v1:foo"}}`)}
+
+			_, err := release.GetDataEncryptedValues()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't be stored in a Secret"))
+		})
+	})
+
+	When("HasDeploymentFinished method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return false when the deployed condition is missing", func() {
+			Expect(release.HasDeploymentFinished()).To(BeFalse())
+		})
+
+		It("should return true when the deployed condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, deployedConditionType, metav1.ConditionTrue, SucceededReason)
+			Expect(release.HasDeploymentFinished()).To(BeTrue())
+		})
+
+		It("should return false when the deployed condition status is False and the reason is Progressing", func() {
+			conditions.SetCondition(&release.Status.Conditions, deployedConditionType, metav1.ConditionFalse, ProgressingReason)
+			Expect(release.HasDeploymentFinished()).To(BeFalse())
+		})
+
+		It("should return true when the deployed condition status is False and the reason is not Progressing", func() {
+			conditions.SetCondition(&release.Status.Conditions, deployedConditionType, metav1.ConditionFalse, FailedReason)
+			Expect(release.HasDeploymentFinished()).To(BeTrue())
+		})
+	})
+
 	When("HasEveryPostActionExecutionFinished method is called", func() {
 		var release *Release
 
@@ -189,6 +326,50 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("IsDeployed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the deployed condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, deployedConditionType, metav1.ConditionTrue, SucceededReason)
+			Expect(release.IsDeployed()).To(BeTrue())
+		})
+
+		It("should return false when the deployed condition status is False", func() {
+			conditions.SetCondition(&release.Status.Conditions, deployedConditionType, metav1.ConditionFalse, FailedReason)
+			Expect(release.IsDeployed()).To(BeFalse())
+		})
+
+		It("should return false when the deployed condition is missing", func() {
+			Expect(release.IsDeployed()).To(BeFalse())
+		})
+	})
+
+	When("IsDeploying method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return false when the deployed condition is missing", func() {
+			Expect(release.IsDeploying()).To(BeFalse())
+		})
+
+		It("should return true when the deployed condition status is False and the reason is Progressing", func() {
+			conditions.SetCondition(&release.Status.Conditions, deployedConditionType, metav1.ConditionFalse, ProgressingReason)
+			Expect(release.IsDeploying()).To(BeTrue())
+		})
+
+		It("should return false when the deployed condition status is False and the reason is not Progressing", func() {
+			conditions.SetCondition(&release.Status.Conditions, deployedConditionType, metav1.ConditionFalse, FailedReason)
+			Expect(release.IsDeploying()).To(BeFalse())
+		})
+	})
+
 	When("IsEveryPostActionExecuted method is called", func() {
 		var release *Release
 
@@ -248,6 +429,64 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("IsEmergencyBypassActive method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						metadata.EmergencyBypassAnnotation:           "outage",
+						metadata.EmergencyBypassUserAnnotation:       "admin",
+						metadata.EmergencyBypassExpirationAnnotation: time.Now().Add(time.Hour).Format(time.RFC3339),
+					},
+				},
+			}
+		})
+
+		It("should return true when the emergency bypass didn't expire", func() {
+			Expect(release.IsEmergencyBypassActive()).To(BeTrue())
+		})
+
+		It("should return false when the emergency bypass expired", func() {
+			release.Annotations[metadata.EmergencyBypassExpirationAnnotation] = time.Now().Add(-time.Hour).Format(time.RFC3339)
+			Expect(release.IsEmergencyBypassActive()).To(BeFalse())
+		})
+
+		It("should return false when the emergency bypass wasn't authorized by the webhook", func() {
+			delete(release.Annotations, metadata.EmergencyBypassUserAnnotation)
+			Expect(release.IsEmergencyBypassActive()).To(BeFalse())
+		})
+	})
+
+	When("IsFailed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the released condition status is False and the reason is Failed", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, FailedReason)
+			Expect(release.IsFailed()).To(BeTrue())
+		})
+
+		It("should return false when the released condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
+			Expect(release.IsFailed()).To(BeFalse())
+		})
+
+		It("should return false when the Release was superseded", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, SupersededReason)
+			Expect(release.IsFailed()).To(BeFalse())
+		})
+
+		It("should return false when the Release is in progress", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, ProgressingReason)
+			Expect(release.IsFailed()).To(BeFalse())
+		})
+	})
+
 	When("IsManagedPipelineProcessed method is called", func() {
 		var release *Release
 
@@ -366,671 +605,1781 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
-	When("IsReleased method is called", func() {
+	When("GetComponents method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should return true when the released condition status is True", func() {
-			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
-			Expect(release.IsReleased()).To(BeTrue())
+		It("should return nil if the Release has no components annotation", func() {
+			Expect(release.GetComponents()).To(BeNil())
 		})
 
-		It("should return false when the released condition status is False", func() {
-			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, SucceededReason)
-			Expect(release.IsReleased()).To(BeFalse())
+		It("should return the components listed in the annotation without duplicates", func() {
+			release.Annotations = map[string]string{metadata.ComponentsAnnotation: " foo, bar,foo "}
+			Expect(release.GetComponents()).To(Equal([]string{"foo", "bar"}))
 		})
 
-		It("should return false when the released condition status is Unknown", func() {
-			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionUnknown, SucceededReason)
-			Expect(release.IsReleased()).To(BeFalse())
+		It("should fail if a component name is not valid", func() {
+			release.Annotations = map[string]string{metadata.ComponentsAnnotation: "foo,Bar"}
+			_, err := release.GetComponents()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid component name"))
 		})
+	})
 
-		It("should return false when the released condition is missing", func() {
-			Expect(release.IsReleased()).To(BeFalse())
+	When("GetFailedComponents method is called", func() {
+		It("should return nil if no component failed", func() {
+			release := &Release{
+				Status: ReleaseStatus{
+					Components: []ComponentStatus{{Name: "foo", Phase: ComponentPhaseSucceeded}},
+				},
+			}
+			Expect(release.GetFailedComponents()).To(BeNil())
+		})
+
+		It("should return the names of the failed components", func() {
+			release := &Release{
+				Status: ReleaseStatus{
+					Components: []ComponentStatus{
+						{Name: "foo", Phase: ComponentPhaseFailed},
+						{Name: "bar", Phase: ComponentPhaseSucceeded},
+						{Name: "baz", Phase: ComponentPhaseFailed},
+					},
+				},
+			}
+			Expect(release.GetFailedComponents()).To(Equal([]string{"foo", "baz"}))
 		})
 	})
 
-	When("IsReleasing method is called", func() {
+	When("GetTarget method is called", func() {
+		It("should return the target with the given ReleasePlanAdmission", func() {
+			release := &Release{
+				Status: ReleaseStatus{
+					Targets: []TargetInfo{{ReleasePlanAdmission: "ns/foo", Phase: TargetPhaseProgressing}},
+				},
+			}
+			Expect(release.GetTarget("ns/foo")).To(Equal(&release.Status.Targets[0]))
+		})
+
+		It("should return nil if the Release wasn't released to the ReleasePlanAdmission", func() {
+			release := &Release{}
+			Expect(release.GetTarget("ns/foo")).To(BeNil())
+		})
+	})
+
+	When("GetTargetsOutcome method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
-			release = &Release{}
+			release = &Release{
+				Status: ReleaseStatus{
+					Targets: []TargetInfo{
+						{ReleasePlanAdmission: "ns/foo", Phase: TargetPhaseSucceeded},
+						{ReleasePlanAdmission: "ns/bar", Phase: TargetPhaseFailed, Message: "error"},
+					},
+				},
+			}
 		})
 
-		It("should return false when the released condition is missing", func() {
-			Expect(release.IsReleasing()).To(BeFalse())
+		It("should succeed if all the targets succeeded", func() {
+			release.Status.Targets[1].Phase = TargetPhaseSucceeded
+			succeeded, message := release.GetTargetsOutcome(FanOutPolicyAll)
+			Expect(succeeded).To(BeTrue())
+			Expect(message).To(BeEmpty())
 		})
 
-		It("should return false when the released condition status is True", func() {
-			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
-			Expect(release.IsReleasing()).To(BeFalse())
+		It("should fail if a target failed and all of them must succeed", func() {
+			succeeded, message := release.GetTargetsOutcome(FanOutPolicyAll)
+			Expect(succeeded).To(BeFalse())
+			Expect(message).To(Equal("1 of 2 targets failed: ns/bar (error)"))
 		})
 
-		It("should return true when the released condition status is False and the reason is Progressing", func() {
-			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, ProgressingReason)
-			Expect(release.IsReleasing()).To(BeTrue())
+		It("should require all the targets to succeed if no policy is given", func() {
+			succeeded, _ := release.GetTargetsOutcome("")
+			Expect(succeeded).To(BeFalse())
 		})
 
-		It("should return false when the released condition status is False and the reason is not Progressing", func() {
-			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, FailedReason)
-			Expect(release.IsReleasing()).To(BeFalse())
+		It("should succeed if a target succeeded and any of them must succeed", func() {
+			succeeded, message := release.GetTargetsOutcome(FanOutPolicyAny)
+			Expect(succeeded).To(BeTrue())
+			Expect(message).To(Equal("1 of 2 targets failed: ns/bar (error)"))
 		})
 
-		It("should return false when the released condition status is Unknown", func() {
-			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionUnknown, ProgressingReason)
-			Expect(release.IsReleasing()).To(BeFalse())
+		It("should fail if all the targets failed and any of them must succeed", func() {
+			release.Status.Targets[0].Phase = TargetPhaseFailed
+			succeeded, _ := release.GetTargetsOutcome(FanOutPolicyAny)
+			Expect(succeeded).To(BeFalse())
 		})
 	})
 
-	When("IsValid method is called", func() {
+	When("HasEveryTargetFinished method is called", func() {
+		It("should return false if a target is progressing", func() {
+			release := &Release{
+				Status: ReleaseStatus{
+					Targets: []TargetInfo{
+						{ReleasePlanAdmission: "ns/foo", Phase: TargetPhaseSucceeded},
+						{ReleasePlanAdmission: "ns/bar", Phase: TargetPhaseProgressing},
+					},
+				},
+			}
+			Expect(release.HasEveryTargetFinished()).To(BeFalse())
+		})
+
+		It("should return false if a target is pending", func() {
+			release := &Release{
+				Status: ReleaseStatus{
+					Targets: []TargetInfo{
+						{ReleasePlanAdmission: "ns/foo", Phase: TargetPhaseSucceeded},
+						{ReleasePlanAdmission: "ns/bar", Phase: TargetPhasePending},
+					},
+				},
+			}
+			Expect(release.HasEveryTargetFinished()).To(BeFalse())
+		})
+
+		It("should return true if no target is progressing", func() {
+			release := &Release{
+				Status: ReleaseStatus{
+					Targets: []TargetInfo{
+						{ReleasePlanAdmission: "ns/foo", Phase: TargetPhaseSucceeded},
+						{ReleasePlanAdmission: "ns/bar", Phase: TargetPhaseFailed},
+					},
+				},
+			}
+			Expect(release.HasEveryTargetFinished()).To(BeTrue())
+		})
+	})
+
+	When("IsFanOut method is called", func() {
+		It("should return true only if the Release has targets", func() {
+			release := &Release{}
+			Expect(release.IsFanOut()).To(BeFalse())
+			release.Status.Targets = []TargetInfo{{ReleasePlanAdmission: "ns/foo", Phase: TargetPhaseProgressing}}
+			Expect(release.IsFanOut()).To(BeTrue())
+		})
+	})
+
+	When("GetSkippedTasks method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should return true when the validated condition status is True", func() {
-			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionTrue, SucceededReason)
-			Expect(release.IsValid()).To(BeTrue())
-		})
-
-		It("should return false when the validated condition status is False", func() {
-			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionFalse, SucceededReason)
-			Expect(release.IsValid()).To(BeFalse())
+		It("should return nil if the Release has no skip-tasks annotation", func() {
+			Expect(release.GetSkippedTasks()).To(BeNil())
 		})
 
-		It("should return false when the validated condition status is Unknown", func() {
-			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionUnknown, SucceededReason)
-			Expect(release.IsValid()).To(BeFalse())
+		It("should return the tasks listed in the annotation without duplicates", func() {
+			release.Annotations = map[string]string{metadata.SkipTasksAnnotation: " verify, sign,verify "}
+			Expect(release.GetSkippedTasks()).To(Equal([]string{"verify", "sign"}))
 		})
 
-		It("should return false when the validated condition is missing", func() {
-			Expect(release.IsValid()).To(BeFalse())
+		It("should fail if a task name is not valid", func() {
+			release.Annotations = map[string]string{metadata.SkipTasksAnnotation: "verify,,sign"}
+			_, err := release.GetSkippedTasks()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid task name"))
 		})
 	})
 
-	When("MarkManagedPipelineProcessed method is called", func() {
+	When("HasInsufficientCapacity method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release managed pipeline processing has not started", func() {
-			release.MarkManagedPipelineProcessed()
-			Expect(release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		It("should return true when the Release is queued waiting for room in the managed namespace", func() {
+			release.MarkInsufficientCapacity("")
+			Expect(release.HasInsufficientCapacity()).To(BeTrue())
 		})
 
-		It("should do nothing if the Release managed pipeline processing finished", func() {
-			release.MarkManagedPipelineProcessing()
-			release.MarkManagedPipelineProcessed()
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.ManagedProcessing.CompletionTime = &metav1.Time{}
-			release.MarkManagedPipelineProcessed()
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
+		It("should return false when the Release is queued for another reason", func() {
+			release.MarkAwaitingCapacity("")
+			Expect(release.HasInsufficientCapacity()).To(BeFalse())
 		})
 
-		It("should register the completion time", func() {
-			release.MarkManagedPipelineProcessing()
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkManagedPipelineProcessed()
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
+		It("should return false when the queued condition is missing", func() {
+			Expect(release.HasInsufficientCapacity()).To(BeFalse())
 		})
+	})
 
-		It("should register the condition", func() {
-			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkManagedPipelineProcessing()
-			release.MarkManagedPipelineProcessed()
+	When("IsAwaitingDependencies method is called", func() {
+		var release *Release
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
-			Expect(condition).NotTo(BeNil())
-			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(SucceededReason.String()),
-				"Status": Equal(metav1.ConditionTrue),
-			}))
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the Release is queued waiting for its dependencies", func() {
+			release.MarkAwaitingDependencies("")
+			Expect(release.IsAwaitingDependencies()).To(BeTrue())
+		})
+
+		It("should return false when the Release is queued for another reason", func() {
+			release.MarkAwaitingCapacity("")
+			Expect(release.IsAwaitingDependencies()).To(BeFalse())
 		})
 	})
 
-	When("MarkTenantPipelineProcessed method is called", func() {
+	When("IsDependencyHealthy method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release tenant pipeline processing has not started", func() {
-			release.MarkTenantPipelineProcessed()
-			Expect(release.Status.TenantProcessing.CompletionTime).To(BeNil())
+		It("should return true when the dependency was marked as healthy", func() {
+			release.MarkDependencyHealthy("Pyxis")
+			Expect(release.IsDependencyHealthy("Pyxis")).To(BeTrue())
+			Expect(release.IsDependencyHealthy("Registry")).To(BeFalse())
 		})
 
-		It("should do nothing if the Release tenant pipeline processing finished", func() {
-			release.MarkTenantPipelineProcessing()
-			release.MarkTenantPipelineProcessed()
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.TenantProcessing.CompletionTime = &metav1.Time{}
-			release.MarkTenantPipelineProcessed()
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
+		It("should return false when the dependency was marked as unhealthy", func() {
+			release.MarkDependencyHealthy("Pyxis")
+			release.MarkDependencyUnhealthy("Pyxis", "")
+			Expect(release.IsDependencyHealthy("Pyxis")).To(BeFalse())
 		})
+	})
 
-		It("should register the completion time", func() {
-			release.MarkTenantPipelineProcessing()
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkTenantPipelineProcessed()
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
+	When("IsAwaitingCapacity method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
 		})
 
-		It("should register the condition", func() {
-			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkTenantPipelineProcessing()
-			release.MarkTenantPipelineProcessed()
+		It("should return true when the Release is queued waiting for capacity", func() {
+			release.MarkAwaitingCapacity("")
+			Expect(release.IsAwaitingCapacity()).To(BeTrue())
+		})
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
-			Expect(condition).NotTo(BeNil())
-			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(SucceededReason.String()),
-				"Status": Equal(metav1.ConditionTrue),
-			}))
+		It("should return false when the Release is queued for another reason", func() {
+			release.MarkQueued("")
+			Expect(release.IsAwaitingCapacity()).To(BeFalse())
+		})
+
+		It("should return false when the queued condition is missing", func() {
+			Expect(release.IsAwaitingCapacity()).To(BeFalse())
 		})
 	})
 
-	When("MarkManagedPipelineProcessing method is called", func() {
+	When("GetQueuedTime method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release managed pipeline processing finished", func() {
-			release.MarkManagedPipelineProcessing()
-			release.MarkManagedPipelineProcessed()
-			Expect(release.IsManagedPipelineProcessing()).To(BeFalse())
-			release.MarkManagedPipelineProcessing()
-			Expect(release.IsManagedPipelineProcessing()).To(BeFalse())
-		})
+		It("should return the time the Release was queued", func() {
+			release.MarkQueued("")
+			queuedTime := release.GetQueuedTime()
+			Expect(queuedTime).NotTo(BeNil())
 
-		It("should register the start time if the managed pipeline is not processing", func() {
-			Expect(release.Status.ManagedProcessing.StartTime).To(BeNil())
-			release.MarkManagedPipelineProcessing()
-			Expect(release.Status.ManagedProcessing.StartTime).NotTo(BeNil())
+			release.MarkAwaitingCapacity("")
+			Expect(release.GetQueuedTime()).To(Equal(queuedTime))
 		})
 
-		It("should not register the start time if the managed pipeline is processing already", func() {
-			Expect(release.Status.ManagedProcessing.StartTime).To(BeNil())
-			release.MarkManagedPipelineProcessing()
-			release.Status.ManagedProcessing.StartTime = &metav1.Time{}
-			Expect(release.Status.ManagedProcessing.StartTime.IsZero()).To(BeTrue())
-			release.MarkManagedPipelineProcessing()
-			Expect(release.Status.ManagedProcessing.StartTime.IsZero()).To(BeTrue())
+		It("should return nil when the Release is not queued", func() {
+			Expect(release.GetQueuedTime()).To(BeNil())
+			release.MarkQueued("")
+			release.MarkDequeued()
+			Expect(release.GetQueuedTime()).To(BeNil())
+		})
+	})
+
+	When("GetManagedPipelineWaitStartTime method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return the time the tenant pipeline processing succeeded", func() {
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessed()
+			Expect(release.GetManagedPipelineWaitStartTime()).NotTo(BeNil())
+		})
+
+		It("should return the time the tenant pipeline processing was skipped", func() {
+			release.MarkTenantPipelineProcessingSkipped()
+			Expect(release.GetManagedPipelineWaitStartTime()).NotTo(BeNil())
+		})
+
+		It("should return nil when the tenant pipeline processing is not finished", func() {
+			Expect(release.GetManagedPipelineWaitStartTime()).To(BeNil())
+			release.MarkTenantPipelineProcessing()
+			Expect(release.GetManagedPipelineWaitStartTime()).To(BeNil())
+		})
+	})
+
+	When("IsQueued method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the queued condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, queuedConditionType, metav1.ConditionTrue, QueuedReason)
+			Expect(release.IsQueued()).To(BeTrue())
+		})
+
+		It("should return false when the queued condition status is False", func() {
+			conditions.SetCondition(&release.Status.Conditions, queuedConditionType, metav1.ConditionFalse, SucceededReason)
+			Expect(release.IsQueued()).To(BeFalse())
+		})
+
+		It("should return false when the queued condition is missing", func() {
+			Expect(release.IsQueued()).To(BeFalse())
+		})
+	})
+
+	When("IsPartiallyReleased method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the partially released condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, partiallyReleasedConditionType, metav1.ConditionTrue, ComponentsFailedReason)
+			Expect(release.IsPartiallyReleased()).To(BeTrue())
+		})
+
+		It("should return false when the partially released condition is missing", func() {
+			Expect(release.IsPartiallyReleased()).To(BeFalse())
+		})
+	})
+
+	When("IsPlanDeleted method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the plan deleted condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, planDeletedConditionType, metav1.ConditionTrue, PlanDeletedReason)
+			Expect(release.IsPlanDeleted()).To(BeTrue())
+		})
+
+		It("should return false when the plan deleted condition is missing", func() {
+			Expect(release.IsPlanDeleted()).To(BeFalse())
+		})
+	})
+
+	When("IsQuarantined method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the quarantined condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, quarantinedConditionType, metav1.ConditionTrue, QuarantinedReason)
+			Expect(release.IsQuarantined()).To(BeTrue())
+		})
+
+		It("should return false when the quarantined condition is missing", func() {
+			Expect(release.IsQuarantined()).To(BeFalse())
+		})
+	})
+
+	When("IsReleased method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the released condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
+			Expect(release.IsReleased()).To(BeTrue())
+		})
+
+		It("should return false when the released condition status is False", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, SucceededReason)
+			Expect(release.IsReleased()).To(BeFalse())
+		})
+
+		It("should return false when the released condition status is Unknown", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionUnknown, SucceededReason)
+			Expect(release.IsReleased()).To(BeFalse())
+		})
+
+		It("should return false when the released condition is missing", func() {
+			Expect(release.IsReleased()).To(BeFalse())
+		})
+	})
+
+	When("IsReleasing method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return false when the released condition is missing", func() {
+			Expect(release.IsReleasing()).To(BeFalse())
+		})
+
+		It("should return false when the released condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
+			Expect(release.IsReleasing()).To(BeFalse())
+		})
+
+		It("should return true when the released condition status is False and the reason is Progressing", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, ProgressingReason)
+			Expect(release.IsReleasing()).To(BeTrue())
+		})
+
+		It("should return false when the released condition status is False and the reason is not Progressing", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, FailedReason)
+			Expect(release.IsReleasing()).To(BeFalse())
+		})
+
+		It("should return false when the released condition status is Unknown", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionUnknown, ProgressingReason)
+			Expect(release.IsReleasing()).To(BeFalse())
+		})
+	})
+
+	When("IsSnapshotStale method is called", func() {
+		It("should return true when the snapshot stale condition status is True", func() {
+			release := &Release{}
+			conditions.SetCondition(&release.Status.Conditions, snapshotStaleConditionType, metav1.ConditionTrue, StaleSnapshotReason)
+			Expect(release.IsSnapshotStale()).To(BeTrue())
+		})
+
+		It("should return false when the snapshot stale condition is missing", func() {
+			release := &Release{}
+			Expect(release.IsSnapshotStale()).To(BeFalse())
+		})
+	})
+
+	When("IsStalled method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the stalled condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, stalledConditionType, metav1.ConditionTrue, StalledReason)
+			Expect(release.IsStalled()).To(BeTrue())
+		})
+
+		It("should return false when the stalled condition status is False", func() {
+			conditions.SetCondition(&release.Status.Conditions, stalledConditionType, metav1.ConditionFalse, StalledReason)
+			Expect(release.IsStalled()).To(BeFalse())
+		})
+
+		It("should return false when the stalled condition is missing", func() {
+			Expect(release.IsStalled()).To(BeFalse())
+		})
+	})
+
+	When("IsUrgent method is called", func() {
+		It("should return true when the Release priority is urgent", func() {
+			release := &Release{Spec: ReleaseSpec{Priority: ReleasePriorityUrgent}}
+			Expect(release.IsUrgent()).To(BeTrue())
+		})
+
+		It("should return false when the Release priority is normal", func() {
+			release := &Release{Spec: ReleaseSpec{Priority: ReleasePriorityNormal}}
+			Expect(release.IsUrgent()).To(BeFalse())
+		})
+	})
+
+	When("IsValid method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the validated condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionTrue, SucceededReason)
+			Expect(release.IsValid()).To(BeTrue())
+		})
+
+		It("should return false when the validated condition status is False", func() {
+			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionFalse, SucceededReason)
+			Expect(release.IsValid()).To(BeFalse())
+		})
+
+		It("should return false when the validated condition status is Unknown", func() {
+			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionUnknown, SucceededReason)
+			Expect(release.IsValid()).To(BeFalse())
+		})
+
+		It("should return false when the validated condition is missing", func() {
+			Expect(release.IsValid()).To(BeFalse())
+		})
+	})
+
+	When("MarkDeployed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the deployment has not started", func() {
+			release.MarkDeployed()
+			Expect(release.Status.Deployment.CompletionTime).To(BeNil())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkDeploying("")
+			Expect(release.Status.Deployment.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkDeployed()
+			Expect(release.Status.Deployment.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			release.MarkDeploying("")
+			release.MarkDeployed()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, deployedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(SucceededReason.String()),
+				"Status": Equal(metav1.ConditionTrue),
+			}))
+		})
+	})
+
+	When("MarkDeploying method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the deployment finished", func() {
+			release.MarkDeploying("")
+			release.MarkDeployed()
+			release.MarkDeploying("")
+			Expect(release.IsDeploying()).To(BeFalse())
+		})
+
+		It("should register the start time only once", func() {
+			release.MarkDeploying("")
+			Expect(release.Status.Deployment.StartTime).NotTo(BeNil())
+			release.Status.Deployment.StartTime = &metav1.Time{}
+			release.MarkDeploying("foo")
+			Expect(release.Status.Deployment.StartTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the condition", func() {
+			release.MarkDeploying("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, deployedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(ProgressingReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkDeploymentFailed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the deployment has not started", func() {
+			release.MarkDeploymentFailed("")
+			Expect(release.Status.Deployment.CompletionTime).To(BeNil())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkDeploying("")
+			release.MarkDeploymentFailed("")
+			Expect(release.Status.Deployment.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			release.MarkDeploying("")
+			release.MarkDeploymentFailed("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, deployedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkManagedPipelineProcessed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release managed pipeline processing has not started", func() {
+			release.MarkManagedPipelineProcessed()
+			Expect(release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("should do nothing if the Release managed pipeline processing finished", func() {
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessed()
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.ManagedProcessing.CompletionTime = &metav1.Time{}
+			release.MarkManagedPipelineProcessed()
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkManagedPipelineProcessing()
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkManagedPipelineProcessed()
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessed()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(SucceededReason.String()),
+				"Status": Equal(metav1.ConditionTrue),
+			}))
+		})
+	})
+
+	When("MarkTenantPipelineProcessed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release tenant pipeline processing has not started", func() {
+			release.MarkTenantPipelineProcessed()
+			Expect(release.Status.TenantProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("should do nothing if the Release tenant pipeline processing finished", func() {
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessed()
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.TenantProcessing.CompletionTime = &metav1.Time{}
+			release.MarkTenantPipelineProcessed()
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkTenantPipelineProcessing()
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkTenantPipelineProcessed()
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessed()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(SucceededReason.String()),
+				"Status": Equal(metav1.ConditionTrue),
+			}))
+		})
+	})
+
+	When("MarkManagedPipelineProcessing method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release managed pipeline processing finished", func() {
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessed()
+			Expect(release.IsManagedPipelineProcessing()).To(BeFalse())
+			release.MarkManagedPipelineProcessing()
+			Expect(release.IsManagedPipelineProcessing()).To(BeFalse())
+		})
+
+		It("should register the start time if the managed pipeline is not processing", func() {
+			Expect(release.Status.ManagedProcessing.StartTime).To(BeNil())
+			release.MarkManagedPipelineProcessing()
+			Expect(release.Status.ManagedProcessing.StartTime).NotTo(BeNil())
+		})
+
+		It("should not register the start time if the managed pipeline is processing already", func() {
+			Expect(release.Status.ManagedProcessing.StartTime).To(BeNil())
+			release.MarkManagedPipelineProcessing()
+			release.Status.ManagedProcessing.StartTime = &metav1.Time{}
+			Expect(release.Status.ManagedProcessing.StartTime.IsZero()).To(BeTrue())
+			release.MarkManagedPipelineProcessing()
+			Expect(release.Status.ManagedProcessing.StartTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkManagedPipelineProcessing()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(ProgressingReason.String()),
+				"Status": Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkTenantPipelineProcessing method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release tenant pipeline processing finished", func() {
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessed()
+			Expect(release.IsTenantPipelineProcessing()).To(BeFalse())
+			release.MarkTenantPipelineProcessing()
+			Expect(release.IsTenantPipelineProcessing()).To(BeFalse())
+		})
+
+		It("should register the start time if the tenant pipeline is not processing", func() {
+			Expect(release.Status.TenantProcessing.StartTime).To(BeNil())
+			release.MarkTenantPipelineProcessing()
+			Expect(release.Status.TenantProcessing.StartTime).NotTo(BeNil())
+		})
+
+		It("should not register the start time if the tenant pipeline is processing already", func() {
+			Expect(release.Status.TenantProcessing.StartTime).To(BeNil())
+			release.MarkTenantPipelineProcessing()
+			release.Status.TenantProcessing.StartTime = &metav1.Time{}
+			Expect(release.Status.TenantProcessing.StartTime.IsZero()).To(BeTrue())
+			release.MarkTenantPipelineProcessing()
+			Expect(release.Status.TenantProcessing.StartTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkTenantPipelineProcessing()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(ProgressingReason.String()),
+				"Status": Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkManagedPipelineProcessingFailed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release managed pipeline processing has not started", func() {
+			release.MarkManagedPipelineProcessingFailed("")
+			Expect(release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("should do nothing if the Release managed pipeline processing finished", func() {
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessed()
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.ManagedProcessing.CompletionTime = &metav1.Time{}
+			release.MarkManagedPipelineProcessingFailed("")
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkManagedPipelineProcessing()
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkManagedPipelineProcessingFailed("")
+			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailed("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkManagedPipelineProcessingFailedWithReason method is called", func() {
+		It("should register the condition with the given reason", func() {
+			release := &Release{}
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailedWithReason(reasons.Timeout, "foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(reasons.Timeout.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkTenantPipelineProcessingFailed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release tenant pipeline processing has not started", func() {
+			release.MarkTenantPipelineProcessingFailed("")
+			Expect(release.Status.TenantProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("should do nothing if the Release tenant pipeline processing finished", func() {
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessed()
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.TenantProcessing.CompletionTime = &metav1.Time{}
+			release.MarkTenantPipelineProcessingFailed("")
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkTenantPipelineProcessing()
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkTenantPipelineProcessingFailed("")
+			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessingFailed("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkTenantPipelineProcessingFailedWithReason method is called", func() {
+		It("should register the condition with the given reason", func() {
+			release := &Release{}
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessingFailedWithReason(reasons.QuotaExceeded, "foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(reasons.QuotaExceeded.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkManagedPipelineProcessingSkipped method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release managed pipeline processing finished already", func() {
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailed("error")
+			release.MarkManagedPipelineProcessingSkipped()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("error"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkManagedPipelineProcessingSkipped()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(SkippedReason.String()),
+				"Status": Equal(metav1.ConditionTrue),
+			}))
+		})
+	})
+
+	When("MarkTenantPipelineProcessingSkipped method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release tenant pipeline processing finished already", func() {
+			release.MarkTenantPipelineProcessing()
+			release.MarkTenantPipelineProcessingFailed("error")
+			release.MarkTenantPipelineProcessingSkipped()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("error"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
 		})
 
 		It("should register the condition", func() {
 			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkManagedPipelineProcessing()
+			release.MarkTenantPipelineProcessingSkipped()
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(ProgressingReason.String()),
+				"Reason": Equal(SkippedReason.String()),
+				"Status": Equal(metav1.ConditionTrue),
+			}))
+		})
+	})
+
+	When("MarkPostActionsExecuted method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release post-actions execution has not started", func() {
+			release.MarkPostActionsExecuted()
+			Expect(release.Status.PostActionsExecution.CompletionTime).To(BeNil())
+		})
+
+		It("should do nothing if the Release post-actions execution finished", func() {
+			release.MarkPostActionsExecuting("")
+			release.MarkPostActionsExecuted()
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.PostActionsExecution.CompletionTime = &metav1.Time{}
+			release.MarkPostActionsExecuted()
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkPostActionsExecuting("")
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkPostActionsExecuted()
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkPostActionsExecuting("")
+			release.MarkPostActionsExecuted()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, postActionsExecutedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(SucceededReason.String()),
+				"Status": Equal(metav1.ConditionTrue),
+			}))
+		})
+	})
+
+	When("MarkPostActionsExecuting method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release post-actions execution finished", func() {
+			release.MarkPostActionsExecuting("")
+			release.MarkPostActionsExecuted()
+			Expect(release.IsEachPostActionExecuting()).To(BeFalse())
+			release.MarkPostActionsExecuting("")
+			Expect(release.IsEachPostActionExecuting()).To(BeFalse())
+		})
+
+		It("should register the start time if it's not executing post-actions", func() {
+			Expect(release.Status.PostActionsExecution.StartTime).To(BeNil())
+			release.MarkPostActionsExecuting("")
+			Expect(release.Status.PostActionsExecution.StartTime).NotTo(BeNil())
+		})
+
+		It("should not register the start time if it's executing post-actions already", func() {
+			Expect(release.Status.PostActionsExecution.StartTime).To(BeNil())
+			release.MarkPostActionsExecuting("")
+			release.Status.PostActionsExecution.StartTime = &metav1.Time{}
+			Expect(release.Status.PostActionsExecution.StartTime.IsZero()).To(BeTrue())
+			release.MarkPostActionsExecuting("")
+			Expect(release.Status.PostActionsExecution.StartTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkPostActionsExecuting("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, postActionsExecutedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(ProgressingReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkPostActionsExecutionFailed method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release post-actions execution has not started", func() {
+			release.MarkPostActionsExecutionFailed("")
+			Expect(release.Status.PostActionsExecution.CompletionTime).To(BeNil())
+		})
+
+		It("should do nothing if the Release post-actions execution finished", func() {
+			release.MarkPostActionsExecuting("")
+			release.MarkPostActionsExecuted()
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.PostActionsExecution.CompletionTime = &metav1.Time{}
+			release.MarkPostActionsExecutionFailed("")
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkPostActionsExecuting("")
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkPostActionsExecutionFailed("")
+			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			Expect(release.Status.Conditions).To(HaveLen(0))
+			release.MarkPostActionsExecuting("")
+			release.MarkPostActionsExecutionFailed("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, postActionsExecutedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkDequeued method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release is not queued", func() {
+			release.MarkDequeued()
+			Expect(release.Status.Conditions).To(HaveLen(0))
+		})
+
+		It("should register the condition", func() {
+			release.MarkQueued("")
+			release.MarkDequeued()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(SucceededReason.String()),
 				"Status": Equal(metav1.ConditionFalse),
 			}))
 		})
 	})
 
-	When("MarkTenantPipelineProcessing method is called", func() {
+	When("MarkPartiallyReleased method is called", func() {
+		It("should register the condition", func() {
+			release := &Release{}
+			release.MarkPartiallyReleased("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, partiallyReleasedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(ComponentsFailedReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
+			}))
+		})
+	})
+
+	When("MarkPreempted method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release tenant pipeline processing finished", func() {
-			release.MarkTenantPipelineProcessing()
-			release.MarkTenantPipelineProcessed()
-			Expect(release.IsTenantPipelineProcessing()).To(BeFalse())
-			release.MarkTenantPipelineProcessing()
-			Expect(release.IsTenantPipelineProcessing()).To(BeFalse())
+		It("should do nothing if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.MarkPreempted("")
+			Expect(release.IsQueued()).To(BeFalse())
 		})
 
-		It("should register the start time if the tenant pipeline is not processing", func() {
-			Expect(release.Status.TenantProcessing.StartTime).To(BeNil())
-			release.MarkTenantPipelineProcessing()
-			Expect(release.Status.TenantProcessing.StartTime).NotTo(BeNil())
+		It("should register the condition", func() {
+			release.MarkPreempted("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(PreemptedReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
+			}))
 		})
+	})
 
-		It("should not register the start time if the tenant pipeline is processing already", func() {
-			Expect(release.Status.TenantProcessing.StartTime).To(BeNil())
-			release.MarkTenantPipelineProcessing()
-			release.Status.TenantProcessing.StartTime = &metav1.Time{}
-			Expect(release.Status.TenantProcessing.StartTime.IsZero()).To(BeTrue())
-			release.MarkTenantPipelineProcessing()
-			Expect(release.Status.TenantProcessing.StartTime.IsZero()).To(BeTrue())
+	When("MarkAwaitingDependencies method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.MarkAwaitingDependencies("")
+			Expect(release.IsQueued()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			release.MarkAwaitingDependencies("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(AwaitingDependenciesReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
+			}))
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseQueued))
+		})
+	})
+
+	When("MarkDependencyUnhealthy method is called", func() {
+		It("should register the condition of the dependency", func() {
+			release := &Release{}
+			release.MarkDependencyUnhealthy("Pyxis", "foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, "PyxisHealthy")
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(UnhealthyReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkAwaitingCapacity method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.MarkAwaitingCapacity("")
+			Expect(release.IsQueued()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			release.MarkAwaitingCapacity("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(AwaitingCapacityReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
+			}))
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseQueued))
+		})
+	})
+
+	When("MarkInsufficientCapacity method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.MarkInsufficientCapacity("")
+			Expect(release.IsQueued()).To(BeFalse())
 		})
 
 		It("should register the condition", func() {
-			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkTenantPipelineProcessing()
+			release.MarkInsufficientCapacity("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(ProgressingReason.String()),
-				"Status": Equal(metav1.ConditionFalse),
+				"Message": Equal("foo"),
+				"Reason":  Equal(InsufficientCapacityReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
 			}))
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseQueued))
 		})
 	})
 
-	When("MarkManagedPipelineProcessingFailed method is called", func() {
+	When("MarkQueued method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release managed pipeline processing has not started", func() {
-			release.MarkManagedPipelineProcessingFailed("")
-			Expect(release.Status.ManagedProcessing.CompletionTime).To(BeNil())
-		})
-
-		It("should do nothing if the Release managed pipeline processing finished", func() {
-			release.MarkManagedPipelineProcessing()
-			release.MarkManagedPipelineProcessed()
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.ManagedProcessing.CompletionTime = &metav1.Time{}
-			release.MarkManagedPipelineProcessingFailed("")
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
-		})
-
-		It("should register the completion time", func() {
-			release.MarkManagedPipelineProcessing()
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkManagedPipelineProcessingFailed("")
-			Expect(release.Status.ManagedProcessing.CompletionTime.IsZero()).To(BeFalse())
+		It("should do nothing if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.MarkQueued("")
+			Expect(release.IsQueued()).To(BeFalse())
 		})
 
 		It("should register the condition", func() {
-			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkManagedPipelineProcessing()
-			release.MarkManagedPipelineProcessingFailed("foo")
+			release.MarkQueued("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
 				"Message": Equal("foo"),
-				"Reason":  Equal(FailedReason.String()),
-				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal(QueuedReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
 			}))
 		})
+
+		It("should set the summary phase to Queued when the Release is releasing", func() {
+			release.MarkReleasing("")
+			release.MarkQueued("foo")
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseQueued))
+			Expect(release.Status.Summary.Message).To(Equal("foo"))
+		})
 	})
 
-	When("MarkTenantPipelineProcessingFailed method is called", func() {
+	When("MarkPlanDeleted method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release tenant pipeline processing has not started", func() {
-			release.MarkTenantPipelineProcessingFailed("")
-			Expect(release.Status.TenantProcessing.CompletionTime).To(BeNil())
+		It("should do nothing if the Release has not finished", func() {
+			release.MarkPlanDeleted("foo")
+			Expect(release.IsPlanDeleted()).To(BeFalse())
 		})
 
-		It("should do nothing if the Release tenant pipeline processing finished", func() {
-			release.MarkTenantPipelineProcessing()
-			release.MarkTenantPipelineProcessed()
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.TenantProcessing.CompletionTime = &metav1.Time{}
-			release.MarkTenantPipelineProcessingFailed("")
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
+		It("should register the condition if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.MarkPlanDeleted("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, planDeletedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("the ReleasePlan foo was deleted"),
+				"Reason":  Equal(PlanDeletedReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
+			}))
 		})
+	})
 
-		It("should register the completion time", func() {
-			release.MarkTenantPipelineProcessing()
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkTenantPipelineProcessingFailed("")
-			Expect(release.Status.TenantProcessing.CompletionTime.IsZero()).To(BeFalse())
+	When("MarkQuarantined method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
 		})
 
 		It("should register the condition", func() {
 			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkTenantPipelineProcessing()
-			release.MarkTenantPipelineProcessingFailed("foo")
+			release.MarkQuarantined("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, quarantinedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
 				"Message": Equal("foo"),
-				"Reason":  Equal(FailedReason.String()),
-				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal(QuarantinedReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
 			}))
 		})
+
+		It("should set the summary phase to Stalled", func() {
+			release.MarkQuarantined("foo")
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseStalled))
+			Expect(release.Status.Summary.Message).To(Equal("foo"))
+		})
 	})
 
-	When("MarkManagedPipelineProcessingSkipped method is called", func() {
+	When("MarkReleased method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release managed pipeline processing finished already", func() {
-			release.MarkManagedPipelineProcessing()
-			release.MarkManagedPipelineProcessingFailed("error")
-			release.MarkManagedPipelineProcessingSkipped()
+		It("should do nothing if the Release has not started", func() {
+			release.MarkReleased()
+			Expect(release.Status.CompletionTime).To(BeNil())
+		})
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
-			Expect(condition).NotTo(BeNil())
-			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Message": Equal("error"),
-				"Reason":  Equal(FailedReason.String()),
-				"Status":  Equal(metav1.ConditionFalse),
-			}))
+		It("should do nothing if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.CompletionTime = &metav1.Time{}
+			release.MarkReleased()
+			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
+		})
+
+		It("should register the completion time", func() {
+			release.MarkReleasing("")
+			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkReleased()
+			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should not register the snapshot latency if the Snapshot releasable time is unknown", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			Expect(release.Status.Latency.SnapshotToReleased).To(BeNil())
+		})
+
+		It("should register the time elapsed since the Snapshot became releasable", func() {
+			release.MarkReleasing("")
+			release.Status.Latency.SnapshotReleasableTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			release.MarkReleased()
+			Expect(release.Status.Latency.SnapshotToReleased).NotTo(BeNil())
+			Expect(release.Status.Latency.SnapshotToReleased.Duration).To(BeNumerically(">=", time.Hour))
 		})
 
 		It("should register the condition", func() {
 			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkManagedPipelineProcessingSkipped()
+			release.MarkReleasing("")
+			release.MarkReleased()
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, managedProcessedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(SkippedReason.String()),
+				"Reason": Equal(SucceededReason.String()),
 				"Status": Equal(metav1.ConditionTrue),
 			}))
 		})
 	})
 
-	When("MarkTenantPipelineProcessingSkipped method is called", func() {
+	When("MarkReleasing method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release tenant pipeline processing finished already", func() {
-			release.MarkTenantPipelineProcessing()
-			release.MarkTenantPipelineProcessingFailed("error")
-			release.MarkTenantPipelineProcessingSkipped()
+		It("should do nothing if the Release finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			Expect(release.IsReleasing()).To(BeFalse())
+			release.MarkReleasing("")
+			Expect(release.IsReleasing()).To(BeFalse())
+		})
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
-			Expect(condition).NotTo(BeNil())
-			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Message": Equal("error"),
-				"Reason":  Equal(FailedReason.String()),
-				"Status":  Equal(metav1.ConditionFalse),
-			}))
+		It("should register the start time if it's not releasing", func() {
+			Expect(release.Status.StartTime).To(BeNil())
+			release.MarkReleasing("")
+			Expect(release.Status.StartTime).NotTo(BeNil())
+		})
+
+		It("should not register the start time if it's releasing already", func() {
+			Expect(release.Status.StartTime).To(BeNil())
+			release.MarkReleasing("")
+			release.Status.StartTime = &metav1.Time{}
+			Expect(release.Status.StartTime.IsZero()).To(BeTrue())
+			release.MarkReleasing("")
+			Expect(release.Status.StartTime.IsZero()).To(BeTrue())
 		})
 
 		It("should register the condition", func() {
 			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkTenantPipelineProcessingSkipped()
+			release.MarkReleasing("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, tenantProcessedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(SkippedReason.String()),
-				"Status": Equal(metav1.ConditionTrue),
+				"Message": Equal("foo"),
+				"Reason":  Equal(ProgressingReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
 			}))
 		})
 	})
 
-	When("MarkPostActionsExecuted method is called", func() {
+	When("MarkReleaseFailed method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release post-actions execution has not started", func() {
-			release.MarkPostActionsExecuted()
-			Expect(release.Status.PostActionsExecution.CompletionTime).To(BeNil())
+		It("should do nothing if the Release has not started", func() {
+			release.MarkReleaseFailed("")
+			Expect(release.Status.CompletionTime).To(BeNil())
 		})
 
-		It("should do nothing if the Release post-actions execution finished", func() {
-			release.MarkPostActionsExecuting("")
-			release.MarkPostActionsExecuted()
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.PostActionsExecution.CompletionTime = &metav1.Time{}
-			release.MarkPostActionsExecuted()
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
+		It("should do nothing if the Release has finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
+			release.Status.CompletionTime = &metav1.Time{}
+			release.MarkReleaseFailed("")
+			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
 		})
 
 		It("should register the completion time", func() {
-			release.MarkPostActionsExecuting("")
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkPostActionsExecuted()
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
+			release.MarkReleasing("")
+			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
+			release.MarkReleaseFailed("")
+			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
 		})
 
 		It("should register the condition", func() {
 			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkPostActionsExecuting("")
-			release.MarkPostActionsExecuted()
+			release.MarkReleasing("")
+			release.MarkReleaseFailed("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, postActionsExecutedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(SucceededReason.String()),
-				"Status": Equal(metav1.ConditionTrue),
+				"Message": Equal("foo"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
 			}))
 		})
+
+		It("should use the reason of the failed phase", func() {
+			release.MarkReleasing("")
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailedWithReason(reasons.PipelineResolutionFailed, "bar")
+			release.MarkReleaseFailed("foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(reasons.PipelineResolutionFailed.String()))
+		})
 	})
 
-	When("MarkPostActionsExecuting method is called", func() {
+	When("MarkAwaitingTestResults method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
+			release.MarkReleasing("")
 		})
 
-		It("should do nothing if the Release post-actions execution finished", func() {
-			release.MarkPostActionsExecuting("")
-			release.MarkPostActionsExecuted()
-			Expect(release.IsEachPostActionExecuting()).To(BeFalse())
-			release.MarkPostActionsExecuting("")
-			Expect(release.IsEachPostActionExecuting()).To(BeFalse())
+		It("should do nothing if the Snapshot was already tested", func() {
+			release.MarkSnapshotTested()
+			release.MarkAwaitingTestResults()
+			Expect(release.IsAwaitingTestResults()).To(BeFalse())
 		})
 
-		It("should register the start time if it's not executing post-actions", func() {
-			Expect(release.Status.PostActionsExecution.StartTime).To(BeNil())
-			release.MarkPostActionsExecuting("")
-			Expect(release.Status.PostActionsExecution.StartTime).NotTo(BeNil())
+		It("should register the condition", func() {
+			release.MarkAwaitingTestResults()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, snapshotTestedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(AwaitingTestResultsReason.String()),
+				"Status": Equal(metav1.ConditionFalse),
+			}))
+			Expect(release.IsAwaitingTestResults()).To(BeTrue())
+			Expect(release.Status.Summary.Message).To(Equal("Waiting for the Snapshot integration tests to pass"))
 		})
+	})
 
-		It("should not register the start time if it's executing post-actions already", func() {
-			Expect(release.Status.PostActionsExecution.StartTime).To(BeNil())
-			release.MarkPostActionsExecuting("")
-			release.Status.PostActionsExecution.StartTime = &metav1.Time{}
-			Expect(release.Status.PostActionsExecution.StartTime.IsZero()).To(BeTrue())
-			release.MarkPostActionsExecuting("")
-			Expect(release.Status.PostActionsExecution.StartTime.IsZero()).To(BeTrue())
+	When("MarkApproved method is called", func() {
+		It("should register the condition and the approver", func() {
+			release := &Release{}
+			release.MarkReleasing("")
+			release.MarkAwaitingApproval("foo")
+			release.MarkApproved("approver")
+			Expect(release.IsApproved()).To(BeTrue())
+			Expect(release.IsAwaitingApproval()).To(BeFalse())
+			Expect(release.Status.Attribution.Approver).To(Equal("approver"))
 		})
+	})
 
-		It("should register the condition", func() {
-			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkPostActionsExecuting("foo")
+	When("MarkAwaitingApproval method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+			release.MarkReleasing("")
+		})
+
+		It("should do nothing if the Release is already approved", func() {
+			release.MarkApproved("approver")
+			release.MarkAwaitingApproval("foo")
+			Expect(release.IsAwaitingApproval()).To(BeFalse())
+		})
+
+		It("should register the condition", func() {
+			release.MarkAwaitingApproval("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, postActionsExecutedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, approvedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
 				"Message": Equal("foo"),
-				"Reason":  Equal(ProgressingReason.String()),
+				"Reason":  Equal(AwaitingApprovalReason.String()),
 				"Status":  Equal(metav1.ConditionFalse),
 			}))
+			Expect(release.IsAwaitingApproval()).To(BeTrue())
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhasePending))
+			Expect(release.Status.Summary.Message).To(Equal("Waiting for the Release to be approved by a second reviewer"))
 		})
 	})
 
-	When("MarkPostActionsExecutionFailed method is called", func() {
+	When("MarkAwaitingReleaseWindow method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
+			release.MarkReleasing("")
 		})
 
-		It("should do nothing if the Release post-actions execution has not started", func() {
-			release.MarkPostActionsExecutionFailed("")
-			Expect(release.Status.PostActionsExecution.CompletionTime).To(BeNil())
+		It("should do nothing if the Release is already in a release window", func() {
+			release.MarkInReleaseWindow()
+			release.MarkAwaitingReleaseWindow(time.Now())
+			Expect(release.IsAwaitingReleaseWindow()).To(BeFalse())
+			Expect(release.Status.ScheduledTime).To(BeNil())
 		})
 
-		It("should do nothing if the Release post-actions execution finished", func() {
-			release.MarkPostActionsExecuting("")
-			release.MarkPostActionsExecuted()
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.PostActionsExecution.CompletionTime = &metav1.Time{}
-			release.MarkPostActionsExecutionFailed("")
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
+		It("should register the condition and the scheduled time", func() {
+			scheduledTime := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+			release.MarkAwaitingReleaseWindow(scheduledTime)
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, inReleaseWindowConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(AwaitingReleaseWindowReason.String()),
+				"Status": Equal(metav1.ConditionFalse),
+			}))
+			Expect(release.IsAwaitingReleaseWindow()).To(BeTrue())
+			Expect(release.Status.ScheduledTime.Time).To(Equal(scheduledTime))
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhasePending))
+			Expect(release.Status.Summary.Message).To(Equal("Queued until the release window opens at 2024-01-01T09:00:00Z"))
 		})
+	})
 
-		It("should register the completion time", func() {
-			release.MarkPostActionsExecuting("")
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkPostActionsExecutionFailed("")
-			Expect(release.Status.PostActionsExecution.CompletionTime.IsZero()).To(BeFalse())
+	When("MarkInReleaseWindow method is called", func() {
+		It("should register the condition and clear the scheduled time", func() {
+			release := &Release{}
+			release.MarkReleasing("")
+			release.MarkAwaitingReleaseWindow(time.Now())
+			release.MarkInReleaseWindow()
+			Expect(release.IsInReleaseWindow()).To(BeTrue())
+			Expect(release.IsAwaitingReleaseWindow()).To(BeFalse())
+			Expect(release.Status.ScheduledTime).To(BeNil())
 		})
+	})
 
-		It("should register the condition", func() {
-			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkPostActionsExecuting("")
-			release.MarkPostActionsExecutionFailed("foo")
+	When("MarkReleaseWindowBypassed method is called", func() {
+		It("should register the condition and clear the scheduled time", func() {
+			release := &Release{}
+			release.MarkReleasing("")
+			release.MarkAwaitingReleaseWindow(time.Now())
+			release.MarkReleaseWindowBypassed("foo")
+			Expect(release.IsInReleaseWindow()).To(BeTrue())
+			Expect(release.Status.ScheduledTime).To(BeNil())
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, postActionsExecutedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, inReleaseWindowConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
 				"Message": Equal("foo"),
-				"Reason":  Equal(FailedReason.String()),
-				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal(EmergencyBypassReason.String()),
 			}))
 		})
 	})
 
-	When("MarkReleased method is called", func() {
-		var release *Release
+	When("MarkSnapshotStale method is called", func() {
+		It("should register the condition", func() {
+			release := &Release{}
+			release.MarkSnapshotStale("foo")
+			Expect(release.IsSnapshotStale()).To(BeTrue())
 
-		BeforeEach(func() {
-			release = &Release{}
+			condition := meta.FindStatusCondition(release.Status.Conditions, snapshotStaleConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(StaleSnapshotReason.String()),
+			}))
 		})
+	})
 
-		It("should do nothing if the Release has not started", func() {
-			release.MarkReleased()
-			Expect(release.Status.CompletionTime).To(BeNil())
+	When("MarkSnapshotTested method is called", func() {
+		It("should register the condition", func() {
+			release := &Release{}
+			release.MarkAwaitingTestResults()
+			release.MarkSnapshotTested()
+			Expect(release.IsSnapshotTested()).To(BeTrue())
+			Expect(release.IsAwaitingTestResults()).To(BeFalse())
 		})
+	})
 
-		It("should do nothing if the Release has finished", func() {
-			release.MarkReleasing("")
-			release.MarkReleased()
-			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.CompletionTime = &metav1.Time{}
-			release.MarkReleased()
-			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
-		})
+	When("MarkSnapshotTestsBypassed method is called", func() {
+		It("should register the condition", func() {
+			release := &Release{}
+			release.MarkAwaitingTestResults()
+			release.MarkSnapshotTestsBypassed("foo")
+			Expect(release.IsSnapshotTested()).To(BeTrue())
 
-		It("should register the completion time", func() {
-			release.MarkReleasing("")
-			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkReleased()
-			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
+			condition := meta.FindStatusCondition(release.Status.Conditions, snapshotTestedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(EmergencyBypassReason.String()),
+			}))
 		})
+	})
 
+	When("MarkSnapshotTestsFailed method is called", func() {
 		It("should register the condition", func() {
-			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkReleasing("")
-			release.MarkReleased()
+			release := &Release{}
+			release.MarkSnapshotTestsFailed("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, snapshotTestedConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Reason": Equal(SucceededReason.String()),
-				"Status": Equal(metav1.ConditionTrue),
+				"Message": Equal("foo"),
+				"Reason":  Equal(FailedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
 			}))
+			Expect(release.IsSnapshotTested()).To(BeFalse())
 		})
 	})
 
-	When("MarkReleasing method is called", func() {
+	When("MarkStalled method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release finished", func() {
+		It("should do nothing if the Release has finished", func() {
 			release.MarkReleasing("")
 			release.MarkReleased()
-			Expect(release.IsReleasing()).To(BeFalse())
-			release.MarkReleasing("")
-			Expect(release.IsReleasing()).To(BeFalse())
-		})
-
-		It("should register the start time if it's not releasing", func() {
-			Expect(release.Status.StartTime).To(BeNil())
-			release.MarkReleasing("")
-			Expect(release.Status.StartTime).NotTo(BeNil())
-		})
-
-		It("should not register the start time if it's releasing already", func() {
-			Expect(release.Status.StartTime).To(BeNil())
-			release.MarkReleasing("")
-			release.Status.StartTime = &metav1.Time{}
-			Expect(release.Status.StartTime.IsZero()).To(BeTrue())
-			release.MarkReleasing("")
-			Expect(release.Status.StartTime.IsZero()).To(BeTrue())
+			release.MarkStalled("")
+			Expect(release.IsStalled()).To(BeFalse())
 		})
 
 		It("should register the condition", func() {
 			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkReleasing("foo")
+			release.MarkStalled("foo")
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
+			condition := meta.FindStatusCondition(release.Status.Conditions, stalledConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
 				"Message": Equal("foo"),
-				"Reason":  Equal(ProgressingReason.String()),
-				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal(StalledReason.String()),
+				"Status":  Equal(metav1.ConditionTrue),
 			}))
 		})
 	})
 
-	When("MarkReleaseFailed method is called", func() {
+	When("MarkUnquarantined method is called", func() {
 		var release *Release
 
 		BeforeEach(func() {
 			release = &Release{}
 		})
 
-		It("should do nothing if the Release has not started", func() {
-			release.MarkReleaseFailed("")
-			Expect(release.Status.CompletionTime).To(BeNil())
+		It("should do nothing if the Release is not quarantined", func() {
+			release.MarkUnquarantined()
+			Expect(release.Status.Conditions).To(HaveLen(0))
 		})
 
-		It("should do nothing if the Release has finished", func() {
-			release.MarkReleasing("")
-			release.MarkReleased()
-			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
-			release.Status.CompletionTime = &metav1.Time{}
-			release.MarkReleaseFailed("")
-			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
+		It("should register the condition", func() {
+			release.MarkQuarantined("foo")
+			release.MarkUnquarantined()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, quarantinedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Reason": Equal(ProgressingReason.String()),
+				"Status": Equal(metav1.ConditionFalse),
+			}))
+			Expect(release.IsQuarantined()).To(BeFalse())
 		})
+	})
 
-		It("should register the completion time", func() {
-			release.MarkReleasing("")
-			Expect(release.Status.CompletionTime.IsZero()).To(BeTrue())
-			release.MarkReleaseFailed("")
-			Expect(release.Status.CompletionTime.IsZero()).To(BeFalse())
+	When("MarkUnstalled method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
 		})
 
-		It("should register the condition", func() {
+		It("should do nothing if the Release is not stalled", func() {
+			release.MarkUnstalled()
 			Expect(release.Status.Conditions).To(HaveLen(0))
-			release.MarkReleasing("")
-			release.MarkReleaseFailed("foo")
+		})
 
-			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
+		It("should register the condition", func() {
+			release.MarkStalled("foo")
+			release.MarkUnstalled()
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, stalledConditionType.String())
 			Expect(condition).NotTo(BeNil())
 			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
-				"Message": Equal("foo"),
-				"Reason":  Equal(FailedReason.String()),
-				"Status":  Equal(metav1.ConditionFalse),
+				"Reason": Equal(ProgressingReason.String()),
+				"Status": Equal(metav1.ConditionFalse),
 			}))
+			Expect(release.IsStalled()).To(BeFalse())
 		})
 	})
 
@@ -1104,6 +2453,123 @@ var _ = Describe("Release type", func() {
 				"Status":  Equal(metav1.ConditionFalse),
 			}))
 		})
+
+		It("should register the message as a cause", func() {
+			release.MarkValidationFailed("foo")
+			Expect(release.Status.Validation.Causes).To(Equal([]ValidationCause{
+				{Message: "foo", Reason: metav1.CauseType(FailedReason)},
+			}))
+		})
+	})
+
+	When("MarkValidationFailedWithCauses method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should register the causes and their description in the condition", func() {
+			causes := []ValidationCause{
+				{Field: "spec.foo", Hint: "set foo", Message: "foo is missing", Reason: metav1.CauseTypeFieldValueRequired},
+				{Message: "bar", Reason: metav1.CauseTypeForbidden},
+			}
+			release.MarkValidationFailedWithCauses(causes...)
+			Expect(release.Status.Validation.Causes).To(Equal(causes))
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, validatedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Message).To(Equal("spec.foo: foo is missing (hint: set foo); bar"))
+			Expect(condition.Reason).To(Equal(FailedReason.String()))
+		})
+
+		It("should use the first cause with a known reason as the condition reason", func() {
+			release.MarkValidationFailedWithCauses(
+				ValidationCause{Message: "foo", Reason: metav1.CauseTypeForbidden},
+				ValidationCause{Message: "bar", Reason: metav1.CauseType(reasons.ReleasePlanMissing)},
+				ValidationCause{Message: "baz", Reason: metav1.CauseType(reasons.SnapshotMissing)},
+			)
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, validatedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(reasons.ReleasePlanMissing.String()))
+		})
+
+		It("should clear the causes once the Release is validated", func() {
+			release.MarkValidationFailedWithCauses(ValidationCause{Message: "foo", Reason: metav1.CauseTypeForbidden})
+			release.MarkValidated()
+			Expect(release.Status.Validation.Causes).To(BeNil())
+		})
+	})
+
+	When("MarkVerificationFailed method is called", func() {
+		It("should do nothing if the released artifacts are not being verified", func() {
+			release := &Release{}
+			release.MarkVerificationFailed("")
+			Expect(release.HasVerificationFinished()).To(BeFalse())
+		})
+
+		It("should register the completion time and the condition", func() {
+			release := &Release{}
+			release.MarkVerifying()
+			release.MarkVerificationFailed("foo")
+			Expect(release.Status.Verification.CompletionTime).NotTo(BeNil())
+			Expect(release.IsVerificationFailed()).To(BeTrue())
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, verifiedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(reasons.VerificationFailed.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkVerificationSkipped method is called", func() {
+		It("should register the condition", func() {
+			release := &Release{}
+			release.MarkVerificationSkipped()
+			Expect(release.HasVerificationFinished()).To(BeTrue())
+			Expect(release.IsVerificationFailed()).To(BeFalse())
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, verifiedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(SkippedReason.String()))
+		})
+	})
+
+	When("MarkVerified method is called", func() {
+		It("should do nothing if the released artifacts are not being verified", func() {
+			release := &Release{}
+			release.MarkVerified()
+			Expect(release.HasVerificationFinished()).To(BeFalse())
+		})
+
+		It("should register the completion time and the condition", func() {
+			release := &Release{}
+			release.MarkVerifying()
+			release.MarkVerified()
+			Expect(release.Status.Verification.CompletionTime).NotTo(BeNil())
+			Expect(release.HasVerificationFinished()).To(BeTrue())
+			Expect(release.IsVerificationFailed()).To(BeFalse())
+		})
+	})
+
+	When("MarkVerifying method is called", func() {
+		It("should register the start time and the condition", func() {
+			release := &Release{}
+			release.MarkVerifying()
+			Expect(release.Status.Verification.StartTime).NotTo(BeNil())
+			Expect(release.IsVerifying()).To(BeTrue())
+		})
+
+		It("should do nothing if the verification has finished", func() {
+			release := &Release{}
+			release.MarkVerificationSkipped()
+			release.MarkVerifying()
+			Expect(release.IsVerifying()).To(BeFalse())
+		})
 	})
 
 	When("SetAutomated method is called", func() {
@@ -1210,6 +2676,80 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("getFailureMessage method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+			release.MarkReleasing("")
+		})
+
+		It("should return the message of the failed phase", func() {
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailed("pipeline failed")
+			release.MarkReleaseFailed("Release processing failed on managed pipelineRun")
+			Expect(release.getFailureMessage()).To(Equal("pipeline failed"))
+		})
+
+		It("should return the message of the Released condition if no phase failed", func() {
+			release.MarkReleaseFailed("dependency failed")
+			Expect(release.getFailureMessage()).To(Equal("dependency failed"))
+		})
+	})
+
+	When("updateSummary method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should set the Pending phase if the Release didn't start", func() {
+			release.updateSummary()
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhasePending))
+		})
+
+		It("should set the Progressing phase while the Release is being validated", func() {
+			release.MarkReleasing("")
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseProgressing))
+			Expect(release.Status.Summary.Message).To(Equal("Validating the Release"))
+		})
+
+		It("should describe the pipeline being run", func() {
+			release.MarkReleasing("")
+			release.MarkTenantPipelineProcessing()
+			Expect(release.Status.Summary.Message).To(Equal("Running the tenant Release Pipeline"))
+			release.MarkTenantPipelineProcessed()
+			release.MarkManagedPipelineProcessing()
+			Expect(release.Status.Summary.Message).To(Equal("Running the managed Release Pipeline"))
+			release.MarkManagedPipelineProcessed()
+			release.MarkPostActionsExecuting("")
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseProgressing))
+			Expect(release.Status.Summary.Message).To(Equal("Executing the post-actions"))
+		})
+
+		It("should set the Stalled phase with the diagnostics if the Release is stalled", func() {
+			release.MarkReleasing("")
+			release.MarkStalled("diagnostics")
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseStalled))
+			Expect(release.Status.Summary.Message).To(Equal("diagnostics"))
+		})
+
+		It("should set the Succeeded phase if the Release succeeded", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseSucceeded))
+		})
+
+		It("should set the Failed phase with the failure message if the Release failed", func() {
+			release.MarkReleasing("")
+			release.MarkValidationFailed("invalid")
+			release.MarkReleaseFailed("Release validation failed")
+			Expect(release.Status.Summary.Phase).To(Equal(ReleasePhaseFailed))
+			Expect(release.Status.Summary.Message).To(Equal("invalid"))
+		})
+	})
+
 	When("SetExpirationTime method is called", func() {
 		var release *Release
 
@@ -1226,4 +2766,39 @@ var _ = Describe("Release type", func() {
 			Expect(release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
 		})
 	})
+
+	When("SetSnapshotReleasableTime method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should set the Snapshot releasable time without latency if the Release hasn't started", func() {
+			releasableTime := time.Now()
+			release.SetSnapshotReleasableTime(releasableTime)
+			Expect(release.HasSnapshotReleasableTime()).To(BeTrue())
+			Expect(release.Status.Latency.SnapshotReleasableTime.Time).To(Equal(releasableTime))
+			Expect(release.Status.Latency.SnapshotToReleaseStart).To(BeNil())
+		})
+
+		It("should set the time elapsed until the Release started", func() {
+			release.MarkReleasing("")
+			release.SetSnapshotReleasableTime(release.Status.StartTime.Add(-time.Minute))
+			Expect(release.Status.Latency.SnapshotToReleaseStart.Duration).To(Equal(time.Minute))
+		})
+
+		It("should not set a negative latency if the Release started before the Snapshot was releasable", func() {
+			release.MarkReleasing("")
+			release.SetSnapshotReleasableTime(release.Status.StartTime.Add(time.Minute))
+			Expect(release.Status.Latency.SnapshotToReleaseStart.Duration).To(BeZero())
+		})
+
+		It("should not overwrite a recorded Snapshot releasable time", func() {
+			releasableTime := time.Now()
+			release.SetSnapshotReleasableTime(releasableTime)
+			release.SetSnapshotReleasableTime(releasableTime.Add(time.Hour))
+			Expect(release.Status.Latency.SnapshotReleasableTime.Time).To(Equal(releasableTime))
+		})
+	})
 })
@@ -0,0 +1,163 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReleasePipelineCatalogSpec defines the desired state of ReleasePipelineCatalog.
+type ReleasePipelineCatalogSpec struct {
+	// Pipelines is the list of named managed Pipelines in the catalog
+	// +listType=map
+	// +listMapKey=name
+	// +required
+	Pipelines []CatalogPipeline `json:"pipelines"`
+}
+
+// CatalogPipeline defines a named managed Pipeline of a ReleasePipelineCatalog and its versions.
+type CatalogPipeline struct {
+	// DefaultVersion is the version used by the ReleasePlanAdmissions referencing the Pipeline without a version, so
+	// all of them are upgraded at once by changing it
+	// +required
+	DefaultVersion string `json:"defaultVersion"`
+
+	// Name is the name of the Pipeline in the catalog
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Name string `json:"name"`
+
+	// Versions is the list of versions of the Pipeline
+	// +listType=map
+	// +listMapKey=version
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Versions []CatalogPipelineVersion `json:"versions"`
+}
+
+// CatalogPipelineVersion defines a version of a managed Pipeline of a ReleasePipelineCatalog, along with the default
+// params passed to it.
+type CatalogPipelineVersion struct {
+	tektonutils.ParameterizedPipeline `json:",inline"`
+
+	// Version is the version of the Pipeline, e.g. v1.2.0
+	// +required
+	Version string `json:"version"`
+}
+
+// CatalogPipelineReference defines a reference to a managed Pipeline of a ReleasePipelineCatalog.
+type CatalogPipelineReference struct {
+	// Catalog is the name of the ReleasePipelineCatalog in the namespace of the ReleasePlanAdmission
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Catalog string `json:"catalog"`
+
+	// Name is the name of the Pipeline in the catalog
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Name string `json:"name"`
+
+	// Params overrides the default params of the Pipeline version with the same name and adds the rest of them
+	// +optional
+	Params []tektonutils.Param `json:"params,omitempty"`
+
+	// Version pins the version of the Pipeline. The default version of the Pipeline in the catalog is used if not set
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// ReleasePipelineCatalogStatus defines the observed state of ReleasePipelineCatalog.
+type ReleasePipelineCatalogStatus struct {
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:shortName=rpc,categories=appstudio
+//+kubebuilder:subresource:status
+
+// ReleasePipelineCatalog is the Schema for the releasepipelinecatalogs API
+type ReleasePipelineCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReleasePipelineCatalogSpec   `json:"spec,omitempty"`
+	Status ReleasePipelineCatalogStatus `json:"status,omitempty"`
+}
+
+// GetPipeline returns the version of the catalog Pipeline referenced by the given CatalogPipelineReference, using the
+// default version of the Pipeline if the reference doesn't pin one. The default params of the version are overridden
+// by the params of the reference. An error is returned if the Pipeline or the version are not in the catalog.
+func (rpc *ReleasePipelineCatalog) GetPipeline(reference *CatalogPipelineReference) (*tektonutils.ParameterizedPipeline, error) {
+	for _, pipeline := range rpc.Spec.Pipelines {
+		if pipeline.Name != reference.Name {
+			continue
+		}
+
+		version := reference.Version
+		if version == "" {
+			version = pipeline.DefaultVersion
+		}
+
+		for _, pipelineVersion := range pipeline.Versions {
+			if pipelineVersion.Version == version {
+				parameterizedPipeline := pipelineVersion.ParameterizedPipeline.DeepCopy()
+				parameterizedPipeline.Params = mergeParams(parameterizedPipeline.Params, reference.Params)
+				return parameterizedPipeline, nil
+			}
+		}
+
+		return nil, fmt.Errorf("version %s of pipeline %s is not in the ReleasePipelineCatalog %s",
+			version, reference.Name, rpc.Name)
+	}
+
+	return nil, fmt.Errorf("pipeline %s is not in the ReleasePipelineCatalog %s", reference.Name, rpc.Name)
+}
+
+// mergeParams returns the given default params with the values of the given overrides, keeping their order. Overrides
+// not present in the default params are appended.
+func mergeParams(defaults, overrides []tektonutils.Param) []tektonutils.Param {
+	params := append([]tektonutils.Param{}, defaults...)
+	for _, override := range overrides {
+		found := false
+		for i := range params {
+			if params[i].Name == override.Name {
+				params[i].Value = override.Value
+				found = true
+			}
+		}
+		if !found {
+			params = append(params, override)
+		}
+	}
+
+	return params
+}
+
+//+kubebuilder:object:root=true
+
+// ReleasePipelineCatalogList contains a list of ReleasePipelineCatalog
+type ReleasePipelineCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReleasePipelineCatalog `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReleasePipelineCatalog{}, &ReleasePipelineCatalogList{})
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ReleasePipelineCatalog type", func() {
+	When("GetPipeline method is called", func() {
+		var releasePipelineCatalog *ReleasePipelineCatalog
+
+		BeforeEach(func() {
+			releasePipelineCatalog = &ReleasePipelineCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "catalog"},
+				Spec: ReleasePipelineCatalogSpec{
+					Pipelines: []CatalogPipeline{
+						{
+							Name:           "push",
+							DefaultVersion: "v2",
+							Versions: []CatalogPipelineVersion{
+								{
+									ParameterizedPipeline: tektonutils.ParameterizedPipeline{
+										Pipeline: tektonutils.Pipeline{
+											PipelineRef: tektonutils.PipelineRef{Resolver: "git"},
+										},
+										Params: []tektonutils.Param{{Name: "foo", Value: "bar"}},
+									},
+									Version: "v1",
+								},
+								{
+									ParameterizedPipeline: tektonutils.ParameterizedPipeline{
+										Pipeline: tektonutils.Pipeline{
+											PipelineRef: tektonutils.PipelineRef{Resolver: "bundles"},
+										},
+										Params: []tektonutils.Param{
+											{Name: "foo", Value: "bar"},
+											{Name: "baz", Value: "qux"},
+										},
+									},
+									Version: "v2",
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return the default version if the reference doesn't pin one", func() {
+			pipeline, err := releasePipelineCatalog.GetPipeline(&CatalogPipelineReference{Name: "push"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline.PipelineRef.Resolver).To(Equal("bundles"))
+		})
+
+		It("should return the version pinned by the reference", func() {
+			pipeline, err := releasePipelineCatalog.GetPipeline(&CatalogPipelineReference{Name: "push", Version: "v1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline.PipelineRef.Resolver).To(Equal("git"))
+		})
+
+		It("should override the default params with the params of the reference", func() {
+			pipeline, err := releasePipelineCatalog.GetPipeline(&CatalogPipelineReference{
+				Name:   "push",
+				Params: []tektonutils.Param{{Name: "foo", Value: "override"}, {Name: "new", Value: "value"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline.Params).To(Equal([]tektonutils.Param{
+				{Name: "foo", Value: "override"},
+				{Name: "baz", Value: "qux"},
+				{Name: "new", Value: "value"},
+			}))
+			Expect(releasePipelineCatalog.Spec.Pipelines[0].Versions[1].Params[0].Value).To(Equal("bar"))
+		})
+
+		It("should return an error if the version is not in the catalog", func() {
+			_, err := releasePipelineCatalog.GetPipeline(&CatalogPipelineReference{Name: "push", Version: "v3"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("version v3 of pipeline push is not in the ReleasePipelineCatalog catalog"))
+		})
+
+		It("should return an error if the pipeline is not in the catalog", func() {
+			_, err := releasePipelineCatalog.GetPipeline(&CatalogPipelineReference{Name: "foo"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("pipeline foo is not in the ReleasePipelineCatalog catalog"))
+		})
+	})
+})
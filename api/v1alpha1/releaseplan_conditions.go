@@ -0,0 +1,39 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "github.com/konflux-ci/operator-toolkit/conditions"
+
+const (
+	// deletionBlockedConditionType is the type used to track whether the deletion of a ReleasePlan waits for its
+	// Releases to finish
+	deletionBlockedConditionType conditions.ConditionType = "DeletionBlocked"
+
+	// transferredConditionType is the type used to track the transfer of a ReleasePlan to another application
+	transferredConditionType conditions.ConditionType = "Transferred"
+)
+
+const (
+	// ActiveReleasesReason is the reason set when the deletion of a ReleasePlan waits for its running Releases
+	ActiveReleasesReason conditions.ConditionReason = "ActiveReleases"
+
+	// TransferPendingReason is the reason set when the transfer of a ReleasePlan waits to be accepted
+	TransferPendingReason conditions.ConditionReason = "TransferPending"
+
+	// TransferredReason is the reason set when a ReleasePlan is transferred to another application
+	TransferredReason conditions.ConditionReason = "Transferred"
+)
@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/konflux-ci/operator-toolkit/conditions"
 	"github.com/konflux-ci/release-service/metadata"
@@ -44,6 +46,19 @@ type ReleasePlanSpec struct {
 	// +optional
 	Data *runtime.RawExtension `json:"data,omitempty"`
 
+	// DeletionPolicy defines what happens to the running Releases using this ReleasePlan when it's deleted
+	// +kubebuilder:validation:Enum=Block;Cascade
+	// +kubebuilder:default:=Block
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// FanOutPolicy enables releasing to every ReleasePlanAdmission matching the ReleasePlan in the target namespace,
+	// running one managed Pipeline per ReleasePlanAdmission, and defines whether all or any of them must succeed for
+	// the Release to succeed. If not set, matching more than one ReleasePlanAdmission is an error
+	// +kubebuilder:validation:Enum=All;Any
+	// +optional
+	FanOutPolicy FanOutPolicy `json:"fanOutPolicy,omitempty"`
+
 	// Pipeline contains all the information about the tenant Pipeline
 	// +optional
 	Pipeline *tektonutils.ParameterizedPipeline `json:"pipeline,omitempty"`
@@ -54,12 +69,90 @@ type ReleasePlanSpec struct {
 	// +optional
 	ReleaseGracePeriodDays int `json:"releaseGracePeriodDays,omitempty"`
 
+	// RequirePassingTests indicates whether the integration tests of the Snapshot of a Release using this ReleasePlan
+	// have to pass before the Release is processed
+	// +optional
+	RequirePassingTests bool `json:"requirePassingTests,omitempty"`
+
+	// RetryBudget limits the automatic retries of the Releases using this ReleasePlan
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RetryFailedComponents indicates whether a follow-up Release should be created to retry only the failed
+	// components when a Release using this ReleasePlan is partially released. Follow-up Releases count against the
+	// retry budget
+	// +optional
+	RetryFailedComponents bool `json:"retryFailedComponents,omitempty"`
+
+	// SnapshotMaxAge limits the age of the Snapshots that can be released using this ReleasePlan
+	// +optional
+	SnapshotMaxAge *SnapshotMaxAge `json:"snapshotMaxAge,omitempty"`
+
 	// Target references where to send the release requests
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
 	Target string `json:"target,omitempty"`
 }
 
+// DeletionPolicy defines what happens to the running Releases using a ReleasePlan when it's deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyBlock keeps the ReleasePlan until all the Releases using it finish
+	DeletionPolicyBlock DeletionPolicy = "Block"
+
+	// DeletionPolicyCascade deletes the running Releases using the ReleasePlan along with it
+	DeletionPolicyCascade DeletionPolicy = "Cascade"
+)
+
+// FanOutPolicy defines which of the managed Pipelines of a fan-out Release must succeed for the Release to succeed.
+type FanOutPolicy string
+
+const (
+	// FanOutPolicyAll requires the managed Pipelines of all the targets to succeed
+	FanOutPolicyAll FanOutPolicy = "All"
+
+	// FanOutPolicyAny requires the managed Pipeline of at least one of the targets to succeed
+	FanOutPolicyAny FanOutPolicy = "Any"
+)
+
+// RetryBudget defines the maximum number of automatic retries allowed within a rolling window.
+type RetryBudget struct {
+	// MaxRetries is the maximum number of automatic retries allowed within the window
+	// +kubebuilder:validation:Minimum=0
+	// +required
+	MaxRetries int `json:"maxRetries"`
+
+	// Window is the duration of the rolling window in which automatic retries are counted
+	// +required
+	Window metav1.Duration `json:"window"`
+}
+
+// SnapshotMaxAgeAction defines what happens when a Release references a Snapshot exceeding the maximum age.
+type SnapshotMaxAgeAction string
+
+const (
+	// SnapshotMaxAgeActionReject fails the validation of Releases referencing stale Snapshots
+	SnapshotMaxAgeActionReject SnapshotMaxAgeAction = "Reject"
+
+	// SnapshotMaxAgeActionWarn only flags Releases referencing stale Snapshots with the SnapshotStale condition
+	SnapshotMaxAgeActionWarn SnapshotMaxAgeAction = "Warn"
+)
+
+// SnapshotMaxAge defines the maximum age of the Snapshots that can be released.
+type SnapshotMaxAge struct {
+	// Action is the action taken when a Release references a Snapshot created more than Days days before it
+	// +kubebuilder:validation:Enum=Reject;Warn
+	// +kubebuilder:default:=Reject
+	// +optional
+	Action SnapshotMaxAgeAction `json:"action,omitempty"`
+
+	// Days is the maximum number of days between the creation of a Snapshot and the creation of the Release
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	Days int `json:"days"`
+}
+
 // MatchedReleasePlanAdmission defines the relevant information for a matched ReleasePlanAdmission.
 type MatchedReleasePlanAdmission struct {
 	// Name contains the namespaced name of the releasePlanAdmission
@@ -82,10 +175,44 @@ type ReleasePlanStatus struct {
 	// matched to
 	// +optional
 	ReleasePlanAdmission MatchedReleasePlanAdmission `json:"releasePlanAdmission,omitempty"`
+
+	// RetryBudget contains the information about the consumption of the retry budget
+	// +optional
+	RetryBudget RetryBudgetStatus `json:"retryBudget,omitempty"`
+
+	// Transfers contains the transfers of the ReleasePlan between applications, oldest first
+	// +optional
+	Transfers []ReleasePlanTransfer `json:"transfers,omitempty"`
+}
+
+// ReleasePlanTransfer defines a transfer of a ReleasePlan from an application to another.
+type ReleasePlanTransfer struct {
+	// CompletionTime is the time when the transfer was completed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// From is the application the ReleasePlan was transferred from
+	// +required
+	From string `json:"from"`
+
+	// To is the application the ReleasePlan was transferred to
+	// +required
+	To string `json:"to"`
+}
+
+// RetryBudgetStatus defines the observed consumption of a retry budget.
+type RetryBudgetStatus struct {
+	// Consumed is the number of automatic retries performed within the current window
+	// +optional
+	Consumed int `json:"consumed,omitempty"`
+
+	// Retries contains the times at which the automatic retries within the current window were performed
+	// +optional
+	Retries []metav1.Time `json:"retries,omitempty"`
 }
 
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:shortName=rp
+// +kubebuilder:resource:shortName=rp,categories=appstudio
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Application",type=string,JSONPath=`.spec.application`
 // +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target`
@@ -99,11 +226,108 @@ type ReleasePlan struct {
 	Status ReleasePlanStatus `json:"status,omitempty"`
 }
 
+// ConsumeRetry registers an automatic retry in the ReleasePlan retry budget, discarding the retries that are out of
+// the current window. If the ReleasePlan has no retry budget, no action will be taken.
+func (rp *ReleasePlan) ConsumeRetry() {
+	if rp.Spec.RetryBudget == nil {
+		return
+	}
+
+	rp.Status.RetryBudget.Retries = append(rp.getRetriesInWindow(), metav1.Time{Time: time.Now()})
+	rp.Status.RetryBudget.Consumed = len(rp.Status.RetryBudget.Retries)
+}
+
+// HasRetryBudgetLeft checks whether the ReleasePlan allows more automatic retries within the current window.
+// ReleasePlans without a retry budget always allow them.
+func (rp *ReleasePlan) HasRetryBudgetLeft() bool {
+	if rp.Spec.RetryBudget == nil {
+		return true
+	}
+
+	return len(rp.getRetriesInWindow()) < rp.Spec.RetryBudget.MaxRetries
+}
+
+// GetTransferTarget returns the application the ReleasePlan is being transferred to. An empty string is returned if
+// no transfer was requested or if the ReleasePlan already belongs to the requested application.
+func (rp *ReleasePlan) GetTransferTarget() string {
+	target := rp.GetAnnotations()[metadata.TransferToAnnotation]
+	if target == rp.Spec.Application {
+		return ""
+	}
+
+	return target
+}
+
+// GetDeprecatedFields returns the deprecated fields set in the ReleasePlan.
+func (rp *ReleasePlan) GetDeprecatedFields() []DeprecatedField {
+	if rp.Spec.Pipeline == nil {
+		return nil
+	}
+
+	return getDeprecatedBundleFields(rp.Spec.Pipeline.PipelineRef, "spec.pipeline", "spec.pipeline")
+}
+
+// HasDeprecatedFieldsReported checks whether the DeprecatedFieldsUsed condition of the ReleasePlan reports the given
+// deprecated fields.
+func (rp *ReleasePlan) HasDeprecatedFieldsReported(fields []DeprecatedField) bool {
+	return isDeprecatedFieldsUsedReported(rp.Status.Conditions, fields)
+}
+
+// IsDeletionBlocked checks whether the deletion of the ReleasePlan waits for its running Releases to finish.
+func (rp *ReleasePlan) IsDeletionBlocked() bool {
+	return meta.IsStatusConditionTrue(rp.Status.Conditions, deletionBlockedConditionType.String())
+}
+
+// IsTransferAccepted checks whether the transfer of the ReleasePlan was accepted on behalf of the application it's
+// being transferred to.
+func (rp *ReleasePlan) IsTransferAccepted() bool {
+	target := rp.GetTransferTarget()
+
+	return target != "" && rp.GetAnnotations()[metadata.TransferAcceptedAnnotation] == target
+}
+
+// IsTransferPending checks whether the ReleasePlan is waiting for its transfer to the given application to be accepted.
+func (rp *ReleasePlan) IsTransferPending(target string) bool {
+	condition := meta.FindStatusCondition(rp.Status.Conditions, transferredConditionType.String())
+
+	return condition != nil && condition.Reason == TransferPendingReason.String() &&
+		condition.Message == getTransferPendingMessage(target)
+}
+
+// MarkDeletionBlocked marks the deletion of the ReleasePlan as waiting for the given running Releases to finish.
+func (rp *ReleasePlan) MarkDeletionBlocked(releases []string) {
+	conditions.SetConditionWithMessage(&rp.Status.Conditions, deletionBlockedConditionType, metav1.ConditionTrue,
+		ActiveReleasesReason, fmt.Sprintf("waiting for the running Releases to finish: %s", strings.Join(releases, ", ")))
+}
+
+// MarkDeprecatedFieldsUsed marks the ReleasePlan as setting the given deprecated fields, or as no longer setting any if
+// none is given.
+func (rp *ReleasePlan) MarkDeprecatedFieldsUsed(fields []DeprecatedField) {
+	setDeprecatedFieldsUsedCondition(&rp.Status.Conditions, fields)
+}
+
 // MarkMatched marks the ReleasePlan as matched to a given ReleasePlanAdmission.
 func (rp *ReleasePlan) MarkMatched(releasePlanAdmission *ReleasePlanAdmission) {
 	rp.setMatchedStatus(releasePlanAdmission, metav1.ConditionTrue)
 }
 
+// MarkTransferPending marks the transfer of the ReleasePlan to the given application as waiting to be accepted.
+func (rp *ReleasePlan) MarkTransferPending(target string) {
+	conditions.SetConditionWithMessage(&rp.Status.Conditions, transferredConditionType, metav1.ConditionFalse,
+		TransferPendingReason, getTransferPendingMessage(target))
+}
+
+// MarkTransferred marks the ReleasePlan as transferred between the given applications, registering the transfer.
+func (rp *ReleasePlan) MarkTransferred(from, to string) {
+	rp.Status.Transfers = append(rp.Status.Transfers, ReleasePlanTransfer{
+		CompletionTime: &metav1.Time{Time: time.Now()},
+		From:           from,
+		To:             to,
+	})
+	conditions.SetConditionWithMessage(&rp.Status.Conditions, transferredConditionType, metav1.ConditionTrue,
+		TransferredReason, fmt.Sprintf("transferred from application %s to %s", from, to))
+}
+
 // MarkUnmatched marks the ReleasePlan as not matched to any ReleasePlanAdmission.
 func (rp *ReleasePlan) MarkUnmatched() {
 	if meta.IsStatusConditionPresentAndEqual(rp.Status.Conditions, MatchedConditionType.String(), metav1.ConditionFalse) {
@@ -113,6 +337,25 @@ func (rp *ReleasePlan) MarkUnmatched() {
 	rp.setMatchedStatus(nil, metav1.ConditionFalse)
 }
 
+// getTransferPendingMessage returns the message of the Transferred condition of a ReleasePlan waiting for its transfer
+// to the given application to be accepted.
+func getTransferPendingMessage(target string) string {
+	return fmt.Sprintf("waiting for the transfer to application %s to be accepted", target)
+}
+
+// getRetriesInWindow returns the automatic retries registered in the ReleasePlan status that are within the current
+// window of its retry budget.
+func (rp *ReleasePlan) getRetriesInWindow() []metav1.Time {
+	var retries []metav1.Time
+	for _, retry := range rp.Status.RetryBudget.Retries {
+		if time.Since(retry.Time) < rp.Spec.RetryBudget.Window.Duration {
+			retries = append(retries, retry)
+		}
+	}
+
+	return retries
+}
+
 // setMatchedStatus sets the ReleasePlan Matched condition based on the passed releasePlanAdmission and status.
 func (rp *ReleasePlan) setMatchedStatus(releasePlanAdmission *ReleasePlanAdmission, status metav1.ConditionStatus) {
 	rp.Status.ReleasePlanAdmission = MatchedReleasePlanAdmission{}
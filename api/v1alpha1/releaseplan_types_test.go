@@ -28,6 +28,170 @@ import (
 )
 
 var _ = Describe("ReleasePlan type", func() {
+	When("ConsumeRetry method is called", func() {
+		var releasePlan *ReleasePlan
+
+		BeforeEach(func() {
+			releasePlan = &ReleasePlan{
+				Spec: ReleasePlanSpec{
+					RetryBudget: &RetryBudget{
+						MaxRetries: 2,
+						Window:     metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+		})
+
+		It("should do nothing if the ReleasePlan has no retry budget", func() {
+			releasePlan.Spec.RetryBudget = nil
+			releasePlan.ConsumeRetry()
+			Expect(releasePlan.Status.RetryBudget.Consumed).To(Equal(0))
+			Expect(releasePlan.Status.RetryBudget.Retries).To(BeEmpty())
+		})
+
+		It("should register the retry", func() {
+			releasePlan.ConsumeRetry()
+			Expect(releasePlan.Status.RetryBudget.Consumed).To(Equal(1))
+			Expect(releasePlan.Status.RetryBudget.Retries).To(HaveLen(1))
+		})
+
+		It("should discard the retries out of the window", func() {
+			releasePlan.Status.RetryBudget.Retries = []metav1.Time{{Time: time.Now().Add(-2 * time.Hour)}}
+			releasePlan.ConsumeRetry()
+			Expect(releasePlan.Status.RetryBudget.Consumed).To(Equal(1))
+			Expect(releasePlan.Status.RetryBudget.Retries).To(HaveLen(1))
+		})
+	})
+
+	When("HasRetryBudgetLeft method is called", func() {
+		var releasePlan *ReleasePlan
+
+		BeforeEach(func() {
+			releasePlan = &ReleasePlan{
+				Spec: ReleasePlanSpec{
+					RetryBudget: &RetryBudget{
+						MaxRetries: 1,
+						Window:     metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+		})
+
+		It("should return true if the ReleasePlan has no retry budget", func() {
+			releasePlan.Spec.RetryBudget = nil
+			Expect(releasePlan.HasRetryBudgetLeft()).To(BeTrue())
+		})
+
+		It("should return true if the budget has not been consumed", func() {
+			Expect(releasePlan.HasRetryBudgetLeft()).To(BeTrue())
+		})
+
+		It("should return false if the budget has been consumed within the window", func() {
+			releasePlan.ConsumeRetry()
+			Expect(releasePlan.HasRetryBudgetLeft()).To(BeFalse())
+		})
+
+		It("should return true if the budget was consumed out of the window", func() {
+			releasePlan.Status.RetryBudget.Retries = []metav1.Time{{Time: time.Now().Add(-2 * time.Hour)}}
+			Expect(releasePlan.HasRetryBudgetLeft()).To(BeTrue())
+		})
+	})
+
+	When("GetTransferTarget method is called", func() {
+		It("should return an empty string if no transfer was requested", func() {
+			releasePlan := &ReleasePlan{}
+			Expect(releasePlan.GetTransferTarget()).To(BeEmpty())
+		})
+
+		It("should return an empty string if the ReleasePlan already belongs to the application", func() {
+			releasePlan := &ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{metadata.TransferToAnnotation: "foo"},
+				},
+				Spec: ReleasePlanSpec{Application: "foo"},
+			}
+			Expect(releasePlan.GetTransferTarget()).To(BeEmpty())
+		})
+
+		It("should return the application the ReleasePlan is being transferred to", func() {
+			releasePlan := &ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{metadata.TransferToAnnotation: "bar"},
+				},
+				Spec: ReleasePlanSpec{Application: "foo"},
+			}
+			Expect(releasePlan.GetTransferTarget()).To(Equal("bar"))
+		})
+	})
+
+	When("IsTransferAccepted method is called", func() {
+		var releasePlan *ReleasePlan
+
+		BeforeEach(func() {
+			releasePlan = &ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{metadata.TransferToAnnotation: "bar"},
+				},
+				Spec: ReleasePlanSpec{Application: "foo"},
+			}
+		})
+
+		It("should return false if the transfer wasn't accepted", func() {
+			Expect(releasePlan.IsTransferAccepted()).To(BeFalse())
+		})
+
+		It("should return false if the transfer was accepted for another application", func() {
+			releasePlan.Annotations[metadata.TransferAcceptedAnnotation] = "baz"
+			Expect(releasePlan.IsTransferAccepted()).To(BeFalse())
+		})
+
+		It("should return true if the transfer was accepted for the application", func() {
+			releasePlan.Annotations[metadata.TransferAcceptedAnnotation] = "bar"
+			Expect(releasePlan.IsTransferAccepted()).To(BeTrue())
+		})
+	})
+
+	When("MarkDeletionBlocked method is called", func() {
+		It("should mark the deletion as blocked by the given Releases", func() {
+			releasePlan := &ReleasePlan{}
+			Expect(releasePlan.IsDeletionBlocked()).To(BeFalse())
+			releasePlan.MarkDeletionBlocked([]string{"foo", "bar"})
+			Expect(releasePlan.IsDeletionBlocked()).To(BeTrue())
+
+			condition := meta.FindStatusCondition(releasePlan.Status.Conditions, deletionBlockedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(ActiveReleasesReason.String()))
+			Expect(condition.Message).To(ContainSubstring("foo, bar"))
+		})
+	})
+
+	When("MarkTransferPending method is called", func() {
+		It("should mark the transfer to the given application as pending", func() {
+			releasePlan := &ReleasePlan{}
+			releasePlan.MarkTransferPending("bar")
+			Expect(releasePlan.IsTransferPending("bar")).To(BeTrue())
+			Expect(releasePlan.IsTransferPending("baz")).To(BeFalse())
+		})
+	})
+
+	When("MarkTransferred method is called", func() {
+		It("should register the transfer and mark the ReleasePlan as transferred", func() {
+			releasePlan := &ReleasePlan{}
+			releasePlan.MarkTransferPending("bar")
+			releasePlan.MarkTransferred("foo", "bar")
+
+			Expect(releasePlan.Status.Transfers).To(HaveLen(1))
+			Expect(releasePlan.Status.Transfers[0].From).To(Equal("foo"))
+			Expect(releasePlan.Status.Transfers[0].To).To(Equal("bar"))
+			Expect(releasePlan.Status.Transfers[0].CompletionTime).NotTo(BeNil())
+			Expect(releasePlan.IsTransferPending("bar")).To(BeFalse())
+
+			condition := meta.FindStatusCondition(releasePlan.Status.Conditions, transferredConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+
 	When("MarkMatched method is called", func() {
 		var releasePlan *ReleasePlan
 		var releasePlanAdmission *ReleasePlanAdmission
@@ -0,0 +1,9 @@
+package v1alpha1
+
+import "github.com/konflux-ci/operator-toolkit/conditions"
+
+const (
+	// pipelineResolvedConditionType is the type used to track whether the managed Pipelines referenced by a
+	// ReleasePlanAdmission can be resolved
+	pipelineResolvedConditionType conditions.ConditionType = "PipelineResolved"
+)
@@ -17,15 +17,22 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
+	"strings"
+	"text/template"
 
 	"github.com/konflux-ci/operator-toolkit/conditions"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
 	"github.com/konflux-ci/release-service/metadata"
 	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/strings/slices"
 )
 
 // ReleasePlanAdmissionSpec defines the desired state of ReleasePlanAdmission.
@@ -34,10 +41,21 @@ type ReleasePlanAdmissionSpec struct {
 	// +required
 	Applications []string `json:"applications"`
 
+	// CatalogPipeline references the managed Pipeline in a ReleasePipelineCatalog, as an alternative to defining it in
+	// the pipeline field, so managed teams can upgrade the Pipeline of many ReleasePlanAdmissions at once
+	// +optional
+	CatalogPipeline *CatalogPipelineReference `json:"catalogPipeline,omitempty"`
+
 	// Collectors is a list of data collectors to be executed as part of the release process
 	// +optional
 	Collectors []Collector `json:"collectors,omitempty"`
 
+	// CreateExecutionNamespace indicates whether the namespace the managed pipelines run in is created when it doesn't
+	// exist. Only namespaces other than the ReleasePlanAdmission namespace are created
+	// +kubebuilder:default:=false
+	// +optional
+	CreateExecutionNamespace bool `json:"createExecutionNamespace,omitempty"`
+
 	// Data is an unstructured key used for providing data for the managed Release Pipeline
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
@@ -48,6 +66,30 @@ type ReleasePlanAdmissionSpec struct {
 	// +optional
 	Environment string `json:"environment,omitempty"`
 
+	// Environments is a list of Environments in the managed namespace where the released Snapshot should be deployed
+	// once the Release succeeds
+	// +kubebuilder:validation:items:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	Environments []string `json:"environments,omitempty"`
+
+	// ExecutionNamespace is a template of the namespace the managed pipelines run in, e.g.
+	// releases-{{ .OriginNamespace }}. The template is rendered with the OriginNamespace of each Release. The managed
+	// pipelines run in the ReleasePlanAdmission namespace if not set
+	// +optional
+	ExecutionNamespace string `json:"executionNamespace,omitempty"`
+
+	// Executor is the name of the backend executing the managed Pipeline. Backends other than the default tekton one
+	// have to be compiled into the service
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	Executor string `json:"executor,omitempty"`
+
+	// ImageAnnotations indicates whether the managed Pipeline should annotate the manifests of the images it releases
+	// with the Release that produced them, passed in the imageAnnotations parameter, so registry queries can identify it
+	// +kubebuilder:default:=false
+	// +optional
+	ImageAnnotations bool `json:"imageAnnotations,omitempty"`
+
 	// Origin references where the release requests should come from
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +required
@@ -57,10 +99,81 @@ type ReleasePlanAdmissionSpec struct {
 	// +optional
 	Pipeline *tektonutils.Pipeline `json:"pipeline,omitempty"`
 
+	// PlatformHints indicates whether the platforms each component image is available for should be passed to the
+	// managed Pipeline in the componentPlatforms parameter
+	// +optional
+	PlatformHints bool `json:"platformHints,omitempty"`
+
 	// Policy to validate before releasing an artifact
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +required
 	Policy string `json:"policy"`
+
+	// Preemption indicates whether urgent Releases are allowed to take the place of the oldest queued normal Release
+	// waiting for another Release of the same application to finish
+	// +kubebuilder:default:=false
+	// +optional
+	Preemption bool `json:"preemption,omitempty"`
+
+	// RegistryCredentials requests short-lived push credentials for the managed Pipeline of each Release, minted by the
+	// credentials broker set in the ReleaseServiceConfig and revoked once the Release finishes
+	// +optional
+	RegistryCredentials *RegistryCredentials `json:"registryCredentials,omitempty"`
+
+	// ReleaseNotes defines the Git repository the release notes of the successful Releases for this
+	// ReleasePlanAdmission are committed to, so teams get a changelog maintained outside the cluster
+	// +optional
+	ReleaseNotes *ReleaseNotesRepository `json:"releaseNotes,omitempty"`
+
+	// ReleaseSchedule restricts when the Releases for this ReleasePlanAdmission are allowed to start. Releases created
+	// outside of the release windows are queued until the next one opens
+	// +optional
+	ReleaseSchedule *ReleaseSchedule `json:"releaseSchedule,omitempty"`
+
+	// RequireChangeRecord indicates whether the Releases for this ReleasePlanAdmission have to reference the change
+	// record approving them in the changeRequest key of their data. Releases without it are rejected on creation
+	// +kubebuilder:default:=false
+	// +optional
+	RequireChangeRecord bool `json:"requireChangeRecord,omitempty"`
+
+	// RequirePinnedImages indicates whether the images of the Snapshot components released for this
+	// ReleasePlanAdmission have to be referenced by digest, so the released content is exactly the one that was tested.
+	// Releases of Snapshots referencing images by tag fail their validation
+	// +kubebuilder:default:=false
+	// +optional
+	RequirePinnedImages bool `json:"requirePinnedImages,omitempty"`
+
+	// RequireTwoPersonReview indicates whether the Releases for this ReleasePlanAdmission have to be approved by a user
+	// other than their author before running the managed Pipeline. Releases wait until they are approved
+	// +kubebuilder:default:=false
+	// +optional
+	RequireTwoPersonReview bool `json:"requireTwoPersonReview,omitempty"`
+
+	// Share is the weight of the origin namespace when scheduling managed Releases in a managed namespace that has
+	// reached the concurrency limit set in the ReleaseServiceConfig. Origins with a higher share get proportionally
+	// more managed pipelines running concurrently
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=1
+	// +optional
+	Share int `json:"share,omitempty"`
+
+	// SkippableTasks is the list of managed Pipeline tasks Releases are allowed to skip by listing them in their
+	// skip-tasks annotation. The tasks to skip are passed to the Pipeline in the skipTasks array parameter, so the
+	// Pipeline has to reference it in the when expressions of those tasks
+	// +optional
+	SkippableTasks []string `json:"skippableTasks,omitempty"`
+
+	// Strategies is a list of named managed Pipelines. Releases selecting one of them by name in their strategy field
+	// run its Pipeline instead of the one defined in the pipeline field
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Strategies []ReleaseStrategy `json:"strategies,omitempty"`
+
+	// Verification defines the Pipeline verifying the released artifacts once the managed Pipeline succeeds. Releases
+	// are only marked as released when the verification passes
+	// +optional
+	Verification *ReleaseVerification `json:"verification,omitempty"`
 }
 
 // MatchedReleasePlan defines the relevant information for a matched ReleasePlan.
@@ -76,6 +189,69 @@ type MatchedReleasePlan struct {
 	Active bool `json:"active,omitempty"`
 }
 
+// RegistryCredentials defines the repositories the registry credentials minted for each Release are scoped to. The
+// credentials are passed to the managed Pipeline in the release-registry-credentials workspace as a .dockerconfigjson
+// file, along with their username and password keys.
+type RegistryCredentials struct {
+	// Repositories is the list of repositories the credentials can push to, e.g. quay.io/org/repo. All of them have
+	// to be hosted in the same registry
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Repositories []string `json:"repositories"`
+}
+
+// ReleaseNotesRepository defines the Git repository release notes are published to. Only repositories hosted in
+// GitHub or GitHub Enterprise are supported.
+type ReleaseNotesRepository struct {
+	// Branch is the branch of the repository the release notes are committed to
+	// +kubebuilder:default:=main
+	// +optional
+	Branch string `json:"branch,omitempty"`
+
+	// Path is the directory of the repository the release notes are committed to. Each Release gets its own file
+	// under a subdirectory named after the released application
+	// +kubebuilder:default:=releases
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Secret is the name of the Secret in the managed namespace holding the token used to commit to the
+	// repository in its token key
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Secret string `json:"secret"`
+
+	// URL is the URL of the repository, e.g. https://github.com/org/repo
+	// +kubebuilder:validation:Pattern=^https?://
+	// +required
+	URL string `json:"url"`
+}
+
+// ReleaseStrategy defines a named managed Pipeline configuration that Releases can select.
+type ReleaseStrategy struct {
+	// Name is the name Releases use to select the strategy
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Name string `json:"name"`
+
+	// Pipeline contains all the information about the managed Pipeline of the strategy
+	// +required
+	Pipeline *tektonutils.Pipeline `json:"pipeline"`
+}
+
+// ReleaseVerification defines the Pipeline verifying the artifacts released by the managed Pipeline, e.g. by running
+// smoke tests against the registry they were pushed to.
+type ReleaseVerification struct {
+	// Pipeline contains all the information about the verification Pipeline
+	// +required
+	Pipeline *tektonutils.Pipeline `json:"pipeline"`
+
+	// Rollback indicates whether a Release of the Snapshot previously released by the ReleasePlan is created when the
+	// verification fails
+	// +kubebuilder:default:=false
+	// +optional
+	Rollback bool `json:"rollback,omitempty"`
+}
+
 // ReleasePlanAdmissionStatus defines the observed state of ReleasePlanAdmission.
 type ReleasePlanAdmissionStatus struct {
 	// Conditions represent the latest available observations for the releasePlanAdmission
@@ -88,7 +264,7 @@ type ReleasePlanAdmissionStatus struct {
 }
 
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:shortName=rpa
+// +kubebuilder:resource:shortName=rpa,categories=appstudio
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
 // +kubebuilder:printcolumn:name="Origin",type=string,JSONPath=`.spec.origin`
@@ -108,6 +284,143 @@ func (rpa *ReleasePlanAdmission) ClearMatchingInfo() {
 	conditions.SetCondition(&rpa.Status.Conditions, MatchedConditionType, metav1.ConditionFalse, MatchedReason)
 }
 
+// GetEnvironments returns the names of the Environments the released Snapshot should be deployed to. The Environment
+// field is included for backwards compatibility, so the returned list contains no duplicates.
+func (rpa *ReleasePlanAdmission) GetEnvironments() []string {
+	var environments []string
+	for _, environment := range append([]string{rpa.Spec.Environment}, rpa.Spec.Environments...) {
+		if environment != "" && !slices.Contains(environments, environment) {
+			environments = append(environments, environment)
+		}
+	}
+
+	return environments
+}
+
+// GetDeprecatedFields returns the deprecated fields set in the ReleasePlanAdmission.
+func (rpa *ReleasePlanAdmission) GetDeprecatedFields() []DeprecatedField {
+	var fields []DeprecatedField
+	if rpa.Spec.Environment != "" {
+		fields = append(fields, DeprecatedField{
+			Field:       "spec.environment",
+			Path:        "spec.environment",
+			Replacement: "spec.environments",
+		})
+	}
+
+	if rpa.Spec.Pipeline != nil {
+		fields = append(fields, getDeprecatedBundleFields(rpa.Spec.Pipeline.PipelineRef,
+			"spec.pipeline", "spec.pipeline")...)
+	}
+	for i, strategy := range rpa.Spec.Strategies {
+		if strategy.Pipeline != nil {
+			fields = append(fields, getDeprecatedBundleFields(strategy.Pipeline.PipelineRef,
+				"spec.strategies.pipeline", fmt.Sprintf("spec.strategies[%d].pipeline", i))...)
+		}
+	}
+	if rpa.Spec.Verification != nil && rpa.Spec.Verification.Pipeline != nil {
+		fields = append(fields, getDeprecatedBundleFields(rpa.Spec.Verification.Pipeline.PipelineRef,
+			"spec.verification.pipeline", "spec.verification.pipeline")...)
+	}
+
+	return fields
+}
+
+// GetExecutionNamespace returns the namespace the managed pipelines of the Releases created in the given origin
+// namespace run in. The ExecutionNamespace template is rendered with the origin namespace, defaulting to the
+// ReleasePlanAdmission namespace if it's not set. An error is returned if the template can't be rendered or the result
+// is not a valid namespace name.
+func (rpa *ReleasePlanAdmission) GetExecutionNamespace(originNamespace string) (string, error) {
+	if rpa.Spec.ExecutionNamespace == "" {
+		return rpa.Namespace, nil
+	}
+
+	executionNamespaceTemplate, err := template.New("executionNamespace").
+		Option("missingkey=error").
+		Parse(rpa.Spec.ExecutionNamespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the execution namespace template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	err = executionNamespaceTemplate.Execute(&buffer, struct{ OriginNamespace string }{originNamespace})
+	if err != nil {
+		return "", fmt.Errorf("failed to render the execution namespace template: %w", err)
+	}
+
+	namespace := buffer.String()
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return "", fmt.Errorf("the execution namespace %q is not a valid namespace name: %s",
+			namespace, strings.Join(errs, ", "))
+	}
+
+	return namespace, nil
+}
+
+// GetPipeline returns the managed Pipeline of the strategy with the given name. If no strategy name is given, the
+// Pipeline defined in the pipeline field is returned. An error is returned if no strategy with the given name exists.
+func (rpa *ReleasePlanAdmission) GetPipeline(strategy string) (*tektonutils.Pipeline, error) {
+	if strategy == "" {
+		return rpa.Spec.Pipeline, nil
+	}
+
+	for _, releaseStrategy := range rpa.Spec.Strategies {
+		if releaseStrategy.Name == strategy {
+			return releaseStrategy.Pipeline, nil
+		}
+	}
+
+	return nil, fmt.Errorf("strategy %s is not defined in the ReleasePlanAdmission %s", strategy, rpa.Name)
+}
+
+// GetShare returns the scheduling share of the ReleasePlanAdmission origin, defaulting to 1 if it's not set.
+func (rpa *ReleasePlanAdmission) GetShare() int {
+	if rpa.Spec.Share < 1 {
+		return 1
+	}
+
+	return rpa.Spec.Share
+}
+
+// HasDeprecatedFieldsReported checks whether the DeprecatedFieldsUsed condition of the ReleasePlanAdmission reports the
+// given deprecated fields.
+func (rpa *ReleasePlanAdmission) HasDeprecatedFieldsReported(fields []DeprecatedField) bool {
+	return isDeprecatedFieldsUsedReported(rpa.Status.Conditions, fields)
+}
+
+// HasPipelineResolutionFinished checks whether the managed Pipelines referenced by the current generation of the
+// ReleasePlanAdmission were already checked.
+func (rpa *ReleasePlanAdmission) HasPipelineResolutionFinished() bool {
+	condition := meta.FindStatusCondition(rpa.Status.Conditions, pipelineResolvedConditionType.String())
+	return condition != nil && condition.ObservedGeneration == rpa.Generation
+}
+
+// IsPipelineResolved checks whether the managed Pipelines referenced by the ReleasePlanAdmission can be resolved.
+func (rpa *ReleasePlanAdmission) IsPipelineResolved() bool {
+	return meta.IsStatusConditionTrue(rpa.Status.Conditions, pipelineResolvedConditionType.String())
+}
+
+// MarkPipelineResolutionFailed marks the managed Pipelines referenced by the current generation of the
+// ReleasePlanAdmission as not resolvable.
+func (rpa *ReleasePlanAdmission) MarkPipelineResolutionFailed(message string) {
+	conditions.SetConditionWithMessage(&rpa.Status.Conditions, pipelineResolvedConditionType, metav1.ConditionFalse,
+		reasons.PipelineResolutionFailed, message)
+	rpa.setPipelineResolutionGeneration()
+}
+
+// MarkPipelineResolved marks the managed Pipelines referenced by the current generation of the ReleasePlanAdmission
+// as resolvable.
+func (rpa *ReleasePlanAdmission) MarkPipelineResolved() {
+	conditions.SetCondition(&rpa.Status.Conditions, pipelineResolvedConditionType, metav1.ConditionTrue, SucceededReason)
+	rpa.setPipelineResolutionGeneration()
+}
+
+// MarkDeprecatedFieldsUsed marks the ReleasePlanAdmission as setting the given deprecated fields, or as no longer
+// setting any if none is given.
+func (rpa *ReleasePlanAdmission) MarkDeprecatedFieldsUsed(fields []DeprecatedField) {
+	setDeprecatedFieldsUsedCondition(&rpa.Status.Conditions, fields)
+}
+
 // MarkMatched marks the ReleasePlanAdmission as matched to a given ReleasePlan.
 func (rpa *ReleasePlanAdmission) MarkMatched(releasePlan *ReleasePlan) {
 	pairedReleasePlan := MatchedReleasePlan{
@@ -124,6 +437,52 @@ func (rpa *ReleasePlanAdmission) MarkMatched(releasePlan *ReleasePlan) {
 	conditions.SetCondition(&rpa.Status.Conditions, MatchedConditionType, metav1.ConditionTrue, MatchedReason)
 }
 
+// ResolveCatalogPipeline resolves the managed Pipeline referenced in the given ReleasePipelineCatalog, setting it as
+// the Pipeline of the ReleasePlanAdmission, and returns it along with its params. If the ReleasePlanAdmission doesn't
+// reference a catalog Pipeline, nil is returned. An error is returned if the Pipeline can't be found in the catalog.
+func (rpa *ReleasePlanAdmission) ResolveCatalogPipeline(catalog *ReleasePipelineCatalog) (*tektonutils.ParameterizedPipeline, error) {
+	if rpa.Spec.CatalogPipeline == nil {
+		return nil, nil
+	}
+
+	pipeline, err := catalog.GetPipeline(rpa.Spec.CatalogPipeline)
+	if err != nil {
+		return nil, err
+	}
+	rpa.Spec.Pipeline = pipeline.Pipeline.DeepCopy()
+
+	return pipeline, nil
+}
+
+// ApplyDefaults applies the given ReleasePlanAdmissionDefaults to the ReleasePlanAdmission. The default data is merged
+// with the data of the ReleasePlanAdmission, whose values take precedence, and the default ServiceAccount and timeouts
+// are set in the Pipelines of the ReleasePlanAdmission not setting them. Catalog Pipelines should be resolved first,
+// so the defaults also apply to them. An error is returned if any of the data can't be merged.
+func (rpa *ReleasePlanAdmission) ApplyDefaults(defaults *ReleasePlanAdmissionDefaults) error {
+	data, err := defaults.applyToData(rpa.Spec.Data)
+	if err != nil {
+		return err
+	}
+	rpa.Spec.Data = data
+
+	defaults.applyToPipeline(rpa.Spec.Pipeline)
+	for i := range rpa.Spec.Strategies {
+		defaults.applyToPipeline(rpa.Spec.Strategies[i].Pipeline)
+	}
+	if rpa.Spec.Verification != nil {
+		defaults.applyToPipeline(rpa.Spec.Verification.Pipeline)
+	}
+
+	return nil
+}
+
+// setPipelineResolutionGeneration records the current generation of the ReleasePlanAdmission in the PipelineResolved
+// condition, so the Pipelines are checked again when the ReleasePlanAdmission changes.
+func (rpa *ReleasePlanAdmission) setPipelineResolutionGeneration() {
+	condition := meta.FindStatusCondition(rpa.Status.Conditions, pipelineResolvedConditionType.String())
+	condition.ObservedGeneration = rpa.Generation
+}
+
 // +kubebuilder:object:root=true
 
 // ReleasePlanAdmissionList contains a list of ReleasePlanAdmission.
@@ -17,15 +17,95 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
 	"github.com/konflux-ci/release-service/metadata"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var _ = Describe("ReleasePlanAdmission type", func() {
+	When("ApplyDefaults method is called", func() {
+		var defaults *ReleasePlanAdmissionDefaults
+
+		BeforeEach(func() {
+			defaults = &ReleasePlanAdmissionDefaults{
+				Spec: ReleasePlanAdmissionDefaultsSpec{
+					Data: &runtime.RawExtension{
+						Raw: []byte(`{"releaseNotes":{"product_name":"foo","product_version":"1"},"sign":{"key":"default"}}`),
+					},
+					ServiceAccountName: "default-sa",
+					Timeouts: tektonv1.TimeoutFields{
+						Pipeline: &metav1.Duration{Duration: 60},
+						Tasks:    &metav1.Duration{Duration: 30},
+					},
+				},
+			}
+		})
+
+		It("should merge the default data with the data of the ReleasePlanAdmission", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				Spec: ReleasePlanAdmissionSpec{
+					Data: &runtime.RawExtension{
+						Raw: []byte(`{"releaseNotes":{"product_version":"2"},"sign":"custom"}`),
+					},
+				},
+			}
+			Expect(releasePlanAdmission.ApplyDefaults(defaults)).To(Succeed())
+			Expect(releasePlanAdmission.Spec.Data.Raw).To(MatchJSON(
+				`{"releaseNotes":{"product_name":"foo","product_version":"2"},"sign":"custom"}`))
+		})
+
+		It("should use the default data if the ReleasePlanAdmission sets none", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{}
+			Expect(releasePlanAdmission.ApplyDefaults(defaults)).To(Succeed())
+			Expect(releasePlanAdmission.Spec.Data.Raw).To(MatchJSON(defaults.Spec.Data.Raw))
+		})
+
+		It("should keep the data of the ReleasePlanAdmission if there is no default data", func() {
+			data := &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+			releasePlanAdmission := &ReleasePlanAdmission{Spec: ReleasePlanAdmissionSpec{Data: data}}
+			defaults.Spec.Data = nil
+			Expect(releasePlanAdmission.ApplyDefaults(defaults)).To(Succeed())
+			Expect(releasePlanAdmission.Spec.Data).To(Equal(data))
+		})
+
+		It("should fail if the data can't be merged", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				Spec: ReleasePlanAdmissionSpec{
+					Data: &runtime.RawExtension{Raw: []byte(`["foo"]`)},
+				},
+			}
+			Expect(releasePlanAdmission.ApplyDefaults(defaults)).NotTo(Succeed())
+		})
+
+		It("should set the default ServiceAccount and timeouts in the Pipelines not setting them", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				Spec: ReleasePlanAdmissionSpec{
+					Pipeline: &tektonutils.Pipeline{
+						ServiceAccountName: "sa",
+						Timeouts:           tektonv1.TimeoutFields{Pipeline: &metav1.Duration{Duration: 120}},
+					},
+					Verification: &ReleaseVerification{
+						Pipeline: &tektonutils.Pipeline{},
+					},
+				},
+			}
+			Expect(releasePlanAdmission.ApplyDefaults(defaults)).To(Succeed())
+			Expect(releasePlanAdmission.Spec.Pipeline.ServiceAccountName).To(Equal("sa"))
+			Expect(releasePlanAdmission.Spec.Pipeline.Timeouts.Pipeline.Duration).To(BeEquivalentTo(120))
+			Expect(releasePlanAdmission.Spec.Pipeline.Timeouts.Tasks).To(Equal(defaults.Spec.Timeouts.Tasks))
+			Expect(releasePlanAdmission.Spec.Pipeline.Timeouts.Finally).To(BeNil())
+			Expect(releasePlanAdmission.Spec.Verification.Pipeline.ServiceAccountName).To(Equal("default-sa"))
+			Expect(releasePlanAdmission.Spec.Verification.Pipeline.Timeouts.Pipeline).To(Equal(defaults.Spec.Timeouts.Pipeline))
+		})
+	})
+
 	When("ClearMatchingInfo method is called", func() {
 		var releasePlan *ReleasePlan
 		var releasePlanAdmission *ReleasePlanAdmission
@@ -67,6 +147,206 @@ var _ = Describe("ReleasePlanAdmission type", func() {
 		})
 	})
 
+	When("GetEnvironments method is called", func() {
+		It("should return nil if no Environments are set", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{}
+			Expect(releasePlanAdmission.GetEnvironments()).To(BeNil())
+		})
+
+		It("should return the Environment and the Environments list without duplicates", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				Spec: ReleasePlanAdmissionSpec{
+					Environment:  "staging",
+					Environments: []string{"production", "staging"},
+				},
+			}
+			Expect(releasePlanAdmission.GetEnvironments()).To(Equal([]string{"staging", "production"}))
+		})
+	})
+
+	When("GetShare method is called", func() {
+		It("should return the share set in the spec", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{Spec: ReleasePlanAdmissionSpec{Share: 3}}
+			Expect(releasePlanAdmission.GetShare()).To(Equal(3))
+		})
+
+		It("should default the share to 1", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{}
+			Expect(releasePlanAdmission.GetShare()).To(Equal(1))
+		})
+	})
+
+	When("GetExecutionNamespace method is called", func() {
+		var releasePlanAdmission *ReleasePlanAdmission
+
+		BeforeEach(func() {
+			releasePlanAdmission = &ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rpa",
+					Namespace: "managed",
+				},
+			}
+		})
+
+		It("should return the ReleasePlanAdmission namespace if no template is set", func() {
+			Expect(releasePlanAdmission.GetExecutionNamespace("tenant")).To(Equal("managed"))
+		})
+
+		It("should render the template with the origin namespace", func() {
+			releasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .OriginNamespace }}"
+			Expect(releasePlanAdmission.GetExecutionNamespace("tenant")).To(Equal("releases-tenant"))
+		})
+
+		It("should fail if the template is malformed", func() {
+			releasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .OriginNamespace"
+			_, err := releasePlanAdmission.GetExecutionNamespace("tenant")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to parse the execution namespace template"))
+		})
+
+		It("should fail if the template references unknown fields", func() {
+			releasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .Foo }}"
+			_, err := releasePlanAdmission.GetExecutionNamespace("tenant")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to render the execution namespace template"))
+		})
+
+		It("should fail if the rendered namespace is not a valid namespace name", func() {
+			releasePlanAdmission.Spec.ExecutionNamespace = "releases_{{ .OriginNamespace }}"
+			_, err := releasePlanAdmission.GetExecutionNamespace("tenant")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a valid namespace name"))
+		})
+	})
+
+	When("GetPipeline method is called", func() {
+		var releasePlanAdmission *ReleasePlanAdmission
+
+		BeforeEach(func() {
+			releasePlanAdmission = &ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rpa",
+				},
+				Spec: ReleasePlanAdmissionSpec{
+					Pipeline: &tektonutils.Pipeline{
+						PipelineRef: tektonutils.PipelineRef{Resolver: "bundles"},
+					},
+					Strategies: []ReleaseStrategy{
+						{
+							Name: "hotfix",
+							Pipeline: &tektonutils.Pipeline{
+								PipelineRef: tektonutils.PipelineRef{Resolver: "git"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return the pipeline field if no strategy is given", func() {
+			pipeline, err := releasePlanAdmission.GetPipeline("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline.PipelineRef.Resolver).To(Equal("bundles"))
+		})
+
+		It("should return the Pipeline of the given strategy", func() {
+			pipeline, err := releasePlanAdmission.GetPipeline("hotfix")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline.PipelineRef.Resolver).To(Equal("git"))
+		})
+
+		It("should fail if the given strategy is not defined", func() {
+			pipeline, err := releasePlanAdmission.GetPipeline("ga")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("strategy ga is not defined in the ReleasePlanAdmission rpa"))
+			Expect(pipeline).To(BeNil())
+		})
+	})
+
+	When("HasPipelineResolutionFinished method is called", func() {
+		var releasePlanAdmission *ReleasePlanAdmission
+
+		BeforeEach(func() {
+			releasePlanAdmission = &ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+			}
+		})
+
+		It("should return false when the Pipelines were not checked", func() {
+			Expect(releasePlanAdmission.HasPipelineResolutionFinished()).To(BeFalse())
+		})
+
+		It("should return true when the Pipelines of the current generation were checked", func() {
+			releasePlanAdmission.MarkPipelineResolutionFailed("")
+			Expect(releasePlanAdmission.HasPipelineResolutionFinished()).To(BeTrue())
+		})
+
+		It("should return false when the Pipelines of a previous generation were checked", func() {
+			releasePlanAdmission.MarkPipelineResolved()
+			releasePlanAdmission.Generation = 2
+			Expect(releasePlanAdmission.HasPipelineResolutionFinished()).To(BeFalse())
+		})
+	})
+
+	When("IsPipelineResolved method is called", func() {
+		var releasePlanAdmission *ReleasePlanAdmission
+
+		BeforeEach(func() {
+			releasePlanAdmission = &ReleasePlanAdmission{}
+		})
+
+		It("should return true when the Pipelines were resolved", func() {
+			releasePlanAdmission.MarkPipelineResolved()
+			Expect(releasePlanAdmission.IsPipelineResolved()).To(BeTrue())
+		})
+
+		It("should return false when the Pipelines failed to resolve", func() {
+			releasePlanAdmission.MarkPipelineResolutionFailed("")
+			Expect(releasePlanAdmission.IsPipelineResolved()).To(BeFalse())
+		})
+
+		It("should return false when the Pipelines were not checked", func() {
+			Expect(releasePlanAdmission.IsPipelineResolved()).To(BeFalse())
+		})
+	})
+
+	When("MarkPipelineResolutionFailed method is called", func() {
+		It("should register the condition with the current generation", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 3,
+				},
+			}
+			releasePlanAdmission.MarkPipelineResolutionFailed("foo")
+
+			condition := meta.FindStatusCondition(releasePlanAdmission.Status.Conditions, pipelineResolvedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Message).To(Equal("foo"))
+			Expect(condition.Reason).To(Equal(reasons.PipelineResolutionFailed.String()))
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.ObservedGeneration).To(Equal(int64(3)))
+		})
+	})
+
+	When("MarkPipelineResolved method is called", func() {
+		It("should register the condition with the current generation", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 3,
+				},
+			}
+			releasePlanAdmission.MarkPipelineResolved()
+
+			condition := meta.FindStatusCondition(releasePlanAdmission.Status.Conditions, pipelineResolvedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(SucceededReason.String()))
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.ObservedGeneration).To(Equal(int64(3)))
+		})
+	})
+
 	When("MarkMatched method is called", func() {
 		var releasePlan *ReleasePlan
 		var releasePlanAdmission *ReleasePlanAdmission
@@ -126,4 +406,63 @@ var _ = Describe("ReleasePlanAdmission type", func() {
 			}))
 		})
 	})
+
+	When("ResolveCatalogPipeline method is called", func() {
+		var releasePipelineCatalog *ReleasePipelineCatalog
+
+		BeforeEach(func() {
+			releasePipelineCatalog = &ReleasePipelineCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "catalog"},
+				Spec: ReleasePipelineCatalogSpec{
+					Pipelines: []CatalogPipeline{
+						{
+							Name:           "push",
+							DefaultVersion: "v1",
+							Versions: []CatalogPipelineVersion{
+								{
+									ParameterizedPipeline: tektonutils.ParameterizedPipeline{
+										Pipeline: tektonutils.Pipeline{
+											PipelineRef: tektonutils.PipelineRef{Resolver: "bundles"},
+										},
+									},
+									Version: "v1",
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return nil if the ReleasePlanAdmission doesn't reference a catalog Pipeline", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{}
+			pipeline, err := releasePlanAdmission.ResolveCatalogPipeline(releasePipelineCatalog)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline).To(BeNil())
+			Expect(releasePlanAdmission.Spec.Pipeline).To(BeNil())
+		})
+
+		It("should set the catalog Pipeline as the Pipeline of the ReleasePlanAdmission", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				Spec: ReleasePlanAdmissionSpec{
+					CatalogPipeline: &CatalogPipelineReference{Catalog: "catalog", Name: "push"},
+				},
+			}
+			pipeline, err := releasePlanAdmission.ResolveCatalogPipeline(releasePipelineCatalog)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline).NotTo(BeNil())
+			Expect(releasePlanAdmission.Spec.Pipeline).To(Equal(&pipeline.Pipeline))
+		})
+
+		It("should return an error if the catalog Pipeline can't be found", func() {
+			releasePlanAdmission := &ReleasePlanAdmission{
+				Spec: ReleasePlanAdmissionSpec{
+					CatalogPipeline: &CatalogPipelineReference{Catalog: "catalog", Name: "foo"},
+				},
+			}
+			_, err := releasePlanAdmission.ResolveCatalogPipeline(releasePipelineCatalog)
+			Expect(err).To(HaveOccurred())
+			Expect(releasePlanAdmission.Spec.Pipeline).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,143 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReleasePlanAdmissionDefaultsResourceName is the name of the ReleasePlanAdmissionDefaults applied to the
+// ReleasePlanAdmissions of its namespace.
+const ReleasePlanAdmissionDefaultsResourceName string = "release-plan-admission-defaults"
+
+// ReleasePlanAdmissionDefaultsSpec defines the desired state of ReleasePlanAdmissionDefaults.
+type ReleasePlanAdmissionDefaultsSpec struct {
+	// Data is an unstructured key used for providing default data for the managed Release Pipeline of every
+	// ReleasePlanAdmission in the namespace. It's merged with the data of each ReleasePlanAdmission, whose values
+	// take precedence
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Data *runtime.RawExtension `json:"data,omitempty"`
+
+	// ServiceAccountName is the ServiceAccount used by the Pipelines of the ReleasePlanAdmissions in the namespace
+	// that don't set one
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// Timeouts defines the Timeouts used by the Pipelines of the ReleasePlanAdmissions in the namespace that don't set
+	// them. Each timeout is applied on its own
+	// +optional
+	Timeouts tektonv1.TimeoutFields `json:"timeouts,omitempty"`
+}
+
+// ReleasePlanAdmissionDefaultsStatus defines the observed state of ReleasePlanAdmissionDefaults.
+type ReleasePlanAdmissionDefaultsStatus struct {
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=releaseplanadmissiondefaults,shortName=rpad,categories=appstudio
+//+kubebuilder:subresource:status
+
+// ReleasePlanAdmissionDefaults is the Schema for the releaseplanadmissiondefaults API
+type ReleasePlanAdmissionDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReleasePlanAdmissionDefaultsSpec   `json:"spec,omitempty"`
+	Status ReleasePlanAdmissionDefaultsStatus `json:"status,omitempty"`
+}
+
+// applyToData returns the given data merged into the default data. Values present in the given data take precedence,
+// except for maps, which are merged.
+func (rpad *ReleasePlanAdmissionDefaults) applyToData(data *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if rpad.Spec.Data == nil || len(rpad.Spec.Data.Raw) == 0 {
+		return data, nil
+	}
+
+	merged := map[string]interface{}{}
+	if err := json.Unmarshal(rpad.Spec.Data.Raw, &merged); err != nil {
+		return nil, err
+	}
+
+	if data != nil && len(data.Raw) > 0 {
+		dataMap := map[string]interface{}{}
+		if err := json.Unmarshal(data.Raw, &dataMap); err != nil {
+			return nil, err
+		}
+		mergeDataMaps(merged, dataMap)
+	}
+
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// applyToPipeline sets the default ServiceAccount and timeouts in the given Pipeline, keeping the ones it sets.
+func (rpad *ReleasePlanAdmissionDefaults) applyToPipeline(pipeline *tektonutils.Pipeline) {
+	if pipeline == nil {
+		return
+	}
+
+	if pipeline.ServiceAccountName == "" {
+		pipeline.ServiceAccountName = rpad.Spec.ServiceAccountName
+	}
+	if pipeline.Timeouts.Pipeline == nil {
+		pipeline.Timeouts.Pipeline = rpad.Spec.Timeouts.Pipeline
+	}
+	if pipeline.Timeouts.Tasks == nil {
+		pipeline.Timeouts.Tasks = rpad.Spec.Timeouts.Tasks
+	}
+	if pipeline.Timeouts.Finally == nil {
+		pipeline.Timeouts.Finally = rpad.Spec.Timeouts.Finally
+	}
+}
+
+// mergeDataMaps recursively copies the values of src into dst, replacing any value already present in dst unless both
+// values are maps, in which case they are merged.
+func mergeDataMaps(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeDataMaps(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// ReleasePlanAdmissionDefaultsList contains a list of ReleasePlanAdmissionDefaults
+type ReleasePlanAdmissionDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReleasePlanAdmissionDefaults `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReleasePlanAdmissionDefaults{}, &ReleasePlanAdmissionDefaultsList{})
+}
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,6 +26,39 @@ const ReleaseServiceConfigResourceName string = "release-service-config"
 
 // ReleaseServiceConfigSpec defines the desired state of ReleaseServiceConfig.
 type ReleaseServiceConfigSpec struct {
+	// BackoffPolicies defines how long the Release Service waits before retrying each category of operations, like
+	// updates rejected because of conflicts or Releases waiting for the services they depend on. Categories without a
+	// policy use their default one
+	// +optional
+	BackoffPolicies []BackoffPolicy `json:"backoffPolicies,omitempty"`
+
+	// ConsoleURL is the URL of the page of a Release in the console, passed to the managed Release Pipelines in the
+	// releaseURL param so their notification tasks can link back to the Release. The {application}, {namespace},
+	// {release} and {workspace} placeholders are replaced with the values of each Release. If not set, no releaseURL
+	// param is passed
+	// +optional
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// CostRates defines the prices used to estimate the compute cost of the Release PipelineRuns. If not set, only the
+	// compute usage of the PipelineRuns is recorded
+	// +optional
+	CostRates *CostRates `json:"costRates,omitempty"`
+
+	// CredentialsBroker defines the service minting the registry credentials of the ReleasePlanAdmissions requesting
+	// them. If not set, Releases for ReleasePlanAdmissions requesting registry credentials fail
+	// +optional
+	CredentialsBroker *CredentialsBroker `json:"credentialsBroker,omitempty"`
+
+	// DataEncryption defines the KMS key used to decrypt the values encrypted in the Release data.
+	// If not set, Releases containing encrypted values fail
+	// +optional
+	DataEncryption *DataEncryption `json:"dataEncryption,omitempty"`
+
+	// Deadlines defines how long each phase of the processing of a Release can take before the Release fails.
+	// If not set, the phases are only limited by the Tekton timeouts of the Release PipelineRuns
+	// +optional
+	Deadlines *ReleaseDeadlines `json:"deadlines,omitempty"`
+
 	// Debug is the boolean that specifies whether or not the Release Service should run
 	// in debug mode
 	// +optional
@@ -33,6 +67,277 @@ type ReleaseServiceConfigSpec struct {
 	// DefaultTimeouts contain the default Tekton timeouts to be used in case they are
 	// not specified in the ReleasePlanAdmission resource.
 	DefaultTimeouts tektonv1.TimeoutFields `json:"defaultTimeouts,omitempty"`
+
+	// DependencyHealthChecks lists the checks run before creating managed Release PipelineRuns to ensure the services
+	// they publish to are healthy. If not set, managed PipelineRuns are created without checking their dependencies
+	// +optional
+	DependencyHealthChecks []DependencyHealthCheck `json:"dependencyHealthChecks,omitempty"`
+
+	// ManagedPipelineSchedulingPolicy defines how managed Release PipelineRuns sharing a managed namespace are scheduled.
+	// If not set, managed PipelineRuns start as soon as their Release is ready
+	// +optional
+	ManagedPipelineSchedulingPolicy *ManagedPipelineSchedulingPolicy `json:"managedPipelineSchedulingPolicy,omitempty"`
+
+	// NodeFailurePolicy defines how managed Release PipelineRuns failing because of the nodes their tasks ran on should
+	// be handled. If not set, such PipelineRuns fail their Release like any other failure
+	// +optional
+	NodeFailurePolicy *NodeFailurePolicy `json:"nodeFailurePolicy,omitempty"`
+
+	// OrphanedPipelineRunPolicy defines how Release PipelineRuns whose Release no longer exists should be handled.
+	// If not set, orphaned PipelineRuns won't be detected
+	// +optional
+	OrphanedPipelineRunPolicy *OrphanedPipelineRunPolicy `json:"orphanedPipelineRunPolicy,omitempty"`
+
+	// PipelineMirror defines the mirror catalog used to resolve Release Pipelines in disconnected clusters.
+	// If not set, Pipelines are resolved from the references set in ReleasePlans and ReleasePlanAdmissions
+	// +optional
+	PipelineMirror *PipelineMirror `json:"pipelineMirror,omitempty"`
+
+	// StalledPipelineRunPolicy defines how Release PipelineRuns that stop progressing should be detected and handled.
+	// If not set, stalled PipelineRuns won't be detected
+	// +optional
+	StalledPipelineRunPolicy *StalledPipelineRunPolicy `json:"stalledPipelineRunPolicy,omitempty"`
+
+	// StatusUpdateInterval is the minimum amount of time between two updates of the status of a Release that don't
+	// change its phase, like the progress of its PipelineRuns or the results of its dependency health checks. Such
+	// updates are batched into the next one allowed. Defaults to 30s
+	// +optional
+	StatusUpdateInterval *metav1.Duration `json:"statusUpdateInterval,omitempty"`
+
+	// WorkspaceUsagePolicy defines how release workspaces running out of space should be detected and handled.
+	// If not set, the usage of release workspaces won't be monitored
+	// +optional
+	WorkspaceUsagePolicy *WorkspaceUsagePolicy `json:"workspaceUsagePolicy,omitempty"`
+}
+
+// BackoffPolicy defines the exponential backoff used to retry a category of operations. The first retry waits for
+// InitialDelay and each following one waits Factor times longer than the previous one, up to MaxDelay. A random jitter
+// is applied to each delay, so operations failing at the same time are not retried at the same time.
+type BackoffPolicy struct {
+	// Category is the category of operations the policy applies to. Conflict applies to Releases whose updates were
+	// rejected because of conflicts, Dependency to Releases and ReleasePlans waiting for the objects and services they
+	// depend on, Notification to the delivery of outbox events and PipelineResolution to the resolution of the
+	// Pipelines of ReleasePlanAdmissions
+	// +kubebuilder:validation:Enum=Conflict;Dependency;Notification;PipelineResolution
+	// +required
+	Category string `json:"category"`
+
+	// Factor is the number each delay is multiplied by to get the next one. Defaults to 2
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Factor int `json:"factor,omitempty"`
+
+	// InitialDelay is the amount of time to wait before the first retry
+	// +required
+	InitialDelay metav1.Duration `json:"initialDelay"`
+
+	// JitterPercent is the maximum percentage of each delay randomly added to or removed from it
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	JitterPercent int `json:"jitterPercent,omitempty"`
+
+	// MaxDelay is the maximum amount of time to wait between retries
+	// +required
+	MaxDelay metav1.Duration `json:"maxDelay"`
+}
+
+// CostRates defines the prices of the compute resources requested by the tasks of the Release PipelineRuns, in the
+// currency platform owners report costs in. The estimated cost of a PipelineRun is the price of the CPU and memory its
+// tasks requested over the time they ran.
+type CostRates struct {
+	// CPUCoreHour is the price of a CPU core requested for an hour
+	// +required
+	CPUCoreHour resource.Quantity `json:"cpuCoreHour"`
+
+	// MemoryGiBHour is the price of a GiB of memory requested for an hour
+	// +required
+	MemoryGiBHour resource.Quantity `json:"memoryGiBHour"`
+}
+
+// GetCost returns the price of the given CPU millicore-seconds and memory MiB-seconds.
+func (r *CostRates) GetCost(cpuMillicoreSeconds, memoryMiBSeconds int64) float64 {
+	cpuCoreHours := float64(cpuMillicoreSeconds) / 1000 / 3600
+	memoryGiBHours := float64(memoryMiBSeconds) / 1024 / 3600
+
+	return cpuCoreHours*r.CPUCoreHour.AsApproximateFloat64() + memoryGiBHours*r.MemoryGiBHour.AsApproximateFloat64()
+}
+
+// CredentialsBroker defines the service the Release Service uses to mint short-lived registry credentials scoped to
+// the repositories a Release pushes to. The credentials are only valid while the Release is being processed, as they
+// are revoked once its managed processing finishes.
+type CredentialsBroker struct {
+	// RevocationURL is the OAuth token revocation endpoint used to revoke the tokens minted by TokenExchange brokers.
+	// If not set, the tokens are left to expire
+	// +kubebuilder:validation:Pattern=^https?://.+$
+	// +optional
+	RevocationURL string `json:"revocationURL,omitempty"`
+
+	// Secret is the name of the Secret in the namespace of the ReleaseServiceConfig holding the token used to
+	// authenticate with the broker in its token key
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Secret string `json:"secret"`
+
+	// Type is the type of the broker. Quay brokers create a robot account with write permissions on the repositories
+	// and TokenExchange brokers exchange the broker token for an OAuth token scoped to the repositories
+	// +kubebuilder:validation:Enum=Quay;TokenExchange
+	// +required
+	Type string `json:"type"`
+
+	// URL is the address of the Quay instance or the OAuth token exchange endpoint
+	// +kubebuilder:validation:Pattern=^https?://.+$
+	// +required
+	URL string `json:"url"`
+}
+
+// DataEncryption defines the KMS key the Release Service uses to decrypt the values encrypted in the Release data.
+// Encrypted values are objects like {"encrypted": "envelope:v1:..."} holding a value encrypted with a data key that is
+// itself encrypted by the KMS key. They are only decrypted when passed to the managed Pipeline, so the plain values
+// are never stored in objects the tenants can read.
+type DataEncryption struct {
+	// KeyRef is the reference to the KMS key, following the cosign conventions (e.g. hashivault://release)
+	// +kubebuilder:validation:Pattern=^[a-z]+://.+$
+	// +required
+	KeyRef string `json:"keyRef"`
+}
+
+// DependencyHealthCheck defines a check of a service the managed Release Pipelines depend on, like the registry the
+// released content is pushed to. Releases don't start their managed Pipeline while any of the checks fails, so they
+// don't fail when the services they publish to are down or under maintenance.
+type DependencyHealthCheck struct {
+	// Name identifies the check. The result of the check is reported in the <Name>Healthy condition of the Releases
+	// +kubebuilder:validation:Pattern=^[A-Z][A-Za-z0-9]*$
+	// +required
+	Name string `json:"name"`
+
+	// Type is the type of the check. HTTP checks succeed if the URL answers with a 2xx status and Registry checks
+	// succeed if the URL is a container registry answering the version check endpoint
+	// +kubebuilder:validation:Enum=HTTP;Registry
+	// +required
+	Type string `json:"type"`
+
+	// URL is the address of the service to check
+	// +kubebuilder:validation:Pattern=^https?://.+$
+	// +required
+	URL string `json:"url"`
+}
+
+// ManagedPipelineSchedulingPolicy defines how the Release Service shares the capacity of a managed namespace among the
+// tenants releasing to it. Once the namespace is saturated, queued Releases are started using weighted fair queuing
+// based on the shares set in the ReleasePlanAdmissions, instead of in the order they were queued.
+type ManagedPipelineSchedulingPolicy struct {
+	// MaxConcurrentPipelineRuns is the maximum number of managed Release PipelineRuns running concurrently in a
+	// managed namespace
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxConcurrentPipelineRuns int `json:"maxConcurrentPipelineRuns"`
+}
+
+// NodeFailurePolicy defines how the Release Service reacts to managed Release PipelineRuns failing because a task Pod
+// was evicted or lost with its node. Such PipelineRuns are created again keeping their tasks away from the failed
+// nodes, so retries don't land on the same broken worker.
+type NodeFailurePolicy struct {
+	// MaxRetries is the number of times a PipelineRun failing because of its nodes will be created again before
+	// failing the Release
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxRetries int `json:"maxRetries"`
+}
+
+// OrphanedPipelineRunAction is the action taken on Release PipelineRuns whose Release no longer exists.
+type OrphanedPipelineRunAction string
+
+const (
+	// OrphanedPipelineRunActionDelete is the action deleting orphaned PipelineRuns
+	OrphanedPipelineRunActionDelete OrphanedPipelineRunAction = "Delete"
+
+	// OrphanedPipelineRunActionFlag is the action labeling orphaned PipelineRuns so they can be reviewed
+	OrphanedPipelineRunActionFlag OrphanedPipelineRunAction = "Flag"
+)
+
+// OrphanedPipelineRunPolicy defines how the Release Service reacts to Release PipelineRuns whose Release no longer
+// exists, e.g. because the tenant namespace was deleted.
+type OrphanedPipelineRunPolicy struct {
+	// Action is the action taken on orphaned PipelineRuns. Delete removes the Release finalizer from them and deletes
+	// them, while Flag only adds the orphaned label to them
+	// +kubebuilder:validation:Enum=Delete;Flag
+	// +required
+	Action OrphanedPipelineRunAction `json:"action"`
+
+	// DryRun indicates whether orphaned PipelineRuns should only be reported instead of being deleted, so the policy
+	// can be evaluated before enabling it. It only takes effect when Action is Delete
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// PipelineMirror defines where the Release Service finds the catalog of Pipelines mirrored for disconnected clusters.
+// When set, every Release Pipeline is replaced by its mirror, a bundle pinned by digest, so no external network calls
+// are needed to resolve it. Releases using Pipelines that are not in the catalog fail.
+type PipelineMirror struct {
+	// ConfigMap is the name of the ConfigMap holding the mirror catalog in its catalog.yaml key. It must exist
+	// in the namespace of the ReleaseServiceConfig
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	ConfigMap string `json:"configMap"`
+}
+
+// ReleaseDeadlines defines the maximum amount of time each phase of the processing of a Release can take, so Releases
+// stuck in one phase fail fast with a reason telling which phase took too long instead of running into the global
+// timeouts.
+type ReleaseDeadlines struct {
+	// Execution is the maximum amount of time the managed Release PipelineRun can run. PipelineRuns running for longer
+	// are cancelled and their Release fails with the Timeout reason
+	// +optional
+	Execution *metav1.Duration `json:"execution,omitempty"`
+
+	// Queue is the maximum amount of time a Release can wait for its managed Release PipelineRun to start once its
+	// tenant pipeline processing finished, e.g. waiting for a release lock or for capacity in the managed namespace.
+	// Releases waiting for longer fail with the QueueTimeout reason
+	// +optional
+	Queue *metav1.Duration `json:"queue,omitempty"`
+
+	// Validation is the maximum amount of time since its creation a Release can take to be validated. Releases whose
+	// validation keeps erroring for longer fail with the ValidationTimeout reason
+	// +optional
+	Validation *metav1.Duration `json:"validation,omitempty"`
+}
+
+// StalledPipelineRunPolicy defines how the Release Service reacts to Release PipelineRuns with no status progress.
+type StalledPipelineRunPolicy struct {
+	// Timeout is the amount of time a Release PipelineRun and its TaskRuns can go without any status progress before
+	// being considered stalled. PipelineRuns with a running TaskRun are not considered stalled
+	// +required
+	Timeout metav1.Duration `json:"timeout"`
+
+	// Cancel indicates whether stalled PipelineRuns should be cancelled
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// MaxRetries is the number of times a stalled PipelineRun will be cancelled and created again before
+	// failing the Release. It only takes effect when Cancel is set
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries int `json:"maxRetries,omitempty"`
+}
+
+// WorkspaceUsagePolicy defines how the Release Service reacts to release workspaces running out of space. The usage of
+// a workspace is read from the workspaceUsage result, a percentage, reported by the tasks of the Release PipelineRuns.
+type WorkspaceUsagePolicy struct {
+	// Threshold is the usage percentage above which a release workspace is considered close to being exhausted
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +required
+	Threshold int `json:"threshold"`
+
+	// Expand indicates whether the claims of the workspaces above the threshold should be expanded, doubling their
+	// size each time. The storage class of the claims has to allow volume expansion
+	// +optional
+	Expand bool `json:"expand,omitempty"`
+
+	// MaxSize is the size the claims won't be expanded beyond. Claims are expanded without limit if not set
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
 }
 
 // ReleaseServiceConfigStatus defines the observed state of ReleaseServiceConfig.
@@ -40,7 +345,7 @@ type ReleaseServiceConfigStatus struct {
 }
 
 //+kubebuilder:object:root=true
-//+kubebuilder:resource:shortName=rsc
+//+kubebuilder:resource:shortName=rsc,categories=appstudio
 //+kubebuilder:subresource:status
 
 // ReleaseServiceConfig is the Schema for the releaseserviceconfigs API
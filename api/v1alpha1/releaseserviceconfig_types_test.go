@@ -0,0 +1,38 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("ReleaseServiceConfig type", func() {
+	When("GetCost method is called", func() {
+		It("should return the price of the CPU and memory usage", func() {
+			costRates := &CostRates{
+				CPUCoreHour:   resource.MustParse("0.04"),
+				MemoryGiBHour: resource.MustParse("0.005"),
+			}
+
+			// Two cores and four GiB of memory for an hour
+			Expect(costRates.GetCost(2*1000*3600, 4*1024*3600)).To(BeNumerically("~", 0.1, 1e-9))
+		})
+	})
+})
@@ -0,0 +1,108 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ValidationCause describes why a resource was rejected or a Release failed its validation, including a hint on how to
+// fix it, so UIs can render actionable errors.
+type ValidationCause struct {
+	// DocsKey is a stable key identifying the documentation section describing the cause
+	// +optional
+	DocsKey string `json:"docsKey,omitempty"`
+
+	// Field is the path of the field causing the error, if any
+	// +optional
+	Field string `json:"field,omitempty"`
+
+	// Hint is a suggestion on how to fix the error
+	// +optional
+	Hint string `json:"hint,omitempty"`
+
+	// Message is the human-readable description of the error
+	// +required
+	Message string `json:"message"`
+
+	// Reason is a machine-readable description of the error, using the Kubernetes cause types when possible
+	// +required
+	Reason metav1.CauseType `json:"reason"`
+}
+
+// String returns the human-readable description of the cause, including the field and hint if any.
+func (c *ValidationCause) String() string {
+	message := c.Message
+	if c.Field != "" {
+		message = fmt.Sprintf("%s: %s", c.Field, message)
+	}
+	if c.Hint != "" {
+		message = fmt.Sprintf("%s (hint: %s)", message, c.Hint)
+	}
+
+	return message
+}
+
+// NewValidationError returns an Invalid error for the resource with the given kind and name containing the given
+// causes. The message of each StatusCause of the error is the JSON representation of the matching ValidationCause, so
+// clients can get the hint and docs key of each cause from the error details.
+func NewValidationError(groupKind schema.GroupKind, name string, causes ...ValidationCause) *errors.StatusError {
+	var messages []string
+	for i := range causes {
+		messages = append(messages, causes[i].String())
+	}
+
+	return &errors.StatusError{ErrStatus: metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   http.StatusUnprocessableEntity,
+		Reason: metav1.StatusReasonInvalid,
+		Details: &metav1.StatusDetails{
+			Group:  groupKind.Group,
+			Kind:   groupKind.Kind,
+			Name:   name,
+			Causes: GetStatusCauses(causes...),
+		},
+		Message: fmt.Sprintf("%s %q is invalid: %s", groupKind.Kind, name, strings.Join(messages, "; ")),
+	}}
+}
+
+// GetStatusCauses returns the StatusCauses matching the given causes. The message of each StatusCause is the JSON
+// representation of the matching ValidationCause.
+func GetStatusCauses(causes ...ValidationCause) []metav1.StatusCause {
+	var statusCauses []metav1.StatusCause
+	for _, cause := range causes {
+		message, err := json.Marshal(cause)
+		if err != nil {
+			message = []byte(cause.String())
+		}
+
+		statusCauses = append(statusCauses, metav1.StatusCause{
+			Type:    cause.Reason,
+			Message: string(message),
+			Field:   cause.Field,
+		})
+	}
+
+	return statusCauses
+}
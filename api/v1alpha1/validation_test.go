@@ -0,0 +1,91 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("ValidationCause type", func() {
+	When("String method is called", func() {
+		It("should return the message if there is no field or hint", func() {
+			cause := &ValidationCause{Message: "foo"}
+			Expect(cause.String()).To(Equal("foo"))
+		})
+
+		It("should include the field and hint if set", func() {
+			cause := &ValidationCause{Field: "spec.foo", Hint: "set foo", Message: "foo is missing"}
+			Expect(cause.String()).To(Equal("spec.foo: foo is missing (hint: set foo)"))
+		})
+	})
+
+	When("NewValidationError function is called", func() {
+		groupKind := schema.GroupKind{Group: "appstudio.redhat.com", Kind: "Release"}
+		cause := ValidationCause{
+			DocsKey: "release.foo",
+			Field:   "spec.foo",
+			Hint:    "set foo",
+			Message: "foo is missing",
+			Reason:  metav1.CauseTypeFieldValueRequired,
+		}
+
+		It("should return an Invalid error", func() {
+			err := NewValidationError(groupKind, "release", cause)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.ErrStatus.Code).To(Equal(int32(http.StatusUnprocessableEntity)))
+			Expect(err.Error()).To(Equal(`Release "release" is invalid: spec.foo: foo is missing (hint: set foo)`))
+		})
+
+		It("should include the causes in the error details", func() {
+			err := NewValidationError(groupKind, "release", cause)
+			Expect(err.ErrStatus.Details.Kind).To(Equal("Release"))
+			Expect(err.ErrStatus.Details.Name).To(Equal("release"))
+			Expect(err.ErrStatus.Details.Causes).To(HaveLen(1))
+		})
+	})
+
+	When("GetStatusCauses function is called", func() {
+		It("should return a StatusCause with the cause encoded in its message", func() {
+			cause := ValidationCause{
+				DocsKey: "release.foo",
+				Field:   "spec.foo",
+				Hint:    "set foo",
+				Message: "foo is missing",
+				Reason:  metav1.CauseTypeFieldValueRequired,
+			}
+			statusCauses := GetStatusCauses(cause)
+			Expect(statusCauses).To(HaveLen(1))
+			Expect(statusCauses[0].Field).To(Equal("spec.foo"))
+			Expect(statusCauses[0].Type).To(Equal(metav1.CauseTypeFieldValueRequired))
+
+			var decoded ValidationCause
+			Expect(json.Unmarshal([]byte(statusCauses[0].Message), &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(cause))
+		})
+
+		It("should return nil if no causes are given", func() {
+			Expect(GetStatusCauses()).To(BeNil())
+		})
+	})
+})
@@ -18,12 +18,16 @@ package author
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/signing"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlWebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -31,21 +35,37 @@ import (
 	"github.com/konflux-ci/release-service/metadata"
 	"github.com/pkg/errors"
 	admissionv1 "k8s.io/api/admission/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+// defaultGitOpsAppliers contains the usernames of the service accounts of the well-known GitOps controllers applying
+// resources on behalf of the users pushing them to Git.
+var defaultGitOpsAppliers = []string{
+	"system:serviceaccount:argocd:argocd-application-controller",
+	"system:serviceaccount:flux-system:helm-controller",
+	"system:serviceaccount:flux-system:kustomize-controller",
+	"system:serviceaccount:openshift-gitops:openshift-gitops-argocd-application-controller",
+}
+
+// emergencyBypassDuration is the time an authorized emergency bypass remains active.
+const emergencyBypassDuration = time.Hour
+
 // Webhook describes the data structure for the author webhook
 type Webhook struct {
-	client client.Client
-	log    logr.Logger
+	client         client.Client
+	gitOpsAppliers map[string]bool
+	log            logr.Logger
+	verifier       signing.Verifier
 }
 
 // Handle creates an admission response for Release and ReleasePlan requests.
 func (w *Webhook) Handle(ctx context.Context, req admission.Request) admission.Response {
 	switch req.Kind.Kind {
 	case "Release":
-		return w.handleRelease(req)
+		return w.handleRelease(ctx, req)
 	case "ReleasePlan":
 		return w.handleReleasePlan(req)
 	default:
@@ -55,21 +75,41 @@ func (w *Webhook) Handle(ctx context.Context, req admission.Request) admission.R
 }
 
 // +kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-author,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releases;releaseplans,verbs=create;update,versions=v1alpha1,name=mauthor.kb.io,admissionReviewVersions=v1
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
-// Register registers the webhook with the passed manager and log.
+// Register registers the webhook with the passed manager and log. The attributions of the Releases created by GitOps
+// appliers are verified with the PEM public key in the file whose path is set in the RELEASE_ATTRIBUTION_PUBLIC_KEY
+// environment variable. The usernames of GitOps appliers other than the well-known ones can be added as a
+// comma-separated list in the RELEASE_GITOPS_APPLIERS environment variable.
 func (w *Webhook) Register(mgr ctrl.Manager, log *logr.Logger) error {
 	w.client = mgr.GetClient()
 	w.log = log.WithName("author")
 
+	w.gitOpsAppliers = make(map[string]bool)
+	for _, applier := range append(defaultGitOpsAppliers, strings.Split(os.Getenv("RELEASE_GITOPS_APPLIERS"), ",")...) {
+		if applier = strings.TrimSpace(applier); applier != "" {
+			w.gitOpsAppliers[applier] = true
+		}
+	}
+
+	if path := os.Getenv("RELEASE_ATTRIBUTION_PUBLIC_KEY"); path != "" {
+		verifier, err := signing.LoadKeyVerifier(path)
+		if err != nil {
+			return err
+		}
+		w.verifier = verifier
+	}
+
 	mgr.GetWebhookServer().Register("/mutate-appstudio-redhat-com-v1alpha1-author", &ctrlWebhook.Admission{Handler: w})
 
 	return nil
 }
 
 // handleRelease takes an incoming admission request and returns an admission response. Create requests
-// add an author label with the current user. Update requests are rejected if the author label is being
-// modified. All other requests are accepted without action.
-func (w *Webhook) handleRelease(req admission.Request) admission.Response {
+// add an author label with the current user, or with the attributed author for Releases created by GitOps
+// appliers. Update requests are rejected if the author label is being modified. Emergency bypasses and
+// approvals requested in both kinds of requests are reviewed. All other requests are accepted without action.
+func (w *Webhook) handleRelease(ctx context.Context, req admission.Request) admission.Response {
 	release := &v1alpha1.Release{}
 	err := json.Unmarshal(req.Object.Raw, release)
 	if err != nil {
@@ -79,7 +119,30 @@ func (w *Webhook) handleRelease(req admission.Request) admission.Response {
 	switch req.AdmissionRequest.Operation {
 	case admissionv1.Create:
 		if release.GetLabels()[metadata.AutomatedLabel] != "true" {
-			w.setAuthorLabel(req.UserInfo.Username, release)
+			author, err := w.getReleaseAuthor(req, release)
+			if err != nil {
+				status := v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("Release").GroupKind(), release.Name,
+					v1alpha1.ValidationCause{
+						DocsKey: "release.attribution-signature",
+						Field:   fmt.Sprintf("metadata.annotations[%s]", metadata.AttributionSignatureAnnotation),
+						Hint: fmt.Sprintf("sign '%s' with the private key matching the attribution public key",
+							getAttributionPayload(req.Namespace, release)),
+						Message: fmt.Sprintf("release attribution could not be verified: %v", err),
+						Reason:  metav1.CauseTypeFieldValueInvalid,
+					}).Status()
+
+				return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{Allowed: false, Result: &status}}
+			}
+
+			w.setAuthorLabel(author, release)
+		}
+
+		if _, rsp := w.reviewEmergencyBypass(ctx, req, release, &v1alpha1.Release{}); rsp != nil {
+			return *rsp
+		}
+
+		if _, rsp := w.reviewApproval(req, release, &v1alpha1.Release{}); rsp != nil {
+			return *rsp
 		}
 
 		return w.patchResponse(req.Object.Raw, release)
@@ -91,7 +154,30 @@ func (w *Webhook) handleRelease(req admission.Request) admission.Response {
 		}
 
 		if release.GetLabels()[metadata.AuthorLabel] != oldRelease.GetLabels()[metadata.AuthorLabel] {
-			return admission.Errored(http.StatusBadRequest, errors.New("release author label cannnot be updated"))
+			status := v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("Release").GroupKind(), release.Name,
+				v1alpha1.ValidationCause{
+					DocsKey: "release.author-label",
+					Field:   fmt.Sprintf("metadata.labels[%s]", metadata.AuthorLabel),
+					Hint:    "the author is set when the Release is created, create a new Release to change it",
+					Message: "release author label cannot be updated",
+					Reason:  metav1.CauseTypeForbidden,
+				}).Status()
+
+			return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{Allowed: false, Result: &status}}
+		}
+
+		bypassModified, rsp := w.reviewEmergencyBypass(ctx, req, release, oldRelease)
+		if rsp != nil {
+			return *rsp
+		}
+
+		approvalModified, rsp := w.reviewApproval(req, release, oldRelease)
+		if rsp != nil {
+			return *rsp
+		}
+
+		if bypassModified || approvalModified {
+			return w.patchResponse(req.Object.Raw, release)
 		}
 	}
 	return admission.Allowed("Success")
@@ -139,6 +225,188 @@ func (w *Webhook) handleReleasePlan(req admission.Request) admission.Response {
 	return w.patchResponse(req.Object.Raw, releasePlan)
 }
 
+// getReleaseAuthor returns the author of the Release created in the given admission request. Releases created by GitOps
+// appliers are attributed to the user in their attributed author annotation, as long as an attribution public key is
+// configured. An error is returned if the signature of that attribution can't be verified. Releases created by GitOps
+// appliers without an attributed author are attributed to the applier, and any other Release to the user creating it.
+func (w *Webhook) getReleaseAuthor(req admission.Request, release *v1alpha1.Release) (string, error) {
+	author, found := release.GetAnnotations()[metadata.AttributedAuthorAnnotation]
+	if !found || w.verifier == nil || !w.gitOpsAppliers[req.UserInfo.Username] {
+		return req.UserInfo.Username, nil
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(release.GetAnnotations()[metadata.AttributionSignatureAnnotation])
+	if err != nil {
+		return "", errors.Wrap(err, "error decoding signature")
+	}
+
+	err = w.verifier.Verify([]byte(getAttributionPayload(req.Namespace, release)), signature)
+	if err != nil {
+		return "", err
+	}
+
+	return author, nil
+}
+
+// getAttributionPayload returns the payload signed to attribute the given Release to the user in its attributed author
+// annotation. It binds the attribution to the namespace, ReleasePlan and Snapshot of the Release, so the signature
+// can't be reused for Releases of other Snapshots.
+func getAttributionPayload(namespace string, release *v1alpha1.Release) string {
+	return fmt.Sprintf("%s/%s/%s/%s", namespace, release.Spec.ReleasePlan, release.Spec.Snapshot,
+		release.GetAnnotations()[metadata.AttributedAuthorAnnotation])
+}
+
+// reviewEmergencyBypass reviews the emergency bypass requested in the given Release, comparing it with the given old
+// Release. Bypasses are requested by setting a justification in the emergency bypass annotation, and they are only
+// authorized if the user making the request is allowed to bypass Releases in their namespace. Authorized bypasses are
+// stamped with the user authorizing them and their expiration. Those annotations are dropped when the justification is
+// removed and can't be changed manually. It returns whether the Release was modified and, if the request has to be
+// denied, the response denying it.
+func (w *Webhook) reviewEmergencyBypass(ctx context.Context, req admission.Request, release, oldRelease *v1alpha1.Release) (bool, *admission.Response) {
+	annotations := release.GetAnnotations()
+	oldAnnotations := oldRelease.GetAnnotations()
+
+	justification, found := annotations[metadata.EmergencyBypassAnnotation]
+	if !found {
+		_, hasUser := annotations[metadata.EmergencyBypassUserAnnotation]
+		_, hasExpiration := annotations[metadata.EmergencyBypassExpirationAnnotation]
+		delete(annotations, metadata.EmergencyBypassUserAnnotation)
+		delete(annotations, metadata.EmergencyBypassExpirationAnnotation)
+
+		return hasUser || hasExpiration, nil
+	}
+
+	if justification == oldAnnotations[metadata.EmergencyBypassAnnotation] {
+		if annotations[metadata.EmergencyBypassUserAnnotation] != oldAnnotations[metadata.EmergencyBypassUserAnnotation] ||
+			annotations[metadata.EmergencyBypassExpirationAnnotation] != oldAnnotations[metadata.EmergencyBypassExpirationAnnotation] {
+			return false, w.denyEmergencyBypass(release, metav1.CauseTypeForbidden,
+				"the emergency bypass user and expiration annotations cannot be updated",
+				"update the justification of the emergency bypass to renew it")
+		}
+
+		return false, nil
+	}
+
+	if strings.TrimSpace(justification) == "" {
+		return false, w.denyEmergencyBypass(release, metav1.CauseTypeFieldValueRequired,
+			"emergency bypasses require a justification",
+			"describe the emergency requiring the bypass in the annotation")
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue)
+	for key, value := range req.UserInfo.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	subjectAccessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			Extra:  extra,
+			Groups: req.UserInfo.Groups,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:     v1alpha1.GroupVersion.Group,
+				Name:      release.Name,
+				Namespace: req.Namespace,
+				Resource:  "releases",
+				Verb:      "bypass",
+			},
+			UID:  req.UserInfo.UID,
+			User: req.UserInfo.Username,
+		},
+	}
+	err := w.client.Create(ctx, subjectAccessReview)
+	if err != nil {
+		rsp := admission.Errored(http.StatusInternalServerError, errors.Wrap(err, "error reviewing emergency bypass"))
+		return false, &rsp
+	}
+
+	if !subjectAccessReview.Status.Allowed {
+		return false, w.denyEmergencyBypass(release, metav1.CauseTypeForbidden,
+			fmt.Sprintf("user %s is not allowed to bypass releases", req.UserInfo.Username),
+			"ask an administrator to bind the release-emergency-bypass-role to the user")
+	}
+
+	annotations[metadata.EmergencyBypassUserAnnotation] = req.UserInfo.Username
+	annotations[metadata.EmergencyBypassExpirationAnnotation] = time.Now().Add(emergencyBypassDuration).UTC().Format(time.RFC3339)
+	w.log.Info("Emergency bypass authorized", "Release.Name", release.Name, "Release.Namespace", req.Namespace,
+		"User", req.UserInfo.Username, "Justification", justification)
+
+	return true, nil
+}
+
+// reviewApproval reviews the approval requested in the given Release, comparing it with the given old Release.
+// Approvals are requested by setting the approved annotation to true, and they are only accepted if the user making
+// the request is not the author of the Release, so a second person reviews it. GitOps appliers can't approve Releases
+// as they act on behalf of other users. Accepted approvals are stamped with the user approving them. That annotation
+// is dropped when the approval is removed and can't be changed manually. It returns whether the Release was modified
+// and, if the request has to be denied, the response denying it.
+func (w *Webhook) reviewApproval(req admission.Request, release, oldRelease *v1alpha1.Release) (bool, *admission.Response) {
+	annotations := release.GetAnnotations()
+	oldAnnotations := oldRelease.GetAnnotations()
+
+	if annotations[metadata.ApprovedAnnotation] != "true" {
+		_, hasApprover := annotations[metadata.ApprovedByAnnotation]
+		delete(annotations, metadata.ApprovedByAnnotation)
+
+		return hasApprover, nil
+	}
+
+	if oldAnnotations[metadata.ApprovedAnnotation] == "true" {
+		if annotations[metadata.ApprovedByAnnotation] != oldAnnotations[metadata.ApprovedByAnnotation] {
+			return false, w.denyApproval(release, metav1.CauseTypeForbidden,
+				"the approved-by annotation cannot be updated",
+				"remove the approved annotation and set it again to approve the Release as another user")
+		}
+
+		return false, nil
+	}
+
+	if w.gitOpsAppliers[req.UserInfo.Username] {
+		return false, w.denyApproval(release, metav1.CauseTypeForbidden,
+			fmt.Sprintf("user %s applies resources on behalf of other users and can't approve releases", req.UserInfo.Username),
+			"approve the Release directly in the cluster")
+	}
+
+	approver := w.sanitizeLabelValue(req.UserInfo.Username)
+	if approver == release.GetLabels()[metadata.AuthorLabel] {
+		return false, w.denyApproval(release, metav1.CauseTypeForbidden,
+			fmt.Sprintf("user %s can't approve their own release", req.UserInfo.Username),
+			"ask a user other than the author of the Release to approve it")
+	}
+
+	annotations[metadata.ApprovedByAnnotation] = approver
+	w.log.Info("Release approved", "Release.Name", release.Name, "Release.Namespace", req.Namespace,
+		"User", req.UserInfo.Username)
+
+	return true, nil
+}
+
+// denyApproval returns an admission response denying the approval requested in the given Release.
+func (w *Webhook) denyApproval(release *v1alpha1.Release, reason metav1.CauseType, message, hint string) *admission.Response {
+	status := v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("Release").GroupKind(), release.Name,
+		v1alpha1.ValidationCause{
+			DocsKey: "release.approval",
+			Field:   fmt.Sprintf("metadata.annotations[%s]", metadata.ApprovedAnnotation),
+			Hint:    hint,
+			Message: message,
+			Reason:  reason,
+		}).Status()
+
+	return &admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{Allowed: false, Result: &status}}
+}
+
+// denyEmergencyBypass returns an admission response denying the emergency bypass requested in the given Release.
+func (w *Webhook) denyEmergencyBypass(release *v1alpha1.Release, reason metav1.CauseType, message, hint string) *admission.Response {
+	status := v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("Release").GroupKind(), release.Name,
+		v1alpha1.ValidationCause{
+			DocsKey: "release.emergency-bypass",
+			Field:   fmt.Sprintf("metadata.annotations[%s]", metadata.EmergencyBypassAnnotation),
+			Hint:    hint,
+			Message: message,
+			Reason:  reason,
+		}).Status()
+
+	return &admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{Allowed: false, Result: &status}}
+}
+
 // patchResponse returns an admission response that patches the passed raw object to be the passed object.
 func (w *Webhook) patchResponse(raw []byte, object client.Object) admission.Response {
 	marshalledObject, err := json.Marshal(object)
@@ -16,10 +16,16 @@
 package author
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/signing"
 
 	"github.com/konflux-ci/release-service/metadata"
 	. "github.com/onsi/ginkgo/v2"
@@ -28,6 +34,7 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	//+kubebuilder:scaffold:imports
 )
@@ -145,6 +152,88 @@ var _ = Describe("Author webhook", Ordered, func() {
 			})
 		})
 
+		When("a Release is created by a GitOps applier", func() {
+			var signer signing.Signer
+
+			BeforeAll(func() {
+				admissionRequest.AdmissionRequest.Operation = admissionv1.Create
+
+				key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				signer, err = signing.NewKeySigner(key)
+				Expect(err).NotTo(HaveOccurred())
+				webhook.verifier, err = signing.NewKeyVerifier(key.Public())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterAll(func() {
+				admissionRequest.Namespace = ""
+				admissionRequest.UserInfo.Username = "admin"
+				webhook.verifier = nil
+			})
+
+			BeforeEach(func() {
+				admissionRequest.Namespace = "default"
+				admissionRequest.UserInfo.Username = defaultGitOpsAppliers[0]
+			})
+
+			It("should attribute the Release to the author in the annotation if its signature is valid", func() {
+				release.Annotations = map[string]string{metadata.AttributedAuthorAnnotation: "user"}
+				signature, err := signer.Sign(ctx, []byte(getAttributionPayload("default", release)))
+				Expect(err).NotTo(HaveOccurred())
+				release.Annotations[metadata.AttributionSignatureAnnotation] = base64.StdEncoding.EncodeToString(signature)
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeTrue())
+				Expect(len(rsp.Patches)).To(Equal(1))
+				Expect(rsp.Patches[0].Value).To(Equal(map[string]interface{}{
+					metadata.AuthorLabel: "user",
+				}))
+			})
+
+			It("should deny the Release if the signature of its attribution is not valid", func() {
+				signature, err := signer.Sign(ctx, []byte(getAttributionPayload("default", release)))
+				Expect(err).NotTo(HaveOccurred())
+				release.Annotations = map[string]string{
+					metadata.AttributedAuthorAnnotation:     "user",
+					metadata.AttributionSignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+				}
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("attribution could not be verified"))
+			})
+
+			It("should attribute the Release to the applier if it has no attributed author", func() {
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeTrue())
+				Expect(len(rsp.Patches)).To(Equal(1))
+				Expect(rsp.Patches[0].Value).To(Equal(map[string]interface{}{
+					metadata.AuthorLabel: webhook.sanitizeLabelValue(defaultGitOpsAppliers[0]),
+				}))
+			})
+
+			It("should ignore the attributed author of Releases not created by GitOps appliers", func() {
+				admissionRequest.UserInfo.Username = "admin"
+				release.Annotations = map[string]string{metadata.AttributedAuthorAnnotation: "user"}
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeTrue())
+				Expect(len(rsp.Patches)).To(Equal(1))
+				Expect(rsp.Patches[0].Value).To(Equal(map[string]interface{}{
+					metadata.AuthorLabel: "admin",
+				}))
+			})
+		})
+
 		When("a Release is updated", func() {
 			BeforeAll(func() {
 				admissionRequest.AdmissionRequest.Operation = admissionv1.Update
@@ -212,10 +301,234 @@ var _ = Describe("Author webhook", Ordered, func() {
 
 				rsp := webhook.Handle(ctx, admissionRequest)
 				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
-				Expect(rsp.AdmissionResponse.Result).To(Equal(&metav1.Status{
-					Code:    http.StatusBadRequest,
-					Message: "release author label cannnot be updated",
-				}))
+				Expect(rsp.AdmissionResponse.Result.Code).To(Equal(int32(http.StatusUnprocessableEntity)))
+				Expect(rsp.AdmissionResponse.Result.Reason).To(Equal(metav1.StatusReasonInvalid))
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("release author label cannot be updated"))
+				Expect(rsp.AdmissionResponse.Result.Details.Causes).To(HaveLen(1))
+				Expect(rsp.AdmissionResponse.Result.Details.Causes[0].Field).To(Equal(
+					fmt.Sprintf("metadata.labels[%s]", metadata.AuthorLabel)))
+				Expect(rsp.AdmissionResponse.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeForbidden))
+			})
+		})
+
+		When("an emergency bypass is requested", func() {
+			var role *rbacv1.Role
+			var roleBinding *rbacv1.RoleBinding
+
+			BeforeAll(func() {
+				admissionRequest.AdmissionRequest.Operation = admissionv1.Update
+				admissionRequest.Namespace = "default"
+
+				role = &rbacv1.Role{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "emergency-bypass",
+						Namespace: "default",
+					},
+					Rules: []rbacv1.PolicyRule{
+						{
+							APIGroups: []string{v1alpha1.GroupVersion.Group},
+							Resources: []string{"releases"},
+							Verbs:     []string{"bypass"},
+						},
+					},
+				}
+				roleBinding = &rbacv1.RoleBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "emergency-bypass",
+						Namespace: "default",
+					},
+					RoleRef: rbacv1.RoleRef{
+						APIGroup: rbacv1.GroupName,
+						Kind:     "Role",
+						Name:     role.Name,
+					},
+					Subjects: []rbacv1.Subject{
+						{
+							APIGroup: rbacv1.GroupName,
+							Kind:     rbacv1.UserKind,
+							Name:     "admin",
+						},
+					},
+				}
+			})
+
+			AfterAll(func() {
+				admissionRequest.Namespace = ""
+				_ = k8sClient.Delete(ctx, roleBinding)
+				_ = k8sClient.Delete(ctx, role)
+			})
+
+			requestBypass := func(justification string) admission.Response {
+				oldRelease := release.DeepCopy()
+				release.Annotations = map[string]string{
+					metadata.EmergencyBypassAnnotation: justification,
+				}
+
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+				admissionRequest.OldObject.Raw, err = json.Marshal(oldRelease)
+				Expect(err).NotTo(HaveOccurred())
+
+				return webhook.Handle(ctx, admissionRequest)
+			}
+
+			It("should deny the bypass if the user is not allowed to bypass Releases", func() {
+				rsp := requestBypass("production outage")
+				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("user admin is not allowed to bypass releases"))
+				Expect(rsp.AdmissionResponse.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeForbidden))
+			})
+
+			It("should deny the bypass if it has no justification", func() {
+				rsp := requestBypass(" ")
+				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("emergency bypasses require a justification"))
+				Expect(rsp.AdmissionResponse.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueRequired))
+			})
+
+			It("should authorize the bypass if the user is allowed to bypass Releases", func() {
+				Expect(k8sClient.Create(ctx, role)).To(Succeed())
+				Expect(k8sClient.Create(ctx, roleBinding)).To(Succeed())
+
+				Eventually(func() bool {
+					return requestBypass("production outage").AdmissionResponse.Allowed
+				}).Should(BeTrue())
+
+				rsp := requestBypass("production outage")
+				Expect(rsp.Patches).NotTo(BeEmpty())
+				values := make([]interface{}, len(rsp.Patches))
+				for i, patch := range rsp.Patches {
+					values[i] = patch.Value
+				}
+				Expect(values).To(ContainElement("admin"))
+			})
+
+			It("should not allow the bypass annotations to be updated manually", func() {
+				release.Annotations = map[string]string{
+					metadata.EmergencyBypassAnnotation:           "production outage",
+					metadata.EmergencyBypassExpirationAnnotation: "2024-01-01T00:00:00Z",
+					metadata.EmergencyBypassUserAnnotation:       "admin",
+				}
+				oldRelease := release.DeepCopy()
+				release.Annotations[metadata.EmergencyBypassExpirationAnnotation] = "2099-01-01T00:00:00Z"
+
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+				admissionRequest.OldObject.Raw, err = json.Marshal(oldRelease)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("cannot be updated"))
+			})
+
+			It("should drop the bypass annotations when the justification is removed", func() {
+				oldRelease := release.DeepCopy()
+				oldRelease.Annotations = map[string]string{
+					metadata.EmergencyBypassAnnotation:           "production outage",
+					metadata.EmergencyBypassExpirationAnnotation: "2024-01-01T00:00:00Z",
+					metadata.EmergencyBypassUserAnnotation:       "admin",
+				}
+				release.Annotations = map[string]string{
+					metadata.EmergencyBypassExpirationAnnotation: "2024-01-01T00:00:00Z",
+					metadata.EmergencyBypassUserAnnotation:       "admin",
+				}
+
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+				admissionRequest.OldObject.Raw, err = json.Marshal(oldRelease)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeTrue())
+				Expect(rsp.Patches).NotTo(BeEmpty())
+			})
+		})
+
+		When("an approval is requested", func() {
+			BeforeAll(func() {
+				admissionRequest.AdmissionRequest.Operation = admissionv1.Update
+			})
+
+			AfterEach(func() {
+				admissionRequest.UserInfo.Username = "admin"
+			})
+
+			requestApproval := func(author string) admission.Response {
+				release.Labels = map[string]string{metadata.AuthorLabel: author}
+				oldRelease := release.DeepCopy()
+				release.Annotations = map[string]string{metadata.ApprovedAnnotation: "true"}
+
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+				admissionRequest.OldObject.Raw, err = json.Marshal(oldRelease)
+				Expect(err).NotTo(HaveOccurred())
+
+				return webhook.Handle(ctx, admissionRequest)
+			}
+
+			It("should deny the approval if the user is the author of the Release", func() {
+				rsp := requestApproval("admin")
+				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("user admin can't approve their own release"))
+				Expect(rsp.AdmissionResponse.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeForbidden))
+			})
+
+			It("should deny the approval if the user is a GitOps applier", func() {
+				admissionRequest.UserInfo.Username = defaultGitOpsAppliers[0]
+
+				rsp := requestApproval("user")
+				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("can't approve releases"))
+			})
+
+			It("should stamp the approver if the user is not the author of the Release", func() {
+				rsp := requestApproval("user")
+				Expect(rsp.AdmissionResponse.Allowed).To(BeTrue())
+				Expect(rsp.Patches).NotTo(BeEmpty())
+				values := make([]interface{}, len(rsp.Patches))
+				for i, patch := range rsp.Patches {
+					values[i] = patch.Value
+				}
+				Expect(values).To(ContainElement("admin"))
+			})
+
+			It("should not allow the approved-by annotation to be updated manually", func() {
+				release.Annotations = map[string]string{
+					metadata.ApprovedAnnotation:   "true",
+					metadata.ApprovedByAnnotation: "admin",
+				}
+				oldRelease := release.DeepCopy()
+				release.Annotations[metadata.ApprovedByAnnotation] = "user"
+
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+				admissionRequest.OldObject.Raw, err = json.Marshal(oldRelease)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeFalse())
+				Expect(rsp.AdmissionResponse.Result.Message).To(ContainSubstring("cannot be updated"))
+			})
+
+			It("should drop the approved-by annotation when the approval is removed", func() {
+				oldRelease := release.DeepCopy()
+				oldRelease.Annotations = map[string]string{
+					metadata.ApprovedAnnotation:   "true",
+					metadata.ApprovedByAnnotation: "user",
+				}
+				release.Annotations = map[string]string{
+					metadata.ApprovedByAnnotation: "user",
+				}
+
+				admissionRequest.Object.Raw, err = json.Marshal(release)
+				Expect(err).NotTo(HaveOccurred())
+				admissionRequest.OldObject.Raw, err = json.Marshal(oldRelease)
+				Expect(err).NotTo(HaveOccurred())
+
+				rsp := webhook.Handle(ctx, admissionRequest)
+				Expect(rsp.AdmissionResponse.Allowed).To(BeTrue())
+				Expect(rsp.Patches).NotTo(BeEmpty())
 			})
 		})
 	})
@@ -0,0 +1,110 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// creationRateLimitWindow is the window in which the Release creations of each user are counted.
+const creationRateLimitWindow = time.Minute
+
+// creationRateLimiter limits the number of Releases each user can create per window, so runaway automation can't
+// flood the shared managed infrastructure. The creations are counted in fixed windows starting with the first
+// creation of each user. The counts are kept in memory, so every replica of the webhook enforces the limit on its own.
+type creationRateLimiter struct {
+	exemptUsers  map[string]bool
+	exemptPrefix string
+	limit        int
+	mutex        sync.Mutex
+	now          func() time.Time
+	windows      map[string]*creationWindow
+}
+
+// creationWindow contains the number of Releases a user created since the window started.
+type creationWindow struct {
+	count int
+	start time.Time
+}
+
+// newCreationRateLimiter creates a new creationRateLimiter allowing each user to create the given number of Releases
+// per window. The given users, as well as the service accounts of the given service namespace, are never limited.
+func newCreationRateLimiter(limit int, exemptUsers []string, serviceNamespace string) *creationRateLimiter {
+	limiter := &creationRateLimiter{
+		exemptUsers: make(map[string]bool),
+		limit:       limit,
+		now:         time.Now,
+		windows:     make(map[string]*creationWindow),
+	}
+
+	for _, user := range exemptUsers {
+		if user = strings.TrimSpace(user); user != "" {
+			limiter.exemptUsers[user] = true
+		}
+	}
+
+	if serviceNamespace != "" {
+		limiter.exemptPrefix = fmt.Sprintf("system:serviceaccount:%s:", serviceNamespace)
+	}
+
+	return limiter
+}
+
+// Allow records a Release creation by the given user, returning whether it's within the limit. If it's not, the
+// creation is not recorded and the time until the user can create Releases again is returned as well.
+func (l *creationRateLimiter) Allow(user string) (bool, time.Duration) {
+	if l.limit <= 0 || l.isExempt(user) {
+		return true, 0
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.removeExpiredWindows(now)
+
+	window, found := l.windows[user]
+	if !found {
+		window = &creationWindow{start: now}
+		l.windows[user] = window
+	}
+
+	if window.count >= l.limit {
+		return false, window.start.Add(creationRateLimitWindow).Sub(now)
+	}
+	window.count++
+
+	return true, 0
+}
+
+// isExempt checks whether the Release creations of the given user are never limited.
+func (l *creationRateLimiter) isExempt(user string) bool {
+	return l.exemptUsers[user] || (l.exemptPrefix != "" && strings.HasPrefix(user, l.exemptPrefix))
+}
+
+// removeExpiredWindows removes the windows that ended before the given time, so users that stopped creating Releases
+// are not kept in memory.
+func (l *creationRateLimiter) removeExpiredWindows(now time.Time) {
+	for user, window := range l.windows {
+		if !now.Before(window.start.Add(creationRateLimitWindow)) {
+			delete(l.windows, user)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Release creation rate limiter", func() {
+	var now time.Time
+	var limiter *creationRateLimiter
+
+	BeforeEach(func() {
+		now = time.Now()
+		limiter = newCreationRateLimiter(2, []string{"admin", " "}, "release-service")
+		limiter.now = func() time.Time {
+			return now
+		}
+	})
+
+	When("Allow method is called", func() {
+		It("should allow every creation if there is no limit", func() {
+			limiter.limit = 0
+			for i := 0; i < 5; i++ {
+				allowed, _ := limiter.Allow("user")
+				Expect(allowed).To(BeTrue())
+			}
+		})
+
+		It("should reject the creations exceeding the limit until the window ends", func() {
+			Expect(limiter.Allow("user")).To(BeTrue())
+			now = now.Add(20 * time.Second)
+			Expect(limiter.Allow("user")).To(BeTrue())
+
+			allowed, retryAfter := limiter.Allow("user")
+			Expect(allowed).To(BeFalse())
+			Expect(retryAfter).To(Equal(40 * time.Second))
+
+			now = now.Add(40 * time.Second)
+			allowed, _ = limiter.Allow("user")
+			Expect(allowed).To(BeTrue())
+		})
+
+		It("should count the creations of each user separately", func() {
+			Expect(limiter.Allow("user")).To(BeTrue())
+			Expect(limiter.Allow("user")).To(BeTrue())
+
+			allowed, _ := limiter.Allow("other-user")
+			Expect(allowed).To(BeTrue())
+		})
+
+		It("should never limit the exempt users and the service accounts of the service", func() {
+			for i := 0; i < 5; i++ {
+				allowed, _ := limiter.Allow("admin")
+				Expect(allowed).To(BeTrue())
+				allowed, _ = limiter.Allow("system:serviceaccount:release-service:controller-manager")
+				Expect(allowed).To(BeTrue())
+			}
+			Expect(limiter.windows).To(BeEmpty())
+		})
+
+		It("should forget the users whose window ended", func() {
+			Expect(limiter.Allow("user")).To(BeTrue())
+			now = now.Add(time.Minute)
+			Expect(limiter.Allow("other-user")).To(BeTrue())
+			Expect(limiter.windows).To(HaveLen(1))
+			Expect(limiter.windows).To(HaveKey("other-user"))
+		})
+	})
+})
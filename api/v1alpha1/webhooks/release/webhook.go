@@ -18,31 +18,60 @@ package release
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"math"
+	"os"
 	"reflect"
+	"strconv"
+	"strings"
 
+	"github.com/konflux-ci/release-service/cache"
+	"github.com/konflux-ci/release-service/encryption"
 	"github.com/konflux-ci/release-service/loader"
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/webhooks/utils"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+// releaseGroupKind is the GroupKind used in the errors returned by the webhook
+var releaseGroupKind = v1alpha1.GroupVersion.WithKind("Release").GroupKind()
+
 // Webhook describes the data structure for the release webhook
 type Webhook struct {
-	client client.Client
-	loader loader.ObjectLoader
-	log    logr.Logger
+	client             client.Client
+	controllerUsername string
+	loader             loader.ObjectLoader
+	log                logr.Logger
+	rateLimiter        *creationRateLimiter
 }
 
 // Default implements webhook.Defaulter so a webhook will be registered for the type.
 func (w *Webhook) Default(ctx context.Context, obj runtime.Object) error {
 	release := obj.(*v1alpha1.Release)
 
+	if err := w.normalizeStrategyAnnotation(ctx, release); err != nil {
+		return err
+	}
+
+	// Releases with an idempotency key are named after it, so the API server itself rejects the retried requests with an
+	// AlreadyExists error naming the existing Release, even if they are received concurrently
+	if release.Spec.IdempotencyKey != "" {
+		release.GenerateName = ""
+		release.Name = getIdempotentName(release.Spec.IdempotencyKey)
+	}
+
 	if release.Spec.GracePeriodDays != 0 {
 		return nil
 	}
@@ -65,11 +94,31 @@ func (w *Webhook) Default(ctx context.Context, obj runtime.Object) error {
 //+kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-release,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releases,verbs=create,versions=v1alpha1,name=mrelease.kb.io,admissionReviewVersions=v1
 //+kubebuilder:webhook:path=/validate-appstudio-redhat-com-v1alpha1-release,mutating=false,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releases,verbs=create;update,versions=v1alpha1,name=vrelease.kb.io,admissionReviewVersions=v1
 
+// Register registers the webhook with the passed manager and log. The number of Releases each user can create per
+// minute is limited to the positive integer set in the RELEASE_CREATION_RATE_LIMIT environment variable. The users
+// listed in the comma-separated RELEASE_CREATION_RATE_LIMIT_EXEMPT_USERS environment variable and the service accounts
+// of the service namespace are never limited. Only the ServiceAccount set in the SERVICE_ACCOUNT_NAME environment
+// variable, which the controller runs as, can propagate results to the data of existing Releases.
 func (w *Webhook) Register(mgr ctrl.Manager, log *logr.Logger) error {
 	w.client = mgr.GetClient()
 	w.loader = loader.NewLoader()
 	w.log = log.WithName("release")
 
+	if serviceAccount := os.Getenv("SERVICE_ACCOUNT_NAME"); serviceAccount != "" {
+		w.controllerUsername = fmt.Sprintf("system:serviceaccount:%s:%s", os.Getenv("SERVICE_NAMESPACE"),
+			serviceAccount)
+	}
+
+	if limit, err := strconv.Atoi(os.Getenv("RELEASE_CREATION_RATE_LIMIT")); err == nil && limit > 0 {
+		w.rateLimiter = newCreationRateLimiter(limit,
+			strings.Split(os.Getenv("RELEASE_CREATION_RATE_LIMIT_EXEMPT_USERS"), ","), os.Getenv("SERVICE_NAMESPACE"))
+	}
+
+	// The idempotency key index is required to find Releases created with the same key
+	if err := cache.SetupReleaseIdempotencyKeyCache(mgr); err != nil {
+		return err
+	}
+
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&v1alpha1.Release{}).
 		WithDefaulter(w).
@@ -79,7 +128,84 @@ func (w *Webhook) Register(mgr ctrl.Manager, log *logr.Logger) error {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (w *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
-	return nil, nil
+	release := obj.(*v1alpha1.Release)
+
+	// ReleasePlans are always looked up in the Release namespace, so namespaced references are rejected to prevent
+	// Releases from attempting to use a ReleasePlan owned by another tenant
+	if strings.ContainsRune(release.Spec.ReleasePlan, types.Separator) {
+		return nil, v1alpha1.NewValidationError(releaseGroupKind, release.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.releaseplan-reference",
+			Field:   "spec.releasePlan",
+			Hint:    "reference the ReleasePlan by name and create the Release in the namespace of the ReleasePlan",
+			Message: "release resources can only reference ReleasePlans in their own namespace",
+			Reason:  metav1.CauseTypeFieldValueInvalid,
+		})
+	}
+
+	// The data is parsed and resolved by the controller, so pathological or malformed data is rejected early
+	if err := validateData(ctx, release); err != nil {
+		return nil, err
+	}
+
+	// Task names are only checked against the ReleasePlanAdmission by the controller, so malformed lists are rejected early
+	if _, err := release.GetSkippedTasks(); err != nil {
+		return nil, v1alpha1.NewValidationError(releaseGroupKind, release.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.skip-tasks",
+			Field:   fmt.Sprintf("metadata.annotations[%s]", metadata.SkipTasksAnnotation),
+			Hint:    "list the names of the managed Pipeline tasks to skip separated by commas",
+			Message: err.Error(),
+			Reason:  metav1.CauseTypeFieldValueInvalid,
+		})
+	}
+
+	// Component names are only checked against the Snapshot by the managed Pipeline, so malformed lists are rejected early
+	if _, err := release.GetComponents(); err != nil {
+		return nil, v1alpha1.NewValidationError(releaseGroupKind, release.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.components",
+			Field:   fmt.Sprintf("metadata.annotations[%s]", metadata.ComponentsAnnotation),
+			Hint:    "list the names of the Snapshot components to release separated by commas",
+			Message: err.Error(),
+			Reason:  metav1.CauseTypeFieldValueInvalid,
+		})
+	}
+
+	// Releases can't be stopped once they are created, so the change record approving them is checked before that
+	if cause := w.validateChangeRequest(ctx, release); cause != nil {
+		return nil, v1alpha1.NewValidationError(releaseGroupKind, release.Name, *cause)
+	}
+
+	// Releases created with the key of an existing Release are rejected with an AlreadyExists error containing the name
+	// of the existing Release and a hint. The cache can miss a Release that was just created, but those are still rejected
+	// by the API server as they are named after the same key
+	if release.Spec.IdempotencyKey != "" {
+		existingRelease, err := w.loader.GetReleaseWithIdempotencyKey(ctx, w.client, release.Spec.IdempotencyKey, release.Namespace)
+		if err == nil {
+			alreadyExistsError := errors.NewAlreadyExists(schema.GroupResource{
+				Group:    v1alpha1.GroupVersion.Group,
+				Resource: "releases",
+			}, existingRelease.Name)
+			alreadyExistsError.ErrStatus.Details.Causes = v1alpha1.GetStatusCauses(v1alpha1.ValidationCause{
+				DocsKey: "release.idempotency-key",
+				Field:   "spec.idempotencyKey",
+				Hint:    fmt.Sprintf("check the status of the existing Release %s or use a different idempotency key", existingRelease.Name),
+				Message: fmt.Sprintf("release %s was already created with the same idempotency key", existingRelease.Name),
+				Reason:  metav1.CauseTypeFieldValueDuplicate,
+			})
+
+			return nil, alreadyExistsError
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	// Releases are only counted against the creation rate limit of their creator once they are known to be valid
+	if err := w.checkCreationRate(ctx, release); err != nil {
+		return nil, err
+	}
+
+	// Deprecated fields are still accepted, but users are warned so they migrate before the v1beta1 cutover
+	return v1alpha1.GetDeprecationWarnings(release.GetDeprecatedFields()), nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -88,7 +214,50 @@ func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Obj
 	newRelease := newObj.(*v1alpha1.Release)
 
 	if !reflect.DeepEqual(newRelease.Spec, oldRelease.Spec) {
-		return nil, fmt.Errorf("release resources spec cannot be updated")
+		if !w.isResultsPropagation(ctx, oldRelease, newRelease) {
+			return nil, v1alpha1.NewValidationError(releaseGroupKind, newRelease.Name, v1alpha1.ValidationCause{
+				DocsKey: "release.immutable-spec",
+				Field:   "spec",
+				Hint:    "create a new Release instead of updating the existing one",
+				Message: "release resources spec cannot be updated",
+				Reason:  metav1.CauseTypeForbidden,
+			})
+		}
+
+		// The propagated results become part of the data, so they are held to the same rules as the data of new Releases
+		if err := validateData(ctx, newRelease); err != nil {
+			return nil, err
+		}
+	}
+
+	if oldRelease.GetLabels()[metadata.HistoricalLabel] != newRelease.GetLabels()[metadata.HistoricalLabel] {
+		return nil, v1alpha1.NewValidationError(releaseGroupKind, newRelease.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.historical-label",
+			Field:   fmt.Sprintf("metadata.labels[%s]", metadata.HistoricalLabel),
+			Hint:    "historical records can only be imported by creating new Releases with the label already set",
+			Message: fmt.Sprintf("the %s label of release resources cannot be updated", metadata.HistoricalLabel),
+			Reason:  metav1.CauseTypeForbidden,
+		})
+	}
+
+	if oldRelease.GetAnnotations()[metadata.SkipTasksAnnotation] != newRelease.GetAnnotations()[metadata.SkipTasksAnnotation] {
+		return nil, v1alpha1.NewValidationError(releaseGroupKind, newRelease.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.skip-tasks",
+			Field:   fmt.Sprintf("metadata.annotations[%s]", metadata.SkipTasksAnnotation),
+			Hint:    "create a new Release skipping the desired tasks instead of updating the existing one",
+			Message: fmt.Sprintf("the %s annotation of release resources cannot be updated", metadata.SkipTasksAnnotation),
+			Reason:  metav1.CauseTypeForbidden,
+		})
+	}
+
+	if oldRelease.GetAnnotations()[metadata.ComponentsAnnotation] != newRelease.GetAnnotations()[metadata.ComponentsAnnotation] {
+		return nil, v1alpha1.NewValidationError(releaseGroupKind, newRelease.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.components",
+			Field:   fmt.Sprintf("metadata.annotations[%s]", metadata.ComponentsAnnotation),
+			Hint:    "create a new Release for the desired components instead of updating the existing one",
+			Message: fmt.Sprintf("the %s annotation of release resources cannot be updated", metadata.ComponentsAnnotation),
+			Reason:  metav1.CauseTypeForbidden,
+		})
 	}
 
 	return nil, nil
@@ -98,3 +267,209 @@ func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Obj
 func (w *Webhook) ValidateDelete(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
 	return nil, nil
 }
+
+// isResultsPropagation returns true if the only change between both Releases is the data, the Release propagates the
+// results of the Release it depends on, its processing didn't start yet and the change is requested by the controller.
+// This is the way the controller adds the results to the data of the Release before it gets processed.
+func (w *Webhook) isResultsPropagation(ctx context.Context, oldRelease, newRelease *v1alpha1.Release) bool {
+	if newRelease.Spec.DependsOn == nil || !newRelease.Spec.DependsOn.PropagateResults {
+		return false
+	}
+
+	if oldRelease.IsTenantPipelineProcessing() || oldRelease.HasTenantPipelineProcessingFinished() {
+		return false
+	}
+
+	oldSpec := oldRelease.Spec.DeepCopy()
+	oldSpec.Data = newRelease.Spec.Data
+	if !reflect.DeepEqual(*oldSpec, newRelease.Spec) {
+		return false
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+
+	return err == nil && w.controllerUsername != "" && req.UserInfo.Username == w.controllerUsername
+}
+
+// normalizeStrategyAnnotation moves the strategy set in the deprecated strategy annotation of the Release into its
+// strategy field, so Releases created by tools that haven't been updated yet keep working during the deprecation
+// window. An error is returned if the annotation and the field select different strategies. Rewrites of dry-run
+// requests are not counted in the metrics.
+func (w *Webhook) normalizeStrategyAnnotation(ctx context.Context, release *v1alpha1.Release) error {
+	strategy, found := release.GetAnnotations()[metadata.StrategyAnnotation]
+	if !found {
+		return nil
+	}
+
+	field := fmt.Sprintf("metadata.annotations[%s]", metadata.StrategyAnnotation)
+	if release.Spec.Strategy != "" && release.Spec.Strategy != strategy {
+		return v1alpha1.NewValidationError(releaseGroupKind, release.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.strategy",
+			Field:   field,
+			Hint:    "remove the deprecated annotation and select the strategy in the strategy field only",
+			Message: fmt.Sprintf("the %s annotation selects a different strategy than the strategy field",
+				metadata.StrategyAnnotation),
+			Reason: metav1.CauseTypeFieldValueInvalid,
+		})
+	}
+
+	release.Spec.Strategy = strategy
+	delete(release.Annotations, metadata.StrategyAnnotation)
+
+	if !utils.IsDryRun(ctx) {
+		w.log.Info("Rewrote the deprecated strategy annotation into the strategy field",
+			"Release.Name", release.Name, "Release.Namespace", release.Namespace)
+		metrics.RegisterLegacyFieldNormalized("Release", field)
+	}
+
+	return nil
+}
+
+// checkCreationRate records the creation of the given Release against the creation rate limit of the user creating
+// it. A TooManyRequests error telling the user when to retry is returned if the limit is exceeded. Dry-run requests
+// and requests created without a rate limiter are not limited.
+func (w *Webhook) checkCreationRate(ctx context.Context, release *v1alpha1.Release) error {
+	if w.rateLimiter == nil || utils.IsDryRun(ctx) {
+		return nil
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	allowed, retryAfter := w.rateLimiter.Allow(req.UserInfo.Username)
+	if allowed {
+		return nil
+	}
+
+	w.log.Info("Rejected a Release exceeding the creation rate limit of its creator",
+		"Release.Namespace", release.Namespace, "User", req.UserInfo.Username)
+	metrics.RegisterRateLimitedRelease(release.Namespace)
+
+	retryAfterSeconds := int(math.Ceil(retryAfter.Seconds()))
+	tooManyRequestsError := errors.NewTooManyRequests(
+		fmt.Sprintf("user %s exceeded the limit of %d releases created per minute", req.UserInfo.Username,
+			w.rateLimiter.limit), retryAfterSeconds)
+	tooManyRequestsError.ErrStatus.Details.Causes = v1alpha1.GetStatusCauses(v1alpha1.ValidationCause{
+		DocsKey: "release.creation-rate-limit",
+		Hint:    fmt.Sprintf("retry in %d seconds or create the releases at a slower pace", retryAfterSeconds),
+		Message: "too many releases created in the last minute",
+		Reason:  metav1.CauseTypeForbidden,
+	})
+
+	return tooManyRequestsError
+}
+
+// validateData checks that the data of the given Release can be processed by the controller. The data is parsed several
+// times while the Release is processed and the Secret references and encrypted values in it are resolved by the
+// controller, so pathological or malformed data is rejected early. A validation error is returned if any check fails.
+func validateData(ctx context.Context, release *v1alpha1.Release) error {
+	if limit, err := v1alpha1.CheckDataLimits(release.Spec.Data); err != nil {
+		if !utils.IsDryRun(ctx) {
+			metrics.RegisterOversizedDataRejected("Release", limit)
+		}
+		return v1alpha1.NewValidationError(releaseGroupKind, release.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.data-limits",
+			Field:   "spec.data",
+			Hint:    "move big or deeply nested values out of the data, e.g. into a ConfigMap referenced by the Pipeline",
+			Message: err.Error(),
+			Reason:  metav1.CauseTypeFieldValueInvalid,
+		})
+	}
+
+	if _, err := release.GetDataSecretKeyRefs(); err != nil {
+		return v1alpha1.NewValidationError(releaseGroupKind, release.Name, v1alpha1.ValidationCause{
+			DocsKey: "release.data-secret-key-ref",
+			Field:   "spec.data",
+			Hint:    "reference Secrets in the data using objects like {\"secretKeyRef\": {\"name\": \"<secret>\", \"key\": \"<key>\"}}",
+			Message: err.Error(),
+			Reason:  metav1.CauseTypeFieldValueInvalid,
+		})
+	}
+
+	if cause := validateDataEncryptedValues(release); cause != nil {
+		return v1alpha1.NewValidationError(releaseGroupKind, release.Name, *cause)
+	}
+
+	return nil
+}
+
+// validateChangeRequest checks the change request in the data of the given Release, which is required when the
+// ReleasePlanAdmission targeted by the Release requires a change record. ReleasePlanAdmissions that can't be loaded are
+// reported by the controller, so the requirement is only enforced when the ReleasePlanAdmission is found. A validation
+// cause is returned if the check fails.
+func (w *Webhook) validateChangeRequest(ctx context.Context, release *v1alpha1.Release) *v1alpha1.ValidationCause {
+	data, err := release.GetData()
+	if err != nil {
+		return &v1alpha1.ValidationCause{
+			DocsKey: "release.data",
+			Field:   "spec.data",
+			Hint:    "check the type of the known keys of the data against the ReleaseData type",
+			Message: err.Error(),
+			Reason:  metav1.CauseTypeFieldValueInvalid,
+		}
+	}
+
+	if data.ChangeRequest != nil {
+		if err := data.ChangeRequest.Validate(); err != nil {
+			return &v1alpha1.ValidationCause{
+				DocsKey: "release.change-request",
+				Field:   "spec.data.changeRequest",
+				Hint:    "reference the change record using its system, id and url",
+				Message: err.Error(),
+				Reason:  metav1.CauseTypeFieldValueInvalid,
+			}
+		}
+
+		return nil
+	}
+
+	releasePlanAdmission, err := w.loader.GetActiveReleasePlanAdmissionFromRelease(ctx, w.client, release)
+	if err != nil || !releasePlanAdmission.Spec.RequireChangeRecord {
+		return nil
+	}
+
+	return &v1alpha1.ValidationCause{
+		DocsKey: "release.change-request",
+		Field:   "spec.data.changeRequest",
+		Hint:    "reference the approved change record in the data using {\"changeRequest\": {\"system\": \"<system>\", \"id\": \"<id>\", \"url\": \"<url>\"}}",
+		Message: fmt.Sprintf("ReleasePlanAdmission %s requires releases to reference a change record", releasePlanAdmission.Name),
+		Reason:  metav1.CauseTypeFieldValueRequired,
+	}
+}
+
+// validateDataEncryptedValues checks that every encrypted value in the data of the given Release is a well-formed
+// envelope. The values can't be decrypted by the webhook, so only their format is checked. A validation cause is
+// returned if the check fails.
+func validateDataEncryptedValues(release *v1alpha1.Release) *v1alpha1.ValidationCause {
+	cause := &v1alpha1.ValidationCause{
+		DocsKey: "release.data-encrypted-value",
+		Field:   "spec.data",
+		Hint:    "encrypt the values with the KMS key of the Release Service and set them using objects like {\"encrypted\": \"envelope:v1:...\"}",
+		Reason:  metav1.CauseTypeFieldValueInvalid,
+	}
+
+	encryptedValues, err := release.GetDataEncryptedValues()
+	if err != nil {
+		cause.Message = err.Error()
+		return cause
+	}
+
+	for path, encryptedValue := range encryptedValues {
+		if _, err = encryption.ParseEnvelope(encryptedValue); err != nil {
+			cause.Message = fmt.Sprintf("invalid encrypted value in %s: %s", path, err)
+			return cause
+		}
+	}
+
+	return nil
+}
+
+// getIdempotentName returns the name of the Releases created with the given idempotency key. The name is derived from
+// a hash of the key, so it's the same for every request with that key and a valid object name whatever the key
+// contains.
+func getIdempotentName(idempotencyKey string) string {
+	hash := sha256.Sum256([]byte(idempotencyKey))
+	return fmt.Sprintf("release-%x", hash[:16])
+}
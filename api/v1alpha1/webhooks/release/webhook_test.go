@@ -17,17 +17,28 @@ package release
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	toolkit "github.com/konflux-ci/operator-toolkit/loader"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/encryption"
 	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -74,6 +85,405 @@ var _ = Describe("Release validation webhook", func() {
 			Expect(mockedWebhook.Default(mockedCtx, release)).To(BeNil())
 			Expect(release.Spec.GracePeriodDays).To(Equal(0))
 		})
+
+		It("should move the deprecated strategy annotation into the strategy field", func() {
+			mockedCtx := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+			})
+			release.Annotations = map[string]string{metadata.StrategyAnnotation: "hotfix"}
+
+			Expect(mockedWebhook.Default(mockedCtx, release)).To(BeNil())
+			Expect(release.Spec.Strategy).To(Equal("hotfix"))
+			Expect(release.Annotations).NotTo(HaveKey(metadata.StrategyAnnotation))
+		})
+
+		It("should move the deprecated strategy annotation without counting it in the metrics on dry-run requests", func() {
+			dryRun := true
+			mockedCtx := toolkit.GetMockedContext(admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{DryRun: &dryRun},
+			}), []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+			})
+			release.Annotations = map[string]string{metadata.StrategyAnnotation: "hotfix"}
+			normalized := testutil.ToFloat64(metrics.LegacyFieldsNormalizedTotal.WithLabelValues(
+				"Release", fmt.Sprintf("metadata.annotations[%s]", metadata.StrategyAnnotation)))
+
+			Expect(mockedWebhook.Default(mockedCtx, release)).To(BeNil())
+			Expect(release.Spec.Strategy).To(Equal("hotfix"))
+			Expect(testutil.ToFloat64(metrics.LegacyFieldsNormalizedTotal.WithLabelValues(
+				"Release", fmt.Sprintf("metadata.annotations[%s]", metadata.StrategyAnnotation)))).To(Equal(normalized))
+		})
+
+		It("should name the Release after its idempotency key", func() {
+			mockedCtx := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+			})
+			release.GenerateName = "release-"
+			release.Spec.IdempotencyKey = "foo"
+
+			Expect(mockedWebhook.Default(mockedCtx, release)).To(BeNil())
+			Expect(release.Name).To(Equal(getIdempotentName("foo")))
+			Expect(release.GenerateName).To(BeEmpty())
+		})
+
+		It("should fail if the deprecated strategy annotation conflicts with the strategy field", func() {
+			release.Annotations = map[string]string{metadata.StrategyAnnotation: "hotfix"}
+			release.Spec.Strategy = "default"
+
+			err := mockedWebhook.Default(ctx, release)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("selects a different strategy than the strategy field"))
+		})
+	})
+
+	When("ValidateCreate method is called", func() {
+		It("should not error out when the ReleasePlan is referenced by name", func() {
+			_, err := webhook.ValidateCreate(ctx, release)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error out when the ReleasePlan is referenced with a namespace", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.ReleasePlan = "other-namespace/" + release.Spec.ReleasePlan
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("can only reference ReleasePlans in their own namespace"))
+		})
+
+		It("should return a structured cause when the ReleasePlan is referenced with a namespace", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.ReleasePlan = "other-namespace/" + release.Spec.ReleasePlan
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+
+			causes := err.(*errors.StatusError).Status().Details.Causes
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("spec.releasePlan"))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+		})
+
+		It("should not error out when the data references Secret keys", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": {"secretKeyRef": {"name": "foo", "key": "bar"}}}`)}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error out when the data contains a malformed Secret key reference", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": {"secretKeyRef": {"name": "foo"}}}`)}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+
+			causes := err.(*errors.StatusError).Status().Details.Causes
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("spec.data"))
+		})
+
+		It("should not error out when the data contains encrypted values", func() {
+			value, err := encryption.SealEnvelope(make([]byte, 32), "vault:v1:key", []byte("secret"))
+			Expect(err).NotTo(HaveOccurred())
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": {"encrypted": "` + value + `"}}`)}
+
+			_, err = webhook.ValidateCreate(ctx, newRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error out when the data contains an encrypted value that is not an envelope", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": {"encrypted": "secret"}}`)}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+
+			causes := err.(*errors.StatusError).Status().Details.Causes
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("spec.data"))
+			Expect(causes[0].Message).To(ContainSubstring("invalid encrypted value in token"))
+		})
+
+		It("should error out when the data exceeds the size limit", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_SIZE", "10")
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo": "0123456789"}`)}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+
+			causes := err.(*errors.StatusError).Status().Details.Causes
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("spec.data"))
+			Expect(causes[0].Message).To(ContainSubstring("exceeds the maximum of 10 bytes"))
+		})
+
+		It("should error out without counting it in the metrics on dry-run requests exceeding the size limit", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_SIZE", "10")
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo": "0123456789"}`)}
+			dryRun := true
+			dryRunCtx := admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{DryRun: &dryRun},
+			})
+			rejected := testutil.ToFloat64(metrics.OversizedDataRejectedTotal.WithLabelValues("Release", v1alpha1.DataLimitSize))
+
+			_, err := webhook.ValidateCreate(dryRunCtx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(testutil.ToFloat64(metrics.OversizedDataRejectedTotal.WithLabelValues("Release", v1alpha1.DataLimitSize))).To(
+				Equal(rejected))
+		})
+
+		It("should error out when the data exceeds the depth limit", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_DEPTH", "1")
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo": {"bar": "baz"}}`)}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("nested more than 1 levels deep"))
+		})
+
+		It("should not error out when the skip-tasks annotation lists valid task names", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Annotations = map[string]string{metadata.SkipTasksAnnotation: "verify, sign"}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error out when the skip-tasks annotation lists invalid task names", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Annotations = map[string]string{metadata.SkipTasksAnnotation: "verify,,Sign"}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+
+			causes := err.(*errors.StatusError).Status().Details.Causes
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(fmt.Sprintf("metadata.annotations[%s]", metadata.SkipTasksAnnotation)))
+		})
+
+		It("should not error out when the components annotation lists valid component names", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Annotations = map[string]string{metadata.ComponentsAnnotation: "component-a, component-b"}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error out when the components annotation lists invalid component names", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Annotations = map[string]string{metadata.ComponentsAnnotation: "component-a,Component_B"}
+
+			_, err := webhook.ValidateCreate(ctx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+
+			causes := err.(*errors.StatusError).Status().Details.Causes
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(fmt.Sprintf("metadata.annotations[%s]", metadata.ComponentsAnnotation)))
+		})
+	})
+
+	When("ValidateCreate method is called with an idempotency key", func() {
+		var mockedWebhook *Webhook
+
+		BeforeEach(func() {
+			mockedWebhook = &Webhook{
+				client: k8sClient,
+				loader: loader.NewMockLoader(),
+			}
+		})
+
+		It("should not error out when no Release has the same key", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.IdempotencyKey = "key"
+
+			mockedCtx := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseWithIdempotencyKeyContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, newRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an AlreadyExists error when a Release has the same key", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.IdempotencyKey = "key"
+
+			mockedCtx := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseWithIdempotencyKeyContextKey,
+					Resource: &v1alpha1.Release{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "existing-release",
+							Namespace: "default",
+						},
+					},
+				},
+			})
+
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, newRelease)
+			Expect(errors.IsAlreadyExists(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("existing-release"))
+		})
+
+		It("should error out when the Releases can't be listed", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.IdempotencyKey = "key"
+
+			mockedCtx := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseWithIdempotencyKeyContextKey,
+					Err:        fmt.Errorf("list error"),
+				},
+			})
+
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, newRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("list error"))
+		})
+	})
+
+	When("ValidateCreate method is called with a creation rate limit", func() {
+		var mockedCtx context.Context
+		var mockedWebhook *Webhook
+
+		BeforeEach(func() {
+			mockedWebhook = &Webhook{
+				client:      k8sClient,
+				loader:      loader.NewMockLoader(),
+				rateLimiter: newCreationRateLimiter(1, nil, ""),
+			}
+			mockedCtx = admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "user"},
+				},
+			})
+		})
+
+		It("should return a TooManyRequests error when the user exceeds the limit", func() {
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, release.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+
+			rejected := testutil.ToFloat64(metrics.RateLimitedReleasesTotal.WithLabelValues(release.Namespace))
+			_, err = mockedWebhook.ValidateCreate(mockedCtx, release.DeepCopy())
+			Expect(errors.IsTooManyRequests(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("user user exceeded the limit of 1 releases created per minute"))
+			retryAfter, found := errors.SuggestsClientDelay(err)
+			Expect(found).To(BeTrue())
+			Expect(retryAfter).To(BeNumerically(">", 0))
+			Expect(testutil.ToFloat64(metrics.RateLimitedReleasesTotal.WithLabelValues(release.Namespace))).To(
+				Equal(rejected + 1))
+		})
+
+		It("should not count dry-run requests", func() {
+			dryRun := true
+			dryRunCtx := admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					DryRun:   &dryRun,
+					UserInfo: authenticationv1.UserInfo{Username: "user"},
+				},
+			})
+
+			_, err := mockedWebhook.ValidateCreate(dryRunCtx, release.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			_, err = mockedWebhook.ValidateCreate(mockedCtx, release.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not count invalid Releases", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.ReleasePlan = "other-namespace/release-plan"
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+
+			_, err = mockedWebhook.ValidateCreate(mockedCtx, release.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("ValidateCreate method is called for a ReleasePlanAdmission requiring a change record", func() {
+		var mockedCtx context.Context
+		var mockedWebhook *Webhook
+
+		BeforeEach(func() {
+			mockedWebhook = &Webhook{
+				client: k8sClient,
+				loader: loader.NewMockLoader(),
+			}
+			mockedCtx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanAdmissionSpec{
+							RequireChangeRecord: true,
+						},
+					},
+				},
+			})
+		})
+
+		It("should not error out when the data references a change record", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"changeRequest": {"system": "servicenow", "id": "CHG0001", "url": "https://example.com/CHG0001"}
+			}`)}
+
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, newRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error out when the data doesn't reference a change record", func() {
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, release.DeepCopy())
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("requires releases to reference a change record"))
+
+			causes := err.(*errors.StatusError).Status().Details.Causes
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("spec.data.changeRequest"))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueRequired))
+		})
+
+		It("should error out when the change request is incomplete", func() {
+			newRelease := release.DeepCopy()
+			newRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"changeRequest": {"system": "servicenow"}}`)}
+
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, newRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("must specify the system and id"))
+		})
+
+		It("should not error out when the ReleasePlanAdmission can't be found", func() {
+			mockedCtx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			_, err := mockedWebhook.ValidateCreate(mockedCtx, release.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 
 	When("When ValidateUpdate is called", func() {
@@ -86,6 +496,91 @@ var _ = Describe("Release validation webhook", func() {
 			Expect(err.Error()).Should(ContainSubstring("release resources spec cannot be updated"))
 		})
 
+		It("should not error out when the controller updates the data of a Release propagating results", func() {
+			dependentRelease := release.DeepCopy()
+			dependentRelease.Spec.DependsOn = &v1alpha1.ReleaseDependency{
+				Release:          "test-dependency",
+				PropagateResults: true,
+			}
+			updatedRelease := dependentRelease.DeepCopy()
+			updatedRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+
+			controllerWebhook := &Webhook{controllerUsername: "system:serviceaccount:release-service:controller-manager"}
+			controllerCtx := admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: controllerWebhook.controllerUsername},
+				},
+			})
+
+			_, err := controllerWebhook.ValidateUpdate(controllerCtx, dependentRelease, updatedRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error out when a user updates the data of a Release propagating results", func() {
+			dependentRelease := release.DeepCopy()
+			dependentRelease.Spec.DependsOn = &v1alpha1.ReleaseDependency{
+				Release:          "test-dependency",
+				PropagateResults: true,
+			}
+			updatedRelease := dependentRelease.DeepCopy()
+			updatedRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+
+			controllerWebhook := &Webhook{controllerUsername: "system:serviceaccount:release-service:controller-manager"}
+			userCtx := admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "user"},
+				},
+			})
+
+			_, err := controllerWebhook.ValidateUpdate(userCtx, dependentRelease, updatedRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("release resources spec cannot be updated"))
+		})
+
+		It("should error out when the propagated results are not valid data", func() {
+			dependentRelease := release.DeepCopy()
+			dependentRelease.Spec.DependsOn = &v1alpha1.ReleaseDependency{
+				Release:          "test-dependency",
+				PropagateResults: true,
+			}
+			updatedRelease := dependentRelease.DeepCopy()
+			updatedRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"token": {"secretKeyRef": {"name": "foo"}}}`)}
+
+			controllerWebhook := &Webhook{controllerUsername: "system:serviceaccount:release-service:controller-manager"}
+			controllerCtx := admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: controllerWebhook.controllerUsername},
+				},
+			})
+
+			_, err := controllerWebhook.ValidateUpdate(controllerCtx, dependentRelease, updatedRelease)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should error out when updating the data of a Release propagating results after processing started", func() {
+			dependentRelease := release.DeepCopy()
+			dependentRelease.Spec.DependsOn = &v1alpha1.ReleaseDependency{
+				Release:          "test-dependency",
+				PropagateResults: true,
+			}
+			dependentRelease.MarkTenantPipelineProcessing()
+			updatedRelease := dependentRelease.DeepCopy()
+			updatedRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+
+			_, err := webhook.ValidateUpdate(ctx, dependentRelease, updatedRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("release resources spec cannot be updated"))
+		})
+
+		It("should error out when updating the data of a Release not propagating results", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+
+			_, err := webhook.ValidateUpdate(ctx, release, updatedRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("release resources spec cannot be updated"))
+		})
+
 		It("should not error out when updating the resource metadata", func() {
 			ctx := context.Background()
 
@@ -97,6 +592,53 @@ var _ = Describe("Release validation webhook", func() {
 			_, err := webhook.ValidateUpdate(ctx, release, updatedRelease)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should error out when updating the historical label", func() {
+			ctx := context.Background()
+
+			historicalRelease := release.DeepCopy()
+			historicalRelease.ObjectMeta.Labels = map[string]string{
+				metadata.HistoricalLabel: "true",
+			}
+			updatedRelease := historicalRelease.DeepCopy()
+			delete(updatedRelease.ObjectMeta.Labels, metadata.HistoricalLabel)
+
+			_, err := webhook.ValidateUpdate(ctx, historicalRelease, updatedRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("label of release resources cannot be updated"))
+		})
+
+		It("should error out when updating the skip-tasks annotation", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Annotations = map[string]string{metadata.SkipTasksAnnotation: "sign"}
+
+			_, err := webhook.ValidateUpdate(ctx, release, updatedRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("annotation of release resources cannot be updated"))
+		})
+
+		It("should error out when updating the components annotation", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Annotations = map[string]string{metadata.ComponentsAnnotation: "component-a"}
+
+			_, err := webhook.ValidateUpdate(ctx, release, updatedRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("annotation of release resources cannot be updated"))
+		})
+	})
+
+	When("getIdempotentName is called", func() {
+		It("should return the same name for the same key", func() {
+			Expect(getIdempotentName("foo")).To(Equal(getIdempotentName("foo")))
+		})
+
+		It("should return different names for different keys", func() {
+			Expect(getIdempotentName("foo")).NotTo(Equal(getIdempotentName("bar")))
+		})
+
+		It("should return a valid name whatever the key contains", func() {
+			Expect(validation.IsDNS1123Label(getIdempotentName("Foo/Bar:baz " + strings.Repeat("x", 253)))).To(BeEmpty())
+		})
 	})
 
 	When("ValidateDelete method is called", func() {
@@ -22,7 +22,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/webhooks/utils"
 	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,10 +51,14 @@ func (w *Webhook) Default(ctx context.Context, obj runtime.Object) error {
 		}
 	}
 
+	if releasePlan.Spec.Pipeline != nil {
+		return w.normalizePipelineRef(ctx, releasePlan, &releasePlan.Spec.Pipeline.PipelineRef)
+	}
+
 	return nil
 }
 
-// +kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-releaseplan,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releaseplans,verbs=create,versions=v1alpha1,name=mreleaseplan.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-releaseplan,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releaseplans,verbs=create;update,versions=v1alpha1,name=mreleaseplan.kb.io,admissionReviewVersions=v1
 // +kubebuilder:webhook:path=/validate-appstudio-redhat-com-v1alpha1-releaseplan,mutating=false,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releaseplans,verbs=create;update,versions=v1alpha1,name=vreleaseplan.kb.io,admissionReviewVersions=v1
 
 // Register registers the webhook with the passed manager and log.
@@ -67,12 +75,28 @@ func (w *Webhook) Register(mgr ctrl.Manager, log *logr.Logger) error {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateAutoReleaseLabel(obj)
+	if warnings, err = w.validateAutoReleaseLabel(obj); err != nil {
+		return warnings, err
+	}
+
+	if warnings, err = w.validateDataLimits(ctx, obj); err != nil {
+		return warnings, err
+	}
+
+	return append(warnings, getDeprecationWarnings(obj)...), nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateAutoReleaseLabel(newObj)
+	if warnings, err = w.validateAutoReleaseLabel(newObj); err != nil {
+		return warnings, err
+	}
+
+	if warnings, err = w.validateDataLimits(ctx, newObj); err != nil {
+		return warnings, err
+	}
+
+	return append(warnings, getDeprecationWarnings(newObj)...), nil
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -80,14 +104,78 @@ func (w *Webhook) ValidateDelete(ctx context.Context, obj runtime.Object) (warni
 	return nil, nil
 }
 
+// getDeprecationWarnings returns the warnings about the deprecated fields set in the ReleasePlan, so users migrate
+// before the v1beta1 cutover.
+func getDeprecationWarnings(obj runtime.Object) admission.Warnings {
+	return v1alpha1.GetDeprecationWarnings(obj.(*v1alpha1.ReleasePlan).GetDeprecatedFields())
+}
+
 // validateAutoReleaseLabel throws an error if the auto-release label value is set to anything besides true or false.
 func (w *Webhook) validateAutoReleaseLabel(obj runtime.Object) (warnings admission.Warnings, err error) {
 	releasePlan := obj.(*v1alpha1.ReleasePlan)
 
 	if value, found := releasePlan.GetLabels()[metadata.AutoReleaseLabel]; found {
 		if value != "true" && value != "false" {
-			return nil, fmt.Errorf("'%s' label can only be set to true or false", metadata.AutoReleaseLabel)
+			return nil, v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlan").GroupKind(), releasePlan.Name,
+				v1alpha1.ValidationCause{
+					DocsKey: "releaseplan.auto-release-label",
+					Field:   fmt.Sprintf("metadata.labels[%s]", metadata.AutoReleaseLabel),
+					Hint:    "set the label to true to release automatically or to false to release manually",
+					Message: fmt.Sprintf("'%s' label can only be set to true or false", metadata.AutoReleaseLabel),
+					Reason:  metav1.CauseTypeFieldValueNotSupported,
+				})
 		}
 	}
 	return nil, nil
 }
+
+// validateDataLimits throws an error if the data of the ReleasePlan exceeds the size or nesting depth limits, as it is
+// passed to the Pipelines of every Release using the ReleasePlan. Rejections of dry-run requests are not counted in the
+// metrics.
+func (w *Webhook) validateDataLimits(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	releasePlan := obj.(*v1alpha1.ReleasePlan)
+
+	if limit, err := v1alpha1.CheckDataLimits(releasePlan.Spec.Data); err != nil {
+		if !utils.IsDryRun(ctx) {
+			metrics.RegisterOversizedDataRejected("ReleasePlan", limit)
+		}
+		return nil, v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlan").GroupKind(), releasePlan.Name,
+			v1alpha1.ValidationCause{
+				DocsKey: "releaseplan.data-limits",
+				Field:   "spec.data",
+				Hint:    "move big or deeply nested values out of the data, e.g. into a ConfigMap referenced by the Pipeline",
+				Message: err.Error(),
+				Reason:  metav1.CauseTypeFieldValueInvalid,
+			})
+	}
+
+	return nil, nil
+}
+
+// normalizePipelineRef rewrites the deprecated bundle reference of the tenant Pipeline of the ReleasePlan into a
+// reference using the bundles resolver, so ReleasePlans that haven't been migrated yet keep working during the
+// deprecation window. Rewrites of dry-run requests are not counted in the metrics.
+func (w *Webhook) normalizePipelineRef(ctx context.Context, releasePlan *v1alpha1.ReleasePlan,
+	pipelineRef *tektonutils.PipelineRef) error {
+	field := "spec.pipeline.pipelineRef.bundle"
+
+	normalized, err := pipelineRef.NormalizeBundle()
+	if err != nil {
+		return v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlan").GroupKind(), releasePlan.Name,
+			v1alpha1.ValidationCause{
+				DocsKey: "pipeline-ref.bundle",
+				Field:   field,
+				Hint:    "reference the Pipeline using the bundles resolver instead of the deprecated bundle field",
+				Message: err.Error(),
+				Reason:  metav1.CauseTypeFieldValueInvalid,
+			})
+	}
+
+	if normalized && !utils.IsDryRun(ctx) {
+		w.log.Info("Rewrote the deprecated bundle reference of the Pipeline",
+			"ReleasePlan.Name", releasePlan.Name, "ReleasePlan.Namespace", releasePlan.Namespace)
+		metrics.RegisterLegacyFieldNormalized("ReleasePlan", field)
+	}
+
+	return nil
+}
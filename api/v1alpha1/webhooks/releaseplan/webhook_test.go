@@ -17,13 +17,18 @@ package releaseplan
 
 import (
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	//+kubebuilder:scaffold:imports
@@ -77,6 +82,14 @@ var _ = Describe("ReleasePlan webhook", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("'%s' label can only be set to true or false", metadata.AutoReleaseLabel))
 		})
+
+		It("should return a structured cause pointing to the label", func() {
+			releasePlan.Labels = map[string]string{metadata.AutoReleaseLabel: "foo"}
+			err := k8sClient.Create(ctx, releasePlan)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.(*errors.StatusError).Status().Details.Causes).To(ContainElement(HaveField("Type",
+				metav1.CauseTypeFieldValueNotSupported)))
+		})
 	})
 
 	When("a ReleasePlan is created with a valid auto-release label value", func() {
@@ -115,6 +128,70 @@ var _ = Describe("ReleasePlan webhook", func() {
 		})
 	})
 
+	When("a ReleasePlan is created with a deprecated bundle reference", func() {
+		It("should get the reference rewritten to use the bundles resolver", func() {
+			releasePlan.Spec.Pipeline = &tektonutils.ParameterizedPipeline{
+				Pipeline: tektonutils.Pipeline{
+					PipelineRef: tektonutils.PipelineRef{Bundle: "quay.io/some/bundle#release-pipeline"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, releasePlan)).Should(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      releasePlan.Name,
+					Namespace: releasePlan.Namespace,
+				}, releasePlan)
+
+				return err == nil && releasePlan.Spec.Pipeline.PipelineRef.Bundle == "" &&
+					releasePlan.Spec.Pipeline.PipelineRef.Resolver == "bundles"
+			}, timeout).Should(BeTrue())
+		})
+
+		It("should get rejected if the reference is malformed", func() {
+			releasePlan.Spec.Pipeline = &tektonutils.ParameterizedPipeline{
+				Pipeline: tektonutils.Pipeline{
+					PipelineRef: tektonutils.PipelineRef{Bundle: "quay.io/some/bundle"},
+				},
+			}
+			err := k8sClient.Create(ctx, releasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not in the <bundle>#<pipeline name> format"))
+		})
+	})
+
+	When("a ReleasePlan is created with data exceeding the limits", func() {
+		It("should get rejected", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_SIZE", "10")
+			releasePlan.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo": "0123456789"}`)}
+			err := k8sClient.Create(ctx, releasePlan)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("exceeds the maximum of 10 bytes"))
+		})
+	})
+
+	When("a ReleasePlan is created with a dry-run request", func() {
+		It("should be admitted without being persisted", func() {
+			Expect(k8sClient.Create(ctx, releasePlan, client.DryRunAll)).To(Succeed())
+			Expect(releasePlan.GetLabels()).To(HaveKeyWithValue(metadata.AutoReleaseLabel, "true"))
+
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: releasePlan.Name, Namespace: releasePlan.Namespace},
+				&v1alpha1.ReleasePlan{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should be rejected without counting it in the metrics if the data exceeds the limits", func() {
+			GinkgoT().Setenv("RELEASE_DATA_MAX_SIZE", "10")
+			releasePlan.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"foo": "0123456789"}`)}
+			rejected := testutil.ToFloat64(metrics.OversizedDataRejectedTotal.WithLabelValues("ReleasePlan",
+				v1alpha1.DataLimitSize))
+
+			err := k8sClient.Create(ctx, releasePlan, client.DryRunAll)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(testutil.ToFloat64(metrics.OversizedDataRejectedTotal.WithLabelValues("ReleasePlan",
+				v1alpha1.DataLimitSize))).To(Equal(rejected))
+		})
+	})
+
 	When("a ReleasePlan is updated using an invalid auto-release label value", func() {
 		It("shouldn't be modified", func() {
 			Expect(k8sClient.Create(ctx, releasePlan)).Should(Succeed())
@@ -125,6 +202,30 @@ var _ = Describe("ReleasePlan webhook", func() {
 		})
 	})
 
+	When("a ReleasePlan setting deprecated fields is validated", func() {
+		It("should return a warning for each deprecated field", func() {
+			releasePlan.Spec.Pipeline = &tektonutils.ParameterizedPipeline{
+				Pipeline: tektonutils.Pipeline{
+					PipelineRef: tektonutils.PipelineRef{Bundle: "quay.io/some/bundle#release-pipeline"},
+				},
+			}
+
+			warnings, err := webhook.ValidateCreate(ctx, releasePlan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("spec.pipeline.pipelineRef.bundle is deprecated")))
+
+			warnings, err = webhook.ValidateUpdate(ctx, releasePlan, releasePlan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+
+		It("should not return warnings if no deprecated field is set", func() {
+			warnings, err := webhook.ValidateCreate(ctx, releasePlan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+	})
+
 	When("ValidateDelete method is called", func() {
 		It("should return nil", func() {
 			releasePlan := &v1alpha1.ReleasePlan{}
@@ -22,7 +22,12 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/webhooks/utils"
+	"github.com/konflux-ci/release-service/executor"
 	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,10 +52,30 @@ func (w *Webhook) Default(ctx context.Context, obj runtime.Object) error {
 		}
 	}
 
+	if releasePlanAdmission.Spec.Pipeline != nil {
+		err := w.normalizePipelineRef(ctx, releasePlanAdmission, &releasePlanAdmission.Spec.Pipeline.PipelineRef,
+			"spec.pipeline.pipelineRef.bundle", "spec.pipeline.pipelineRef.bundle")
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, strategy := range releasePlanAdmission.Spec.Strategies {
+		if strategy.Pipeline == nil {
+			continue
+		}
+
+		err := w.normalizePipelineRef(ctx, releasePlanAdmission, &strategy.Pipeline.PipelineRef,
+			fmt.Sprintf("spec.strategies[%d].pipeline.pipelineRef.bundle", i), "spec.strategies.pipeline.pipelineRef.bundle")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// +kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-releaseplanadmission,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releaseplanadmissions,verbs=create,versions=v1alpha1,name=mreleaseplanadmission.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-releaseplanadmission,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releaseplanadmissions,verbs=create;update,versions=v1alpha1,name=mreleaseplanadmission.kb.io,admissionReviewVersions=v1
 // +kubebuilder:webhook:path=/validate-appstudio-redhat-com-v1alpha1-releaseplanadmission,mutating=false,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releaseplanadmissions,verbs=create;update,versions=v1alpha1,name=vreleaseplanadmission.kb.io,admissionReviewVersions=v1
 
 // Register registers the webhook with the passed manager and log.
@@ -67,12 +92,46 @@ func (w *Webhook) Register(mgr ctrl.Manager, log *logr.Logger) error {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateAutoReleaseLabel(obj)
+	warnings, err = w.validateAutoReleaseLabel(obj)
+	if err != nil {
+		return warnings, err
+	}
+
+	if err = w.validateExecutionNamespace(obj); err != nil {
+		return warnings, err
+	}
+
+	if err = w.validateExecutor(obj); err != nil {
+		return warnings, err
+	}
+
+	if err = w.validateCatalogPipeline(obj); err != nil {
+		return warnings, err
+	}
+
+	return append(warnings, getDeprecationWarnings(obj)...), nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateAutoReleaseLabel(newObj)
+	warnings, err = w.validateAutoReleaseLabel(newObj)
+	if err != nil {
+		return warnings, err
+	}
+
+	if err = w.validateExecutionNamespace(newObj); err != nil {
+		return warnings, err
+	}
+
+	if err = w.validateExecutor(newObj); err != nil {
+		return warnings, err
+	}
+
+	if err = w.validateCatalogPipeline(newObj); err != nil {
+		return warnings, err
+	}
+
+	return append(warnings, getDeprecationWarnings(newObj)...), nil
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -80,14 +139,114 @@ func (w *Webhook) ValidateDelete(ctx context.Context, obj runtime.Object) (warni
 	return nil, nil
 }
 
+// getDeprecationWarnings returns the warnings about the deprecated fields set in the ReleasePlanAdmission, so users
+// migrate before the v1beta1 cutover.
+func getDeprecationWarnings(obj runtime.Object) admission.Warnings {
+	return v1alpha1.GetDeprecationWarnings(obj.(*v1alpha1.ReleasePlanAdmission).GetDeprecatedFields())
+}
+
 // validateAutoReleaseLabel throws an error if the auto-release label value is set to anything besides true or false.
 func (w *Webhook) validateAutoReleaseLabel(obj runtime.Object) (warnings admission.Warnings, err error) {
 	releasePlanAdmission := obj.(*v1alpha1.ReleasePlanAdmission)
 
 	if value, found := releasePlanAdmission.GetLabels()[metadata.AutoReleaseLabel]; found {
 		if value != "true" && value != "false" {
-			return nil, fmt.Errorf("'%s' label can only be set to true or false", metadata.AutoReleaseLabel)
+			return nil, v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlanAdmission").GroupKind(), releasePlanAdmission.Name,
+				v1alpha1.ValidationCause{
+					DocsKey: "releaseplanadmission.auto-release-label",
+					Field:   fmt.Sprintf("metadata.labels[%s]", metadata.AutoReleaseLabel),
+					Hint:    "set the label to true to release automatically or to false to release manually",
+					Message: fmt.Sprintf("'%s' label can only be set to true or false", metadata.AutoReleaseLabel),
+					Reason:  metav1.CauseTypeFieldValueNotSupported,
+				})
 		}
 	}
 	return nil, nil
 }
+
+// validateCatalogPipeline throws an error if the ReleasePlanAdmission references a catalog Pipeline while also defining
+// its managed Pipeline in the pipeline field, as it would be ambiguous which one to run.
+func (w *Webhook) validateCatalogPipeline(obj runtime.Object) error {
+	releasePlanAdmission := obj.(*v1alpha1.ReleasePlanAdmission)
+
+	if releasePlanAdmission.Spec.CatalogPipeline != nil && releasePlanAdmission.Spec.Pipeline != nil {
+		return v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlanAdmission").GroupKind(),
+			releasePlanAdmission.Name, v1alpha1.ValidationCause{
+				DocsKey: "releaseplanadmission.catalog-pipeline",
+				Field:   "spec.catalogPipeline",
+				Hint:    "remove either the catalogPipeline or the pipeline field",
+				Message: "the catalogPipeline and pipeline fields are mutually exclusive",
+				Reason:  metav1.CauseTypeFieldValueInvalid,
+			})
+	}
+
+	return nil
+}
+
+// validateExecutionNamespace throws an error if the execution namespace template can't be rendered into a valid
+// namespace name for the origin of the ReleasePlanAdmission.
+func (w *Webhook) validateExecutionNamespace(obj runtime.Object) error {
+	releasePlanAdmission := obj.(*v1alpha1.ReleasePlanAdmission)
+
+	_, err := releasePlanAdmission.GetExecutionNamespace(releasePlanAdmission.Spec.Origin)
+	if err != nil {
+		return v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlanAdmission").GroupKind(),
+			releasePlanAdmission.Name, v1alpha1.ValidationCause{
+				DocsKey: "releaseplanadmission.execution-namespace",
+				Field:   "spec.executionNamespace",
+				Hint:    "use a template like releases-{{ .OriginNamespace }} rendering a valid namespace name",
+				Message: err.Error(),
+				Reason:  metav1.CauseTypeFieldValueInvalid,
+			})
+	}
+
+	return nil
+}
+
+// validateExecutor throws an error if the executor selected by the ReleasePlanAdmission is not compiled into the
+// service, as its managed Pipeline could never run.
+func (w *Webhook) validateExecutor(obj runtime.Object) error {
+	releasePlanAdmission := obj.(*v1alpha1.ReleasePlanAdmission)
+
+	_, err := executor.Get(releasePlanAdmission.Spec.Executor)
+	if err != nil {
+		return v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlanAdmission").GroupKind(),
+			releasePlanAdmission.Name, v1alpha1.ValidationCause{
+				DocsKey: "releaseplanadmission.executor",
+				Field:   "spec.executor",
+				Hint:    fmt.Sprintf("remove the field to use the default %s executor", executor.TektonExecutorName),
+				Message: err.Error(),
+				Reason:  metav1.CauseTypeFieldValueNotSupported,
+			})
+	}
+
+	return nil
+}
+
+// normalizePipelineRef rewrites the deprecated bundle reference of a managed Pipeline of the ReleasePlanAdmission into a
+// reference using the bundles resolver, so ReleasePlanAdmissions that haven't been migrated yet keep working during the
+// deprecation window. The field is the path of the bundle reference reported in errors, while the metric field omits
+// indexes, so the rewrites of all the strategies are counted together. Rewrites of dry-run requests are not counted.
+func (w *Webhook) normalizePipelineRef(ctx context.Context, releasePlanAdmission *v1alpha1.ReleasePlanAdmission,
+	pipelineRef *tektonutils.PipelineRef, field, metricField string) error {
+	normalized, err := pipelineRef.NormalizeBundle()
+	if err != nil {
+		return v1alpha1.NewValidationError(v1alpha1.GroupVersion.WithKind("ReleasePlanAdmission").GroupKind(),
+			releasePlanAdmission.Name, v1alpha1.ValidationCause{
+				DocsKey: "pipeline-ref.bundle",
+				Field:   field,
+				Hint:    "reference the Pipeline using the bundles resolver instead of the deprecated bundle field",
+				Message: err.Error(),
+				Reason:  metav1.CauseTypeFieldValueInvalid,
+			})
+	}
+
+	if normalized && !utils.IsDryRun(ctx) {
+		w.log.Info("Rewrote the deprecated bundle reference of a Pipeline", "Field", field,
+			"ReleasePlanAdmission.Name", releasePlanAdmission.Name,
+			"ReleasePlanAdmission.Namespace", releasePlanAdmission.Namespace)
+		metrics.RegisterLegacyFieldNormalized("ReleasePlanAdmission", metricField)
+	}
+
+	return nil
+}
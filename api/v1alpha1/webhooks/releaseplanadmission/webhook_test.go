@@ -127,6 +127,101 @@ var _ = Describe("ReleasePlanAdmission webhook", func() {
 		})
 	})
 
+	When("a ReleasePlanAdmission is created with deprecated bundle references", func() {
+		It("should get the references rewritten to use the bundles resolver", func() {
+			releasePlanAdmission.Spec.Pipeline.PipelineRef = tektonutils.PipelineRef{
+				Bundle: "quay.io/some/bundle#release-pipeline",
+			}
+			releasePlanAdmission.Spec.Strategies = []v1alpha1.ReleaseStrategy{
+				{
+					Name: "hotfix",
+					Pipeline: &tektonutils.Pipeline{
+						PipelineRef: tektonutils.PipelineRef{Bundle: "quay.io/some/bundle#hotfix-pipeline"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, releasePlanAdmission)).Should(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      releasePlanAdmission.Name,
+					Namespace: releasePlanAdmission.Namespace,
+				}, releasePlanAdmission)
+
+				return err == nil && releasePlanAdmission.Spec.Pipeline.PipelineRef.Resolver == "bundles" &&
+					releasePlanAdmission.Spec.Strategies[0].Pipeline.PipelineRef.Resolver == "bundles"
+			}, timeout).Should(BeTrue())
+		})
+
+		It("should get rejected if a reference is malformed", func() {
+			releasePlanAdmission.Spec.Strategies = []v1alpha1.ReleaseStrategy{
+				{
+					Name: "hotfix",
+					Pipeline: &tektonutils.Pipeline{
+						PipelineRef: tektonutils.PipelineRef{Bundle: "quay.io/some/bundle"},
+					},
+				},
+			}
+			err := k8sClient.Create(ctx, releasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.strategies[0].pipeline.pipelineRef.bundle"))
+		})
+	})
+
+	When("a ReleasePlanAdmission is created with an execution namespace template", func() {
+		It("should be accepted if the template renders a valid namespace name", func() {
+			releasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .OriginNamespace }}"
+			Expect(k8sClient.Create(ctx, releasePlanAdmission)).Should(Succeed())
+		})
+
+		It("should get rejected if the template can't be rendered", func() {
+			releasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .Foo }}"
+			err := k8sClient.Create(ctx, releasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to render the execution namespace template"))
+		})
+
+		It("should get rejected if the template renders an invalid namespace name", func() {
+			releasePlanAdmission.Spec.ExecutionNamespace = "Releases_{{ .OriginNamespace }}"
+			err := k8sClient.Create(ctx, releasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a valid namespace name"))
+		})
+	})
+
+	When("a ReleasePlanAdmission is created selecting an executor", func() {
+		It("should be accepted if the executor is registered", func() {
+			releasePlanAdmission.Spec.Executor = "tekton"
+			Expect(k8sClient.Create(ctx, releasePlanAdmission)).Should(Succeed())
+		})
+
+		It("should get rejected if the executor is unknown", func() {
+			releasePlanAdmission.Spec.Executor = "argo"
+			err := k8sClient.Create(ctx, releasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown release pipeline executor argo"))
+		})
+	})
+
+	When("a ReleasePlanAdmission is created referencing a catalog Pipeline", func() {
+		BeforeEach(func() {
+			releasePlanAdmission.Spec.CatalogPipeline = &v1alpha1.CatalogPipelineReference{
+				Catalog: "catalog",
+				Name:    "release-pipeline",
+			}
+		})
+
+		It("should be accepted if it doesn't define a pipeline", func() {
+			releasePlanAdmission.Spec.Pipeline = nil
+			Expect(k8sClient.Create(ctx, releasePlanAdmission)).Should(Succeed())
+		})
+
+		It("should get rejected if it also defines a pipeline", func() {
+			err := k8sClient.Create(ctx, releasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the catalogPipeline and pipeline fields are mutually exclusive"))
+		})
+	})
+
 	When("a ReleasePlanAdmission is updated using an invalid auto-release label value", func() {
 		It("shouldn't be modified", func() {
 			Expect(k8sClient.Create(ctx, releasePlanAdmission)).Should(Succeed())
@@ -137,6 +232,27 @@ var _ = Describe("ReleasePlanAdmission webhook", func() {
 		})
 	})
 
+	When("a ReleasePlanAdmission setting deprecated fields is validated", func() {
+		It("should return a warning for each deprecated field", func() {
+			warnings, err := webhook.ValidateCreate(ctx, releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.environment is deprecated and won't be supported in v1beta1, use spec.environments instead"))
+
+			warnings, err = webhook.ValidateUpdate(ctx, releasePlanAdmission, releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+
+		It("should not return warnings if no deprecated field is set", func() {
+			releasePlanAdmission.Spec.Environment = ""
+
+			warnings, err := webhook.ValidateCreate(ctx, releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+	})
+
 	When("ValidateDelete method is called", func() {
 		It("should return nil", func() {
 			releasePlanAdmission := &v1alpha1.ReleasePlanAdmission{}
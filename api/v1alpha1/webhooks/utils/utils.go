@@ -0,0 +1,33 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// IsDryRun returns whether the admission request in the given context is a dry-run request. Webhooks are registered
+// declaring they have no side effects, so the API server sends them dry-run requests. Handling them must not change
+// anything outside the admitted object, e.g. writing other resources or updating metrics. Contexts without an admission
+// request are not considered dry-run requests.
+func IsDryRun(ctx context.Context) bool {
+	req, err := admission.RequestFromContext(ctx)
+
+	return err == nil && req.DryRun != nil && *req.DryRun
+}
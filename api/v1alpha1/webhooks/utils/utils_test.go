@@ -0,0 +1,51 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Webhook utils", func() {
+	When("IsDryRun is called", func() {
+		dryRun, notDryRun := true, false
+
+		newContext := func(dryRun *bool) context.Context {
+			return admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{DryRun: dryRun},
+			})
+		}
+
+		It("should return true for dry-run requests", func() {
+			Expect(IsDryRun(newContext(&dryRun))).To(BeTrue())
+		})
+
+		It("should return false for requests that are not dry-run requests", func() {
+			Expect(IsDryRun(newContext(&notDryRun))).To(BeFalse())
+			Expect(IsDryRun(newContext(nil))).To(BeFalse())
+		})
+
+		It("should return false if the context has no admission request", func() {
+			Expect(IsDryRun(context.Background())).To(BeFalse())
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"github.com/konflux-ci/release-service/api/v1alpha1/webhooks/release"
 	"github.com/konflux-ci/release-service/api/v1alpha1/webhooks/releaseplan"
 	"github.com/konflux-ci/release-service/api/v1alpha1/webhooks/releaseplanadmission"
+	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 // EnabledWebhooks is a slice containing references to all the webhooks that have to be registered
@@ -15,3 +16,9 @@ var EnabledWebhooks = []webhook.Webhook{
 	&releaseplan.Webhook{},
 	&releaseplanadmission.Webhook{},
 }
+
+// SetupWebhooks registers all the EnabledWebhooks with the given manager, so every deployment of the service serves
+// the same webhooks.
+func SetupWebhooks(mgr ctrl.Manager) error {
+	return webhook.SetupWebhooks(mgr, EnabledWebhooks...)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+)
+
+var _ = Describe("Webhooks", Ordered, func() {
+	// getPath returns the path a webhook of the manifests is served at. The webhook install options rewrite the
+	// service references into URLs pointing to the local server, joining the path with an extra slash, so both are
+	// considered and the path is cleaned.
+	getPath := func(clientConfig admissionregistrationv1.WebhookClientConfig) string {
+		if clientConfig.Service != nil && clientConfig.Service.Path != nil {
+			return *clientConfig.Service.Path
+		}
+
+		Expect(clientConfig.URL).NotTo(BeNil())
+		webhookURL, err := url.Parse(*clientConfig.URL)
+		Expect(err).NotTo(HaveOccurred())
+		return path.Clean(webhookURL.Path)
+	}
+
+	// isServed returns whether the webhook server has a handler registered for exactly the given path.
+	isServed := func(webhookPath string) bool {
+		_, pattern := webhookMux.Handler(&http.Request{Method: http.MethodPost, URL: &url.URL{Path: webhookPath}})
+		return pattern == webhookPath
+	}
+
+	When("SetupWebhooks is called", func() {
+		BeforeAll(func() {
+			Expect(SetupWebhooks(mgr)).To(Succeed())
+		})
+
+		It("registers every mutating webhook of the manifests", func() {
+			Expect(testEnv.WebhookInstallOptions.MutatingWebhooks).NotTo(BeEmpty())
+			for _, configuration := range testEnv.WebhookInstallOptions.MutatingWebhooks {
+				for _, webhook := range configuration.Webhooks {
+					webhookPath := getPath(webhook.ClientConfig)
+					Expect(isServed(webhookPath)).To(BeTrue(), "no handler registered for %s", webhookPath)
+				}
+			}
+		})
+
+		It("registers every validating webhook of the manifests", func() {
+			Expect(testEnv.WebhookInstallOptions.ValidatingWebhooks).NotTo(BeEmpty())
+			for _, configuration := range testEnv.WebhookInstallOptions.ValidatingWebhooks {
+				for _, webhook := range configuration.Webhooks {
+					webhookPath := getPath(webhook.ClientConfig)
+					Expect(isServed(webhookPath)).To(BeTrue(), "no handler registered for %s", webhookPath)
+				}
+			}
+		})
+
+		It("doesn't serve paths missing from the manifests", func() {
+			Expect(isServed("/validate-appstudio-redhat-com-v1alpha1-foo")).To(BeFalse())
+		})
+	})
+})
@@ -26,130 +26,849 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationReleaseStatus) DeepCopyInto(out *ApplicationReleaseStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationReleaseStatus.
+func (in *ApplicationReleaseStatus) DeepCopy() *ApplicationReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationReleaseStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationReleaseStatusList) DeepCopyInto(out *ApplicationReleaseStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationReleaseStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationReleaseStatusList.
+func (in *ApplicationReleaseStatusList) DeepCopy() *ApplicationReleaseStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationReleaseStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationReleaseStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationReleaseStatusSpec) DeepCopyInto(out *ApplicationReleaseStatusSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationReleaseStatusSpec.
+func (in *ApplicationReleaseStatusSpec) DeepCopy() *ApplicationReleaseStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationReleaseStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationReleaseStatusStatus) DeepCopyInto(out *ApplicationReleaseStatusStatus) {
+	*out = *in
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]EnvironmentReleaseStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationReleaseStatusStatus.
+func (in *ApplicationReleaseStatusStatus) DeepCopy() *ApplicationReleaseStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationReleaseStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttributionInfo) DeepCopyInto(out *AttributionInfo) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttributionInfo.
-func (in *AttributionInfo) DeepCopy() *AttributionInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttributionInfo.
+func (in *AttributionInfo) DeepCopy() *AttributionInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(AttributionInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackoffPolicy) DeepCopyInto(out *BackoffPolicy) {
+	*out = *in
+	out.InitialDelay = in.InitialDelay
+	out.MaxDelay = in.MaxDelay
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackoffPolicy.
+func (in *BackoffPolicy) DeepCopy() *BackoffPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackoffPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogPipeline) DeepCopyInto(out *CatalogPipeline) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]CatalogPipelineVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogPipeline.
+func (in *CatalogPipeline) DeepCopy() *CatalogPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogPipelineReference) DeepCopyInto(out *CatalogPipelineReference) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]utils.Param, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogPipelineReference.
+func (in *CatalogPipelineReference) DeepCopy() *CatalogPipelineReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogPipelineReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogPipelineVersion) DeepCopyInto(out *CatalogPipelineVersion) {
+	*out = *in
+	in.ParameterizedPipeline.DeepCopyInto(&out.ParameterizedPipeline)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogPipelineVersion.
+func (in *CatalogPipelineVersion) DeepCopy() *CatalogPipelineVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogPipelineVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Collector) DeepCopyInto(out *Collector) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]Param, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Collector.
+func (in *Collector) DeepCopy() *Collector {
+	if in == nil {
+		return nil
+	}
+	out := new(Collector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeUsage) DeepCopyInto(out *ComputeUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeUsage.
+func (in *ComputeUsage) DeepCopy() *ComputeUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostRates) DeepCopyInto(out *CostRates) {
+	*out = *in
+	out.CPUCoreHour = in.CPUCoreHour.DeepCopy()
+	out.MemoryGiBHour = in.MemoryGiBHour.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostRates.
+func (in *CostRates) DeepCopy() *CostRates {
+	if in == nil {
+		return nil
+	}
+	out := new(CostRates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsBroker) DeepCopyInto(out *CredentialsBroker) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsBroker.
+func (in *CredentialsBroker) DeepCopy() *CredentialsBroker {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsBroker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataEncryption) DeepCopyInto(out *DataEncryption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEncryption.
+func (in *DataEncryption) DeepCopy() *DataEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DataEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyHealthCheck) DeepCopyInto(out *DependencyHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyHealthCheck.
+func (in *DependencyHealthCheck) DeepCopy() *DependencyHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentInfo) DeepCopyInto(out *DeploymentInfo) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotEnvironmentBindings != nil {
+		in, out := &in.SnapshotEnvironmentBindings, &out.SnapshotEnvironmentBindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentInfo.
+func (in *DeploymentInfo) DeepCopy() *DeploymentInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentReleaseStatus) DeepCopyInto(out *EnvironmentReleaseStatus) {
+	*out = *in
+	if in.LastFailedRelease != nil {
+		in, out := &in.LastFailedRelease, &out.LastFailedRelease
+		*out = new(ReleaseReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSucceededRelease != nil {
+		in, out := &in.LastSucceededRelease, &out.LastSucceededRelease
+		*out = new(ReleaseReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingReleases != nil {
+		in, out := &in.PendingReleases, &out.PendingReleases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentReleaseStatus.
+func (in *EnvironmentReleaseStatus) DeepCopy() *EnvironmentReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyInfo) DeepCopyInto(out *LatencyInfo) {
+	*out = *in
+	if in.SnapshotReleasableTime != nil {
+		in, out := &in.SnapshotReleasableTime, &out.SnapshotReleasableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotToReleaseStart != nil {
+		in, out := &in.SnapshotToReleaseStart, &out.SnapshotToReleaseStart
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SnapshotToReleased != nil {
+		in, out := &in.SnapshotToReleased, &out.SnapshotToReleased
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyInfo.
+func (in *LatencyInfo) DeepCopy() *LatencyInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPipelineSchedulingPolicy) DeepCopyInto(out *ManagedPipelineSchedulingPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPipelineSchedulingPolicy.
+func (in *ManagedPipelineSchedulingPolicy) DeepCopy() *ManagedPipelineSchedulingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPipelineSchedulingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchedReleasePlan) DeepCopyInto(out *MatchedReleasePlan) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedReleasePlan.
+func (in *MatchedReleasePlan) DeepCopy() *MatchedReleasePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchedReleasePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchedReleasePlanAdmission) DeepCopyInto(out *MatchedReleasePlanAdmission) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedReleasePlanAdmission.
+func (in *MatchedReleasePlanAdmission) DeepCopy() *MatchedReleasePlanAdmission {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchedReleasePlanAdmission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFailurePolicy) DeepCopyInto(out *NodeFailurePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFailurePolicy.
+func (in *NodeFailurePolicy) DeepCopy() *NodeFailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedPipelineRunPolicy) DeepCopyInto(out *OrphanedPipelineRunPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedPipelineRunPolicy.
+func (in *OrphanedPipelineRunPolicy) DeepCopy() *OrphanedPipelineRunPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedPipelineRunPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Param) DeepCopyInto(out *Param) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Param.
+func (in *Param) DeepCopy() *Param {
+	if in == nil {
+		return nil
+	}
+	out := new(Param)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineInfo) DeepCopyInto(out *PipelineInfo) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ComputeUsage != nil {
+		in, out := &in.ComputeUsage, &out.ComputeUsage
+		*out = new(ComputeUsage)
+		**out = **in
+	}
+	if in.ExcludedNodes != nil {
+		in, out := &in.ExcludedNodes, &out.ExcludedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(PipelineProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineInfo.
+func (in *PipelineInfo) DeepCopy() *PipelineInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineMirror) DeepCopyInto(out *PipelineMirror) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineMirror.
+func (in *PipelineMirror) DeepCopy() *PipelineMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineProgress) DeepCopyInto(out *PipelineProgress) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineProgress.
+func (in *PipelineProgress) DeepCopy() *PipelineProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryCredentials) DeepCopyInto(out *RegistryCredentials) {
+	*out = *in
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryCredentials.
+func (in *RegistryCredentials) DeepCopy() *RegistryCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Release) DeepCopyInto(out *Release) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Release.
+func (in *Release) DeepCopy() *Release {
+	if in == nil {
+		return nil
+	}
+	out := new(Release)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Release) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseData) DeepCopyInto(out *ReleaseData) {
+	*out = *in
+	if in.ChangeRequest != nil {
+		in, out := &in.ChangeRequest, &out.ChangeRequest
+		*out = new(ReleaseDataChangeRequest)
+		**out = **in
+	}
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = new(ReleaseDataMapping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pyxis != nil {
+		in, out := &in.Pyxis, &out.Pyxis
+		*out = new(ReleaseDataPyxis)
+		**out = **in
+	}
+	if in.Sensitive != nil {
+		in, out := &in.Sensitive, &out.Sensitive
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sign != nil {
+		in, out := &in.Sign, &out.Sign
+		*out = new(ReleaseDataSign)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseData.
+func (in *ReleaseData) DeepCopy() *ReleaseData {
 	if in == nil {
 		return nil
 	}
-	out := new(AttributionInfo)
+	out := new(ReleaseData)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Collector) DeepCopyInto(out *Collector) {
+func (in *ReleaseDataChangeRequest) DeepCopyInto(out *ReleaseDataChangeRequest) {
 	*out = *in
-	if in.Params != nil {
-		in, out := &in.Params, &out.Params
-		*out = make([]Param, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDataChangeRequest.
+func (in *ReleaseDataChangeRequest) DeepCopy() *ReleaseDataChangeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseDataChangeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseDataComponent) DeepCopyInto(out *ReleaseDataComponent) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Collector.
-func (in *Collector) DeepCopy() *Collector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDataComponent.
+func (in *ReleaseDataComponent) DeepCopy() *ReleaseDataComponent {
 	if in == nil {
 		return nil
 	}
-	out := new(Collector)
+	out := new(ReleaseDataComponent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MatchedReleasePlan) DeepCopyInto(out *MatchedReleasePlan) {
+func (in *ReleaseDataMapping) DeepCopyInto(out *ReleaseDataMapping) {
 	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ReleaseDataComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedReleasePlan.
-func (in *MatchedReleasePlan) DeepCopy() *MatchedReleasePlan {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDataMapping.
+func (in *ReleaseDataMapping) DeepCopy() *ReleaseDataMapping {
 	if in == nil {
 		return nil
 	}
-	out := new(MatchedReleasePlan)
+	out := new(ReleaseDataMapping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MatchedReleasePlanAdmission) DeepCopyInto(out *MatchedReleasePlanAdmission) {
+func (in *ReleaseDataPyxis) DeepCopyInto(out *ReleaseDataPyxis) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedReleasePlanAdmission.
-func (in *MatchedReleasePlanAdmission) DeepCopy() *MatchedReleasePlanAdmission {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDataPyxis.
+func (in *ReleaseDataPyxis) DeepCopy() *ReleaseDataPyxis {
 	if in == nil {
 		return nil
 	}
-	out := new(MatchedReleasePlanAdmission)
+	out := new(ReleaseDataPyxis)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Param) DeepCopyInto(out *Param) {
+func (in *ReleaseDataSign) DeepCopyInto(out *ReleaseDataSign) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Param.
-func (in *Param) DeepCopy() *Param {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDataSign.
+func (in *ReleaseDataSign) DeepCopy() *ReleaseDataSign {
 	if in == nil {
 		return nil
 	}
-	out := new(Param)
+	out := new(ReleaseDataSign)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineInfo) DeepCopyInto(out *PipelineInfo) {
+func (in *ReleaseDependency) DeepCopyInto(out *ReleaseDependency) {
 	*out = *in
-	if in.CompletionTime != nil {
-		in, out := &in.CompletionTime, &out.CompletionTime
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDependency.
+func (in *ReleaseDependency) DeepCopy() *ReleaseDependency {
+	if in == nil {
+		return nil
 	}
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
-		*out = (*in).DeepCopy()
+	out := new(ReleaseDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseDeadlines) DeepCopyInto(out *ReleaseDeadlines) {
+	*out = *in
+	if in.Execution != nil {
+		in, out := &in.Execution, &out.Execution
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineInfo.
-func (in *PipelineInfo) DeepCopy() *PipelineInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDeadlines.
+func (in *ReleaseDeadlines) DeepCopy() *ReleaseDeadlines {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineInfo)
+	out := new(ReleaseDeadlines)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Release) DeepCopyInto(out *Release) {
+func (in *ReleaseList) DeepCopyInto(out *ReleaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Release, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseList.
+func (in *ReleaseList) DeepCopy() *ReleaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseNotesRepository) DeepCopyInto(out *ReleaseNotesRepository) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseNotesRepository.
+func (in *ReleaseNotesRepository) DeepCopy() *ReleaseNotesRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseNotesRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePipelineCatalog) DeepCopyInto(out *ReleasePipelineCatalog) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Release.
-func (in *Release) DeepCopy() *Release {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePipelineCatalog.
+func (in *ReleasePipelineCatalog) DeepCopy() *ReleasePipelineCatalog {
 	if in == nil {
 		return nil
 	}
-	out := new(Release)
+	out := new(ReleasePipelineCatalog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Release) DeepCopyObject() runtime.Object {
+func (in *ReleasePipelineCatalog) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -157,37 +876,74 @@ func (in *Release) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReleaseList) DeepCopyInto(out *ReleaseList) {
+func (in *ReleasePipelineCatalogList) DeepCopyInto(out *ReleasePipelineCatalogList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Release, len(*in))
+		*out = make([]ReleasePipelineCatalog, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseList.
-func (in *ReleaseList) DeepCopy() *ReleaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePipelineCatalogList.
+func (in *ReleasePipelineCatalogList) DeepCopy() *ReleasePipelineCatalogList {
 	if in == nil {
 		return nil
 	}
-	out := new(ReleaseList)
+	out := new(ReleasePipelineCatalogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReleaseList) DeepCopyObject() runtime.Object {
+func (in *ReleasePipelineCatalogList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePipelineCatalogSpec) DeepCopyInto(out *ReleasePipelineCatalogSpec) {
+	*out = *in
+	if in.Pipelines != nil {
+		in, out := &in.Pipelines, &out.Pipelines
+		*out = make([]CatalogPipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePipelineCatalogSpec.
+func (in *ReleasePipelineCatalogSpec) DeepCopy() *ReleasePipelineCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasePipelineCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePipelineCatalogStatus) DeepCopyInto(out *ReleasePipelineCatalogStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePipelineCatalogStatus.
+func (in *ReleasePipelineCatalogStatus) DeepCopy() *ReleasePipelineCatalogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasePipelineCatalogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleasePlan) DeepCopyInto(out *ReleasePlan) {
 	*out = *in
@@ -234,14 +990,109 @@ func (in *ReleasePlanAdmission) DeepCopy() *ReleasePlanAdmission {
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReleasePlanAdmission) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleasePlanAdmission) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePlanAdmissionDefaults) DeepCopyInto(out *ReleasePlanAdmissionDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanAdmissionDefaults.
+func (in *ReleasePlanAdmissionDefaults) DeepCopy() *ReleasePlanAdmissionDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasePlanAdmissionDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleasePlanAdmissionDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePlanAdmissionDefaultsList) DeepCopyInto(out *ReleasePlanAdmissionDefaultsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReleasePlanAdmissionDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanAdmissionDefaultsList.
+func (in *ReleasePlanAdmissionDefaultsList) DeepCopy() *ReleasePlanAdmissionDefaultsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasePlanAdmissionDefaultsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleasePlanAdmissionDefaultsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePlanAdmissionDefaultsSpec) DeepCopyInto(out *ReleasePlanAdmissionDefaultsSpec) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Timeouts.DeepCopyInto(&out.Timeouts)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanAdmissionDefaultsSpec.
+func (in *ReleasePlanAdmissionDefaultsSpec) DeepCopy() *ReleasePlanAdmissionDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasePlanAdmissionDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePlanAdmissionDefaultsStatus) DeepCopyInto(out *ReleasePlanAdmissionDefaultsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanAdmissionDefaultsStatus.
+func (in *ReleasePlanAdmissionDefaultsStatus) DeepCopy() *ReleasePlanAdmissionDefaultsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasePlanAdmissionDefaultsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleasePlanAdmissionList) DeepCopyInto(out *ReleasePlanAdmissionList) {
 	*out = *in
@@ -282,6 +1133,11 @@ func (in *ReleasePlanAdmissionSpec) DeepCopyInto(out *ReleasePlanAdmissionSpec)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CatalogPipeline != nil {
+		in, out := &in.CatalogPipeline, &out.CatalogPipeline
+		*out = new(CatalogPipelineReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Collectors != nil {
 		in, out := &in.Collectors, &out.Collectors
 		*out = make([]Collector, len(*in))
@@ -294,11 +1150,48 @@ func (in *ReleasePlanAdmissionSpec) DeepCopyInto(out *ReleasePlanAdmissionSpec)
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Pipeline != nil {
 		in, out := &in.Pipeline, &out.Pipeline
 		*out = new(utils.Pipeline)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RegistryCredentials != nil {
+		in, out := &in.RegistryCredentials, &out.RegistryCredentials
+		*out = new(RegistryCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReleaseNotes != nil {
+		in, out := &in.ReleaseNotes, &out.ReleaseNotes
+		*out = new(ReleaseNotesRepository)
+		**out = **in
+	}
+	if in.ReleaseSchedule != nil {
+		in, out := &in.ReleaseSchedule, &out.ReleaseSchedule
+		*out = new(ReleaseSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkippableTasks != nil {
+		in, out := &in.SkippableTasks, &out.SkippableTasks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Strategies != nil {
+		in, out := &in.Strategies, &out.Strategies
+		*out = make([]ReleaseStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ReleaseVerification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanAdmissionSpec.
@@ -390,6 +1283,16 @@ func (in *ReleasePlanSpec) DeepCopyInto(out *ReleasePlanSpec) {
 		*out = new(utils.ParameterizedPipeline)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryBudget != nil {
+		in, out := &in.RetryBudget, &out.RetryBudget
+		*out = new(RetryBudget)
+		**out = **in
+	}
+	if in.SnapshotMaxAge != nil {
+		in, out := &in.SnapshotMaxAge, &out.SnapshotMaxAge
+		*out = new(SnapshotMaxAge)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanSpec.
@@ -413,6 +1316,14 @@ func (in *ReleasePlanStatus) DeepCopyInto(out *ReleasePlanStatus) {
 		}
 	}
 	out.ReleasePlanAdmission = in.ReleasePlanAdmission
+	in.RetryBudget.DeepCopyInto(&out.RetryBudget)
+	if in.Transfers != nil {
+		in, out := &in.Transfers, &out.Transfers
+		*out = make([]ReleasePlanTransfer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanStatus.
@@ -425,6 +1336,66 @@ func (in *ReleasePlanStatus) DeepCopy() *ReleasePlanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasePlanTransfer) DeepCopyInto(out *ReleasePlanTransfer) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanTransfer.
+func (in *ReleasePlanTransfer) DeepCopy() *ReleasePlanTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasePlanTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseReference) DeepCopyInto(out *ReleaseReference) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseReference.
+func (in *ReleaseReference) DeepCopy() *ReleaseReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseSchedule) DeepCopyInto(out *ReleaseSchedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]ReleaseWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSchedule.
+func (in *ReleaseSchedule) DeepCopy() *ReleaseSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseServiceConfig) DeepCopyInto(out *ReleaseServiceConfig) {
 	*out = *in
@@ -487,7 +1458,72 @@ func (in *ReleaseServiceConfigList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseServiceConfigSpec) DeepCopyInto(out *ReleaseServiceConfigSpec) {
 	*out = *in
+	if in.BackoffPolicies != nil {
+		in, out := &in.BackoffPolicies, &out.BackoffPolicies
+		*out = make([]BackoffPolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.CostRates != nil {
+		in, out := &in.CostRates, &out.CostRates
+		*out = new(CostRates)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsBroker != nil {
+		in, out := &in.CredentialsBroker, &out.CredentialsBroker
+		*out = new(CredentialsBroker)
+		**out = **in
+	}
+	if in.DataEncryption != nil {
+		in, out := &in.DataEncryption, &out.DataEncryption
+		*out = new(DataEncryption)
+		**out = **in
+	}
+	if in.Deadlines != nil {
+		in, out := &in.Deadlines, &out.Deadlines
+		*out = new(ReleaseDeadlines)
+		(*in).DeepCopyInto(*out)
+	}
 	in.DefaultTimeouts.DeepCopyInto(&out.DefaultTimeouts)
+	if in.DependencyHealthChecks != nil {
+		in, out := &in.DependencyHealthChecks, &out.DependencyHealthChecks
+		*out = make([]DependencyHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPipelineSchedulingPolicy != nil {
+		in, out := &in.ManagedPipelineSchedulingPolicy, &out.ManagedPipelineSchedulingPolicy
+		*out = new(ManagedPipelineSchedulingPolicy)
+		**out = **in
+	}
+	if in.NodeFailurePolicy != nil {
+		in, out := &in.NodeFailurePolicy, &out.NodeFailurePolicy
+		*out = new(NodeFailurePolicy)
+		**out = **in
+	}
+	if in.OrphanedPipelineRunPolicy != nil {
+		in, out := &in.OrphanedPipelineRunPolicy, &out.OrphanedPipelineRunPolicy
+		*out = new(OrphanedPipelineRunPolicy)
+		**out = **in
+	}
+	if in.PipelineMirror != nil {
+		in, out := &in.PipelineMirror, &out.PipelineMirror
+		*out = new(PipelineMirror)
+		**out = **in
+	}
+	if in.StalledPipelineRunPolicy != nil {
+		in, out := &in.StalledPipelineRunPolicy, &out.StalledPipelineRunPolicy
+		*out = new(StalledPipelineRunPolicy)
+		**out = **in
+	}
+	if in.StatusUpdateInterval != nil {
+		in, out := &in.StatusUpdateInterval, &out.StatusUpdateInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WorkspaceUsagePolicy != nil {
+		in, out := &in.WorkspaceUsagePolicy, &out.WorkspaceUsagePolicy
+		*out = new(WorkspaceUsagePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseServiceConfigSpec.
@@ -523,6 +1559,11 @@ func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = new(ReleaseDependency)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSpec.
@@ -549,6 +1590,11 @@ func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -556,10 +1602,28 @@ func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.Deployment.DeepCopyInto(&out.Deployment)
+	in.Latency.DeepCopyInto(&out.Latency)
 	in.ManagedProcessing.DeepCopyInto(&out.ManagedProcessing)
 	in.PostActionsExecution.DeepCopyInto(&out.PostActionsExecution)
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Summary = in.Summary
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.TenantProcessing.DeepCopyInto(&out.TenantProcessing)
 	in.Validation.DeepCopyInto(&out.Validation)
+	in.Verification.DeepCopyInto(&out.Verification)
 	if in.CompletionTime != nil {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
@@ -568,6 +1632,10 @@ func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ScheduledTime != nil {
+		in, out := &in.ScheduledTime, &out.ScheduledTime
+		*out = (*in).DeepCopy()
+	}
 	if in.ExpirationTime != nil {
 		in, out := &in.ExpirationTime, &out.ExpirationTime
 		*out = (*in).DeepCopy()
@@ -584,9 +1652,196 @@ func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseStrategy) DeepCopyInto(out *ReleaseStrategy) {
+	*out = *in
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(utils.Pipeline)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStrategy.
+func (in *ReleaseStrategy) DeepCopy() *ReleaseStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseSummary) DeepCopyInto(out *ReleaseSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSummary.
+func (in *ReleaseSummary) DeepCopy() *ReleaseSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseVerification) DeepCopyInto(out *ReleaseVerification) {
+	*out = *in
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(utils.Pipeline)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseVerification.
+func (in *ReleaseVerification) DeepCopy() *ReleaseVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseWindow) DeepCopyInto(out *ReleaseWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseWindow.
+func (in *ReleaseWindow) DeepCopy() *ReleaseWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBudget) DeepCopyInto(out *RetryBudget) {
+	*out = *in
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudget.
+func (in *RetryBudget) DeepCopy() *RetryBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBudgetStatus) DeepCopyInto(out *RetryBudgetStatus) {
+	*out = *in
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = make([]v1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudgetStatus.
+func (in *RetryBudgetStatus) DeepCopy() *RetryBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotMaxAge) DeepCopyInto(out *SnapshotMaxAge) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotMaxAge.
+func (in *SnapshotMaxAge) DeepCopy() *SnapshotMaxAge {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotMaxAge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StalledPipelineRunPolicy) DeepCopyInto(out *StalledPipelineRunPolicy) {
+	*out = *in
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StalledPipelineRunPolicy.
+func (in *StalledPipelineRunPolicy) DeepCopy() *StalledPipelineRunPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StalledPipelineRunPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInfo) DeepCopyInto(out *TargetInfo) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInfo.
+func (in *TargetInfo) DeepCopy() *TargetInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationCause) DeepCopyInto(out *ValidationCause) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationCause.
+func (in *ValidationCause) DeepCopy() *ValidationCause {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationCause)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidationInfo) DeepCopyInto(out *ValidationInfo) {
 	*out = *in
+	if in.Causes != nil {
+		in, out := &in.Causes, &out.Causes
+		*out = make([]ValidationCause, len(*in))
+		copy(*out, *in)
+	}
 	if in.Time != nil {
 		in, out := &in.Time, &out.Time
 		*out = (*in).DeepCopy()
@@ -602,3 +1857,23 @@ func (in *ValidationInfo) DeepCopy() *ValidationInfo {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceUsagePolicy) DeepCopyInto(out *WorkspaceUsagePolicy) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceUsagePolicy.
+func (in *WorkspaceUsagePolicy) DeepCopy() *WorkspaceUsagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceUsagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/konflux-ci/release-service/history"
+)
+
+// Backend is an interface to store the records of completed Releases in an external store, so the release history
+// survives the Releases being garbage collected. Storing the same record more than once must not fail.
+type Backend interface {
+	Store(ctx context.Context, record *history.ReleaseRecord) error
+}
+
+// NewBackend creates and returns the Backend matching the scheme of the given url. Object storage buckets exposed over
+// http and https are written using the given http client and bearer token, if any. PostgreSQL databases are reached
+// using the postgres and postgresql schemes, which requires a database/sql driver named postgres to be linked in the
+// binary.
+func NewBackend(backendUrl, token string, httpClient *http.Client) (Backend, error) {
+	parsedUrl, err := url.Parse(backendUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	switch parsedUrl.Scheme {
+	case "http", "https":
+		return NewObjectStorageBackend(parsedUrl, token, httpClient), nil
+	case "postgres", "postgresql":
+		return OpenSQLBackend("postgres", backendUrl, DefaultTableName)
+	default:
+		return nil, fmt.Errorf("unsupported archive backend scheme '%s'", parsedUrl.Scheme)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archive", func() {
+	When("NewBackend is called", func() {
+		It("should return an object storage backend for http urls", func() {
+			backend, err := NewBackend("https://archive.example.com/releases", "", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend).To(BeAssignableToTypeOf(&objectStorageBackend{}))
+		})
+
+		It("should fail for postgres urls if no driver is linked", func() {
+			backend, err := NewBackend("postgres://user@db/releases", "", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(backend).To(BeNil())
+		})
+
+		It("should fail for unsupported schemes", func() {
+			backend, err := NewBackend("ftp://archive.example.com", "", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported archive backend scheme"))
+			Expect(backend).To(BeNil())
+		})
+	})
+
+	When("NewSQLBackend is called", func() {
+		It("should fail if the table name is not valid", func() {
+			backend, err := NewSQLBackend(nil, "releases; DROP TABLE releases")
+			Expect(err).To(HaveOccurred())
+			Expect(backend).To(BeNil())
+		})
+
+		It("should return a backend if the table name is valid", func() {
+			backend, err := NewSQLBackend(nil, DefaultTableName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend).NotTo(BeNil())
+		})
+	})
+})
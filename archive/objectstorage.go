@@ -0,0 +1,89 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/konflux-ci/release-service/history"
+)
+
+// objectStorageBackend stores each record as a JSON object in a bucket exposed over http, such as S3-compatible
+// storages behind a gateway handling the authentication with bearer tokens.
+type objectStorageBackend struct {
+	baseUrl    *url.URL
+	httpClient *http.Client
+	token      string
+}
+
+// NewObjectStorageBackend creates and returns a Backend writing the records under the given base url with the given
+// http client. If a token is given, it's sent as a bearer token in every request.
+func NewObjectStorageBackend(baseUrl *url.URL, token string, httpClient *http.Client) Backend {
+	return &objectStorageBackend{
+		baseUrl:    baseUrl,
+		httpClient: httpClient,
+		token:      token,
+	}
+}
+
+// Store writes the given record as a JSON object named after the namespace and name of the Release and its start time,
+// so Releases recreated with the same name don't overwrite the records of the previous ones.
+func (b *objectStorageBackend) Store(ctx context.Context, record *history.ReleaseRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, b.baseUrl.JoinPath(getObjectName(record)).String(),
+		bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	if b.token != "" {
+		request.Header.Set("Authorization", "Bearer "+b.token)
+	}
+
+	response, err := b.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d storing the record of release %s/%s", response.StatusCode,
+			record.Namespace, record.Name)
+	}
+
+	return nil
+}
+
+// getObjectName returns the name of the object the given record is stored in.
+func getObjectName(record *history.ReleaseRecord) string {
+	var startTime int64
+	if record.StartTime != nil {
+		startTime = record.StartTime.Unix()
+	}
+
+	return fmt.Sprintf("%s/%s-%d.json", record.Namespace, record.Name, startTime)
+}
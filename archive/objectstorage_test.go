@@ -0,0 +1,77 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/konflux-ci/release-service/history"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Object storage backend", func() {
+	var record *history.ReleaseRecord
+
+	BeforeEach(func() {
+		record = &history.ReleaseRecord{
+			Name:      "release",
+			Namespace: "default",
+			StartTime: &metav1.Time{Time: time.Unix(1700000000, 0)},
+		}
+	})
+
+	It("should store the record as a JSON object", func() {
+		var method, path, authorization string
+		stored := &history.ReleaseRecord{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, path, authorization = r.Method, r.URL.Path, r.Header.Get("Authorization")
+			Expect(json.NewDecoder(r.Body).Decode(stored)).To(Succeed())
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		baseUrl, _ := url.Parse(server.URL + "/bucket")
+		backend := NewObjectStorageBackend(baseUrl, "token", server.Client())
+
+		Expect(backend.Store(context.TODO(), record)).To(Succeed())
+		Expect(method).To(Equal(http.MethodPut))
+		Expect(path).To(Equal("/bucket/default/release-1700000000.json"))
+		Expect(authorization).To(Equal("Bearer token"))
+		Expect(stored.Name).To(Equal("release"))
+	})
+
+	It("should fail if the storage returns an error", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		baseUrl, _ := url.Parse(server.URL)
+		backend := NewObjectStorageBackend(baseUrl, "", server.Client())
+
+		err := backend.Store(context.TODO(), record)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status 403"))
+	})
+})
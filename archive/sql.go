@@ -0,0 +1,131 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/konflux-ci/release-service/history"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultTableName is the name of the table the records are stored in by default
+const DefaultTableName = "release_history"
+
+// tableNameRegex matches the table names that can be safely used in the queries
+var tableNameRegex = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// sqlBackend stores each record as a row in a PostgreSQL table.
+type sqlBackend struct {
+	db    *sql.DB
+	table string
+
+	mutex        sync.Mutex
+	tableCreated bool
+}
+
+// NewSQLBackend creates and returns a Backend writing the records into the given table of the given database. The
+// table is created on the first write if it doesn't exist.
+func NewSQLBackend(db *sql.DB, table string) (Backend, error) {
+	if !tableNameRegex.MatchString(table) {
+		return nil, fmt.Errorf("invalid table name '%s'", table)
+	}
+
+	return &sqlBackend{
+		db:    db,
+		table: table,
+	}, nil
+}
+
+// OpenSQLBackend opens the database with the given driver and data source name and returns a Backend writing the
+// records into the given table. An error is returned if the driver is not linked in the binary.
+func OpenSQLBackend(driver, dataSourceName, table string) (Backend, error) {
+	db, err := sql.Open(driver, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSQLBackend(db, table)
+}
+
+// Store inserts the given record in the table. Records of Releases already archived are ignored, so storing them more
+// than once doesn't fail.
+func (b *sqlBackend) Store(ctx context.Context, record *history.ReleaseRecord) error {
+	if err := b.ensureTableExists(ctx); err != nil {
+		return err
+	}
+
+	var artifacts []byte
+	if record.Artifacts != nil {
+		artifacts = record.Artifacts.Raw
+	}
+
+	_, err := b.db.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %s
+		(namespace, name, start_time, completion_time, application, release_plan, snapshot, target, phase, message, artifacts)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT DO NOTHING`, b.table),
+		record.Namespace, record.Name, getTime(record.StartTime), getTime(record.CompletionTime), record.Application,
+		record.ReleasePlan, record.Snapshot, record.Target, string(record.Phase), record.Message, artifacts)
+
+	return err
+}
+
+// ensureTableExists creates the table if it wasn't created yet by this backend.
+func (b *sqlBackend) ensureTableExists(ctx context.Context) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.tableCreated {
+		return nil
+	}
+
+	_, err := b.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		namespace TEXT NOT NULL,
+		name TEXT NOT NULL,
+		start_time TIMESTAMPTZ,
+		completion_time TIMESTAMPTZ,
+		application TEXT,
+		release_plan TEXT,
+		snapshot TEXT,
+		target TEXT,
+		phase TEXT,
+		message TEXT,
+		artifacts JSONB,
+		UNIQUE (namespace, name, start_time)
+	)`, b.table))
+	if err != nil {
+		return err
+	}
+
+	b.tableCreated = true
+
+	return nil
+}
+
+// getTime returns the time of the given metav1.Time, or nil if it's not set.
+func getTime(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return &t.Time
+}
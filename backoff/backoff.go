@@ -0,0 +1,191 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backoff
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Category is a category of operations sharing the same backoff policy.
+type Category string
+
+const (
+	// ConflictCategory is the category of the reconciles failing because an update was rejected due to a conflict
+	ConflictCategory Category = "Conflict"
+
+	// DependencyCategory is the category of the waits for the objects and services a resource depends on
+	DependencyCategory Category = "Dependency"
+
+	// NotificationCategory is the category of the deliveries of outbox events
+	NotificationCategory Category = "Notification"
+
+	// PipelineResolutionCategory is the category of the resolutions of the Pipelines of ReleasePlanAdmissions
+	PipelineResolutionCategory Category = "PipelineResolution"
+)
+
+// Policy defines the exponential backoff used to retry a category of operations.
+type Policy struct {
+	// Factor is the number each delay is multiplied by to get the next one
+	Factor int
+
+	// InitialDelay is the delay before the first retry
+	InitialDelay time.Duration
+
+	// Jitter is the maximum fraction of each delay randomly added to or removed from it
+	Jitter float64
+
+	// MaxDelay is the maximum delay between retries
+	MaxDelay time.Duration
+}
+
+// defaultPolicies contains the policy of each category used when the ReleaseServiceConfig doesn't define one.
+var defaultPolicies = map[Category]Policy{
+	ConflictCategory:           {Factor: 2, InitialDelay: time.Second, Jitter: 0.2, MaxDelay: time.Minute},
+	DependencyCategory:         {Factor: 2, InitialDelay: 30 * time.Second, Jitter: 0.2, MaxDelay: 5 * time.Minute},
+	NotificationCategory:       {Factor: 2, InitialDelay: 5 * time.Second, Jitter: 0.2, MaxDelay: 10 * time.Minute},
+	PipelineResolutionCategory: {Factor: 2, InitialDelay: time.Minute, Jitter: 0.2, MaxDelay: time.Hour},
+}
+
+// getDelay returns the delay before the retry following the given number of attempts. The given random number, which
+// is expected to be in [0, 1), determines the jitter applied to the delay.
+func (p Policy) getDelay(attempts int, random float64) time.Duration {
+	delay := p.InitialDelay
+	for i := 0; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= time.Duration(p.Factor)
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return delay + time.Duration(float64(delay)*p.Jitter*(2*random-1))
+}
+
+// GetPolicy returns the policy the given ReleaseServiceConfig defines for the given category. If the config is nil or
+// doesn't define a policy for the category, its default policy is returned.
+func GetPolicy(releaseServiceConfig *v1alpha1.ReleaseServiceConfig, category Category) Policy {
+	if releaseServiceConfig != nil {
+		for _, backoffPolicy := range releaseServiceConfig.Spec.BackoffPolicies {
+			if backoffPolicy.Category != string(category) {
+				continue
+			}
+
+			policy := Policy{
+				Factor:       backoffPolicy.Factor,
+				InitialDelay: backoffPolicy.InitialDelay.Duration,
+				Jitter:       float64(backoffPolicy.JitterPercent) / 100,
+				MaxDelay:     backoffPolicy.MaxDelay.Duration,
+			}
+			if policy.Factor < 1 {
+				policy.Factor = 2
+			}
+
+			return policy
+		}
+	}
+
+	return defaultPolicies[category]
+}
+
+// LoadPolicy returns the policy the ReleaseServiceConfig of the namespace set in the SERVICE_NAMESPACE environment
+// variable defines for the given category. If the config can't be loaded, the default policy of the category is
+// returned, so retries are never blocked by the config.
+func LoadPolicy(ctx context.Context, cli client.Client, objectLoader loader.ObjectLoader, category Category) Policy {
+	releaseServiceConfig, err := objectLoader.GetReleaseServiceConfig(ctx, cli,
+		v1alpha1.ReleaseServiceConfigResourceName, os.Getenv("SERVICE_NAMESPACE"))
+	if err != nil {
+		return GetPolicy(nil, category)
+	}
+
+	return GetPolicy(releaseServiceConfig, category)
+}
+
+// attemptsKey identifies the attempts of a category of operations on a resource.
+type attemptsKey struct {
+	category Category
+	key      string
+}
+
+// Backoff tracks the attempts of each category of operations on each resource to compute the delay before retrying
+// them. It's safe for concurrent use, so a single Backoff can be shared by all the reconciles of a controller. A nil
+// Backoff doesn't track attempts, so it always returns the delay of the first retry.
+type Backoff struct {
+	attempts map[attemptsKey]int
+	mutex    sync.Mutex
+	random   func() float64
+}
+
+// New creates and returns a new Backoff.
+func New() *Backoff {
+	return &Backoff{
+		attempts: make(map[attemptsKey]int),
+		random:   rand.Float64,
+	}
+}
+
+// Next records a new attempt of the given category of operations on the resource with the given key and returns the
+// delay the given policy defines before retrying it.
+func (b *Backoff) Next(category Category, key string, policy Policy) time.Duration {
+	if b == nil {
+		return policy.getDelay(0, rand.Float64())
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	attempts := b.attempts[attemptsKey{category, key}]
+	b.attempts[attemptsKey{category, key}] = attempts + 1
+
+	return policy.getDelay(attempts, b.random())
+}
+
+// Reset forgets the attempts of the given category of operations on the resource with the given key, so the next
+// retry waits for the initial delay again. It should be called once the operations succeed.
+func (b *Backoff) Reset(category Category, key string) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	delete(b.attempts, attemptsKey{category, key})
+}
+
+// Forget forgets the attempts of every category of operations on the resource with the given key. It should be
+// called once the resource is deleted.
+func (b *Backoff) Forget(key string) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for id := range b.attempts {
+		if id.key == key {
+			delete(b.attempts, id)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backoff
+
+import (
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Backoff", func() {
+	policy := Policy{Factor: 2, InitialDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	When("getDelay is called", func() {
+		It("should multiply the delay by the factor after each attempt", func() {
+			Expect(policy.getDelay(0, 0.5)).To(Equal(time.Second))
+			Expect(policy.getDelay(1, 0.5)).To(Equal(2 * time.Second))
+			Expect(policy.getDelay(2, 0.5)).To(Equal(4 * time.Second))
+		})
+
+		It("should not exceed the maximum delay", func() {
+			Expect(policy.getDelay(3, 0.5)).To(Equal(5 * time.Second))
+			Expect(policy.getDelay(100, 0.5)).To(Equal(5 * time.Second))
+		})
+
+		It("should apply the jitter", func() {
+			jitteredPolicy := policy
+			jitteredPolicy.Jitter = 0.5
+			Expect(jitteredPolicy.getDelay(1, 0)).To(Equal(time.Second))
+			Expect(jitteredPolicy.getDelay(1, 0.75)).To(Equal(2500 * time.Millisecond))
+		})
+	})
+
+	When("GetPolicy is called", func() {
+		It("should return the default policy if no config is passed", func() {
+			Expect(GetPolicy(nil, ConflictCategory)).To(Equal(defaultPolicies[ConflictCategory]))
+		})
+
+		It("should return the default policy if the config doesn't define one for the category", func() {
+			releaseServiceConfig := &v1alpha1.ReleaseServiceConfig{
+				Spec: v1alpha1.ReleaseServiceConfigSpec{
+					BackoffPolicies: []v1alpha1.BackoffPolicy{{Category: "Notification"}},
+				},
+			}
+			Expect(GetPolicy(releaseServiceConfig, DependencyCategory)).To(Equal(defaultPolicies[DependencyCategory]))
+		})
+
+		It("should return the policy defined in the config", func() {
+			releaseServiceConfig := &v1alpha1.ReleaseServiceConfig{
+				Spec: v1alpha1.ReleaseServiceConfigSpec{
+					BackoffPolicies: []v1alpha1.BackoffPolicy{
+						{
+							Category:      "Dependency",
+							InitialDelay:  metav1.Duration{Duration: time.Minute},
+							JitterPercent: 10,
+							MaxDelay:      metav1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+			}
+			Expect(GetPolicy(releaseServiceConfig, DependencyCategory)).To(Equal(Policy{
+				Factor:       2,
+				InitialDelay: time.Minute,
+				Jitter:       0.1,
+				MaxDelay:     time.Hour,
+			}))
+		})
+	})
+
+	When("Next is called", func() {
+		It("should increase the delay with each attempt", func() {
+			backoff := New()
+			backoff.random = func() float64 { return 0.5 }
+			Expect(backoff.Next(ConflictCategory, "foo", policy)).To(Equal(time.Second))
+			Expect(backoff.Next(ConflictCategory, "foo", policy)).To(Equal(2 * time.Second))
+			Expect(backoff.Next(DependencyCategory, "foo", policy)).To(Equal(time.Second))
+			Expect(backoff.Next(ConflictCategory, "bar", policy)).To(Equal(time.Second))
+		})
+
+		It("should return the initial delay if the backoff is nil", func() {
+			var backoff *Backoff
+			Expect(backoff.Next(ConflictCategory, "foo", policy)).To(Equal(time.Second))
+			Expect(backoff.Next(ConflictCategory, "foo", policy)).To(Equal(time.Second))
+		})
+	})
+
+	When("Reset is called", func() {
+		It("should only reset the attempts of the given category", func() {
+			backoff := New()
+			backoff.random = func() float64 { return 0.5 }
+			backoff.Next(ConflictCategory, "foo", policy)
+			backoff.Next(DependencyCategory, "foo", policy)
+			backoff.Reset(ConflictCategory, "foo")
+			Expect(backoff.Next(ConflictCategory, "foo", policy)).To(Equal(time.Second))
+			Expect(backoff.Next(DependencyCategory, "foo", policy)).To(Equal(2 * time.Second))
+		})
+	})
+
+	When("Forget is called", func() {
+		It("should reset the attempts of every category of the given key", func() {
+			backoff := New()
+			backoff.Next(ConflictCategory, "foo", policy)
+			backoff.Next(DependencyCategory, "foo", policy)
+			backoff.Next(DependencyCategory, "bar", policy)
+			backoff.Forget("foo")
+			Expect(backoff.attempts).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// objectExtension is the extension used for the keys of the exported objects
+const objectExtension = ".json"
+
+// Backup exports Releases to an ObjectStore and imports them back as historical Releases.
+type Backup struct {
+	client client.Client
+	ctx    context.Context
+	logger *logr.Logger
+	store  ObjectStore
+}
+
+// NewBackup creates a new Backup with the given client, logger and store.
+func NewBackup(client client.Client, logger *logr.Logger, store ObjectStore) *Backup {
+	return NewBackupWithContext(client, logger, store, context.TODO())
+}
+
+// NewBackupWithContext creates a new Backup with the given client, logger, store and context.
+func NewBackupWithContext(client client.Client, logger *logr.Logger, store ObjectStore, ctx context.Context) *Backup {
+	return &Backup{
+		client: client,
+		ctx:    ctx,
+		logger: logger,
+		store:  store,
+	}
+}
+
+// ExportReleases serializes every finished Release in the given namespace (or in all namespaces if empty), including
+// its status, and saves it in the store. Releases still in progress are ignored. The number of exported Releases is
+// returned.
+func (b *Backup) ExportReleases(namespace string) (int, error) {
+	releases := &v1alpha1.ReleaseList{}
+	err := b.client.List(b.ctx, releases, client.InNamespace(namespace))
+	if err != nil {
+		return 0, err
+	}
+
+	exported := 0
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if !release.HasReleaseFinished() {
+			continue
+		}
+
+		data, err := json.Marshal(getExportableRelease(release))
+		if err != nil {
+			return exported, err
+		}
+
+		err = b.store.Put(b.ctx, getReleaseKey(release), data)
+		if err != nil {
+			return exported, err
+		}
+
+		b.logger.Info("Exported Release", "Release.Name", release.Name, "Release.Namespace", release.Namespace)
+		exported++
+	}
+
+	return exported, nil
+}
+
+// ImportReleases recreates every Release found in the store as a read-only historical Release, restoring its status.
+// Releases that already exist in the cluster are ignored. The number of imported Releases is returned.
+func (b *Backup) ImportReleases() (int, error) {
+	keys, err := b.store.List(b.ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	imported := 0
+	for _, key := range keys {
+		data, err := b.store.Get(b.ctx, key)
+		if err != nil {
+			return imported, err
+		}
+
+		release := &v1alpha1.Release{}
+		if err = json.Unmarshal(data, release); err != nil {
+			return imported, fmt.Errorf("unable to read Release from %s: %w", key, err)
+		}
+
+		created, err := b.importRelease(release)
+		if err != nil {
+			return imported, err
+		}
+
+		if created {
+			b.logger.Info("Imported Release", "Release.Name", release.Name, "Release.Namespace", release.Namespace)
+			imported++
+		}
+	}
+
+	return imported, nil
+}
+
+// importRelease creates the given Release labeled as historical and restores its status. If the Release already
+// exists, no operations will be taken and false will be returned.
+func (b *Backup) importRelease(release *v1alpha1.Release) (bool, error) {
+	status := release.Status
+
+	release.ResourceVersion = ""
+	metadata.AddLabels(release, map[string]string{metadata.HistoricalLabel: "true"})
+
+	err := b.client.Create(b.ctx, release)
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	release.Status = status
+
+	return true, b.client.Status().Update(b.ctx, release)
+}
+
+// getExportableRelease returns a copy of the given Release without the metadata fields that are specific to the
+// cluster it was created in.
+func getExportableRelease(release *v1alpha1.Release) *v1alpha1.Release {
+	exportableRelease := release.DeepCopy()
+	exportableRelease.ObjectMeta = metav1.ObjectMeta{
+		Name:              release.Name,
+		Namespace:         release.Namespace,
+		Annotations:       release.Annotations,
+		Labels:            release.Labels,
+		CreationTimestamp: release.CreationTimestamp,
+	}
+	exportableRelease.APIVersion = v1alpha1.GroupVersion.String()
+	exportableRelease.Kind = "Release"
+
+	return exportableRelease
+}
+
+// getReleaseKey returns the key used to save the given Release in the store.
+func getReleaseKey(release *v1alpha1.Release) string {
+	return fmt.Sprintf("releases/%s/%s%s", release.Namespace, release.Name, objectExtension)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Backup", Ordered, func() {
+	var (
+		releaseBackup   *Backup
+		store           *FileObjectStore
+		finishedRelease *v1alpha1.Release
+		runningRelease  *v1alpha1.Release
+		createResources func()
+		deleteResources func()
+	)
+
+	BeforeAll(func() {
+		store = NewFileObjectStore(GinkgoT().TempDir())
+		releaseBackup = NewBackupWithContext(k8sClient, &ctrl.Log, store, ctx)
+		createResources()
+	})
+
+	AfterAll(func() {
+		deleteResources()
+	})
+
+	When("ExportReleases is called", func() {
+		It("should export only the finished Releases", func() {
+			count, err := releaseBackup.ExportReleases("default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+
+			keys, err := store.List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]string{getReleaseKey(finishedRelease)}))
+		})
+
+		It("should export the Release status without cluster specific metadata", func() {
+			data, err := store.Get(ctx, getReleaseKey(finishedRelease))
+			Expect(err).NotTo(HaveOccurred())
+
+			release := &v1alpha1.Release{}
+			Expect(json.Unmarshal(data, release)).To(Succeed())
+			Expect(release.Name).To(Equal(finishedRelease.Name))
+			Expect(release.UID).To(BeEmpty())
+			Expect(release.ResourceVersion).To(BeEmpty())
+			Expect(release.IsReleased()).To(BeTrue())
+		})
+	})
+
+	When("ImportReleases is called", func() {
+		It("should not import Releases that already exist", func() {
+			count, err := releaseBackup.ImportReleases()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(0))
+		})
+
+		It("should import the Releases as historical Releases restoring their status", func() {
+			Expect(k8sClient.Delete(ctx, finishedRelease)).To(Succeed())
+
+			count, err := releaseBackup.ImportReleases()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(finishedRelease), release)).To(Succeed())
+			Expect(release.GetLabels()).To(HaveKeyWithValue(metadata.HistoricalLabel, "true"))
+			Expect(release.IsReleased()).To(BeTrue())
+		})
+	})
+
+	createResources = func() {
+		finishedRelease = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "finished-release",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				Snapshot:    "snapshot",
+				ReleasePlan: "releaseplan",
+			},
+		}
+		Expect(k8sClient.Create(ctx, finishedRelease)).To(Succeed())
+		finishedRelease.MarkReleasing("")
+		finishedRelease.MarkReleased()
+		Expect(k8sClient.Status().Update(ctx, finishedRelease)).To(Succeed())
+
+		runningRelease = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "running-release",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				Snapshot:    "snapshot",
+				ReleasePlan: "releaseplan",
+			},
+		}
+		Expect(k8sClient.Create(ctx, runningRelease)).To(Succeed())
+	}
+
+	deleteResources = func() {
+		Expect(k8sClient.Delete(ctx, finishedRelease)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, runningRelease)).To(Succeed())
+	}
+})
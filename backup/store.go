@@ -0,0 +1,86 @@
+/*
+Copyright 2024 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ObjectStore represents a storage where exported objects can be written to and read from.
+type ObjectStore interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	List(ctx context.Context) ([]string, error)
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// FileObjectStore is an ObjectStore that saves objects as files within a directory. Object storage buckets (S3/GCS)
+// can be used by mounting them in the filesystem or by syncing the directory with them.
+type FileObjectStore struct {
+	dir string
+}
+
+// NewFileObjectStore creates a new FileObjectStore that saves objects within the given directory.
+func NewFileObjectStore(dir string) *FileObjectStore {
+	return &FileObjectStore{
+		dir: dir,
+	}
+}
+
+// Get returns the contents of the object saved with the given key.
+func (s *FileObjectStore) Get(_ context.Context, key string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, filepath.FromSlash(key)))
+}
+
+// List returns the sorted keys of all the objects saved in the store.
+func (s *FileObjectStore) List(_ context.Context) ([]string, error) {
+	var keys []string
+	err := filepath.WalkDir(s.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !strings.HasSuffix(path, objectExtension) {
+			return err
+		}
+
+		key, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(key))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// Put saves the given data as an object with the given key, overwriting it if it already exists.
+func (s *FileObjectStore) Put(_ context.Context, key string, data []byte) error {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileObjectStore", func() {
+	var store *FileObjectStore
+
+	BeforeEach(func() {
+		store = NewFileObjectStore(GinkgoT().TempDir())
+	})
+
+	It("should return the data of an object that was put", func() {
+		Expect(store.Put(ctx, "releases/default/release.json", []byte("foo"))).To(Succeed())
+
+		data, err := store.Get(ctx, "releases/default/release.json")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal([]byte("foo")))
+	})
+
+	It("should fail to get an object that doesn't exist", func() {
+		_, err := store.Get(ctx, "releases/default/missing.json")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should list the sorted keys of all the objects", func() {
+		Expect(store.Put(ctx, "releases/other/release.json", []byte("foo"))).To(Succeed())
+		Expect(store.Put(ctx, "releases/default/release.json", []byte("bar"))).To(Succeed())
+		Expect(store.Put(ctx, "releases/default/ignored.txt", []byte("baz"))).To(Succeed())
+
+		keys, err := store.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(Equal([]string{"releases/default/release.json", "releases/other/release.json"}))
+	})
+})
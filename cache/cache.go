@@ -18,9 +18,12 @@ package cache
 
 import (
 	"context"
+	"strings"
 
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -45,6 +48,52 @@ func SetupReleaseCache(mgr ctrl.Manager) error {
 		"spec.releasePlan", releaseIndexFunc)
 }
 
+// SetupReleaseIdempotencyKeyCache adds a new index field to be able to search Releases by idempotency key.
+func SetupReleaseIdempotencyKeyCache(mgr ctrl.Manager) error {
+	releaseIndexFunc := func(obj client.Object) []string {
+		return []string{obj.(*v1alpha1.Release).Spec.IdempotencyKey}
+	}
+
+	return ignoreIndexConflict(mgr.GetCache().IndexField(context.Background(), &v1alpha1.Release{},
+		"spec.idempotencyKey", releaseIndexFunc))
+}
+
+// SetupReleaseSnapshotCache adds a new index field to be able to search Releases by Snapshot name.
+func SetupReleaseSnapshotCache(mgr ctrl.Manager) error {
+	releaseIndexFunc := func(obj client.Object) []string {
+		return []string{obj.(*v1alpha1.Release).Spec.Snapshot}
+	}
+
+	return mgr.GetCache().IndexField(context.Background(), &v1alpha1.Release{},
+		"spec.snapshot", releaseIndexFunc)
+}
+
+// SetupReleaseTargetCache adds a new index field to be able to search Releases by target namespace.
+func SetupReleaseTargetCache(mgr ctrl.Manager) error {
+	releaseIndexFunc := func(obj client.Object) []string {
+		return []string{obj.(*v1alpha1.Release).Status.Target}
+	}
+
+	return mgr.GetCache().IndexField(context.Background(), &v1alpha1.Release{},
+		"status.target", releaseIndexFunc)
+}
+
+// SetupReleasePipelineRunCache adds a new index field to be able to search PipelineRuns by the Release they were
+// created for, in the namespace/name form.
+func SetupReleasePipelineRunCache(mgr ctrl.Manager) error {
+	pipelineRunIndexFunc := func(obj client.Object) []string {
+		release, found := metadata.GetTrackedRelease(obj)
+		if !found {
+			return nil
+		}
+
+		return []string{release.String()}
+	}
+
+	return ignoreIndexConflict(mgr.GetCache().IndexField(context.Background(), &tektonv1.PipelineRun{},
+		"metadata.release", pipelineRunIndexFunc))
+}
+
 // SetupReleasePlanCache adds a new index field to be able to search ReleasePlans by target.
 func SetupReleasePlanCache(mgr ctrl.Manager) error {
 	releasePlanIndexFunc := func(obj client.Object) []string {
@@ -61,6 +110,27 @@ func SetupReleasePlanAdmissionCache(mgr ctrl.Manager) error {
 		return []string{obj.(*v1alpha1.ReleasePlanAdmission).Spec.Origin}
 	}
 
-	return mgr.GetCache().IndexField(context.Background(), &v1alpha1.ReleasePlanAdmission{},
-		"spec.origin", releasePlanAdmissionIndexFunc)
+	return ignoreIndexConflict(mgr.GetCache().IndexField(context.Background(), &v1alpha1.ReleasePlanAdmission{},
+		"spec.origin", releasePlanAdmissionIndexFunc))
+}
+
+// SetupSnapshotEnvironmentBindingCache adds a new index field to be able to search SnapshotEnvironmentBindings by
+// Environment.
+func SetupSnapshotEnvironmentBindingCache(mgr ctrl.Manager) error {
+	snapshotEnvironmentBindingIndexFunc := func(obj client.Object) []string {
+		return []string{obj.(*applicationapiv1alpha1.SnapshotEnvironmentBinding).Spec.Environment}
+	}
+
+	return ignoreIndexConflict(mgr.GetCache().IndexField(context.Background(), &applicationapiv1alpha1.SnapshotEnvironmentBinding{},
+		"spec.environment", snapshotEnvironmentBindingIndexFunc))
+}
+
+// ignoreIndexConflict returns nil if the given error was caused by an index field that was already added to the cache.
+// This allows controllers sharing an index to set it up independently, so they can also be enabled independently.
+func ignoreIndexConflict(err error) error {
+	if err != nil && strings.Contains(err.Error(), "indexer conflict") {
+		return nil
+	}
+
+	return err
 }
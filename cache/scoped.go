@@ -0,0 +1,790 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// clusterWideObjects contains the kinds that are always cached in every namespace, as they are needed to determine
+// the namespaces the rest of the objects are cached in.
+var clusterWideObjects = []client.Object{
+	&v1alpha1.ReleasePlan{},
+	&v1alpha1.ReleasePlanAdmission{},
+	&v1alpha1.ReleaseServiceConfig{},
+}
+
+// ScopedCache is a cache.Cache that only caches namespaced objects in the namespaces involved in releases, which are
+// the namespaces with ReleasePlans or ReleasePlanAdmissions and the namespaces they point to. The namespaces are
+// adjusted as ReleasePlans and ReleasePlanAdmissions are created and deleted, starting and stopping a cache for each
+// of them. Objects in namespaces out of the scope or whose cache is not synced yet, as well as the lists spanning all
+// the namespaces, are read directly from the API server.
+type ScopedCache struct {
+	apiReader          client.Reader
+	clusterCache       crcache.Cache
+	newNamespacedCache func(namespace string) (crcache.Cache, error)
+	scheme             *runtime.Scheme
+	restMapper         apimeta.RESTMapper
+	staticNamespaces   []string
+
+	mutex      sync.RWMutex
+	ctx        context.Context
+	indexes    []fieldIndex
+	informers  map[schema.GroupVersionKind]*scopedInformer
+	namespaces map[string]*namespacedCache
+	ready      chan struct{}
+}
+
+// fieldIndex describes an index added to the namespaced caches.
+type fieldIndex struct {
+	extractValue client.IndexerFunc
+	field        string
+	gvk          schema.GroupVersionKind
+	object       client.Object
+}
+
+// namespacedCache holds the cache of a single namespace in the scope.
+type namespacedCache struct {
+	cache  crcache.Cache
+	cancel context.CancelFunc
+	synced bool
+}
+
+var _ crcache.Cache = &ScopedCache{}
+
+// NewScopedCacheFunc returns a cache.NewCacheFunc creating a ScopedCache. The given namespaces are always part of the
+// scope, so they can be used for the namespace the Release Service runs in.
+func NewScopedCacheFunc(namespaces ...string) crcache.NewCacheFunc {
+	return func(config *rest.Config, opts crcache.Options) (crcache.Cache, error) {
+		clusterCache, err := crcache.New(config, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		apiReader, err := client.New(config, client.Options{
+			HTTPClient: opts.HTTPClient,
+			Mapper:     opts.Mapper,
+			Scheme:     opts.Scheme,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &ScopedCache{
+			apiReader:    apiReader,
+			clusterCache: clusterCache,
+			newNamespacedCache: func(namespace string) (crcache.Cache, error) {
+				namespacedOpts := opts
+				namespacedOpts.DefaultNamespaces = map[string]crcache.Config{namespace: {}}
+
+				return crcache.New(config, namespacedOpts)
+			},
+			scheme:           opts.Scheme,
+			restMapper:       opts.Mapper,
+			staticNamespaces: namespaces,
+			informers:        map[schema.GroupVersionKind]*scopedInformer{},
+			namespaces:       map[string]*namespacedCache{},
+			ready:            make(chan struct{}),
+		}, nil
+	}
+}
+
+// Get retrieves the given object from the cache of its namespace, or from the API server if the namespace is not in
+// the scope.
+func (c *ScopedCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	scoped, err := c.isScoped(obj)
+	if err != nil {
+		return err
+	}
+	if !scoped {
+		return c.clusterCache.Get(ctx, key, obj, opts...)
+	}
+
+	c.mutex.RLock()
+	namespaced, found := c.namespaces[key.Namespace]
+	synced := found && namespaced.synced
+	c.mutex.RUnlock()
+	if !synced {
+		return c.apiReader.Get(ctx, key, obj, opts...)
+	}
+
+	return namespaced.cache.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves the list of objects matching the given options from the cache of the given namespace, or from the
+// API server if the namespace is not in the scope or its cache is not synced yet. When no namespace is given, the
+// objects are read from the API server, as the scope doesn't cover every namespace.
+func (c *ScopedCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	scoped, err := c.isScoped(list)
+	if err != nil {
+		return err
+	}
+	if !scoped {
+		return c.clusterCache.List(ctx, list, opts...)
+	}
+
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	if listOpts.Namespace == "" {
+		return c.listFromAPIServer(ctx, list, &listOpts)
+	}
+
+	c.mutex.RLock()
+	namespaced, found := c.namespaces[listOpts.Namespace]
+	synced := found && namespaced.synced
+	c.mutex.RUnlock()
+	if !synced {
+		return c.listFromAPIServer(ctx, list, &listOpts)
+	}
+
+	return namespaced.cache.List(ctx, list, &listOpts)
+}
+
+// GetInformer returns an informer for the given object that aggregates the informers of all the namespaces in the
+// scope, including the ones added later on.
+func (c *ScopedCache) GetInformer(ctx context.Context, obj client.Object, opts ...crcache.InformerGetOption) (crcache.Informer, error) {
+	scoped, err := c.isScoped(obj)
+	if err != nil {
+		return nil, err
+	}
+	if !scoped {
+		return c.clusterCache.GetInformer(ctx, obj, opts...)
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	informer, found := c.informers[gvk]
+	if found {
+		return informer, nil
+	}
+
+	informer = &scopedInformer{
+		informers: map[string]crcache.Informer{},
+		object:    obj,
+	}
+	for namespace, namespaced := range c.namespaces {
+		err = informer.addNamespace(ctx, namespace, namespaced.cache)
+		if err != nil {
+			return nil, err
+		}
+	}
+	c.informers[gvk] = informer
+
+	return informer, nil
+}
+
+// GetInformerForKind is similar to GetInformer, except that it takes a group-version-kind instead of an object.
+func (c *ScopedCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind, opts ...crcache.InformerGetOption) (crcache.Informer, error) {
+	obj, err := c.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	clientObj, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a client.Object", gvk)
+	}
+
+	return c.GetInformer(ctx, clientObj, opts...)
+}
+
+// RemoveInformer removes the informer of the given object from all the namespaces in the scope.
+func (c *ScopedCache) RemoveInformer(ctx context.Context, obj client.Object) error {
+	scoped, err := c.isScoped(obj)
+	if err != nil {
+		return err
+	}
+	if !scoped {
+		return c.clusterCache.RemoveInformer(ctx, obj)
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.informers, gvk)
+	for _, namespaced := range c.namespaces {
+		err = namespaced.cache.RemoveInformer(ctx, obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IndexField adds an index to the given object in all the namespaces in the scope, including the ones added later on.
+func (c *ScopedCache) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	scoped, err := c.isScoped(obj)
+	if err != nil {
+		return err
+	}
+	if !scoped {
+		return c.clusterCache.IndexField(ctx, obj, field, extractValue)
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, index := range c.indexes {
+		if index.field == field && index.gvk == gvk {
+			return fmt.Errorf("indexer conflict: field %s is already indexed for %s", field, gvk)
+		}
+	}
+
+	for _, namespaced := range c.namespaces {
+		err = namespaced.cache.IndexField(ctx, obj, field, extractValue)
+		if err != nil {
+			return err
+		}
+	}
+	c.indexes = append(c.indexes, fieldIndex{extractValue: extractValue, field: field, gvk: gvk, object: obj})
+
+	return nil
+}
+
+// Start starts the cluster wide cache and adjusts the namespaces in the scope every time a ReleasePlan or
+// ReleasePlanAdmission changes. It blocks until the given context is done.
+func (c *ScopedCache) Start(ctx context.Context) error {
+	logger := ctrl.Log.WithName("scoped-cache")
+
+	c.mutex.Lock()
+	c.ctx = ctx
+	c.mutex.Unlock()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- c.clusterCache.Start(ctx)
+	}()
+
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify() },
+		UpdateFunc: func(interface{}, interface{}) { notify() },
+		DeleteFunc: func(interface{}) { notify() },
+	}
+	for _, obj := range []client.Object{&v1alpha1.ReleasePlan{}, &v1alpha1.ReleasePlanAdmission{}} {
+		informer, err := c.clusterCache.GetInformer(ctx, obj)
+		if err != nil {
+			return err
+		}
+
+		_, err = informer.AddEventHandler(handler)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !c.clusterCache.WaitForCacheSync(ctx) {
+		return fmt.Errorf("failed to sync the cluster wide cache")
+	}
+
+	err := c.updateNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	close(c.ready)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			return err
+		case <-changes:
+			err := c.updateNamespaces(ctx)
+			if err != nil {
+				logger.Error(err, "Failed to update the namespaces in the cache scope")
+			}
+		}
+	}
+}
+
+// WaitForCacheSync waits for the cluster wide cache and the caches of the namespaces in the scope to be synced.
+func (c *ScopedCache) WaitForCacheSync(ctx context.Context) bool {
+	select {
+	case <-c.ready:
+	case <-ctx.Done():
+		return false
+	}
+
+	if !c.clusterCache.WaitForCacheSync(ctx) {
+		return false
+	}
+
+	c.mutex.RLock()
+	caches := make([]crcache.Cache, 0, len(c.namespaces))
+	for _, namespaced := range c.namespaces {
+		caches = append(caches, namespaced.cache)
+	}
+	c.mutex.RUnlock()
+
+	for _, namespaceCache := range caches {
+		if !namespaceCache.WaitForCacheSync(ctx) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getScopedNamespaces returns the set of namespaces that should be in the scope of the cache.
+func (c *ScopedCache) getScopedNamespaces(ctx context.Context) (map[string]bool, error) {
+	namespaces := map[string]bool{}
+	for _, namespace := range c.staticNamespaces {
+		namespaces[namespace] = true
+	}
+
+	releasePlans := &v1alpha1.ReleasePlanList{}
+	err := c.clusterCache.List(ctx, releasePlans)
+	if err != nil {
+		return nil, err
+	}
+	for _, releasePlan := range releasePlans.Items {
+		namespaces[releasePlan.Namespace] = true
+		if releasePlan.Spec.Target != "" {
+			namespaces[releasePlan.Spec.Target] = true
+		}
+	}
+
+	releasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
+	err = c.clusterCache.List(ctx, releasePlanAdmissions)
+	if err != nil {
+		return nil, err
+	}
+	for _, releasePlanAdmission := range releasePlanAdmissions.Items {
+		if releasePlanAdmission.DeletionTimestamp != nil {
+			continue
+		}
+		namespaces[releasePlanAdmission.Namespace] = true
+		namespaces[releasePlanAdmission.Spec.Origin] = true
+		// The managed pipelines of the origin run in the execution namespace, which can differ from the admission's
+		if executionNamespace, err := releasePlanAdmission.GetExecutionNamespace(releasePlanAdmission.Spec.Origin); err == nil {
+			namespaces[executionNamespace] = true
+		}
+	}
+
+	delete(namespaces, "")
+
+	return namespaces, nil
+}
+
+// listFromAPIServer retrieves the list of objects matching the given options from the API server. As the API server
+// doesn't know about the indexes added to the cache, field selectors are applied to the listed objects using them.
+func (c *ScopedCache) listFromAPIServer(ctx context.Context, list client.ObjectList, listOpts *client.ListOptions) error {
+	if listOpts.FieldSelector == nil || listOpts.FieldSelector.Empty() {
+		return c.apiReader.List(ctx, list, listOpts)
+	}
+
+	gvk, err := apiutil.GVKForObject(list, c.scheme)
+	if err != nil {
+		return err
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+
+	unfilteredOpts := *listOpts
+	unfilteredOpts.FieldSelector = nil
+	unfilteredOpts.Limit = 0
+	unfilteredOpts.Continue = ""
+	err = c.apiReader.List(ctx, list, &unfilteredOpts)
+	if err != nil {
+		return err
+	}
+
+	items, err := apimeta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	var matchingItems []runtime.Object
+	for _, item := range items {
+		matches, err := c.matchesFieldSelector(gvk, item, listOpts.FieldSelector)
+		if err != nil {
+			return err
+		}
+		if matches {
+			matchingItems = append(matchingItems, item)
+		}
+	}
+
+	return apimeta.SetList(list, matchingItems)
+}
+
+// matchesFieldSelector checks whether the given object matches the given field selector, using the indexes added for
+// its kind. Only exact matches on indexed fields are supported, as in the cache of controller-runtime.
+func (c *ScopedCache) matchesFieldSelector(gvk schema.GroupVersionKind, obj runtime.Object, selector fields.Selector) (bool, error) {
+	clientObj, ok := obj.(client.Object)
+	if !ok {
+		return false, fmt.Errorf("%s is not a client.Object", gvk)
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for _, requirement := range selector.Requirements() {
+		if requirement.Operator != selection.Equals && requirement.Operator != selection.DoubleEquals {
+			return false, fmt.Errorf("field selector %s is not supported: only exact matches are", selector)
+		}
+
+		var index *fieldIndex
+		for i := range c.indexes {
+			if c.indexes[i].gvk == gvk && c.indexes[i].field == requirement.Field {
+				index = &c.indexes[i]
+				break
+			}
+		}
+		if index == nil {
+			return false, fmt.Errorf("field %s is not indexed for %s", requirement.Field, gvk)
+		}
+
+		if !slices.Contains(index.extractValue(clientObj), requirement.Value) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// isScoped returns whether the given object is namespaced and not one of the kinds cached in every namespace.
+func (c *ScopedCache) isScoped(obj runtime.Object) (bool, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return false, err
+	}
+	if _, isList := obj.(client.ObjectList); isList {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+
+	for _, clusterWideObject := range clusterWideObjects {
+		clusterWideGvk, err := apiutil.GVKForObject(clusterWideObject, c.scheme)
+		if err != nil {
+			return false, err
+		}
+		if gvk.GroupKind() == clusterWideGvk.GroupKind() {
+			return false, nil
+		}
+	}
+
+	return apiutil.IsGVKNamespaced(gvk, c.restMapper)
+}
+
+// updateNamespaces starts the caches of the namespaces that entered the scope and stops the caches of the namespaces
+// that left it.
+func (c *ScopedCache) updateNamespaces(ctx context.Context) error {
+	namespaces, err := c.getScopedNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for namespace, namespaced := range c.namespaces {
+		if namespaces[namespace] {
+			continue
+		}
+
+		namespaced.cancel()
+		delete(c.namespaces, namespace)
+		for _, informer := range c.informers {
+			informer.removeNamespace(namespace)
+		}
+	}
+
+	for namespace := range namespaces {
+		if _, found := c.namespaces[namespace]; found {
+			continue
+		}
+
+		err = c.addNamespace(namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addNamespace creates and starts the cache of the given namespace, adding to it the indexes and informers requested
+// so far. It must be called while holding the lock.
+func (c *ScopedCache) addNamespace(namespace string) error {
+	namespaceCache, err := c.newNamespacedCache(namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range c.indexes {
+		err = namespaceCache.IndexField(c.ctx, index.object, index.field, index.extractValue)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, informer := range c.informers {
+		err = informer.addNamespace(c.ctx, namespace, namespaceCache)
+		if err != nil {
+			for _, addedInformer := range c.informers {
+				addedInformer.removeNamespace(namespace)
+			}
+
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	namespaced := &namespacedCache{cache: namespaceCache, cancel: cancel}
+	c.namespaces[namespace] = namespaced
+
+	go func() {
+		_ = namespaceCache.Start(ctx)
+	}()
+	go func() {
+		if namespaceCache.WaitForCacheSync(ctx) {
+			c.mutex.Lock()
+			namespaced.synced = true
+			c.mutex.Unlock()
+		}
+	}()
+
+	return nil
+}
+
+// scopedInformer is a cache.Informer aggregating the informers of the same kind in the namespaces in the scope.
+type scopedInformer struct {
+	mutex         sync.Mutex
+	indexers      []toolscache.Indexers
+	informers     map[string]crcache.Informer
+	object        client.Object
+	registrations []*scopedRegistration
+}
+
+// scopedRegistration is the registration of an event handler in the informers of all the namespaces in the scope.
+type scopedRegistration struct {
+	handler       toolscache.ResourceEventHandler
+	registrations map[string]toolscache.ResourceEventHandlerRegistration
+	resyncPeriod  time.Duration
+}
+
+var _ crcache.Informer = &scopedInformer{}
+
+// AddEventHandler adds the given handler to the informers of all the namespaces in the scope.
+func (i *scopedInformer) AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error) {
+	return i.AddEventHandlerWithResyncPeriod(handler, 0)
+}
+
+// AddEventHandlerWithResyncPeriod adds the given handler with the given resync period to the informers of all the
+// namespaces in the scope.
+func (i *scopedInformer) AddEventHandlerWithResyncPeriod(handler toolscache.ResourceEventHandler, resyncPeriod time.Duration) (toolscache.ResourceEventHandlerRegistration, error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	registration := &scopedRegistration{
+		handler:       handler,
+		registrations: map[string]toolscache.ResourceEventHandlerRegistration{},
+		resyncPeriod:  resyncPeriod,
+	}
+	for namespace, informer := range i.informers {
+		err := registration.register(namespace, informer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	i.registrations = append(i.registrations, registration)
+
+	return registration, nil
+}
+
+// RemoveEventHandler removes the handler with the given registration from the informers of all the namespaces.
+func (i *scopedInformer) RemoveEventHandler(handle toolscache.ResourceEventHandlerRegistration) error {
+	registration, ok := handle.(*scopedRegistration)
+	if !ok {
+		return fmt.Errorf("registration %v was not returned by this informer", handle)
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for namespace, namespacedRegistration := range registration.registrations {
+		if informer, found := i.informers[namespace]; found {
+			err := informer.RemoveEventHandler(namespacedRegistration)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for index, existingRegistration := range i.registrations {
+		if existingRegistration == registration {
+			i.registrations = append(i.registrations[:index], i.registrations[index+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddIndexers adds the given indexers to the informers of all the namespaces in the scope.
+func (i *scopedInformer) AddIndexers(indexers toolscache.Indexers) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, informer := range i.informers {
+		err := informer.AddIndexers(indexers)
+		if err != nil {
+			return err
+		}
+	}
+	i.indexers = append(i.indexers, indexers)
+
+	return nil
+}
+
+// HasSynced returns whether the informers of all the namespaces in the scope are synced.
+func (i *scopedInformer) HasSynced() bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, informer := range i.informers {
+		if !informer.HasSynced() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsStopped returns whether the informers of all the namespaces in the scope are stopped.
+func (i *scopedInformer) IsStopped() bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, informer := range i.informers {
+		if !informer.IsStopped() {
+			return false
+		}
+	}
+
+	return len(i.informers) > 0
+}
+
+// addNamespace gets the informer of the given namespace cache and adds the indexers and handlers added so far to it.
+func (i *scopedInformer) addNamespace(ctx context.Context, namespace string, namespaceCache crcache.Cache) error {
+	informer, err := namespaceCache.GetInformer(ctx, i.object, crcache.BlockUntilSynced(false))
+	if err != nil {
+		return err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, indexers := range i.indexers {
+		err = informer.AddIndexers(indexers)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, registration := range i.registrations {
+		err = registration.register(namespace, informer)
+		if err != nil {
+			return err
+		}
+	}
+	i.informers[namespace] = informer
+
+	return nil
+}
+
+// removeNamespace forgets the informer of the given namespace. The informer itself is stopped with its cache.
+func (i *scopedInformer) removeNamespace(namespace string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	delete(i.informers, namespace)
+	for _, registration := range i.registrations {
+		delete(registration.registrations, namespace)
+	}
+}
+
+// HasSynced returns whether the handler received the initial list of objects in all the namespaces in the scope.
+func (r *scopedRegistration) HasSynced() bool {
+	for _, registration := range r.registrations {
+		if !registration.HasSynced() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// register adds the handler to the given informer of the given namespace.
+func (r *scopedRegistration) register(namespace string, informer crcache.Informer) error {
+	var registration toolscache.ResourceEventHandlerRegistration
+	var err error
+	if r.resyncPeriod > 0 {
+		registration, err = informer.AddEventHandlerWithResyncPeriod(r.handler, r.resyncPeriod)
+	} else {
+		registration, err = informer.AddEventHandler(r.handler)
+	}
+	if err != nil {
+		return err
+	}
+
+	r.registrations[namespace] = registration
+
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var _ = Describe("ScopedCache", Ordered, func() {
+	const (
+		inScopeNamespace    = "scoped"
+		outOfScopeNamespace = "unscoped"
+		serviceNamespace    = "release-service"
+		indexedField        = "data.team"
+	)
+
+	var (
+		cacheCancel         context.CancelFunc
+		scopedCache         *ScopedCache
+		inScopeConfigMap    *corev1.ConfigMap
+		outOfScopeConfigMap *corev1.ConfigMap
+	)
+
+	BeforeAll(func() {
+		for _, namespace := range []string{inScopeNamespace, outOfScopeNamespace, serviceNamespace} {
+			Expect(k8sClient.Create(ctx, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			})).To(Succeed())
+		}
+
+		Expect(k8sClient.Create(ctx, &v1alpha1.ReleasePlan{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release-plan",
+				Namespace: inScopeNamespace,
+			},
+			Spec: v1alpha1.ReleasePlanSpec{
+				Application: "application",
+			},
+		})).To(Succeed())
+
+		inScopeConfigMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "config-map",
+				Namespace: inScopeNamespace,
+			},
+			Data: map[string]string{"team": "foo"},
+		}
+		Expect(k8sClient.Create(ctx, inScopeConfigMap)).To(Succeed())
+
+		outOfScopeConfigMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "config-map",
+				Namespace: outOfScopeNamespace,
+			},
+			Data: map[string]string{"team": "bar"},
+		}
+		Expect(k8sClient.Create(ctx, outOfScopeConfigMap)).To(Succeed())
+
+		httpClient, err := rest.HTTPClientFor(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		mapper, err := apiutil.NewDynamicRESTMapper(cfg, httpClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		newCache, err := NewScopedCacheFunc(serviceNamespace)(cfg, crcache.Options{
+			HTTPClient: httpClient,
+			Mapper:     mapper,
+			Scheme:     scheme.Scheme,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		scopedCache = newCache.(*ScopedCache)
+
+		Expect(scopedCache.IndexField(ctx, &corev1.ConfigMap{}, indexedField, func(obj client.Object) []string {
+			return []string{obj.(*corev1.ConfigMap).Data["team"]}
+		})).To(Succeed())
+	})
+
+	AfterAll(func() {
+		if cacheCancel != nil {
+			cacheCancel()
+		}
+	})
+
+	getNames := func(list *corev1.ConfigMapList) []string {
+		var names []string
+		for _, item := range list.Items {
+			if item.Name == "config-map" {
+				names = append(names, item.Namespace+"/"+item.Name)
+			}
+		}
+		return names
+	}
+
+	// testReads checks the reads inside and outside the scope, which have to return the same results whether they are
+	// served by the caches of the namespaces or by the API server.
+	testReads := func() {
+		It("gets the objects inside and outside the scope", func() {
+			configMap := &corev1.ConfigMap{}
+			Expect(scopedCache.Get(ctx, client.ObjectKeyFromObject(inScopeConfigMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(inScopeConfigMap.Data))
+
+			Expect(scopedCache.Get(ctx, client.ObjectKeyFromObject(outOfScopeConfigMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(outOfScopeConfigMap.Data))
+		})
+
+		It("lists the objects of the namespaces inside and outside the scope", func() {
+			list := &corev1.ConfigMapList{}
+			Expect(scopedCache.List(ctx, list, client.InNamespace(inScopeNamespace))).To(Succeed())
+			Expect(getNames(list)).To(ConsistOf(inScopeNamespace + "/config-map"))
+
+			Expect(scopedCache.List(ctx, list, client.InNamespace(outOfScopeNamespace))).To(Succeed())
+			Expect(getNames(list)).To(ConsistOf(outOfScopeNamespace + "/config-map"))
+		})
+
+		It("lists the objects in all the namespaces, including the ones outside the scope", func() {
+			list := &corev1.ConfigMapList{}
+			Expect(scopedCache.List(ctx, list)).To(Succeed())
+			Expect(getNames(list)).To(ConsistOf(inScopeNamespace+"/config-map", outOfScopeNamespace+"/config-map"))
+		})
+
+		It("filters the objects by indexed fields", func() {
+			list := &corev1.ConfigMapList{}
+			Expect(scopedCache.List(ctx, list, client.MatchingFields{indexedField: "bar"})).To(Succeed())
+			Expect(getNames(list)).To(ConsistOf(outOfScopeNamespace + "/config-map"))
+
+			Expect(scopedCache.List(ctx, list, client.InNamespace(inScopeNamespace),
+				client.MatchingFields{indexedField: "foo"})).To(Succeed())
+			Expect(getNames(list)).To(ConsistOf(inScopeNamespace + "/config-map"))
+		})
+
+		It("fails to filter the objects in all the namespaces by fields that are not indexed", func() {
+			list := &corev1.ConfigMapList{}
+			Expect(scopedCache.List(ctx, list, client.MatchingFields{"data.foo": "bar"})).To(
+				MatchError(ContainSubstring("is not indexed")))
+		})
+	}
+
+	When("the caches of the namespaces are not synced", func() {
+		It("has no namespace in the scope", func() {
+			Expect(scopedCache.namespaces).To(BeEmpty())
+		})
+
+		testReads()
+	})
+
+	When("the caches of the namespaces are synced", func() {
+		BeforeAll(func() {
+			var cacheCtx context.Context
+			cacheCtx, cacheCancel = context.WithCancel(ctx)
+			go func() {
+				defer GinkgoRecover()
+				Expect(scopedCache.Start(cacheCtx)).To(Succeed())
+			}()
+			Expect(scopedCache.WaitForCacheSync(cacheCtx)).To(BeTrue())
+
+			Eventually(func() bool {
+				scopedCache.mutex.RLock()
+				defer scopedCache.mutex.RUnlock()
+				namespaced, found := scopedCache.namespaces[inScopeNamespace]
+				return found && namespaced.synced
+			}).Should(BeTrue())
+		})
+
+		It("only has the namespaces involved in releases in the scope", func() {
+			scopedCache.mutex.RLock()
+			defer scopedCache.mutex.RUnlock()
+			Expect(scopedCache.namespaces).To(HaveKey(inScopeNamespace))
+			Expect(scopedCache.namespaces).To(HaveKey(serviceNamespace))
+			Expect(scopedCache.namespaces).NotTo(HaveKey(outOfScopeNamespace))
+		})
+
+		testReads()
+	})
+})
@@ -0,0 +1,53 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/konflux-ci/release-service/events"
+)
+
+// event-schemas writes the JSON Schema of the payload of each type of event to a directory, so the schemas published
+// for integrators are regenerated whenever the payload types change.
+func main() {
+	var dir string
+	flag.StringVar(&dir, "dir", filepath.Join("events", "schemas", events.Version),
+		"The directory where the schemas are written to.")
+	flag.Parse()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	for _, eventType := range events.Types {
+		schema, err := events.GenerateSchema(eventType)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		if err = os.WriteFile(filepath.Join(dir, events.GetSchemaFileName(eventType)), schema, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
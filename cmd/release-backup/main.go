@@ -0,0 +1,97 @@
+/*
+Copyright 2024 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap/zapcore"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	appstudiov1alpha1 "github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backup"
+)
+
+var (
+	scheme = runtime.NewScheme()
+	log    = ctrl.Log.WithName("release-backup")
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(appstudiov1alpha1.AddToScheme(scheme))
+}
+
+// release-backup exports finished Releases to a directory or imports them back as read-only historical Releases,
+// e.g. after a cluster rebuild. Object storage buckets can be used by mounting them or syncing the directory.
+func main() {
+	var dir string
+	var namespace string
+	var export bool
+	var restore bool
+	flag.StringVar(&dir, "dir", "", "The directory where the Releases are exported to or imported from.")
+	flag.StringVar(&namespace, "namespace", "", "The namespace to export Releases from. All namespaces if not set.")
+	flag.BoolVar(&export, "export", false, "Export the finished Releases to the directory.")
+	flag.BoolVar(&restore, "import", false, "Import the Releases in the directory as historical Releases.")
+	opts := zap.Options{
+		Development: true,
+		TimeEncoder: zapcore.ISO8601TimeEncoder,
+	}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+
+	if dir == "" || export == restore {
+		log.Error(fmt.Errorf("invalid arguments"), "a directory and exactly one of --export or --import are required")
+		os.Exit(1)
+	}
+
+	k8sClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		log.Error(err, "unable to create client")
+		os.Exit(1)
+	}
+
+	releaseBackup := backup.NewBackupWithContext(k8sClient, &log, backup.NewFileObjectStore(dir), ctrl.SetupSignalHandler())
+	if export {
+		count, err := releaseBackup.ExportReleases(namespace)
+		if err != nil {
+			log.Error(err, "unable to export Releases")
+			os.Exit(1)
+		}
+		log.Info("Finished exporting Releases", "count", count)
+	} else {
+		count, err := releaseBackup.ImportReleases()
+		if err != nil {
+			log.Error(err, "unable to import Releases")
+			os.Exit(1)
+		}
+		log.Info("Finished importing Releases", "count", count)
+	}
+}
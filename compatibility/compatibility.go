@@ -0,0 +1,111 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compatibility
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RequiredKinds contains the kinds the Release Service depends on, in the versions this binary was built for.
+var RequiredKinds = []schema.GroupVersionKind{
+	v1alpha1.GroupVersion.WithKind("Release"),
+	v1alpha1.GroupVersion.WithKind("ReleasePipelineCatalog"),
+	v1alpha1.GroupVersion.WithKind("ReleasePlan"),
+	v1alpha1.GroupVersion.WithKind("ReleasePlanAdmission"),
+	v1alpha1.GroupVersion.WithKind("ReleasePlanAdmissionDefaults"),
+	v1alpha1.GroupVersion.WithKind("ReleaseServiceConfig"),
+	applicationapiv1alpha1.GroupVersion.WithKind("Snapshot"),
+	applicationapiv1alpha1.GroupVersion.WithKind("SnapshotEnvironmentBinding"),
+	tektonv1.SchemeGroupVersion.WithKind("PipelineRun"),
+}
+
+// Checker checks that the cluster serves a list of kinds in the versions the Release Service expects, so mismatched
+// CRDs are reported precisely instead of making the reconciles fail.
+type Checker struct {
+	kinds  []schema.GroupVersionKind
+	mapper meta.RESTMapper
+}
+
+// NewChecker creates and returns a Checker for the given kinds using the given RESTMapper to discover the kinds served
+// by the cluster.
+func NewChecker(mapper meta.RESTMapper, kinds ...schema.GroupVersionKind) *Checker {
+	return &Checker{
+		kinds:  kinds,
+		mapper: mapper,
+	}
+}
+
+// Check returns an error describing every kind of the Checker that is not served in the expected version, along with
+// the versions served instead, if any. An error is also returned if the kinds served by the cluster can't be discovered.
+func (c *Checker) Check() error {
+	var mismatches []string
+
+	for _, kind := range c.kinds {
+		_, err := c.mapper.RESTMapping(kind.GroupKind(), kind.Version)
+		if err == nil {
+			continue
+		}
+		if !meta.IsNoMatchError(err) {
+			return fmt.Errorf("unable to discover %s: %w", kind.GroupKind(), err)
+		}
+
+		servedVersions := c.getServedVersions(kind.GroupKind())
+		if len(servedVersions) == 0 {
+			mismatches = append(mismatches, fmt.Sprintf("%s is not installed (expected version %s)",
+				kind.GroupKind(), kind.Version))
+		} else {
+			mismatches = append(mismatches, fmt.Sprintf("%s is only served in versions %s (expected version %s)",
+				kind.GroupKind(), strings.Join(servedVersions, ", "), kind.Version))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("the installed CRDs are not compatible with this release service version: %s",
+			strings.Join(mismatches, "; "))
+	}
+
+	return nil
+}
+
+// ReadyzCheck is a healthz.Checker failing while the kinds of the Checker are not served in the expected versions, so
+// the Release Service doesn't become ready until the CRDs are updated.
+func (c *Checker) ReadyzCheck(_ *http.Request) error {
+	return c.Check()
+}
+
+// getServedVersions returns the versions of the given kind served by the cluster.
+func (c *Checker) getServedVersions(groupKind schema.GroupKind) []string {
+	var versions []string
+
+	mappings, err := c.mapper.RESTMappings(groupKind)
+	if err != nil {
+		return nil
+	}
+	for _, mapping := range mappings {
+		versions = append(versions, mapping.GroupVersionKind.Version)
+	}
+
+	return versions
+}
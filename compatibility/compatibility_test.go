@@ -0,0 +1,72 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compatibility
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("Compatibility", func() {
+	When("Check is called", func() {
+		It("should succeed if the required kinds are served in the expected versions", func() {
+			checker := NewChecker(k8sClient.RESTMapper(), RequiredKinds...)
+			Expect(checker.Check()).To(Succeed())
+		})
+
+		It("should fail if a kind is only served in other versions", func() {
+			checker := NewChecker(k8sClient.RESTMapper(), schema.GroupVersionKind{
+				Group:   "appstudio.redhat.com",
+				Version: "v1",
+				Kind:    "Release",
+			})
+
+			err := checker.Check()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"Release.appstudio.redhat.com is only served in versions v1alpha1 (expected version v1)"))
+		})
+
+		It("should fail if a kind is not installed", func() {
+			checker := NewChecker(k8sClient.RESTMapper(), schema.GroupVersionKind{
+				Group:   "appstudio.redhat.com",
+				Version: "v1alpha1",
+				Kind:    "Missing",
+			})
+
+			err := checker.Check()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"Missing.appstudio.redhat.com is not installed (expected version v1alpha1)"))
+		})
+	})
+
+	When("ReadyzCheck is called", func() {
+		It("should fail while the kinds are not compatible", func() {
+			checker := NewChecker(k8sClient.RESTMapper(), schema.GroupVersionKind{
+				Group:   "appstudio.redhat.com",
+				Version: "v1alpha1",
+				Kind:    "Missing",
+			})
+			Expect(checker.ReadyzCheck(&http.Request{})).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,148 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// adapter holds the objects needed to reconcile an Application.
+type adapter struct {
+	application *applicationapiv1alpha1.Application
+	client      client.Client
+	ctx         context.Context
+	logger      *logr.Logger
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, application *applicationapiv1alpha1.Application, logger *logr.Logger) *adapter {
+	return &adapter{
+		application: application,
+		client:      client,
+		ctx:         ctx,
+		logger:      logger,
+	}
+}
+
+// EnsureDefaultReleasePlanIsDeleted is an operation that will ensure that the default ReleasePlan of the Application is
+// deleted when the Application doesn't have the target annotation. If the annotation is not set, no other operation
+// after this one will be executed.
+func (a *adapter) EnsureDefaultReleasePlanIsDeleted() (controller.OperationResult, error) {
+	if a.application.GetAnnotations()[metadata.ReleaseTargetAnnotation] != "" {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlan, err := a.getDefaultReleasePlan()
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.StopProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	if releasePlan.GetLabels()[metadata.DefaultReleasePlanLabel] != "true" {
+		return controller.StopProcessing()
+	}
+
+	err = a.client.Delete(a.ctx, releasePlan)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Deleted default ReleasePlan", "ReleasePlan.Name", releasePlan.Name)
+
+	return controller.StopProcessing()
+}
+
+// EnsureDefaultReleasePlanExists is an operation that will ensure that the default ReleasePlan of the Application
+// exists and targets the namespace specified in the target annotation of the Application. ReleasePlans with the same
+// name that were not created by this operation are never modified.
+func (a *adapter) EnsureDefaultReleasePlanExists() (controller.OperationResult, error) {
+	target := a.application.GetAnnotations()[metadata.ReleaseTargetAnnotation]
+
+	releasePlan, err := a.getDefaultReleasePlan()
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	if err == nil {
+		if releasePlan.GetLabels()[metadata.DefaultReleasePlanLabel] != "true" || releasePlan.Spec.Target == target {
+			return controller.ContinueProcessing()
+		}
+
+		patch := client.MergeFrom(releasePlan.DeepCopy())
+		releasePlan.Spec.Target = target
+		return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, releasePlan, patch))
+	}
+
+	releasePlan = &v1alpha1.ReleasePlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getDefaultReleasePlanName(a.application),
+			Namespace: a.application.Namespace,
+			Labels: map[string]string{
+				metadata.DefaultReleasePlanLabel: "true",
+			},
+		},
+		Spec: v1alpha1.ReleasePlanSpec{
+			Application: a.application.Name,
+			Target:      target,
+		},
+	}
+
+	err = ctrl.SetControllerReference(a.application, releasePlan, a.client.Scheme())
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	err = a.client.Create(a.ctx, releasePlan)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Created default ReleasePlan", "ReleasePlan.Name", releasePlan.Name, "ReleasePlan.Target", target)
+
+	return controller.ContinueProcessing()
+}
+
+// getDefaultReleasePlan returns the default ReleasePlan of the Application being processed.
+func (a *adapter) getDefaultReleasePlan() (*v1alpha1.ReleasePlan, error) {
+	releasePlan := &v1alpha1.ReleasePlan{}
+	err := a.client.Get(a.ctx, types.NamespacedName{
+		Name:      getDefaultReleasePlanName(a.application),
+		Namespace: a.application.Namespace,
+	}, releasePlan)
+
+	return releasePlan, err
+}
+
+// getDefaultReleasePlanName returns the name of the default ReleasePlan of the given Application.
+func getDefaultReleasePlanName(application *applicationapiv1alpha1.Application) string {
+	return fmt.Sprintf("%s-default", application.Name)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"reflect"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Application adapter", Ordered, func() {
+	var (
+		createApplicationAndAdapter func(target string) *adapter
+		deleteApplicationAndAdapter func(adapter *adapter)
+	)
+
+	Context("When newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+		})
+	})
+
+	Context("When EnsureDefaultReleasePlanIsDeleted is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			deleteApplicationAndAdapter(adapter)
+		})
+
+		It("should continue if the Application has the target annotation", func() {
+			adapter = createApplicationAndAdapter("target")
+
+			result, err := adapter.EnsureDefaultReleasePlanIsDeleted()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should stop processing if the default ReleasePlan doesn't exist", func() {
+			adapter = createApplicationAndAdapter("")
+
+			result, err := adapter.EnsureDefaultReleasePlanIsDeleted()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should delete the default ReleasePlan", func() {
+			adapter = createApplicationAndAdapter("target")
+			_, err := adapter.EnsureDefaultReleasePlanExists()
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.application.Annotations = nil
+			result, err := adapter.EnsureDefaultReleasePlanIsDeleted()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = adapter.getDefaultReleasePlan()
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not delete a ReleasePlan that was not created by default", func() {
+			adapter = createApplicationAndAdapter("")
+			releasePlan := &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      getDefaultReleasePlanName(adapter.application),
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application: adapter.application.Name,
+					Target:      "target",
+				},
+			}
+			Expect(k8sClient.Create(ctx, releasePlan)).To(Succeed())
+
+			result, err := adapter.EnsureDefaultReleasePlanIsDeleted()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = adapter.getDefaultReleasePlan()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When EnsureDefaultReleasePlanExists is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			deleteApplicationAndAdapter(adapter)
+		})
+
+		BeforeEach(func() {
+			adapter = createApplicationAndAdapter("target")
+		})
+
+		It("should create the default ReleasePlan", func() {
+			result, err := adapter.EnsureDefaultReleasePlanExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			releasePlan, err := adapter.getDefaultReleasePlan()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releasePlan.Spec.Application).To(Equal(adapter.application.Name))
+			Expect(releasePlan.Spec.Target).To(Equal("target"))
+			Expect(releasePlan.GetLabels()).To(HaveKeyWithValue(metadata.DefaultReleasePlanLabel, "true"))
+			Expect(releasePlan.OwnerReferences).To(HaveLen(1))
+		})
+
+		It("should update the target of the default ReleasePlan", func() {
+			_, err := adapter.EnsureDefaultReleasePlanExists()
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.application.Annotations[metadata.ReleaseTargetAnnotation] = "new-target"
+			result, err := adapter.EnsureDefaultReleasePlanExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			releasePlan, err := adapter.getDefaultReleasePlan()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releasePlan.Spec.Target).To(Equal("new-target"))
+		})
+	})
+
+	Context("When getDefaultReleasePlanName is called", func() {
+		It("should return the name of the default ReleasePlan", func() {
+			application := &applicationapiv1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "application",
+				},
+			}
+			Expect(getDefaultReleasePlanName(application)).To(Equal("application-default"))
+		})
+	})
+
+	createApplicationAndAdapter = func(target string) *adapter {
+		application := &applicationapiv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "application-",
+				Namespace:    "default",
+			},
+			Spec: applicationapiv1alpha1.ApplicationSpec{
+				DisplayName: "application",
+			},
+		}
+		if target != "" {
+			application.Annotations = map[string]string{
+				metadata.ReleaseTargetAnnotation: target,
+			}
+		}
+		Expect(k8sClient.Create(ctx, application)).To(Succeed())
+
+		return newAdapter(ctx, k8sClient, application, &ctrl.Log)
+	}
+
+	deleteApplicationAndAdapter = func(adapter *adapter) {
+		releasePlan, err := adapter.getDefaultReleasePlan()
+		if err == nil {
+			_ = k8sClient.Delete(ctx, releasePlan)
+		}
+		_ = k8sClient.Delete(ctx, adapter.application)
+	}
+})
@@ -0,0 +1,77 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metrics"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Controller reconciles an Application object to manage its default ReleasePlan
+type Controller struct {
+	client client.Client
+	log    logr.Logger
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("Application", req.NamespacedName)
+
+	application := &applicationapiv1alpha1.Application{}
+	err := c.client.Get(ctx, req.NamespacedName, application)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	adapter := newAdapter(ctx, c.client, application, &logger)
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureDefaultReleasePlanIsDeleted,
+		adapter.EnsureDefaultReleasePlanExists,
+	})
+}
+
+// Register registers the controller with the passed manager and log. This controller only reacts to changes in the
+// Application annotations, and to changes in the ReleasePlans it owns so the default ReleasePlan is recreated if
+// deleted while the Application is still annotated.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.client = mgr.GetClient()
+	c.log = log.WithName("application")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&applicationapiv1alpha1.Application{}, builder.WithPredicates(predicate.AnnotationChangedPredicate{})).
+		Owns(&v1alpha1.ReleasePlan{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(metrics.NewInstrumentedReconciler("application", c))
+}
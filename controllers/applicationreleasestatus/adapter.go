@@ -0,0 +1,200 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationreleasestatus
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// adapter holds the objects needed to reconcile an Application to summarize its Releases.
+type adapter struct {
+	application *applicationapiv1alpha1.Application
+	client      client.Client
+	ctx         context.Context
+	loader      loader.ObjectLoader
+	logger      *logr.Logger
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, application *applicationapiv1alpha1.Application, loader loader.ObjectLoader, logger *logr.Logger) *adapter {
+	return &adapter{
+		application: application,
+		client:      client,
+		ctx:         ctx,
+		loader:      loader,
+		logger:      logger,
+	}
+}
+
+// EnsureApplicationReleaseStatusExists is an operation that will ensure that the ApplicationReleaseStatus of the
+// Application exists. The ApplicationReleaseStatus has the same name as the Application and is owned by it, so it's
+// deleted along with it.
+func (a *adapter) EnsureApplicationReleaseStatusExists() (controller.OperationResult, error) {
+	if a.application.GetDeletionTimestamp() != nil {
+		return controller.StopProcessing()
+	}
+
+	_, err := a.getApplicationReleaseStatus()
+	if err == nil || !errors.IsNotFound(err) {
+		return controller.RequeueOnErrorOrContinue(err)
+	}
+
+	applicationReleaseStatus := &v1alpha1.ApplicationReleaseStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.application.Name,
+			Namespace: a.application.Namespace,
+		},
+		Spec: v1alpha1.ApplicationReleaseStatusSpec{
+			Application: a.application.Name,
+		},
+	}
+
+	err = ctrl.SetControllerReference(a.application, applicationReleaseStatus, a.client.Scheme())
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	err = a.client.Create(a.ctx, applicationReleaseStatus)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Created ApplicationReleaseStatus", "ApplicationReleaseStatus.Name", applicationReleaseStatus.Name)
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureApplicationReleaseStatusIsUpdated is an operation that will ensure that the status of the
+// ApplicationReleaseStatus of the Application summarizes the Releases of each of the ReleasePlans of the Application.
+// The status is only patched when the summary changes.
+func (a *adapter) EnsureApplicationReleaseStatusIsUpdated() (controller.OperationResult, error) {
+	applicationReleaseStatus, err := a.getApplicationReleaseStatus()
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	releasePlans, err := a.loader.GetReleasePlans(a.ctx, a.client, a.application.Namespace)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	releases, err := a.loader.GetReleases(a.ctx, a.client, a.application.Namespace)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	environments := getEnvironmentReleaseStatuses(a.application.Name, releasePlans.Items, releases.Items)
+	if equality.Semantic.DeepEqual(environments, applicationReleaseStatus.Status.Environments) {
+		return controller.ContinueProcessing()
+	}
+
+	now := metav1.Now()
+	patch := client.MergeFrom(applicationReleaseStatus.DeepCopy())
+	applicationReleaseStatus.Status.Environments = environments
+	applicationReleaseStatus.Status.LastUpdateTime = &now
+
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, applicationReleaseStatus, patch))
+}
+
+// getApplicationReleaseStatus returns the ApplicationReleaseStatus of the Application being processed. If it's not
+// found or the Get operation fails, an error will be returned.
+func (a *adapter) getApplicationReleaseStatus() (*v1alpha1.ApplicationReleaseStatus, error) {
+	applicationReleaseStatus := &v1alpha1.ApplicationReleaseStatus{}
+	err := a.client.Get(a.ctx, client.ObjectKeyFromObject(a.application), applicationReleaseStatus)
+
+	return applicationReleaseStatus, err
+}
+
+// getEnvironmentReleaseStatuses returns the summary of the given Releases for each of the given ReleasePlans releasing
+// the given application, sorted by ReleasePlan name. Superseded Releases are not taken into account.
+func getEnvironmentReleaseStatuses(application string, releasePlans []v1alpha1.ReleasePlan, releases []v1alpha1.Release) []v1alpha1.EnvironmentReleaseStatus {
+	environmentsByReleasePlan := make(map[string]*v1alpha1.EnvironmentReleaseStatus)
+	for _, releasePlan := range releasePlans {
+		if releasePlan.Spec.Application == application {
+			environmentsByReleasePlan[releasePlan.Name] = &v1alpha1.EnvironmentReleaseStatus{
+				ReleasePlan: releasePlan.Name,
+				Target:      releasePlan.Spec.Target,
+			}
+		}
+	}
+
+	for i := range releases {
+		release := &releases[i]
+		environment, found := environmentsByReleasePlan[release.Spec.ReleasePlan]
+		if !found {
+			continue
+		}
+
+		switch {
+		case !release.HasReleaseFinished():
+			environment.PendingReleases = append(environment.PendingReleases, release.Name)
+		case release.IsReleased():
+			if isMoreRecent(release, environment.LastSucceededRelease) {
+				environment.LastSucceededRelease = getReleaseReference(release)
+				environment.CurrentSnapshot = release.Spec.Snapshot
+			}
+		case release.IsFailed():
+			if isMoreRecent(release, environment.LastFailedRelease) {
+				environment.LastFailedRelease = getReleaseReference(release)
+			}
+		}
+	}
+
+	var environments []v1alpha1.EnvironmentReleaseStatus
+	for _, environment := range environmentsByReleasePlan {
+		sort.Strings(environment.PendingReleases)
+		environments = append(environments, *environment)
+	}
+	sort.Slice(environments, func(i, j int) bool {
+		return environments[i].ReleasePlan < environments[j].ReleasePlan
+	})
+
+	return environments
+}
+
+// getReleaseReference returns a reference to the given Release.
+func getReleaseReference(release *v1alpha1.Release) *v1alpha1.ReleaseReference {
+	return &v1alpha1.ReleaseReference{
+		CompletionTime: release.Status.CompletionTime,
+		Name:           release.Name,
+		Snapshot:       release.Spec.Snapshot,
+	}
+}
+
+// isMoreRecent returns true if the given Release was completed after the referenced one or if there's no reference.
+func isMoreRecent(release *v1alpha1.Release, reference *v1alpha1.ReleaseReference) bool {
+	if reference == nil {
+		return true
+	}
+	if release.Status.CompletionTime == nil {
+		return false
+	}
+
+	return reference.CompletionTime == nil || release.Status.CompletionTime.After(reference.CompletionTime.Time)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationreleasestatus
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("ApplicationReleaseStatus adapter", Ordered, func() {
+	var (
+		createApplicationAndAdapter func() *adapter
+		deleteApplicationAndAdapter func(adapter *adapter)
+	)
+
+	Context("When newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, loader.NewMockLoader(), &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+		})
+	})
+
+	Context("When EnsureApplicationReleaseStatusExists is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			deleteApplicationAndAdapter(adapter)
+		})
+
+		BeforeEach(func() {
+			adapter = createApplicationAndAdapter()
+		})
+
+		It("should create the ApplicationReleaseStatus owned by the Application", func() {
+			result, err := adapter.EnsureApplicationReleaseStatusExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			applicationReleaseStatus, err := adapter.getApplicationReleaseStatus()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applicationReleaseStatus.Spec.Application).To(Equal(adapter.application.Name))
+			Expect(applicationReleaseStatus.OwnerReferences).To(HaveLen(1))
+		})
+
+		It("should continue if the ApplicationReleaseStatus already exists", func() {
+			_, err := adapter.EnsureApplicationReleaseStatusExists()
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := adapter.EnsureApplicationReleaseStatusExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When EnsureApplicationReleaseStatusIsUpdated is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			deleteApplicationAndAdapter(adapter)
+		})
+
+		BeforeEach(func() {
+			adapter = createApplicationAndAdapter()
+		})
+
+		It("should requeue with an error if the ApplicationReleaseStatus doesn't exist", func() {
+			result, err := adapter.EnsureApplicationReleaseStatusIsUpdated()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should summarize the Releases of the Application", func() {
+			_, err := adapter.EnsureApplicationReleaseStatusExists()
+			Expect(err).NotTo(HaveOccurred())
+
+			release := v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{Name: "release"},
+				Spec: v1alpha1.ReleaseSpec{
+					ReleasePlan: "releaseplan",
+					Snapshot:    "snapshot",
+				},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlansContextKey,
+					Resource: &v1alpha1.ReleasePlanList{
+						Items: []v1alpha1.ReleasePlan{
+							{
+								ObjectMeta: metav1.ObjectMeta{Name: "releaseplan"},
+								Spec: v1alpha1.ReleasePlanSpec{
+									Application: adapter.application.Name,
+									Target:      "managed",
+								},
+							},
+						},
+					},
+				},
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{release}},
+				},
+			})
+
+			result, err := adapter.EnsureApplicationReleaseStatusIsUpdated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			applicationReleaseStatus, err := adapter.getApplicationReleaseStatus()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applicationReleaseStatus.Status.LastUpdateTime).NotTo(BeNil())
+			Expect(applicationReleaseStatus.Status.Environments).To(Equal([]v1alpha1.EnvironmentReleaseStatus{
+				{
+					PendingReleases: []string{"release"},
+					ReleasePlan:     "releaseplan",
+					Target:          "managed",
+				},
+			}))
+		})
+
+		It("should requeue with an error if the Releases can't be listed", func() {
+			_, err := adapter.EnsureApplicationReleaseStatusExists()
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Err:        fmt.Errorf("error"),
+				},
+			})
+
+			result, err := adapter.EnsureApplicationReleaseStatusIsUpdated()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When getEnvironmentReleaseStatuses is called", func() {
+		var releasePlans []v1alpha1.ReleasePlan
+
+		BeforeAll(func() {
+			releasePlans = []v1alpha1.ReleasePlan{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "staging"},
+					Spec:       v1alpha1.ReleasePlanSpec{Application: "application", Target: "managed-staging"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "production"},
+					Spec:       v1alpha1.ReleasePlanSpec{Application: "application", Target: "managed-production"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec:       v1alpha1.ReleasePlanSpec{Application: "other", Target: "managed-production"},
+				},
+			}
+		})
+
+		newFinishedRelease := func(name, releasePlan, snapshot string, succeeded bool, completionTime time.Time) v1alpha1.Release {
+			release := v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       v1alpha1.ReleaseSpec{ReleasePlan: releasePlan, Snapshot: snapshot},
+			}
+			release.MarkReleasing("")
+			if succeeded {
+				release.MarkReleased()
+			} else {
+				release.MarkReleaseFailed("")
+			}
+			release.Status.CompletionTime = &metav1.Time{Time: completionTime}
+
+			return release
+		}
+
+		It("should only return the ReleasePlans of the application sorted by name", func() {
+			environments := getEnvironmentReleaseStatuses("application", releasePlans, nil)
+			Expect(environments).To(HaveLen(2))
+			Expect(environments[0].ReleasePlan).To(Equal("production"))
+			Expect(environments[0].Target).To(Equal("managed-production"))
+			Expect(environments[1].ReleasePlan).To(Equal("staging"))
+		})
+
+		It("should keep the most recent succeeded and failed Releases", func() {
+			now := time.Now()
+			releases := []v1alpha1.Release{
+				newFinishedRelease("old", "production", "snapshot-1", true, now.Add(-2*time.Hour)),
+				newFinishedRelease("new", "production", "snapshot-2", true, now.Add(-time.Hour)),
+				newFinishedRelease("failed", "production", "snapshot-3", false, now),
+				newFinishedRelease("other", "other", "snapshot-4", true, now),
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pending"},
+					Spec:       v1alpha1.ReleaseSpec{ReleasePlan: "production", Snapshot: "snapshot-5"},
+				},
+			}
+
+			environments := getEnvironmentReleaseStatuses("application", releasePlans, releases)
+			Expect(environments).To(HaveLen(2))
+			Expect(environments[0].CurrentSnapshot).To(Equal("snapshot-2"))
+			Expect(environments[0].LastSucceededRelease.Name).To(Equal("new"))
+			Expect(environments[0].LastFailedRelease.Name).To(Equal("failed"))
+			Expect(environments[0].PendingReleases).To(Equal([]string{"pending"}))
+			Expect(environments[1].LastSucceededRelease).To(BeNil())
+		})
+	})
+
+	createApplicationAndAdapter = func() *adapter {
+		application := &applicationapiv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "application-",
+				Namespace:    "default",
+			},
+			Spec: applicationapiv1alpha1.ApplicationSpec{
+				DisplayName: "application",
+			},
+		}
+		Expect(k8sClient.Create(ctx, application)).To(Succeed())
+
+		return newAdapter(ctx, k8sClient, application, loader.NewMockLoader(), &ctrl.Log)
+	}
+
+	deleteApplicationAndAdapter = func(adapter *adapter) {
+		applicationReleaseStatus, err := adapter.getApplicationReleaseStatus()
+		if err == nil {
+			_ = k8sClient.Delete(ctx, applicationReleaseStatus)
+		}
+		_ = k8sClient.Delete(ctx, adapter.application)
+	}
+})
@@ -0,0 +1,120 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationreleasestatus
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/controllers/utils/predicates"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metrics"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Controller reconciles an Application object to summarize its Releases in its ApplicationReleaseStatus
+type Controller struct {
+	client client.Client
+	log    logr.Logger
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=applicationreleasestatuses,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=applicationreleasestatuses/status,verbs=get;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("Application", req.NamespacedName)
+
+	application := &applicationapiv1alpha1.Application{}
+	err := c.client.Get(ctx, req.NamespacedName, application)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	adapter := newAdapter(ctx, c.client, application, loader.NewLoader(), &logger)
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureApplicationReleaseStatusExists,
+		adapter.EnsureApplicationReleaseStatusIsUpdated,
+	})
+}
+
+// Register registers the controller with the passed manager and log. Applications are reconciled when they are created,
+// when their ApplicationReleaseStatus is deleted, when the ReleasePlans releasing them change and when the phase of
+// their Releases changes.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.client = mgr.GetClient()
+	c.log = log.WithName("applicationreleasestatus")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("applicationreleasestatus").
+		For(&applicationapiv1alpha1.Application{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&v1alpha1.ApplicationReleaseStatus{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1alpha1.ReleasePlan{}, handler.EnqueueRequestsFromMapFunc(getReleasePlanApplication)).
+		Watches(&v1alpha1.Release{}, handler.EnqueueRequestsFromMapFunc(c.getReleaseApplication),
+			builder.WithPredicates(predicates.ReleasePhaseChangedPredicate())).
+		Complete(metrics.NewInstrumentedReconciler("applicationreleasestatus", c))
+}
+
+// getReleaseApplication returns a request for the Application released by the given Release. If its ReleasePlan can't
+// be retrieved, no request is returned.
+func (c *Controller) getReleaseApplication(ctx context.Context, object client.Object) []reconcile.Request {
+	release, ok := object.(*v1alpha1.Release)
+	if !ok {
+		return nil
+	}
+
+	releasePlan, err := loader.NewLoader().GetReleasePlan(ctx, c.client, release)
+	if err != nil {
+		return nil
+	}
+
+	return getReleasePlanApplication(ctx, releasePlan)
+}
+
+// getReleasePlanApplication returns a request for the Application released by the given ReleasePlan.
+func getReleasePlanApplication(_ context.Context, object client.Object) []reconcile.Request {
+	releasePlan, ok := object.(*v1alpha1.ReleasePlan)
+	if !ok || releasePlan.Spec.Application == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      releasePlan.Spec.Application,
+				Namespace: releasePlan.Namespace,
+			},
+		},
+	}
+}
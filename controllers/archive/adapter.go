@@ -0,0 +1,89 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/archive"
+	"github.com/konflux-ci/release-service/history"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// adapter holds the objects needed to reconcile a Release to archive it.
+type adapter struct {
+	backend archive.Backend
+	client  client.Client
+	ctx     context.Context
+	loader  loader.ObjectLoader
+	logger  *logr.Logger
+	release *v1alpha1.Release
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, release *v1alpha1.Release, backend archive.Backend, loader loader.ObjectLoader, logger *logr.Logger) *adapter {
+	return &adapter{
+		backend: backend,
+		client:  client,
+		ctx:     ctx,
+		loader:  loader,
+		logger:  logger,
+		release: release,
+	}
+}
+
+// EnsureReleaseIsArchived is an operation that will ensure that the record of a completed Release is stored in the
+// archive backend. Once stored, the Release is annotated so it's not stored again.
+func (a *adapter) EnsureReleaseIsArchived() (controller.OperationResult, error) {
+	if !a.release.HasReleaseFinished() || a.release.GetAnnotations()[metadata.ArchivedAnnotation] == "true" {
+		return controller.ContinueProcessing()
+	}
+
+	var application string
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+	if err == nil {
+		application = releasePlan.Spec.Application
+	}
+
+	record := history.NewReleaseRecord(a.release, application)
+	record.Artifacts = a.release.Status.Artifacts
+
+	err = a.backend.Store(a.ctx, record)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	metadata.AddAnnotations(a.release, map[string]string{metadata.ArchivedAnnotation: "true"})
+	err = a.client.Patch(a.ctx, a.release, patch)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Archived Release")
+
+	return controller.ContinueProcessing()
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/history"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Archive adapter", Ordered, func() {
+	var (
+		createReleaseAndAdapter func() *adapter
+		backend                 *mockBackend
+	)
+
+	When("newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, nil, loader.NewLoader(), &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+		})
+	})
+
+	When("EnsureReleaseIsArchived is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			backend = &mockBackend{}
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should not archive a Release that didn't finish", func() {
+			result, err := adapter.EnsureReleaseIsArchived()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.records).To(BeEmpty())
+		})
+
+		It("should not archive a Release that was already archived", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			metadata.AddAnnotations(adapter.release, map[string]string{metadata.ArchivedAnnotation: "true"})
+
+			result, err := adapter.EnsureReleaseIsArchived()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.records).To(BeEmpty())
+		})
+
+		It("should archive a completed Release and annotate it", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						Spec: v1alpha1.ReleasePlanSpec{Application: "application"},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsArchived()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.records).To(HaveLen(1))
+			Expect(backend.records[0].Name).To(Equal(adapter.release.Name))
+			Expect(backend.records[0].Application).To(Equal("application"))
+			Expect(backend.records[0].Phase).To(Equal(v1alpha1.ReleasePhaseSucceeded))
+			Expect(adapter.release.GetAnnotations()[metadata.ArchivedAnnotation]).To(Equal("true"))
+		})
+
+		It("should archive a Release whose ReleasePlan no longer exists", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsArchived()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.records).To(HaveLen(1))
+			Expect(backend.records[0].Application).To(BeEmpty())
+		})
+
+		It("should requeue if the record can't be stored", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			backend.err = fmt.Errorf("store failed")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   &v1alpha1.ReleasePlan{},
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsArchived()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.ArchivedAnnotation]).To(BeEmpty())
+		})
+	})
+
+	createReleaseAndAdapter = func() *adapter {
+		release := &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "release-",
+				Namespace:    "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: "releaseplan",
+				Snapshot:    "snapshot",
+			},
+		}
+		Expect(k8sClient.Create(ctx, release)).To(Succeed())
+
+		return newAdapter(ctx, k8sClient, release, backend, loader.NewMockLoader(), &ctrl.Log)
+	}
+})
+
+// mockBackend is an archive.Backend keeping the stored records in memory.
+type mockBackend struct {
+	err     error
+	records []*history.ReleaseRecord
+}
+
+func (b *mockBackend) Store(_ context.Context, record *history.ReleaseRecord) error {
+	if b.err != nil {
+		return b.err
+	}
+
+	b.records = append(b.records, record)
+
+	return nil
+}
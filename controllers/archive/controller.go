@@ -0,0 +1,104 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/archive"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Controller reconciles completed Releases to store their records in the release archive
+type Controller struct {
+	backend archive.Backend
+	client  client.Client
+	log     logr.Logger
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("Release", req.NamespacedName)
+
+	release := &v1alpha1.Release{}
+	err := c.client.Get(ctx, req.NamespacedName, release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	adapter := newAdapter(ctx, c.client, release, c.backend, loader.NewLoader(), &logger)
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureReleaseIsArchived,
+	})
+}
+
+// Register registers the controller with the passed manager and log. The archive backend is created from the url set
+// in the RELEASE_ARCHIVE_URL environment variable, using the token in RELEASE_ARCHIVE_TOKEN if any. This controller
+// only reacts to completed Releases that were not archived yet.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	archiveUrl := os.Getenv("RELEASE_ARCHIVE_URL")
+	if archiveUrl == "" {
+		return fmt.Errorf("the RELEASE_ARCHIVE_URL environment variable is required by the archive controller")
+	}
+
+	backend, err := archive.NewBackend(archiveUrl, os.Getenv("RELEASE_ARCHIVE_TOKEN"), http.DefaultClient)
+	if err != nil {
+		return err
+	}
+
+	c.backend = backend
+	c.client = mgr.GetClient()
+	c.log = log.WithName("archive")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("archive").
+		For(&v1alpha1.Release{}, builder.WithPredicates(predicate.NewPredicateFuncs(isPendingArchive))).
+		Complete(metrics.NewInstrumentedReconciler("archive", c))
+}
+
+// isPendingArchive returns whether the given object is a completed Release that was not archived yet.
+func isPendingArchive(object client.Object) bool {
+	release, ok := object.(*v1alpha1.Release)
+	if !ok {
+		return false
+	}
+
+	return release.HasReleaseFinished() && release.GetAnnotations()[metadata.ArchivedAnnotation] != "true"
+}
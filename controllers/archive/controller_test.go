@@ -0,0 +1,119 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"os"
+	"reflect"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Archive Controller", Ordered, func() {
+
+	When("Reconcile is called", func() {
+		It("should succeed even if the Release is not found", func() {
+			controller := &Controller{
+				client: k8sClient,
+				log:    ctrl.Log,
+			}
+
+			req := ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "non-existent",
+					Namespace: "default",
+				},
+			}
+			result, err := controller.Reconcile(ctx, req)
+			Expect(reflect.TypeOf(result)).To(Equal(reflect.TypeOf(reconcile.Result{})))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("Register is called", func() {
+		var mgr ctrl.Manager
+
+		BeforeEach(func() {
+			var err error
+			mgr, err = ctrl.NewManager(cfg, ctrl.Options{
+				Scheme: scheme.Scheme,
+				Metrics: server.Options{
+					BindAddress: "0",
+				},
+				LeaderElection: false,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_ARCHIVE_URL")
+		})
+
+		It("should fail if the archive url is not set", func() {
+			Expect((&Controller{}).Register(mgr, &ctrl.Log, nil)).NotTo(Succeed())
+		})
+
+		It("should fail if the archive url scheme is not supported", func() {
+			os.Setenv("RELEASE_ARCHIVE_URL", "ftp://archive")
+			Expect((&Controller{}).Register(mgr, &ctrl.Log, nil)).NotTo(Succeed())
+		})
+
+		It("should register the controller if the archive url is valid", func() {
+			os.Setenv("RELEASE_ARCHIVE_URL", "https://archive/releases")
+			Expect((&Controller{}).Register(mgr, &ctrl.Log, nil)).To(Succeed())
+		})
+	})
+
+	When("isPendingArchive is called", func() {
+		var release *v1alpha1.Release
+
+		BeforeEach(func() {
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "default",
+				},
+			}
+		})
+
+		It("should return false for a Release that didn't finish", func() {
+			Expect(isPendingArchive(release)).To(BeFalse())
+		})
+
+		It("should return true for a completed Release that was not archived", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			Expect(isPendingArchive(release)).To(BeTrue())
+		})
+
+		It("should return false for a completed Release that was archived", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			metadata.AddAnnotations(release, map[string]string{metadata.ArchivedAnnotation: "true"})
+			Expect(isPendingArchive(release)).To(BeFalse())
+		})
+	})
+})
@@ -17,15 +17,114 @@ limitations under the License.
 package controllers
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/controllers/application"
+	"github.com/konflux-ci/release-service/controllers/applicationreleasestatus"
+	"github.com/konflux-ci/release-service/controllers/archive"
+	"github.com/konflux-ci/release-service/controllers/outbox"
+	"github.com/konflux-ci/release-service/controllers/pipelinerun"
 	"github.com/konflux-ci/release-service/controllers/release"
+	"github.com/konflux-ci/release-service/controllers/releasenotes"
 	"github.com/konflux-ci/release-service/controllers/releaseplan"
 	"github.com/konflux-ci/release-service/controllers/releaseplanadmission"
+	"github.com/konflux-ci/release-service/controllers/signing"
+)
+
+const (
+	// ApplicationControllerName is the name used to enable the Application controller
+	ApplicationControllerName = "application"
+
+	// ApplicationReleaseStatusControllerName is the name used to enable the ApplicationReleaseStatus controller
+	ApplicationReleaseStatusControllerName = "applicationreleasestatus"
+
+	// ArchiveControllerName is the name used to enable the Archive controller
+	ArchiveControllerName = "archive"
+
+	// OutboxControllerName is the name used to enable the Outbox controller
+	OutboxControllerName = "outbox"
+
+	// PipelineRunControllerName is the name used to enable the PipelineRun controller
+	PipelineRunControllerName = "pipelinerun"
+
+	// ReleaseControllerName is the name used to enable the Release controller
+	ReleaseControllerName = "release"
+
+	// ReleaseNotesControllerName is the name used to enable the Release Notes controller
+	ReleaseNotesControllerName = "releasenotes"
+
+	// ReleasePlanControllerName is the name used to enable the ReleasePlan controller
+	ReleasePlanControllerName = "releaseplan"
+
+	// ReleasePlanAdmissionControllerName is the name used to enable the ReleasePlanAdmission controller
+	ReleasePlanAdmissionControllerName = "releaseplanadmission"
+
+	// SigningControllerName is the name used to enable the Signing controller
+	SigningControllerName = "signing"
 )
 
-// EnabledControllers is a slice containing references to all the controllers that have to be registered
-var EnabledControllers = []controller.Controller{
-	&release.Controller{},
-	&releaseplan.Controller{},
-	&releaseplanadmission.Controller{},
+// AvailableControllers is a map containing references to all the controllers that can be registered indexed by name
+var AvailableControllers = map[string]controller.Controller{
+	ApplicationControllerName:              &application.Controller{},
+	ApplicationReleaseStatusControllerName: &applicationreleasestatus.Controller{},
+	ArchiveControllerName:                  &archive.Controller{},
+	OutboxControllerName:                   &outbox.Controller{},
+	PipelineRunControllerName:              &pipelinerun.Controller{},
+	ReleaseControllerName:                  &release.Controller{},
+	ReleaseNotesControllerName:             &releasenotes.Controller{},
+	ReleasePlanControllerName:              &releaseplan.Controller{},
+	ReleasePlanAdmissionControllerName:     &releaseplanadmission.Controller{},
+	SigningControllerName:                  &signing.Controller{},
+}
+
+// OptionalControllers is a set containing the names of the controllers that are only registered if explicitly enabled
+var OptionalControllers = map[string]bool{
+	ApplicationControllerName:              true,
+	ApplicationReleaseStatusControllerName: true,
+	ArchiveControllerName:                  true,
+	OutboxControllerName:                   true,
+	ReleaseNotesControllerName:             true,
+	SigningControllerName:                  true,
+}
+
+// GetEnabledControllers returns the controllers matching the given names sorted by name. If no names are passed, all
+// the available controllers but the optional ones will be returned. If a name doesn't match any of the available controllers, an error
+// will be returned.
+func GetEnabledControllers(names ...string) ([]controller.Controller, error) {
+	var enabledNames []string
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if _, found := AvailableControllers[name]; !found {
+			return nil, fmt.Errorf("unknown controller '%s'", name)
+		}
+
+		enabledNames = append(enabledNames, name)
+	}
+
+	if len(enabledNames) == 0 {
+		for name := range AvailableControllers {
+			if !OptionalControllers[name] {
+				enabledNames = append(enabledNames, name)
+			}
+		}
+	}
+
+	sort.Strings(enabledNames)
+
+	var enabledControllers []controller.Controller
+	for i, name := range enabledNames {
+		if i > 0 && enabledNames[i-1] == name {
+			continue
+		}
+		enabledControllers = append(enabledControllers, AvailableControllers[name])
+	}
+
+	return enabledControllers, nil
 }
@@ -0,0 +1,81 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/konflux-ci/release-service/controllers/application"
+	"github.com/konflux-ci/release-service/controllers/archive"
+	"github.com/konflux-ci/release-service/controllers/release"
+	"github.com/konflux-ci/release-service/controllers/releasenotes"
+	"github.com/konflux-ci/release-service/controllers/releaseplan"
+	"github.com/konflux-ci/release-service/controllers/releaseplanadmission"
+	"github.com/konflux-ci/release-service/controllers/signing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Controllers", func() {
+	When("GetEnabledControllers is called", func() {
+		It("should return all the non optional controllers if no names are passed", func() {
+			enabledControllers, err := GetEnabledControllers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabledControllers).To(HaveLen(len(AvailableControllers) - len(OptionalControllers)))
+			for _, enabledController := range enabledControllers {
+				Expect(enabledController).NotTo(BeAssignableToTypeOf(&application.Controller{}))
+				Expect(enabledController).NotTo(BeAssignableToTypeOf(&archive.Controller{}))
+				Expect(enabledController).NotTo(BeAssignableToTypeOf(&releasenotes.Controller{}))
+				Expect(enabledController).NotTo(BeAssignableToTypeOf(&signing.Controller{}))
+			}
+		})
+
+		It("should return all the non optional controllers if only empty names are passed", func() {
+			enabledControllers, err := GetEnabledControllers("", " ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabledControllers).To(HaveLen(len(AvailableControllers) - len(OptionalControllers)))
+		})
+
+		It("should return optional controllers if explicitly enabled", func() {
+			enabledControllers, err := GetEnabledControllers(ApplicationControllerName, ReleaseControllerName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabledControllers).To(HaveLen(2))
+			Expect(enabledControllers[0]).To(BeAssignableToTypeOf(&application.Controller{}))
+			Expect(enabledControllers[1]).To(BeAssignableToTypeOf(&release.Controller{}))
+		})
+
+		It("should return only the controllers matching the given names", func() {
+			enabledControllers, err := GetEnabledControllers(ReleasePlanControllerName, " "+ReleaseControllerName+" ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabledControllers).To(HaveLen(2))
+			Expect(enabledControllers[0]).To(BeAssignableToTypeOf(&release.Controller{}))
+			Expect(enabledControllers[1]).To(BeAssignableToTypeOf(&releaseplan.Controller{}))
+		})
+
+		It("should not return the same controller twice", func() {
+			enabledControllers, err := GetEnabledControllers(ReleasePlanAdmissionControllerName, ReleasePlanAdmissionControllerName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabledControllers).To(HaveLen(1))
+			Expect(enabledControllers[0]).To(BeAssignableToTypeOf(&releaseplanadmission.Controller{}))
+		})
+
+		It("should fail if a name doesn't match any controller", func() {
+			enabledControllers, err := GetEnabledControllers(ReleaseControllerName, "foo")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown controller 'foo'"))
+			Expect(enabledControllers).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,176 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backoff"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/outbox"
+	"github.com/konflux-ci/release-service/redaction"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// adapter holds the objects needed to reconcile the outbox of a namespace.
+type adapter struct {
+	backoff          *backoff.Backoff
+	client           client.Client
+	ctx              context.Context
+	loader           loader.ObjectLoader
+	logger           *logr.Logger
+	namespace        string
+	outbox           *outbox.Outbox
+	serviceNamespace string
+	sinks            []outbox.Sink
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, serviceNamespace, namespace string, sinks []outbox.Sink, loader loader.ObjectLoader, logger *logr.Logger) *adapter {
+	return &adapter{
+		client:           client,
+		ctx:              ctx,
+		loader:           loader,
+		logger:           logger,
+		namespace:        namespace,
+		serviceNamespace: serviceNamespace,
+		sinks:            sinks,
+	}
+}
+
+// EnsureOutboxIsLoaded is an operation that will load the outbox of the namespace being processed from the service
+// namespace. This operation sets the outbox in the adapter to be used in other operations.
+func (a *adapter) EnsureOutboxIsLoaded() (controller.OperationResult, error) {
+	var err error
+	a.outbox, err = outbox.Load(a.ctx, a.client, a.serviceNamespace, a.namespace)
+
+	return controller.RequeueOnErrorOrContinue(err)
+}
+
+// EnsureConditionChangesAreRecorded is an operation that will ensure that the condition changes of every Release in
+// the namespace being processed are appended to its outbox, redacting the sensitive values of their data. The conditions
+// recorded for Releases that no longer exist or finished a while ago are removed from the outbox. If the outbox is full,
+// its oldest events are dropped and reported.
+func (a *adapter) EnsureConditionChangesAreRecorded() (controller.OperationResult, error) {
+	now := time.Now()
+	releases, err := a.loader.GetReleases(a.ctx, a.client, a.namespace)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	dropped := a.outbox.GetDroppedEvents()
+	changed := false
+	existingReleases := map[string]bool{}
+	for i := range releases.Items {
+		existingReleases[releases.Items[i].Name] = true
+
+		recorded, err := a.outbox.Record(&releases.Items[i], a.getRedactor(&releases.Items[i]), now)
+		if err != nil {
+			a.logger.Error(err, "Failed to record the condition changes", "Release.Name", releases.Items[i].Name)
+			continue
+		}
+		changed = changed || recorded
+	}
+
+	for _, release := range a.outbox.GetRecordedReleases() {
+		if !existingReleases[release] {
+			a.outbox.Forget(release)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return controller.ContinueProcessing()
+	}
+	a.outbox.SetRecordedTime(now)
+
+	err = a.outbox.Save(a.ctx, a.client)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	if newlyDropped := a.outbox.GetDroppedEvents() - dropped; newlyDropped > 0 {
+		a.logger.Error(fmt.Errorf("the outbox reached its limit of %d pending events", outbox.MaxPendingEvents),
+			"Dropped the oldest outbox events", "Events.Dropped", newlyDropped)
+		metrics.RegisterOutboxDroppedEvents(a.namespace, int(newlyDropped))
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureEventsAreDelivered is an operation that will ensure that the events pending in the outbox of the namespace
+// being processed are delivered to every sink in the order they were recorded. Each event is removed from the outbox
+// once delivered, so a restart only delivers the event in flight again. If an event can't be delivered, the namespace
+// is requeued following the Notification backoff policy and the events after it wait, so they are not delivered out of
+// order.
+func (a *adapter) EnsureEventsAreDelivered() (controller.OperationResult, error) {
+	events, err := a.outbox.GetPendingEvents()
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	for i := range events {
+		for _, sink := range a.sinks {
+			err = sink.Deliver(a.ctx, &events[i])
+			if err != nil {
+				a.logger.Error(err, "Failed to deliver the outbox event", "Event.ID", events[i].ID)
+				policy := backoff.LoadPolicy(a.ctx, a.client, a.loader, backoff.NotificationCategory)
+				return controller.RequeueAfter(a.backoff.Next(backoff.NotificationCategory, a.namespace, policy), nil)
+			}
+		}
+
+		a.outbox.Remove(&events[i])
+		err = a.outbox.Save(a.ctx, a.client)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+	}
+	a.backoff.Reset(backoff.NotificationCategory, a.namespace)
+
+	return controller.ContinueProcessing()
+}
+
+// getRedactor returns a Redactor for the values of the data of the given Release sourced from Secrets or marked as
+// sensitive. Values that can't be read are ignored, so a nil Redactor is returned if none of them can be read.
+func (a *adapter) getRedactor(release *v1alpha1.Release) *redaction.Redactor {
+	values, err := release.GetDataSensitiveValues()
+	if err != nil {
+		return nil
+	}
+
+	secretKeyRefs, err := release.GetDataSecretKeyRefs()
+	if err == nil {
+		for _, secretKeyRef := range secretKeyRefs {
+			secret, err := a.loader.GetSecret(a.ctx, a.client, secretKeyRef.Name, release.Namespace)
+			if err == nil {
+				values = append(values, string(secret.Data[secretKeyRef.Key]))
+			}
+		}
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return redaction.NewRedactor(values...)
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/outbox"
+	"github.com/konflux-ci/release-service/redaction"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// mockSink records the IDs of the events delivered to it, failing with the given error if any.
+type mockSink struct {
+	delivered []string
+	err       error
+}
+
+func (s *mockSink) Deliver(_ context.Context, event *outbox.Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.delivered = append(s.delivered, event.ID)
+
+	return nil
+}
+
+var _ = Describe("Outbox adapter", Ordered, func() {
+	var (
+		adapter *adapter
+		release *v1alpha1.Release
+		sink    *mockSink
+	)
+
+	When("newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, "default", "tenant", nil, loader.NewLoader(), &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter)))
+		})
+	})
+
+	BeforeEach(func() {
+		sink = &mockSink{}
+		adapter = newAdapter(ctx, k8sClient, "default", "tenant", []outbox.Sink{sink}, loader.NewMockLoader(), &ctrl.Log)
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: "tenant",
+			},
+			Status: v1alpha1.ReleaseStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               "Released",
+						Status:             metav1.ConditionUnknown,
+						Reason:             "Progressing",
+						LastTransitionTime: metav1.Now(),
+					},
+				},
+			},
+		}
+		adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+			{
+				ContextKey: loader.ReleasesContextKey,
+				Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{*release}},
+			},
+		})
+	})
+
+	AfterEach(func() {
+		_ = k8sClient.Delete(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      outbox.GetConfigMapName("tenant"),
+				Namespace: "default",
+			},
+		})
+	})
+
+	getOutboxData := func() map[string]string {
+		configMap := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: outbox.GetConfigMapName("tenant"), Namespace: "default"}, configMap)).To(Succeed())
+		return configMap.Data
+	}
+
+	When("EnsureOutboxIsLoaded is called", func() {
+		It("should load an empty outbox if it doesn't exist", func() {
+			result, err := adapter.EnsureOutboxIsLoaded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.outbox).NotTo(BeNil())
+
+			events, err := adapter.outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+	})
+
+	When("EnsureConditionChangesAreRecorded is called", func() {
+		BeforeEach(func() {
+			_, err := adapter.EnsureOutboxIsLoaded()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should append the condition changes to the outbox and save it", func() {
+			result, err := adapter.EnsureConditionChangesAreRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := adapter.outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Release).To(Equal("release"))
+			Expect(getOutboxData()).To(HaveKey("state.release"))
+			Expect(adapter.outbox.GetRecordedTime()).NotTo(BeZero())
+		})
+
+		It("should forget the Releases that no longer exist", func() {
+			_, err := adapter.EnsureConditionChangesAreRecorded()
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{},
+				},
+			})
+			result, err := adapter.EnsureConditionChangesAreRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getOutboxData()).NotTo(HaveKey("state.release"))
+		})
+
+		It("should forget the Releases that finished a while ago once recorded", func() {
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			release.Status.Conditions[0].Reason = "Succeeded"
+			release.Status.Conditions[0].LastTransitionTime = metav1.NewTime(
+				time.Now().Add(-outbox.FinishedReleaseRetention - time.Minute))
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{*release}},
+				},
+			})
+
+			result, err := adapter.EnsureConditionChangesAreRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getOutboxData()).NotTo(HaveKey("state.release"))
+
+			events, err := adapter.outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Reason).To(Equal("Succeeded"))
+
+			_, err = adapter.EnsureConditionChangesAreRecorded()
+			Expect(err).NotTo(HaveOccurred())
+			events, err = adapter.outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+		})
+
+		It("should redact the sensitive values of the Release data from the messages", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{"password": "s3cr3t", "sensitive": ["password"]}`)}
+			release.Status.Conditions[0].Message = "failed to log in with s3cr3t"
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{*release}},
+				},
+			})
+
+			_, err := adapter.EnsureConditionChangesAreRecorded()
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := adapter.outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Message).To(Equal("failed to log in with " + redaction.Placeholder))
+		})
+	})
+
+	When("getRedactor is called", func() {
+		It("should return nil if the Release data has no sensitive values", func() {
+			Expect(adapter.getRedactor(release)).To(BeNil())
+		})
+
+		It("should redact the values marked as sensitive and the values sourced from Secrets", func() {
+			release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"password": "s3cr3t",
+				"token": {"secretKeyRef": {"name": "secret", "key": "token"}},
+				"sensitive": ["password"]
+			}`)}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SecretContextKey,
+					Resource: &corev1.Secret{
+						Data: map[string][]byte{"token": []byte("t0k3n")},
+					},
+				},
+			})
+
+			redactor := adapter.getRedactor(release)
+			Expect(redactor).NotTo(BeNil())
+			Expect(redactor.Redact("s3cr3t t0k3n")).To(Equal(redaction.Placeholder + " " + redaction.Placeholder))
+		})
+	})
+
+	When("EnsureEventsAreDelivered is called", func() {
+		BeforeEach(func() {
+			_, err := adapter.EnsureOutboxIsLoaded()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = adapter.EnsureConditionChangesAreRecorded()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deliver the pending events and remove them from the outbox", func() {
+			result, err := adapter.EnsureEventsAreDelivered()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink.delivered).To(Equal([]string{"tenant-1"}))
+
+			adapter.outbox, err = outbox.Load(ctx, k8sClient, "default", "tenant")
+			Expect(err).NotTo(HaveOccurred())
+			events, err := adapter.outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("should keep the events in the outbox and requeue if they can't be delivered", func() {
+			sink.err = fmt.Errorf("unavailable")
+
+			result, err := adapter.EnsureEventsAreDelivered()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically(">", 0))
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := adapter.outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+		})
+	})
+})
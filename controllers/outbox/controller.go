@@ -0,0 +1,126 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backoff"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/outbox"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Controller reconciles the outbox of each namespace to record the condition changes of its Releases and deliver them
+// to the configured sinks
+type Controller struct {
+	backoff          *backoff.Backoff
+	client           client.Client
+	log              logr.Logger
+	serviceNamespace string
+	sinks            []outbox.Sink
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("Namespace", req.Namespace)
+
+	adapter := newAdapter(ctx, c.client, c.serviceNamespace, req.Namespace, c.sinks, loader.NewLoader(), &logger)
+	adapter.backoff = c.backoff
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureOutboxIsLoaded, // This operation sets the outbox in the adapter to be used in other operations.
+		adapter.EnsureConditionChangesAreRecorded,
+		adapter.EnsureEventsAreDelivered,
+	})
+}
+
+// Register registers the controller with the passed manager and log. The sinks are webhooks created from the
+// comma-separated list of urls set in the RELEASE_OUTBOX_SINKS environment variable, using the token in
+// RELEASE_OUTBOX_TOKEN if any. The outboxes are stored in the namespace set in the SERVICE_NAMESPACE environment
+// variable. Every Release change and outbox ConfigMap change triggers a reconcile of the outbox of its namespace.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	serviceNamespace := os.Getenv("SERVICE_NAMESPACE")
+	if serviceNamespace == "" {
+		return fmt.Errorf("the SERVICE_NAMESPACE environment variable is required by the outbox controller")
+	}
+
+	sinks, err := outbox.NewWebhookSinks(os.Getenv("RELEASE_OUTBOX_SINKS"), os.Getenv("RELEASE_OUTBOX_TOKEN"),
+		http.DefaultClient)
+	if err != nil {
+		return err
+	}
+	if len(sinks) == 0 {
+		return fmt.Errorf("the RELEASE_OUTBOX_SINKS environment variable is required by the outbox controller")
+	}
+
+	c.backoff = backoff.New()
+	c.client = mgr.GetClient()
+	c.log = log.WithName("outbox")
+	c.serviceNamespace = serviceNamespace
+	c.sinks = sinks
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("outbox").
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(getOutboxConfigMapRequest),
+			builder.WithPredicates(predicate.NewPredicateFuncs(c.isOutbox))).
+		Watches(&v1alpha1.Release{}, handler.EnqueueRequestsFromMapFunc(getOutboxRequest)).
+		Complete(metrics.NewInstrumentedReconciler("outbox", c))
+}
+
+// getOutboxConfigMapRequest returns a reconcile request for the outbox held by the given ConfigMap.
+func getOutboxConfigMapRequest(_ context.Context, object client.Object) []reconcile.Request {
+	namespace := strings.TrimPrefix(object.GetName(), outbox.ConfigMapNamePrefix)
+
+	return []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: outbox.GetConfigMapName(namespace), Namespace: namespace}},
+	}
+}
+
+// getOutboxRequest returns a reconcile request for the outbox of the namespace of the given object.
+func getOutboxRequest(_ context.Context, object client.Object) []reconcile.Request {
+	namespace := object.GetNamespace()
+
+	return []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: outbox.GetConfigMapName(namespace), Namespace: namespace}},
+	}
+}
+
+// isOutbox returns whether the given object is an outbox ConfigMap. Only the ConfigMaps in the service namespace are
+// outboxes, so the ConfigMaps tenants create in their namespaces are ignored.
+func (c *Controller) isOutbox(object client.Object) bool {
+	return object.GetNamespace() == c.serviceNamespace && strings.HasPrefix(object.GetName(), outbox.ConfigMapNamePrefix)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"os"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/outbox"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+var _ = Describe("Outbox Controller", Ordered, func() {
+
+	When("Register is called", func() {
+		var mgr ctrl.Manager
+
+		BeforeEach(func() {
+			var err error
+			mgr, err = ctrl.NewManager(cfg, ctrl.Options{
+				Scheme: scheme.Scheme,
+				Metrics: server.Options{
+					BindAddress: "0",
+				},
+				LeaderElection: false,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("RELEASE_OUTBOX_SINKS")).To(Succeed())
+			Expect(os.Unsetenv("SERVICE_NAMESPACE")).To(Succeed())
+		})
+
+		It("should fail if the service namespace is not set", func() {
+			Expect(os.Setenv("RELEASE_OUTBOX_SINKS", "https://example.com/events")).To(Succeed())
+			err := (&Controller{}).Register(mgr, &ctrl.Log, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("SERVICE_NAMESPACE"))
+		})
+
+		It("should fail if no sink is configured", func() {
+			Expect(os.Setenv("SERVICE_NAMESPACE", "default")).To(Succeed())
+			err := (&Controller{}).Register(mgr, &ctrl.Log, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("RELEASE_OUTBOX_SINKS"))
+		})
+
+		It("should register the controller", func() {
+			Expect(os.Setenv("RELEASE_OUTBOX_SINKS", "https://example.com/events")).To(Succeed())
+			Expect(os.Setenv("SERVICE_NAMESPACE", "default")).To(Succeed())
+			controller := &Controller{}
+			Expect(controller.Register(mgr, &ctrl.Log, nil)).To(Succeed())
+			Expect(controller.serviceNamespace).To(Equal("default"))
+			Expect(controller.sinks).To(HaveLen(1))
+		})
+	})
+
+	When("getOutboxRequest is called", func() {
+		It("should return a request for the outbox of the namespace of the object", func() {
+			requests := getOutboxRequest(ctx, &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "tenant",
+				},
+			})
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].NamespacedName).To(Equal(client.ObjectKey{
+				Name:      outbox.GetConfigMapName("tenant"),
+				Namespace: "tenant",
+			}))
+		})
+	})
+
+	When("getOutboxConfigMapRequest is called", func() {
+		It("should return a request for the outbox of the namespace the ConfigMap tracks", func() {
+			requests := getOutboxConfigMapRequest(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      outbox.GetConfigMapName("tenant"),
+					Namespace: "default",
+				},
+			})
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].NamespacedName).To(Equal(client.ObjectKey{
+				Name:      outbox.GetConfigMapName("tenant"),
+				Namespace: "tenant",
+			}))
+		})
+	})
+
+	When("isOutbox is called", func() {
+		It("should return true only for outbox ConfigMaps in the service namespace", func() {
+			controller := &Controller{serviceNamespace: "default"}
+			Expect(controller.isOutbox(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      outbox.GetConfigMapName("tenant"),
+				Namespace: "default",
+			}})).To(BeTrue())
+			Expect(controller.isOutbox(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      outbox.GetConfigMapName("tenant"),
+				Namespace: "tenant",
+			}})).To(BeFalse())
+			Expect(controller.isOutbox(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      "other",
+				Namespace: "default",
+			}})).To(BeFalse())
+		})
+	})
+})
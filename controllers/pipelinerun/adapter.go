@@ -0,0 +1,326 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/tekton"
+	"github.com/konflux-ci/release-service/throttle"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// adapter holds the objects needed to reconcile a Release PipelineRun.
+type adapter struct {
+	client               client.Client
+	ctx                  context.Context
+	loader               loader.ObjectLoader
+	logger               *logr.Logger
+	pipelineRun          *tektonv1.PipelineRun
+	releaseServiceConfig *v1alpha1.ReleaseServiceConfig
+	throttle             *throttle.Throttle
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, pipelineRun *tektonv1.PipelineRun, loader loader.ObjectLoader, logger *logr.Logger) *adapter {
+	return &adapter{
+		client:      client,
+		ctx:         ctx,
+		loader:      loader,
+		logger:      logger,
+		pipelineRun: pipelineRun,
+	}
+}
+
+// EnsureReleaseProgressIsUpdated is an operation that will ensure that the task-level progress of the PipelineRun
+// being processed is reported in the status of its Release, so UIs can show it without accessing the PipelineRun. The
+// progress of running PipelineRuns is updated at most once per status update interval for each Release, so the progress
+// changed in between is reported in the next update, while the final progress is always reported.
+func (a *adapter) EnsureReleaseProgressIsUpdated() (controller.OperationResult, error) {
+	labels := a.pipelineRun.GetLabels()
+	trackedRelease, _ := metadata.GetTrackedRelease(a.pipelineRun)
+	key := trackedRelease.String()
+	release, err := a.loader.GetRelease(a.ctx, a.client, trackedRelease.Name, trackedRelease.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			a.throttle.Forget(key)
+			return controller.ContinueProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	var pipelineInfo *v1alpha1.PipelineInfo
+	switch labels[metadata.PipelinesTypeLabel] {
+	case metadata.ManagedPipelineType:
+		pipelineInfo = &release.Status.ManagedProcessing
+	case metadata.TenantPipelineType:
+		pipelineInfo = &release.Status.TenantProcessing
+	case metadata.VerificationPipelineType:
+		pipelineInfo = &release.Status.Verification
+	default:
+		return controller.ContinueProcessing()
+	}
+
+	progress := pipelineInfo.Progress
+	if progress != nil && !a.pipelineRun.IsDone() &&
+		a.throttle.Delay(key, throttle.LoadInterval(a.ctx, a.client, a.loader)) > 0 {
+		metrics.RegisterThrottledStatusUpdate("pipelinerun")
+		return controller.ContinueProcessing()
+	}
+
+	completed, total, err := tekton.GetPipelineRunProgress(a.ctx, a.client, a.pipelineRun)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	if progress != nil && progress.CompletedTasks == completed && progress.TotalTasks == total {
+		return controller.ContinueProcessing()
+	}
+
+	patch := client.MergeFrom(release.DeepCopy())
+	pipelineInfo.Progress = &v1alpha1.PipelineProgress{
+		CompletedTasks: completed,
+		LastUpdateTime: &metav1.Time{Time: time.Now()},
+		TotalTasks:     total,
+	}
+	err = a.client.Status().Patch(a.ctx, release, patch)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+	a.throttle.Record(key)
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureConfigIsLoaded is an operation that will load the service ReleaseServiceConfig from the manager namespace. If
+// it's not found or the namespace is not set, no other operation after this one will be executed, as there is no policy
+// defining how orphaned PipelineRuns should be handled.
+func (a *adapter) EnsureConfigIsLoaded() (controller.OperationResult, error) {
+	namespace := os.Getenv("SERVICE_NAMESPACE")
+	if namespace == "" {
+		return controller.StopProcessing()
+	}
+
+	var err error
+	a.releaseServiceConfig, err = a.loader.GetReleaseServiceConfig(a.ctx, a.client, v1alpha1.ReleaseServiceConfigResourceName, namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.StopProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureOrphanedPipelineRunIsHandled is an operation that will ensure that the PipelineRun being processed is handled
+// according to the OrphanedPipelineRunPolicy if the Release it was created for no longer exists. Orphaned PipelineRuns
+// are either labeled as orphaned or deleted after removing the Release finalizer from them, as no Release will remove it.
+// In dry-run mode, the PipelineRuns that would be deleted are only logged and registered in the metrics.
+func (a *adapter) EnsureOrphanedPipelineRunIsHandled() (controller.OperationResult, error) {
+	policy := a.releaseServiceConfig.Spec.OrphanedPipelineRunPolicy
+	if policy == nil {
+		return controller.ContinueProcessing()
+	}
+
+	labels := a.pipelineRun.GetLabels()
+	trackedRelease, _ := metadata.GetTrackedRelease(a.pipelineRun)
+	_, err := a.loader.GetRelease(a.ctx, a.client, trackedRelease.Name, trackedRelease.Namespace)
+	if err == nil {
+		return controller.ContinueProcessing()
+	}
+	if !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	switch policy.Action {
+	case v1alpha1.OrphanedPipelineRunActionDelete:
+		if policy.DryRun {
+			a.logger.Info("Orphaned PipelineRun would be deleted (dry-run)")
+			metrics.RegisterGarbageCollectedObject("PipelineRun", metrics.GarbageCollectionReasonOrphaned, true)
+
+			return controller.ContinueProcessing()
+		}
+
+		if controllerutil.ContainsFinalizer(a.pipelineRun, metadata.ReleaseFinalizer) {
+			patch := client.MergeFrom(a.pipelineRun.DeepCopy())
+			controllerutil.RemoveFinalizer(a.pipelineRun, metadata.ReleaseFinalizer)
+			err = a.client.Patch(a.ctx, a.pipelineRun, patch)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return controller.ContinueProcessing()
+				}
+
+				return controller.RequeueWithError(err)
+			}
+		}
+
+		if a.pipelineRun.GetDeletionTimestamp() == nil {
+			err = a.client.Delete(a.ctx, a.pipelineRun)
+			if err != nil && !errors.IsNotFound(err) {
+				return controller.RequeueWithError(err)
+			}
+
+			a.logger.Info("Deleted orphaned PipelineRun")
+			metrics.RegisterGarbageCollectedObject("PipelineRun", metrics.GarbageCollectionReasonOrphaned, false)
+		}
+	case v1alpha1.OrphanedPipelineRunActionFlag:
+		if labels[metadata.OrphanedLabel] == "true" {
+			return controller.ContinueProcessing()
+		}
+
+		patch := client.MergeFrom(a.pipelineRun.DeepCopy())
+		metadata.AddLabels(a.pipelineRun, map[string]string{metadata.OrphanedLabel: "true"})
+		err = a.client.Patch(a.ctx, a.pipelineRun, patch)
+		if err != nil && !errors.IsNotFound(err) {
+			return controller.RequeueWithError(err)
+		}
+
+		a.logger.Info("Flagged orphaned PipelineRun")
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureWorkspaceUsageIsHandled is an operation that will ensure that the release workspace of the PipelineRun being
+// processed is handled according to the WorkspaceUsagePolicy when its tasks report a usage above the threshold. Such
+// reports are always registered in the metrics, so they can be alerted on, and, if the policy says so, the claims of
+// the workspace are expanded. Each report is only handled once, as the PipelineRun is annotated with the TaskRun
+// reporting it.
+func (a *adapter) EnsureWorkspaceUsageIsHandled() (controller.OperationResult, error) {
+	policy := a.releaseServiceConfig.Spec.WorkspaceUsagePolicy
+	if policy == nil || a.pipelineRun.IsDone() {
+		return controller.ContinueProcessing()
+	}
+
+	usage, taskRun, err := tekton.GetWorkspaceUsage(a.ctx, a.client, a.pipelineRun)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	if taskRun == "" || usage < policy.Threshold ||
+		a.pipelineRun.GetAnnotations()[metadata.WorkspaceUsageCheckedAnnotation] == taskRun {
+		return controller.ContinueProcessing()
+	}
+
+	a.logger.Info("Workspace usage above the threshold", "Usage", usage, "TaskRun.Name", taskRun)
+	action := metrics.WorkspaceActionAlerted
+	if policy.Expand {
+		action, err = a.expandWorkspaceClaims(policy.MaxSize)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+	}
+
+	patch := client.MergeFrom(a.pipelineRun.DeepCopy())
+	delete(a.pipelineRun.Annotations, metadata.WorkspaceUsageCheckedAnnotation)
+	metadata.AddAnnotations(a.pipelineRun, map[string]string{metadata.WorkspaceUsageCheckedAnnotation: taskRun})
+	err = a.client.Patch(a.ctx, a.pipelineRun, patch)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	metrics.RegisterWorkspaceUsageThresholdExceeded(action)
+
+	return controller.ContinueProcessing()
+}
+
+// expandWorkspaceClaims doubles the size of the PersistentVolumeClaims owned by the PipelineRun being processed, which
+// are the claims Tekton creates for its release workspace, without going beyond the given maximum size. The returned
+// string is the action taken, to be registered in the metrics. Claims whose storage class doesn't allow expansion are
+// reported as failed expansions instead of returning an error, as retrying wouldn't change the outcome.
+func (a *adapter) expandWorkspaceClaims(maxSize *resource.Quantity) (string, error) {
+	claims := &corev1.PersistentVolumeClaimList{}
+	err := a.client.List(a.ctx, claims, client.InNamespace(a.pipelineRun.Namespace))
+	if err != nil {
+		return "", err
+	}
+
+	action := metrics.WorkspaceActionAlerted
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if !isOwnedBy(claim, a.pipelineRun) {
+			continue
+		}
+
+		size, expanded := getExpandedSize(claim.Spec.Resources.Requests[corev1.ResourceStorage], maxSize)
+		if !expanded {
+			a.logger.Info("Workspace claim already at its maximum size", "PersistentVolumeClaim.Name", claim.Name)
+			continue
+		}
+
+		patch := client.MergeFrom(claim.DeepCopy())
+		claim.Spec.Resources.Requests[corev1.ResourceStorage] = size
+		err = a.client.Patch(a.ctx, claim, patch)
+		if err != nil {
+			if errors.IsForbidden(err) || errors.IsInvalid(err) {
+				a.logger.Error(err, "Failed to expand the workspace claim", "PersistentVolumeClaim.Name", claim.Name)
+				action = metrics.WorkspaceActionExpansionFailed
+				continue
+			}
+
+			return "", err
+		}
+
+		a.logger.Info(fmt.Sprintf("Expanded the workspace claim to %s", size.String()),
+			"PersistentVolumeClaim.Name", claim.Name)
+		if action == metrics.WorkspaceActionAlerted {
+			action = metrics.WorkspaceActionExpanded
+		}
+	}
+
+	return action, nil
+}
+
+// getExpandedSize returns the given size doubled, without going beyond the given maximum size if set. The returned
+// boolean indicates whether the size grows.
+func getExpandedSize(size resource.Quantity, maxSize *resource.Quantity) (resource.Quantity, bool) {
+	expandedSize := *resource.NewQuantity(size.Value()*2, size.Format)
+	if maxSize != nil && expandedSize.Cmp(*maxSize) > 0 {
+		expandedSize = maxSize.DeepCopy()
+	}
+
+	return expandedSize, expandedSize.Cmp(size) > 0
+}
+
+// isOwnedBy returns whether the given object has an owner reference to the given owner.
+func isOwnedBy(object, owner metav1.Object) bool {
+	for _, ownerReference := range object.GetOwnerReferences() {
+		if ownerReference.UID == owner.GetUID() {
+			return true
+		}
+	}
+
+	return false
+}
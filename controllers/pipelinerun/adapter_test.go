@@ -0,0 +1,481 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"os"
+	"reflect"
+	"time"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/tekton"
+	"github.com/konflux-ci/release-service/throttle"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("PipelineRun adapter", Ordered, func() {
+	var (
+		createPipelineRunAndAdapter func() *adapter
+		notFoundError               = errors.NewNotFound(schema.GroupResource{}, "")
+	)
+
+	When("newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, loader.NewLoader(), &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+		})
+	})
+
+	When("EnsureReleaseProgressIsUpdated is called", func() {
+		var adapter *adapter
+		var release *v1alpha1.Release
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, release)
+			pipelineRun := &tektonv1.PipelineRun{}
+			err := adapter.client.Get(ctx, client.ObjectKeyFromObject(adapter.pipelineRun), pipelineRun)
+			if err == nil {
+				controllerutil.RemoveFinalizer(pipelineRun, metadata.ReleaseFinalizer)
+				_ = adapter.client.Update(ctx, pipelineRun)
+				_ = adapter.client.Delete(ctx, pipelineRun)
+			}
+		})
+
+		BeforeEach(func() {
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "release-",
+					Namespace:    testNamespace,
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					Snapshot:    "snapshot",
+					ReleasePlan: "release-plan",
+				},
+			}
+			Expect(k8sClient.Create(ctx, release)).To(Succeed())
+
+			adapter = createPipelineRunAndAdapter()
+			adapter.pipelineRun.Labels[metadata.ReleaseNameLabel] = release.Name
+			adapter.pipelineRun.Status.PipelineSpec = &tektonv1.PipelineSpec{
+				Tasks: []tektonv1.PipelineTask{{Name: "a"}, {Name: "b"}},
+			}
+		})
+
+		It("should do nothing if the Release doesn't exist", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        notFoundError,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseProgressIsUpdated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should report the progress in the status of the Release", func() {
+			result, err := adapter.EnsureReleaseProgressIsUpdated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release)).To(Succeed())
+			Expect(release.Status.ManagedProcessing.Progress).NotTo(BeNil())
+			Expect(release.Status.ManagedProcessing.Progress.CompletedTasks).To(BeZero())
+			Expect(release.Status.ManagedProcessing.Progress.TotalTasks).To(Equal(2))
+			Expect(release.Status.TenantProcessing.Progress).To(BeNil())
+		})
+
+		It("should not update the progress of a running PipelineRun more often than the interval", func() {
+			release.Status.ManagedProcessing.Progress = &v1alpha1.PipelineProgress{
+				LastUpdateTime: &metav1.Time{Time: time.Now()},
+				TotalTasks:     1,
+			}
+			Expect(k8sClient.Status().Update(ctx, release)).To(Succeed())
+			adapter.throttle = throttle.New()
+			adapter.throttle.Record(client.ObjectKeyFromObject(release).String())
+
+			result, err := adapter.EnsureReleaseProgressIsUpdated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release)).To(Succeed())
+			Expect(release.Status.ManagedProcessing.Progress.TotalTasks).To(Equal(1))
+		})
+
+		It("should update the progress of a running PipelineRun once the interval elapses", func() {
+			release.Status.ManagedProcessing.Progress = &v1alpha1.PipelineProgress{
+				LastUpdateTime: &metav1.Time{Time: time.Now()},
+				TotalTasks:     1,
+			}
+			Expect(k8sClient.Status().Update(ctx, release)).To(Succeed())
+			adapter.throttle = throttle.New()
+
+			result, err := adapter.EnsureReleaseProgressIsUpdated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release)).To(Succeed())
+			Expect(release.Status.ManagedProcessing.Progress.TotalTasks).To(Equal(2))
+			Expect(adapter.throttle.Delay(client.ObjectKeyFromObject(release).String(), time.Minute)).NotTo(BeZero())
+		})
+	})
+
+	When("EnsureConfigIsLoaded is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.pipelineRun)
+			os.Unsetenv("SERVICE_NAMESPACE")
+		})
+
+		BeforeEach(func() {
+			adapter = createPipelineRunAndAdapter()
+		})
+
+		It("should stop processing if the SERVICE_NAMESPACE env var is not set", func() {
+			result, err := adapter.EnsureConfigIsLoaded()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should stop processing if the ReleaseServiceConfig is not found", func() {
+			os.Setenv("SERVICE_NAMESPACE", "test")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseServiceConfigContextKey,
+					Err:        notFoundError,
+				},
+			})
+
+			result, err := adapter.EnsureConfigIsLoaded()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should load the ReleaseServiceConfig", func() {
+			os.Setenv("SERVICE_NAMESPACE", "test")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseServiceConfigContextKey,
+					Resource:   &v1alpha1.ReleaseServiceConfig{},
+				},
+			})
+
+			result, err := adapter.EnsureConfigIsLoaded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releaseServiceConfig).NotTo(BeNil())
+		})
+	})
+
+	When("EnsureOrphanedPipelineRunIsHandled is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			err := adapter.client.Get(ctx, client.ObjectKeyFromObject(adapter.pipelineRun), pipelineRun)
+			if err == nil {
+				controllerutil.RemoveFinalizer(pipelineRun, metadata.ReleaseFinalizer)
+				_ = adapter.client.Update(ctx, pipelineRun)
+				_ = adapter.client.Delete(ctx, pipelineRun)
+			}
+		})
+
+		BeforeEach(func() {
+			adapter = createPipelineRunAndAdapter()
+			adapter.releaseServiceConfig = &v1alpha1.ReleaseServiceConfig{}
+		})
+
+		It("should do nothing if there is no OrphanedPipelineRunPolicy", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        notFoundError,
+				},
+			})
+
+			result, err := adapter.EnsureOrphanedPipelineRunIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.pipelineRun.GetLabels()).NotTo(HaveKey(metadata.OrphanedLabel))
+		})
+
+		It("should do nothing if the Release exists", func() {
+			adapter.releaseServiceConfig.Spec.OrphanedPipelineRunPolicy = &v1alpha1.OrphanedPipelineRunPolicy{
+				Action: v1alpha1.OrphanedPipelineRunActionDelete,
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   &v1alpha1.Release{},
+				},
+			})
+
+			result, err := adapter.EnsureOrphanedPipelineRunIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Get(ctx, client.ObjectKeyFromObject(adapter.pipelineRun), &tektonv1.PipelineRun{})).To(Succeed())
+		})
+
+		It("should flag the PipelineRun if the Release doesn't exist and the action is Flag", func() {
+			adapter.releaseServiceConfig.Spec.OrphanedPipelineRunPolicy = &v1alpha1.OrphanedPipelineRunPolicy{
+				Action: v1alpha1.OrphanedPipelineRunActionFlag,
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        notFoundError,
+				},
+			})
+
+			result, err := adapter.EnsureOrphanedPipelineRunIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun := &tektonv1.PipelineRun{}
+			Expect(adapter.client.Get(ctx, client.ObjectKeyFromObject(adapter.pipelineRun), pipelineRun)).To(Succeed())
+			Expect(pipelineRun.GetLabels()).To(HaveKeyWithValue(metadata.OrphanedLabel, "true"))
+			Expect(pipelineRun.GetLabels()).To(HaveKeyWithValue(metadata.ReleaseNameLabel, "release"))
+		})
+
+		It("should delete the PipelineRun if the Release doesn't exist and the action is Delete", func() {
+			adapter.releaseServiceConfig.Spec.OrphanedPipelineRunPolicy = &v1alpha1.OrphanedPipelineRunPolicy{
+				Action: v1alpha1.OrphanedPipelineRunActionDelete,
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        notFoundError,
+				},
+			})
+
+			result, err := adapter.EnsureOrphanedPipelineRunIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() bool {
+				err := adapter.client.Get(ctx, client.ObjectKeyFromObject(adapter.pipelineRun), &tektonv1.PipelineRun{})
+				return errors.IsNotFound(err)
+			}).Should(BeTrue())
+		})
+
+		It("should not delete the PipelineRun if the action is Delete in dry-run mode", func() {
+			metrics.GarbageCollectedObjectsTotal.Reset()
+			adapter.releaseServiceConfig.Spec.OrphanedPipelineRunPolicy = &v1alpha1.OrphanedPipelineRunPolicy{
+				Action: v1alpha1.OrphanedPipelineRunActionDelete,
+				DryRun: true,
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        notFoundError,
+				},
+			})
+
+			result, err := adapter.EnsureOrphanedPipelineRunIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun := &tektonv1.PipelineRun{}
+			Expect(adapter.client.Get(ctx, client.ObjectKeyFromObject(adapter.pipelineRun), pipelineRun)).To(Succeed())
+			Expect(pipelineRun.GetFinalizers()).To(ContainElement(metadata.ReleaseFinalizer))
+			Expect(testutil.ToFloat64(metrics.GarbageCollectedObjectsTotal.WithLabelValues(
+				"PipelineRun", metrics.GarbageCollectionReasonOrphaned, "true"))).To(Equal(float64(1)))
+		})
+	})
+
+	When("EnsureWorkspaceUsageIsHandled is called", func() {
+		var adapter *adapter
+		var claim *corev1.PersistentVolumeClaim
+		var taskRun *tektonv1.TaskRun
+
+		AfterEach(func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			err := adapter.client.Get(ctx, client.ObjectKeyFromObject(adapter.pipelineRun), pipelineRun)
+			if err == nil {
+				controllerutil.RemoveFinalizer(pipelineRun, metadata.ReleaseFinalizer)
+				_ = adapter.client.Update(ctx, pipelineRun)
+				_ = adapter.client.Delete(ctx, pipelineRun)
+			}
+			_ = adapter.client.Delete(ctx, taskRun)
+			_ = adapter.client.Delete(ctx, claim)
+		})
+
+		BeforeEach(func() {
+			metrics.WorkspaceUsageThresholdExceededTotal.Reset()
+
+			adapter = createPipelineRunAndAdapter()
+			adapter.releaseServiceConfig = &v1alpha1.ReleaseServiceConfig{}
+
+			taskRun = &tektonv1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "task-run-",
+					Namespace:    testNamespace,
+				},
+			}
+			Expect(k8sClient.Create(ctx, taskRun)).To(Succeed())
+			taskRun.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+			taskRun.Status.Results = []tektonv1.TaskRunResult{
+				{
+					Name:  tekton.WorkspaceUsageResultName,
+					Value: *tektonv1.NewStructuredValues("95"),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, taskRun)).To(Succeed())
+
+			adapter.pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{
+					TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+					Name:     taskRun.Name,
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, adapter.pipelineRun)).To(Succeed())
+
+			claim = &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pvc-",
+					Namespace:    testNamespace,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "tekton.dev/v1",
+							Kind:       "PipelineRun",
+							Name:       adapter.pipelineRun.Name,
+							UID:        adapter.pipelineRun.UID,
+						},
+					},
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, claim)).To(Succeed())
+		})
+
+		It("should do nothing if there is no WorkspaceUsagePolicy", func() {
+			result, err := adapter.EnsureWorkspaceUsageIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.pipelineRun.GetAnnotations()).NotTo(HaveKey(metadata.WorkspaceUsageCheckedAnnotation))
+		})
+
+		It("should do nothing if the usage is below the threshold", func() {
+			adapter.releaseServiceConfig.Spec.WorkspaceUsagePolicy = &v1alpha1.WorkspaceUsagePolicy{Threshold: 99}
+
+			result, err := adapter.EnsureWorkspaceUsageIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.pipelineRun.GetAnnotations()).NotTo(HaveKey(metadata.WorkspaceUsageCheckedAnnotation))
+		})
+
+		It("should only register the usage if the claims shouldn't be expanded", func() {
+			adapter.releaseServiceConfig.Spec.WorkspaceUsagePolicy = &v1alpha1.WorkspaceUsagePolicy{Threshold: 90}
+
+			result, err := adapter.EnsureWorkspaceUsageIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.pipelineRun.GetAnnotations()).To(HaveKeyWithValue(
+				metadata.WorkspaceUsageCheckedAnnotation, taskRun.Name))
+			Expect(testutil.ToFloat64(metrics.WorkspaceUsageThresholdExceededTotal.WithLabelValues(
+				metrics.WorkspaceActionAlerted))).To(Equal(float64(1)))
+		})
+
+		It("should handle each usage report only once", func() {
+			adapter.releaseServiceConfig.Spec.WorkspaceUsagePolicy = &v1alpha1.WorkspaceUsagePolicy{Threshold: 90}
+
+			_, err := adapter.EnsureWorkspaceUsageIsHandled()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = adapter.EnsureWorkspaceUsageIsHandled()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testutil.ToFloat64(metrics.WorkspaceUsageThresholdExceededTotal.WithLabelValues(
+				metrics.WorkspaceActionAlerted))).To(Equal(float64(1)))
+		})
+
+		It("should report a failed expansion if the claim can't be expanded", func() {
+			// Claims that are not bound to a volume can't be expanded
+			adapter.releaseServiceConfig.Spec.WorkspaceUsagePolicy = &v1alpha1.WorkspaceUsagePolicy{
+				Threshold: 90,
+				Expand:    true,
+			}
+
+			result, err := adapter.EnsureWorkspaceUsageIsHandled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testutil.ToFloat64(metrics.WorkspaceUsageThresholdExceededTotal.WithLabelValues(
+				metrics.WorkspaceActionExpansionFailed))).To(Equal(float64(1)))
+		})
+	})
+
+	When("getExpandedSize is called", func() {
+		It("should double the size", func() {
+			size, expanded := getExpandedSize(resource.MustParse("1Gi"), nil)
+			Expect(expanded).To(BeTrue())
+			Expect(size.Cmp(resource.MustParse("2Gi"))).To(BeZero())
+		})
+
+		It("should not go beyond the maximum size", func() {
+			maxSize := resource.MustParse("3Gi")
+			size, expanded := getExpandedSize(resource.MustParse("2Gi"), &maxSize)
+			Expect(expanded).To(BeTrue())
+			Expect(size.Cmp(maxSize)).To(BeZero())
+		})
+
+		It("should not expand a size already at the maximum", func() {
+			maxSize := resource.MustParse("2Gi")
+			_, expanded := getExpandedSize(resource.MustParse("2Gi"), &maxSize)
+			Expect(expanded).To(BeFalse())
+		})
+	})
+
+	createPipelineRunAndAdapter = func() *adapter {
+		pipelineRun := &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "pipeline-run-",
+				Namespace:    testNamespace,
+				Finalizers:   []string{metadata.ReleaseFinalizer},
+				Labels: map[string]string{
+					metadata.PipelinesTypeLabel:    metadata.ManagedPipelineType,
+					metadata.ReleaseNameLabel:      "release",
+					metadata.ReleaseNamespaceLabel: testNamespace,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+
+		return newAdapter(ctx, k8sClient, pipelineRun, loader.NewMockLoader(), &ctrl.Log)
+	}
+})
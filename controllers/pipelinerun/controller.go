@@ -0,0 +1,131 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/cache"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/throttle"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Controller reconciles Release PipelineRuns to report their progress in the status of their Release, to detect those
+// whose Release no longer exists and to monitor the usage of their release workspace
+type Controller struct {
+	client   client.Client
+	log      logr.Logger
+	throttle *throttle.Throttle
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseserviceconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=tekton.dev,resources=taskruns,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("PipelineRun", req.NamespacedName)
+
+	pipelineRun := &tektonv1.PipelineRun{}
+	err := c.client.Get(ctx, req.NamespacedName, pipelineRun)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	adapter := newAdapter(ctx, c.client, pipelineRun, loader.NewLoader(), &logger)
+	adapter.throttle = c.throttle
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureReleaseProgressIsUpdated,
+		adapter.EnsureConfigIsLoaded,
+		adapter.EnsureOrphanedPipelineRunIsHandled,
+		adapter.EnsureWorkspaceUsageIsHandled,
+	})
+}
+
+// Register registers the controller with the passed manager and log. This controller only reacts to PipelineRuns
+// created for a Release, and to Release deletions so the PipelineRuns left behind by them are detected right away.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.client = mgr.GetClient()
+	c.log = log.WithName("pipelinerun")
+	c.throttle = throttle.New()
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tektonv1.PipelineRun{}, builder.WithPredicates(predicate.NewPredicateFuncs(isReleasePipelineRun))).
+		Watches(&v1alpha1.Release{}, handler.EnqueueRequestsFromMapFunc(c.getReleasePipelineRuns),
+			builder.WithPredicates(predicate.Funcs{
+				CreateFunc:  func(event.CreateEvent) bool { return false },
+				DeleteFunc:  func(event.DeleteEvent) bool { return true },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+				UpdateFunc:  func(event.UpdateEvent) bool { return false },
+			})).
+		Complete(metrics.NewInstrumentedReconciler("pipelinerun", c))
+}
+
+// getReleasePipelineRuns returns a reconcile request for each PipelineRun created for the given Release.
+func (c *Controller) getReleasePipelineRuns(ctx context.Context, object client.Object) []reconcile.Request {
+	pipelineRuns := &tektonv1.PipelineRunList{}
+	err := c.client.List(ctx, pipelineRuns,
+		client.MatchingFields{"metadata.release": client.ObjectKeyFromObject(object).String()})
+	if err != nil {
+		c.log.Error(err, "Failed to list the PipelineRuns of a deleted Release", "Release.Name", object.GetName(),
+			"Release.Namespace", object.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range pipelineRuns.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pipelineRuns.Items[i])})
+	}
+
+	return requests
+}
+
+// isReleasePipelineRun returns whether the given object is a PipelineRun created for a Release.
+func isReleasePipelineRun(object client.Object) bool {
+	_, found := metadata.GetTrackedRelease(object)
+
+	return found
+}
+
+// SetupCache indexes the PipelineRuns by the Release they were created for, so the PipelineRuns of a deleted Release
+// can be found without listing every PipelineRun.
+func (c *Controller) SetupCache(mgr ctrl.Manager) error {
+	return cache.SetupReleasePipelineRunCache(mgr)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"reflect"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("PipelineRun Controller", Ordered, func() {
+
+	When("Reconcile is called", func() {
+		It("should succeed even if the PipelineRun is not found", func() {
+			controller := &Controller{
+				client: k8sClient,
+				log:    ctrl.Log,
+			}
+
+			req := ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "non-existent",
+					Namespace: "default",
+				},
+			}
+			result, err := controller.Reconcile(ctx, req)
+			Expect(reflect.TypeOf(result)).To(Equal(reflect.TypeOf(reconcile.Result{})))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("getReleasePipelineRuns is called", func() {
+		It("should return a request for each PipelineRun of the Release", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    testNamespace,
+					Labels: map[string]string{
+						metadata.ReleaseNameLabel:      "deleted-release",
+						metadata.ReleaseNamespaceLabel: testNamespace,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			defer func() {
+				_ = k8sClient.Delete(ctx, pipelineRun)
+			}()
+
+			controller := &Controller{
+				client: cachedClient,
+				log:    ctrl.Log,
+			}
+
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deleted-release",
+					Namespace: testNamespace,
+				},
+			}
+			var requests []reconcile.Request
+			Eventually(func() []reconcile.Request {
+				requests = controller.getReleasePipelineRuns(ctx, release)
+				return requests
+			}).Should(HaveLen(1))
+			Expect(requests[0].Name).To(Equal(pipelineRun.Name))
+			Expect(requests[0].Namespace).To(Equal(testNamespace))
+		})
+	})
+
+	When("isReleasePipelineRun is called", func() {
+		It("should return true for PipelineRuns with the Release labels", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						metadata.ReleaseNameLabel:      "release",
+						metadata.ReleaseNamespaceLabel: testNamespace,
+					},
+				},
+			}
+			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
+		})
+
+		It("should return false for PipelineRuns without the Release labels", func() {
+			Expect(isReleasePipelineRun(&tektonv1.PipelineRun{})).To(BeFalse())
+		})
+	})
+
+	When("Register is called", func() {
+		It("should setup the controller successfully", func() {
+			controller := &Controller{
+				client: k8sClient,
+				log:    ctrl.Log,
+			}
+
+			mgr, _ := ctrl.NewManager(cfg, ctrl.Options{
+				Scheme: scheme.Scheme,
+				Metrics: server.Options{
+					BindAddress: "0", // disables metrics
+				},
+				LeaderElection: false,
+			})
+			Expect(controller.Register(mgr, &ctrl.Log, nil)).To(Succeed())
+		})
+	})
+
+})
@@ -17,41 +17,147 @@ limitations under the License.
 package release
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"hash/fnv"
+	"net/http"
 	"os"
+	"reflect"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/conditions"
 	"github.com/konflux-ci/operator-toolkit/controller"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
+	"github.com/konflux-ci/release-service/backoff"
+	"github.com/konflux-ci/release-service/credentials"
+	"github.com/konflux-ci/release-service/encryption"
+	"github.com/konflux-ci/release-service/executor"
+	"github.com/konflux-ci/release-service/health"
 	"github.com/konflux-ci/release-service/loader"
 	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/platforms"
+	"github.com/konflux-ci/release-service/redaction"
+	"github.com/konflux-ci/release-service/support"
 	"github.com/konflux-ci/release-service/syncer"
+	"github.com/konflux-ci/release-service/tekton"
 	"github.com/konflux-ci/release-service/tekton/utils"
+	"github.com/konflux-ci/release-service/throttle"
+	"github.com/konflux-ci/release-service/validators"
+	libhandler "github.com/operator-framework/operator-lib/handler"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	integrationgitops "github.com/redhat-appstudio/integration-service/gitops"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	"knative.dev/pkg/apis"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+const (
+	// dataSecretsWorkspaceName is the name of the managed Pipeline workspace containing the Secret values referenced
+	// in the Release data
+	dataSecretsWorkspaceName = "release-data-secrets"
+
+	// paramsWorkspaceName is the name of the Release Pipeline workspace containing the values of the params that were
+	// too big to be passed inline
+	paramsWorkspaceName = "release-params"
+
+	// registryCredentialsWorkspaceName is the name of the managed Pipeline workspace containing the registry
+	// credentials minted for the Release
+	registryCredentialsWorkspaceName = "release-registry-credentials"
+
+	// dependencyHealthCheckTimeout is the maximum amount of time each dependency health check can take
+	dependencyHealthCheckTimeout = 10 * time.Second
+
+	// maxInlineParamSize is the maximum size of the Release PipelineRun params passed inline. Bigger values are passed
+	// through the params workspace instead
+	maxInlineParamSize = 64 * 1024
+
+	// componentsParamName is the name of the managed Pipeline parameter listing the components the Release releases
+	componentsParamName = "components"
+
+	// imageAnnotationsParamName is the name of the managed Pipeline parameter containing the annotations to set on the
+	// released images
+	imageAnnotationsParamName = "imageAnnotations"
+
+	// releaseNameParamName is the name of the managed Pipeline parameter containing the name of the Release
+	releaseNameParamName = "releaseName"
+
+	// releaseNamespaceParamName is the name of the managed Pipeline parameter containing the namespace of the Release
+	releaseNamespaceParamName = "releaseNamespace"
+
+	// releasePlanAdmissionDefaultsParamName is the name of the managed Pipeline parameter referencing the
+	// ReleasePlanAdmissionDefaults applied to the ReleasePlanAdmission
+	releasePlanAdmissionDefaultsParamName = "releasePlanAdmissionDefaults"
+
+	// releaseURLParamName is the name of the managed Pipeline parameter containing the URL of the Release in the console
+	releaseURLParamName = "releaseURL"
+
+	// tenantNamespaceSuffix is the suffix of the tenant namespaces appended to the name of their workspace
+	tenantNamespaceSuffix = "-tenant"
+
+	// tenantWorkspaceParamName is the name of the managed Pipeline parameter containing the workspace of the Release
+	tenantWorkspaceParamName = "tenantWorkspace"
+
+	// releaseLockPrefix is the prefix of the name of the Leases used as release locks
+	releaseLockPrefix = "release-lock-"
+
+	// skipTasksParamName is the name of the managed Pipeline parameter listing the tasks the Release skips
+	skipTasksParamName = "skipTasks"
+
+	// snapshotReleasesLimit is the maximum number of Releases recorded in the releases annotation of a Snapshot. Older
+	// records are dropped first
+	snapshotReleasesLimit = 20
+)
+
+// snapshotReleaseRecord is the summary of a finished Release recorded in the releases annotation of its Snapshot.
+type snapshotReleaseRecord struct {
+	Name           string                `json:"name"`
+	Target         string                `json:"target,omitempty"`
+	Phase          v1alpha1.ReleasePhase `json:"phase,omitempty"`
+	StartTime      *metav1.Time          `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time          `json:"completionTime,omitempty"`
+}
+
 // adapter holds the objects needed to reconcile a Release.
 type adapter struct {
+	backoff              *backoff.Backoff
+	broker               credentials.Broker
 	client               client.Client
 	ctx                  context.Context
+	decrypter            encryption.Decrypter
+	eventsGetter         support.EventsGetter
 	loader               loader.ObjectLoader
 	logger               *logr.Logger
+	platformsGetter      platforms.Getter
+	podGetter            tekton.PodGetter
+	podLogsGetter        tekton.PodLogsGetter
+	recorder             record.EventRecorder
+	redactor             *redaction.Redactor
 	release              *v1alpha1.Release
 	releaseServiceConfig *v1alpha1.ReleaseServiceConfig
 	syncer               *syncer.Syncer
+	throttle             *throttle.Throttle
 	validations          []controller.ValidationFunction
 }
 
@@ -71,6 +177,15 @@ func newAdapter(ctx context.Context, client client.Client, release *v1alpha1.Rel
 		releaseAdapter.validateProcessingResources,
 		releaseAdapter.validateAuthor,
 		releaseAdapter.validatePipelineSource,
+		releaseAdapter.validateSnapshotAge,
+		releaseAdapter.validatePinnedImages,
+		releaseAdapter.validateSkippedTasks,
+		releaseAdapter.validateHold,
+	}
+
+	// Validators compiled in by downstream distributions run after the built-in ones
+	for _, validator := range validators.GetRegistered() {
+		releaseAdapter.validations = append(releaseAdapter.validations, releaseAdapter.getCustomValidation(validator))
 	}
 
 	return releaseAdapter
@@ -100,6 +215,45 @@ func (a *adapter) EnsureConfigIsLoaded() (controller.OperationResult, error) {
 	return controller.ContinueProcessing()
 }
 
+// EnsureDeletedRegistryCredentialsAreRevoked is an operation that will ensure that the registry credentials minted for
+// the Release are revoked when the Secret holding them is deleted. These Secrets are owned by the managed PipelineRun,
+// so they are deleted along with it when it's retried or removed, and their finalizer keeps them until then.
+func (a *adapter) EnsureDeletedRegistryCredentialsAreRevoked() (controller.OperationResult, error) {
+	return controller.RequeueOnErrorOrContinue(a.revokeReleaseRegistryCredentials(true))
+}
+
+// EnsureRedactionIsConfigured is an operation that will ensure that the values of the Release data sourced from
+// Secrets or marked as sensitive are redacted from the logs, events, support bundles and captured logs of the Release.
+// This operation sets the redactor in the adapter and wraps its logger and recorder to be used in other operations.
+// Secrets that can't be read are ignored, as the Release fails when its data is resolved anyway.
+func (a *adapter) EnsureRedactionIsConfigured() (controller.OperationResult, error) {
+	values, err := a.release.GetDataSensitiveValues()
+	if err != nil {
+		return controller.ContinueProcessing()
+	}
+
+	secretKeyRefs, err := a.release.GetDataSecretKeyRefs()
+	if err == nil {
+		for _, secretKeyRef := range secretKeyRefs {
+			secret, err := a.loader.GetSecret(a.ctx, a.client, secretKeyRef.Name, a.release.Namespace)
+			if err == nil {
+				values = append(values, string(secret.Data[secretKeyRef.Key]))
+			}
+		}
+	}
+
+	if len(values) == 0 {
+		return controller.ContinueProcessing()
+	}
+
+	a.redactor = redaction.NewRedactor(values...)
+	logger := redaction.NewLogger(*a.logger, a.redactor)
+	a.logger = &logger
+	a.recorder = redaction.NewEventRecorder(a.recorder, a.redactor)
+
+	return controller.ContinueProcessing()
+}
+
 // EnsureFinalizersAreCalled is an operation that will ensure that finalizers are called whenever the Release being
 // processed is marked for deletion. Once finalizers get called, the finalizer will be removed and the Release will go
 // back to the queue, so it gets deleted. If a finalizer function fails its execution or a finalizer fails to be removed,
@@ -128,6 +282,29 @@ func (a *adapter) EnsureFinalizersAreCalled() (controller.OperationResult, error
 	return controller.Requeue()
 }
 
+// EnsureDeprecatedFieldsAreReported is an operation that will ensure that the DeprecatedFieldsUsed condition of the
+// Release reports the deprecated fields it sets, so they are migrated before the v1beta1 cutover. The reported fields
+// are also registered in the metrics.
+func (a *adapter) EnsureDeprecatedFieldsAreReported() (controller.OperationResult, error) {
+	fields := a.release.GetDeprecatedFields()
+	if a.release.HasDeprecatedFieldsReported(fields) {
+		return controller.ContinueProcessing()
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.MarkDeprecatedFieldsUsed(fields)
+	err := a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	for _, field := range fields {
+		metrics.RegisterDeprecatedFieldUsed("Release", field.Field)
+	}
+
+	return controller.ContinueProcessing()
+}
+
 // EnsureFinalizerIsAdded is an operation that will ensure that the Release being processed contains a finalizer.
 func (a *adapter) EnsureFinalizerIsAdded() (controller.OperationResult, error) {
 	var finalizerFound bool
@@ -162,15 +339,38 @@ func (a *adapter) EnsureReleaseIsCompleted() (controller.OperationResult, error)
 		return controller.ContinueProcessing()
 	}
 
+	// The released artifacts have to be verified for a Release to be completed
+	if !a.release.HasVerificationFinished() {
+		return controller.ContinueProcessing()
+	}
+
 	patch := client.MergeFrom(a.release.DeepCopy())
 	a.release.MarkReleased()
 	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
 }
 
+// EnsureReleaseIsReadyForManagedProcessing is an operation that will ensure that a Release was validated and its
+// tenant pipeline processing finished before processing its managed pipeline. It is used when the tenant processing is
+// handled by a different instance of the controller, so no other operation after this one will be executed until
+// the Release status reflects that the tenant processing finished. Releases marked for deletion are also ignored, as
+// finalizing them is the responsibility of the tenant instance.
+func (a *adapter) EnsureReleaseIsReadyForManagedProcessing() (controller.OperationResult, error) {
+	if a.release.GetDeletionTimestamp() != nil || a.release.HasReleaseFinished() {
+		return controller.StopProcessing()
+	}
+
+	if !a.release.IsValid() || !a.release.HasTenantPipelineProcessingFinished() {
+		return controller.StopProcessing()
+	}
+
+	return controller.ContinueProcessing()
+}
+
 // EnsureReleaseIsRunning is an operation that will ensure that a Release has not finished already and that
-// it is marked as releasing. If the Release has finished, no other operation after this one will be executed.
+// it is marked as releasing. If the Release has finished or is a historical Release imported from a backup, no other
+// operation after this one will be executed.
 func (a *adapter) EnsureReleaseIsRunning() (controller.OperationResult, error) {
-	if a.release.HasReleaseFinished() {
+	if a.release.HasReleaseFinished() || a.release.GetLabels()[metadata.HistoricalLabel] == "true" {
 		return controller.StopProcessing()
 	}
 
@@ -216,6 +416,23 @@ func (a *adapter) EnsureTenantPipelineIsProcessed() (controller.OperationResult,
 
 			pipelineRun, err = a.createTenantPipelineRun(releasePlan, snapshot)
 			if err != nil {
+				if isPipelineNotMirroredError(err) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkTenantPipelineProcessing()
+					a.release.MarkTenantPipelineProcessingFailedWithReason(reasons.PipelineResolutionFailed, err.Error())
+					a.release.MarkManagedPipelineProcessingSkipped()
+					a.release.MarkReleaseFailed("Release processing failed resolving the tenant Pipeline")
+					return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+				}
+				if isQuotaExceededError(err) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkTenantPipelineProcessing()
+					a.release.MarkTenantPipelineProcessingFailedWithReason(reasons.QuotaExceeded,
+						fmt.Sprintf("failed to create the tenant Release PipelineRun: %s", err))
+					a.release.MarkManagedPipelineProcessingSkipped()
+					a.release.MarkReleaseFailed("Release processing failed creating the tenant pipelineRun")
+					return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+				}
 				return controller.RequeueWithError(err)
 			}
 
@@ -229,10 +446,123 @@ func (a *adapter) EnsureTenantPipelineIsProcessed() (controller.OperationResult,
 	return controller.ContinueProcessing()
 }
 
+// EnsureFanOutPipelinesAreProcessed is an operation that will ensure that, when the ReleasePlan of the Release sets a
+// fan-out policy and more than one active ReleasePlanAdmission matches it, a managed Release PipelineRun exists for each
+// of them. The status of each target is registered in the Release, so targets whose PipelineRun can't be created are
+// marked as failed without blocking the others. Each target stays pending until the gates of its own
+// ReleasePlanAdmission pass. Releases with a single target are left to the managed processing.
+func (a *adapter) EnsureFanOutPipelinesAreProcessed() (controller.OperationResult, error) {
+	if a.release.HasManagedPipelineProcessingFinished() || !a.release.HasTenantPipelineProcessingFinished() ||
+		(a.release.IsManagedPipelineProcessing() && !a.release.IsFanOut()) {
+		return controller.ContinueProcessing()
+	}
+
+	resources, err := a.loader.GetProcessingResources(a.ctx, a.client, a.release)
+	if err != nil {
+		if strings.Contains(err.Error(), "no ReleasePlanAdmissions can be found") {
+			return controller.ContinueProcessing()
+		}
+		return controller.RequeueWithError(err)
+	}
+
+	if resources.ReleasePlan.Spec.FanOutPolicy == "" {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlanAdmissions, err := a.getFanOutReleasePlanAdmissions(resources.ReleasePlan)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+	if len(releasePlanAdmissions) < 2 && !a.release.IsFanOut() {
+		return controller.ContinueProcessing()
+	}
+
+	if !a.release.IsFanOut() {
+		acquired, err := a.acquireReleaseLock(resources.ReleasePlan.Spec.Application,
+			resources.ReleasePlanAdmission.Namespace, resources.ReleasePlanAdmission.Spec.Preemption)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+		if !acquired {
+			a.logger.Info("Waiting for another Release of the same application to the same target to finish")
+			if !a.release.IsQueued() {
+				patch := client.MergeFrom(a.release.DeepCopy())
+				a.release.MarkQueued("waiting for another Release of the same application to the same target to finish")
+				err = a.client.Status().Patch(a.ctx, a.release, patch)
+				if err != nil {
+					return controller.RequeueWithError(err)
+				}
+			}
+			return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+		}
+	}
+
+	// Targets wait for the shortest of their delays, computing the backoff delay once, as each call counts as an attempt
+	var delay, backoffDelay time.Duration
+	for i := range releasePlanAdmissions {
+		releasePlanAdmission := &releasePlanAdmissions[i]
+		name := fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name)
+
+		// Each target is registered as pending before anything is created for it. The optimistic lock prevents two
+		// reconciles working on a stale Release from registering and processing the same target twice
+		if a.release.GetTarget(name) == nil {
+			patch := client.MergeFromWithOptions(a.release.DeepCopy(), client.MergeFromWithOptimisticLock{})
+			a.release.Status.Targets = append(a.release.Status.Targets, v1alpha1.TargetInfo{
+				Phase:                v1alpha1.TargetPhasePending,
+				ReleasePlanAdmission: name,
+			})
+			a.release.MarkDequeued()
+			a.release.MarkManagedPipelineProcessing()
+			err = a.client.Status().Patch(a.ctx, a.release, patch)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+		}
+
+		target := a.release.GetTarget(name)
+		if target.Phase != v1alpha1.TargetPhasePending {
+			continue
+		}
+
+		patch := client.MergeFromWithOptions(a.release.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		previousTarget := target.DeepCopy()
+		targetDelay, err := a.processFanOutTarget(resources, releasePlanAdmission, target)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+
+		if target.Phase == v1alpha1.TargetPhasePending {
+			if targetDelay == 0 {
+				if backoffDelay == 0 {
+					backoffDelay = a.getBackoffDelay(backoff.DependencyCategory)
+				}
+				targetDelay = backoffDelay
+			}
+			if delay == 0 || targetDelay < delay {
+				delay = targetDelay
+			}
+		}
+
+		if !reflect.DeepEqual(previousTarget, target) {
+			err = a.client.Status().Patch(a.ctx, a.release, patch)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+		}
+	}
+
+	if delay > 0 {
+		return controller.RequeueAfter(delay, nil)
+	}
+
+	return controller.ContinueProcessing()
+}
+
 // EnsureManagedPipelineIsProcessed is an operation that will ensure that a managed Release PipelineRun associated to the Release
 // being processed and a RoleBinding to grant its serviceAccount permissions exist. Otherwise, it will create them.
 func (a *adapter) EnsureManagedPipelineIsProcessed() (controller.OperationResult, error) {
-	if a.release.HasManagedPipelineProcessingFinished() || !a.release.HasTenantPipelineProcessingFinished() {
+	if a.release.HasManagedPipelineProcessingFinished() || !a.release.HasTenantPipelineProcessingFinished() ||
+		a.release.IsFanOut() {
 		return controller.ContinueProcessing()
 	}
 
@@ -259,15 +589,109 @@ func (a *adapter) EnsureManagedPipelineIsProcessed() (controller.OperationResult
 		}
 
 		if pipelineRun == nil {
-			if resources.ReleasePlanAdmission.Spec.Pipeline == nil {
+			pipeline, err := resources.ReleasePlanAdmission.GetPipeline(a.release.Spec.Strategy)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+
+			if pipeline == nil {
 				// no managed pipeline to run
 				patch := client.MergeFrom(a.release.DeepCopy())
 				a.release.MarkManagedPipelineProcessingSkipped()
 				return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
 			}
 
+			executionNamespace, err := resources.ReleasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+			if err != nil {
+				patch := client.MergeFrom(a.release.DeepCopy())
+				a.release.MarkManagedPipelineProcessing()
+				a.release.MarkManagedPipelineProcessingFailed(err.Error())
+				a.release.MarkReleaseFailed("Release processing failed resolving the managed pipeline namespace")
+				return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+			}
+
+			waitStartTime := a.release.GetManagedPipelineWaitStartTime()
+			if waitStartTime != nil && a.isPastDeadline(waitStartTime.Time, a.getDeadlines().Queue) {
+				patch := client.MergeFrom(a.release.DeepCopy())
+				a.release.MarkManagedPipelineProcessing()
+				a.release.MarkManagedPipelineProcessingFailedWithReason(reasons.QueueTimeout,
+					"the managed pipeline didn't start before the queue deadline")
+				a.release.MarkReleaseFailed("Release processing failed waiting for the managed pipeline to start")
+				return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+			}
+
+			acquired, err := a.acquireReleaseLock(resources.ReleasePlan.Spec.Application,
+				resources.ReleasePlanAdmission.Namespace, resources.ReleasePlanAdmission.Spec.Preemption)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+			if !acquired {
+				a.logger.Info("Waiting for another Release of the same application to the same target to finish")
+				if !a.release.IsQueued() {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkQueued("waiting for another Release of the same application to the same target to finish")
+					err = a.client.Status().Patch(a.ctx, a.release, patch)
+					if err != nil {
+						return controller.RequeueWithError(err)
+					}
+				}
+				return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+			}
+
+			scheduled, err := a.isManagedPipelineScheduled(resources.ReleasePlanAdmission.Namespace)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+			if !scheduled {
+				a.logger.Info("Waiting for capacity to run the managed pipeline in the target namespace")
+				if !a.release.IsAwaitingCapacity() {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkAwaitingCapacity("waiting for capacity to run the managed pipeline in the target namespace")
+					err = a.client.Status().Patch(a.ctx, a.release, patch)
+					if err != nil {
+						return controller.RequeueWithError(err)
+					}
+				}
+				return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+			}
+
+			shortage, err := a.getCapacityShortage(executionNamespace, pipeline.ResourceRequests)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+			if shortage != "" {
+				a.logger.Info("Waiting for the target namespace to have room for the managed pipeline", "Shortage", shortage)
+				if !a.release.HasInsufficientCapacity() {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkInsufficientCapacity(shortage)
+					err = a.client.Status().Patch(a.ctx, a.release, patch)
+					if err != nil {
+						return controller.RequeueWithError(err)
+					}
+				}
+				return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+			}
+
+			healthy, err := a.checkDependencies()
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+			if !healthy {
+				a.logger.Info("Waiting for the services the managed pipeline depends on to be healthy")
+				return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+			}
+			a.backoff.Reset(backoff.DependencyCategory, client.ObjectKeyFromObject(a.release).String())
+
+			if resources.ReleasePlanAdmission.Spec.CreateExecutionNamespace &&
+				executionNamespace != resources.ReleasePlanAdmission.Namespace {
+				err = a.ensureNamespaceExists(executionNamespace)
+				if err != nil {
+					return controller.RequeueWithError(err)
+				}
+			}
+
 			// Only create a RoleBinding if a ServiceAccount is specified
-			if roleBinding == nil && resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName != "" {
+			if roleBinding == nil && pipeline.ServiceAccountName != "" {
 				// This string should probably be a constant somewhere
 				roleBinding, err = a.createRoleBindingForClusterRole("release-pipeline-resource-role", resources.ReleasePlanAdmission)
 				if err != nil {
@@ -275,7 +699,63 @@ func (a *adapter) EnsureManagedPipelineIsProcessed() (controller.OperationResult
 				}
 			}
 
-			pipelineRun, err = a.createManagedPipelineRun(resources)
+			dataSecret, err := a.createDataSecret(executionNamespace)
+			if err != nil {
+				if errors.IsNotFound(err) || strings.Contains(err.Error(), "does not contain the key") ||
+					strings.Contains(err.Error(), "no KMS key is configured") {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkManagedPipelineProcessing()
+					a.release.MarkManagedPipelineProcessingFailed(
+						fmt.Sprintf("failed to resolve the Secrets and encrypted values in the Release data: %s", err))
+					a.release.MarkReleaseFailed("Release processing failed resolving the Release data")
+					return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+				}
+				return controller.RequeueWithError(err)
+			}
+
+			credentialsSecret, err := a.createRegistryCredentialsSecret(executionNamespace, resources.ReleasePlanAdmission)
+			if err != nil {
+				if dataSecret != nil {
+					_ = a.client.Delete(a.ctx, dataSecret)
+				}
+				if errors.IsNotFound(err) || stderrors.Is(err, credentials.ErrNoBroker) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkManagedPipelineProcessing()
+					a.release.MarkManagedPipelineProcessingFailed(
+						fmt.Sprintf("failed to issue the registry credentials: %s", err))
+					a.release.MarkReleaseFailed("Release processing failed issuing the registry credentials")
+					return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+				}
+				return controller.RequeueWithError(err)
+			}
+
+			pipelineRun, err = a.createManagedPipelineRun(resources, dataSecret, credentialsSecret)
+			if err != nil {
+				if dataSecret != nil {
+					_ = a.client.Delete(a.ctx, dataSecret)
+				}
+				if credentialsSecret != nil {
+					_ = a.revokeRegistryCredentials(credentialsSecret)
+				}
+				if isPipelineNotMirroredError(err) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkManagedPipelineProcessing()
+					a.release.MarkManagedPipelineProcessingFailedWithReason(reasons.PipelineResolutionFailed, err.Error())
+					a.release.MarkReleaseFailed("Release processing failed resolving the managed Pipeline")
+					return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+				}
+				if isQuotaExceededError(err) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkManagedPipelineProcessing()
+					a.release.MarkManagedPipelineProcessingFailedWithReason(reasons.QuotaExceeded,
+						fmt.Sprintf("failed to create the managed Release PipelineRun: %s", err))
+					a.release.MarkReleaseFailed("Release processing failed creating the managed pipelineRun")
+					return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+				}
+				return controller.RequeueWithError(err)
+			}
+
+			err = a.setSecretsOwner(pipelineRun, dataSecret, credentialsSecret)
 			if err != nil {
 				return controller.RequeueWithError(err)
 			}
@@ -310,365 +790,3309 @@ func (a *adapter) EnsureReleaseExpirationTimeIsAdded() (controller.OperationResu
 	return controller.ContinueProcessing()
 }
 
-// EnsureReleaseIsValid is an operation that will ensure that a Release is valid by performing all
-// validation checks.
-func (a *adapter) EnsureReleaseIsValid() (controller.OperationResult, error) {
-	patch := client.MergeFrom(a.release.DeepCopy())
+// EnsureReleaseProvenanceIsRecorded is an operation that ensures that the provenance annotations of the released
+// Snapshot (e.g. the commit it was built from) are recorded in the Release status, so they remain available once the
+// Snapshot is gone.
+func (a *adapter) EnsureReleaseProvenanceIsRecorded() (controller.OperationResult, error) {
+	if a.release.Status.Provenance != nil {
+		return controller.ContinueProcessing()
+	}
 
-	result := controller.Validate(a.validations...)
-	if !result.Valid {
-		if result.Err != nil {
-			return controller.RequeueWithError(result.Err)
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.ContinueProcessing()
 		}
-		a.release.MarkReleaseFailed("Release validation failed")
+		return controller.RequeueWithError(err)
 	}
 
-	// IsReleasing will be false if MarkReleaseFailed was called
-	if a.release.IsReleasing() {
-		a.release.MarkValidated()
-		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	provenance := a.getProvenanceAnnotations(snapshot)
+	if len(provenance) == 0 {
+		return controller.ContinueProcessing()
 	}
 
-	return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.Status.Provenance = provenance
+
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
 }
 
-// EnsureTenantPipelineProcessingIsTracked is an operation that will ensure that the Release Tenant PipelineRun status
-// is tracked in the Release being processed.
-func (a *adapter) EnsureTenantPipelineProcessingIsTracked() (controller.OperationResult, error) {
-	if !a.release.IsTenantPipelineProcessing() || a.release.HasTenantPipelineProcessingFinished() {
+// EnsureReleaseDependencyIsMet is an operation that will ensure that the Release the Release being processed depends on
+// finished successfully before any pipeline is processed. If the dependency failed or doesn't exist, the Release will be
+// marked as failed. If the Release propagates the results of its dependency, the artifacts of the dependency will be
+// added to the Release data, with the values already present in the data taking precedence.
+func (a *adapter) EnsureReleaseDependencyIsMet() (controller.OperationResult, error) {
+	dependsOn := a.release.Spec.DependsOn
+	if dependsOn == nil || a.release.IsTenantPipelineProcessing() || a.release.HasTenantPipelineProcessingFinished() {
 		return controller.ContinueProcessing()
 	}
 
-	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.TenantPipelineType)
+	dependency, err := a.loader.GetRelease(a.ctx, a.client, dependsOn.Release, a.release.Namespace)
 	if err != nil {
+		if errors.IsNotFound(err) {
+			patch := client.MergeFrom(a.release.DeepCopy())
+			a.release.MarkReleaseFailed(fmt.Sprintf("the Release this Release depends on (%s) was not found", dependsOn.Release))
+			return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+		}
+
 		return controller.RequeueWithError(err)
 	}
-	if pipelineRun != nil {
-		err = a.registerTenantProcessingStatus(pipelineRun)
-		if err != nil {
-			return controller.RequeueWithError(err)
-		}
+
+	if !dependency.HasReleaseFinished() {
+		a.logger.Info("Waiting for the Release this Release depends on to finish", "Release.Name", dependency.Name)
+		return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
 	}
+	a.backoff.Reset(backoff.DependencyCategory, client.ObjectKeyFromObject(a.release).String())
 
-	return controller.ContinueProcessing()
-}
+	if !dependency.IsReleased() {
+		patch := client.MergeFrom(a.release.DeepCopy())
+		a.release.MarkReleaseFailed(fmt.Sprintf("the Release this Release depends on (%s) failed", dependsOn.Release))
+		return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
 
-// EnsureManagedPipelineProcessingIsTracked is an operation that will ensure that the Release Managed PipelineRun status
-// is tracked in the Release being processed.
-func (a *adapter) EnsureManagedPipelineProcessingIsTracked() (controller.OperationResult, error) {
-	if !a.release.IsManagedPipelineProcessing() || a.release.HasManagedPipelineProcessingFinished() {
+	if !dependsOn.PropagateResults || dependency.Status.Artifacts == nil {
 		return controller.ContinueProcessing()
 	}
 
-	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.ManagedPipelineType)
+	data, err := getDataWithPropagatedResults(a.release.Spec.Data, dependency.Status.Artifacts)
 	if err != nil {
-		return controller.RequeueWithError(err)
+		patch := client.MergeFrom(a.release.DeepCopy())
+		a.release.MarkReleaseFailed(fmt.Sprintf("failed to propagate the results of Release %s: %s", dependsOn.Release, err))
+		return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
 	}
-	if pipelineRun != nil {
-		err = a.registerManagedProcessingStatus(pipelineRun)
-		if err != nil {
-			return controller.RequeueWithError(err)
-		}
+
+	if a.release.Spec.Data != nil && bytes.Equal(data.Raw, a.release.Spec.Data.Raw) {
+		return controller.ContinueProcessing()
 	}
 
-	return controller.ContinueProcessing()
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.Spec.Data = data
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, a.release, patch))
 }
 
-// EnsureReleaseProcessingResourcesAreCleanedUp is an operation that will ensure that the resources created for the Release
-// Processing step are cleaned up once processing is finished. This exists in conjunction with EnsureFinalizersAreCalled because
-// the finalizers should be removed from the pipelineRuns even if the Release is not marked for deletion for quota reasons.
-func (a *adapter) EnsureReleaseProcessingResourcesAreCleanedUp() (controller.OperationResult, error) {
-	if !a.release.HasTenantPipelineProcessingFinished() || !a.release.HasManagedPipelineProcessingFinished() {
+// EnsureSupersededReleaseIsSkipped is an operation that will ensure that an automated Release that didn't start its
+// pipelines yet is skipped if a newer automated Release of the same ReleasePlan releases the same components. This
+// way, when many Snapshots pass their tests at once, only the latest one of each set of components is released and the
+// load of the managed pipelines stays proportional to their useful output.
+func (a *adapter) EnsureSupersededReleaseIsSkipped() (controller.OperationResult, error) {
+	if a.release.Labels[metadata.AutomatedLabel] != "true" || !a.release.IsReleasing() ||
+		a.release.IsTenantPipelineProcessing() || a.release.IsManagedPipelineProcessing() ||
+		a.release.HasManagedPipelineProcessingFinished() {
 		return controller.ContinueProcessing()
 	}
 
-	return controller.RequeueOnErrorOrContinue(a.finalizeRelease(false))
-}
-
-// cleanupProcessingResources removes the finalizer from the PipelineRun created for the Release Processing
-// and removes the roleBinding that was created in order for the PipelineRun to succeed.
-func (a *adapter) cleanupProcessingResources(pipelineRun *tektonv1.PipelineRun, roleBinding *rbac.RoleBinding) error {
-	if roleBinding != nil {
-		err := a.client.Delete(a.ctx, roleBinding)
-		if err != nil {
-			return err
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.ContinueProcessing()
 		}
+
+		return controller.RequeueWithError(err)
 	}
 
-	if pipelineRun != nil {
-		if controllerutil.ContainsFinalizer(pipelineRun, metadata.ReleaseFinalizer) {
-			patch := client.MergeFrom(pipelineRun.DeepCopy())
-			removedFinalizer := controllerutil.RemoveFinalizer(pipelineRun, metadata.ReleaseFinalizer)
-			if !removedFinalizer {
-				return fmt.Errorf("finalizer not removed")
+	releases, err := a.loader.GetReleases(a.ctx, a.client, a.release.Namespace)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	components := getReleasedComponents(a.release, snapshot)
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Name == a.release.Name || release.Spec.ReleasePlan != a.release.Spec.ReleasePlan ||
+			release.Labels[metadata.AutomatedLabel] != "true" || !a.release.CreationTimestamp.Before(&release.CreationTimestamp) ||
+			(release.HasReleaseFinished() && !release.IsReleased()) {
+			continue
+		}
+
+		releaseSnapshot, err := a.loader.GetSnapshot(a.ctx, a.client, release)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
 			}
-			err := a.client.Patch(a.ctx, pipelineRun, patch)
+
+			return controller.RequeueWithError(err)
+		}
+
+		if slices.Equal(components, getReleasedComponents(release, releaseSnapshot)) {
+			return a.skipSupersededRelease(release)
+		}
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureSnapshotTestsHavePassed is an operation that will ensure that the integration tests of the Release Snapshot
+// passed before any pipeline is processed if the ReleasePlan requires it. While the Snapshot has no test results, the
+// Release will be marked as awaiting them and no other operation will be executed. The Release will be reconciled
+// again once the Snapshot test results change. If the tests failed, the Release will be marked as failed.
+func (a *adapter) EnsureSnapshotTestsHavePassed() (controller.OperationResult, error) {
+	if a.release.IsSnapshotTested() || a.release.IsTenantPipelineProcessing() || a.release.HasTenantPipelineProcessingFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	if !releasePlan.Spec.RequirePassingTests {
+		return controller.ContinueProcessing()
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	condition := meta.FindStatusCondition(snapshot.Status.Conditions, v1alpha1.SnapshotTestSucceededConditionType)
+	if (condition == nil || condition.Status != metav1.ConditionTrue) && a.release.IsEmergencyBypassActive() {
+		a.release.MarkSnapshotTestsBypassed(a.recordEmergencyBypass("integration tests"))
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
+
+	switch {
+	case condition == nil || condition.Status == metav1.ConditionUnknown:
+		if a.release.IsAwaitingTestResults() {
+			return controller.StopProcessing()
+		}
+
+		a.logger.Info("Waiting for the Snapshot integration tests to pass", "Snapshot.Name", snapshot.Name)
+		a.release.MarkAwaitingTestResults()
+		return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+	case condition.Status == metav1.ConditionTrue:
+		a.release.MarkSnapshotTested()
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	default:
+		a.release.MarkSnapshotTestsFailed(fmt.Sprintf("the integration tests of Snapshot %s failed", snapshot.Name))
+		a.release.MarkReleaseFailed("Snapshot integration tests failed")
+		return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
+}
+
+// EnsureSnapshotReleasableTimeIsRecorded is an operation that will ensure that the time when the released Snapshot
+// became releasable is recorded in the Release status, so the latency between the Snapshot integration tests passing
+// and the Release can be observed. Snapshots not reporting their integration tests are considered releasable once
+// created, while Snapshots whose tests didn't pass yet are checked again in later reconciles.
+func (a *adapter) EnsureSnapshotReleasableTimeIsRecorded() (controller.OperationResult, error) {
+	if a.release.HasSnapshotReleasableTime() || a.release.HasReleaseFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	releasableTime := snapshot.CreationTimestamp.Time
+	condition := meta.FindStatusCondition(snapshot.Status.Conditions, v1alpha1.SnapshotTestSucceededConditionType)
+	if condition != nil {
+		if condition.Status != metav1.ConditionTrue {
+			return controller.ContinueProcessing()
+		}
+		releasableTime = condition.LastTransitionTime.Time
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.SetSnapshotReleasableTime(releasableTime)
+
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+}
+
+// EnsureReleaseIsApproved is an operation that will ensure that a Release whose ReleasePlanAdmission requires a
+// two-person review is approved by a user other than its author before running the managed Pipeline. The approver is
+// stamped by the author webhook and compared with the author again, so self-approvals are rejected even if the
+// webhook is disabled. Releases wait until they are approved.
+func (a *adapter) EnsureReleaseIsApproved() (controller.OperationResult, error) {
+	if a.release.IsApproved() || a.release.IsTenantPipelineProcessing() ||
+		a.release.IsManagedPipelineProcessing() || a.release.HasManagedPipelineProcessingFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.ContinueProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	if !releasePlanAdmission.Spec.RequireTwoPersonReview {
+		return controller.ContinueProcessing()
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	approver := a.release.GetAnnotations()[metadata.ApprovedByAnnotation]
+	if approver != "" && approver != a.release.Status.Attribution.Author {
+		a.release.MarkApproved(approver)
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
+
+	message := fmt.Sprintf("a user other than the author has to approve the Release setting the %s annotation to true",
+		metadata.ApprovedAnnotation)
+	if approver != "" {
+		message = fmt.Sprintf("the Release can't be approved by its author %s", approver)
+	}
+
+	a.logger.Info("Waiting for the Release to be approved by a second reviewer")
+	a.release.MarkAwaitingApproval(message)
+	return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+}
+
+// EnsureReleaseWindowIsOpen is an operation that will ensure that a Release only starts within the release windows
+// defined in the ReleaseSchedule of its ReleasePlanAdmission. Releases created outside of the windows are queued until
+// the next window opens, setting the expected start time in their status.
+func (a *adapter) EnsureReleaseWindowIsOpen() (controller.OperationResult, error) {
+	if a.release.IsInReleaseWindow() || a.release.IsTenantPipelineProcessing() ||
+		a.release.IsManagedPipelineProcessing() || a.release.HasManagedPipelineProcessingFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.ContinueProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	schedule := releasePlanAdmission.Spec.ReleaseSchedule
+	if schedule == nil {
+		return controller.ContinueProcessing()
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	now := time.Now()
+	nextOpening, err := schedule.GetNextOpening(now)
+	if err != nil {
+		a.release.MarkReleaseFailed(fmt.Sprintf("invalid release schedule: %s", err))
+		return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
+
+	if nextOpening.After(now) && a.release.IsEmergencyBypassActive() {
+		a.release.MarkReleaseWindowBypassed(a.recordEmergencyBypass("release window"))
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
+
+	if nextOpening.After(now) {
+		if !a.release.IsAwaitingReleaseWindow() || !a.release.Status.ScheduledTime.Equal(&metav1.Time{Time: nextOpening}) {
+			a.logger.Info("Queuing the Release until the next release window opens", "ScheduledTime", nextOpening)
+			a.release.MarkAwaitingReleaseWindow(nextOpening)
+			if err := a.client.Status().Patch(a.ctx, a.release, patch); err != nil {
+				return controller.RequeueWithError(err)
+			}
+		}
+
+		return controller.RequeueAfter(time.Until(nextOpening), nil)
+	}
+
+	a.release.MarkInReleaseWindow()
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+}
+
+// EnsureReleaseIsValid is an operation that will ensure that a Release is valid by performing all
+// validation checks.
+func (a *adapter) EnsureReleaseIsValid() (controller.OperationResult, error) {
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	result := controller.Validate(a.validations...)
+	if !result.Valid {
+		if result.Err != nil {
+			if !a.isPastDeadline(a.release.CreationTimestamp.Time, a.getDeadlines().Validation) {
+				return controller.RequeueWithError(result.Err)
+			}
+			a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+				Message: fmt.Sprintf("the Release couldn't be validated before the validation deadline: %v", result.Err),
+				Reason:  metav1.CauseType(reasons.ValidationTimeout),
+			})
+		}
+		a.release.MarkReleaseFailed("Release validation failed")
+	}
+
+	// IsReleasing will be false if MarkReleaseFailed was called
+	if a.release.IsReleasing() {
+		a.release.MarkValidated()
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
+
+	return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+}
+
+// EnsureTenantPipelineProcessingIsTracked is an operation that will ensure that the Release Tenant PipelineRun status
+// is tracked in the Release being processed.
+func (a *adapter) EnsureTenantPipelineProcessingIsTracked() (controller.OperationResult, error) {
+	if !a.release.IsTenantPipelineProcessing() || a.release.HasTenantPipelineProcessingFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.TenantPipelineType)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+	if pipelineRun != nil {
+		err = a.registerTenantProcessingStatus(pipelineRun)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+
+		return a.ensurePipelineRunIsNotStalled(pipelineRun, &a.release.Status.TenantProcessing)
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureFanOutPipelinesProcessingIsTracked is an operation that will ensure that the status of the managed Release
+// PipelineRun of each target of a fan-out Release is tracked in the Release being processed. Once all of them finish,
+// the managed processing is marked as succeeded or failed according to the fan-out policy of the ReleasePlan.
+func (a *adapter) EnsureFanOutPipelinesProcessingIsTracked() (controller.OperationResult, error) {
+	if !a.release.IsFanOut() || !a.release.IsManagedPipelineProcessing() ||
+		a.release.HasManagedPipelineProcessingFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	for i := range a.release.Status.Targets {
+		target := &a.release.Status.Targets[i]
+		if target.Phase != v1alpha1.TargetPhaseProgressing {
+			continue
+		}
+
+		pipelineRun, _, err := a.getTargetResources(target)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+		a.registerTargetStatus(target, pipelineRun)
+	}
+
+	if a.release.HasEveryTargetFinished() {
+		succeeded, message := a.release.GetTargetsOutcome(releasePlan.Spec.FanOutPolicy)
+		if succeeded {
+			a.release.MarkManagedPipelineProcessed()
+		} else {
+			a.release.MarkManagedPipelineProcessingFailed(message)
+			a.release.MarkReleaseFailed("Release processing failed on managed pipelineRuns")
+		}
+	}
+
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+}
+
+// EnsureManagedPipelineProcessingIsTracked is an operation that will ensure that the Release Managed PipelineRun status
+// is tracked in the Release being processed.
+func (a *adapter) EnsureManagedPipelineProcessingIsTracked() (controller.OperationResult, error) {
+	if !a.release.IsManagedPipelineProcessing() || a.release.HasManagedPipelineProcessingFinished() ||
+		a.release.IsFanOut() {
+		return controller.ContinueProcessing()
+	}
+
+	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.ManagedPipelineType)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+	if pipelineRun != nil {
+		if pipelineRun.IsDone() && !pipelineRun.IsCancelled() &&
+			!pipelineRun.Status.GetCondition(apis.ConditionSucceeded).IsTrue() {
+			retried, err := a.retryPipelineRunOnNodeFailure(pipelineRun, &a.release.Status.ManagedProcessing)
 			if err != nil {
-				return err
+				return controller.RequeueWithError(err)
+			}
+			if retried {
+				return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+			}
+		}
+
+		err = a.registerManagedProcessingStatus(pipelineRun)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+
+		if !pipelineRun.IsDone() && !pipelineRun.IsCancelled() && a.release.Status.ManagedProcessing.StartTime != nil &&
+			a.isPastDeadline(a.release.Status.ManagedProcessing.StartTime.Time, a.getDeadlines().Execution) {
+			return a.cancelManagedPipelineRunPastDeadline(pipelineRun)
+		}
+
+		return a.ensurePipelineRunIsNotStalled(pipelineRun, &a.release.Status.ManagedProcessing)
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureVerificationPipelineIsProcessed is an operation that will ensure that, once the managed pipeline succeeds, a
+// verification Release PipelineRun checking the released artifacts exists if the ReleasePlanAdmission defines a
+// verification Pipeline. Otherwise, the verification is marked as skipped.
+func (a *adapter) EnsureVerificationPipelineIsProcessed() (controller.OperationResult, error) {
+	if a.release.HasReleaseFinished() || a.release.HasVerificationFinished() || a.release.IsVerifying() ||
+		!a.release.HasManagedPipelineProcessingFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.VerificationPipelineType)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	if pipelineRun == nil {
+		resources, err := a.loader.GetProcessingResources(a.ctx, a.client, a.release)
+		if err != nil {
+			if strings.Contains(err.Error(), "no ReleasePlanAdmissions can be found") {
+				// No ReleasePlanAdmission, so no verification pipeline to run
+				patch := client.MergeFrom(a.release.DeepCopy())
+				a.release.MarkVerificationSkipped()
+				return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+			}
+			return controller.RequeueWithError(err)
+		}
+
+		verification := resources.ReleasePlanAdmission.Spec.Verification
+		if verification == nil || verification.Pipeline == nil {
+			// no verification pipeline to run
+			patch := client.MergeFrom(a.release.DeepCopy())
+			a.release.MarkVerificationSkipped()
+			return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+		}
+
+		pipelineRun, err = a.createVerificationPipelineRun(resources, verification.Pipeline)
+		if err != nil {
+			if isPipelineNotMirroredError(err) || isQuotaExceededError(err) {
+				patch := client.MergeFrom(a.release.DeepCopy())
+				a.release.MarkVerifying()
+				a.release.MarkVerificationFailed(fmt.Sprintf("failed to create the verification Release PipelineRun: %s", err))
+				a.release.MarkReleaseFailed("Release verification failed creating the verification pipelineRun")
+				return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
 			}
+			return controller.RequeueWithError(err)
+		}
+
+		a.logger.Info(fmt.Sprintf("Created %s Release PipelineRun", metadata.VerificationPipelineType),
+			"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+	}
+
+	return controller.RequeueOnErrorOrContinue(a.registerVerificationData(pipelineRun))
+}
+
+// EnsureVerificationPipelineProcessingIsTracked is an operation that will ensure that the verification Release
+// PipelineRun status is tracked in the Release being processed.
+func (a *adapter) EnsureVerificationPipelineProcessingIsTracked() (controller.OperationResult, error) {
+	if !a.release.IsVerifying() {
+		return controller.ContinueProcessing()
+	}
+
+	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.VerificationPipelineType)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	return controller.RequeueOnErrorOrContinue(a.registerVerificationStatus(pipelineRun))
+}
+
+// EnsureReleaseProcessingResourcesAreCleanedUp is an operation that will ensure that the resources created for the Release
+// Processing step are cleaned up once processing is finished. This exists in conjunction with EnsureFinalizersAreCalled because
+// the finalizers should be removed from the pipelineRuns even if the Release is not marked for deletion for quota reasons.
+// The resources are kept while the released artifacts are verified, as the verification pipeline relies on them.
+func (a *adapter) EnsureReleaseProcessingResourcesAreCleanedUp() (controller.OperationResult, error) {
+	if !a.release.HasTenantPipelineProcessingFinished() || !a.release.HasManagedPipelineProcessingFinished() ||
+		a.release.IsVerifying() {
+		return controller.ContinueProcessing()
+	}
+
+	return controller.RequeueOnErrorOrContinue(a.finalizeRelease(false))
+}
+
+// EnsureSnapshotEnvironmentBindingsAreCreated is an operation that will ensure that, once a Release succeeds, the
+// released Snapshot is deployed to each of the Environments listed in the ReleasePlanAdmission. To do that, the Snapshot
+// is synced into the managed namespace and a SnapshotEnvironmentBinding is created for each Environment or updated if
+// one already exists for the application. Releases without Environments to deploy to are ignored.
+func (a *adapter) EnsureSnapshotEnvironmentBindingsAreCreated() (controller.OperationResult, error) {
+	if !a.release.IsReleased() || a.release.IsDeploying() || a.release.HasDeploymentFinished() ||
+		a.release.GetDeletionTimestamp() != nil {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) || strings.Contains(err.Error(), "no ReleasePlanAdmissions can be found") {
+			return controller.ContinueProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	environments := releasePlanAdmission.GetEnvironments()
+	if len(environments) == 0 {
+		return controller.ContinueProcessing()
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	err = a.syncer.SyncSnapshot(snapshot, releasePlanAdmission.Namespace)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	var bindings []string
+
+	for _, environment := range environments {
+		_, err = a.loader.GetEnvironment(a.ctx, a.client, environment, releasePlanAdmission.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				a.release.MarkDeploying("")
+				a.release.MarkDeploymentFailed(fmt.Sprintf("environment %s not found in namespace %s",
+					environment, releasePlanAdmission.Namespace))
+				return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+			}
+
+			return controller.RequeueWithError(err)
+		}
+
+		binding, err := a.createOrUpdateSnapshotEnvironmentBinding(snapshot, environment, releasePlanAdmission.Namespace)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+
+		a.logger.Info("Deploying released Snapshot", "Environment", environment,
+			"SnapshotEnvironmentBinding.Name", binding.Name, "SnapshotEnvironmentBinding.Namespace", binding.Namespace)
+
+		bindings = append(bindings, fmt.Sprintf("%s%c%s", binding.Namespace, types.Separator, binding.Name))
+	}
+
+	a.release.Status.Deployment.SnapshotEnvironmentBindings = bindings
+	a.release.MarkDeploying("")
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+}
+
+// EnsureSnapshotEnvironmentBindingsAreTracked is an operation that will ensure that the deployment status of the
+// SnapshotEnvironmentBindings created for a Release is rolled up into its Deployed condition.
+func (a *adapter) EnsureSnapshotEnvironmentBindingsAreTracked() (controller.OperationResult, error) {
+	if !a.release.IsDeploying() || a.release.HasDeploymentFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	bindings, err := a.loader.GetSnapshotEnvironmentBindingsFromReleaseStatus(a.ctx, a.client, a.release)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return controller.RequeueWithError(err)
+		}
+
+		a.release.MarkDeploymentFailed(err.Error())
+	} else {
+		a.registerDeploymentStatus(bindings)
+	}
+
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+}
+
+// EnsureFailedComponentsAreRetried is an operation that will ensure that a follow-up Release retrying only the failed
+// components is created for partially released Releases whose ReleasePlan requests it. Each follow-up Release consumes
+// the retry budget of the ReleasePlan once it's created, and the original Release is annotated with its name so it's
+// created only once. A follow-up Release left by a previous attempt is reused without consuming the budget again.
+func (a *adapter) EnsureFailedComponentsAreRetried() (controller.OperationResult, error) {
+	if !a.release.IsPartiallyReleased() || a.release.GetAnnotations()[metadata.FollowUpReleaseAnnotation] != "" ||
+		a.release.GetDeletionTimestamp() != nil || a.release.IsPlanDeleted() {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.ContinueProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	if !releasePlan.Spec.RetryFailedComponents {
+		return controller.ContinueProcessing()
+	}
+
+	followUpRelease, err := a.loader.GetRelease(a.ctx, a.client, a.getFollowUpReleaseName(), a.release.Namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	if errors.IsNotFound(err) {
+		if !releasePlan.HasRetryBudgetLeft() {
+			a.logger.Info("ReleasePlan retry budget exhausted, not retrying the failed components",
+				"ReleasePlan.Name", releasePlan.Name, "ReleasePlan.Namespace", releasePlan.Namespace)
+			return controller.ContinueProcessing()
+		}
+
+		followUpRelease, err = a.createFollowUpRelease()
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return controller.RequeueWithError(err)
+		}
+
+		// The budget is only consumed by the attempt creating the follow-up Release, so it's never consumed twice
+		if err == nil {
+			if _, err = a.consumeReleasePlanRetryBudget(); err != nil {
+				a.logger.Error(err, "Unable to register the follow-up Release in the ReleasePlan retry budget")
+			}
+		}
+	}
+
+	a.logger.Info("Retrying the failed components in a follow-up Release",
+		"Components", a.release.GetFailedComponents(), "Release.Name", followUpRelease.Name)
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	metadata.AddAnnotations(a.release, map[string]string{metadata.FollowUpReleaseAnnotation: followUpRelease.Name})
+
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, a.release, patch))
+}
+
+// EnsureFailedVerificationIsRolledBack is an operation that will ensure that a Release of the Snapshot previously
+// released by the ReleasePlan is created for Releases whose released artifacts didn't pass their verification, as long
+// as their ReleasePlanAdmission requests it. The original Release is annotated with the name of the rollback Release
+// so it's created only once.
+func (a *adapter) EnsureFailedVerificationIsRolledBack() (controller.OperationResult, error) {
+	if !a.release.IsVerificationFailed() || a.release.GetAnnotations()[metadata.RollbackReleaseAnnotation] != "" ||
+		a.release.GetDeletionTimestamp() != nil || a.release.IsPlanDeleted() {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) || strings.Contains(err.Error(), "no ReleasePlanAdmission") {
+			return controller.ContinueProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	if releasePlanAdmission.Spec.Verification == nil || !releasePlanAdmission.Spec.Verification.Rollback {
+		return controller.ContinueProcessing()
+	}
+
+	snapshot, err := a.getRollbackSnapshot()
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+	if snapshot == "" {
+		a.logger.Info("No previously released Snapshot to roll back to")
+		return controller.ContinueProcessing()
+	}
+
+	rollbackRelease, err := a.createRollbackRelease(snapshot)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Rolling back to the previously released Snapshot", "Snapshot.Name", snapshot,
+		"Release.Name", rollbackRelease.Name)
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	metadata.AddAnnotations(a.release, map[string]string{metadata.RollbackReleaseAnnotation: rollbackRelease.Name})
+
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, a.release, patch))
+}
+
+// EnsureSupportBundleIsGenerated is an operation that will ensure that a support bundle is generated for failed
+// Releases and for Releases annotated to request one. The support bundle is stored in a ConfigMap owned by the Release
+// whose namespaced name is added to the Release status. Once generated, the annotation requesting it is removed, so it
+// can be requested again.
+func (a *adapter) EnsureSupportBundleIsGenerated() (controller.OperationResult, error) {
+	requested := a.release.GetAnnotations()[metadata.SupportBundleAnnotation] == "true"
+	if a.release.GetDeletionTimestamp() != nil ||
+		(!requested && (!a.release.IsFailed() || a.release.Status.SupportBundle != "")) {
+		return controller.ContinueProcessing()
+	}
+
+	configMap, err := a.generateSupportBundle()
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Generated the support bundle of the Release", "ConfigMap.Name", configMap.Name)
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.Status.SupportBundle = fmt.Sprintf("%s%c%s", configMap.Namespace, types.Separator, configMap.Name)
+	err = a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil || !requested {
+		return controller.RequeueOnErrorOrContinue(err)
+	}
+
+	patch = client.MergeFrom(a.release.DeepCopy())
+	delete(a.release.Annotations, metadata.SupportBundleAnnotation)
+
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, a.release, patch))
+}
+
+// EnsureReleaseIsRecordedInSnapshot is an operation that will ensure that finished Releases are recorded in the releases
+// annotation of the Snapshot they released, so the outcome of the Releases of a Snapshot can be checked from the
+// Snapshot itself. Only the most recent Releases are kept. If the Snapshot doesn't exist anymore, no action is taken.
+func (a *adapter) EnsureReleaseIsRecordedInSnapshot() (controller.OperationResult, error) {
+	if a.release.GetDeletionTimestamp() != nil || !a.release.HasReleaseFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.ContinueProcessing()
+		}
+
+		return controller.RequeueWithError(err)
+	}
+
+	var records []snapshotReleaseRecord
+	if value, found := snapshot.GetAnnotations()[metadata.SnapshotReleasesAnnotation]; found {
+		if err := json.Unmarshal([]byte(value), &records); err != nil {
+			a.logger.Info("Ignoring malformed releases annotation in the Snapshot", "Snapshot.Name", snapshot.Name)
+			records = nil
+		}
+	}
+
+	for _, record := range records {
+		if record.Name == a.release.Name {
+			return controller.ContinueProcessing()
+		}
+	}
+
+	records = append(records, snapshotReleaseRecord{
+		Name:           a.release.Name,
+		Target:         a.release.Status.Target,
+		Phase:          a.release.Status.Summary.Phase,
+		StartTime:      a.release.Status.StartTime,
+		CompletionTime: a.release.Status.CompletionTime,
+	})
+	if len(records) > snapshotReleasesLimit {
+		records = records[len(records)-snapshotReleasesLimit:]
+	}
+
+	value, err := json.Marshal(records)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	patch := client.MergeFromWithOptions(snapshot.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	metadata.AddAnnotations(snapshot, map[string]string{metadata.SnapshotReleasesAnnotation: string(value)})
+	// A conflict is returned as is, so the Release is requeued with the conflict backoff and the record is added to the
+	// updated annotation
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, snapshot, patch))
+}
+
+// acquireReleaseLock acquires the release lock for the given application and target, so no other Release of the same
+// application to the same target can process its managed pipeline concurrently. Locks are implemented with Leases in the
+// namespace of the Release being processed. A lock held by a Release that doesn't exist anymore or that already finished
+// its managed processing is considered stale and taken over, unless another queued Release is next in line to hold it.
+// If the lock can't be acquired, the Release being processed is queued on it. The returned boolean indicates whether
+// the lock is held by the Release being processed.
+func (a *adapter) acquireReleaseLock(application, target string, preemption bool) (bool, error) {
+	lease := &coordinationv1.Lease{}
+	err := a.client.Get(a.ctx, types.NamespacedName{
+		Name:      getReleaseLockName(application, target),
+		Namespace: a.release.Namespace,
+	}, lease)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      getReleaseLockName(application, target),
+				Namespace: a.release.Namespace,
+			},
+		}
+		a.setReleaseLockHolder(lease)
+		err = a.client.Create(a.ctx, lease)
+		if errors.IsAlreadyExists(err) {
+			return false, nil
+		}
+
+		return err == nil, err
+	}
+
+	if lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity == a.release.Name {
+		return true, nil
+	}
+
+	if lease.Spec.HolderIdentity != nil {
+		holder, err := a.loader.GetRelease(a.ctx, a.client, *lease.Spec.HolderIdentity, a.release.Namespace)
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		if err == nil && !holder.HasManagedPipelineProcessingFinished() {
+			return false, a.queueOnReleaseLock(lease, target, preemption)
+		}
+	}
+
+	nextHolder := lease.Annotations[metadata.ReleaseLockNextHolderAnnotation]
+	if nextHolder != "" && nextHolder != a.release.Name {
+		queuedRelease, err := a.getQueuedRelease(nextHolder)
+		if err != nil {
+			return false, err
+		}
+
+		if queuedRelease != nil {
+			return false, a.queueOnReleaseLock(lease, target, preemption)
+		}
+	}
+
+	a.setReleaseLockHolder(lease)
+	delete(lease.Annotations, metadata.ReleaseLockNextHolderAnnotation)
+	err = a.client.Update(a.ctx, lease)
+	if errors.IsConflict(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// captureFailureLogs saves the tail of the logs of the failed tasks of the given PipelineRun in a ConfigMap owned by
+// the Release being processed, so they can be inspected without access to the namespace the PipelineRun ran in. The
+// namespaced name of the ConfigMap is added to the given PipelineInfo. The sensitive values of the Release data are
+// redacted from the logs. If the adapter can't retrieve Pod logs, no action will be taken.
+func (a *adapter) captureFailureLogs(pipelineRun *tektonv1.PipelineRun, pipelineInfo *v1alpha1.PipelineInfo) error {
+	if a.podLogsGetter == nil {
+		return nil
+	}
+
+	logs, err := tekton.GetFailureLogs(a.ctx, a.client, a.podLogsGetter, pipelineRun)
+	if err != nil || len(logs) == 0 {
+		return err
+	}
+	for key, value := range logs {
+		logs[key] = a.redactor.Redact(value)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-logs", a.release.Name, pipelineRun.Labels[metadata.PipelinesTypeLabel]),
+			Namespace: a.release.Namespace,
+		},
+		Data: logs,
+	}
+
+	err = ctrl.SetControllerReference(a.release, configMap, a.client.Scheme())
+	if err != nil {
+		return err
+	}
+
+	err = a.client.Create(a.ctx, configMap)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	pipelineInfo.LogsConfigMap = fmt.Sprintf("%s%c%s", configMap.Namespace, types.Separator, configMap.Name)
+
+	return nil
+}
+
+// cleanupProcessingResources removes the finalizer from the PipelineRun created for the Release Processing
+// and removes the roleBinding that was created in order for the PipelineRun to succeed.
+func (a *adapter) cleanupProcessingResources(pipelineRun *tektonv1.PipelineRun, roleBinding *rbac.RoleBinding) error {
+	if roleBinding != nil {
+		err := a.client.Delete(a.ctx, roleBinding)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pipelineRun != nil {
+		if controllerutil.ContainsFinalizer(pipelineRun, metadata.ReleaseFinalizer) {
+			patch := client.MergeFrom(pipelineRun.DeepCopy())
+			removedFinalizer := controllerutil.RemoveFinalizer(pipelineRun, metadata.ReleaseFinalizer)
+			if !removedFinalizer {
+				return fmt.Errorf("finalizer not removed")
+			}
+			err := a.client.Patch(a.ctx, pipelineRun, patch)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// consumeReleasePlanRetryBudget registers an automatic retry in the retry budget of the ReleasePlan of the Release
+// being processed. If the budget has been exhausted, false will be returned and no retry should be performed. The
+// retries are patched with an optimistic lock, so concurrent Releases of the ReleasePlan can't drop each other's retries,
+// and the budget is checked again against the latest ReleasePlan on conflict.
+func (a *adapter) consumeReleasePlanRetryBudget() (bool, error) {
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	hasRetryBudgetLeft := false
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		hasRetryBudgetLeft = releasePlan.HasRetryBudgetLeft()
+		if !hasRetryBudgetLeft || releasePlan.Spec.RetryBudget == nil {
+			return nil
+		}
+
+		patch := client.MergeFromWithOptions(releasePlan.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		releasePlan.ConsumeRetry()
+		err := a.client.Status().Patch(a.ctx, releasePlan, patch)
+		if errors.IsConflict(err) {
+			if err := a.client.Get(a.ctx, client.ObjectKeyFromObject(releasePlan), releasePlan); err != nil {
+				return err
+			}
+		}
+
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !hasRetryBudgetLeft {
+		a.logger.Info("ReleasePlan retry budget exhausted, not retrying Release PipelineRun",
+			"ReleasePlan.Name", releasePlan.Name, "ReleasePlan.Namespace", releasePlan.Namespace)
+	}
+
+	return hasRetryBudgetLeft, nil
+}
+
+// createDataSecret creates a Secret in the given namespace containing the values of the Secret keys referenced in the
+// Release data and the decrypted encrypted values in it, so the managed Pipeline can read them from a workspace without
+// the plain values being stored in the Release. Each value is stored under its path within the data. If the data
+// doesn't reference any Secret key nor contain encrypted values, no Secret is created and nil is returned.
+func (a *adapter) createDataSecret(namespace string) (*corev1.Secret, error) {
+	secretKeyRefs, err := a.release.GetDataSecretKeyRefs()
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedValues, err := a.release.GetDataEncryptedValues()
+	if err != nil || (len(secretKeyRefs) == 0 && len(encryptedValues) == 0) {
+		return nil, err
+	}
+
+	dataSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-data-", a.release.Name),
+			Namespace:    namespace,
+			Labels:       metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{},
+	}
+
+	for path, secretKeyRef := range secretKeyRefs {
+		secret, err := a.loader.GetSecret(a.ctx, a.client, secretKeyRef.Name, a.release.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		value, found := secret.Data[secretKeyRef.Key]
+		if !found {
+			return nil, fmt.Errorf("secret %s does not contain the key %s referenced in %s",
+				secretKeyRef.Name, secretKeyRef.Key, path)
+		}
+		dataSecret.Data[path] = value
+	}
+
+	if len(encryptedValues) > 0 {
+		decrypter, err := a.getDecrypter()
+		if err != nil {
+			return nil, err
+		}
+
+		for path, encryptedValue := range encryptedValues {
+			value, err := decrypter.Decrypt(a.ctx, encryptedValue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt the value in %s: %w", path, err)
+			}
+			dataSecret.Data[path] = value
+		}
+	}
+
+	err = a.client.Create(a.ctx, dataSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataSecret, nil
+}
+
+// createRegistryCredentialsSecret mints registry credentials scoped to the repositories requested in the given
+// ReleasePlanAdmission and stores them in a dockerconfigjson Secret in the given namespace, so the managed Pipeline
+// can push with them without the tenants or the Pipeline definitions holding long-lived credentials. The identifier of
+// the credentials in the broker is kept in an annotation, so they can be revoked once the Release finishes. If the
+// ReleasePlanAdmission doesn't request registry credentials, no Secret is created and nil is returned.
+func (a *adapter) createRegistryCredentialsSecret(namespace string, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*corev1.Secret, error) {
+	if releasePlanAdmission.Spec.RegistryCredentials == nil {
+		return nil, nil
+	}
+
+	broker, err := a.getCredentialsBroker()
+	if err != nil {
+		return nil, err
+	}
+
+	registryCredentials, err := broker.Issue(a.ctx, a.getRegistryCredentialsName(),
+		releasePlanAdmission.Spec.RegistryCredentials.Repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	dockerConfigJson, err := credentials.GetDockerConfigJson(registryCredentials)
+	if err != nil {
+		_ = broker.Revoke(a.ctx, registryCredentials)
+		return nil, err
+	}
+
+	credentialsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-registry-credentials-", a.release.Name),
+			Namespace:    namespace,
+			// The Secret is owned by the PipelineRun, so the finalizer keeps it until the credentials are revoked
+			Finalizers: []string{metadata.ReleaseFinalizer},
+			Labels:     metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace),
+			Annotations: map[string]string{
+				metadata.RegistryCredentialsAnnotation: registryCredentials.ID,
+			},
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: dockerConfigJson,
+			"password":                 []byte(registryCredentials.Password),
+			"registry":                 []byte(registryCredentials.Registry),
+			"username":                 []byte(registryCredentials.Username),
+		},
+	}
+
+	err = a.client.Create(a.ctx, credentialsSecret)
+	if err != nil {
+		_ = broker.Revoke(a.ctx, registryCredentials)
+		return nil, err
+	}
+
+	return credentialsSecret, nil
+}
+
+// createFanOutPipelineRun creates the managed Release PipelineRun of the given target ReleasePlanAdmission of a fan-out
+// Release, along with the RoleBinding granting its serviceAccount permissions and the Secrets bound to it, which are
+// removed if the PipelineRun can't be created. The processing resources of the Release are used, replacing the
+// ReleasePlanAdmission, EnterpriseContractPolicy, catalog Pipeline and ReleasePlanAdmissionDefaults with the ones of the
+// target.
+func (a *adapter) createFanOutPipelineRun(resources *loader.ProcessingResources, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*tektonv1.PipelineRun, *rbac.RoleBinding, error) {
+	catalogPipeline, err := a.resolveCatalogPipeline(releasePlanAdmission)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	releasePlanAdmissionDefaults, err := a.applyReleasePlanAdmissionDefaults(releasePlanAdmission)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipeline, err := releasePlanAdmission.GetPipeline(a.release.Spec.Strategy)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pipeline == nil {
+		return nil, nil, fmt.Errorf("the ReleasePlanAdmission defines no managed Pipeline")
+	}
+
+	executionNamespace, err := releasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetResources := *resources
+	targetResources.ReleasePlanAdmission = releasePlanAdmission
+	targetResources.CatalogPipeline = catalogPipeline
+	targetResources.ReleasePlanAdmissionDefaults = releasePlanAdmissionDefaults
+	targetResources.EnterpriseContractPolicy, err = a.loader.GetEnterpriseContractPolicy(a.ctx, a.client,
+		releasePlanAdmission)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if releasePlanAdmission.Spec.CreateExecutionNamespace && executionNamespace != releasePlanAdmission.Namespace {
+		err = a.ensureNamespaceExists(executionNamespace)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var roleBinding *rbac.RoleBinding
+	if pipeline.ServiceAccountName != "" {
+		roleBinding, err = a.createRoleBindingForClusterRole("release-pipeline-resource-role", releasePlanAdmission)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	dataSecret, err := a.createDataSecret(executionNamespace)
+	if err != nil {
+		if roleBinding != nil {
+			_ = a.client.Delete(a.ctx, roleBinding)
+		}
+		return nil, nil, err
+	}
+
+	credentialsSecret, err := a.createRegistryCredentialsSecret(executionNamespace, releasePlanAdmission)
+	if err != nil {
+		if roleBinding != nil {
+			_ = a.client.Delete(a.ctx, roleBinding)
+		}
+		if dataSecret != nil {
+			_ = a.client.Delete(a.ctx, dataSecret)
+		}
+		return nil, nil, err
+	}
+
+	pipelineRun, err := a.createManagedPipelineRun(&targetResources, dataSecret, credentialsSecret)
+	if err != nil {
+		if roleBinding != nil {
+			_ = a.client.Delete(a.ctx, roleBinding)
+		}
+		if dataSecret != nil {
+			_ = a.client.Delete(a.ctx, dataSecret)
+		}
+		if credentialsSecret != nil {
+			_ = a.revokeRegistryCredentials(credentialsSecret)
+		}
+		return nil, nil, err
+	}
+
+	return pipelineRun, roleBinding, a.setSecretsOwner(pipelineRun, dataSecret, credentialsSecret)
+}
+
+// createFollowUpRelease creates a Release retrying the components that failed to be released by the Release being
+// processed. The follow-up Release shares the spec and skipped tasks of the original one, except for its idempotency
+// key, and lists the failed components in its components annotation. The Release is returned even if it already exists.
+func (a *adapter) createFollowUpRelease() (*v1alpha1.Release, error) {
+	annotations := map[string]string{
+		metadata.ComponentsAnnotation: strings.Join(a.release.GetFailedComponents(), ","),
+	}
+	if skippedTasks, found := a.release.GetAnnotations()[metadata.SkipTasksAnnotation]; found {
+		annotations[metadata.SkipTasksAnnotation] = skippedTasks
+	}
+
+	release := &v1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        a.getFollowUpReleaseName(),
+			Namespace:   a.release.Namespace,
+			Annotations: annotations,
+		},
+		Spec: *a.release.Spec.DeepCopy(),
+	}
+	release.Spec.IdempotencyKey = ""
+
+	return release, a.client.Create(a.ctx, release)
+}
+
+// createManagedPipelineRun creates and returns a new managed Release PipelineRun. The new PipelineRun will include owner
+// annotations, so it triggers Release reconciles whenever it changes. The Pipeline information and the parameters to it
+// will be extracted from the given ReleasePlanAdmission. The Release's Snapshot will also be passed to the release
+// PipelineRun. If a data Secret is given, it will be bound to the PipelineRun as the release-data-secrets workspace and
+// if a registry credentials Secret is given, as the release-registry-credentials workspace. If the Release selects a
+// strategy, the Pipeline of that strategy will be used. Otherwise, if the ReleasePlanAdmission references a catalog
+// Pipeline, its params will be passed too. The PipelineRun is created in the execution namespace of the
+// ReleasePlanAdmission.
+func (a *adapter) createManagedPipelineRun(resources *loader.ProcessingResources, dataSecret, credentialsSecret *corev1.Secret) (*tektonv1.PipelineRun, error) {
+	pipeline, err := resources.ReleasePlanAdmission.GetPipeline(a.release.Spec.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	executionNamespace, err := resources.ReleasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineRef, err := a.getPipelineRef(pipeline, resources.ReleasePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := utils.NewPipelineRunBuilder(metadata.ManagedPipelineType, executionNamespace).
+		WithAnnotations(a.getProvenanceAnnotations(resources.Snapshot)).
+		WithFinalizer(metadata.ReleaseFinalizer).
+		WithLabels(map[string]string{
+			metadata.ApplicationNameLabel: resources.ReleasePlan.Spec.Application,
+			metadata.PipelinesTypeLabel:   metadata.ManagedPipelineType,
+			metadata.ReleaseSnapshotLabel: a.release.Spec.Snapshot,
+		}).
+		WithLabels(metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace)).
+		WithNodeAntiAffinity(a.release.Status.ManagedProcessing.ExcludedNodes).
+		WithObjectReferences(a.release, resources.ReleasePlan, resources.ReleasePlanAdmission, a.releaseServiceConfig,
+			resources.Snapshot).
+		WithObjectSpecsAsJson(resources.EnterpriseContractPolicy).
+		WithOwner(a.release).
+		WithParamsFromConfigMap(resources.EnterpriseContractConfigMap, []string{"verify_ec_task_bundle"}).
+		WithParams(a.getImageAnnotationsParams(resources)...).
+		WithParams(a.getPlatformHintsParams(resources, pipeline.ServiceAccountName, executionNamespace)...).
+		WithParams(a.getSkippedTasksParams()...).
+		WithParams(a.getComponentsParams()...).
+		WithParams(a.getReleaseLinkParams(resources.ReleasePlan.Spec.Application)...).
+		WithParams(a.getReleasePlanAdmissionDefaultsParams(resources)...).
+		WithPipelineRef(pipelineRef).
+		WithServiceAccount(pipeline.ServiceAccountName).
+		WithTimeouts(&pipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts).
+		WithWorkspaceFromVolumeTemplate(
+			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
+			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
+		).
+		WithWorkspaceLabels(metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace))
+
+	if a.release.Spec.Strategy == "" && resources.CatalogPipeline != nil {
+		builder.WithParams(resources.CatalogPipeline.GetTektonParams()...)
+	}
+
+	if dataSecret != nil {
+		builder.WithWorkspaceFromSecret(dataSecretsWorkspaceName, dataSecret.Name)
+	}
+
+	if credentialsSecret != nil {
+		builder.WithWorkspaceFromSecret(registryCredentialsWorkspaceName, credentialsSecret.Name)
+	}
+
+	return a.createPipelineRun(builder, resources.ReleasePlanAdmission.Spec.Executor)
+}
+
+// createOrUpdateSnapshotEnvironmentBinding creates a SnapshotEnvironmentBinding in the given namespace binding the
+// application of the given Snapshot to the given Environment. If one already exists, it's updated to reference the
+// Snapshot and its components, keeping the configuration of the components that were already bound. In both cases,
+// the SnapshotEnvironmentBinding is annotated with the Release, so it gets reconciled when the deployment progresses.
+func (a *adapter) createOrUpdateSnapshotEnvironmentBinding(snapshot *applicationapiv1alpha1.Snapshot, environment, namespace string) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error) {
+	binding, err := a.loader.GetSnapshotEnvironmentBinding(a.ctx, a.client, snapshot.Spec.Application, environment, namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	exists := err == nil
+	if !exists {
+		binding = &applicationapiv1alpha1.SnapshotEnvironmentBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-%s-", snapshot.Spec.Application, environment),
+				Namespace:    namespace,
+			},
+			Spec: applicationapiv1alpha1.SnapshotEnvironmentBindingSpec{
+				Application: snapshot.Spec.Application,
+				Environment: environment,
+			},
+		}
+	}
+
+	patch := client.MergeFrom(binding.DeepCopy())
+
+	configurations := make(map[string]applicationapiv1alpha1.BindingComponentConfiguration)
+	for _, component := range binding.Spec.Components {
+		configurations[component.Name] = component.Configuration
+	}
+
+	components := []applicationapiv1alpha1.BindingComponent{}
+	for _, component := range snapshot.Spec.Components {
+		components = append(components, applicationapiv1alpha1.BindingComponent{
+			Name:          component.Name,
+			Configuration: configurations[component.Name],
+		})
+	}
+
+	binding.Spec.Components = components
+	binding.Spec.Snapshot = snapshot.Name
+
+	err = libhandler.SetOwnerAnnotations(a.release, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return binding, a.client.Patch(a.ctx, binding, patch)
+	}
+
+	return binding, a.client.Create(a.ctx, binding)
+}
+
+// createTenantPipelineRun creates and returns a new tenant Release PipelineRun. The new PipelineRun will include owner
+// annotations, so it triggers Release reconciles whenever it changes. The Pipeline information and the parameters to it
+// will be extracted from the given ReleasePlan. The Release's Snapshot will also be passed to the release
+// PipelineRun.
+func (a *adapter) createTenantPipelineRun(releasePlan *v1alpha1.ReleasePlan, snapshot *applicationapiv1alpha1.Snapshot) (*tektonv1.PipelineRun, error) {
+	pipelineRef, err := a.getPipelineRef(&releasePlan.Spec.Pipeline.Pipeline, releasePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := utils.NewPipelineRunBuilder(metadata.TenantPipelineType, releasePlan.Namespace).
+		WithAnnotations(a.getProvenanceAnnotations(snapshot)).
+		WithFinalizer(metadata.ReleaseFinalizer).
+		WithLabels(map[string]string{
+			metadata.ApplicationNameLabel: releasePlan.Spec.Application,
+			metadata.PipelinesTypeLabel:   metadata.TenantPipelineType,
+			metadata.ReleaseSnapshotLabel: a.release.Spec.Snapshot,
+		}).
+		WithLabels(metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace)).
+		WithObjectReferences(a.release, releasePlan, snapshot).
+		WithParams(releasePlan.Spec.Pipeline.GetTektonParams()...).
+		WithOwner(a.release).
+		WithPipelineRef(pipelineRef).
+		WithServiceAccount(releasePlan.Spec.Pipeline.ServiceAccountName).
+		WithTimeouts(&releasePlan.Spec.Pipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts).
+		WithWorkspaceFromVolumeTemplate(
+			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
+			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
+		).
+		WithWorkspaceLabels(metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace))
+
+	return a.createPipelineRun(builder, executor.TektonExecutorName)
+}
+
+// createParamsConfigMap moves the values of the params of the given PipelineRun too big to be passed inline into a new
+// ConfigMap in the PipelineRun namespace, so they can be mounted as the params workspace. If every param fits, no
+// ConfigMap is created and nil is returned.
+func (a *adapter) createParamsConfigMap(pipelineRun *tektonv1.PipelineRun) (*corev1.ConfigMap, error) {
+	overflow := utils.OverflowParams(pipelineRun, maxInlineParamSize)
+	if len(overflow) == 0 {
+		return nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-params-", a.release.Name),
+			Namespace:    pipelineRun.Namespace,
+			Labels:       metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace),
+		},
+		Data: overflow,
+	}
+
+	return configMap, a.client.Create(a.ctx, configMap)
+}
+
+// createPipelineRun builds the Release PipelineRun of the given builder and creates it with the executor registered
+// with the given name, defaulting to Tekton. The values of the params too big to be passed inline are moved to a
+// ConfigMap bound to the PipelineRun as the params workspace, which is owned by the PipelineRun so it gets removed along
+// with it.
+func (a *adapter) createPipelineRun(builder *utils.PipelineRunBuilder, executorName string) (*tektonv1.PipelineRun, error) {
+	pipelineExecutor, err := executor.Get(executorName)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineRun, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	paramsConfigMap, err := a.createParamsConfigMap(pipelineRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if paramsConfigMap != nil {
+		a.logger.Info("Moved the Release PipelineRun params exceeding the inline size limit to a workspace",
+			"ConfigMap.Name", paramsConfigMap.Name, "ConfigMap.Namespace", paramsConfigMap.Namespace)
+		pipelineRun, err = builder.WithWorkspaceFromConfigMap(paramsWorkspaceName, paramsConfigMap.Name).Build()
+		if err != nil {
+			_ = a.client.Delete(a.ctx, paramsConfigMap)
+			return nil, err
+		}
+	}
+
+	err = pipelineExecutor.Execute(a.ctx, a.client, pipelineRun)
+	if err != nil {
+		if paramsConfigMap != nil {
+			_ = a.client.Delete(a.ctx, paramsConfigMap)
+		}
+		return nil, err
+	}
+
+	if paramsConfigMap != nil {
+		patch := client.MergeFrom(paramsConfigMap.DeepCopy())
+		err = controllerutil.SetOwnerReference(pipelineRun, paramsConfigMap, a.client.Scheme())
+		if err != nil {
+			return nil, err
+		}
+		err = a.client.Patch(a.ctx, paramsConfigMap, patch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pipelineRun, nil
+}
+
+// createRoleBindingForClusterRole creates a RoleBinding that binds the serviceAccount from the passed
+// ReleasePlanAdmission to the passed ClusterRole. The serviceAccount is expected in the execution namespace of the
+// ReleasePlanAdmission. If the creation fails, the error is returned. If the creation is successful, the RoleBinding
+// is returned.
+func (a *adapter) createRoleBindingForClusterRole(clusterRole string, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*rbac.RoleBinding, error) {
+	pipeline, err := releasePlanAdmission.GetPipeline(a.release.Spec.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	executionNamespace, err := releasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	roleBinding := &rbac.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-rolebinding-for-%s-", a.release.Name, clusterRole),
+			Namespace:    releasePlanAdmission.Spec.Origin,
+			// Only the RoleBindings managed by the Release Service are cached
+			Labels: map[string]string{metadata.ManagedByLabel: metadata.ManagedByLabelValue},
+		},
+		RoleRef: rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole,
+		},
+		Subjects: []rbac.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      pipeline.ServiceAccountName,
+				Namespace: executionNamespace,
+			},
+		},
+	}
+
+	// Set ownerRef so it is deleted if the Release is deleted
+	err = ctrl.SetControllerReference(a.release, roleBinding, a.client.Scheme())
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.client.Create(a.ctx, roleBinding)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleBinding, nil
+}
+
+// createRollbackRelease creates a Release of the given Snapshot rolling back the Snapshot released by the Release
+// being processed. The rollback Release uses the same ReleasePlan as the original one. The Release is returned even if
+// it already exists.
+func (a *adapter) createRollbackRelease(snapshot string) (*v1alpha1.Release, error) {
+	release := &v1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-rollback", a.release.Name),
+			Namespace: a.release.Namespace,
+		},
+		Spec: v1alpha1.ReleaseSpec{
+			ReleasePlan: a.release.Spec.ReleasePlan,
+			Snapshot:    snapshot,
+		},
+	}
+
+	return release, a.client.Create(a.ctx, release)
+}
+
+// createVerificationPipelineRun creates and returns a new verification Release PipelineRun running the given Pipeline.
+// The new PipelineRun will include owner annotations, so it triggers Release reconciles whenever it changes. The
+// Release is passed to the PipelineRun, so it can verify the artifacts in its status. The PipelineRun is created in the
+// execution namespace of the ReleasePlanAdmission.
+func (a *adapter) createVerificationPipelineRun(resources *loader.ProcessingResources, pipeline *utils.Pipeline) (*tektonv1.PipelineRun, error) {
+	executionNamespace, err := resources.ReleasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineRef, err := a.getPipelineRef(pipeline, resources.ReleasePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := utils.NewPipelineRunBuilder(metadata.VerificationPipelineType, executionNamespace).
+		WithAnnotations(a.getProvenanceAnnotations(resources.Snapshot)).
+		WithLabels(map[string]string{
+			metadata.ApplicationNameLabel: resources.ReleasePlan.Spec.Application,
+			metadata.PipelinesTypeLabel:   metadata.VerificationPipelineType,
+			metadata.ReleaseSnapshotLabel: a.release.Spec.Snapshot,
+		}).
+		WithLabels(metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace)).
+		WithObjectReferences(a.release, resources.ReleasePlan, resources.ReleasePlanAdmission, resources.Snapshot).
+		WithOwner(a.release).
+		WithParams(a.getComponentsParams()...).
+		WithPipelineRef(pipelineRef).
+		WithServiceAccount(pipeline.ServiceAccountName).
+		WithTimeouts(&pipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts)
+
+	return a.createPipelineRun(builder, resources.ReleasePlanAdmission.Spec.Executor)
+}
+
+// ensureNamespaceExists creates the namespace with the given name if it doesn't exist yet, so the managed pipelines
+// can run in the execution namespaces of the ReleasePlanAdmissions that ask for them to be created.
+func (a *adapter) ensureNamespaceExists(name string) error {
+	namespace := &corev1.Namespace{}
+	err := a.client.Get(a.ctx, types.NamespacedName{Name: name}, namespace)
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	namespace = &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	err = a.client.Create(a.ctx, namespace)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	a.logger.Info("Created the execution namespace of the managed pipeline", "Namespace", name)
+
+	return nil
+}
+
+// checkDependencies runs the dependency health checks defined in the ReleaseServiceConfig and reports the result of each
+// one in the conditions of the Release being processed, marking it as waiting for its dependencies if any check fails.
+// While the Release keeps waiting in the same phase, the results are reported at most once per status update interval.
+// It returns true if every check succeeded or no checks are defined.
+func (a *adapter) checkDependencies() (bool, error) {
+	if a.releaseServiceConfig == nil || len(a.releaseServiceConfig.Spec.DependencyHealthChecks) == 0 {
+		return true, nil
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	phase := a.release.Status.Summary.Phase
+	httpClient := &http.Client{Timeout: dependencyHealthCheckTimeout}
+
+	var unhealthy []string
+	for _, dependencyHealthCheck := range a.releaseServiceConfig.Spec.DependencyHealthChecks {
+		check, err := health.NewCheck(dependencyHealthCheck.Type, dependencyHealthCheck.URL, httpClient)
+		if err != nil {
+			return false, err
+		}
+
+		err = check.Check(a.ctx)
+		if err != nil {
+			a.release.MarkDependencyUnhealthy(dependencyHealthCheck.Name, err.Error())
+			unhealthy = append(unhealthy, dependencyHealthCheck.Name)
+		} else {
+			a.release.MarkDependencyHealthy(dependencyHealthCheck.Name)
+		}
+	}
+
+	if len(unhealthy) > 0 {
+		a.release.MarkAwaitingDependencies(fmt.Sprintf("waiting for the unhealthy dependencies %s to be healthy",
+			strings.Join(unhealthy, ", ")))
+	}
+
+	key := client.ObjectKeyFromObject(a.release).String()
+	if len(unhealthy) > 0 && a.release.Status.Summary.Phase == phase &&
+		a.throttle.Delay(key, throttle.GetInterval(a.releaseServiceConfig)) > 0 {
+		metrics.RegisterThrottledStatusUpdate("release")
+		return false, nil
+	}
+
+	err := a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return false, err
+	}
+	a.throttle.Record(key)
+
+	return len(unhealthy) == 0, nil
+}
+
+// cancelManagedPipelineRunPastDeadline fails the Release being processed as its managed PipelineRun didn't complete
+// before the execution deadline and cancels the PipelineRun afterward.
+func (a *adapter) cancelManagedPipelineRunPastDeadline(pipelineRun *tektonv1.PipelineRun) (controller.OperationResult, error) {
+	a.logger.Info("Managed Release PipelineRun exceeded the execution deadline",
+		"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.MarkManagedPipelineProcessingFailedWithReason(reasons.Timeout,
+		"the managed pipeline didn't complete before the execution deadline")
+	a.release.MarkReleaseFailed("Release processing failed running the managed pipeline")
+	err := a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	pipelineRunPatch := client.MergeFrom(pipelineRun.DeepCopy())
+	pipelineRun.Spec.Status = tektonv1.PipelineRunSpecStatusCancelled
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, pipelineRun, pipelineRunPatch))
+}
+
+// generateSupportBundle collects the Release being processed, its ReleasePlan, its ReleasePlanAdmission, the status of
+// its PipelineRuns, its events and the controller log entries mentioning it into a ConfigMap owned by the Release,
+// which is created or updated and returned. The controller logs are only collected if the adapter can retrieve Pod logs
+// and the POD_NAME environment variable is set, and failing to retrieve them doesn't prevent the bundle generation.
+func (a *adapter) generateSupportBundle() (*corev1.ConfigMap, error) {
+	bundle := support.NewBundle().WithRedactor(a.redactor)
+
+	err := bundle.AddObject("release.json", a.release)
+	if err != nil {
+		return nil, err
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		err = bundle.AddObject("releaseplan.json", releasePlan)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		err = bundle.AddObject("releaseplanadmission.json", releasePlanAdmission)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, pipelineType := range []string{metadata.TenantPipelineType, metadata.ManagedPipelineType,
+		metadata.VerificationPipelineType} {
+		pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, pipelineType)
+		if err != nil {
+			return nil, err
+		}
+		if pipelineRun == nil {
+			continue
+		}
+
+		err = bundle.AddObject(fmt.Sprintf("%s-pipelinerun-status.json", pipelineType), pipelineRun.Status)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if a.eventsGetter != nil {
+		events, err := a.eventsGetter.GetEvents(a.ctx, a.release.Namespace, a.release.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = bundle.AddObject("events.json", events)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	podName := os.Getenv("POD_NAME")
+	if a.podLogsGetter != nil && podName != "" {
+		logs, err := a.podLogsGetter.GetLogs(a.ctx, os.Getenv("SERVICE_NAMESPACE"), podName, "manager",
+			support.ControllerLogsTailLines)
+		if err != nil {
+			a.logger.Error(err, "Failed to get the controller logs for the support bundle")
+		} else {
+			bundle.AddText("controller.log", support.FilterLogs(logs, a.release.Name, a.release.Namespace))
+		}
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-support-bundle", a.release.Name),
+			Namespace: a.release.Namespace,
+		},
+	}
+
+	_, err = controllerutil.CreateOrUpdate(a.ctx, a.client, configMap, func() error {
+		configMap.Data = bundle.Data()
+
+		return ctrl.SetControllerReference(a.release, configMap, a.client.Scheme())
+	})
+
+	return configMap, err
+}
+
+// getBackoffDelay records a new attempt of the given category of operations on the Release being processed and returns
+// the delay the backoff policy of the category defines before retrying it.
+func (a *adapter) getBackoffDelay(category backoff.Category) time.Duration {
+	return a.backoff.Next(category, client.ObjectKeyFromObject(a.release).String(),
+		backoff.GetPolicy(a.releaseServiceConfig, category))
+}
+
+// getDecrypter returns the Decrypter used to decrypt the encrypted values in the Release data, creating it from the
+// KMS key reference set in the ReleaseServiceConfig if the adapter doesn't have one. An error is returned if no KMS key
+// is configured.
+func (a *adapter) getDecrypter() (encryption.Decrypter, error) {
+	if a.decrypter != nil {
+		return a.decrypter, nil
+	}
+
+	if a.releaseServiceConfig == nil || a.releaseServiceConfig.Spec.DataEncryption == nil {
+		return nil, fmt.Errorf("the Release data contains encrypted values but no KMS key is configured to decrypt them")
+	}
+
+	decrypter, err := encryption.NewDecrypter(a.releaseServiceConfig.Spec.DataEncryption.KeyRef, http.DefaultClient)
+	if err != nil {
+		return nil, err
+	}
+	a.decrypter = decrypter
+
+	return a.decrypter, nil
+}
+
+// getCredentialsBroker returns the Broker minting the registry credentials of the Release. The adapter Broker is used
+// if set. Otherwise, one is created from the credentials broker set in the ReleaseServiceConfig, authenticating with the
+// token stored in its Secret. The ReleaseServiceConfig is loaded if the adapter doesn't have it yet, as Releases are
+// finalized before loading it. An error wrapping credentials.ErrNoBroker is returned if no credentials broker is
+// configured.
+func (a *adapter) getCredentialsBroker() (credentials.Broker, error) {
+	if a.broker != nil {
+		return a.broker, nil
+	}
+
+	releaseServiceConfig := a.releaseServiceConfig
+	if releaseServiceConfig == nil {
+		var err error
+		releaseServiceConfig, err = a.loader.GetReleaseServiceConfig(a.ctx, a.client,
+			v1alpha1.ReleaseServiceConfigResourceName, os.Getenv("SERVICE_NAMESPACE"))
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	if releaseServiceConfig == nil || releaseServiceConfig.Spec.CredentialsBroker == nil {
+		return nil, fmt.Errorf("the ReleasePlanAdmission requests registry credentials but %w", credentials.ErrNoBroker)
+	}
+
+	brokerConfig := releaseServiceConfig.Spec.CredentialsBroker
+	secret, err := a.loader.GetSecret(a.ctx, a.client, brokerConfig.Secret, releaseServiceConfig.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	broker, err := credentials.NewBroker(brokerConfig, string(secret.Data["token"]), http.DefaultClient)
+	if err != nil {
+		return nil, err
+	}
+	a.broker = broker
+
+	return a.broker, nil
+}
+
+// getRegistryCredentialsName returns the name of the registry credentials minted for the Release. It's derived from the
+// Release uid, so it's unique and only contains characters allowed in the names of robot accounts.
+func (a *adapter) getRegistryCredentialsName() string {
+	hash := sha256.Sum256([]byte(a.release.UID))
+
+	return "release_" + hex.EncodeToString(hash[:])[:16]
+}
+
+// getReleasedComponents returns the sorted names of the components the given Release releases from the given Snapshot,
+// which are the ones listed in its components annotation or every component of the Snapshot if it has none.
+func getReleasedComponents(release *v1alpha1.Release, snapshot *applicationapiv1alpha1.Snapshot) []string {
+	components, err := release.GetComponents()
+	if err != nil || len(components) == 0 {
+		components = []string{}
+		for _, component := range snapshot.Spec.Components {
+			components = append(components, component.Name)
+		}
+	}
+	slices.Sort(components)
+
+	return components
+}
+
+// getDeadlines returns the Release processing deadlines defined in the ReleaseServiceConfig.
+func (a *adapter) getDeadlines() v1alpha1.ReleaseDeadlines {
+	if a.releaseServiceConfig == nil || a.releaseServiceConfig.Spec.Deadlines == nil {
+		return v1alpha1.ReleaseDeadlines{}
+	}
+
+	return *a.releaseServiceConfig.Spec.Deadlines
+}
+
+// isPastDeadline returns true if the given deadline is set and has elapsed since the given start time.
+func (a *adapter) isPastDeadline(start time.Time, deadline *metav1.Duration) bool {
+	return deadline != nil && deadline.Duration > 0 && time.Since(start) > deadline.Duration
+}
+
+// ensurePipelineRunIsNotStalled checks whether the given Release PipelineRun stopped progressing according to the
+// StalledPipelineRunPolicy defined in the ReleaseServiceConfig. Stalled PipelineRuns are reported in the Release status
+// and, if the policy says so, created again until the retries are exhausted and cancelled afterward. While the
+// PipelineRun keeps progressing, the Stalled condition is cleared and the Release is requeued so the check is performed
+// again once the timeout is reached.
+func (a *adapter) ensurePipelineRunIsNotStalled(pipelineRun *tektonv1.PipelineRun, pipelineInfo *v1alpha1.PipelineInfo) (controller.OperationResult, error) {
+	if pipelineRun.IsDone() || pipelineRun.IsCancelled() {
+		return controller.ContinueProcessing()
+	}
+
+	policy := a.releaseServiceConfig.Spec.StalledPipelineRunPolicy
+	if policy == nil {
+		return controller.ContinueProcessing()
+	}
+
+	stalled, err := tekton.IsPipelineRunStalled(a.ctx, a.client, pipelineRun, policy.Timeout.Duration)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+	if !stalled {
+		if a.release.IsStalled() {
+			patch := client.MergeFrom(a.release.DeepCopy())
+			a.release.MarkUnstalled()
+			err = a.client.Status().Patch(a.ctx, a.release, patch)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+		}
+
+		lastProgressTime, err := tekton.GetLastProgressTime(a.ctx, a.client, pipelineRun)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+		return controller.RequeueAfter(time.Until(lastProgressTime.Add(policy.Timeout.Duration)), nil)
+	}
+
+	if a.release.IsStalled() && !policy.Cancel {
+		return controller.ContinueProcessing()
+	}
+
+	a.logger.Info("Release PipelineRun stalled",
+		"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.MarkStalled(a.redactor.Redact(getStalledPipelineRunDiagnostics(pipelineRun, policy.Timeout.Duration)))
+
+	if !policy.Cancel {
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	}
+
+	retry := pipelineInfo.Retries < policy.MaxRetries
+	if retry {
+		retry, err = a.consumeReleasePlanRetryBudget()
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+	}
+
+	if retry {
+		pipelineInfo.Retries++
+		pipelineInfo.PipelineRun = ""
+		err := a.client.Status().Patch(a.ctx, a.release, patch)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+
+		// Deleting the PipelineRun will make the processing operations create it again
+		err = a.cleanupProcessingResources(pipelineRun, nil)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+		err = a.client.Delete(a.ctx, pipelineRun)
+		if err != nil && !errors.IsNotFound(err) {
+			return controller.RequeueWithError(err)
+		}
+
+		return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+	}
+
+	err = a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	// Once cancelled, the PipelineRun will fail and the Release will be marked as failed when tracking its status
+	pipelineRunPatch := client.MergeFrom(pipelineRun.DeepCopy())
+	pipelineRun.Spec.Status = tektonv1.PipelineRunSpecStatusCancelled
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, pipelineRun, pipelineRunPatch))
+}
+
+// finalizeRelease will finalize the Release being processed, removing the associated resources. The pipelineRuns are optionally
+// deleted so that EnsureReleaseProcessingResourcesAreCleanedUp can call this and just remove the finalizers, but
+// EnsureFinalizersAreCalled will remove the finalizers and delete the pipelineRuns. If the pipelineRuns were deleted in
+// EnsureReleaseProcessingResourcesAreCleanedUp, they could be removed before all the tracking data is saved.
+func (a *adapter) finalizeRelease(delete bool) error {
+	// Cleanup Tenant Processing Resources
+	tenantPipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.TenantPipelineType)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	err = a.cleanupProcessingResources(tenantPipelineRun, nil)
+	if err != nil {
+		return err
+	}
+
+	if delete && tenantPipelineRun != nil {
+		err = a.client.Delete(a.ctx, tenantPipelineRun)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		metrics.RegisterGarbageCollectedObject("PipelineRun", metrics.GarbageCollectionReasonReleaseDeleted, false)
+	}
+
+	// Cleanup Managed Processing Resources. The PipelineRuns of fan-out Releases are cleaned up along with their targets
+	var managedPipelineRun *tektonv1.PipelineRun
+	if !a.release.IsFanOut() {
+		managedPipelineRun, err = a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.ManagedPipelineType)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	roleBinding, err := a.loader.GetRoleBindingFromReleaseStatus(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) && !strings.Contains(err.Error(), "valid reference to a RoleBinding") {
+		return err
+	}
+
+	err = a.cleanupProcessingResources(managedPipelineRun, roleBinding)
+	if err != nil {
+		return err
+	}
+
+	err = a.revokeReleaseRegistryCredentials(false)
+	if err != nil {
+		return err
+	}
+
+	err = a.finalizeTargets(delete)
+	if err != nil {
+		return err
+	}
+
+	err = a.releaseReleaseLocks()
+	if err != nil {
+		return err
+	}
+
+	if delete && managedPipelineRun != nil {
+		err = a.client.Delete(a.ctx, managedPipelineRun)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		metrics.RegisterGarbageCollectedObject("PipelineRun", metrics.GarbageCollectionReasonReleaseDeleted, false)
+	}
+
+	// Cleanup Verification Resources
+	if delete {
+		verificationPipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.VerificationPipelineType)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+
+		if verificationPipelineRun != nil {
+			err = a.client.Delete(a.ctx, verificationPipelineRun)
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			metrics.RegisterGarbageCollectedObject("PipelineRun", metrics.GarbageCollectionReasonReleaseDeleted, false)
+		}
+	}
+
+	a.logger.Info("Successfully finalized Release")
+
+	return nil
+}
+
+// finalizeTargets cleans up the processing resources of each target of a fan-out Release. The PipelineRuns of the
+// targets are also deleted if delete is true.
+func (a *adapter) finalizeTargets(delete bool) error {
+	for i := range a.release.Status.Targets {
+		pipelineRun, roleBinding, err := a.getTargetResources(&a.release.Status.Targets[i])
+		if err != nil {
+			return err
+		}
+
+		err = a.cleanupProcessingResources(pipelineRun, roleBinding)
+		if err != nil {
+			return err
+		}
+
+		if pipelineRun == nil {
+			continue
+		}
+
+		if delete {
+			err = a.client.Delete(a.ctx, pipelineRun)
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			metrics.RegisterGarbageCollectedObject("PipelineRun", metrics.GarbageCollectionReasonReleaseDeleted, false)
+		}
+	}
+
+	return nil
+}
+
+// getPipelineRef returns the Tekton PipelineRef of the given Pipeline for the given ReleasePlan. If the
+// ReleaseServiceConfig sets a pipeline mirror, the reference to the mirrored Pipeline will be returned instead, failing
+// if the Pipeline is not in the mirror catalog.
+func (a *adapter) getPipelineRef(pipeline *utils.Pipeline, releasePlan *v1alpha1.ReleasePlan) (*tektonv1.PipelineRef, error) {
+	pipelineRef := pipeline.GetTektonPipelineRef(releasePlan.Namespace, releasePlan.Name)
+	if a.releaseServiceConfig.Spec.PipelineMirror == nil {
+		return pipelineRef, nil
+	}
+
+	configMap, err := a.loader.GetConfigMap(a.ctx, a.client, a.releaseServiceConfig.Spec.PipelineMirror.ConfigMap,
+		a.releaseServiceConfig.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := utils.NewPipelineMirrorCatalog(configMap.Data[utils.PipelineMirrorCatalogKey])
+	if err != nil {
+		return nil, err
+	}
+
+	return catalog.GetMirroredPipelineRef(pipelineRef)
+}
+
+// getImageAnnotationsParams returns the param containing the JSON-encoded annotations the managed Pipeline should set
+// on the manifests of the images it releases if the ReleasePlanAdmission requests them: the namespaced name of the
+// Release, the time it started at and the managed namespace the images are released to.
+func (a *adapter) getImageAnnotationsParams(resources *loader.ProcessingResources) []tektonv1.Param {
+	if !resources.ReleasePlanAdmission.Spec.ImageAnnotations {
+		return nil
+	}
+
+	releaseDate := time.Now()
+	if a.release.Status.StartTime != nil {
+		releaseDate = a.release.Status.StartTime.Time
+	}
+
+	value, err := json.Marshal(map[string]string{
+		metadata.ImageReleaseAnnotation:       fmt.Sprintf("%s%c%s", a.release.Namespace, types.Separator, a.release.Name),
+		metadata.ImageReleaseDateAnnotation:   releaseDate.UTC().Format(time.RFC3339),
+		metadata.ImageReleaseTargetAnnotation: resources.ReleasePlanAdmission.Namespace,
+	})
+	if err != nil {
+		a.logger.Error(err, "Failed to serialize the image annotations")
+		return nil
+	}
+
+	return []tektonv1.Param{
+		{
+			Name: imageAnnotationsParamName,
+			Value: tektonv1.ParamValue{
+				Type:      tektonv1.ParamTypeString,
+				StringVal: string(value),
+			},
+		},
+	}
+}
+
+// getPlatformHintsParams returns the params containing the platforms each component image in the Snapshot is
+// available for if the ReleasePlanAdmission requests them. The registries are accessed with the pull secrets of the
+// given ServiceAccount of the managed Pipeline, so private images can be queried. Components whose platforms can't be
+// determined are left out of the params, so the managed Pipeline can fall back to querying the registry for them.
+func (a *adapter) getPlatformHintsParams(resources *loader.ProcessingResources, serviceAccountName, namespace string) []tektonv1.Param {
+	if !resources.ReleasePlanAdmission.Spec.PlatformHints || a.platformsGetter == nil || resources.Snapshot == nil {
+		return nil
+	}
+
+	keychain := platforms.NewKeychain(a.getPullSecrets(serviceAccountName, namespace))
+	componentPlatforms, err := platforms.GetComponentPlatforms(a.ctx, a.platformsGetter, keychain, resources.Snapshot)
+	if err != nil {
+		a.logger.Error(err, "Failed to get the platforms of some components")
+	}
+
+	value, err := platforms.ToJson(componentPlatforms)
+	if err != nil {
+		a.logger.Error(err, "Failed to serialize the platforms of the components")
+		return nil
+	}
+
+	return []tektonv1.Param{
+		{
+			Name: platforms.ParamName,
+			Value: tektonv1.ParamValue{
+				Type:      tektonv1.ParamTypeString,
+				StringVal: value,
+			},
+		},
+	}
+}
+
+// getPullSecrets returns the image pull secrets and the secrets of the given ServiceAccount, like the ones Tekton uses
+// to pull the images of the Pipelines it runs. If no ServiceAccount is given, the default one is used. The ServiceAccount
+// and secrets that can't be read are ignored, so the registries are accessed anonymously instead.
+func (a *adapter) getPullSecrets(serviceAccountName, namespace string) []corev1.Secret {
+	if serviceAccountName == "" {
+		serviceAccountName = "default"
+	}
+
+	serviceAccount, err := a.loader.GetServiceAccount(a.ctx, a.client, serviceAccountName, namespace)
+	if err != nil {
+		a.logger.Error(err, "Failed to get the ServiceAccount of the managed Pipeline", "ServiceAccount.Name",
+			serviceAccountName)
+		return nil
+	}
+
+	var secretNames []string
+	for _, reference := range serviceAccount.ImagePullSecrets {
+		secretNames = append(secretNames, reference.Name)
+	}
+	for _, reference := range serviceAccount.Secrets {
+		secretNames = append(secretNames, reference.Name)
+	}
+
+	var secrets []corev1.Secret
+	for _, secretName := range secretNames {
+		secret, err := a.loader.GetSecret(a.ctx, a.client, secretName, namespace)
+		if err == nil {
+			secrets = append(secrets, *secret)
+		}
+	}
+
+	return secrets
+}
+
+// getQueueTime returns the time the given Release started waiting to run its managed pipeline. Releases that are not
+// queued yet are considered to be queued now.
+func getQueueTime(release *v1alpha1.Release) time.Time {
+	queuedTime := release.GetQueuedTime()
+	if queuedTime == nil {
+		return time.Now()
+	}
+
+	return queuedTime.Time
+}
+
+// getReleaseLockName returns the name of the Lease used as release lock for the given application and target.
+func getReleaseLockName(application, target string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(application + "/" + target))
+
+	return fmt.Sprintf("%s%s-%08x", releaseLockPrefix, application, hash.Sum32())
+}
+
+// getProvenanceAnnotations returns the Pipelines as Code annotations describing the source the given Snapshot was built
+// from, so they can be passed to the Release PipelineRuns and end up in the provenance generated by Tekton Chains. The
+// annotations of the Release are kept for the keys not found in the Snapshot.
+func (a *adapter) getProvenanceAnnotations(snapshot *applicationapiv1alpha1.Snapshot) map[string]string {
+	annotations := metadata.GetAnnotationsWithPrefix(a.release, integrationgitops.PipelinesAsCodePrefix)
+	if snapshot != nil {
+		for key, value := range metadata.GetAnnotationsWithPrefix(snapshot, integrationgitops.PipelinesAsCodePrefix) {
+			annotations[key] = value
+		}
+	}
+
+	return annotations
+}
+
+// getCapacityShortage returns a message describing why the given namespace has no room for the given resource
+// requests, or an empty string if they fit. The requests fit if every ResourceQuota tracking them has enough unused
+// room left and no LimitRange caps the pods of the namespace below them.
+func (a *adapter) getCapacityShortage(namespace string, requests corev1.ResourceList) (string, error) {
+	if len(requests) == 0 {
+		return "", nil
+	}
+
+	names := make([]corev1.ResourceName, 0, len(requests))
+	for name := range requests {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	resourceQuotas, err := a.loader.GetResourceQuotas(a.ctx, a.client, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	for _, resourceQuota := range resourceQuotas.Items {
+		for _, name := range names {
+			request := requests[name]
+			for _, quotaName := range []corev1.ResourceName{name, "requests." + name} {
+				hard, found := resourceQuota.Status.Hard[quotaName]
+				if !found {
+					continue
+				}
+
+				available := hard.DeepCopy()
+				available.Sub(resourceQuota.Status.Used[quotaName])
+				if request.Cmp(available) > 0 {
+					return fmt.Sprintf("the %s request of %s exceeds the %s left in ResourceQuota %s", name,
+						request.String(), available.String(), resourceQuota.Name), nil
+				}
+			}
+		}
+	}
+
+	limitRanges, err := a.loader.GetLimitRanges(a.ctx, a.client, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	for _, limitRange := range limitRanges.Items {
+		for _, limit := range limitRange.Spec.Limits {
+			if limit.Type != corev1.LimitTypePod {
+				continue
+			}
+
+			for _, name := range names {
+				request := requests[name]
+				if maximum, found := limit.Max[name]; found && request.Cmp(maximum) > 0 {
+					return fmt.Sprintf("the %s request of %s exceeds the pod maximum of %s in LimitRange %s", name,
+						request.String(), maximum.String(), limitRange.Name), nil
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// getEmptyReleaseServiceConfig creates and returns an empty ReleaseServiceConfig resource.
+func (a *adapter) getEmptyReleaseServiceConfig(namespace string) *v1alpha1.ReleaseServiceConfig {
+	releaseServiceConfig := &v1alpha1.ReleaseServiceConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.ReleaseServiceConfigResourceName,
+			Namespace: namespace,
+		},
+	}
+	releaseServiceConfig.Kind = "ReleaseServiceConfig"
+	return releaseServiceConfig
+}
+
+// getFanOutReleasePlanAdmissions returns the active ReleasePlanAdmissions matching the given ReleasePlan, which are
+// the targets of a fan-out Release. ReleasePlanAdmissions with the auto-release label set to false are left out.
+func (a *adapter) getFanOutReleasePlanAdmissions(releasePlan *v1alpha1.ReleasePlan) ([]v1alpha1.ReleasePlanAdmission, error) {
+	releasePlanAdmissions, err := a.loader.GetMatchingReleasePlanAdmissions(a.ctx, a.client, releasePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	var activeReleasePlanAdmissions []v1alpha1.ReleasePlanAdmission
+	for _, releasePlanAdmission := range releasePlanAdmissions.Items {
+		if releasePlanAdmission.GetLabels()[metadata.AutoReleaseLabel] != "false" {
+			activeReleasePlanAdmissions = append(activeReleasePlanAdmissions, releasePlanAdmission)
+		}
+	}
+
+	return activeReleasePlanAdmissions, nil
+}
+
+// getFanOutPipelineRun returns the managed Release PipelineRun created for the given target ReleasePlanAdmission of the
+// fan-out Release being processed, found by its tracking labels and the ReleasePlanAdmission it references. If none is
+// found, nil will be returned.
+func (a *adapter) getFanOutPipelineRun(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*tektonv1.PipelineRun, error) {
+	executionNamespace, err := releasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace)
+	labels[metadata.PipelinesTypeLabel] = metadata.ManagedPipelineType
+
+	pipelineRuns := &tektonv1.PipelineRunList{}
+	err = a.client.List(a.ctx, pipelineRuns, client.InNamespace(executionNamespace), client.MatchingLabels(labels))
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name)
+	for i := range pipelineRuns.Items {
+		for _, param := range pipelineRuns.Items[i].Spec.Params {
+			if param.Name == "releasePlanAdmission" && param.Value.StringVal == name {
+				return &pipelineRuns.Items[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getFanOutTargetRejection evaluates the gates of the given target ReleasePlanAdmission of the fan-out Release being
+// processed that can't pass by waiting: the change record it requires, its release schedule and its execution
+// namespace. A message describing why the target is rejected is returned, or an empty string if it isn't.
+func (a *adapter) getFanOutTargetRejection(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) string {
+	if releasePlanAdmission.Spec.RequireChangeRecord {
+		data, err := a.release.GetData()
+		if err != nil {
+			return err.Error()
+		}
+		if data.ChangeRequest == nil {
+			return fmt.Sprintf("ReleasePlanAdmission %s requires releases to reference a change record",
+				releasePlanAdmission.Name)
+		}
+	}
+
+	if releasePlanAdmission.Spec.ReleaseSchedule != nil {
+		_, err := releasePlanAdmission.Spec.ReleaseSchedule.GetNextOpening(time.Now())
+		if err != nil {
+			return fmt.Sprintf("invalid release schedule: %s", err)
+		}
+	}
+
+	_, err := releasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+	if err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
+
+// getFanOutTargetWait evaluates the gates of the given target ReleasePlanAdmission of the fan-out Release being
+// processed that make the target wait: the two-person review, the fair scheduling and capacity of the target namespace
+// and the release window. A message describing the gate the target waits for is returned along with the time to wait,
+// which is zero if the dependency backoff applies. An empty message is returned once all the gates pass.
+func (a *adapter) getFanOutTargetWait(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (string, time.Duration, error) {
+	if releasePlanAdmission.Spec.RequireTwoPersonReview {
+		approver := a.release.GetAnnotations()[metadata.ApprovedByAnnotation]
+		if approver == "" || approver == a.release.Status.Attribution.Author {
+			return fmt.Sprintf("a user other than the author has to approve the Release setting the %s annotation to true",
+				metadata.ApprovedAnnotation), 0, nil
+		}
+	}
+
+	scheduled, err := a.isManagedPipelineScheduled(releasePlanAdmission.Namespace)
+	if err != nil {
+		return "", 0, err
+	}
+	if !scheduled {
+		return "waiting for capacity to run the managed pipeline in the target namespace", 0, nil
+	}
+
+	// Targets without a managed Pipeline fail once their PipelineRun is created, so they don't wait for capacity
+	pipeline, err := releasePlanAdmission.GetPipeline(a.release.Spec.Strategy)
+	if err == nil && pipeline != nil {
+		executionNamespace, err := releasePlanAdmission.GetExecutionNamespace(a.release.Namespace)
+		if err != nil {
+			return "", 0, err
+		}
+
+		shortage, err := a.getCapacityShortage(executionNamespace, pipeline.ResourceRequests)
+		if err != nil {
+			return "", 0, err
+		}
+		if shortage != "" {
+			return shortage, 0, nil
+		}
+	}
+
+	if releasePlanAdmission.Spec.ReleaseSchedule != nil {
+		now := time.Now()
+		nextOpening, err := releasePlanAdmission.Spec.ReleaseSchedule.GetNextOpening(now)
+		if err != nil {
+			return "", 0, err
+		}
+
+		if nextOpening.After(now) {
+			if !a.release.IsEmergencyBypassActive() {
+				return fmt.Sprintf("waiting for the next release window to open at %s", nextOpening.Format(time.RFC3339)),
+					time.Until(nextOpening), nil
+			}
+			a.recordEmergencyBypass("release window")
+		}
+	}
+
+	return "", 0, nil
+}
+
+// getQueuedRelease returns the Release with the given name in the namespace of the Release being processed if it's
+// still queued waiting for a release lock. If the Release doesn't exist or is no longer queued, nil will be returned.
+func (a *adapter) getQueuedRelease(name string) (*v1alpha1.Release, error) {
+	release, err := a.loader.GetRelease(a.ctx, a.client, name, a.release.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !release.IsQueued() || release.HasManagedPipelineProcessingFinished() {
+		return nil, nil
+	}
+
+	return release, nil
+}
+
+// getRollbackSnapshot returns the name of the Snapshot most recently released by a Release of the same ReleasePlan as
+// the Release being processed, ignoring the Snapshot of that Release. If there's none, an empty string is returned.
+func (a *adapter) getRollbackSnapshot() (string, error) {
+	releases, err := a.loader.GetReleases(a.ctx, a.client, a.release.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	var previousRelease *v1alpha1.Release
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Spec.ReleasePlan != a.release.Spec.ReleasePlan || release.Spec.Snapshot == a.release.Spec.Snapshot ||
+			!release.IsReleased() || release.Status.CompletionTime == nil {
+			continue
+		}
+
+		if previousRelease == nil || release.Status.CompletionTime.After(previousRelease.Status.CompletionTime.Time) {
+			previousRelease = release
+		}
+	}
+
+	if previousRelease == nil {
+		return "", nil
+	}
+
+	return previousRelease.Spec.Snapshot, nil
+}
+
+// getComponentsParams returns the managed Pipeline parameter listing the Snapshot components the Release releases, so
+// the Pipeline can release only them. No parameter is returned if the Release releases the whole Snapshot.
+func (a *adapter) getComponentsParams() []tektonv1.Param {
+	components, err := a.release.GetComponents()
+	if err != nil || len(components) == 0 {
+		return nil
+	}
+
+	return []tektonv1.Param{
+		{
+			Name: componentsParamName,
+			Value: tektonv1.ParamValue{
+				Type:     tektonv1.ParamTypeArray,
+				ArrayVal: components,
+			},
+		},
+	}
+}
+
+// getFollowUpReleaseName returns the name of the follow-up Release retrying the failed components of the Release being
+// processed.
+func (a *adapter) getFollowUpReleaseName() string {
+	return fmt.Sprintf("%s-retry", a.release.Name)
+}
+
+// getReleaseLinkParams returns the managed Pipeline parameters identifying the Release, so notification tasks can link
+// back to it: its name, namespace and tenant workspace and, if the ReleaseServiceConfig sets a console URL, its URL in
+// the console for the given application.
+func (a *adapter) getReleaseLinkParams(application string) []tektonv1.Param {
+	workspace := strings.TrimSuffix(a.release.Namespace, tenantNamespaceSuffix)
+	values := [][2]string{
+		{releaseNameParamName, a.release.Name},
+		{releaseNamespaceParamName, a.release.Namespace},
+		{tenantWorkspaceParamName, workspace},
+	}
+
+	if a.releaseServiceConfig != nil && a.releaseServiceConfig.Spec.ConsoleURL != "" {
+		releaseURL := strings.NewReplacer(
+			"{application}", application,
+			"{namespace}", a.release.Namespace,
+			"{release}", a.release.Name,
+			"{workspace}", workspace,
+		).Replace(a.releaseServiceConfig.Spec.ConsoleURL)
+		values = append(values, [2]string{releaseURLParamName, releaseURL})
+	}
+
+	params := make([]tektonv1.Param, 0, len(values))
+	for _, value := range values {
+		params = append(params, tektonv1.Param{
+			Name: value[0],
+			Value: tektonv1.ParamValue{
+				Type:      tektonv1.ParamTypeString,
+				StringVal: value[1],
+			},
+		})
+	}
+
+	return params
+}
+
+// getReleasePlanAdmissionDefaultsParams returns the managed Pipeline parameter referencing the
+// ReleasePlanAdmissionDefaults applied to the ReleasePlanAdmission in the given processing resources, so tasks reading
+// the ReleasePlanAdmission from the cluster can merge the default data too. If no defaults were applied, no parameter is
+// returned.
+func (a *adapter) getReleasePlanAdmissionDefaultsParams(resources *loader.ProcessingResources) []tektonv1.Param {
+	if resources.ReleasePlanAdmissionDefaults == nil {
+		return nil
+	}
+
+	return []tektonv1.Param{
+		{
+			Name: releasePlanAdmissionDefaultsParamName,
+			Value: tektonv1.ParamValue{
+				Type: tektonv1.ParamTypeString,
+				StringVal: resources.ReleasePlanAdmissionDefaults.Namespace + "/" +
+					resources.ReleasePlanAdmissionDefaults.Name,
+			},
+		},
+	}
+}
+
+// getSkippedTasksParams returns the managed Pipeline parameter listing the tasks skipped by the Release, so the
+// Pipeline can skip them using when expressions. No parameter is returned if the Release doesn't skip any task.
+func (a *adapter) getSkippedTasksParams() []tektonv1.Param {
+	skippedTasks, err := a.release.GetSkippedTasks()
+	if err != nil || len(skippedTasks) == 0 {
+		return nil
+	}
+
+	return []tektonv1.Param{
+		{
+			Name: skipTasksParamName,
+			Value: tektonv1.ParamValue{
+				Type:     tektonv1.ParamTypeArray,
+				ArrayVal: skippedTasks,
+			},
+		},
+	}
+}
+
+// getTargetResources returns the managed Release PipelineRun and RoleBinding registered for the given target of a
+// fan-out Release. Resources that were not created or don't exist anymore are returned as nil.
+func (a *adapter) getTargetResources(target *v1alpha1.TargetInfo) (*tektonv1.PipelineRun, *rbac.RoleBinding, error) {
+	var pipelineRun *tektonv1.PipelineRun
+	if target.PipelineRun != "" {
+		pipelineRun = &tektonv1.PipelineRun{}
+		err := a.getObjectFromNamespacedName(target.PipelineRun, pipelineRun)
+		if errors.IsNotFound(err) {
+			pipelineRun = nil
+		} else if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var roleBinding *rbac.RoleBinding
+	if target.RoleBinding != "" {
+		roleBinding = &rbac.RoleBinding{}
+		err := a.getObjectFromNamespacedName(target.RoleBinding, roleBinding)
+		if errors.IsNotFound(err) {
+			roleBinding = nil
+		} else if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return pipelineRun, roleBinding, nil
+}
+
+// getObjectFromNamespacedName gets the object with the given namespaced name (e.g. "namespace/name") into the given
+// object. An error is returned if the namespaced name is not valid or the Get operation fails.
+func (a *adapter) getObjectFromNamespacedName(namespacedName string, object client.Object) error {
+	parts := strings.Split(namespacedName, string(types.Separator))
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid namespaced name '%s'", namespacedName)
+	}
+
+	return a.client.Get(a.ctx, types.NamespacedName{Namespace: parts[0], Name: parts[1]}, object)
+}
+
+// getStrategyValidationCause returns the ValidationCause reported when the strategy selected by the Release can't be
+// found in the ReleasePlanAdmission.
+func (a *adapter) getStrategyValidationCause(err error) v1alpha1.ValidationCause {
+	return v1alpha1.ValidationCause{
+		DocsKey: "release.strategy",
+		Field:   "spec.strategy",
+		Hint:    "select one of the strategies defined in the ReleasePlanAdmission or unset the strategy",
+		Message: err.Error(),
+		Reason:  metav1.CauseTypeFieldValueNotFound,
+	}
+}
+
+// getDataWithPropagatedResults returns the given data after adding the given results to it. Values already present in
+// the data take precedence over the results.
+func getDataWithPropagatedResults(data, results *runtime.RawExtension) (*runtime.RawExtension, error) {
+	merged := map[string]interface{}{}
+	if err := json.Unmarshal(results.Raw, &merged); err != nil {
+		return nil, err
+	}
+
+	if data != nil && len(data.Raw) > 0 {
+		dataMap := map[string]interface{}{}
+		if err := json.Unmarshal(data.Raw, &dataMap); err != nil {
+			return nil, err
+		}
+		mergeMaps(merged, dataMap)
+	}
+
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// getStalledPipelineRunDiagnostics returns a message describing why the given PipelineRun is considered stalled,
+// including the last reason and message reported by the PipelineRun if any.
+func getStalledPipelineRunDiagnostics(pipelineRun *tektonv1.PipelineRun, timeout time.Duration) string {
+	message := fmt.Sprintf("PipelineRun %s%c%s has not progressed in %s", pipelineRun.Namespace, types.Separator,
+		pipelineRun.Name, timeout)
+
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition != nil {
+		message += fmt.Sprintf(" (reason: %s, message: %s)", condition.Reason, condition.Message)
+	}
+
+	return message
+}
+
+// isManagedPipelineScheduled checks whether the Release being processed can start its managed pipeline in the given
+// target namespace according to the ManagedPipelineSchedulingPolicy of the ReleaseServiceConfig. Once the target
+// reaches its concurrency limit, Releases waiting for capacity are started using weighted fair queuing: the next one
+// to start belongs to the origin namespace with the fewest running managed pipelines relative to the share set in its
+// ReleasePlanAdmission, and Releases of the same origin start in the order they were queued.
+func (a *adapter) isManagedPipelineScheduled(target string) (bool, error) {
+	policy := a.releaseServiceConfig.Spec.ManagedPipelineSchedulingPolicy
+	if policy == nil {
+		return true, nil
+	}
+
+	releases, err := a.loader.GetReleasesWithTarget(a.ctx, a.client, target)
+	if err != nil {
+		return false, err
+	}
+
+	running := make(map[string]int)
+	runningTotal := 0
+	var waiting []*v1alpha1.Release
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if (release.Name == a.release.Name && release.Namespace == a.release.Namespace) || release.HasReleaseFinished() {
+			continue
+		}
+
+		if release.IsManagedPipelineProcessing() {
+			running[release.Namespace]++
+			runningTotal++
+		} else if release.IsAwaitingCapacity() {
+			waiting = append(waiting, release)
+		}
+	}
+
+	if runningTotal >= policy.MaxConcurrentPipelineRuns {
+		return false, nil
+	}
+	if len(waiting) == 0 {
+		return true, nil
+	}
+
+	releasePlanAdmissions, err := a.loader.GetReleasePlanAdmissions(a.ctx, a.client, target)
+	if err != nil {
+		return false, err
+	}
+
+	shares := make(map[string]int)
+	for i := range releasePlanAdmissions.Items {
+		releasePlanAdmission := &releasePlanAdmissions.Items[i]
+		if releasePlanAdmission.GetShare() > shares[releasePlanAdmission.Spec.Origin] {
+			shares[releasePlanAdmission.Spec.Origin] = releasePlanAdmission.GetShare()
+		}
+	}
+
+	next := a.release
+	for _, release := range waiting {
+		if hasSchedulingPriority(release, next, running, shares) {
+			next = release
 		}
 	}
 
-	return nil
+	return next == a.release, nil
 }
 
-// createManagedPipelineRun creates and returns a new managed Release PipelineRun. The new PipelineRun will include owner
-// annotations, so it triggers Release reconciles whenever it changes. The Pipeline information and the parameters to it
-// will be extracted from the given ReleasePlanAdmission. The Release's Snapshot will also be passed to the release
-// PipelineRun.
-func (a *adapter) createManagedPipelineRun(resources *loader.ProcessingResources) (*tektonv1.PipelineRun, error) {
-	pipelineRun, err := utils.NewPipelineRunBuilder(metadata.ManagedPipelineType, resources.ReleasePlanAdmission.Namespace).
-		WithAnnotations(metadata.GetAnnotationsWithPrefix(a.release, integrationgitops.PipelinesAsCodePrefix)).
-		WithFinalizer(metadata.ReleaseFinalizer).
-		WithLabels(map[string]string{
-			metadata.ApplicationNameLabel:  resources.ReleasePlan.Spec.Application,
-			metadata.PipelinesTypeLabel:    metadata.ManagedPipelineType,
-			metadata.ReleaseNameLabel:      a.release.Name,
-			metadata.ReleaseNamespaceLabel: a.release.Namespace,
-			metadata.ReleaseSnapshotLabel:  a.release.Spec.Snapshot,
-		}).
-		WithObjectReferences(a.release, resources.ReleasePlan, resources.ReleasePlanAdmission, a.releaseServiceConfig,
-			resources.Snapshot).
-		WithObjectSpecsAsJson(resources.EnterpriseContractPolicy).
-		WithOwner(a.release).
-		WithParamsFromConfigMap(resources.EnterpriseContractConfigMap, []string{"verify_ec_task_bundle"}).
-		WithPipelineRef(resources.ReleasePlanAdmission.Spec.Pipeline.PipelineRef.ToTektonPipelineRef()).
-		WithServiceAccount(resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName).
-		WithTimeouts(&resources.ReleasePlanAdmission.Spec.Pipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts).
-		WithWorkspaceFromVolumeTemplate(
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
-		).
-		Build()
+// mergeMaps recursively copies the values of src into dst, replacing any value already present in dst unless both
+// values are maps, in which case they are merged.
+func mergeMaps(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeMaps(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
 
+// preemptRelease marks the given queued Release as preempted by the urgent Release being processed, which took its
+// place as the next holder of the release lock for the given target.
+func (a *adapter) preemptRelease(release *v1alpha1.Release, target string) error {
+	patch := client.MergeFrom(release.DeepCopy())
+	release.MarkPreempted(fmt.Sprintf("preempted by the urgent Release %s", a.release.Name))
+	err := a.client.Status().Patch(a.ctx, release, patch)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	err = a.client.Create(a.ctx, pipelineRun)
-	if err != nil {
-		return nil, err
+	a.logger.Info("Preempted queued Release", "Release.Name", release.Name)
+	if a.recorder != nil {
+		a.recorder.Eventf(release, corev1.EventTypeNormal, "Preempted",
+			"Preempted by the urgent Release %s", a.release.Name)
+		a.recorder.Eventf(a.release, corev1.EventTypeNormal, "Preempting",
+			"Took the place of the queued Release %s", release.Name)
 	}
+	metrics.RegisterPreemptedRelease(target)
 
-	return pipelineRun, nil
+	return nil
 }
 
-// createTenantPipelineRun creates and returns a new tenant Release PipelineRun. The new PipelineRun will include owner
-// annotations, so it triggers Release reconciles whenever it changes. The Pipeline information and the parameters to it
-// will be extracted from the given ReleasePlan. The Release's Snapshot will also be passed to the release
-// PipelineRun.
-func (a *adapter) createTenantPipelineRun(releasePlan *v1alpha1.ReleasePlan, snapshot *applicationapiv1alpha1.Snapshot) (*tektonv1.PipelineRun, error) {
-	pipelineRun, err := utils.NewPipelineRunBuilder(metadata.TenantPipelineType, releasePlan.Namespace).
-		WithAnnotations(metadata.GetAnnotationsWithPrefix(a.release, integrationgitops.PipelinesAsCodePrefix)).
-		WithFinalizer(metadata.ReleaseFinalizer).
-		WithLabels(map[string]string{
-			metadata.ApplicationNameLabel:  releasePlan.Spec.Application,
-			metadata.PipelinesTypeLabel:    metadata.TenantPipelineType,
-			metadata.ReleaseNameLabel:      a.release.Name,
-			metadata.ReleaseNamespaceLabel: a.release.Namespace,
-			metadata.ReleaseSnapshotLabel:  a.release.Spec.Snapshot,
-		}).
-		WithObjectReferences(a.release, releasePlan, snapshot).
-		WithParams(releasePlan.Spec.Pipeline.GetTektonParams()...).
-		WithOwner(a.release).
-		WithPipelineRef(releasePlan.Spec.Pipeline.PipelineRef.ToTektonPipelineRef()).
-		WithServiceAccount(releasePlan.Spec.Pipeline.ServiceAccountName).
-		WithTimeouts(&releasePlan.Spec.Pipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts).
-		WithWorkspaceFromVolumeTemplate(
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
-		).
-		Build()
+// processFanOutTarget processes the given pending target of the fan-out Release being processed, updating it in place.
+// The target stays pending while the gates of its ReleasePlanAdmission make it wait, returning the time to wait, and
+// fails if they reject the Release. Once they pass, the managed Release PipelineRun of the target is created, unless a
+// previous attempt created it but failed to register it, in which case it's reused.
+func (a *adapter) processFanOutTarget(resources *loader.ProcessingResources, releasePlanAdmission *v1alpha1.ReleasePlanAdmission, target *v1alpha1.TargetInfo) (time.Duration, error) {
+	if message := a.getFanOutTargetRejection(releasePlanAdmission); message != "" {
+		target.CompletionTime = &metav1.Time{Time: time.Now()}
+		target.Message = a.redactor.Redact(message)
+		target.Phase = v1alpha1.TargetPhaseFailed
+		return 0, nil
+	}
 
+	message, delay, err := a.getFanOutTargetWait(releasePlanAdmission)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	if message != "" {
+		a.logger.Info("Waiting for the gates of a target to pass", "ReleasePlanAdmission", target.ReleasePlanAdmission,
+			"Reason", message)
+		target.Message = message
+		return delay, nil
 	}
 
-	err = a.client.Create(a.ctx, pipelineRun)
+	pipelineRun, err := a.getFanOutPipelineRun(releasePlanAdmission)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return pipelineRun, nil
-}
+	var roleBinding *rbac.RoleBinding
+	if pipelineRun == nil {
+		pipelineRun, roleBinding, err = a.createFanOutPipelineRun(resources, releasePlanAdmission)
+		if err != nil {
+			a.logger.Error(err, "Unable to create the managed Release PipelineRun of a target",
+				"ReleasePlanAdmission", target.ReleasePlanAdmission)
+			target.CompletionTime = &metav1.Time{Time: time.Now()}
+			target.Message = a.redactor.Redact(err.Error())
+			target.Phase = v1alpha1.TargetPhaseFailed
+			return 0, nil
+		}
 
-// createRoleBindingForClusterRole creates a RoleBinding that binds the serviceAccount from the passed
-// ReleasePlanAdmission to the passed ClusterRole. If the creation fails, the error is returned. If the creation
-// is successful, the RoleBinding is returned.
-func (a *adapter) createRoleBindingForClusterRole(clusterRole string, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*rbac.RoleBinding, error) {
-	roleBinding := &rbac.RoleBinding{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: fmt.Sprintf("%s-rolebinding-for-%s-", a.release.Name, clusterRole),
-			Namespace:    releasePlanAdmission.Spec.Origin,
-		},
-		RoleRef: rbac.RoleRef{
-			APIGroup: rbac.GroupName,
-			Kind:     "ClusterRole",
-			Name:     clusterRole,
-		},
-		Subjects: []rbac.Subject{
-			{
-				Kind:      "ServiceAccount",
-				Name:      releasePlanAdmission.Spec.Pipeline.ServiceAccountName,
-				Namespace: releasePlanAdmission.Namespace,
-			},
-		},
+		a.logger.Info(fmt.Sprintf("Created %s Release PipelineRun", metadata.ManagedPipelineType),
+			"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
 	}
 
-	// Set ownerRef so it is deleted if the Release is deleted
-	err := ctrl.SetControllerReference(a.release, roleBinding, a.client.Scheme())
-	if err != nil {
-		return nil, err
+	target.Message = ""
+	target.Phase = v1alpha1.TargetPhaseProgressing
+	target.PipelineRun = fmt.Sprintf("%s%c%s", pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+	if roleBinding != nil {
+		target.RoleBinding = fmt.Sprintf("%s%c%s", roleBinding.Namespace, types.Separator, roleBinding.Name)
 	}
+	target.StartTime = &metav1.Time{Time: time.Now()}
 
-	err = a.client.Create(a.ctx, roleBinding)
-	if err != nil {
-		return nil, err
+	return 0, nil
+}
+
+// queueOnReleaseLock queues the Release being processed on the given release lock by setting it as the next holder
+// if no other queued Release is next in line. If preemption is enabled, an urgent Release takes the place of a normal
+// Release next in line, which is preempted back to the queue.
+func (a *adapter) queueOnReleaseLock(lease *coordinationv1.Lease, target string, preemption bool) error {
+	nextHolder := lease.Annotations[metadata.ReleaseLockNextHolderAnnotation]
+	if nextHolder == a.release.Name {
+		return nil
 	}
 
-	return roleBinding, nil
-}
+	var preempted *v1alpha1.Release
+	if nextHolder != "" {
+		queuedRelease, err := a.getQueuedRelease(nextHolder)
+		if err != nil {
+			return err
+		}
+
+		if queuedRelease != nil {
+			if !preemption || !a.release.IsUrgent() || queuedRelease.IsUrgent() {
+				return nil
+			}
+			preempted = queuedRelease
+		}
+	}
+
+	metadata.AddAnnotations(lease, map[string]string{metadata.ReleaseLockNextHolderAnnotation: a.release.Name})
+	err := a.client.Update(a.ctx, lease)
+	if err != nil {
+		if errors.IsConflict(err) {
+			return nil
+		}
 
-// finalizeRelease will finalize the Release being processed, removing the associated resources. The pipelineRuns are optionally
-// deleted so that EnsureReleaseProcessingResourcesAreCleanedUp can call this and just remove the finalizers, but
-// EnsureFinalizersAreCalled will remove the finalizers and delete the pipelineRuns. If the pipelineRuns were deleted in
-// EnsureReleaseProcessingResourcesAreCleanedUp, they could be removed before all the tracking data is saved.
-func (a *adapter) finalizeRelease(delete bool) error {
-	// Cleanup Tenant Processing Resources
-	tenantPipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.TenantPipelineType)
-	if err != nil && !errors.IsNotFound(err) {
 		return err
 	}
 
-	err = a.cleanupProcessingResources(tenantPipelineRun, nil)
+	if preempted != nil {
+		return a.preemptRelease(preempted, target)
+	}
+
+	return nil
+}
+
+// releaseReleaseLocks deletes the release locks held by the Release being processed, so other Releases of the same
+// application to the same target can acquire them. Locks with a queued Release next in line are kept, only clearing
+// their holder, so the queued Release keeps its place.
+func (a *adapter) releaseReleaseLocks() error {
+	leases := &coordinationv1.LeaseList{}
+	err := a.client.List(a.ctx, leases, client.InNamespace(a.release.Namespace))
 	if err != nil {
 		return err
 	}
 
-	if delete && tenantPipelineRun != nil {
-		err = a.client.Delete(a.ctx, tenantPipelineRun)
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+		if !strings.HasPrefix(lease.Name, releaseLockPrefix) ||
+			lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != a.release.Name {
+			continue
+		}
+
+		if lease.Annotations[metadata.ReleaseLockNextHolderAnnotation] != "" {
+			lease.Spec.HolderIdentity = nil
+			err = a.client.Update(a.ctx, lease)
+		} else {
+			err = a.client.Delete(a.ctx, lease)
+		}
 		if err != nil && !errors.IsNotFound(err) {
 			return err
 		}
 	}
 
-	// Cleanup Managed Processing Resources
-	managedPipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.ManagedPipelineType)
-	if err != nil && !errors.IsNotFound(err) {
+	return nil
+}
+
+// revokeRegistryCredentials revokes the registry credentials stored in the given Secret and deletes it, so they can't
+// be used once the Release no longer needs them. The finalizer of the Secret is removed once they are revoked. If no
+// credentials broker is configured anymore, the credentials can't be revoked, so the finalizer is just removed.
+func (a *adapter) revokeRegistryCredentials(secret *corev1.Secret) error {
+	broker, err := a.getCredentialsBroker()
+	if err == nil {
+		err = broker.Revoke(a.ctx, &credentials.Credentials{
+			ID:       secret.Annotations[metadata.RegistryCredentialsAnnotation],
+			Registry: string(secret.Data["registry"]),
+			Username: string(secret.Data["username"]),
+			Password: string(secret.Data["password"]),
+		})
+	}
+	if stderrors.Is(err, credentials.ErrNoBroker) {
+		a.logger.Info("Unable to revoke registry credentials as no credentials broker is configured",
+			"Secret.Name", secret.Name, "Secret.Namespace", secret.Namespace)
+	} else if err != nil {
 		return err
 	}
 
-	roleBinding, err := a.loader.GetRoleBindingFromReleaseStatus(a.ctx, a.client, a.release)
-	if err != nil && !errors.IsNotFound(err) && !strings.Contains(err.Error(), "valid reference to a RoleBinding") {
-		return err
+	if controllerutil.ContainsFinalizer(secret, metadata.ReleaseFinalizer) {
+		patch := client.MergeFrom(secret.DeepCopy())
+		controllerutil.RemoveFinalizer(secret, metadata.ReleaseFinalizer)
+		err = a.client.Patch(a.ctx, secret, patch)
+		if err != nil {
+			return client.IgnoreNotFound(err)
+		}
 	}
 
-	err = a.cleanupProcessingResources(managedPipelineRun, roleBinding)
+	if secret.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	return client.IgnoreNotFound(a.client.Delete(a.ctx, secret))
+}
+
+// revokeReleaseRegistryCredentials revokes the registry credentials minted for the Release, deleting the Secrets holding
+// them. The Secrets are found by their tracking labels, so the credentials are revoked even if the PipelineRuns they
+// were bound to don't exist anymore. If onlyDeleted is true, only the credentials whose Secrets are being deleted are
+// revoked.
+func (a *adapter) revokeReleaseRegistryCredentials(onlyDeleted bool) error {
+	secrets := &corev1.SecretList{}
+	err := a.client.List(a.ctx, secrets,
+		client.MatchingLabels(metadata.GetReleaseTrackingLabels(a.release.Name, a.release.Namespace)))
 	if err != nil {
 		return err
 	}
 
-	if delete && managedPipelineRun != nil {
-		err = a.client.Delete(a.ctx, managedPipelineRun)
-		if err != nil && !errors.IsNotFound(err) {
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if _, found := secret.Annotations[metadata.RegistryCredentialsAnnotation]; !found {
+			continue
+		}
+		if onlyDeleted && secret.GetDeletionTimestamp() == nil {
+			continue
+		}
+
+		err = a.revokeRegistryCredentials(secret)
+		if err != nil {
 			return err
 		}
 	}
 
-	a.logger.Info("Successfully finalized Release")
-
 	return nil
 }
 
-// getEmptyReleaseServiceConfig creates and returns an empty ReleaseServiceConfig resource.
-func (a *adapter) getEmptyReleaseServiceConfig(namespace string) *v1alpha1.ReleaseServiceConfig {
-	releaseServiceConfig := &v1alpha1.ReleaseServiceConfig{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      v1alpha1.ReleaseServiceConfigResourceName,
-			Namespace: namespace,
-		},
+// registerComputeUsage records in the given PipelineInfo the compute usage of the given finished PipelineRun of the
+// given type and, if the ReleaseServiceConfig defines cost rates, its estimated cost. Both are also registered in the
+// metrics of the ReleasePlan of the Release. Errors are only logged, as the usage is informative and shouldn't block
+// the processing of the Release.
+func (a *adapter) registerComputeUsage(pipelineRun *tektonv1.PipelineRun, pipelineInfo *v1alpha1.PipelineInfo, pipelineType string) {
+	if pipelineInfo.ComputeUsage != nil {
+		return
+	}
+
+	cpuMillicoreSeconds, memoryMiBSeconds, err := tekton.GetPipelineRunComputeUsage(a.ctx, a.client, pipelineRun)
+	if err != nil {
+		a.logger.Error(err, "Unable to compute the usage of the Release PipelineRun", "PipelineRun.Name", pipelineRun.Name)
+		return
+	}
+
+	pipelineInfo.ComputeUsage = &v1alpha1.ComputeUsage{
+		CPUMillicoreSeconds: cpuMillicoreSeconds,
+		MemoryMiBSeconds:    memoryMiBSeconds,
+	}
+
+	var cost float64
+	if a.releaseServiceConfig != nil && a.releaseServiceConfig.Spec.CostRates != nil {
+		cost = a.releaseServiceConfig.Spec.CostRates.GetCost(cpuMillicoreSeconds, memoryMiBSeconds)
+		pipelineInfo.ComputeUsage.EstimatedCost = fmt.Sprintf("%.4f", cost)
+	}
+
+	go metrics.RegisterReleasePipelineComputeUsage(a.release.Namespace, a.release.Spec.ReleasePlan, pipelineType,
+		float64(cpuMillicoreSeconds)/1000, float64(memoryMiBSeconds)/1024, cost)
+}
+
+// registerDeploymentStatus rolls the status of the given SnapshotEnvironmentBindings up into the Deployed condition of
+// the Release. The deployment fails as soon as one of them reports an error and succeeds once all of them report
+// that all their components were deployed.
+func (a *adapter) registerDeploymentStatus(bindings *applicationapiv1alpha1.SnapshotEnvironmentBindingList) {
+	deployed := 0
+
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+
+		errorCondition := meta.FindStatusCondition(binding.Status.BindingConditions,
+			applicationapiv1alpha1.ComponentDeploymentConditionErrorOccurred)
+		if errorCondition != nil && errorCondition.Status == metav1.ConditionTrue {
+			a.release.MarkDeploymentFailed(fmt.Sprintf("failed to deploy to environment %s: %s",
+				binding.Spec.Environment, errorCondition.Message))
+			return
+		}
+
+		if meta.IsStatusConditionTrue(binding.Status.ComponentDeploymentConditions,
+			applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed) {
+			deployed++
+		}
+	}
+
+	if deployed == len(bindings.Items) {
+		a.release.MarkDeployed()
+		return
+	}
+
+	a.release.MarkDeploying(fmt.Sprintf("%d of %d environments deployed", deployed, len(bindings.Items)))
+}
+
+// registerTenantProcessingData adds all the Release Tenant processing information to its Status and marks it as tenant processing.
+func (a *adapter) registerTenantProcessingData(releasePipelineRun *tektonv1.PipelineRun) error {
+	if releasePipelineRun == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	a.release.Status.TenantProcessing.PipelineRun = fmt.Sprintf("%s%c%s",
+		releasePipelineRun.Namespace, types.Separator, releasePipelineRun.Name)
+
+	a.release.MarkTenantPipelineProcessing()
+
+	return a.client.Status().Patch(a.ctx, a.release, patch)
+}
+
+// registerProcessingData adds all the Release Managed processing information to its Status and marks it as managed processing.
+func (a *adapter) registerManagedProcessingData(releasePipelineRun *tektonv1.PipelineRun, roleBinding *rbac.RoleBinding) error {
+	if releasePipelineRun == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	a.release.Status.ManagedProcessing.PipelineRun = fmt.Sprintf("%s%c%s",
+		releasePipelineRun.Namespace, types.Separator, releasePipelineRun.Name)
+	if roleBinding != nil {
+		a.release.Status.ManagedProcessing.RoleBinding = fmt.Sprintf("%s%c%s",
+			roleBinding.Namespace, types.Separator, roleBinding.Name)
+	}
+
+	a.release.MarkDequeued()
+	a.release.MarkManagedPipelineProcessing()
+
+	return a.client.Status().Patch(a.ctx, a.release, patch)
+}
+
+// registerTenantProcessingStatus updates the status of the Release being processed by monitoring the status of the
+// associated tenant Release PipelineRun and setting the appropriate state in the Release. If the PipelineRun hasn't
+// started/succeeded, no action will be taken.
+func (a *adapter) registerTenantProcessingStatus(pipelineRun *tektonv1.PipelineRun) error {
+	if pipelineRun == nil || !pipelineRun.IsDone() {
+		return nil
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	a.registerComputeUsage(pipelineRun, &a.release.Status.TenantProcessing, metadata.TenantPipelineType)
+
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition.IsTrue() {
+		a.release.MarkTenantPipelineProcessed()
+	} else {
+		if err := a.captureFailureLogs(pipelineRun, &a.release.Status.TenantProcessing); err != nil {
+			a.logger.Error(err, "Unable to capture the logs of the failed tenant Release PipelineRun")
+		}
+		a.release.MarkTenantPipelineProcessingFailedWithReason(tekton.GetPipelineRunFailureReason(pipelineRun),
+			a.redactor.Redact(condition.Message))
+		a.release.MarkManagedPipelineProcessingSkipped() // Do not run managed pipeline if tenant pipeline fails
+		a.release.MarkReleaseFailed("Release processing failed on tenant pipelineRun")
+	}
+
+	return a.client.Status().Patch(a.ctx, a.release, patch)
+}
+
+// registerManagedProcessingStatus updates the status of the Release being processed by monitoring the status of the
+// associated managed Release PipelineRun and setting the appropriate state in the Release. If the PipelineRun hasn't
+// started/succeeded, no action will be taken. The outcome reported by the PipelineRun for each component is also
+// registered, marking the Release as partially released if only some of the components failed.
+func (a *adapter) registerManagedProcessingStatus(pipelineRun *tektonv1.PipelineRun) error {
+	if pipelineRun == nil || !pipelineRun.IsDone() {
+		return nil
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	a.registerComputeUsage(pipelineRun, &a.release.Status.ManagedProcessing, metadata.ManagedPipelineType)
+
+	components, err := tekton.GetComponentResults(pipelineRun)
+	if err != nil {
+		a.logger.Error(err, "Unable to read the component results of the managed Release PipelineRun")
+	}
+	a.release.Status.Components = components
+	if failedComponents := a.release.GetFailedComponents(); len(failedComponents) > 0 && len(failedComponents) < len(components) {
+		a.release.MarkPartiallyReleased(fmt.Sprintf("failed to release the components %s",
+			strings.Join(failedComponents, ", ")))
+	}
+
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition.IsTrue() {
+		a.release.MarkManagedPipelineProcessed()
+	} else {
+		if err := a.captureFailureLogs(pipelineRun, &a.release.Status.ManagedProcessing); err != nil {
+			a.logger.Error(err, "Unable to capture the logs of the failed managed Release PipelineRun")
+		}
+		a.release.MarkManagedPipelineProcessingFailedWithReason(tekton.GetPipelineRunFailureReason(pipelineRun),
+			a.redactor.Redact(condition.Message))
+		a.release.MarkReleaseFailed("Release processing failed on managed pipelineRun")
+	}
+
+	return a.client.Status().Patch(a.ctx, a.release, patch)
+}
+
+// registerTargetStatus updates the given target of the fan-out Release being processed with the status of its managed
+// Release PipelineRun. A missing PipelineRun marks the target as failed. If the PipelineRun hasn't finished, no action
+// will be taken.
+func (a *adapter) registerTargetStatus(target *v1alpha1.TargetInfo, pipelineRun *tektonv1.PipelineRun) {
+	if pipelineRun == nil {
+		target.CompletionTime = &metav1.Time{Time: time.Now()}
+		target.Message = "the managed Release PipelineRun was not found"
+		target.Phase = v1alpha1.TargetPhaseFailed
+		return
+	}
+
+	if !pipelineRun.IsDone() {
+		return
+	}
+
+	target.CompletionTime = &metav1.Time{Time: time.Now()}
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition.IsTrue() {
+		target.Phase = v1alpha1.TargetPhaseSucceeded
+	} else {
+		target.Message = a.redactor.Redact(condition.Message)
+		target.Phase = v1alpha1.TargetPhaseFailed
 	}
-	releaseServiceConfig.Kind = "ReleaseServiceConfig"
-	return releaseServiceConfig
 }
 
-// registerTenantProcessingData adds all the Release Tenant processing information to its Status and marks it as tenant processing.
-func (a *adapter) registerTenantProcessingData(releasePipelineRun *tektonv1.PipelineRun) error {
-	if releasePipelineRun == nil {
+// registerVerificationData adds the verification information to the Release status and marks it as verifying.
+func (a *adapter) registerVerificationData(pipelineRun *tektonv1.PipelineRun) error {
+	if pipelineRun == nil {
 		return nil
 	}
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 
-	a.release.Status.TenantProcessing.PipelineRun = fmt.Sprintf("%s%c%s",
-		releasePipelineRun.Namespace, types.Separator, releasePipelineRun.Name)
-
-	a.release.MarkTenantPipelineProcessing()
+	a.release.Status.Verification.PipelineRun = fmt.Sprintf("%s%c%s",
+		pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+	a.release.MarkVerifying()
 
 	return a.client.Status().Patch(a.ctx, a.release, patch)
 }
 
-// registerProcessingData adds all the Release Managed processing information to its Status and marks it as managed processing.
-func (a *adapter) registerManagedProcessingData(releasePipelineRun *tektonv1.PipelineRun, roleBinding *rbac.RoleBinding) error {
-	if releasePipelineRun == nil {
+// registerVerificationStatus updates the status of the Release being processed by monitoring the status of the
+// associated verification Release PipelineRun and setting the appropriate state in the Release. If the PipelineRun
+// hasn't finished, no action will be taken. The Release fails if the verification fails.
+func (a *adapter) registerVerificationStatus(pipelineRun *tektonv1.PipelineRun) error {
+	if pipelineRun == nil || !pipelineRun.IsDone() {
 		return nil
 	}
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 
-	a.release.Status.ManagedProcessing.PipelineRun = fmt.Sprintf("%s%c%s",
-		releasePipelineRun.Namespace, types.Separator, releasePipelineRun.Name)
-	if roleBinding != nil {
-		a.release.Status.ManagedProcessing.RoleBinding = fmt.Sprintf("%s%c%s",
-			roleBinding.Namespace, types.Separator, roleBinding.Name)
-	}
+	a.registerComputeUsage(pipelineRun, &a.release.Status.Verification, metadata.VerificationPipelineType)
 
-	a.release.MarkManagedPipelineProcessing()
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition.IsTrue() {
+		a.release.MarkVerified()
+	} else {
+		if err := a.captureFailureLogs(pipelineRun, &a.release.Status.Verification); err != nil {
+			a.logger.Error(err, "Unable to capture the logs of the failed verification Release PipelineRun")
+		}
+		a.release.MarkVerificationFailed(a.redactor.Redact(condition.Message))
+		a.release.MarkReleaseFailed("Release verification failed on verification pipelineRun")
+	}
 
 	return a.client.Status().Patch(a.ctx, a.release, patch)
 }
 
-// registerTenantProcessingStatus updates the status of the Release being processed by monitoring the status of the
-// associated tenant Release PipelineRun and setting the appropriate state in the Release. If the PipelineRun hasn't
-// started/succeeded, no action will be taken.
-func (a *adapter) registerTenantProcessingStatus(pipelineRun *tektonv1.PipelineRun) error {
-	if pipelineRun == nil || !pipelineRun.IsDone() {
-		return nil
+// resolveCatalogPipeline resolves the managed Pipeline the given ReleasePlanAdmission references in a
+// ReleasePipelineCatalog, returning it along with its params. If the ReleasePlanAdmission doesn't reference a catalog
+// Pipeline, nil is returned.
+func (a *adapter) resolveCatalogPipeline(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*utils.ParameterizedPipeline, error) {
+	if releasePlanAdmission.Spec.CatalogPipeline == nil {
+		return nil, nil
 	}
 
-	patch := client.MergeFrom(a.release.DeepCopy())
+	releasePipelineCatalog, err := a.loader.GetReleasePipelineCatalog(a.ctx, a.client,
+		releasePlanAdmission.Spec.CatalogPipeline.Catalog, releasePlanAdmission.Namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-	if condition.IsTrue() {
-		a.release.MarkTenantPipelineProcessed()
-	} else {
-		a.release.MarkTenantPipelineProcessingFailed(condition.Message)
-		a.release.MarkManagedPipelineProcessingSkipped() // Do not run managed pipeline if tenant pipeline fails
-		a.release.MarkReleaseFailed("Release processing failed on tenant pipelineRun")
+	return releasePlanAdmission.ResolveCatalogPipeline(releasePipelineCatalog)
+}
+
+// applyReleasePlanAdmissionDefaults applies the ReleasePlanAdmissionDefaults of the namespace of the given
+// ReleasePlanAdmission to it and returns them. If the namespace has no ReleasePlanAdmissionDefaults, nil is returned.
+func (a *adapter) applyReleasePlanAdmissionDefaults(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleasePlanAdmissionDefaults, error) {
+	releasePlanAdmissionDefaults, err := a.loader.GetReleasePlanAdmissionDefaults(a.ctx, a.client,
+		releasePlanAdmission.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
 	}
 
-	return a.client.Status().Patch(a.ctx, a.release, patch)
+	return releasePlanAdmissionDefaults, releasePlanAdmission.ApplyDefaults(releasePlanAdmissionDefaults)
 }
 
-// registerManagedProcessingStatus updates the status of the Release being processed by monitoring the status of the
-// associated managed Release PipelineRun and setting the appropriate state in the Release. If the PipelineRun hasn't
-// started/succeeded, no action will be taken.
-func (a *adapter) registerManagedProcessingStatus(pipelineRun *tektonv1.PipelineRun) error {
-	if pipelineRun == nil || !pipelineRun.IsDone() {
-		return nil
+// retryPipelineRunOnNodeFailure deletes the given failed PipelineRun so the processing operations create it again when
+// it failed because of the nodes its tasks ran on, as long as the NodeFailurePolicy allows more retries and the retry
+// budget of the ReleasePlan is not exhausted. The failed nodes are added to the excluded nodes of the given
+// PipelineInfo, so the new PipelineRun keeps its tasks away from them. The returned boolean indicates whether the
+// PipelineRun will be created again.
+func (a *adapter) retryPipelineRunOnNodeFailure(pipelineRun *tektonv1.PipelineRun, pipelineInfo *v1alpha1.PipelineInfo) (bool, error) {
+	policy := a.releaseServiceConfig.Spec.NodeFailurePolicy
+	if policy == nil || a.podGetter == nil || pipelineInfo.Retries >= policy.MaxRetries {
+		return false, nil
+	}
+
+	nodes, err := tekton.GetFailedNodes(a.ctx, a.client, a.podGetter, pipelineRun)
+	if err != nil || len(nodes) == 0 {
+		return false, err
 	}
 
+	retry, err := a.consumeReleasePlanRetryBudget()
+	if err != nil || !retry {
+		return false, err
+	}
+
+	a.logger.Info("Release PipelineRun failed because of its nodes, creating it again away from them",
+		"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace, "Nodes", nodes)
+
 	patch := client.MergeFrom(a.release.DeepCopy())
+	pipelineInfo.Retries++
+	pipelineInfo.PipelineRun = ""
+	for _, node := range nodes {
+		if !slices.Contains(pipelineInfo.ExcludedNodes, node) {
+			pipelineInfo.ExcludedNodes = append(pipelineInfo.ExcludedNodes, node)
+		}
+	}
+	err = a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return false, err
+	}
 
-	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-	if condition.IsTrue() {
-		a.release.MarkManagedPipelineProcessed()
-	} else {
-		a.release.MarkManagedPipelineProcessingFailed(condition.Message)
-		a.release.MarkReleaseFailed("Release processing failed on managed pipelineRun")
+	// Deleting the PipelineRun will make the processing operations create it again
+	err = a.cleanupProcessingResources(pipelineRun, nil)
+	if err != nil {
+		return false, err
+	}
+	err = a.client.Delete(a.ctx, pipelineRun)
+	if err != nil && !errors.IsNotFound(err) {
+		return false, err
 	}
 
-	return a.client.Status().Patch(a.ctx, a.release, patch)
+	return true, nil
+}
+
+// skipSupersededRelease marks the Release being processed as superseded by the given newer Release and releases any
+// release lock it holds, so its managed pipeline never starts.
+func (a *adapter) skipSupersededRelease(newerRelease *v1alpha1.Release) (controller.OperationResult, error) {
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.MarkSuperseded(fmt.Sprintf("superseded by the newer Release %s of the same components", newerRelease.Name))
+	err := a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Skipped superseded Release", "NewerRelease.Name", newerRelease.Name)
+	if a.recorder != nil {
+		a.recorder.Eventf(a.release, corev1.EventTypeNormal, "Superseded",
+			"Superseded by the newer Release %s of the same components", newerRelease.Name)
+	}
+	metrics.RegisterSupersededRelease(a.release.Status.Target)
+
+	return controller.RequeueOnErrorOrStop(a.releaseReleaseLocks())
+}
+
+// recordEmergencyBypass logs and emits an event recording that the given gate of the Release being processed is
+// bypassed by its emergency bypass. The returned message describing the bypass is meant to be set in the condition of
+// the gate, so the bypass is also delivered to the outbox sinks.
+func (a *adapter) recordEmergencyBypass(gate string) string {
+	user := a.release.GetAnnotations()[metadata.EmergencyBypassUserAnnotation]
+	justification := a.release.GetAnnotations()[metadata.EmergencyBypassAnnotation]
+	message := fmt.Sprintf("the %s gate was bypassed by %s: %s", gate, user, justification)
+
+	a.logger.Info("Bypassing a gate with the emergency bypass of the Release", "Gate", gate, "User", user,
+		"Justification", justification)
+	if a.recorder != nil {
+		a.recorder.Event(a.release, corev1.EventTypeWarning, "EmergencyBypass", message)
+	}
+
+	return message
+}
+
+// setSecretsOwner sets the given PipelineRun as the owner of the given Secrets, so they get removed along with it. Nil
+// Secrets are ignored.
+func (a *adapter) setSecretsOwner(pipelineRun *tektonv1.PipelineRun, secrets ...*corev1.Secret) error {
+	for _, secret := range secrets {
+		if secret == nil {
+			continue
+		}
+
+		patch := client.MergeFrom(secret.DeepCopy())
+		err := controllerutil.SetOwnerReference(pipelineRun, secret, a.client.Scheme())
+		if err != nil {
+			return err
+		}
+		err = a.client.Patch(a.ctx, secret, patch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setReleaseLockHolder sets the Release being processed as the holder of the given release lock.
+func (a *adapter) setReleaseLockHolder(lease *coordinationv1.Lease) {
+	holder := a.release.Name
+	lease.Spec.HolderIdentity = &holder
+	lease.Spec.AcquireTime = &metav1.MicroTime{Time: time.Now()}
 }
 
 // validateAuthor will ensure that a valid author exists for the Release and add it to its status. If the Release
@@ -691,7 +4115,7 @@ func (a *adapter) validateAuthor() *controller.ValidationResult {
 	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			a.release.MarkValidationFailed(err.Error())
+			a.release.MarkValidationFailedWithCauses(getValidationFailureCause(err))
 			return &controller.ValidationResult{Valid: false}
 		}
 		return &controller.ValidationResult{Err: err}
@@ -702,14 +4126,26 @@ func (a *adapter) validateAuthor() *controller.ValidationResult {
 	if a.release.Labels[metadata.AutomatedLabel] == "true" {
 		author = releasePlan.Labels[metadata.AuthorLabel]
 		if author == "" {
-			a.release.MarkValidationFailed("no author in the ReleasePlan found for automated release")
+			a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+				DocsKey: "releaseplan.auto-release-label",
+				Field:   fmt.Sprintf("metadata.labels[%s]", metadata.AuthorLabel),
+				Hint:    "recreate or relabel the ReleasePlan so the author webhook can attribute automated releases",
+				Message: "no author in the ReleasePlan found for automated release",
+				Reason:  metav1.CauseTypeFieldValueRequired,
+			})
 			return &controller.ValidationResult{Valid: false}
 		}
 		a.release.Status.Attribution.StandingAuthorization = true
 	} else {
 		author = a.release.Labels[metadata.AuthorLabel]
 		if author == "" { // webhooks prevent this from happening but they could be disabled in some scenarios
-			a.release.MarkValidationFailed("no author found for manual release")
+			a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+				DocsKey: "release.author-label",
+				Field:   fmt.Sprintf("metadata.labels[%s]", metadata.AuthorLabel),
+				Hint:    "create the Release with the author webhook enabled so the author label is set",
+				Message: "no author found for manual release",
+				Reason:  metav1.CauseTypeFieldValueRequired,
+			})
 			return &controller.ValidationResult{Valid: false}
 		}
 	}
@@ -723,7 +4159,7 @@ func (a *adapter) validateProcessingResources() *controller.ValidationResult {
 	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			a.release.MarkValidationFailed(err.Error())
+			a.release.MarkValidationFailedWithCauses(getValidationFailureCause(err))
 			return &controller.ValidationResult{Valid: false}
 		}
 		return &controller.ValidationResult{Err: err}
@@ -733,7 +4169,7 @@ func (a *adapter) validateProcessingResources() *controller.ValidationResult {
 		resources, err := a.loader.GetProcessingResources(a.ctx, a.client, a.release)
 		if err != nil {
 			if resources == nil || resources.ReleasePlan == nil || resources.ReleasePlanAdmission == nil || errors.IsNotFound(err) {
-				a.release.MarkValidationFailed(err.Error())
+				a.release.MarkValidationFailedWithCauses(getValidationFailureCause(err))
 				return &controller.ValidationResult{Valid: false}
 			}
 
@@ -759,11 +4195,25 @@ func (a *adapter) validatePipelineSource() *controller.ValidationResult {
 			return a.validationError(err)
 		}
 
-		pipelineRef = releasePlanAdmission.Spec.Pipeline.PipelineRef
+		pipeline, err := releasePlanAdmission.GetPipeline(a.release.Spec.Strategy)
+		if err != nil {
+			a.release.MarkValidationFailedWithCauses(a.getStrategyValidationCause(err))
+			return &controller.ValidationResult{Valid: false}
+		}
+
+		if pipeline != nil {
+			pipelineRef = pipeline.PipelineRef
+		}
 	}
 
 	if !a.releaseServiceConfig.Spec.Debug && pipelineRef.IsClusterScoped() {
-		a.release.MarkValidationFailed("tried using debug only options while debug mode is disabled in the ReleaseServiceConfig")
+		a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+			DocsKey: "releaseserviceconfig.debug",
+			Field:   "spec.pipeline.pipelineRef",
+			Hint:    "use a namespaced pipeline resolver or enable debug mode in the ReleaseServiceConfig",
+			Message: "tried using debug only options while debug mode is disabled in the ReleaseServiceConfig",
+			Reason:  metav1.CauseTypeForbidden,
+		})
 		return &controller.ValidationResult{Valid: false}
 	}
 
@@ -775,7 +4225,7 @@ func (a *adapter) validatePipelineDefined() *controller.ValidationResult {
 	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			a.release.MarkValidationFailed(err.Error())
+			a.release.MarkValidationFailedWithCauses(getValidationFailureCause(err))
 			return &controller.ValidationResult{Valid: false}
 		}
 
@@ -790,22 +4240,37 @@ func (a *adapter) validatePipelineDefined() *controller.ValidationResult {
 
 	if releasePlan.Spec.Pipeline == nil {
 		if releasePlan.Spec.Target == "" {
-			errString := "releasePlan has no pipeline or target. Each Release should define a tenant pipeline, managed pipeline, or both"
-			a.release.MarkValidationFailed(errString)
+			a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+				DocsKey: "releaseplan.pipeline",
+				Field:   "spec.pipeline",
+				Hint:    "set spec.pipeline or spec.target in the ReleasePlan",
+				Message: "releasePlan has no pipeline or target. Each Release should define a tenant pipeline, managed pipeline, or both",
+				Reason:  metav1.CauseTypeFieldValueRequired,
+			})
 			return &controller.ValidationResult{Valid: false}
 		}
 		releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
 		if err != nil {
 			if errors.IsNotFound(err) {
-				a.release.MarkValidationFailed(err.Error())
+				a.release.MarkValidationFailedWithCauses(getValidationFailureCause(err))
 				return &controller.ValidationResult{Valid: false}
 			}
 
 			return &controller.ValidationResult{Err: err}
 		}
-		if releasePlanAdmission.Spec.Pipeline == nil {
-			errString := "releasePlan and releasePlanAdmission both have no pipeline. Each Release should define a tenant pipeline, managed pipeline, or both"
-			a.release.MarkValidationFailed(errString)
+		pipeline, err := releasePlanAdmission.GetPipeline(a.release.Spec.Strategy)
+		if err != nil {
+			a.release.MarkValidationFailedWithCauses(a.getStrategyValidationCause(err))
+			return &controller.ValidationResult{Valid: false}
+		}
+		if pipeline == nil {
+			a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+				DocsKey: "releaseplanadmission.pipeline",
+				Field:   "spec.pipeline",
+				Hint:    "set spec.pipeline in the ReleasePlan or in the ReleasePlanAdmission",
+				Message: "releasePlan and releasePlanAdmission both have no pipeline. Each Release should define a tenant pipeline, managed pipeline, or both",
+				Reason:  metav1.CauseTypeFieldValueRequired,
+			})
 			return &controller.ValidationResult{Valid: false}
 		}
 	}
@@ -813,11 +4278,325 @@ func (a *adapter) validatePipelineDefined() *controller.ValidationResult {
 	return &controller.ValidationResult{Valid: true}
 }
 
+// validateSkippedTasks checks that the managed Pipeline tasks listed in the skip-tasks annotation of the Release are
+// allowed to be skipped by the ReleasePlanAdmission.
+func (a *adapter) validateSkippedTasks() *controller.ValidationResult {
+	field := fmt.Sprintf("metadata.annotations[%s]", metadata.SkipTasksAnnotation)
+
+	skippedTasks, err := a.release.GetSkippedTasks()
+	if err != nil {
+		a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+			DocsKey: "release.skip-tasks",
+			Field:   field,
+			Hint:    "list the names of the managed Pipeline tasks to skip separated by commas",
+			Message: err.Error(),
+			Reason:  metav1.CauseTypeFieldValueInvalid,
+		})
+		return &controller.ValidationResult{Valid: false}
+	}
+	if len(skippedTasks) == 0 {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	if releasePlan.Spec.Target == "" {
+		a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+			DocsKey: "release.skip-tasks",
+			Field:   field,
+			Hint:    "remove the annotation, tasks can only be skipped in managed Pipelines",
+			Message: "the Release skips tasks but its ReleasePlan has no target",
+			Reason:  metav1.CauseTypeForbidden,
+		})
+		return &controller.ValidationResult{Valid: false}
+	}
+
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	var forbiddenTasks []string
+	for _, task := range skippedTasks {
+		if !slices.Contains(releasePlanAdmission.Spec.SkippableTasks, task) {
+			forbiddenTasks = append(forbiddenTasks, task)
+		}
+	}
+
+	if len(forbiddenTasks) > 0 {
+		a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+			DocsKey: "releaseplanadmission.skippable-tasks",
+			Field:   field,
+			Hint:    "ask the owners of the managed namespace to add the tasks to spec.skippableTasks in the ReleasePlanAdmission",
+			Message: fmt.Sprintf("the ReleasePlanAdmission %s doesn't allow skipping the tasks %s",
+				releasePlanAdmission.Name, strings.Join(forbiddenTasks, ", ")),
+			Reason: metav1.CauseTypeForbidden,
+		})
+		return &controller.ValidationResult{Valid: false}
+	}
+
+	return &controller.ValidationResult{Valid: true}
+}
+
+// validateSnapshotAge checks that the Snapshot of the Release was not created earlier than the maximum age defined in
+// the ReleasePlan allows, measured at the time the Release was created. Depending on the action set in the ReleasePlan,
+// stale Snapshots either fail the validation or are only flagged in the Release status.
+func (a *adapter) validateSnapshotAge() *controller.ValidationResult {
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	snapshotMaxAge := releasePlan.Spec.SnapshotMaxAge
+	if snapshotMaxAge == nil {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	releaseTime := a.release.CreationTimestamp.Time
+	if releaseTime.IsZero() {
+		releaseTime = time.Now()
+	}
+
+	age := releaseTime.Sub(snapshot.CreationTimestamp.Time)
+	if age <= time.Duration(snapshotMaxAge.Days)*24*time.Hour {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	message := fmt.Sprintf("snapshot %s was created %d days before the Release, exceeding the maximum age of %d days",
+		snapshot.Name, int(age.Hours()/24), snapshotMaxAge.Days)
+
+	if snapshotMaxAge.Action == v1alpha1.SnapshotMaxAgeActionWarn {
+		a.release.MarkSnapshotStale(message)
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+		DocsKey: "releaseplan.snapshot-max-age",
+		Field:   "spec.snapshot",
+		Hint:    "release a more recent Snapshot or increase the snapshotMaxAge of the ReleasePlan",
+		Message: message,
+		Reason:  metav1.CauseTypeFieldValueInvalid,
+	})
+	return &controller.ValidationResult{Valid: false}
+}
+
+// validatePinnedImages checks that the images of the Snapshot components the Release releases are referenced by digest
+// when its ReleasePlanAdmission requires it, so tags moved after the Snapshot was tested can't change the released
+// content. Releases whose ReleasePlan has no target are not affected.
+func (a *adapter) validatePinnedImages() *controller.ValidationResult {
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	if releasePlan.Spec.Target == "" {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	if !releasePlanAdmission.Spec.RequirePinnedImages {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	components := getReleasedComponents(a.release, snapshot)
+
+	var causes []v1alpha1.ValidationCause
+	for _, component := range snapshot.Spec.Components {
+		if !slices.Contains(components, component.Name) {
+			continue
+		}
+
+		if !isPinnedByDigest(component.ContainerImage) {
+			causes = append(causes, v1alpha1.ValidationCause{
+				DocsKey: "releaseplanadmission.require-pinned-images",
+				Field:   "spec.snapshot",
+				Hint:    "reference the image by its digest, e.g. quay.io/org/repo@sha256:<digest>, in the Snapshot",
+				Message: fmt.Sprintf("the image %s of component %s is not pinned by digest",
+					component.ContainerImage, component.Name),
+				Reason: metav1.CauseTypeFieldValueInvalid,
+			})
+		}
+	}
+
+	if len(causes) > 0 {
+		a.release.MarkValidationFailedWithCauses(causes...)
+		return &controller.ValidationResult{Valid: false}
+	}
+
+	return &controller.ValidationResult{Valid: true}
+}
+
+// validateHold checks that neither the Snapshot of an automated Release nor its Application has the hold label set to
+// true, so builds marked as not meant to be promoted are not released automatically. Manual Releases are not affected.
+func (a *adapter) validateHold() *controller.ValidationResult {
+	if a.release.Labels[metadata.AutomatedLabel] != "true" {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	if snapshot.GetLabels()[metadata.HoldLabel] == "true" {
+		a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+			DocsKey: "snapshot.hold-label",
+			Field:   "spec.snapshot",
+			Hint:    fmt.Sprintf("remove the %s label from the Snapshot and release it manually", metadata.HoldLabel),
+			Message: fmt.Sprintf("snapshot %s is on hold and can't be released automatically", snapshot.Name),
+			Reason:  metav1.CauseTypeForbidden,
+		})
+		return &controller.ValidationResult{Valid: false}
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	application, err := a.loader.GetApplication(a.ctx, a.client, releasePlan)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	if application.GetLabels()[metadata.HoldLabel] == "true" {
+		a.release.MarkValidationFailedWithCauses(v1alpha1.ValidationCause{
+			DocsKey: "application.hold-label",
+			Field:   "spec.releasePlan",
+			Hint:    fmt.Sprintf("remove the %s label from the Application to resume the automated Releases", metadata.HoldLabel),
+			Message: fmt.Sprintf("application %s is on hold and can't be released automatically", application.Name),
+			Reason:  metav1.CauseTypeForbidden,
+		})
+		return &controller.ValidationResult{Valid: false}
+	}
+
+	return &controller.ValidationResult{Valid: true}
+}
+
+// isPinnedByDigest returns true if the given image reference points to an image digest, like
+// quay.io/org/repo@sha256:<digest>, rather than only to a tag.
+func isPinnedByDigest(image string) bool {
+	_, digest, found := strings.Cut(image, "@")
+	if !found {
+		return false
+	}
+
+	algorithm, encoded, found := strings.Cut(digest, ":")
+
+	return found && algorithm != "" && encoded != "" && !strings.ContainsAny(encoded, "/:@")
+}
+
+// hasSchedulingPriority returns a boolean indicating whether the Release a should start its managed pipeline before
+// the Release b. The Release whose origin namespace uses less of its share, i.e. has the fewest running managed
+// pipelines relative to its share, goes first. The oldest queued Release goes first when both use the same amount.
+func hasSchedulingPriority(a, b *v1alpha1.Release, running, shares map[string]int) bool {
+	getShare := func(namespace string) int {
+		if share, found := shares[namespace]; found {
+			return share
+		}
+		return 1
+	}
+
+	// Comparing running[a] / share[a] with running[b] / share[b] without dividing
+	usageA := running[a.Namespace] * getShare(b.Namespace)
+	usageB := running[b.Namespace] * getShare(a.Namespace)
+	if usageA != usageB {
+		return usageA < usageB
+	}
+
+	return getQueueTime(a).Before(getQueueTime(b))
+}
+
+// isPipelineNotMirroredError returns a boolean indicating whether the given error was returned because a Release
+// Pipeline is not in the pipeline mirror catalog set in the ReleaseServiceConfig.
+func isPipelineNotMirroredError(err error) bool {
+	return strings.Contains(err.Error(), "is not in the pipeline mirror catalog")
+}
+
+// isQuotaExceededError returns a boolean indicating whether the given error was returned by the API server because
+// creating a resource would exceed a ResourceQuota of its namespace.
+func isQuotaExceededError(err error) bool {
+	return errors.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota")
+}
+
+// getValidationFailureCause returns a ValidationCause describing the given error, using the reason that matches the
+// resource that couldn't be loaded.
+func getValidationFailureCause(err error) v1alpha1.ValidationCause {
+	return v1alpha1.ValidationCause{
+		Message: err.Error(),
+		Reason:  metav1.CauseType(getValidationFailureReason(err)),
+	}
+}
+
+// getValidationFailureReason returns the reason matching the given error returned while loading the resources needed
+// to process a Release, falling back to the generic Failed reason for unknown errors.
+func getValidationFailureReason(err error) conditions.ConditionReason {
+	if status, ok := err.(errors.APIStatus); ok && errors.IsNotFound(err) && status.Status().Details != nil {
+		switch status.Status().Details.Kind {
+		case "releaseplans":
+			return reasons.ReleasePlanMissing
+		case "releaseplanadmissions":
+			return reasons.ReleasePlanAdmissionMissing
+		case "snapshots":
+			return reasons.SnapshotMissing
+		}
+	}
+
+	switch {
+	case strings.Contains(err.Error(), "auto-release label set to false"):
+		return reasons.AdmissionBlocked
+	case strings.Contains(err.Error(), "no ReleasePlanAdmission"):
+		return reasons.ReleasePlanAdmissionMissing
+	default:
+		return reasons.Failed
+	}
+}
+
+// getCustomValidation returns a ValidationFunction running the given validator compiled in by a downstream
+// distribution. The cause returned by the validator is registered in the Release status, using the name of the
+// validator as its docs key if it doesn't set one, so the failed step can be identified.
+func (a *adapter) getCustomValidation(validator validators.ReleaseValidator) controller.ValidationFunction {
+	return func() *controller.ValidationResult {
+		cause, err := validator.Validate(a.ctx, a.client, a.release)
+		if err != nil {
+			return &controller.ValidationResult{Err: err}
+		}
+
+		if cause == nil {
+			return &controller.ValidationResult{Valid: true}
+		}
+
+		if cause.DocsKey == "" {
+			cause.DocsKey = validator.Name()
+		}
+		a.release.MarkValidationFailedWithCauses(*cause)
+
+		return &controller.ValidationResult{Valid: false}
+	}
+}
+
 // validationError checks the error type, marks the release as failed when the error for known errors, and returns the
 // ValidationResult for the error found.
 func (a *adapter) validationError(err error) *controller.ValidationResult {
 	if errors.IsNotFound(err) {
-		a.release.MarkValidationFailed(err.Error())
+		a.release.MarkValidationFailedWithCauses(getValidationFailureCause(err))
 		return &controller.ValidationResult{Valid: false}
 	}
 	return &controller.ValidationResult{Err: err}
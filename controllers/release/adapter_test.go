@@ -17,8 +17,11 @@ limitations under the License.
 package release
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
 	"strings"
@@ -27,26 +30,40 @@ import (
 
 	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
 
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/konflux-ci/operator-toolkit/controller"
 	toolkit "github.com/konflux-ci/operator-toolkit/loader"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
+	"github.com/konflux-ci/release-service/credentials"
 	"github.com/konflux-ci/release-service/loader"
 	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/platforms"
+	"github.com/konflux-ci/release-service/redaction"
+	"github.com/konflux-ci/release-service/tekton"
+	"github.com/konflux-ci/release-service/throttle"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/operator-framework/operator-lib/handler"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
 
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	integrationgitops "github.com/redhat-appstudio/integration-service/gitops"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("Release adapter", Ordered, func() {
@@ -123,6 +140,47 @@ var _ = Describe("Release adapter", Ordered, func() {
 		})
 	})
 
+	When("EnsureRedactionIsConfigured is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should not set a redactor if the Release data has no sensitive values", func() {
+			result, err := adapter.EnsureRedactionIsConfigured()
+			Expect(!result.CancelRequest && !result.RequeueRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.redactor).To(BeNil())
+		})
+
+		It("should redact the values marked as sensitive and the values sourced from Secrets", func() {
+			adapter.release.Spec.Data = &runtime.RawExtension{Raw: []byte(`{
+				"password": "s3cr3t",
+				"token": {"secretKeyRef": {"name": "secret", "key": "token"}},
+				"sensitive": ["password"]
+			}`)}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SecretContextKey,
+					Resource: &corev1.Secret{
+						Data: map[string][]byte{"token": []byte("t0k3n")},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureRedactionIsConfigured()
+			Expect(!result.CancelRequest && !result.RequeueRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.redactor).NotTo(BeNil())
+			Expect(adapter.redactor.Redact("s3cr3t t0k3n")).To(Equal(redaction.Placeholder + " " + redaction.Placeholder))
+		})
+	})
+
 	When("EnsureFinalizersAreCalled is called", func() {
 		var adapter *adapter
 
@@ -278,11 +336,70 @@ var _ = Describe("Release adapter", Ordered, func() {
 			})
 			adapter.release.MarkManagedPipelineProcessing()
 			adapter.release.MarkManagedPipelineProcessed()
+			adapter.release.MarkVerificationSkipped()
 			result, err := adapter.EnsureReleaseIsCompleted()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
 		})
+
+		It("should do nothing if the released artifacts are being verified", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessed()
+			adapter.release.MarkVerifying()
+			result, err := adapter.EnsureReleaseIsCompleted()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
+		})
+	})
+
+	When("EnsureReleaseIsReadyForManagedProcessing is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should stop processing if the release has finished", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkValidated()
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+			adapter.release.MarkReleased()
+
+			result, err := adapter.EnsureReleaseIsReadyForManagedProcessing()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should stop processing if the release is not valid", func() {
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+
+			result, err := adapter.EnsureReleaseIsReadyForManagedProcessing()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should stop processing if the tenant pipeline processing has not finished", func() {
+			adapter.release.MarkValidated()
+
+			result, err := adapter.EnsureReleaseIsReadyForManagedProcessing()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should continue processing if the release is valid and the tenant pipeline processing finished", func() {
+			adapter.release.MarkValidated()
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+
+			result, err := adapter.EnsureReleaseIsReadyForManagedProcessing()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 
 	When("EnsureReleaseIsRunning is called", func() {
@@ -305,6 +422,15 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should stop processing if the release is a historical release", func() {
+			adapter.release.Labels = map[string]string{metadata.HistoricalLabel: "true"}
+
+			result, err := adapter.EnsureReleaseIsRunning()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsReleasing()).To(BeFalse())
+		})
+
 		It("should mark the Release as releasing if it is missing the status", func() {
 			result, err := adapter.EnsureReleaseIsRunning()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
@@ -322,8 +448,9 @@ var _ = Describe("Release adapter", Ordered, func() {
 		})
 	})
 
-	When("EnsureManagedPipelineIsProcessed is called", func() {
+	When("EnsureFanOutPipelinesAreProcessed is called", func() {
 		var adapter *adapter
+		var fanOutReleasePlan *v1alpha1.ReleasePlan
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -332,325 +459,394 @@ var _ = Describe("Release adapter", Ordered, func() {
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
 			adapter.releaseServiceConfig = releaseServiceConfig
-		})
-
-		It("should do nothing if the Release managed pipeline is already complete", func() {
-			adapter.release.MarkManagedPipelineProcessing()
-			adapter.release.MarkManagedPipelineProcessed()
-			adapter.release.MarkTenantPipelineProcessingSkipped()
-
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
+			fanOutReleasePlan = releasePlan.DeepCopy()
+			fanOutReleasePlan.Spec.FanOutPolicy = v1alpha1.FanOutPolicyAll
 		})
 
 		It("should do nothing if the Release tenant pipeline processing has not yet completed", func() {
 			adapter.release.MarkTenantPipelineProcessing()
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
+			Expect(adapter.release.IsFanOut()).To(BeFalse())
 		})
 
-		It("should requeue with error if fetching the Release managed pipeline returns an error besides not found", func() {
-			adapter.release.MarkTenantPipelineProcessingSkipped()
+		It("should do nothing if the ReleasePlan doesn't set a fan-out policy", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Err:        fmt.Errorf("some error"),
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						ReleasePlan:          releasePlan,
+						ReleasePlanAdmission: releasePlanAdmission,
+						Snapshot:             snapshot,
+					},
 				},
 			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
-			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).To(HaveOccurred())
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFanOut()).To(BeFalse())
 			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
 		})
 
-		It("should mark the Managed Pipeline Processing as Skipped if the ReleasePlanAdmission isn't found", func() {
+		It("should do nothing if a single ReleasePlanAdmission matches the ReleasePlan", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ProcessingResourcesContextKey,
-					Err:        fmt.Errorf("no ReleasePlanAdmissions can be found"),
+					Resource: &loader.ProcessingResources{
+						ReleasePlan:          fanOutReleasePlan,
+						ReleasePlanAdmission: releasePlanAdmission,
+						Snapshot:             snapshot,
+					},
 				},
 				{
-					ContextKey: loader.RoleBindingContextKey,
-					Resource:   nil,
+					ContextKey: loader.MatchedReleasePlanAdmissionsContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmissionList{
+						Items: []v1alpha1.ReleasePlanAdmission{*releasePlanAdmission},
+					},
 				},
 			})
 			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
-			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
+			Expect(adapter.release.IsFanOut()).To(BeFalse())
 		})
 
-		It("should mark the Managed Pipeline Processing as Skipped if the ReleasePlanAdmission has no pipeline", func() {
-			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan-admission",
-					Namespace: "default",
-				},
-				Spec: v1alpha1.ReleasePlanAdmissionSpec{
-					Applications: []string{application.Name},
-					Origin:       "default",
-					Policy:       enterpriseContractPolicy.Name,
-				},
-			}
-			newReleasePlanAdmission.Kind = "ReleasePlanAdmission"
+		It("should requeue with error if the matching ReleasePlanAdmissions can't be listed", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ProcessingResourcesContextKey,
 					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlan:                 releasePlan,
-						ReleasePlanAdmission:        newReleasePlanAdmission,
-						Snapshot:                    snapshot,
+						ReleasePlan:          fanOutReleasePlan,
+						ReleasePlanAdmission: releasePlanAdmission,
+						Snapshot:             snapshot,
 					},
 				},
 				{
-					ContextKey: loader.RoleBindingContextKey,
-					Resource:   nil,
+					ContextKey: loader.MatchedReleasePlanAdmissionsContextKey,
+					Err:        fmt.Errorf("some error"),
 				},
 			})
 			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
-			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.IsFanOut()).To(BeFalse())
 		})
 
-		It("should continue if the PipelineRun exists and the release managed pipeline processing has started", func() {
+		It("should register a failed target for each ReleasePlanAdmission without a managed pipeline", func() {
+			firstReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			firstReleasePlanAdmission.Name = "first"
+			firstReleasePlanAdmission.Spec.Pipeline = nil
+			secondReleasePlanAdmission := firstReleasePlanAdmission.DeepCopy()
+			secondReleasePlanAdmission.Name = "second"
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource: &tektonv1.PipelineRun{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "pipeline-run",
-							Namespace: "default",
-						},
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						ReleasePlan:          fanOutReleasePlan,
+						ReleasePlanAdmission: firstReleasePlanAdmission,
+						Snapshot:             snapshot,
 					},
 				},
 				{
-					ContextKey: loader.RoleBindingContextKey,
-					Resource:   roleBinding,
+					ContextKey: loader.MatchedReleasePlanAdmissionsContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmissionList{
+						Items: []v1alpha1.ReleasePlanAdmission{*firstReleasePlanAdmission, *secondReleasePlanAdmission},
+					},
 				},
 			})
-			adapter.release.MarkManagedPipelineProcessing()
 			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+			Expect(adapter.release.Status.Targets).To(HaveLen(2))
+			for _, target := range adapter.release.Status.Targets {
+				Expect(target.Phase).To(Equal(v1alpha1.TargetPhaseFailed))
+				Expect(target.Message).To(ContainSubstring("defines no managed Pipeline"))
+				Expect(target.PipelineRun).To(BeEmpty())
+			}
+			Expect(adapter.release.GetTarget("default/first")).NotTo(BeNil())
+			Expect(adapter.release.GetTarget("default/second")).NotTo(BeNil())
 		})
 
-		It("should register the processing data if the PipelineRun already exists", func() {
+		It("should not register a target twice", func() {
+			firstReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			firstReleasePlanAdmission.Name = "first"
+			firstReleasePlanAdmission.Spec.Pipeline = nil
+			secondReleasePlanAdmission := firstReleasePlanAdmission.DeepCopy()
+			secondReleasePlanAdmission.Name = "second"
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource: &tektonv1.PipelineRun{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "pipeline-run",
-							Namespace: "default",
-						},
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						ReleasePlan:          fanOutReleasePlan,
+						ReleasePlanAdmission: firstReleasePlanAdmission,
+						Snapshot:             snapshot,
 					},
 				},
 				{
-					ContextKey: loader.RoleBindingContextKey,
-					Resource:   roleBinding,
-				},
-				{
-					ContextKey: loader.ProcessingResourcesContextKey,
-					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlanAdmission:        releasePlanAdmission,
-						ReleasePlan:                 releasePlan,
-						Snapshot:                    snapshot,
+					ContextKey: loader.MatchedReleasePlanAdmissionsContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmissionList{
+						Items: []v1alpha1.ReleasePlanAdmission{*firstReleasePlanAdmission, *secondReleasePlanAdmission},
 					},
 				},
 			})
 			adapter.release.MarkTenantPipelineProcessingSkipped()
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.Targets = []v1alpha1.TargetInfo{
+				{
+					Phase:                v1alpha1.TargetPhaseProgressing,
+					PipelineRun:          "default/foo",
+					ReleasePlanAdmission: "default/first",
+				},
+			}
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
-		})
-
-		It("should requeue the Release if any of the resources is not found", func() {
+			Expect(adapter.release.Status.Targets).To(HaveLen(2))
+			Expect(adapter.release.GetTarget("default/first").Phase).To(Equal(v1alpha1.TargetPhaseProgressing))
+			Expect(adapter.release.GetTarget("default/second").Phase).To(Equal(v1alpha1.TargetPhaseFailed))
+		})
+		It("should keep a target pending until the gates of its ReleasePlanAdmission pass", func() {
+			firstReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			firstReleasePlanAdmission.Name = "first"
+			firstReleasePlanAdmission.Spec.Pipeline = nil
+			secondReleasePlanAdmission := firstReleasePlanAdmission.DeepCopy()
+			secondReleasePlanAdmission.Name = "second"
+			secondReleasePlanAdmission.Spec.RequireTwoPersonReview = true
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ProcessingResourcesContextKey,
-					Err:        fmt.Errorf("not found"),
+					Resource: &loader.ProcessingResources{
+						ReleasePlan:          fanOutReleasePlan,
+						ReleasePlanAdmission: firstReleasePlanAdmission,
+						Snapshot:             snapshot,
+					},
+				},
+				{
+					ContextKey: loader.MatchedReleasePlanAdmissionsContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmissionList{
+						Items: []v1alpha1.ReleasePlanAdmission{*firstReleasePlanAdmission, *secondReleasePlanAdmission},
+					},
 				},
 			})
 			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
-			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).To(HaveOccurred())
-		})
-
-		It("should create a RoleBinding if all the required resources are present and none exists in the Release Status", func() {
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
+			Expect(result.RequeueRequest && result.RequeueDelay > 0).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetTarget("default/first").Phase).To(Equal(v1alpha1.TargetPhaseFailed))
+			target := adapter.release.GetTarget("default/second")
+			Expect(target.Phase).To(Equal(v1alpha1.TargetPhasePending))
+			Expect(target.Message).To(ContainSubstring("has to approve the Release"))
+			Expect(target.PipelineRun).To(BeEmpty())
+			Expect(adapter.release.HasEveryTargetFinished()).To(BeFalse())
+		})
+
+		It("should fail a target whose ReleasePlanAdmission requires a change record the Release doesn't reference", func() {
+			firstReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			firstReleasePlanAdmission.Name = "first"
+			firstReleasePlanAdmission.Spec.Pipeline = nil
+			secondReleasePlanAdmission := firstReleasePlanAdmission.DeepCopy()
+			secondReleasePlanAdmission.Name = "second"
+			secondReleasePlanAdmission.Spec.RequireChangeRecord = true
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ProcessingResourcesContextKey,
 					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlan:                 releasePlan,
-						ReleasePlanAdmission:        releasePlanAdmission,
-						Snapshot:                    snapshot,
+						ReleasePlan:          fanOutReleasePlan,
+						ReleasePlanAdmission: firstReleasePlanAdmission,
+						Snapshot:             snapshot,
 					},
 				},
 				{
-					ContextKey: loader.RoleBindingContextKey,
-					Resource:   nil,
+					ContextKey: loader.MatchedReleasePlanAdmissionsContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmissionList{
+						Items: []v1alpha1.ReleasePlanAdmission{*firstReleasePlanAdmission, *secondReleasePlanAdmission},
+					},
 				},
 			})
 			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(BeEmpty())
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			target := adapter.release.GetTarget("default/second")
+			Expect(target.Phase).To(Equal(v1alpha1.TargetPhaseFailed))
+			Expect(target.Message).To(ContainSubstring("requires releases to reference a change record"))
+		})
 
-			// Reset MockedContext so that the RoleBinding that was just created can be fetched
+		It("should reuse the managed PipelineRun already created for a target", func() {
+			firstReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			firstReleasePlanAdmission.Name = "first"
+			firstReleasePlanAdmission.Spec.Pipeline = nil
+			secondReleasePlanAdmission := firstReleasePlanAdmission.DeepCopy()
+			secondReleasePlanAdmission.Name = "second"
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ProcessingResourcesContextKey,
 					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlan:                 releasePlan,
-						ReleasePlanAdmission:        releasePlanAdmission,
-						Snapshot:                    snapshot,
+						ReleasePlan:          fanOutReleasePlan,
+						ReleasePlanAdmission: firstReleasePlanAdmission,
+						Snapshot:             snapshot,
+					},
+				},
+				{
+					ContextKey: loader.MatchedReleasePlanAdmissionsContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmissionList{
+						Items: []v1alpha1.ReleasePlanAdmission{*firstReleasePlanAdmission, *secondReleasePlanAdmission},
 					},
 				},
 			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			roleBinding, err := adapter.loader.GetRoleBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
-			Expect(roleBinding).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.client.Delete(adapter.ctx, roleBinding)).To(Succeed())
-			// Still need to cleanup the PipelineRun
-			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
-		})
-
-		It("should not create a RoleBinding if the ReleasePlanAdmission has no ServiceAccount set", func() {
-			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
+			labels := metadata.GetReleaseTrackingLabels(adapter.release.Name, adapter.release.Namespace)
+			labels[metadata.PipelinesTypeLabel] = metadata.ManagedPipelineType
+			pipelineRun := &tektonv1.PipelineRun{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan-admission",
-					Namespace: "default",
+					GenerateName: "fan-out-",
+					Labels:       labels,
+					Namespace:    "default",
 				},
-				Spec: v1alpha1.ReleasePlanAdmissionSpec{
-					Applications: []string{application.Name},
-					Origin:       "default",
-					Pipeline: &tektonutils.Pipeline{
-						PipelineRef: tektonutils.PipelineRef{
-							Resolver: "git",
-							Params: []tektonutils.Param{
-								{Name: "url", Value: "my-url"},
-								{Name: "revision", Value: "my-revision"},
-								{Name: "pathInRepo", Value: "my-path"},
-							},
+				Spec: tektonv1.PipelineRunSpec{
+					Params: []tektonv1.Param{
+						{
+							Name:  "releasePlanAdmission",
+							Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "default/second"},
 						},
 					},
-					Policy: enterpriseContractPolicy.Name,
 				},
 			}
-			newReleasePlanAdmission.Kind = "ReleasePlanAdmission"
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			defer func() {
+				_ = k8sClient.Delete(ctx, pipelineRun)
+			}()
 
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ProcessingResourcesContextKey,
-					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlan:                 releasePlan,
-						ReleasePlanAdmission:        newReleasePlanAdmission,
-						Snapshot:                    snapshot,
-					},
-				},
+			result, err := adapter.EnsureFanOutPipelinesAreProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetTarget("default/first").Phase).To(Equal(v1alpha1.TargetPhaseFailed))
+			target := adapter.release.GetTarget("default/second")
+			Expect(target.Phase).To(Equal(v1alpha1.TargetPhaseProgressing))
+			Expect(target.PipelineRun).To(Equal("default/" + pipelineRun.Name))
+		})
+	})
+
+	When("EnsureFanOutPipelinesProcessingIsTracked is called", func() {
+		var adapter *adapter
+		var fanOutReleasePlan *v1alpha1.ReleasePlan
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			fanOutReleasePlan = releasePlan.DeepCopy()
+			fanOutReleasePlan.Spec.FanOutPolicy = v1alpha1.FanOutPolicyAll
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.RoleBindingContextKey,
-					Resource:   nil,
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   fanOutReleasePlan,
 				},
 			})
-			adapter.release.MarkTenantPipelineProcessingSkipped()
+		})
 
-			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(BeEmpty())
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
+		It("should do nothing if the Release is not a fan-out Release", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+
+			result, err := adapter.EnsureFanOutPipelinesProcessingIsTracked()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+		})
 
-			// Reset MockedContext so that the RoleBinding can be fetched if it exists
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+		It("should fail the managed processing if a target fails and all of them must succeed", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.Targets = []v1alpha1.TargetInfo{
 				{
-					ContextKey: loader.ProcessingResourcesContextKey,
-					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlan:                 releasePlan,
-						ReleasePlanAdmission:        newReleasePlanAdmission,
-						Snapshot:                    snapshot,
-					},
+					Phase:                v1alpha1.TargetPhaseSucceeded,
+					ReleasePlanAdmission: "default/first",
 				},
-			})
+				{
+					Phase:                v1alpha1.TargetPhaseProgressing,
+					PipelineRun:          "default/missing",
+					ReleasePlanAdmission: "default/second",
+				},
+			}
 
-			roleBinding, err := adapter.loader.GetRoleBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
-			Expect(roleBinding).To(BeNil())
-			Expect(err).To(HaveOccurred())
-			// Still need to cleanup the PipelineRun
-			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
-			Expect(pipelineRun).NotTo(BeNil())
+			result, err := adapter.EnsureFanOutPipelinesProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+			Expect(adapter.release.GetTarget("default/second").Phase).To(Equal(v1alpha1.TargetPhaseFailed))
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeFalse())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
 		})
 
-		It("should create a pipelineRun and register the processing data if all the required resources are present", func() {
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+		It("should succeed the managed processing if a target succeeds and any of them must succeed", func() {
+			fanOutReleasePlan.Spec.FanOutPolicy = v1alpha1.FanOutPolicyAny
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.Targets = []v1alpha1.TargetInfo{
 				{
-					ContextKey: loader.ProcessingResourcesContextKey,
-					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlan:                 releasePlan,
-						ReleasePlanAdmission:        releasePlanAdmission,
-						Snapshot:                    snapshot,
-					},
+					Phase:                v1alpha1.TargetPhaseSucceeded,
+					ReleasePlanAdmission: "default/first",
 				},
 				{
-					ContextKey: loader.RoleBindingContextKey,
-					Resource:   roleBinding,
+					Phase:                v1alpha1.TargetPhaseProgressing,
+					PipelineRun:          "default/missing",
+					ReleasePlanAdmission: "default/second",
 				},
-			})
-			adapter.release.MarkTenantPipelineProcessingSkipped()
+			}
 
-			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			result, err := adapter.EnsureFanOutPipelinesProcessingIsTracked()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+			Expect(adapter.release.GetTarget("default/second").Phase).To(Equal(v1alpha1.TargetPhaseFailed))
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
+		})
 
-			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
-			Expect(pipelineRun).NotTo(BeNil())
+		It("should not finish the managed processing while a target is progressing", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "fan-out-",
+					Namespace:    "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			defer func() {
+				_ = k8sClient.Delete(ctx, pipelineRun)
+			}()
+
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.Targets = []v1alpha1.TargetInfo{
+				{
+					Phase:                v1alpha1.TargetPhaseProgressing,
+					PipelineRun:          "default/" + pipelineRun.Name,
+					ReleasePlanAdmission: "default/first",
+				},
+			}
+
+			result, err := adapter.EnsureFanOutPipelinesProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+			Expect(adapter.release.GetTarget("default/first").Phase).To(Equal(v1alpha1.TargetPhaseProgressing))
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
 		})
 	})
 
-	When("EnsureTenantPipelineIsProcessed is called", func() {
+	When("EnsureManagedPipelineIsProcessed is called", func() {
 		var adapter *adapter
 
 		AfterEach(func() {
@@ -662,17 +858,28 @@ var _ = Describe("Release adapter", Ordered, func() {
 			adapter.releaseServiceConfig = releaseServiceConfig
 		})
 
-		It("should do nothing if the Release tenant pipeline is complete", func() {
+		It("should do nothing if the Release managed pipeline is already complete", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessed()
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
+		})
+
+		It("should do nothing if the Release tenant pipeline processing has not yet completed", func() {
 			adapter.release.MarkTenantPipelineProcessing()
-			adapter.release.MarkTenantPipelineProcessed()
 
-			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeFalse())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
 		})
 
 		It("should requeue with error if fetching the Release managed pipeline returns an error besides not found", func() {
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ReleasePipelineRunContextKey,
@@ -680,303 +887,404 @@ var _ = Describe("Release adapter", Ordered, func() {
 				},
 			})
 
-			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).To(HaveOccurred())
-			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeFalse())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
 		})
 
-		It("should continue and mark tenant processing as skipped if the ReleasePlan has no Pipeline set", func() {
-			newReleasePlan := releasePlan.DeepCopy()
-			newReleasePlan.Spec.Pipeline = nil
+		It("should mark the Managed Pipeline Processing as Skipped if the ReleasePlanAdmission isn't found", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ProcessingResourcesContextKey,
-					Resource: &loader.ProcessingResources{
-						ReleasePlan: newReleasePlan,
-					},
+					Err:        fmt.Errorf("no ReleasePlanAdmissions can be found"),
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   nil,
 				},
 			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeFalse())
-			Expect(adapter.release.IsTenantPipelineProcessed()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
 		})
 
-		It("should register the processing data if the PipelineRun already exists", func() {
+		It("should mark the Managed Pipeline Processing as Skipped if the ReleasePlanAdmission has no pipeline", func() {
+			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan-admission",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanAdmissionSpec{
+					Applications: []string{application.Name},
+					Origin:       "default",
+					Policy:       enterpriseContractPolicy.Name,
+				},
+			}
+			newReleasePlanAdmission.Kind = "ReleasePlanAdmission"
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource: &tektonv1.PipelineRun{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "pipeline-run",
-							Namespace: "default",
-						},
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
 					},
 				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   nil,
+				},
 			})
-			adapter.release.MarkTenantPipelineProcessing()
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
 		})
 
-		It("should requeue the Release if any of the resources is not found", func() {
+		It("should fail the managed pipeline processing if the execution namespace can't be resolved", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .Foo }}"
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Err:        fmt.Errorf("Not Found"),
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
 					Resource:   nil,
 				},
 			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureTenantPipelineIsProcessed()
-			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).To(HaveOccurred())
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeFalse())
 		})
 
-		It("should create a pipelineRun and register the processing data if all the required resources are present", func() {
-			releasePlan := &v1alpha1.ReleasePlan{}
-
-			parameterizedPipeline := tektonutils.ParameterizedPipeline{}
-			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
-				Resolver: "git",
-				Params: []tektonutils.Param{
-					{Name: "url", Value: "my-url"},
-					{Name: "revision", Value: "my-revision"},
-					{Name: "pathInRepo", Value: "my-path"},
-				},
-			}
-			parameterizedPipeline.Params = []tektonutils.Param{
-				{Name: "parameter1", Value: "value1"},
-				{Name: "parameter2", Value: "value2"},
-			}
-			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
-				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
-			}
-
-			releasePlan = &v1alpha1.ReleasePlan{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan",
-					Namespace: "default",
-				},
-				Spec: v1alpha1.ReleasePlanSpec{
-					Application:            application.Name,
-					Pipeline:               &parameterizedPipeline,
-					ReleaseGracePeriodDays: 6,
-				},
-			}
-			releasePlan.Kind = "ReleasePlan"
-
+		It("should continue if the PipelineRun exists and the release managed pipeline processing has started", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Resource:   releasePlan,
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &tektonv1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "default",
+						},
+					},
 				},
 				{
-					ContextKey: loader.SnapshotContextKey,
-					Resource:   snapshot,
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   roleBinding,
 				},
 			})
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeTrue())
-
-			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
-		})
-	})
-
-	When("EnsureReleaseIsValid is called", func() {
-		var adapter *adapter
-
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-			adapter.release.MarkReleasing("")
-			Expect(adapter.client.Status().Update(adapter.ctx, adapter.release)).To(Succeed())
-		})
-
-		It("should mark the release as validated if all checks pass", func() {
-			adapter.validations = []controller.ValidationFunction{}
+		It("should register the processing data if the PipelineRun already exists", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &tektonv1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "default",
+						},
+					},
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   roleBinding,
+				},
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlanAdmission:        releasePlanAdmission,
+						ReleasePlan:                 releasePlan,
+						Snapshot:                    snapshot,
+					},
+				},
+			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureReleaseIsValid()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeTrue())
-			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
 		})
 
-		It("should mark the release as failed if a validation fails", func() {
-			adapter.validations = []controller.ValidationFunction{
-				func() *controller.ValidationResult {
-					return &controller.ValidationResult{Valid: false}
-				},
+		It("should fail the Release if the managed pipeline doesn't start before the queue deadline", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.Deadlines = &v1alpha1.ReleaseDeadlines{
+				Queue: &metav1.Duration{Duration: time.Nanosecond},
 			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlanAdmission:        releasePlanAdmission,
+						ReleasePlan:                 releasePlan,
+						Snapshot:                    snapshot,
+					},
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   nil,
+				},
+			})
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureReleaseIsValid()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeFalse())
 			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+
+			condition := meta.FindStatusCondition(adapter.release.Status.Conditions, "ManagedPipelineProcessed")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(reasons.QueueTimeout.String()))
 		})
 
-		It("should requeue the release if a validation fails with an error", func() {
-			adapter.validations = []controller.ValidationFunction{
-				func() *controller.ValidationResult {
-					return &controller.ValidationResult{Err: fmt.Errorf("internal error")}
+		It("should requeue the Release if any of the resources is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Err:        fmt.Errorf("not found"),
 				},
-			}
+			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureReleaseIsValid()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).To(HaveOccurred())
-			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
 		})
 
-		It("does not clear the release status", func() {
-			adapter.validations = []controller.ValidationFunction{}
+		It("should queue the Release if the target namespace has no room for the managed pipeline", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Pipeline.ResourceRequests = corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("2"),
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+				{
+					ContextKey: loader.ResourceQuotasContextKey,
+					Resource: &corev1.ResourceQuotaList{
+						Items: []corev1.ResourceQuota{
+							{
+								ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+								Status: corev1.ResourceQuotaStatus{
+									Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")},
+									Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("3")},
+								},
+							},
+						},
+					},
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   nil,
+				},
+			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureReleaseIsValid()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", 30*time.Second, 6*time.Second))
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.StartTime).NotTo(BeNil())
-		})
-	})
-
-	When("EnsureTenantPielineProcessingIsTracked is called", func() {
-		var adapter *adapter
-
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
+			Expect(adapter.release.HasInsufficientCapacity()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
+		It("should create a RoleBinding if all the required resources are present and none exists in the Release Status", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        releasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   nil,
+				},
+			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-		It("should continue if the Release tenant pipeline processing has not started", func() {
-			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(BeEmpty())
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-		})
 
-		It("should continue if the Release tenant pipeline processing has finished", func() {
-			adapter.release.MarkTenantPipelineProcessing()
-			adapter.release.MarkTenantPipelineProcessed()
+			// Reset MockedContext so that the RoleBinding that was just created can be fetched
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        releasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+			})
 
-			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			roleBinding, err := adapter.loader.GetRoleBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
+			Expect(roleBinding).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, roleBinding)).To(Succeed())
+			// Still need to cleanup the PipelineRun
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
 		})
 
-		It("should track the status if the PipelineRun exists", func() {
-			adapter.release.MarkTenantPipelineProcessing()
-
-			pipelineRun := &tektonv1.PipelineRun{
+		It("should not create a RoleBinding if the ReleasePlanAdmission has no ServiceAccount set", func() {
+			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
+					Name:      "release-plan-admission",
 					Namespace: "default",
 				},
+				Spec: v1alpha1.ReleasePlanAdmissionSpec{
+					Applications: []string{application.Name},
+					Origin:       "default",
+					Pipeline: &tektonutils.Pipeline{
+						PipelineRef: tektonutils.PipelineRef{
+							Resolver: "git",
+							Params: []tektonutils.Param{
+								{Name: "url", Value: "my-url"},
+								{Name: "revision", Value: "my-revision"},
+								{Name: "pathInRepo", Value: "my-path"},
+							},
+						},
+					},
+					Policy: enterpriseContractPolicy.Name,
+				},
 			}
-			pipelineRun.Status.MarkSucceeded("", "")
+			newReleasePlanAdmission.Kind = "ReleasePlanAdmission"
+
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource:   pipelineRun,
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+					Resource:   nil,
 				},
 			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(BeEmpty())
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
-		})
 
-		It("should continue if the PipelineRun doesn't exist", func() {
-			adapter.release.MarkTenantPipelineProcessing()
+			// Reset MockedContext so that the RoleBinding can be fetched if it exists
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+			})
 
-			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			roleBinding, err := adapter.loader.GetRoleBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
+			Expect(roleBinding).To(BeNil())
+			Expect(err).To(HaveOccurred())
+			// Still need to cleanup the PipelineRun
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
 		})
-	})
 
-	When("EnsureManagedPipelineProcessingIsTracked is called", func() {
-		var adapter *adapter
-
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
-		})
-
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
-
-		It("should continue if the Release managed pipeline processing has not started", func() {
-			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("should continue if the Release managed pipeline processing has finished", func() {
-			adapter.release.MarkManagedPipelineProcessing()
-			adapter.release.MarkManagedPipelineProcessed()
-
-			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("should track the status if the PipelineRun exists", func() {
-			adapter.release.MarkManagedPipelineProcessing()
-
-			pipelineRun := &tektonv1.PipelineRun{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
-					Namespace: "default",
-				},
-			}
-			pipelineRun.Status.MarkSucceeded("", "")
+		It("should create a pipelineRun and register the processing data if all the required resources are present", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource:   pipelineRun,
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        releasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
 				},
 				{
 					ContextKey: loader.RoleBindingContextKey,
+					Resource:   roleBinding,
 				},
 			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
 
-			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
-		})
-
-		It("should continue if the PipelineRun doesn't exist", func() {
-			adapter.release.MarkManagedPipelineProcessing()
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
 
-			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
 		})
 	})
 
-	When("EnsureReleaseExpirationTimeIsAdded is called", func() {
+	When("EnsureTenantPipelineIsProcessed is called", func() {
 		var adapter *adapter
-		var newReleasePlan *v1alpha1.ReleasePlan
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -984,95 +1292,89 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
-			newReleasePlan = &v1alpha1.ReleasePlan{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan",
-					Namespace: "default",
-				},
-				Spec: v1alpha1.ReleasePlanSpec{
-					Application:            application.Name,
-					Target:                 "default",
-					ReleaseGracePeriodDays: 6,
-				},
-			}
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Resource:   newReleasePlan,
-				},
-			})
+			adapter.releaseServiceConfig = releaseServiceConfig
 		})
 
-		It("should set the ExpirationTime with the value of Release's GracePeriodDays and then continue", func() {
-			expireDays := time.Duration(3)
-			adapter.release.Spec.GracePeriodDays = 3
-			creationTime := adapter.release.CreationTimestamp
-			expectedExpirationTime := &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
+		It("should do nothing if the Release tenant pipeline is complete", func() {
+			adapter.release.MarkTenantPipelineProcessing()
+			adapter.release.MarkTenantPipelineProcessed()
 
-			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
-			Expect(adapter.release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
+			result, err := adapter.EnsureTenantPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeFalse())
 		})
 
-		It("should set the ExpirationTime with the value of ReleasePlan's ReleaseGracePeriodDays and then continue", func() {
-			expireDays := time.Duration(newReleasePlan.Spec.ReleaseGracePeriodDays)
-			creationTime := adapter.release.CreationTimestamp
-			expectedExpirationTime := &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
+		It("should requeue with error if fetching the Release managed pipeline returns an error besides not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Err:        fmt.Errorf("some error"),
+				},
+			})
 
-			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
-			Expect(adapter.release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
+			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeFalse())
 		})
 
-		It("should not change the ExpirationTime if it is already set", func() {
-			expireDays := time.Duration(5)
-			creationTime := adapter.release.CreationTimestamp
-			expectedExpirationTime := &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
+		It("should continue and mark tenant processing as skipped if the ReleasePlan has no Pipeline set", func() {
+			newReleasePlan := releasePlan.DeepCopy()
+			newReleasePlan.Spec.Pipeline = nil
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						ReleasePlan: newReleasePlan,
+					},
+				},
+			})
 
-			adapter.release.Status.ExpirationTime = expectedExpirationTime
-			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
-			Expect(adapter.release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
+			result, err := adapter.EnsureTenantPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-		})
-	})
-
-	When("EnsureReleaseProcessingResourcesAreCleanedUp is called", func() {
-		var adapter *adapter
-
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
+			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeFalse())
+			Expect(adapter.release.IsTenantPipelineProcessed()).To(BeTrue())
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
+		It("should register the processing data if the PipelineRun already exists", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &tektonv1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "default",
+						},
+					},
+				},
+			})
+			adapter.release.MarkTenantPipelineProcessing()
 
-		It("should continue if the Release tenant processing has not finished", func() {
-			adapter.release.MarkManagedPipelineProcessingSkipped()
-			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			result, err := adapter.EnsureTenantPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeTrue())
 		})
 
-		It("should continue if the Release managed processing has not finished", func() {
-			adapter.release.MarkTenantPipelineProcessingSkipped()
-			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
+		It("should requeue the Release if any of the resources is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Err:        fmt.Errorf("Not Found"),
+					Resource:   nil,
+				},
+			})
+
+			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
 		})
 
-		It("should call finalizeRelease with false if all release processing is complete", func() {
-			adapter.releaseServiceConfig = releaseServiceConfig
-			resources := &loader.ProcessingResources{
-				ReleasePlan:                 releasePlan,
-				ReleasePlanAdmission:        releasePlanAdmission,
-				EnterpriseContractConfigMap: enterpriseContractConfigMap,
-				EnterpriseContractPolicy:    enterpriseContractPolicy,
-				Snapshot:                    snapshot,
-			}
+		It("should create a pipelineRun and register the processing data if all the required resources are present", func() {
+			releasePlan := &v1alpha1.ReleasePlan{}
+
 			parameterizedPipeline := tektonutils.ParameterizedPipeline{}
 			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
 				Resolver: "git",
@@ -1090,7 +1392,7 @@ var _ = Describe("Release adapter", Ordered, func() {
 				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
 			}
 
-			newReleasePlan := &v1alpha1.ReleasePlan{
+			releasePlan = &v1alpha1.ReleasePlan{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "release-plan",
 					Namespace: "default",
@@ -1101,42 +1403,32 @@ var _ = Describe("Release adapter", Ordered, func() {
 					ReleaseGracePeriodDays: 6,
 				},
 			}
-			newReleasePlan.Kind = "ReleasePlan"
-
-			// Create tenant and managed pipelineRuns
-			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
-
-			pipelineRun, err = adapter.createManagedPipelineRun(resources)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
+			releasePlan.Kind = "ReleasePlan"
 
-			adapter.release.MarkTenantPipelineProcessing()
-			adapter.release.MarkTenantPipelineProcessed()
-			adapter.release.MarkManagedPipelineProcessing()
-			adapter.release.MarkManagedPipelineProcessed()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
 
-			// Ensure both pipelineRuns have finalizers removed
-			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			result, err := adapter.EnsureTenantPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeTrue())
 
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
-			Expect(err).NotTo(HaveOccurred())
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
 			Expect(pipelineRun).NotTo(BeNil())
-			Expect(pipelineRun.Finalizers).To(HaveLen(0))
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
-
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(pipelineRun.Finalizers).To(HaveLen(0))
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
 		})
 	})
 
-	When("cleanupProcessingResources is called", func() {
+	When("EnsureReleaseIsValid is called", func() {
 		var adapter *adapter
 
 		AfterEach(func() {
@@ -1145,324 +1437,381 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+			Expect(adapter.client.Status().Update(adapter.ctx, adapter.release)).To(Succeed())
 		})
 
-		It("removes the roleBinding if present", func() {
-			newRoleBinding := &rbac.RoleBinding{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "new-role-binding",
-					Namespace: "default",
-				},
-				RoleRef: rbac.RoleRef{
-					APIGroup: rbac.GroupName,
-					Kind:     "ClusterRole",
-					Name:     "clusterrole",
-				},
-			}
-			// The resource needs to be created as it will get patched
-			Expect(adapter.client.Create(adapter.ctx, newRoleBinding)).To(Succeed())
+		It("should mark the release as validated if all checks pass", func() {
+			adapter.validations = []controller.ValidationFunction{}
 
-			err := adapter.cleanupProcessingResources(nil, newRoleBinding)
+			result, err := adapter.EnsureReleaseIsValid()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-
-			checkRoleBinding := &rbac.RoleBinding{}
-			err = toolkit.GetObject(newRoleBinding.Name, newRoleBinding.Namespace, adapter.client, adapter.ctx, checkRoleBinding)
-			Expect(checkRoleBinding).To(Equal(&rbac.RoleBinding{}))
-			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(adapter.release.IsValid()).To(BeTrue())
+			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
 		})
 
-		It("removes the pipelineRun if present", func() {
-			pipelineRun := &tektonv1.PipelineRun{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:       "pipeline-run",
-					Namespace:  "default",
-					Finalizers: []string{metadata.ReleaseFinalizer},
+		It("should mark the release as failed if a validation fails", func() {
+			adapter.validations = []controller.ValidationFunction{
+				func() *controller.ValidationResult {
+					return &controller.ValidationResult{Valid: false}
 				},
 			}
-			// The resource needs to be created as it will get patched
-			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
 
-			err := adapter.cleanupProcessingResources(pipelineRun, nil)
+			result, err := adapter.EnsureReleaseIsValid()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+		})
 
-			checkPipelineRun := &tektonv1.PipelineRun{}
-			err = toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)
+		It("should requeue the release if a validation fails with an error", func() {
+			adapter.validations = []controller.ValidationFunction{
+				func() *controller.ValidationResult {
+					return &controller.ValidationResult{Err: fmt.Errorf("internal error")}
+				},
+			}
+
+			result, err := adapter.EnsureReleaseIsValid()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
+		})
+
+		It("should mark the release as failed if a validation fails with an error past the validation deadline", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.Deadlines = &v1alpha1.ReleaseDeadlines{
+				Validation: &metav1.Duration{Duration: time.Nanosecond},
+			}
+			adapter.validations = []controller.ValidationFunction{
+				func() *controller.ValidationResult {
+					return &controller.ValidationResult{Err: fmt.Errorf("internal error")}
+				},
+			}
+
+			result, err := adapter.EnsureReleaseIsValid()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(checkPipelineRun).NotTo(BeNil())
-			Expect(checkPipelineRun.Finalizers).To(HaveLen(0))
+			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
 
-			// Cleanup as only the finalizer was removed
-			Expect(adapter.client.Delete(adapter.ctx, checkPipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Validation.Causes).To(HaveLen(1))
+			Expect(adapter.release.Status.Validation.Causes[0].Reason).To(Equal(metav1.CauseType(reasons.ValidationTimeout)))
 		})
 
-		It("should not error if either resource is nil", func() {
-			err := adapter.cleanupProcessingResources(nil, nil)
+		It("does not clear the release status", func() {
+			adapter.validations = []controller.ValidationFunction{}
+
+			result, err := adapter.EnsureReleaseIsValid()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.StartTime).NotTo(BeNil())
 		})
 	})
 
-	When("createTenantPipelineRun is called", func() {
-		var (
-			adapter        *adapter
-			pipelineRun    *tektonv1.PipelineRun
-			newReleasePlan *v1alpha1.ReleasePlan
-		)
+	When("EnsureTenantPielineProcessingIsTracked is called", func() {
+		var adapter *adapter
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
-
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
 		})
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+		})
 
-			parameterizedPipeline := tektonutils.ParameterizedPipeline{}
-			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
-				Resolver: "git",
-				Params: []tektonutils.Param{
-					{Name: "url", Value: "my-url"},
-					{Name: "revision", Value: "my-revision"},
-					{Name: "pathInRepo", Value: "my-path"},
-				},
-			}
-			parameterizedPipeline.Params = []tektonutils.Param{
-				{Name: "parameter1", Value: "value1"},
-				{Name: "parameter2", Value: "value2"},
-			}
-			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
-				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
-			}
+		It("should continue if the Release tenant pipeline processing has not started", func() {
+			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
 
-			newReleasePlan = &v1alpha1.ReleasePlan{
+		It("should continue if the Release tenant pipeline processing has finished", func() {
+			adapter.release.MarkTenantPipelineProcessing()
+			adapter.release.MarkTenantPipelineProcessed()
+
+			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should track the status if the PipelineRun exists", func() {
+			adapter.release.MarkTenantPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan",
+					Name:      "pipeline-run",
 					Namespace: "default",
 				},
-				Spec: v1alpha1.ReleasePlanSpec{
-					Application:            application.Name,
-					Pipeline:               &parameterizedPipeline,
-					ReleaseGracePeriodDays: 6,
-				},
 			}
-			newReleasePlan.Kind = "ReleasePlan"
-
+			pipelineRun.Status.MarkSucceeded("", "")
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Resource:   newReleasePlan,
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
 				},
 			})
 
-			var err error
-			pipelineRun, err = adapter.createTenantPipelineRun(newReleasePlan, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
+			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
 		})
 
-		It("returns a PipelineRun with the right prefix", func() {
-			Expect(reflect.TypeOf(pipelineRun)).To(Equal(reflect.TypeOf(&tektonv1.PipelineRun{})))
-			Expect(pipelineRun.Name).To(HavePrefix("tenant"))
-		})
+		It("should continue if the PipelineRun doesn't exist", func() {
+			adapter.release.MarkTenantPipelineProcessing()
 
-		It("has the release reference", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(adapter.release.Kind))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", adapter.release.Namespace, types.Separator, adapter.release.Name))))
+			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
 		})
+	})
 
-		It("has the releasePlan reference", func() {
-			name := []rune(releasePlan.Kind)
-			name[0] = unicode.ToLower(name[0])
+	When("EnsureManagedPipelineProcessingIsTracked is called", func() {
+		var adapter *adapter
 
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", releasePlan.Namespace, types.Separator, releasePlan.Name))))
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
 		})
 
-		It("has the snapshot reference", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(snapshot.Kind))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
 		})
 
-		It("has owner annotations", func() {
-			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
-			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
+		It("should continue if the Release managed pipeline processing has not started", func() {
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("has release labels", func() {
-			Expect(pipelineRun.GetLabels()[metadata.PipelinesTypeLabel]).To(Equal(metadata.TenantPipelineType))
-			Expect(pipelineRun.GetLabels()[metadata.ReleaseNameLabel]).To(Equal(adapter.release.Name))
-			Expect(pipelineRun.GetLabels()[metadata.ReleaseNamespaceLabel]).To(Equal(testNamespace))
-			Expect(pipelineRun.GetLabels()[metadata.ReleaseSnapshotLabel]).To(Equal(adapter.release.Spec.Snapshot))
-		})
+		It("should continue if the Release managed pipeline processing has finished", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessed()
 
-		It("contains a parameter with the taskGitUrl", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitUrl")))
-			var url string
-			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
-			for i := range resolverParams {
-				if resolverParams[i].Name == "url" {
-					url = resolverParams[i].Value.StringVal
-				}
-			}
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", url)))
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("contains a parameter with the taskGitRevision", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitRevision")))
-			var revision string
-			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
-			for i := range resolverParams {
-				if resolverParams[i].Name == "revision" {
-					revision = resolverParams[i].Value.StringVal
-				}
+		It("should track the status if the PipelineRun exists", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
 			}
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", revision)))
-		})
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+				},
+			})
 
-		It("contains the proper timeout value", func() {
-			Expect(pipelineRun.Spec.Timeouts.Pipeline).To(Equal(newReleasePlan.Spec.Pipeline.Timeouts.Pipeline))
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
 		})
-	})
 
-	When("createManagedPipelineRun is called", func() {
-		var (
-			adapter     *adapter
-			pipelineRun *tektonv1.PipelineRun
-		)
+		It("should fail the Release and cancel the PipelineRun if it doesn't complete before the execution deadline", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.Deadlines = &v1alpha1.ReleaseDeadlines{
+				Execution: &metav1.Duration{Duration: time.Nanosecond},
+			}
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
 
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    "default",
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
+			defer func() {
+				_ = adapter.client.Delete(ctx, pipelineRun)
+			}()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+				},
+			})
 
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+
+			checkPipelineRun := &tektonv1.PipelineRun{}
+			Expect(toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)).To(Succeed())
+			Expect(checkPipelineRun.IsCancelled()).To(BeTrue())
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-			adapter.releaseServiceConfig = releaseServiceConfig
-			resources := &loader.ProcessingResources{
-				ReleasePlan:                 releasePlan,
-				ReleasePlanAdmission:        releasePlanAdmission,
-				EnterpriseContractConfigMap: enterpriseContractConfigMap,
-				EnterpriseContractPolicy:    enterpriseContractPolicy,
-				Snapshot:                    snapshot,
-			}
-
-			var err error
-			pipelineRun, err = adapter.createManagedPipelineRun(resources)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("returns a PipelineRun with the right prefix", func() {
-			Expect(reflect.TypeOf(pipelineRun)).To(Equal(reflect.TypeOf(&tektonv1.PipelineRun{})))
-			Expect(pipelineRun.Name).To(HavePrefix("managed"))
-		})
+		It("should requeue with a delay once the PipelineRun is deleted to retry it away from its failed nodes", func() {
+			adapter.podGetter = &mockPodGetter{}
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.NodeFailurePolicy = &v1alpha1.NodeFailurePolicy{MaxRetries: 1}
+			adapter.release.MarkManagedPipelineProcessing()
 
-		It("has the release reference", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(adapter.release.Kind))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", adapter.release.Namespace, types.Separator, adapter.release.Name))))
-		})
+			taskRun := &tektonv1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "task-run-",
+					Namespace:    "default",
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, taskRun)).To(Succeed())
+			defer func() {
+				_ = adapter.client.Delete(ctx, taskRun)
+			}()
+			taskRun.Status.PodName = taskRun.Name + "-pod"
+			taskRun.Status.SetCondition(&apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionFalse,
+			})
+			Expect(adapter.client.Status().Update(adapter.ctx, taskRun)).To(Succeed())
 
-		It("has the releasePlan reference", func() {
-			name := []rune(releasePlan.Kind)
-			name[0] = unicode.ToLower(name[0])
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    "default",
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
+			defer func() {
+				_ = adapter.client.Delete(ctx, pipelineRun)
+			}()
+			pipelineRun.Status.MarkFailed("", "")
+			pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{
+					TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+					Name:     taskRun.Name,
+				},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+				},
+			})
 
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", releasePlan.Namespace, types.Separator, releasePlan.Name))))
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically(">", 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.ManagedProcessing.Retries).To(Equal(1))
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeFalse())
 		})
 
-		It("has the releasePlanAdmission reference", func() {
-			name := []rune(releasePlanAdmission.Kind)
-			name[0] = unicode.ToLower(name[0])
+		It("should continue if the PipelineRun doesn't exist", func() {
+			adapter.release.MarkManagedPipelineProcessing()
 
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name))))
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
 		})
+	})
 
-		It("has the releaseServiceConfig reference", func() {
-			name := []rune(releaseServiceConfig.Kind)
-			name[0] = unicode.ToLower(name[0])
+	When("EnsureVerificationPipelineIsProcessed is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
 
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", releaseServiceConfig.Namespace, types.Separator, releaseServiceConfig.Name))))
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
 		})
 
-		It("has the snapshot reference", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(snapshot.Kind))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
-		})
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig = releaseServiceConfig
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessed()
 
-		It("has owner annotations", func() {
-			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
-			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Verification = &v1alpha1.ReleaseVerification{
+				Pipeline: &tektonutils.Pipeline{
+					PipelineRef: tektonutils.PipelineRef{
+						Resolver: "git",
+						Params: []tektonutils.Param{
+							{Name: "url", Value: "my-url"},
+							{Name: "revision", Value: "my-revision"},
+							{Name: "pathInRepo", Value: "my-path"},
+						},
+					},
+				},
+			}
 		})
 
-		It("has release labels", func() {
-			Expect(pipelineRun.GetLabels()[metadata.PipelinesTypeLabel]).To(Equal(metadata.ManagedPipelineType))
-			Expect(pipelineRun.GetLabels()[metadata.ReleaseNameLabel]).To(Equal(adapter.release.Name))
-			Expect(pipelineRun.GetLabels()[metadata.ReleaseNamespaceLabel]).To(Equal(testNamespace))
-			Expect(pipelineRun.GetLabels()[metadata.ReleaseSnapshotLabel]).To(Equal(adapter.release.Spec.Snapshot))
-		})
+		It("should continue if the managed pipeline processing has not finished", func() {
+			adapter.release.Status.Conditions = nil
+			adapter.release.MarkReleasing("")
 
-		It("references the pipeline specified in the ReleasePlanAdmission", func() {
-			var pipelineUrl string
-			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
-			for i := range resolverParams {
-				if resolverParams[i].Name == "url" {
-					pipelineUrl = resolverParams[i].Value.StringVal
-				}
-			}
-			Expect(pipelineUrl).To(Equal(releasePlanAdmission.Spec.Pipeline.PipelineRef.Params[0].Value))
+			result, err := adapter.EnsureVerificationPipelineIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasVerificationFinished()).To(BeFalse())
+			Expect(adapter.release.IsVerifying()).To(BeFalse())
 		})
 
-		It("contains a parameter with the taskGitUrl", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitUrl")))
-			var url string
-			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
-			for i := range resolverParams {
-				if resolverParams[i].Name == "url" {
-					url = resolverParams[i].Value.StringVal
-				}
-			}
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", url)))
-		})
+		It("should skip the verification if the ReleasePlanAdmission doesn't define a verification pipeline", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						ReleasePlan:          releasePlan,
+						ReleasePlanAdmission: releasePlanAdmission,
+						Snapshot:             snapshot,
+					},
+				},
+			})
 
-		It("contains a parameter with the taskGitRevision", func() {
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitRevision")))
-			var revision string
-			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
-			for i := range resolverParams {
-				if resolverParams[i].Name == "revision" {
-					revision = resolverParams[i].Value.StringVal
-				}
-			}
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", revision)))
+			result, err := adapter.EnsureVerificationPipelineIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasVerificationFinished()).To(BeTrue())
+			Expect(adapter.release.IsVerificationFailed()).To(BeFalse())
 		})
 
-		It("contains the proper timeout value", func() {
-			Expect(pipelineRun.Spec.Timeouts.Pipeline).To(Equal(releasePlanAdmission.Spec.Pipeline.Timeouts.Pipeline))
-		})
+		It("should create the verification PipelineRun and mark the Release as verifying", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						ReleasePlan:          releasePlan,
+						ReleasePlanAdmission: newReleasePlanAdmission,
+						Snapshot:             snapshot,
+					},
+				},
+			})
 
-		It("contains a parameter with the verify ec task bundle", func() {
-			bundle := enterpriseContractConfigMap.Data["verify_ec_task_bundle"]
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(bundle)))))
-		})
+			result, err := adapter.EnsureVerificationPipelineIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsVerifying()).To(BeTrue())
+			Expect(adapter.release.Status.Verification.PipelineRun).NotTo(BeEmpty())
 
-		It("contains a parameter with the json representation of the EnterpriseContractPolicy", func() {
-			jsonSpec, _ := json.Marshal(enterpriseContractPolicy.Spec)
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release,
+				metadata.VerificationPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
 		})
 	})
 
-	When("createRoleBindingForClusterRole is called", func() {
+	When("EnsureVerificationPipelineProcessingIsTracked is called", func() {
 		var adapter *adapter
 
 		AfterEach(func() {
@@ -1471,56 +1820,68 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
 		})
 
-		It("fails when the releasePlanAdmission has no serviceAccount", func() {
-			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
+		It("should continue if the released artifacts are not being verified", func() {
+			result, err := adapter.EnsureVerificationPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mark the released artifacts as verified if the PipelineRun succeeded", func() {
+			adapter.release.MarkVerifying()
+
+			pipelineRun := &tektonv1.PipelineRun{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan-admission",
+					Name:      "pipeline-run",
 					Namespace: "default",
 				},
-				Spec: v1alpha1.ReleasePlanAdmissionSpec{
-					Applications: []string{application.Name},
-					Origin:       "default",
-					Pipeline: &tektonutils.Pipeline{
-						PipelineRef: tektonutils.PipelineRef{
-							Resolver: "git",
-							Params: []tektonutils.Param{
-								{Name: "url", Value: "my-url"},
-								{Name: "revision", Value: "my-revision"},
-								{Name: "pathInRepo", Value: "my-path"},
-							},
-						},
-					},
-					Policy: enterpriseContractPolicy.Name,
-				},
 			}
-			roleBinding, err := adapter.createRoleBindingForClusterRole("foo", newReleasePlanAdmission)
-			Expect(err).To(HaveOccurred())
-			Expect(roleBinding).To(BeNil())
-			Expect(err.Error()).To(ContainSubstring("is invalid"))
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureVerificationPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasVerificationFinished()).To(BeTrue())
+			Expect(adapter.release.IsVerificationFailed()).To(BeFalse())
+			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
 		})
 
-		It("creates a new roleBinding", func() {
+		It("should fail the Release if the PipelineRun failed", func() {
+			adapter.release.MarkVerifying()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkFailed("", "smoke tests failed")
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
 				},
 			})
 
-			roleBinding, err := adapter.createRoleBindingForClusterRole("foo", releasePlanAdmission)
+			result, err := adapter.EnsureVerificationPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(roleBinding).NotTo(BeNil())
-			Expect(roleBinding.RoleRef.Name).To(Equal("foo"))
-
-			Expect(k8sClient.Delete(ctx, roleBinding)).Should(Succeed())
+			Expect(adapter.release.IsVerificationFailed()).To(BeTrue())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Status.Summary.Message).To(Equal("smoke tests failed"))
 		})
 	})
 
-	When("finalizeRelease is called", func() {
+	When("EnsureReleaseExpirationTimeIsAdded is called", func() {
 		var adapter *adapter
-		var parameterizedPipeline *tektonutils.ParameterizedPipeline
 		var newReleasePlan *v1alpha1.ReleasePlan
 
 		AfterEach(func() {
@@ -1529,22 +1890,6 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
-			parameterizedPipeline = &tektonutils.ParameterizedPipeline{}
-			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
-				Resolver: "git",
-				Params: []tektonutils.Param{
-					{Name: "url", Value: "my-url"},
-					{Name: "revision", Value: "my-revision"},
-					{Name: "pathInRepo", Value: "my-path"},
-				},
-			}
-			parameterizedPipeline.Params = []tektonutils.Param{
-				{Name: "parameter1", Value: "value1"},
-				{Name: "parameter2", Value: "value2"},
-			}
-			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
-				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
-			}
 			newReleasePlan = &v1alpha1.ReleasePlan{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "release-plan",
@@ -1552,80 +1897,57 @@ var _ = Describe("Release adapter", Ordered, func() {
 				},
 				Spec: v1alpha1.ReleasePlanSpec{
 					Application:            application.Name,
-					Pipeline:               parameterizedPipeline,
+					Target:                 "default",
 					ReleaseGracePeriodDays: 6,
 				},
 			}
-			newReleasePlan.Kind = "ReleasePlan"
-		})
-
-		It("finalizes the Release and removes the finalizer from the Tenant PipelineRun when called with false", func() {
-			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
-
-			Expect(adapter.finalizeRelease(false)).To(Succeed())
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(pipelineRun.Finalizers).To(HaveLen(0))
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+			})
 		})
 
-		It("finalizes the Release and removes the finalizer from the Managed PipelineRun when called with false", func() {
-			adapter.releaseServiceConfig = releaseServiceConfig
-			resources := &loader.ProcessingResources{
-				ReleasePlan:                 releasePlan,
-				ReleasePlanAdmission:        releasePlanAdmission,
-				EnterpriseContractConfigMap: enterpriseContractConfigMap,
-				EnterpriseContractPolicy:    enterpriseContractPolicy,
-				Snapshot:                    snapshot,
-			}
-			pipelineRun, err := adapter.createManagedPipelineRun(resources)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
+		It("should set the ExpirationTime with the value of Release's GracePeriodDays and then continue", func() {
+			expireDays := time.Duration(3)
+			adapter.release.Spec.GracePeriodDays = 3
+			creationTime := adapter.release.CreationTimestamp
+			expectedExpirationTime := &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
 
-			Expect(adapter.finalizeRelease(false)).To(Succeed())
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
+			Expect(adapter.release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(pipelineRun.Finalizers).To(HaveLen(0))
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
 		})
 
-		It("finalizes the Release and deletes the Tenant PipelineRun when called with true", func() {
-			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
+		It("should set the ExpirationTime with the value of ReleasePlan's ReleaseGracePeriodDays and then continue", func() {
+			expireDays := time.Duration(newReleasePlan.Spec.ReleaseGracePeriodDays)
+			creationTime := adapter.release.CreationTimestamp
+			expectedExpirationTime := &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
 
-			Expect(adapter.finalizeRelease(true)).To(Succeed())
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
+			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
+			Expect(adapter.release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).To(BeNil())
 		})
 
-		It("finalizes the Release and deletes the Managed PipelineRun when called with true", func() {
-			adapter.releaseServiceConfig = releaseServiceConfig
-			resources := &loader.ProcessingResources{
-				ReleasePlan:                 releasePlan,
-				ReleasePlanAdmission:        releasePlanAdmission,
-				EnterpriseContractConfigMap: enterpriseContractConfigMap,
-				EnterpriseContractPolicy:    enterpriseContractPolicy,
-				Snapshot:                    snapshot,
-			}
-			pipelineRun, err := adapter.createManagedPipelineRun(resources)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
+		It("should not change the ExpirationTime if it is already set", func() {
+			expireDays := time.Duration(5)
+			creationTime := adapter.release.CreationTimestamp
+			expectedExpirationTime := &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
 
-			Expect(adapter.finalizeRelease(true)).To(Succeed())
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			adapter.release.Status.ExpirationTime = expectedExpirationTime
+			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
+			Expect(adapter.release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).To(BeNil())
 		})
 	})
 
-	When("getEmptyReleaseServiceConfig is called", func() {
+	When("EnsureReleaseProvenanceIsRecorded is called", func() {
 		var adapter *adapter
+		var newSnapshot *applicationapiv1alpha1.Snapshot
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -1633,49 +1955,64 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+			newSnapshot = snapshot.DeepCopy()
+			newSnapshot.Annotations = map[string]string{
+				integrationgitops.PipelinesAsCodePrefix + "/sha": "abc123",
+				"other": "value",
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   newSnapshot,
+				},
+			})
 		})
 
-		It("should return a ReleaseServiceConfig without Spec and with the right ObjectMeta and Kind set", func() {
-			releaseServiceConfig := adapter.getEmptyReleaseServiceConfig("namespace")
-			Expect(releaseServiceConfig).NotTo(BeNil())
-			Expect(releaseServiceConfig.Name).To(Equal(v1alpha1.ReleaseServiceConfigResourceName))
-			Expect(releaseServiceConfig.Namespace).To(Equal("namespace"))
-			Expect(releaseServiceConfig.Kind).To(Equal("ReleaseServiceConfig"))
+		It("should record the provenance annotations of the Snapshot and continue", func() {
+			result, err := adapter.EnsureReleaseProvenanceIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Provenance).To(Equal(map[string]string{
+				integrationgitops.PipelinesAsCodePrefix + "/sha": "abc123",
+			}))
 		})
-	})
 
-	When("registerTenantProcessingData is called", func() {
-		var adapter *adapter
+		It("should not change the provenance if it is already recorded", func() {
+			adapter.release.Status.Provenance = map[string]string{"foo": "bar"}
 
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
+			result, err := adapter.EnsureReleaseProvenanceIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Provenance).To(Equal(map[string]string{"foo": "bar"}))
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
+		It("should continue if the Snapshot has no provenance annotations", func() {
+			newSnapshot.Annotations = nil
 
-		It("does nothing if there is no PipelineRun", func() {
-			Expect(adapter.registerTenantProcessingData(nil)).To(Succeed())
-			Expect(adapter.release.Status.TenantProcessing.PipelineRun).To(BeEmpty())
+			result, err := adapter.EnsureReleaseProvenanceIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Provenance).To(BeNil())
 		})
 
-		It("registers the Release tenant processing data", func() {
-			pipelineRun := &tektonv1.PipelineRun{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
-					Namespace: "default",
+		It("should continue if the Snapshot is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
 				},
-			}
-			Expect(adapter.registerTenantProcessingData(pipelineRun)).To(Succeed())
-			Expect(adapter.release.Status.TenantProcessing.PipelineRun).To(Equal(fmt.Sprintf("%s%c%s",
-				pipelineRun.Namespace, types.Separator, pipelineRun.Name)))
-			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeTrue())
+			})
+
+			result, err := adapter.EnsureReleaseProvenanceIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
 		})
 	})
 
-	When("registerManagedProcessingData is called", func() {
+	When("EnsureSnapshotTestsHavePassed is called", func() {
 		var adapter *adapter
+		var newReleasePlan *v1alpha1.ReleasePlan
+		var newSnapshot *applicationapiv1alpha1.Snapshot
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -1683,50 +2020,133 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+
+			newReleasePlan = releasePlan.DeepCopy()
+			newReleasePlan.Spec.RequirePassingTests = true
+			newSnapshot = snapshot.DeepCopy()
 		})
 
-		It("does nothing if there is no PipelineRun", func() {
-			Expect(adapter.registerManagedProcessingData(nil, nil)).To(Succeed())
-			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).To(BeEmpty())
+		It("should continue if the ReleasePlan doesn't require passing tests", func() {
+			newReleasePlan.Spec.RequirePassingTests = false
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotTestsHavePassed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsAwaitingTestResults()).To(BeFalse())
 		})
 
-		It("registers the Release managed processing data", func() {
-			pipelineRun := &tektonv1.PipelineRun{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
-					Namespace: "default",
+		It("should continue if the tenant pipeline processing already started", func() {
+			adapter.release.MarkTenantPipelineProcessing()
+
+			result, err := adapter.EnsureSnapshotTestsHavePassed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mark the Release as awaiting test results if the Snapshot has no test results", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
 				},
-			}
-			roleBinding := &rbac.RoleBinding{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "role-binding",
-					Namespace: "default",
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   newSnapshot,
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotTestsHavePassed()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsAwaitingTestResults()).To(BeTrue())
+		})
+
+		It("should mark the Snapshot as tested and continue if the tests passed", func() {
+			newSnapshot.Status.Conditions = []metav1.Condition{
+				{
+					Type:   v1alpha1.SnapshotTestSucceededConditionType,
+					Status: metav1.ConditionTrue,
 				},
 			}
-			Expect(adapter.registerManagedProcessingData(pipelineRun, roleBinding)).To(Succeed())
-			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).To(Equal(fmt.Sprintf("%s%c%s",
-				pipelineRun.Namespace, types.Separator, pipelineRun.Name)))
-			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(Equal(fmt.Sprintf("%s%c%s",
-				roleBinding.Namespace, types.Separator, roleBinding.Name)))
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   newSnapshot,
+				},
+			})
+
+			adapter.release.MarkAwaitingTestResults()
+			result, err := adapter.EnsureSnapshotTestsHavePassed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsAwaitingTestResults()).To(BeFalse())
+			Expect(adapter.release.IsSnapshotTested()).To(BeTrue())
 		})
 
-		It("does not set RoleBinding when no RoleBinding is passed", func() {
-			pipelineRun := &tektonv1.PipelineRun{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
-					Namespace: "default",
+		It("should mark the Release as failed if the tests failed", func() {
+			newSnapshot.Status.Conditions = []metav1.Condition{
+				{
+					Type:   v1alpha1.SnapshotTestSucceededConditionType,
+					Status: metav1.ConditionFalse,
 				},
 			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   newSnapshot,
+				},
+			})
 
-			Expect(adapter.registerManagedProcessingData(pipelineRun, nil)).To(Succeed())
-			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(BeEmpty())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+			result, err := adapter.EnsureSnapshotTestsHavePassed()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+			Expect(adapter.release.IsReleased()).To(BeFalse())
+		})
+
+		It("should bypass the tests and continue if an emergency bypass is active", func() {
+			adapter.release.Annotations = map[string]string{
+				metadata.EmergencyBypassAnnotation:           "production outage",
+				metadata.EmergencyBypassExpirationAnnotation: time.Now().Add(time.Hour).Format(time.RFC3339),
+				metadata.EmergencyBypassUserAnnotation:       "admin",
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   newSnapshot,
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotTestsHavePassed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsSnapshotTested()).To(BeTrue())
+			Expect(adapter.release.IsAwaitingTestResults()).To(BeFalse())
 		})
 	})
 
-	When("registerTenantProcessingStatus is called", func() {
+	When("EnsureSnapshotReleasableTimeIsRecorded is called", func() {
 		var adapter *adapter
+		var newSnapshot *applicationapiv1alpha1.Snapshot
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -1734,42 +2154,71 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
-		})
+			adapter.release.MarkReleasing("")
 
-		It("does nothing if there is no PipelineRun", func() {
-			Expect(adapter.registerTenantProcessingStatus(nil)).To(Succeed())
-			Expect(adapter.release.Status.TenantProcessing.CompletionTime).To(BeNil())
+			newSnapshot = snapshot.DeepCopy()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   newSnapshot,
+				},
+			})
 		})
 
-		It("does nothing if the PipelineRun is not done", func() {
-			pipelineRun := &tektonv1.PipelineRun{}
-			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.Status.TenantProcessing.CompletionTime).To(BeNil())
+		It("should continue if the Snapshot releasable time was already recorded", func() {
+			releasableTime := &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			adapter.release.Status.Latency.SnapshotReleasableTime = releasableTime
+
+			result, err := adapter.EnsureSnapshotReleasableTimeIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Latency.SnapshotReleasableTime).To(Equal(releasableTime))
 		})
 
-		It("sets the Release as Tenant Processed if the PipelineRun succeeded", func() {
-			pipelineRun := &tektonv1.PipelineRun{}
-			pipelineRun.Status.MarkSucceeded("", "")
-			adapter.release.MarkTenantPipelineProcessing()
+		It("should record the Snapshot creation time if it didn't report its tests", func() {
+			newSnapshot.CreationTimestamp = metav1.Time{Time: time.Now().Add(-time.Hour)}
 
-			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.IsTenantPipelineProcessed()).To(BeTrue())
+			result, err := adapter.EnsureSnapshotReleasableTimeIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Latency.SnapshotReleasableTime.Time).To(BeTemporally("~", newSnapshot.CreationTimestamp.Time, time.Second))
+			Expect(adapter.release.Status.Latency.SnapshotToReleaseStart).NotTo(BeNil())
 		})
 
-		It("sets the Release as Tenant Processing failed and Managed Processing skipped if the PipelineRun didn't succeed", func() {
-			pipelineRun := &tektonv1.PipelineRun{}
-			pipelineRun.Status.MarkFailed("", "")
-			adapter.release.MarkTenantPipelineProcessing()
+		It("should record the time when the Snapshot tests passed", func() {
+			testsPassedTime := time.Now().Add(-time.Minute)
+			newSnapshot.Status.Conditions = []metav1.Condition{
+				{
+					Type:               v1alpha1.SnapshotTestSucceededConditionType,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: testsPassedTime},
+				},
+			}
 
-			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
-			Expect(adapter.release.IsTenantPipelineProcessed()).To(BeFalse())
-			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
+			result, err := adapter.EnsureSnapshotReleasableTimeIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Latency.SnapshotReleasableTime.Time).To(BeTemporally("~", testsPassedTime, time.Second))
+		})
+
+		It("should not record anything while the Snapshot tests haven't passed", func() {
+			newSnapshot.Status.Conditions = []metav1.Condition{
+				{
+					Type:   v1alpha1.SnapshotTestSucceededConditionType,
+					Status: metav1.ConditionUnknown,
+				},
+			}
+
+			result, err := adapter.EnsureSnapshotReleasableTimeIsRecorded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasSnapshotReleasableTime()).To(BeFalse())
 		})
 	})
 
-	When("registerManagedProcessingStatus is called", func() {
+	When("EnsureReleaseIsApproved is called", func() {
 		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -1777,177 +2226,4565 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+			adapter.release.Status.Attribution.Author = "author"
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.RequireTwoPersonReview = true
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
 		})
 
-		It("does nothing if there is no PipelineRun", func() {
-			Expect(adapter.registerManagedProcessingStatus(nil)).To(Succeed())
-			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		It("should continue if the ReleasePlanAdmission doesn't require a two-person review", func() {
+			newReleasePlanAdmission.Spec.RequireTwoPersonReview = false
+
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsApproved()).To(BeFalse())
 		})
 
-		It("does nothing if the PipelineRun is not done", func() {
-			pipelineRun := &tektonv1.PipelineRun{}
-			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		It("should wait for the approval if the Release is not approved", func() {
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsAwaitingApproval()).To(BeTrue())
 		})
 
-		It("sets the Release as Managed Processed if the PipelineRun succeeded", func() {
-			pipelineRun := &tektonv1.PipelineRun{}
-			pipelineRun.Status.MarkSucceeded("", "")
+		It("should wait for the approval if the Release was approved by its author", func() {
+			adapter.release.Annotations = map[string]string{metadata.ApprovedByAnnotation: "author"}
+
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsAwaitingApproval()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions).To(ContainElement(HaveField("Message",
+				"the Release can't be approved by its author author")))
+		})
+
+		It("should mark the Release as approved and continue if it was approved by another user", func() {
+			adapter.release.Annotations = map[string]string{metadata.ApprovedByAnnotation: "reviewer"}
+
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsApproved()).To(BeTrue())
+			Expect(adapter.release.Status.Attribution.Approver).To(Equal("reviewer"))
+		})
+	})
+
+	When("EnsureReleaseWindowIsOpen is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.ReleaseSchedule = &v1alpha1.ReleaseSchedule{
+				Windows: []v1alpha1.ReleaseWindow{
+					{Start: "00:00", End: "24:00"},
+				},
+			}
+		})
+
+		It("should continue if the ReleasePlanAdmission has no release schedule", func() {
+			newReleasePlanAdmission.Spec.ReleaseSchedule = nil
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseWindowIsOpen()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsInReleaseWindow()).To(BeFalse())
+		})
+
+		It("should continue if the managed pipeline processing already started", func() {
 			adapter.release.MarkManagedPipelineProcessing()
 
-			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
+			result, err := adapter.EnsureReleaseWindowIsOpen()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("sets the Release as Managed Processing failed if the PipelineRun didn't succeed", func() {
-			pipelineRun := &tektonv1.PipelineRun{}
-			pipelineRun.Status.MarkFailed("", "")
+		It("should mark the Release as in the release window and continue if a window is open", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseWindowIsOpen()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsInReleaseWindow()).To(BeTrue())
+		})
+
+		It("should queue the Release until the next window opens", func() {
+			tomorrow := time.Now().UTC().Add(24 * time.Hour)
+			newReleasePlanAdmission.Spec.ReleaseSchedule.Windows[0].Days = []string{tomorrow.Weekday().String()}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseWindowIsOpen()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically(">", 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsAwaitingReleaseWindow()).To(BeTrue())
+			Expect(adapter.release.Status.ScheduledTime.Time.Equal(
+				time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should bypass the release window and continue if an emergency bypass is active", func() {
+			tomorrow := time.Now().UTC().Add(24 * time.Hour)
+			newReleasePlanAdmission.Spec.ReleaseSchedule.Windows[0].Days = []string{tomorrow.Weekday().String()}
+			adapter.release.Annotations = map[string]string{
+				metadata.EmergencyBypassAnnotation:           "production outage",
+				metadata.EmergencyBypassExpirationAnnotation: time.Now().Add(time.Hour).Format(time.RFC3339),
+				metadata.EmergencyBypassUserAnnotation:       "admin",
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseWindowIsOpen()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsInReleaseWindow()).To(BeTrue())
+			Expect(adapter.release.Status.ScheduledTime).To(BeNil())
+		})
+
+		It("should mark the Release as failed if the release schedule is invalid", func() {
+			newReleasePlanAdmission.Spec.ReleaseSchedule.TimeZone = "Invalid/TimeZone"
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseWindowIsOpen()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+		})
+	})
+
+	When("EnsureSupersededReleaseIsSkipped is called", func() {
+		var adapter *adapter
+		var newerRelease *v1alpha1.Release
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Labels = map[string]string{metadata.AutomatedLabel: "true"}
+			adapter.release.MarkReleasing("")
+
+			newerRelease = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "newer-release",
+					Namespace:         "default",
+					CreationTimestamp: metav1.NewTime(adapter.release.CreationTimestamp.Add(time.Minute)),
+					Labels:            map[string]string{metadata.AutomatedLabel: "true"},
+				},
+				Spec: adapter.release.Spec,
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource: &v1alpha1.ReleaseList{
+						Items: []v1alpha1.Release{*adapter.release, *newerRelease},
+					},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+		})
+
+		It("should continue if the Release is not automated", func() {
+			adapter.release.Labels = nil
+
+			result, err := adapter.EnsureSupersededReleaseIsSkipped()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsSuperseded()).To(BeFalse())
+		})
+
+		It("should continue if the managed pipeline processing already started", func() {
 			adapter.release.MarkManagedPipelineProcessing()
 
-			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
-			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeFalse())
+			result, err := adapter.EnsureSupersededReleaseIsSkipped()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsSuperseded()).To(BeFalse())
+		})
+
+		It("should skip the Release if a newer automated Release releases the same components", func() {
+			result, err := adapter.EnsureSupersededReleaseIsSkipped()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsSuperseded()).To(BeTrue())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+			Expect(adapter.release.Status.Summary.Phase).To(Equal(v1alpha1.ReleasePhaseSuperseded))
+		})
+
+		It("should continue if the newer Release releases other components", func() {
+			newerRelease.Annotations = map[string]string{metadata.ComponentsAnnotation: "other"}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource: &v1alpha1.ReleaseList{
+						Items: []v1alpha1.Release{*adapter.release, *newerRelease},
+					},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureSupersededReleaseIsSkipped()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsSuperseded()).To(BeFalse())
+		})
+
+		It("should continue if the newer Release failed", func() {
+			newerRelease.MarkReleasing("")
+			newerRelease.MarkReleaseFailed("")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource: &v1alpha1.ReleaseList{
+						Items: []v1alpha1.Release{*adapter.release, *newerRelease},
+					},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureSupersededReleaseIsSkipped()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsSuperseded()).To(BeFalse())
 		})
 	})
 
-	When("calling validateAuthor", func() {
+	When("EnsureReleaseDependencyIsMet is called", func() {
 		var adapter *adapter
-		var conditionMsg string
+		var dependency *v1alpha1.Release
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Spec.DependsOn = &v1alpha1.ReleaseDependency{
+				Release:          "dependency",
+				PropagateResults: true,
+			}
+			Expect(k8sClient.Update(ctx, adapter.release)).To(Succeed())
+
+			dependency = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dependency",
+					Namespace: "default",
+				},
+				Status: v1alpha1.ReleaseStatus{
+					Artifacts: &runtime.RawExtension{
+						Raw: []byte(`{"index":{"image":"quay.io/foo/bar:1"},"bundle":"quay.io/foo/bundle"}`),
+					},
+				},
+			}
+		})
+
+		It("should continue if the Release doesn't depend on another Release", func() {
+			adapter.release.Spec.DependsOn = nil
+
+			result, err := adapter.EnsureReleaseDependencyIsMet()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should continue if the tenant pipeline processing already started", func() {
+			adapter.release.MarkTenantPipelineProcessing()
+
+			result, err := adapter.EnsureReleaseDependencyIsMet()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mark the Release as failed if the dependency is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseDependencyIsMet()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+			Expect(adapter.release.IsReleased()).To(BeFalse())
+		})
+
+		It("should requeue if the dependency didn't finish", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseDependencyIsMet()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", 30*time.Second, 6*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mark the Release as failed if the dependency failed", func() {
+			dependency.MarkReleasing("")
+			dependency.MarkReleaseFailed("")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseDependencyIsMet()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
+			Expect(adapter.release.IsReleased()).To(BeFalse())
+		})
+
+		It("should add the dependency results to the Release data", func() {
+			dependency.MarkReleasing("")
+			dependency.MarkReleased()
+			adapter.release.Spec.Data = &runtime.RawExtension{
+				Raw: []byte(`{"index":{"fromIndex":"quay.io/foo/index"},"bundle":"quay.io/foo/other"}`),
+			}
+			Expect(k8sClient.Update(ctx, adapter.release)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseDependencyIsMet()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Spec.Data.Raw).To(MatchJSON(
+				`{"index":{"image":"quay.io/foo/bar:1","fromIndex":"quay.io/foo/index"},"bundle":"quay.io/foo/other"}`))
+		})
+
+		It("should not modify the Release data if results are not propagated", func() {
+			dependency.MarkReleasing("")
+			dependency.MarkReleased()
+			adapter.release.Spec.DependsOn.PropagateResults = false
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseDependencyIsMet()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Spec.Data).To(BeNil())
+		})
+	})
+
+	When("EnsureReleaseProcessingResourcesAreCleanedUp is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the Release tenant processing has not finished", func() {
+			adapter.release.MarkManagedPipelineProcessingSkipped()
+			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should continue if the Release managed processing has not finished", func() {
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should call finalizeRelease with false if all release processing is complete", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig
+			resources := &loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}
+			parameterizedPipeline := tektonutils.ParameterizedPipeline{}
+			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
+				Resolver: "git",
+				Params: []tektonutils.Param{
+					{Name: "url", Value: "my-url"},
+					{Name: "revision", Value: "my-revision"},
+					{Name: "pathInRepo", Value: "my-path"},
+				},
+			}
+			parameterizedPipeline.Params = []tektonutils.Param{
+				{Name: "parameter1", Value: "value1"},
+				{Name: "parameter2", Value: "value2"},
+			}
+			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+			}
+
+			newReleasePlan := &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application:            application.Name,
+					Pipeline:               &parameterizedPipeline,
+					ReleaseGracePeriodDays: 6,
+				},
+			}
+			newReleasePlan.Kind = "ReleasePlan"
+
+			// Create tenant and managed pipelineRuns
+			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err = adapter.createManagedPipelineRun(resources, nil, nil)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.release.MarkTenantPipelineProcessing()
+			adapter.release.MarkTenantPipelineProcessed()
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessed()
+
+			// Ensure both pipelineRuns have finalizers removed
+			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Finalizers).To(HaveLen(0))
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Finalizers).To(HaveLen(0))
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+	})
+
+	When("EnsureSnapshotEnvironmentBindingsAreCreated is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Environments = []string{"environment"}
+		})
+
+		It("should continue if the Release was not released", func() {
+			adapter.release.Status.Conditions = nil
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingsAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDeploying()).To(BeFalse())
+		})
+
+		It("should continue if the ReleasePlanAdmission has no environments", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingsAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDeploying()).To(BeFalse())
+		})
+
+		It("should mark the deployment as failed if an environment is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnvironmentContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingsAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasDeploymentFinished()).To(BeTrue())
+			Expect(adapter.release.IsDeployed()).To(BeFalse())
+		})
+
+		It("should create a binding for each environment and mark the Release as deploying", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnvironmentContextKey,
+					Resource:   &applicationapiv1alpha1.Environment{},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingsAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDeploying()).To(BeTrue())
+			Expect(adapter.release.Status.Deployment.SnapshotEnvironmentBindings).To(HaveLen(1))
+
+			bindings := &applicationapiv1alpha1.SnapshotEnvironmentBindingList{}
+			Expect(k8sClient.List(ctx, bindings)).To(Succeed())
+			for i := range bindings.Items {
+				Expect(k8sClient.Delete(ctx, &bindings.Items[i])).To(Succeed())
+			}
+		})
+	})
+
+	When("EnsureSnapshotEnvironmentBindingsAreTracked is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkDeploying("")
+		})
+
+		It("should continue if the Release is not being deployed", func() {
+			adapter.release.Status.Conditions = nil
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingsAreTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mark the deployment as failed if a binding is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingsContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingsAreTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasDeploymentFinished()).To(BeTrue())
+		})
+
+		It("should mark the Release as deployed once all the bindings are deployed", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingsContextKey,
+					Resource: &applicationapiv1alpha1.SnapshotEnvironmentBindingList{
+						Items: []applicationapiv1alpha1.SnapshotEnvironmentBinding{
+							{
+								Status: applicationapiv1alpha1.SnapshotEnvironmentBindingStatus{
+									ComponentDeploymentConditions: []metav1.Condition{
+										{
+											Type:   applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
+											Status: metav1.ConditionTrue,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingsAreTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDeployed()).To(BeTrue())
+		})
+	})
+
+	When("EnsureFailedComponentsAreRetried is called", func() {
+		var adapter *adapter
+		var newReleasePlan *v1alpha1.ReleasePlan
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      adapter.release.Name + "-retry",
+					Namespace: adapter.release.Namespace,
+				},
+			})
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Status.Components = []v1alpha1.ComponentStatus{
+				{Name: "foo", Phase: v1alpha1.ComponentPhaseFailed},
+				{Name: "bar", Phase: v1alpha1.ComponentPhaseSucceeded},
+			}
+			adapter.release.MarkPartiallyReleased("")
+			newReleasePlan = releasePlan.DeepCopy()
+			newReleasePlan.Spec.RetryFailedComponents = true
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+			})
+		})
+
+		It("should continue if the Release is not partially released", func() {
+			adapter.release.Status.Conditions = nil
+
+			result, err := adapter.EnsureFailedComponentsAreRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()).NotTo(HaveKey(metadata.FollowUpReleaseAnnotation))
+		})
+
+		It("should continue if the ReleasePlan doesn't retry failed components", func() {
+			newReleasePlan.Spec.RetryFailedComponents = false
+
+			result, err := adapter.EnsureFailedComponentsAreRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()).NotTo(HaveKey(metadata.FollowUpReleaseAnnotation))
+		})
+
+		It("should continue if the retry budget of the ReleasePlan is exhausted", func() {
+			newReleasePlan.Spec.RetryBudget = &v1alpha1.RetryBudget{
+				MaxRetries: 1,
+				Window:     metav1.Duration{Duration: time.Hour},
+			}
+			newReleasePlan.Status.RetryBudget.Retries = []metav1.Time{{Time: time.Now()}}
+
+			result, err := adapter.EnsureFailedComponentsAreRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()).NotTo(HaveKey(metadata.FollowUpReleaseAnnotation))
+		})
+
+		It("should continue if the ReleasePlan of the Release was deleted", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleaseFailed("")
+			adapter.release.MarkPlanDeleted(newReleasePlan.Name)
+
+			result, err := adapter.EnsureFailedComponentsAreRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()).NotTo(HaveKey(metadata.FollowUpReleaseAnnotation))
+		})
+
+		It("should requeue with an error if the ReleasePlan can't be loaded", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Err:        fmt.Errorf("error"),
+				},
+			})
+
+			result, err := adapter.EnsureFailedComponentsAreRetried()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should create a follow-up Release and annotate the Release with it", func() {
+			newReleasePlan.Spec.RetryBudget = &v1alpha1.RetryBudget{
+				MaxRetries: 1,
+				Window:     metav1.Duration{Duration: time.Hour},
+			}
+
+			result, err := adapter.EnsureFailedComponentsAreRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.FollowUpReleaseAnnotation]).To(
+				Equal(adapter.release.Name + "-retry"))
+			Expect(newReleasePlan.Status.RetryBudget.Retries).To(HaveLen(1))
+		})
+
+		It("should reuse an existing follow-up Release without consuming the retry budget", func() {
+			newReleasePlan.Spec.RetryBudget = &v1alpha1.RetryBudget{
+				MaxRetries: 1,
+				Window:     metav1.Duration{Duration: time.Hour},
+			}
+			followUpRelease, err := adapter.createFollowUpRelease()
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := adapter.EnsureFailedComponentsAreRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.FollowUpReleaseAnnotation]).To(Equal(followUpRelease.Name))
+			Expect(newReleasePlan.Status.RetryBudget.Retries).To(BeEmpty())
+		})
+	})
+
+	When("EnsureFailedVerificationIsRolledBack is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      adapter.release.Name + "-rollback",
+					Namespace: adapter.release.Namespace,
+				},
+			})
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkVerifying()
+			adapter.release.MarkVerificationFailed("")
+			adapter.release.MarkReleaseFailed("")
+
+			previousRelease := v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{Name: "previous-release", Namespace: adapter.release.Namespace},
+				Spec: v1alpha1.ReleaseSpec{
+					ReleasePlan: adapter.release.Spec.ReleasePlan,
+					Snapshot:    "previous-snapshot",
+				},
+			}
+			previousRelease.MarkReleasing("")
+			previousRelease.MarkReleased()
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Verification = &v1alpha1.ReleaseVerification{
+				Pipeline: &tektonutils.Pipeline{},
+				Rollback: true,
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Resource: &v1alpha1.ReleaseList{
+						Items: []v1alpha1.Release{*adapter.release, previousRelease},
+					},
+				},
+			})
+		})
+
+		It("should continue if the verification of the Release didn't fail", func() {
+			adapter.release.Status.Conditions = nil
+
+			result, err := adapter.EnsureFailedVerificationIsRolledBack()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()).NotTo(HaveKey(metadata.RollbackReleaseAnnotation))
+		})
+
+		It("should continue if the ReleasePlanAdmission doesn't request rollbacks", func() {
+			newReleasePlanAdmission.Spec.Verification.Rollback = false
+
+			result, err := adapter.EnsureFailedVerificationIsRolledBack()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()).NotTo(HaveKey(metadata.RollbackReleaseAnnotation))
+		})
+
+		It("should create a Release of the previously released Snapshot and annotate the Release with it", func() {
+			result, err := adapter.EnsureFailedVerificationIsRolledBack()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.RollbackReleaseAnnotation]).To(
+				Equal(adapter.release.Name + "-rollback"))
+
+			rollbackRelease := &v1alpha1.Release{}
+			Expect(toolkit.GetObject(adapter.release.Name+"-rollback", adapter.release.Namespace, adapter.client,
+				ctx, rollbackRelease)).To(Succeed())
+			Expect(rollbackRelease.Spec.Snapshot).To(Equal("previous-snapshot"))
+		})
+	})
+
+	When("EnsureSupportBundleIsGenerated is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      adapter.release.Name + "-support-bundle",
+					Namespace: adapter.release.Namespace,
+				},
+			})
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.eventsGetter = &mockEventsGetter{}
+			adapter.release.MarkReleasing("")
+		})
+
+		It("should continue without generating a support bundle if the Release didn't fail", func() {
+			result, err := adapter.EnsureSupportBundleIsGenerated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.SupportBundle).To(BeEmpty())
+		})
+
+		It("should generate a support bundle if the Release failed", func() {
+			adapter.release.MarkReleaseFailed("")
+
+			result, err := adapter.EnsureSupportBundleIsGenerated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.SupportBundle).To(Equal(fmt.Sprintf("%s%c%s",
+				adapter.release.Namespace, types.Separator, adapter.release.Name+"-support-bundle")))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(toolkit.GetObject(adapter.release.Name+"-support-bundle", adapter.release.Namespace,
+				adapter.client, adapter.ctx, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKey("release.json"))
+			Expect(configMap.Data).To(HaveKey("releaseplan.json"))
+			Expect(configMap.Data["events.json"]).To(ContainSubstring("ReleaseFailed"))
+			Expect(configMap.OwnerReferences).To(HaveLen(1))
+		})
+
+		It("should generate a support bundle and remove the annotation if it was requested", func() {
+			adapter.release.Annotations = map[string]string{metadata.SupportBundleAnnotation: "true"}
+			Expect(adapter.client.Update(adapter.ctx, adapter.release)).To(Succeed())
+
+			result, err := adapter.EnsureSupportBundleIsGenerated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.SupportBundle).NotTo(BeEmpty())
+
+			release := &v1alpha1.Release{}
+			Expect(toolkit.GetObject(adapter.release.Name, adapter.release.Namespace, adapter.client, adapter.ctx,
+				release)).To(Succeed())
+			Expect(release.GetAnnotations()).NotTo(HaveKey(metadata.SupportBundleAnnotation))
+		})
+
+		It("should requeue with an error if the ReleasePlan can't be loaded", func() {
+			adapter.release.MarkReleaseFailed("")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Err:        fmt.Errorf("error"),
+				},
+			})
+
+			result, err := adapter.EnsureSupportBundleIsGenerated()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.Status.SupportBundle).To(BeEmpty())
+		})
+	})
+
+	When("EnsureReleaseIsRecordedInSnapshot is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+
+			Expect(toolkit.GetObject(snapshot.Name, snapshot.Namespace, k8sClient, ctx, snapshot)).To(Succeed())
+			patch := client.MergeFrom(snapshot.DeepCopy())
+			delete(snapshot.Annotations, metadata.SnapshotReleasesAnnotation)
+			Expect(k8sClient.Patch(ctx, snapshot, patch)).To(Succeed())
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Status.Target = "default"
+		})
+
+		It("should continue without recording the Release if it didn't finish", func() {
+			adapter.release.MarkReleasing("")
+
+			result, err := adapter.EnsureReleaseIsRecordedInSnapshot()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(toolkit.GetObject(snapshot.Name, snapshot.Namespace, k8sClient, ctx, snapshot)).To(Succeed())
+			Expect(snapshot.GetAnnotations()).NotTo(HaveKey(metadata.SnapshotReleasesAnnotation))
+		})
+
+		It("should record the finished Release in the Snapshot", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+
+			result, err := adapter.EnsureReleaseIsRecordedInSnapshot()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(toolkit.GetObject(snapshot.Name, snapshot.Namespace, k8sClient, ctx, snapshot)).To(Succeed())
+			var records []snapshotReleaseRecord
+			Expect(json.Unmarshal([]byte(snapshot.GetAnnotations()[metadata.SnapshotReleasesAnnotation]),
+				&records)).To(Succeed())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Name).To(Equal(adapter.release.Name))
+			Expect(records[0].Target).To(Equal("default"))
+			Expect(records[0].Phase).To(Equal(v1alpha1.ReleasePhaseSucceeded))
+			Expect(records[0].CompletionTime).NotTo(BeNil())
+		})
+
+		It("should not record the Release twice", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleaseFailed("")
+
+			for i := 0; i < 2; i++ {
+				result, err := adapter.EnsureReleaseIsRecordedInSnapshot()
+				Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(toolkit.GetObject(snapshot.Name, snapshot.Namespace, k8sClient, ctx, snapshot)).To(Succeed())
+			var records []snapshotReleaseRecord
+			Expect(json.Unmarshal([]byte(snapshot.GetAnnotations()[metadata.SnapshotReleasesAnnotation]),
+				&records)).To(Succeed())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Phase).To(Equal(v1alpha1.ReleasePhaseFailed))
+		})
+
+		It("should only keep the most recent Releases", func() {
+			records := make([]snapshotReleaseRecord, snapshotReleasesLimit)
+			for i := range records {
+				records[i].Name = fmt.Sprintf("release-%d", i)
+			}
+			value, err := json.Marshal(records)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(toolkit.GetObject(snapshot.Name, snapshot.Namespace, k8sClient, ctx, snapshot)).To(Succeed())
+			patch := client.MergeFrom(snapshot.DeepCopy())
+			metadata.AddAnnotations(snapshot, map[string]string{metadata.SnapshotReleasesAnnotation: string(value)})
+			Expect(k8sClient.Patch(ctx, snapshot, patch)).To(Succeed())
+
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+
+			result, err := adapter.EnsureReleaseIsRecordedInSnapshot()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(toolkit.GetObject(snapshot.Name, snapshot.Namespace, k8sClient, ctx, snapshot)).To(Succeed())
+			records = nil
+			Expect(json.Unmarshal([]byte(snapshot.GetAnnotations()[metadata.SnapshotReleasesAnnotation]),
+				&records)).To(Succeed())
+			Expect(records).To(HaveLen(snapshotReleasesLimit))
+			Expect(records[0].Name).To(Equal("release-1"))
+			Expect(records[snapshotReleasesLimit-1].Name).To(Equal(adapter.release.Name))
+		})
+
+		It("should continue if the Snapshot doesn't exist", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsRecordedInSnapshot()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the conflict error if the Snapshot changed since it was read", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+
+			outdatedSnapshot := &applicationapiv1alpha1.Snapshot{}
+			Expect(toolkit.GetObject(snapshot.Name, snapshot.Namespace, k8sClient, ctx, outdatedSnapshot)).To(Succeed())
+			patch := client.MergeFrom(snapshot.DeepCopy())
+			metadata.AddAnnotations(snapshot, map[string]string{metadata.SnapshotReleasesAnnotation: "[]"})
+			Expect(k8sClient.Patch(ctx, snapshot, patch)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   outdatedSnapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsRecordedInSnapshot()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(errors.IsConflict(err)).To(BeTrue())
+		})
+	})
+
+	When("acquireReleaseLock is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      getReleaseLockName("app", "target"),
+					Namespace: "default",
+				},
+			})
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should create the lock if it doesn't exist", func() {
+			acquired, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(acquired).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			lease := &coordinationv1.Lease{}
+			Expect(adapter.client.Get(ctx, types.NamespacedName{
+				Name:      getReleaseLockName("app", "target"),
+				Namespace: "default",
+			}, lease)).To(Succeed())
+			Expect(*lease.Spec.HolderIdentity).To(Equal(adapter.release.Name))
+		})
+
+		It("should return true if the lock is already held by the Release", func() {
+			_, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			acquired, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(acquired).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return false if the lock is held by a Release still processing", func() {
+			holder := "holder-release"
+			Expect(adapter.client.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      getReleaseLockName("app", "target"),
+					Namespace: "default",
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity: &holder,
+				},
+			})).To(Succeed())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource: &v1alpha1.Release{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      holder,
+							Namespace: "default",
+						},
+					},
+				},
+			})
+
+			acquired, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(acquired).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should take over the lock if the holder Release doesn't exist", func() {
+			holder := "holder-release"
+			Expect(adapter.client.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      getReleaseLockName("app", "target"),
+					Namespace: "default",
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity: &holder,
+				},
+			})).To(Succeed())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			acquired, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(acquired).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should queue the Release as next holder if the lock is held by a Release still processing", func() {
+			holder := "holder-release"
+			Expect(adapter.client.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      getReleaseLockName("app", "target"),
+					Namespace: "default",
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity: &holder,
+				},
+			})).To(Succeed())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource: &v1alpha1.Release{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      holder,
+							Namespace: "default",
+						},
+					},
+				},
+			})
+
+			acquired, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(acquired).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+
+			lease := &coordinationv1.Lease{}
+			Expect(adapter.client.Get(ctx, types.NamespacedName{
+				Name:      getReleaseLockName("app", "target"),
+				Namespace: "default",
+			}, lease)).To(Succeed())
+			Expect(lease.Annotations).To(HaveKeyWithValue(metadata.ReleaseLockNextHolderAnnotation, adapter.release.Name))
+		})
+
+		When("another Release is next in line", func() {
+			var queuedRelease *v1alpha1.Release
+
+			AfterEach(func() {
+				_ = adapter.client.Delete(ctx, queuedRelease)
+			})
+
+			BeforeEach(func() {
+				queuedRelease = &v1alpha1.Release{
+					ObjectMeta: metav1.ObjectMeta{
+						GenerateName: "queued-release-",
+						Namespace:    "default",
+					},
+					Spec: v1alpha1.ReleaseSpec{
+						Snapshot:    snapshot.Name,
+						ReleasePlan: releasePlan.Name,
+					},
+				}
+				Expect(adapter.client.Create(ctx, queuedRelease)).To(Succeed())
+				queuedRelease.MarkQueued("")
+
+				Expect(adapter.client.Create(ctx, &coordinationv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      getReleaseLockName("app", "target"),
+						Namespace: "default",
+						Annotations: map[string]string{
+							metadata.ReleaseLockNextHolderAnnotation: queuedRelease.Name,
+						},
+					},
+				})).To(Succeed())
+
+				adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+					{
+						ContextKey: loader.ReleaseContextKey,
+						Resource:   queuedRelease,
+					},
+				})
+			})
+
+			It("should not take over a free lock", func() {
+				acquired, err := adapter.acquireReleaseLock("app", "target", false)
+				Expect(acquired).To(BeFalse())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not preempt the queued Release if preemption is disabled", func() {
+				adapter.release.Spec.Priority = v1alpha1.ReleasePriorityUrgent
+
+				acquired, err := adapter.acquireReleaseLock("app", "target", false)
+				Expect(acquired).To(BeFalse())
+				Expect(err).NotTo(HaveOccurred())
+
+				lease := &coordinationv1.Lease{}
+				Expect(adapter.client.Get(ctx, types.NamespacedName{
+					Name:      getReleaseLockName("app", "target"),
+					Namespace: "default",
+				}, lease)).To(Succeed())
+				Expect(lease.Annotations).To(HaveKeyWithValue(metadata.ReleaseLockNextHolderAnnotation, queuedRelease.Name))
+			})
+
+			It("should not preempt the queued Release if the Release is not urgent", func() {
+				acquired, err := adapter.acquireReleaseLock("app", "target", true)
+				Expect(acquired).To(BeFalse())
+				Expect(err).NotTo(HaveOccurred())
+
+				lease := &coordinationv1.Lease{}
+				Expect(adapter.client.Get(ctx, types.NamespacedName{
+					Name:      getReleaseLockName("app", "target"),
+					Namespace: "default",
+				}, lease)).To(Succeed())
+				Expect(lease.Annotations).To(HaveKeyWithValue(metadata.ReleaseLockNextHolderAnnotation, queuedRelease.Name))
+			})
+
+			It("should preempt the queued Release if the Release is urgent and preemption is enabled", func() {
+				adapter.release.Spec.Priority = v1alpha1.ReleasePriorityUrgent
+
+				acquired, err := adapter.acquireReleaseLock("app", "target", true)
+				Expect(acquired).To(BeFalse())
+				Expect(err).NotTo(HaveOccurred())
+
+				lease := &coordinationv1.Lease{}
+				Expect(adapter.client.Get(ctx, types.NamespacedName{
+					Name:      getReleaseLockName("app", "target"),
+					Namespace: "default",
+				}, lease)).To(Succeed())
+				Expect(lease.Annotations).To(HaveKeyWithValue(metadata.ReleaseLockNextHolderAnnotation, adapter.release.Name))
+
+				Expect(adapter.client.Get(ctx, types.NamespacedName{
+					Name:      queuedRelease.Name,
+					Namespace: "default",
+				}, queuedRelease)).To(Succeed())
+				Expect(queuedRelease.IsQueued()).To(BeTrue())
+				Expect(queuedRelease.Status.Summary.Message).To(ContainSubstring(adapter.release.Name))
+			})
+		})
+	})
+
+	When("captureFailureLogs is called", func() {
+		var adapter *adapter
+		var pipelineRun *tektonv1.PipelineRun
+		var taskRun *tektonv1.TaskRun
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, taskRun)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.podLogsGetter = &mockPodLogsGetter{}
+
+			taskRun = &tektonv1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "task-run-",
+					Namespace:    "default",
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, taskRun)).To(Succeed())
+			taskRun.Status.PodName = "pod"
+			taskRun.Status.MarkResourceFailed(tektonv1.TaskRunReasonFailed, nil)
+			taskRun.Status.Steps = []tektonv1.StepState{{Name: "step", Container: "step-step"}}
+			Expect(adapter.client.Status().Update(adapter.ctx, taskRun)).To(Succeed())
+
+			pipelineRun = &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+					Labels: map[string]string{
+						metadata.PipelinesTypeLabel: metadata.ManagedPipelineType,
+					},
+				},
+			}
+			pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{
+					TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+					Name:             taskRun.Name,
+					PipelineTaskName: "task",
+				},
+			}
+		})
+
+		It("should do nothing if the adapter can't retrieve Pod logs", func() {
+			adapter.podLogsGetter = nil
+			Expect(adapter.captureFailureLogs(pipelineRun, &adapter.release.Status.ManagedProcessing)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.LogsConfigMap).To(BeEmpty())
+		})
+
+		It("should save the logs of the failed tasks in a ConfigMap owned by the Release", func() {
+			Expect(adapter.captureFailureLogs(pipelineRun, &adapter.release.Status.ManagedProcessing)).To(Succeed())
+
+			configMapName := adapter.release.Name + "-managed-logs"
+			Expect(adapter.release.Status.ManagedProcessing.LogsConfigMap).To(Equal("default/" + configMapName))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(toolkit.GetObject(configMapName, "default", adapter.client, adapter.ctx, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("task.step.log", "step-step"))
+			Expect(configMap.OwnerReferences).To(HaveLen(1))
+			Expect(configMap.OwnerReferences[0].Name).To(Equal(adapter.release.Name))
+
+			Expect(adapter.client.Delete(adapter.ctx, configMap)).To(Succeed())
+		})
+	})
+
+	When("cleanupProcessingResources is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("removes the roleBinding if present", func() {
+			newRoleBinding := &rbac.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "new-role-binding",
+					Namespace: "default",
+				},
+				RoleRef: rbac.RoleRef{
+					APIGroup: rbac.GroupName,
+					Kind:     "ClusterRole",
+					Name:     "clusterrole",
+				},
+			}
+			// The resource needs to be created as it will get patched
+			Expect(adapter.client.Create(adapter.ctx, newRoleBinding)).To(Succeed())
+
+			err := adapter.cleanupProcessingResources(nil, newRoleBinding)
+			Expect(err).NotTo(HaveOccurred())
+
+			checkRoleBinding := &rbac.RoleBinding{}
+			err = toolkit.GetObject(newRoleBinding.Name, newRoleBinding.Namespace, adapter.client, adapter.ctx, checkRoleBinding)
+			Expect(checkRoleBinding).To(Equal(&rbac.RoleBinding{}))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("removes the pipelineRun if present", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pipeline-run",
+					Namespace:  "default",
+					Finalizers: []string{metadata.ReleaseFinalizer},
+				},
+			}
+			// The resource needs to be created as it will get patched
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
+
+			err := adapter.cleanupProcessingResources(pipelineRun, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			checkPipelineRun := &tektonv1.PipelineRun{}
+			err = toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkPipelineRun).NotTo(BeNil())
+			Expect(checkPipelineRun.Finalizers).To(HaveLen(0))
+
+			// Cleanup as only the finalizer was removed
+			Expect(adapter.client.Delete(adapter.ctx, checkPipelineRun)).To(Succeed())
+		})
+
+		It("should not error if either resource is nil", func() {
+			err := adapter.cleanupProcessingResources(nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("createOrUpdateSnapshotEnvironmentBinding is called", func() {
+		var adapter *adapter
+		var newSnapshot *applicationapiv1alpha1.Snapshot
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+
+			newSnapshot = snapshot.DeepCopy()
+			newSnapshot.Spec.Components = []applicationapiv1alpha1.SnapshotComponent{
+				{Name: "component", ContainerImage: "quay.io/foo/bar:latest"},
+			}
+		})
+
+		It("should create a binding annotated with the Release if none exists", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			binding, err := adapter.createOrUpdateSnapshotEnvironmentBinding(newSnapshot, "environment", "default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binding.Name).To(HavePrefix(fmt.Sprintf("%s-environment-", application.Name)))
+			Expect(binding.Spec.Snapshot).To(Equal(newSnapshot.Name))
+			Expect(binding.Spec.Components).To(HaveLen(1))
+			Expect(binding.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
+
+			Expect(k8sClient.Delete(ctx, binding)).To(Succeed())
+		})
+
+		It("should update the existing binding keeping the component configuration", func() {
+			replicas := 3
+			existingBinding := &applicationapiv1alpha1.SnapshotEnvironmentBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-binding",
+					Namespace: "default",
+				},
+				Spec: applicationapiv1alpha1.SnapshotEnvironmentBindingSpec{
+					Application: application.Name,
+					Environment: "environment",
+					Snapshot:    "old-snapshot",
+					Components: []applicationapiv1alpha1.BindingComponent{
+						{
+							Name:          "component",
+							Configuration: applicationapiv1alpha1.BindingComponentConfiguration{Replicas: &replicas},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, existingBinding)).To(Succeed())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
+					Resource:   existingBinding,
+				},
+			})
+
+			binding, err := adapter.createOrUpdateSnapshotEnvironmentBinding(newSnapshot, "environment", "default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binding.Name).To(Equal(existingBinding.Name))
+			Expect(binding.Spec.Snapshot).To(Equal(newSnapshot.Name))
+			Expect(*binding.Spec.Components[0].Configuration.Replicas).To(Equal(replicas))
+
+			Expect(k8sClient.Delete(ctx, binding)).To(Succeed())
+		})
+	})
+
+	When("createTenantPipelineRun is called", func() {
+		var (
+			adapter        *adapter
+			pipelineRun    *tektonv1.PipelineRun
+			newReleasePlan *v1alpha1.ReleasePlan
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+
+			parameterizedPipeline := tektonutils.ParameterizedPipeline{}
+			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
+				Resolver: "git",
+				Params: []tektonutils.Param{
+					{Name: "url", Value: "my-url"},
+					{Name: "revision", Value: "my-revision"},
+					{Name: "pathInRepo", Value: "my-path"},
+				},
+			}
+			parameterizedPipeline.Params = []tektonutils.Param{
+				{Name: "parameter1", Value: "value1"},
+				{Name: "parameter2", Value: "value2"},
+			}
+			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+			}
+
+			newReleasePlan = &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application:            application.Name,
+					Pipeline:               &parameterizedPipeline,
+					ReleaseGracePeriodDays: 6,
+				},
+			}
+			newReleasePlan.Kind = "ReleasePlan"
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+			})
+
+			var err error
+			pipelineRun, err = adapter.createTenantPipelineRun(newReleasePlan, snapshot)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns a PipelineRun with the right prefix", func() {
+			Expect(reflect.TypeOf(pipelineRun)).To(Equal(reflect.TypeOf(&tektonv1.PipelineRun{})))
+			Expect(pipelineRun.Name).To(HavePrefix("tenant"))
+		})
+
+		It("has the release reference", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(adapter.release.Kind))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", adapter.release.Namespace, types.Separator, adapter.release.Name))))
+		})
+
+		It("has the releasePlan reference", func() {
+			name := []rune(releasePlan.Kind)
+			name[0] = unicode.ToLower(name[0])
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", releasePlan.Namespace, types.Separator, releasePlan.Name))))
+		})
+
+		It("has the snapshot reference", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(snapshot.Kind))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
+		})
+
+		It("has owner annotations", func() {
+			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
+			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
+		})
+
+		It("has release labels", func() {
+			Expect(pipelineRun.GetLabels()[metadata.PipelinesTypeLabel]).To(Equal(metadata.TenantPipelineType))
+			Expect(pipelineRun.GetLabels()[metadata.ReleaseNameLabel]).To(Equal(adapter.release.Name))
+			Expect(pipelineRun.GetLabels()[metadata.ReleaseNamespaceLabel]).To(Equal(testNamespace))
+			Expect(pipelineRun.GetLabels()[metadata.ReleaseSnapshotLabel]).To(Equal(adapter.release.Spec.Snapshot))
+		})
+
+		It("contains a parameter with the taskGitUrl", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitUrl")))
+			var url string
+			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
+			for i := range resolverParams {
+				if resolverParams[i].Name == "url" {
+					url = resolverParams[i].Value.StringVal
+				}
+			}
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", url)))
+		})
+
+		It("contains a parameter with the taskGitRevision", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitRevision")))
+			var revision string
+			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
+			for i := range resolverParams {
+				if resolverParams[i].Name == "revision" {
+					revision = resolverParams[i].Value.StringVal
+				}
+			}
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", revision)))
+		})
+
+		It("contains the proper timeout value", func() {
+			Expect(pipelineRun.Spec.Timeouts.Pipeline).To(Equal(newReleasePlan.Spec.Pipeline.Timeouts.Pipeline))
+		})
+	})
+
+	When("createDataSecret is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Spec.Data = &runtime.RawExtension{
+				Raw: []byte(`{"mapping": {"token": {"secretKeyRef": {"name": "secret", "key": "token"}}}}`),
+			}
+		})
+
+		It("should return nil if the Release data doesn't reference any Secret", func() {
+			adapter.release.Spec.Data = nil
+
+			dataSecret, err := adapter.createDataSecret("default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataSecret).To(BeNil())
+		})
+
+		It("should create a Secret containing the referenced values indexed by their path", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SecretContextKey,
+					Resource: &corev1.Secret{
+						Data: map[string][]byte{"token": []byte("foo")},
+					},
+				},
+			})
+
+			dataSecret, err := adapter.createDataSecret("default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataSecret.Name).To(HavePrefix(adapter.release.Name))
+			Expect(dataSecret.Data).To(HaveKeyWithValue("mapping.token", []byte("foo")))
+
+			Expect(k8sClient.Delete(ctx, dataSecret)).To(Succeed())
+		})
+
+		It("should fail if the referenced Secret doesn't contain the key", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SecretContextKey,
+					Resource:   &corev1.Secret{},
+				},
+			})
+
+			dataSecret, err := adapter.createDataSecret("default")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not contain the key token"))
+			Expect(dataSecret).To(BeNil())
+		})
+
+		It("should fail if the referenced Secret is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SecretContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			dataSecret, err := adapter.createDataSecret("default")
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(dataSecret).To(BeNil())
+		})
+
+		It("should add the decrypted values to the Secret indexed by their path", func() {
+			adapter.release.Spec.Data = &runtime.RawExtension{
+				Raw: []byte(`{"mapping": {"token": {"encrypted": "envelope:v1:foo"}}}`),
+			}
+			adapter.decrypter = &mockDecrypter{}
+
+			dataSecret, err := adapter.createDataSecret("default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataSecret.Data).To(HaveKeyWithValue("mapping.token", []byte("decrypted envelope:v1:foo")))
+
+			Expect(k8sClient.Delete(ctx, dataSecret)).To(Succeed())
+		})
+
+		It("should fail if the data contains encrypted values and no KMS key is configured", func() {
+			adapter.release.Spec.Data = &runtime.RawExtension{
+				Raw: []byte(`{"mapping": {"token": {"encrypted": "envelope:v1:foo"}}}`),
+			}
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.DataEncryption = nil
+
+			dataSecret, err := adapter.createDataSecret("default")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no KMS key is configured"))
+			Expect(dataSecret).To(BeNil())
+		})
+	})
+
+	When("createFollowUpRelease is called", func() {
+		var adapter *adapter
+		var followUpRelease *v1alpha1.Release
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, followUpRelease)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Annotations = map[string]string{metadata.SkipTasksAnnotation: "verify"}
+			adapter.release.Spec.IdempotencyKey = "key"
+			adapter.release.Status.Components = []v1alpha1.ComponentStatus{
+				{Name: "foo", Phase: v1alpha1.ComponentPhaseFailed},
+				{Name: "bar", Phase: v1alpha1.ComponentPhaseSucceeded},
+				{Name: "baz", Phase: v1alpha1.ComponentPhaseFailed},
+			}
+
+			var err error
+			followUpRelease, err = adapter.createFollowUpRelease()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates a Release named after the original one", func() {
+			Expect(followUpRelease.Name).To(Equal(adapter.release.Name + "-retry"))
+			Expect(followUpRelease.Namespace).To(Equal(adapter.release.Namespace))
+		})
+
+		It("lists the failed components in the components annotation", func() {
+			Expect(followUpRelease.Annotations).To(HaveKeyWithValue(metadata.ComponentsAnnotation, "foo,baz"))
+		})
+
+		It("keeps the skipped tasks of the original Release", func() {
+			Expect(followUpRelease.Annotations).To(HaveKeyWithValue(metadata.SkipTasksAnnotation, "verify"))
+		})
+
+		It("copies the spec of the original Release without its idempotency key", func() {
+			Expect(followUpRelease.Spec.Snapshot).To(Equal(adapter.release.Spec.Snapshot))
+			Expect(followUpRelease.Spec.ReleasePlan).To(Equal(adapter.release.Spec.ReleasePlan))
+			Expect(followUpRelease.Spec.IdempotencyKey).To(BeEmpty())
+		})
+
+		It("fails with an AlreadyExists error if the follow-up Release was already created", func() {
+			_, err := adapter.createFollowUpRelease()
+			Expect(errors.IsAlreadyExists(err)).To(BeTrue())
+		})
+	})
+
+	When("createManagedPipelineRun is called", func() {
+		var (
+			adapter     *adapter
+			pipelineRun *tektonv1.PipelineRun
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig = releaseServiceConfig
+			resources := &loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources, nil, nil)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns a PipelineRun with the right prefix", func() {
+			Expect(reflect.TypeOf(pipelineRun)).To(Equal(reflect.TypeOf(&tektonv1.PipelineRun{})))
+			Expect(pipelineRun.Name).To(HavePrefix("managed"))
+		})
+
+		It("binds the data Secret as a workspace when one is given", func() {
+			dataSecretPipelineRun, err := adapter.createManagedPipelineRun(&loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "data-secret"}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataSecretPipelineRun.Spec.Workspaces).To(ContainElement(HaveField("Name", dataSecretsWorkspaceName)))
+			Expect(dataSecretPipelineRun.Spec.Workspaces).To(ContainElement(HaveField("Secret.SecretName", "data-secret")))
+			Expect(pipelineRun.Spec.Workspaces).NotTo(ContainElement(HaveField("Name", dataSecretsWorkspaceName)))
+
+			Expect(k8sClient.Delete(ctx, dataSecretPipelineRun)).To(Succeed())
+		})
+
+		It("binds the registry credentials Secret as a workspace when one is given", func() {
+			credentialsPipelineRun, err := adapter.createManagedPipelineRun(&loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}, nil, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "credentials-secret"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentialsPipelineRun.Spec.Workspaces).To(ContainElement(
+				HaveField("Name", registryCredentialsWorkspaceName)))
+			Expect(credentialsPipelineRun.Spec.Workspaces).To(ContainElement(
+				HaveField("Secret.SecretName", "credentials-secret")))
+
+			Expect(k8sClient.Delete(ctx, credentialsPipelineRun)).To(Succeed())
+		})
+
+		It("uses the Pipeline of the strategy selected by the Release", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Strategies = []v1alpha1.ReleaseStrategy{
+				{
+					Name: "hotfix",
+					Pipeline: &tektonutils.Pipeline{
+						PipelineRef:        releasePlanAdmission.Spec.Pipeline.PipelineRef,
+						ServiceAccountName: "hotfix-service-account",
+					},
+				},
+			}
+			adapter.release.Spec.Strategy = "hotfix"
+
+			strategyPipelineRun, err := adapter.createManagedPipelineRun(&loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        newReleasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strategyPipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("hotfix-service-account"))
+
+			Expect(k8sClient.Delete(ctx, strategyPipelineRun)).To(Succeed())
+		})
+
+		It("passes the params of the catalog Pipeline", func() {
+			catalogPipelineRun, err := adapter.createManagedPipelineRun(&loader.ProcessingResources{
+				CatalogPipeline: &tektonutils.ParameterizedPipeline{
+					Pipeline: *releasePlanAdmission.Spec.Pipeline,
+					Params:   []tektonutils.Param{{Name: "catalog-param", Value: "catalog-value"}},
+				},
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(catalogPipelineRun.Spec.Params).To(ContainElement(HaveField("Name", "catalog-param")))
+			Expect(pipelineRun.Spec.Params).NotTo(ContainElement(HaveField("Name", "catalog-param")))
+
+			Expect(k8sClient.Delete(ctx, catalogPipelineRun)).To(Succeed())
+		})
+
+		It("creates the PipelineRun in the execution namespace of the ReleasePlanAdmission", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .OriginNamespace }}"
+			Expect(adapter.ensureNamespaceExists("releases-" + adapter.release.Namespace)).To(Succeed())
+
+			executionPipelineRun, err := adapter.createManagedPipelineRun(&loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        newReleasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(executionPipelineRun.Namespace).To(Equal("releases-" + adapter.release.Namespace))
+
+			Expect(k8sClient.Delete(ctx, executionPipelineRun)).To(Succeed())
+		})
+
+		It("has the release reference", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(adapter.release.Kind))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", adapter.release.Namespace, types.Separator, adapter.release.Name))))
+		})
+
+		It("has the releasePlan reference", func() {
+			name := []rune(releasePlan.Kind)
+			name[0] = unicode.ToLower(name[0])
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", releasePlan.Namespace, types.Separator, releasePlan.Name))))
+		})
+
+		It("has the releasePlanAdmission reference", func() {
+			name := []rune(releasePlanAdmission.Kind)
+			name[0] = unicode.ToLower(name[0])
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name))))
+		})
+
+		It("has the releaseServiceConfig reference", func() {
+			name := []rune(releaseServiceConfig.Kind)
+			name[0] = unicode.ToLower(name[0])
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", releaseServiceConfig.Namespace, types.Separator, releaseServiceConfig.Name))))
+		})
+
+		It("has the snapshot reference", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(snapshot.Kind))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
+		})
+
+		It("has owner annotations", func() {
+			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
+			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
+		})
+
+		It("has release labels", func() {
+			Expect(pipelineRun.GetLabels()[metadata.PipelinesTypeLabel]).To(Equal(metadata.ManagedPipelineType))
+			Expect(pipelineRun.GetLabels()[metadata.ReleaseNameLabel]).To(Equal(adapter.release.Name))
+			Expect(pipelineRun.GetLabels()[metadata.ReleaseNamespaceLabel]).To(Equal(testNamespace))
+			Expect(pipelineRun.GetLabels()[metadata.ReleaseSnapshotLabel]).To(Equal(adapter.release.Spec.Snapshot))
+		})
+
+		It("references the pipeline specified in the ReleasePlanAdmission", func() {
+			var pipelineUrl string
+			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
+			for i := range resolverParams {
+				if resolverParams[i].Name == "url" {
+					pipelineUrl = resolverParams[i].Value.StringVal
+				}
+			}
+			Expect(pipelineUrl).To(Equal(releasePlanAdmission.Spec.Pipeline.PipelineRef.Params[0].Value))
+		})
+
+		It("contains a parameter with the taskGitUrl", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitUrl")))
+			var url string
+			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
+			for i := range resolverParams {
+				if resolverParams[i].Name == "url" {
+					url = resolverParams[i].Value.StringVal
+				}
+			}
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", url)))
+		})
+
+		It("contains a parameter with the taskGitRevision", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "taskGitRevision")))
+			var revision string
+			resolverParams := pipelineRun.Spec.PipelineRef.ResolverRef.Params
+			for i := range resolverParams {
+				if resolverParams[i].Name == "revision" {
+					revision = resolverParams[i].Value.StringVal
+				}
+			}
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", revision)))
+		})
+
+		It("contains the proper timeout value", func() {
+			Expect(pipelineRun.Spec.Timeouts.Pipeline).To(Equal(releasePlanAdmission.Spec.Pipeline.Timeouts.Pipeline))
+		})
+
+		It("contains a parameter with the verify ec task bundle", func() {
+			bundle := enterpriseContractConfigMap.Data["verify_ec_task_bundle"]
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(bundle)))))
+		})
+
+		It("contains a parameter with the json representation of the EnterpriseContractPolicy", func() {
+			jsonSpec, _ := json.Marshal(enterpriseContractPolicy.Spec)
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
+		})
+	})
+
+	When("createPipelineRun is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("creates the PipelineRun with every param inline if they fit", func() {
+			pipelineRun, err := adapter.createPipelineRun(tektonutils.NewPipelineRunBuilder("test", "default").
+				WithPipelineRef(&tektonv1.PipelineRef{Name: "pipeline"}).
+				WithParams(tektonv1.Param{
+					Name:  "small",
+					Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value"},
+				}), "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(pipelineRun.Spec.Workspaces).NotTo(ContainElement(HaveField("Name", paramsWorkspaceName)))
+
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("moves the oversized params to a ConfigMap owned by the PipelineRun", func() {
+			value := strings.Repeat("a", maxInlineParamSize+1)
+			pipelineRun, err := adapter.createPipelineRun(tektonutils.NewPipelineRunBuilder("test", "default").
+				WithPipelineRef(&tektonv1.PipelineRef{Name: "pipeline"}).
+				WithParams(tektonv1.Param{
+					Name:  "big",
+					Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: value},
+				}), "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.Params).To(ContainElement(HaveField("Name", "big"+tektonutils.OverflowPathParamSuffix)))
+			Expect(pipelineRun.Spec.Workspaces).To(ContainElement(HaveField("Name", paramsWorkspaceName)))
+
+			configMap := &corev1.ConfigMap{}
+			for _, workspace := range pipelineRun.Spec.Workspaces {
+				if workspace.Name == paramsWorkspaceName {
+					Expect(k8sClient.Get(ctx, types.NamespacedName{
+						Name:      workspace.ConfigMap.Name,
+						Namespace: pipelineRun.Namespace,
+					}, configMap)).To(Succeed())
+				}
+			}
+			Expect(configMap.Data).To(HaveKeyWithValue("big", value))
+			Expect(configMap.OwnerReferences).To(ContainElement(HaveField("Name", pipelineRun.Name)))
+
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+		})
+
+		It("fails if the executor is unknown", func() {
+			_, err := adapter.createPipelineRun(tektonutils.NewPipelineRunBuilder("test", "default").
+				WithPipelineRef(&tektonv1.PipelineRef{Name: "pipeline"}), "unknown")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown release pipeline executor unknown"))
+		})
+	})
+
+	When("createRegistryCredentialsSecret is called", func() {
+		var adapter *adapter
+		var broker *mockBroker
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			broker = &mockBroker{}
+			adapter.broker = broker
+		})
+
+		It("should return nil if the ReleasePlanAdmission doesn't request registry credentials", func() {
+			credentialsSecret, err := adapter.createRegistryCredentialsSecret("default", releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentialsSecret).To(BeNil())
+			Expect(broker.issued).To(BeEmpty())
+		})
+
+		It("should create a dockerconfigjson Secret with credentials scoped to the repositories", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.RegistryCredentials = &v1alpha1.RegistryCredentials{
+				Repositories: []string{"quay.io/org/foo"},
+			}
+
+			credentialsSecret, err := adapter.createRegistryCredentialsSecret("default", newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentialsSecret.Name).To(HavePrefix(adapter.release.Name + "-registry-credentials-"))
+			Expect(credentialsSecret.Type).To(Equal(corev1.SecretTypeDockerConfigJson))
+			Expect(credentialsSecret.Data).To(HaveKey(corev1.DockerConfigJsonKey))
+			Expect(credentialsSecret.Data).To(HaveKeyWithValue("password", []byte("password")))
+			Expect(credentialsSecret.Labels).To(HaveKeyWithValue(metadata.ReleaseNameLabel, adapter.release.Name))
+			Expect(credentialsSecret.Annotations).To(HaveKeyWithValue(metadata.RegistryCredentialsAnnotation,
+				adapter.getRegistryCredentialsName()))
+			Expect(credentialsSecret.Finalizers).To(ContainElement(metadata.ReleaseFinalizer))
+			Expect(broker.issued).To(Equal([]string{"quay.io/org/foo"}))
+
+			Expect(adapter.revokeRegistryCredentials(credentialsSecret)).To(Succeed())
+		})
+
+		It("should fail if no credentials broker is configured", func() {
+			adapter.broker = nil
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.CredentialsBroker = nil
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.RegistryCredentials = &v1alpha1.RegistryCredentials{
+				Repositories: []string{"quay.io/org/foo"},
+			}
+
+			credentialsSecret, err := adapter.createRegistryCredentialsSecret("default", newReleasePlanAdmission)
+			Expect(err).To(MatchError(credentials.ErrNoBroker))
+			Expect(credentialsSecret).To(BeNil())
+		})
+	})
+
+	When("createRoleBindingForClusterRole is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("fails when the releasePlanAdmission has no serviceAccount", func() {
+			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan-admission",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanAdmissionSpec{
+					Applications: []string{application.Name},
+					Origin:       "default",
+					Pipeline: &tektonutils.Pipeline{
+						PipelineRef: tektonutils.PipelineRef{
+							Resolver: "git",
+							Params: []tektonutils.Param{
+								{Name: "url", Value: "my-url"},
+								{Name: "revision", Value: "my-revision"},
+								{Name: "pathInRepo", Value: "my-path"},
+							},
+						},
+					},
+					Policy: enterpriseContractPolicy.Name,
+				},
+			}
+			roleBinding, err := adapter.createRoleBindingForClusterRole("foo", newReleasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(roleBinding).To(BeNil())
+			Expect(err.Error()).To(ContainSubstring("is invalid"))
+		})
+
+		It("creates a new roleBinding", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			roleBinding, err := adapter.createRoleBindingForClusterRole("foo", releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roleBinding).NotTo(BeNil())
+			Expect(roleBinding.RoleRef.Name).To(Equal("foo"))
+			Expect(roleBinding.Subjects[0].Namespace).To(Equal(releasePlanAdmission.Namespace))
+			Expect(roleBinding.Labels).To(HaveKeyWithValue(metadata.ManagedByLabel, metadata.ManagedByLabelValue))
+
+			Expect(k8sClient.Delete(ctx, roleBinding)).Should(Succeed())
+		})
+
+		It("binds the serviceAccount of the execution namespace", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.ExecutionNamespace = "releases-{{ .OriginNamespace }}"
+
+			roleBinding, err := adapter.createRoleBindingForClusterRole("foo", newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roleBinding.Subjects[0].Namespace).To(Equal("releases-" + adapter.release.Namespace))
+
+			Expect(k8sClient.Delete(ctx, roleBinding)).Should(Succeed())
+		})
+	})
+
+	When("ensureNamespaceExists is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("creates the namespace if it doesn't exist", func() {
+			Expect(adapter.ensureNamespaceExists("execution-namespace")).To(Succeed())
+
+			namespace := &corev1.Namespace{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "execution-namespace"}, namespace)).To(Succeed())
+		})
+
+		It("does nothing if the namespace already exists", func() {
+			Expect(adapter.ensureNamespaceExists("default")).To(Succeed())
+		})
+	})
+
+	When("ensurePipelineRunIsNotStalled is called", func() {
+		var adapter *adapter
+		var pipelineRun *tektonv1.PipelineRun
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, pipelineRun)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun = &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    "default",
+					Finalizers:   []string{metadata.ReleaseFinalizer},
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should continue if the PipelineRun is done", func() {
+			pipelineRun.Status.MarkSucceeded("", "")
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should continue if no StalledPipelineRunPolicy is set", func() {
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should requeue the Release until the timeout is reached if the PipelineRun is progressing", func() {
+			adapter.releaseServiceConfig.Spec.StalledPipelineRunPolicy = &v1alpha1.StalledPipelineRunPolicy{
+				Timeout: metav1.Duration{Duration: time.Hour},
+			}
+
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", time.Hour, time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsStalled()).To(BeFalse())
+		})
+
+		It("should clear the Stalled condition once the PipelineRun progresses again", func() {
+			adapter.releaseServiceConfig.Spec.StalledPipelineRunPolicy = &v1alpha1.StalledPipelineRunPolicy{
+				Timeout: metav1.Duration{Duration: time.Hour},
+			}
+			adapter.release.MarkStalled("diagnostics")
+
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsStalled()).To(BeFalse())
+		})
+
+		It("should mark the Release as stalled if the PipelineRun is stalled", func() {
+			adapter.releaseServiceConfig.Spec.StalledPipelineRunPolicy = &v1alpha1.StalledPipelineRunPolicy{
+				Timeout: metav1.Duration{Duration: time.Nanosecond},
+			}
+
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsStalled()).To(BeTrue())
+
+			checkPipelineRun := &tektonv1.PipelineRun{}
+			Expect(toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)).To(Succeed())
+			Expect(checkPipelineRun.IsCancelled()).To(BeFalse())
+		})
+
+		It("should delete the PipelineRun so it gets created again if there are retries left", func() {
+			adapter.releaseServiceConfig.Spec.StalledPipelineRunPolicy = &v1alpha1.StalledPipelineRunPolicy{
+				Timeout:    metav1.Duration{Duration: time.Nanosecond},
+				Cancel:     true,
+				MaxRetries: 1,
+			}
+
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically(">", 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsStalled()).To(BeTrue())
+			Expect(adapter.release.Status.ManagedProcessing.Retries).To(Equal(1))
+
+			checkPipelineRun := &tektonv1.PipelineRun{}
+			err = toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should cancel the PipelineRun if there are no retries left", func() {
+			adapter.releaseServiceConfig.Spec.StalledPipelineRunPolicy = &v1alpha1.StalledPipelineRunPolicy{
+				Timeout: metav1.Duration{Duration: time.Nanosecond},
+				Cancel:  true,
+			}
+
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsStalled()).To(BeTrue())
+
+			checkPipelineRun := &tektonv1.PipelineRun{}
+			Expect(toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)).To(Succeed())
+			Expect(checkPipelineRun.IsCancelled()).To(BeTrue())
+
+			// Cleanup as the PipelineRun was only cancelled
+			Expect(adapter.cleanupProcessingResources(checkPipelineRun, nil)).To(Succeed())
+		})
+
+		It("should cancel the PipelineRun if the ReleasePlan retry budget is exhausted", func() {
+			newReleasePlan := releasePlan.DeepCopy()
+			newReleasePlan.Spec.RetryBudget = &v1alpha1.RetryBudget{
+				MaxRetries: 0,
+				Window:     metav1.Duration{Duration: time.Hour},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+			})
+			adapter.releaseServiceConfig.Spec.StalledPipelineRunPolicy = &v1alpha1.StalledPipelineRunPolicy{
+				Timeout:    metav1.Duration{Duration: time.Nanosecond},
+				Cancel:     true,
+				MaxRetries: 1,
+			}
+
+			result, err := adapter.ensurePipelineRunIsNotStalled(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.ManagedProcessing.Retries).To(Equal(0))
+
+			checkPipelineRun := &tektonv1.PipelineRun{}
+			Expect(toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)).To(Succeed())
+			Expect(checkPipelineRun.IsCancelled()).To(BeTrue())
+
+			// Cleanup as the PipelineRun was only cancelled
+			Expect(adapter.cleanupProcessingResources(checkPipelineRun, nil)).To(Succeed())
+		})
+	})
+
+	When("retryPipelineRunOnNodeFailure is called", func() {
+		var adapter *adapter
+		var pipelineRun *tektonv1.PipelineRun
+		var taskRun *tektonv1.TaskRun
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = adapter.client.Delete(ctx, pipelineRun)
+			_ = adapter.client.Delete(ctx, taskRun)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.podGetter = &mockPodGetter{}
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.NodeFailurePolicy = &v1alpha1.NodeFailurePolicy{MaxRetries: 1}
+
+			taskRun = &tektonv1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "task-run-",
+					Namespace:    "default",
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, taskRun)).To(Succeed())
+			taskRun.Status.PodName = taskRun.Name + "-pod"
+			taskRun.Status.SetCondition(&apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionFalse,
+			})
+			Expect(adapter.client.Status().Update(adapter.ctx, taskRun)).To(Succeed())
+
+			pipelineRun = &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    "default",
+					Finalizers:   []string{metadata.ReleaseFinalizer},
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
+			pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{
+					TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+					Name:     taskRun.Name,
+				},
+			}
+			adapter.release.Status.ManagedProcessing.PipelineRun = fmt.Sprintf("%s%c%s",
+				pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+		})
+
+		It("should not retry the PipelineRun if no NodeFailurePolicy is set", func() {
+			adapter.releaseServiceConfig.Spec.NodeFailurePolicy = nil
+
+			retried, err := adapter.retryPipelineRunOnNodeFailure(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(retried).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not retry the PipelineRun if there are no retries left", func() {
+			adapter.release.Status.ManagedProcessing.Retries = 1
+
+			retried, err := adapter.retryPipelineRunOnNodeFailure(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(retried).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not retry the PipelineRun if none of its tasks failed because of their node", func() {
+			pipelineRun.Status.ChildReferences = nil
+
+			retried, err := adapter.retryPipelineRunOnNodeFailure(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(retried).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should delete the PipelineRun and exclude the failed nodes if its tasks failed because of their node", func() {
+			retried, err := adapter.retryPipelineRunOnNodeFailure(pipelineRun, &adapter.release.Status.ManagedProcessing)
+			Expect(retried).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.ManagedProcessing.Retries).To(Equal(1))
+			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).To(BeEmpty())
+			Expect(adapter.release.Status.ManagedProcessing.ExcludedNodes).To(Equal([]string{"failed-node"}))
+
+			checkPipelineRun := &tektonv1.PipelineRun{}
+			err = toolkit.GetObject(pipelineRun.Name, pipelineRun.Namespace, adapter.client, adapter.ctx, checkPipelineRun)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("consumeReleasePlanRetryBudget is called", func() {
+		var adapter *adapter
+		var newReleasePlan *v1alpha1.ReleasePlan
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			newReleasePlan = &v1alpha1.ReleasePlan{}
+			Expect(toolkit.GetObject(releasePlan.Name, releasePlan.Namespace, k8sClient, ctx, newReleasePlan)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+			})
+		})
+
+		It("should allow the retry if the ReleasePlan has no retry budget", func() {
+			retry, err := adapter.consumeReleasePlanRetryBudget()
+			Expect(retry).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newReleasePlan.Status.RetryBudget.Consumed).To(Equal(0))
+		})
+
+		It("should allow the retry and consume the budget if there is budget left", func() {
+			newReleasePlan.Spec.RetryBudget = &v1alpha1.RetryBudget{
+				MaxRetries: 1,
+				Window:     metav1.Duration{Duration: time.Hour},
+			}
+
+			retry, err := adapter.consumeReleasePlanRetryBudget()
+			Expect(retry).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newReleasePlan.Status.RetryBudget.Consumed).To(Equal(1))
+		})
+
+		It("should not allow the retry if the budget is exhausted", func() {
+			newReleasePlan.Spec.RetryBudget = &v1alpha1.RetryBudget{
+				MaxRetries: 1,
+				Window:     metav1.Duration{Duration: time.Hour},
+			}
+			newReleasePlan.Status.RetryBudget.Retries = []metav1.Time{{Time: time.Now()}}
+
+			retry, err := adapter.consumeReleasePlanRetryBudget()
+			Expect(retry).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should check the budget again against the latest ReleasePlan if it changed since it was read", func() {
+			newReleasePlan.Status.RetryBudget = v1alpha1.RetryBudgetStatus{}
+			Expect(k8sClient.Status().Update(ctx, newReleasePlan)).To(Succeed())
+			newReleasePlan.Spec.RetryBudget = &v1alpha1.RetryBudget{
+				MaxRetries: 1,
+				Window:     metav1.Duration{Duration: time.Hour},
+			}
+			Expect(k8sClient.Update(ctx, newReleasePlan)).To(Succeed())
+			outdatedReleasePlan := newReleasePlan.DeepCopy()
+			defer func() {
+				Expect(toolkit.GetObject(releasePlan.Name, releasePlan.Namespace, k8sClient, ctx, newReleasePlan)).To(Succeed())
+				newReleasePlan.Status.RetryBudget = v1alpha1.RetryBudgetStatus{}
+				Expect(k8sClient.Status().Update(ctx, newReleasePlan)).To(Succeed())
+				newReleasePlan.Spec.RetryBudget = nil
+				Expect(k8sClient.Update(ctx, newReleasePlan)).To(Succeed())
+			}()
+
+			patch := client.MergeFrom(newReleasePlan.DeepCopy())
+			newReleasePlan.ConsumeRetry()
+			Expect(k8sClient.Status().Patch(ctx, newReleasePlan, patch)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   outdatedReleasePlan,
+				},
+			})
+
+			retry, err := adapter.consumeReleasePlanRetryBudget()
+			Expect(retry).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if the ReleasePlan can't be loaded", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			retry, err := adapter.consumeReleasePlanRetryBudget()
+			Expect(retry).To(BeFalse())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("finalizeRelease is called", func() {
+		var adapter *adapter
+		var parameterizedPipeline *tektonutils.ParameterizedPipeline
+		var newReleasePlan *v1alpha1.ReleasePlan
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			parameterizedPipeline = &tektonutils.ParameterizedPipeline{}
+			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
+				Resolver: "git",
+				Params: []tektonutils.Param{
+					{Name: "url", Value: "my-url"},
+					{Name: "revision", Value: "my-revision"},
+					{Name: "pathInRepo", Value: "my-path"},
+				},
+			}
+			parameterizedPipeline.Params = []tektonutils.Param{
+				{Name: "parameter1", Value: "value1"},
+				{Name: "parameter2", Value: "value2"},
+			}
+			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+			}
+			newReleasePlan = &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application:            application.Name,
+					Pipeline:               parameterizedPipeline,
+					ReleaseGracePeriodDays: 6,
+				},
+			}
+			newReleasePlan.Kind = "ReleasePlan"
+		})
+
+		It("finalizes the Release and removes the finalizer from the Tenant PipelineRun when called with false", func() {
+			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.finalizeRelease(false)).To(Succeed())
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Finalizers).To(HaveLen(0))
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("finalizes the Release and removes the finalizer from the Managed PipelineRun when called with false", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig
+			resources := &loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}
+			pipelineRun, err := adapter.createManagedPipelineRun(resources, nil, nil)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.finalizeRelease(false)).To(Succeed())
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Finalizers).To(HaveLen(0))
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("finalizes the Release and deletes the Tenant PipelineRun when called with true", func() {
+			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.finalizeRelease(true)).To(Succeed())
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).To(BeNil())
+		})
+
+		It("finalizes the Release and deletes the Managed PipelineRun when called with true", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig
+			resources := &loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}
+			pipelineRun, err := adapter.createManagedPipelineRun(resources, nil, nil)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.finalizeRelease(true)).To(Succeed())
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).To(BeNil())
+		})
+	})
+
+	When("getQueuedRelease is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return nil if the Release doesn't exist", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			release, err := adapter.getQueuedRelease("queued-release")
+			Expect(release).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return nil if the Release is not queued", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   &v1alpha1.Release{},
+				},
+			})
+
+			release, err := adapter.getQueuedRelease("queued-release")
+			Expect(release).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the Release if it's queued", func() {
+			queuedRelease := &v1alpha1.Release{}
+			queuedRelease.MarkQueued("")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   queuedRelease,
+				},
+			})
+
+			release, err := adapter.getQueuedRelease("queued-release")
+			Expect(release).To(Equal(queuedRelease))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("getCapacityShortage is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		requests := corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("4Gi"),
+		}
+
+		mockResources := func(resourceQuota *corev1.ResourceQuota, limitRange *corev1.LimitRange) {
+			resourceQuotas := &corev1.ResourceQuotaList{}
+			if resourceQuota != nil {
+				resourceQuotas.Items = append(resourceQuotas.Items, *resourceQuota)
+			}
+			limitRanges := &corev1.LimitRangeList{}
+			if limitRange != nil {
+				limitRanges.Items = append(limitRanges.Items, *limitRange)
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.LimitRangesContextKey,
+					Resource:   limitRanges,
+				},
+				{
+					ContextKey: loader.ResourceQuotasContextKey,
+					Resource:   resourceQuotas,
+				},
+			})
+		}
+
+		It("should return an empty string if there are no requests", func() {
+			shortage, err := adapter.getCapacityShortage("default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shortage).To(BeEmpty())
+		})
+
+		It("should return an empty string if the requests fit", func() {
+			mockResources(&corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+				Status: corev1.ResourceQuotaStatus{
+					Hard: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+					Used: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				},
+			}, nil)
+
+			shortage, err := adapter.getCapacityShortage("default", requests)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shortage).To(BeEmpty())
+		})
+
+		It("should return the shortage if a ResourceQuota has no room left for the requests", func() {
+			mockResources(&corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+				Status: corev1.ResourceQuotaStatus{
+					Hard: corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("8Gi")},
+					Used: corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("6Gi")},
+				},
+			}, nil)
+
+			shortage, err := adapter.getCapacityShortage("default", requests)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shortage).To(Equal("the memory request of 4Gi exceeds the 2Gi left in ResourceQuota quota"))
+		})
+
+		It("should return the shortage if a LimitRange caps the pods below the requests", func() {
+			mockResources(nil, &corev1.LimitRange{
+				ObjectMeta: metav1.ObjectMeta{Name: "limits"},
+				Spec: corev1.LimitRangeSpec{
+					Limits: []corev1.LimitRangeItem{
+						{
+							Type: corev1.LimitTypePod,
+							Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+						},
+					},
+				},
+			})
+
+			shortage, err := adapter.getCapacityShortage("default", requests)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shortage).To(Equal("the cpu request of 2 exceeds the pod maximum of 1 in LimitRange limits"))
+		})
+
+		It("should return an error if the ResourceQuotas can't be listed", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ResourceQuotasContextKey,
+					Err:        fmt.Errorf("some error"),
+				},
+			})
+
+			_, err := adapter.getCapacityShortage("default", requests)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("getEmptyReleaseServiceConfig is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return a ReleaseServiceConfig without Spec and with the right ObjectMeta and Kind set", func() {
+			releaseServiceConfig := adapter.getEmptyReleaseServiceConfig("namespace")
+			Expect(releaseServiceConfig).NotTo(BeNil())
+			Expect(releaseServiceConfig.Name).To(Equal(v1alpha1.ReleaseServiceConfigResourceName))
+			Expect(releaseServiceConfig.Namespace).To(Equal("namespace"))
+			Expect(releaseServiceConfig.Kind).To(Equal("ReleaseServiceConfig"))
+		})
+	})
+
+	When("getPipelineRef is called", func() {
+		var (
+			adapter  *adapter
+			pipeline *tektonutils.Pipeline
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+
+			pipeline = &tektonutils.Pipeline{
+				PipelineRef: tektonutils.PipelineRef{
+					Resolver: "git",
+					Params: []tektonutils.Param{
+						{Name: "url", Value: "my-url"},
+						{Name: "revision", Value: "my-revision"},
+						{Name: "pathInRepo", Value: "my-path"},
+					},
+				},
+			}
+		})
+
+		It("should return the Pipeline reference if no pipeline mirror is set", func() {
+			pipelineRef, err := adapter.getPipelineRef(pipeline, releasePlan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRef.Resolver).To(Equal(tektonv1.ResolverName("git")))
+		})
+
+		It("should return the mirrored Pipeline reference if a pipeline mirror is set", func() {
+			adapter.releaseServiceConfig.Spec.PipelineMirror = &v1alpha1.PipelineMirror{ConfigMap: "pipeline-mirror"}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ConfigMapContextKey,
+					Resource: &corev1.ConfigMap{
+						Data: map[string]string{
+							tektonutils.PipelineMirrorCatalogKey: `{"pipelines": [{
+								"source": {"resolver": "git", "params": [
+									{"name": "url", "value": "my-url"},
+									{"name": "revision", "value": "my-revision"},
+									{"name": "pathInRepo", "value": "my-path"}
+								]},
+								"bundle": "registry.internal/pipeline@sha256:0123",
+								"name": "my-pipeline"
+							}]}`,
+						},
+					},
+				},
+			})
+
+			pipelineRef, err := adapter.getPipelineRef(pipeline, releasePlan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRef.Resolver).To(Equal(tektonv1.ResolverName("bundles")))
+			Expect(pipelineRef.Params).To(ContainElement(HaveField("Value.StringVal",
+				"registry.internal/pipeline@sha256:0123")))
+		})
+
+		It("should fail if the Pipeline is not in the pipeline mirror catalog", func() {
+			adapter.releaseServiceConfig.Spec.PipelineMirror = &v1alpha1.PipelineMirror{ConfigMap: "pipeline-mirror"}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ConfigMapContextKey,
+					Resource: &corev1.ConfigMap{
+						Data: map[string]string{
+							tektonutils.PipelineMirrorCatalogKey: `{"pipelines": []}`,
+						},
+					},
+				},
+			})
+
+			_, err := adapter.getPipelineRef(pipeline, releasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(isPipelineNotMirroredError(err)).To(BeTrue())
+		})
+
+		It("should fail if the pipeline mirror ConfigMap can't be loaded", func() {
+			adapter.releaseServiceConfig.Spec.PipelineMirror = &v1alpha1.PipelineMirror{ConfigMap: "pipeline-mirror"}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ConfigMapContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			_, err := adapter.getPipelineRef(pipeline, releasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(isPipelineNotMirroredError(err)).To(BeFalse())
+		})
+	})
+
+	When("getImageAnnotationsParams is called", func() {
+		var adapter *adapter
+		var resources *loader.ProcessingResources
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.ImageAnnotations = true
+			resources = &loader.ProcessingResources{
+				ReleasePlan:          releasePlan,
+				ReleasePlanAdmission: newReleasePlanAdmission,
+			}
+		})
+
+		It("should return no params if the ReleasePlanAdmission doesn't request image annotations", func() {
+			resources.ReleasePlanAdmission.Spec.ImageAnnotations = false
+			Expect(adapter.getImageAnnotationsParams(resources)).To(BeNil())
+		})
+
+		It("should return the annotations identifying the Release", func() {
+			startTime := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+			adapter.release.Status.StartTime = &startTime
+
+			params := adapter.getImageAnnotationsParams(resources)
+			Expect(params).To(HaveLen(1))
+			Expect(params[0].Name).To(Equal(imageAnnotationsParamName))
+
+			annotations := map[string]string{}
+			Expect(json.Unmarshal([]byte(params[0].Value.StringVal), &annotations)).To(Succeed())
+			Expect(annotations).To(Equal(map[string]string{
+				metadata.ImageReleaseAnnotation:       adapter.release.Namespace + "/" + adapter.release.Name,
+				metadata.ImageReleaseDateAnnotation:   "2024-05-01T10:00:00Z",
+				metadata.ImageReleaseTargetAnnotation: resources.ReleasePlanAdmission.Namespace,
+			}))
+		})
+	})
+
+	When("getPlatformHintsParams is called", func() {
+		var adapter *adapter
+		var resources *loader.ProcessingResources
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.platformsGetter = &mockPlatformsGetter{}
+
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.PlatformHints = true
+			newSnapshot := snapshot.DeepCopy()
+			newSnapshot.Spec.Components = []applicationapiv1alpha1.SnapshotComponent{
+				{Name: "component", ContainerImage: "quay.io/foo/bar:1.0"},
+			}
+			resources = &loader.ProcessingResources{
+				ReleasePlan:          releasePlan,
+				ReleasePlanAdmission: newReleasePlanAdmission,
+				Snapshot:             newSnapshot,
+			}
+		})
+
+		It("should return no params if the ReleasePlanAdmission doesn't request platform hints", func() {
+			resources.ReleasePlanAdmission.Spec.PlatformHints = false
+			Expect(adapter.getPlatformHintsParams(resources, "", "default")).To(BeNil())
+		})
+
+		It("should return no params if the adapter can't get image platforms", func() {
+			adapter.platformsGetter = nil
+			Expect(adapter.getPlatformHintsParams(resources, "", "default")).To(BeNil())
+		})
+
+		It("should return the platforms of each component as a param", func() {
+			params := adapter.getPlatformHintsParams(resources, "", "default")
+			Expect(params).To(HaveLen(1))
+			Expect(params[0].Name).To(Equal(platforms.ParamName))
+			Expect(params[0].Value.StringVal).To(MatchJSON(
+				`{"components":[{"name":"component","platforms":["linux/amd64","linux/arm64"]}]}`))
+		})
+	})
+
+	When("getPullSecrets is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return the image pull secrets and the secrets of the ServiceAccount", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ServiceAccountContextKey,
+					Resource: &corev1.ServiceAccount{
+						ImagePullSecrets: []corev1.LocalObjectReference{{Name: "pull-secret"}},
+						Secrets:          []corev1.ObjectReference{{Name: "secret"}},
+					},
+				},
+				{
+					ContextKey: loader.SecretContextKey,
+					Resource: &corev1.Secret{
+						Type: corev1.SecretTypeDockerConfigJson,
+					},
+				},
+			})
+
+			Expect(adapter.getPullSecrets("service-account", "default")).To(HaveLen(2))
+		})
+
+		It("should return no secrets if the ServiceAccount can't be read", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ServiceAccountContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			Expect(adapter.getPullSecrets("service-account", "default")).To(BeNil())
+		})
+	})
+
+	When("getProvenanceAnnotations is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return the Pipelines as Code annotations of the Snapshot and Release", func() {
+			adapter.release.Annotations = map[string]string{
+				integrationgitops.PipelinesAsCodePrefix + "/event-type": "push",
+				integrationgitops.PipelinesAsCodePrefix + "/sha":        "def456",
+			}
+			newSnapshot := snapshot.DeepCopy()
+			newSnapshot.Annotations = map[string]string{
+				integrationgitops.PipelinesAsCodePrefix + "/sha": "abc123",
+				"other": "value",
+			}
+
+			Expect(adapter.getProvenanceAnnotations(newSnapshot)).To(Equal(map[string]string{
+				integrationgitops.PipelinesAsCodePrefix + "/event-type": "push",
+				integrationgitops.PipelinesAsCodePrefix + "/sha":        "abc123",
+			}))
+		})
+
+		It("should return the Release annotations if there is no Snapshot", func() {
+			adapter.release.Annotations = map[string]string{
+				integrationgitops.PipelinesAsCodePrefix + "/sha": "def456",
+			}
+
+			Expect(adapter.getProvenanceAnnotations(nil)).To(Equal(map[string]string{
+				integrationgitops.PipelinesAsCodePrefix + "/sha": "def456",
+			}))
+		})
+	})
+
+	When("getReleaseLockName is called", func() {
+		It("should return the same name for the same application and target", func() {
+			Expect(getReleaseLockName("app", "target")).To(Equal(getReleaseLockName("app", "target")))
+			Expect(getReleaseLockName("app", "target")).To(HavePrefix(releaseLockPrefix + "app-"))
+		})
+
+		It("should return different names for different targets", func() {
+			Expect(getReleaseLockName("app-a", "b")).NotTo(Equal(getReleaseLockName("app", "a-b")))
+		})
+	})
+
+	When("getStalledPipelineRunDiagnostics is called", func() {
+		It("should include the PipelineRun reference and the timeout", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			Expect(getStalledPipelineRunDiagnostics(pipelineRun, time.Minute)).To(
+				Equal("PipelineRun default/pipeline-run has not progressed in 1m0s"))
+		})
+
+		It("should include the last reason and message reported by the PipelineRun", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkRunning("PipelineRunPending", "pods are unschedulable")
+			Expect(getStalledPipelineRunDiagnostics(pipelineRun, time.Minute)).To(
+				ContainSubstring("(reason: PipelineRunPending, message: pods are unschedulable)"))
+		})
+	})
+
+	When("getValidationFailureReason is called", func() {
+		It("should return the reason matching the resource that wasn't found", func() {
+			Expect(getValidationFailureReason(errors.NewNotFound(schema.GroupResource{
+				Group:    v1alpha1.GroupVersion.Group,
+				Resource: "releaseplans",
+			}, "release-plan"))).To(Equal(reasons.ReleasePlanMissing))
+			Expect(getValidationFailureReason(errors.NewNotFound(schema.GroupResource{
+				Group:    v1alpha1.GroupVersion.Group,
+				Resource: "releaseplanadmissions",
+			}, "release-plan-admission"))).To(Equal(reasons.ReleasePlanAdmissionMissing))
+			Expect(getValidationFailureReason(errors.NewNotFound(schema.GroupResource{
+				Group:    applicationapiv1alpha1.GroupVersion.Group,
+				Resource: "snapshots",
+			}, "snapshot"))).To(Equal(reasons.SnapshotMissing))
+		})
+
+		It("should return the AdmissionBlocked reason when the ReleasePlanAdmission has auto-release disabled", func() {
+			Expect(getValidationFailureReason(fmt.Errorf("found ReleasePlanAdmission 'rpa' with auto-release label set to false"))).To(
+				Equal(reasons.AdmissionBlocked))
+		})
+
+		It("should return the ReleasePlanAdmissionMissing reason when no ReleasePlanAdmission matches", func() {
+			Expect(getValidationFailureReason(fmt.Errorf("releasePlan has no target, so no ReleasePlanAdmissions can be found"))).To(
+				Equal(reasons.ReleasePlanAdmissionMissing))
+		})
+
+		It("should return the Failed reason for unknown errors", func() {
+			Expect(getValidationFailureReason(fmt.Errorf("foo"))).To(Equal(reasons.Failed))
+		})
+	})
+
+	When("isPipelineNotMirroredError is called", func() {
+		It("should return true for errors caused by a Pipeline missing in the pipeline mirror catalog", func() {
+			Expect(isPipelineNotMirroredError(fmt.Errorf("the Pipeline using the git resolver is not in the pipeline mirror catalog"))).To(BeTrue())
+		})
+
+		It("should return false for other errors", func() {
+			Expect(isPipelineNotMirroredError(fmt.Errorf("not found"))).To(BeFalse())
+		})
+	})
+
+	When("isQuotaExceededError is called", func() {
+		It("should return true for errors caused by an exceeded quota", func() {
+			Expect(isQuotaExceededError(errors.NewForbidden(schema.GroupResource{Resource: "pipelineruns"}, "foo",
+				fmt.Errorf("exceeded quota: compute-resources")))).To(BeTrue())
+		})
+
+		It("should return false for other errors", func() {
+			Expect(isQuotaExceededError(errors.NewForbidden(schema.GroupResource{Resource: "pipelineruns"}, "foo",
+				fmt.Errorf("not allowed")))).To(BeFalse())
+			Expect(isQuotaExceededError(fmt.Errorf("exceeded quota"))).To(BeFalse())
+		})
+	})
+
+	When("checkDependencies is called", func() {
+		var (
+			adapter *adapter
+			server  *httptest.Server
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			server.Close()
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/down" {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			}))
+		})
+
+		It("should return true if no dependency health checks are defined", func() {
+			adapter.releaseServiceConfig.Spec.DependencyHealthChecks = nil
+
+			healthy, err := adapter.checkDependencies()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthy).To(BeTrue())
+		})
+
+		It("should return true and mark the dependencies as healthy if every check succeeds", func() {
+			adapter.releaseServiceConfig.Spec.DependencyHealthChecks = []v1alpha1.DependencyHealthCheck{
+				{Name: "Pyxis", Type: "HTTP", URL: server.URL + "/ping"},
+				{Name: "Registry", Type: "Registry", URL: server.URL},
+			}
+
+			healthy, err := adapter.checkDependencies()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthy).To(BeTrue())
+			Expect(adapter.release.IsDependencyHealthy("Pyxis")).To(BeTrue())
+			Expect(adapter.release.IsDependencyHealthy("Registry")).To(BeTrue())
+			Expect(adapter.release.IsAwaitingDependencies()).To(BeFalse())
+		})
+
+		It("should return false and mark the Release as waiting for its dependencies if a check fails", func() {
+			adapter.releaseServiceConfig.Spec.DependencyHealthChecks = []v1alpha1.DependencyHealthCheck{
+				{Name: "Pyxis", Type: "HTTP", URL: server.URL + "/ping"},
+				{Name: "CDN", Type: "HTTP", URL: server.URL + "/down"},
+			}
+
+			healthy, err := adapter.checkDependencies()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthy).To(BeFalse())
+			Expect(adapter.release.IsDependencyHealthy("Pyxis")).To(BeTrue())
+			Expect(adapter.release.IsDependencyHealthy("CDN")).To(BeFalse())
+			Expect(adapter.release.IsAwaitingDependencies()).To(BeTrue())
+		})
+
+		It("should not report the results more often than the interval while the Release keeps waiting", func() {
+			adapter.throttle = throttle.New()
+			adapter.releaseServiceConfig.Spec.DependencyHealthChecks = []v1alpha1.DependencyHealthCheck{
+				{Name: "Pyxis", Type: "HTTP", URL: server.URL + "/ping"},
+				{Name: "CDN", Type: "HTTP", URL: server.URL + "/down"},
+			}
+
+			healthy, err := adapter.checkDependencies()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthy).To(BeFalse())
+
+			adapter.releaseServiceConfig.Spec.DependencyHealthChecks[0].URL = server.URL + "/down"
+			healthy, err = adapter.checkDependencies()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthy).To(BeFalse())
+			Expect(adapter.release.IsDependencyHealthy("Pyxis")).To(BeFalse())
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(adapter.release), release)).To(Succeed())
+			Expect(release.IsAwaitingDependencies()).To(BeTrue())
+			Expect(release.IsDependencyHealthy("Pyxis")).To(BeTrue())
+		})
+
+		It("should fail if a check has an unsupported type", func() {
+			adapter.releaseServiceConfig.Spec.DependencyHealthChecks = []v1alpha1.DependencyHealthCheck{
+				{Name: "Pyxis", Type: "DNS", URL: server.URL},
+			}
+
+			_, err := adapter.checkDependencies()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("isManagedPipelineScheduled is called", func() {
+		var adapter *adapter
+
+		newTargetRelease := func(name, namespace string) *v1alpha1.Release {
+			return &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			}
+		}
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig.Spec.ManagedPipelineSchedulingPolicy = &v1alpha1.ManagedPipelineSchedulingPolicy{
+				MaxConcurrentPipelineRuns: 1,
+			}
+		})
+
+		It("should schedule the Release if no ManagedPipelineSchedulingPolicy is set", func() {
+			adapter.releaseServiceConfig.Spec.ManagedPipelineSchedulingPolicy = nil
+
+			scheduled, err := adapter.isManagedPipelineScheduled("target")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduled).To(BeTrue())
+		})
+
+		It("should not schedule the Release if the target is saturated", func() {
+			running := newTargetRelease("running", "tenant")
+			running.MarkManagedPipelineProcessing()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesWithTargetContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{*running}},
+				},
+			})
+
+			scheduled, err := adapter.isManagedPipelineScheduled("target")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduled).To(BeFalse())
+		})
+
+		It("should schedule the Release if the target has capacity and no other Release is waiting", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesWithTargetContextKey,
+					Resource:   &v1alpha1.ReleaseList{},
+				},
+			})
+
+			scheduled, err := adapter.isManagedPipelineScheduled("target")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduled).To(BeTrue())
+		})
+
+		It("should let a Release of an origin with fewer running pipelines start first", func() {
+			adapter.releaseServiceConfig.Spec.ManagedPipelineSchedulingPolicy.MaxConcurrentPipelineRuns = 2
+			running := newTargetRelease("running", adapter.release.Namespace)
+			running.MarkManagedPipelineProcessing()
+			waiting := newTargetRelease("waiting", "other-tenant")
+			waiting.MarkAwaitingCapacity("")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesWithTargetContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{*running, *waiting}},
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionsContextKey,
+					Resource:   &v1alpha1.ReleasePlanAdmissionList{},
+				},
+			})
+
+			scheduled, err := adapter.isManagedPipelineScheduled("target")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduled).To(BeFalse())
+		})
+
+		It("should let a Release of an origin with a bigger share start first", func() {
+			adapter.releaseServiceConfig.Spec.ManagedPipelineSchedulingPolicy.MaxConcurrentPipelineRuns = 3
+			running := newTargetRelease("running", adapter.release.Namespace)
+			running.MarkManagedPipelineProcessing()
+			otherRunning := newTargetRelease("other-running", "other-tenant")
+			otherRunning.MarkManagedPipelineProcessing()
+			waiting := newTargetRelease("waiting", "other-tenant")
+			waiting.MarkAwaitingCapacity("")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesWithTargetContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{*running, *otherRunning, *waiting}},
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionsContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmissionList{
+						Items: []v1alpha1.ReleasePlanAdmission{
+							{Spec: v1alpha1.ReleasePlanAdmissionSpec{Origin: "other-tenant", Share: 3}},
+						},
+					},
+				},
+			})
+
+			scheduled, err := adapter.isManagedPipelineScheduled("target")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduled).To(BeFalse())
+		})
+
+		It("should schedule the Release if its origin uses less of its share than the waiting Releases", func() {
+			adapter.releaseServiceConfig.Spec.ManagedPipelineSchedulingPolicy.MaxConcurrentPipelineRuns = 2
+			running := newTargetRelease("running", "other-tenant")
+			running.MarkManagedPipelineProcessing()
+			waiting := newTargetRelease("waiting", "other-tenant")
+			waiting.MarkAwaitingCapacity("")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasesWithTargetContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: []v1alpha1.Release{*running, *waiting}},
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionsContextKey,
+					Resource:   &v1alpha1.ReleasePlanAdmissionList{},
+				},
+			})
+
+			scheduled, err := adapter.isManagedPipelineScheduled("target")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduled).To(BeTrue())
+		})
+	})
+
+	When("hasSchedulingPriority is called", func() {
+		var first, second *v1alpha1.Release
+
+		BeforeEach(func() {
+			first = &v1alpha1.Release{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "tenant-a"}}
+			first.MarkAwaitingCapacity("")
+			first.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+			second = &v1alpha1.Release{ObjectMeta: metav1.ObjectMeta{Name: "second", Namespace: "tenant-b"}}
+			second.MarkAwaitingCapacity("")
+		})
+
+		It("should prioritize the oldest queued Release if both origins use the same amount of their share", func() {
+			Expect(hasSchedulingPriority(first, second, map[string]int{}, map[string]int{})).To(BeTrue())
+			Expect(hasSchedulingPriority(second, first, map[string]int{}, map[string]int{})).To(BeFalse())
+		})
+
+		It("should prioritize the Release whose origin has fewer running pipelines", func() {
+			running := map[string]int{"tenant-a": 1}
+			Expect(hasSchedulingPriority(second, first, running, map[string]int{})).To(BeTrue())
+		})
+
+		It("should take the shares of the origins into account", func() {
+			running := map[string]int{"tenant-a": 2, "tenant-b": 1}
+			shares := map[string]int{"tenant-a": 4}
+			Expect(hasSchedulingPriority(first, second, running, shares)).To(BeTrue())
+		})
+	})
+
+	When("releaseReleaseLocks is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should delete the locks held by the Release", func() {
+			acquired, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(acquired).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.releaseReleaseLocks()).To(Succeed())
+
+			lease := &coordinationv1.Lease{}
+			err = adapter.client.Get(ctx, types.NamespacedName{
+				Name:      getReleaseLockName("app", "target"),
+				Namespace: "default",
+			}, lease)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not fail if the Release holds no locks", func() {
+			Expect(adapter.releaseReleaseLocks()).To(Succeed())
+		})
+
+		It("should only clear the holder of the locks with a queued Release next in line", func() {
+			acquired, err := adapter.acquireReleaseLock("app", "target", false)
+			Expect(acquired).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			lease := &coordinationv1.Lease{}
+			Expect(adapter.client.Get(ctx, types.NamespacedName{
+				Name:      getReleaseLockName("app", "target"),
+				Namespace: "default",
+			}, lease)).To(Succeed())
+			metadata.AddAnnotations(lease, map[string]string{metadata.ReleaseLockNextHolderAnnotation: "queued-release"})
+			Expect(adapter.client.Update(ctx, lease)).To(Succeed())
+
+			Expect(adapter.releaseReleaseLocks()).To(Succeed())
+
+			Expect(adapter.client.Get(ctx, types.NamespacedName{
+				Name:      getReleaseLockName("app", "target"),
+				Namespace: "default",
+			}, lease)).To(Succeed())
+			Expect(lease.Spec.HolderIdentity).To(BeNil())
+			Expect(adapter.client.Delete(ctx, lease)).To(Succeed())
+		})
+	})
+
+	When("revokeReleaseRegistryCredentials is called", func() {
+		var adapter *adapter
+		var broker *mockBroker
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			broker = &mockBroker{}
+			adapter.broker = broker
+		})
+
+		It("should revoke the credentials of the Release and delete their Secrets", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.RegistryCredentials = &v1alpha1.RegistryCredentials{
+				Repositories: []string{"quay.io/org/foo"},
+			}
+			credentialsSecret, err := adapter.createRegistryCredentialsSecret("default", newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.revokeReleaseRegistryCredentials(false)).To(Succeed())
+			Expect(broker.revoked).To(Equal([]string{adapter.getRegistryCredentialsName()}))
+
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(credentialsSecret), &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should only revoke the credentials whose Secrets are being deleted if requested", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.RegistryCredentials = &v1alpha1.RegistryCredentials{
+				Repositories: []string{"quay.io/org/foo"},
+			}
+			credentialsSecret, err := adapter.createRegistryCredentialsSecret("default", newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.revokeReleaseRegistryCredentials(true)).To(Succeed())
+			Expect(broker.revoked).To(BeEmpty())
+
+			// The finalizer keeps the Secret once it's garbage collected along with its PipelineRun
+			Expect(k8sClient.Delete(ctx, credentialsSecret)).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(credentialsSecret), &corev1.Secret{})).To(Succeed())
+
+			Expect(adapter.revokeReleaseRegistryCredentials(true)).To(Succeed())
+			Expect(broker.revoked).To(Equal([]string{adapter.getRegistryCredentialsName()}))
+
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(credentialsSecret), &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should remove the finalizer of the Secrets if no credentials broker is configured", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.RegistryCredentials = &v1alpha1.RegistryCredentials{
+				Repositories: []string{"quay.io/org/foo"},
+			}
+			credentialsSecret, err := adapter.createRegistryCredentialsSecret("default", newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.broker = nil
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+			adapter.releaseServiceConfig.Spec.CredentialsBroker = nil
+			Expect(adapter.revokeReleaseRegistryCredentials(false)).To(Succeed())
+			Expect(broker.revoked).To(BeEmpty())
+
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(credentialsSecret), &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should ignore the Secrets of the Release not holding registry credentials", func() {
+			dataSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "data-",
+					Namespace:    "default",
+					Labels:       metadata.GetReleaseTrackingLabels(adapter.release.Name, adapter.release.Namespace),
+				},
+			}
+			Expect(k8sClient.Create(ctx, dataSecret)).To(Succeed())
+
+			Expect(adapter.revokeReleaseRegistryCredentials(false)).To(Succeed())
+			Expect(broker.revoked).To(BeEmpty())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dataSecret), &corev1.Secret{})).To(Succeed())
+
+			Expect(k8sClient.Delete(ctx, dataSecret)).To(Succeed())
+		})
+	})
+
+	When("registerDeploymentStatus is called", func() {
+		var adapter *adapter
+		var deployedBinding, errorBinding, pendingBinding applicationapiv1alpha1.SnapshotEnvironmentBinding
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkDeploying("")
+
+			pendingBinding = applicationapiv1alpha1.SnapshotEnvironmentBinding{
+				Spec: applicationapiv1alpha1.SnapshotEnvironmentBindingSpec{Environment: "environment"},
+			}
+			deployedBinding = *pendingBinding.DeepCopy()
+			deployedBinding.Status.ComponentDeploymentConditions = []metav1.Condition{
+				{
+					Type:   applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
+					Status: metav1.ConditionTrue,
+				},
+			}
+			errorBinding = *pendingBinding.DeepCopy()
+			errorBinding.Status.BindingConditions = []metav1.Condition{
+				{
+					Type:    applicationapiv1alpha1.ComponentDeploymentConditionErrorOccurred,
+					Status:  metav1.ConditionTrue,
+					Message: "foo",
+				},
+			}
+		})
+
+		It("should keep the Release deploying while some bindings are not deployed", func() {
+			adapter.registerDeploymentStatus(&applicationapiv1alpha1.SnapshotEnvironmentBindingList{
+				Items: []applicationapiv1alpha1.SnapshotEnvironmentBinding{deployedBinding, pendingBinding},
+			})
+			Expect(adapter.release.IsDeploying()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions[0].Message).To(Equal("1 of 2 environments deployed"))
+		})
+
+		It("should mark the deployment as failed if a binding reports an error", func() {
+			adapter.registerDeploymentStatus(&applicationapiv1alpha1.SnapshotEnvironmentBindingList{
+				Items: []applicationapiv1alpha1.SnapshotEnvironmentBinding{deployedBinding, errorBinding},
+			})
+			Expect(adapter.release.HasDeploymentFinished()).To(BeTrue())
+			Expect(adapter.release.IsDeployed()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("foo"))
+		})
+
+		It("should mark the Release as deployed if all the bindings are deployed", func() {
+			adapter.registerDeploymentStatus(&applicationapiv1alpha1.SnapshotEnvironmentBindingList{
+				Items: []applicationapiv1alpha1.SnapshotEnvironmentBinding{deployedBinding},
+			})
+			Expect(adapter.release.IsDeployed()).To(BeTrue())
+		})
+	})
+
+	When("registerTenantProcessingData is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if there is no PipelineRun", func() {
+			Expect(adapter.registerTenantProcessingData(nil)).To(Succeed())
+			Expect(adapter.release.Status.TenantProcessing.PipelineRun).To(BeEmpty())
+		})
+
+		It("registers the Release tenant processing data", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			Expect(adapter.registerTenantProcessingData(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.TenantProcessing.PipelineRun).To(Equal(fmt.Sprintf("%s%c%s",
+				pipelineRun.Namespace, types.Separator, pipelineRun.Name)))
+			Expect(adapter.release.IsTenantPipelineProcessing()).To(BeTrue())
+		})
+	})
+
+	When("registerManagedProcessingData is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if there is no PipelineRun", func() {
+			Expect(adapter.registerManagedProcessingData(nil, nil)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).To(BeEmpty())
+		})
+
+		It("registers the Release managed processing data", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			roleBinding := &rbac.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "role-binding",
+					Namespace: "default",
+				},
+			}
+			adapter.release.MarkQueued("")
+			Expect(adapter.registerManagedProcessingData(pipelineRun, roleBinding)).To(Succeed())
+			Expect(adapter.release.IsQueued()).To(BeFalse())
+			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).To(Equal(fmt.Sprintf("%s%c%s",
+				pipelineRun.Namespace, types.Separator, pipelineRun.Name)))
+			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(Equal(fmt.Sprintf("%s%c%s",
+				roleBinding.Namespace, types.Separator, roleBinding.Name)))
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+		})
+
+		It("does not set RoleBinding when no RoleBinding is passed", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+
+			Expect(adapter.registerManagedProcessingData(pipelineRun, nil)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.RoleBinding).To(BeEmpty())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+		})
+	})
+
+	When("registerTenantProcessingStatus is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if there is no PipelineRun", func() {
+			Expect(adapter.registerTenantProcessingStatus(nil)).To(Succeed())
+			Expect(adapter.release.Status.TenantProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("does nothing if the PipelineRun is not done", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.TenantProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("sets the Release as Tenant Processed if the PipelineRun succeeded", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkTenantPipelineProcessing()
+
+			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsTenantPipelineProcessed()).To(BeTrue())
+		})
+
+		It("sets the Release as Tenant Processing failed and Managed Processing skipped if the PipelineRun didn't succeed", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			adapter.release.MarkTenantPipelineProcessing()
+
+			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsTenantPipelineProcessed()).To(BeFalse())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
+		})
+	})
+
+	When("registerComputeUsage is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+		})
+
+		It("records the compute usage without cost if the ReleaseServiceConfig has no cost rates", func() {
+			adapter.registerComputeUsage(&tektonv1.PipelineRun{}, &adapter.release.Status.ManagedProcessing,
+				metadata.ManagedPipelineType)
+			Expect(adapter.release.Status.ManagedProcessing.ComputeUsage).NotTo(BeNil())
+			Expect(adapter.release.Status.ManagedProcessing.ComputeUsage.EstimatedCost).To(BeEmpty())
+		})
+
+		It("records the estimated cost if the ReleaseServiceConfig has cost rates", func() {
+			adapter.releaseServiceConfig.Spec.CostRates = &v1alpha1.CostRates{
+				CPUCoreHour:   resource.MustParse("0.04"),
+				MemoryGiBHour: resource.MustParse("0.005"),
+			}
+
+			adapter.registerComputeUsage(&tektonv1.PipelineRun{}, &adapter.release.Status.TenantProcessing,
+				metadata.TenantPipelineType)
+			Expect(adapter.release.Status.TenantProcessing.ComputeUsage).NotTo(BeNil())
+			Expect(adapter.release.Status.TenantProcessing.ComputeUsage.EstimatedCost).To(Equal("0.0000"))
+		})
+
+		It("does nothing if the compute usage was already recorded", func() {
+			adapter.release.Status.ManagedProcessing.ComputeUsage = &v1alpha1.ComputeUsage{CPUMillicoreSeconds: 1000}
+
+			adapter.registerComputeUsage(&tektonv1.PipelineRun{}, &adapter.release.Status.ManagedProcessing,
+				metadata.ManagedPipelineType)
+			Expect(adapter.release.Status.ManagedProcessing.ComputeUsage.CPUMillicoreSeconds).To(Equal(int64(1000)))
+		})
+	})
+
+	When("registerManagedProcessingStatus is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if there is no PipelineRun", func() {
+			Expect(adapter.registerManagedProcessingStatus(nil)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("does nothing if the PipelineRun is not done", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("sets the Release as Managed Processed if the PipelineRun succeeded", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeTrue())
+		})
+
+		It("sets the Release as Managed Processing failed if the PipelineRun didn't succeed", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessed()).To(BeFalse())
+		})
+
+		It("registers the component results and marks the Release as partially released if some failed", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{
+					Name: tekton.ComponentResultsResultName,
+					Value: *tektonv1.NewStructuredValues(
+						`[{"name":"foo","phase":"Failed","message":"error"},{"name":"bar","phase":"Succeeded"}]`),
+				},
+			}
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Components).To(HaveLen(2))
+			Expect(adapter.release.IsPartiallyReleased()).To(BeTrue())
+		})
+
+		It("doesn't mark the Release as partially released if all the components failed", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{
+					Name:  tekton.ComponentResultsResultName,
+					Value: *tektonv1.NewStructuredValues(`[{"name":"foo","phase":"Failed"}]`),
+				},
+			}
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Components).To(HaveLen(1))
+			Expect(adapter.release.IsPartiallyReleased()).To(BeFalse())
+		})
+	})
+
+	When("calling validateAuthor", func() {
+		var adapter *adapter
+		var conditionMsg string
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+			})
+		})
+
+		It("returns valid and no error if the release is already attributed", func() {
+			adapter.release.Status.Attribution.Author = "user"
+			result := adapter.validateAuthor()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return invalid and no error if the ReleasePlan is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result := adapter.validateAuthor()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		When("the release has the automated label", func() {
+			AfterEach(func() {
+				_ = adapter.client.Delete(ctx, adapter.release)
+			})
+
+			BeforeEach(func() {
+				adapter = createReleaseAndAdapter()
+				adapter.release.Labels = map[string]string{
+					metadata.AutomatedLabel: "true",
+				}
+				adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+					{
+						ContextKey: loader.ReleasePlanContextKey,
+						Resource:   releasePlan,
+					},
+				})
+			})
+
+			It("returns invalid and an error if automated label is present but is not set in release status", func() {
+				result := adapter.validateAuthor()
+				Expect(result.Valid).To(BeFalse())
+				Expect(result.Err).To(HaveOccurred())
+				for i := range adapter.release.Status.Conditions {
+					if adapter.release.Status.Conditions[i].Type == "Validated" {
+						conditionMsg = adapter.release.Status.Conditions[i].Message
+					}
+				}
+				Expect(conditionMsg).To(Equal("automated not set in status for automated release"))
+			})
+
+			It("returns invalid if the error appears after 5 minutes of being created", func() {
+				adapter.release.SetCreationTimestamp(metav1.Time{Time: time.Now().Add(-7 * time.Minute)})
+				result := adapter.validateAuthor()
+				Expect(result.Valid).To(BeFalse())
+				Expect(result.Err).NotTo(HaveOccurred())
+				for i := range adapter.release.Status.Conditions {
+					if adapter.release.Status.Conditions[i].Type == "Validated" {
+						conditionMsg = adapter.release.Status.Conditions[i].Message
+					}
+				}
+				Expect(conditionMsg).To(Equal("automated not set in status for automated release"))
+			})
+
+			It("returns invalid and an error if the ReleasePlan has no author", func() {
+				adapter.release.Status.Automated = true
+				result := adapter.validateAuthor()
+				Expect(result.Valid).To(BeFalse())
+				Expect(result.Err).NotTo(HaveOccurred())
+				for i := range adapter.release.Status.Conditions {
+					if adapter.release.Status.Conditions[i].Type == "Validated" {
+						conditionMsg = adapter.release.Status.Conditions[i].Message
+					}
+				}
+				Expect(conditionMsg).To(ContainSubstring("no author in the ReleasePlan found for automated release"))
+				Expect(adapter.release.Status.Validation.Causes).To(HaveLen(1))
+				Expect(adapter.release.Status.Validation.Causes[0].Reason).To(Equal(metav1.CauseTypeFieldValueRequired))
+			})
+
+			It("properly sets the Attribution data in the release status", func() {
+				adapter.release.Status.Automated = true
+				releasePlan.Labels = map[string]string{
+					metadata.AuthorLabel: "user",
+				}
+				result := adapter.validateAuthor()
+				Expect(result.Valid).To(BeTrue())
+				Expect(result.Err).NotTo(HaveOccurred())
+				Expect(adapter.release.Status.Attribution.StandingAuthorization).To(BeTrue())
+				Expect(adapter.release.Status.Attribution.Author).To(Equal("user"))
+			})
+		})
+
+		It("returns invalid and an error if the Release has the automated label and no author", func() {
+			adapter.release.Labels = map[string]string{
+				metadata.AutomatedLabel: "false",
+				metadata.AuthorLabel:    "",
+			}
+			result := adapter.validateAuthor()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			for i := range adapter.release.Status.Conditions {
+				if adapter.release.Status.Conditions[i].Type == "Validated" {
+					conditionMsg = adapter.release.Status.Conditions[i].Message
+				}
+			}
+			Expect(conditionMsg).To(ContainSubstring("no author found for manual release"))
+			Expect(adapter.release.Status.Validation.Causes).To(HaveLen(1))
+			Expect(adapter.release.Status.Validation.Causes[0].Hint).NotTo(BeEmpty())
+		})
+
+		It("properly sets the Attribution author in the manual release status", func() {
+			adapter.release.Labels = map[string]string{
+				metadata.AuthorLabel: "user",
+			}
+			result := adapter.validateAuthor()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Attribution.StandingAuthorization).To(BeFalse())
+			Expect(adapter.release.Status.Attribution.Author).To(Equal("user"))
+		})
+	})
+
+	When("validateProcessingResources is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+		})
+
+		It("should return valid and no error if all the resources are found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlanAdmission:        releasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+			})
+
+			result := adapter.validateProcessingResources()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return invalid and no error if any of the resources are not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result := adapter.validateProcessingResources()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("should return invalid and no error if the ReleasePlanAdmission is found to be disabled", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("auto-release label set to false"),
+				},
+			})
+
+			result := adapter.validateProcessingResources()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("should return invalid and no error if multiple ReleasePlanAdmissions exist", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("multiple ReleasePlanAdmissions found"),
+				},
+			})
+
+			result := adapter.validateProcessingResources()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("should return invalid and an error if some other type of error occurs", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Err:        fmt.Errorf("internal error"),
+					Resource: &loader.ProcessingResources{
+						ReleasePlanAdmission: releasePlanAdmission,
+						ReleasePlan:          releasePlan,
+					},
+				},
+			})
+
+			result := adapter.validateProcessingResources()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).To(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+	})
+
+	When("validatePipelineSource is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			releaseServiceConfig.Spec = v1alpha1.ReleaseServiceConfigSpec{}
+			adapter.releaseServiceConfig = releaseServiceConfig
+		})
+
+		It("should return invalid and no error if the ReleasePlanAdmission is not found", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result := adapter.validatePipelineSource()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("should return invalid and an error if some other type of error occurs when retrieving the ReleasePlanAdmission", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("internal error"),
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			result := adapter.validatePipelineSource()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).To(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("returns invalid and no error if debug is false and the PipelineRef uses a cluster resolver", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "default",
+							Labels: map[string]string{
+								metadata.AutoReleaseLabel: "true",
+							},
+						},
+						Spec: v1alpha1.ReleasePlanAdmissionSpec{
+							Applications: []string{application.Name},
+							Origin:       "default",
+							Pipeline: &tektonutils.Pipeline{
+								PipelineRef: tektonutils.PipelineRef{
+									Resolver: "cluster",
+									Params: []tektonutils.Param{
+										{Name: "name", Value: "release-pipeline"},
+										{Name: "namespace", Value: "default"},
+										{Name: "kind", Value: "pipeline"},
+									},
+								},
+							},
+							Policy: enterpriseContractPolicy.Name,
+						},
+					},
+				},
+			})
+			adapter.releaseServiceConfig.Spec.Debug = false
+
+			result := adapter.validatePipelineSource()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).To(BeNil())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("returns valid and no error if debug mode is enabled in the ReleaseServiceConfig", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+			adapter.releaseServiceConfig.Spec.Debug = true
+
+			result := adapter.validatePipelineSource()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).To(BeNil())
+		})
+
+		It("returns valid and no error if debug mode is disabled and the PipelineRef uses a bundle resolver", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+			result := adapter.validatePipelineSource()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).To(BeNil())
+		})
+	})
+
+	When("validatePipelineDefined is called", func() {
+		var adapter *adapter
+		var parameterizedPipeline *tektonutils.ParameterizedPipeline
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			parameterizedPipeline = &tektonutils.ParameterizedPipeline{}
+			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
+				Resolver: "git",
+				Params: []tektonutils.Param{
+					{Name: "url", Value: "my-url"},
+					{Name: "revision", Value: "my-revision"},
+					{Name: "pathInRepo", Value: "my-path"},
+				},
+			}
+			parameterizedPipeline.Params = []tektonutils.Param{
+				{Name: "parameter1", Value: "value1"},
+				{Name: "parameter2", Value: "value2"},
+			}
+			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+			}
+		})
+
+		It("should return true if the strategy selected by the Release has a Pipeline", func() {
+			adapter.release.Spec.Strategy = "hotfix"
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanAdmissionSpec{
+							Applications: []string{application.Name},
+							Origin:       "default",
+							Policy:       enterpriseContractPolicy.Name,
+							Strategies: []v1alpha1.ReleaseStrategy{
+								{
+									Name:     "hotfix",
+									Pipeline: &tektonutils.Pipeline{PipelineRef: parameterizedPipeline.PipelineRef},
+								},
+							},
+						},
+					},
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application: application.Name,
+							Target:      "default",
+						},
+					},
+				},
+			})
+
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return false if the strategy selected by the Release is not defined", func() {
+			adapter.release.Spec.Strategy = "hotfix"
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanAdmissionSpec{
+							Applications: []string{application.Name},
+							Origin:       "default",
+							Pipeline:     &tektonutils.Pipeline{PipelineRef: parameterizedPipeline.PipelineRef},
+							Policy:       enterpriseContractPolicy.Name,
+						},
+					},
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application: application.Name,
+							Target:      "default",
+						},
+					},
+				},
+			})
+
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Validation.Causes).To(ContainElement(HaveField("Field", "spec.strategy")))
+		})
+
+		It("should return true if ReleasePlanAdmission and ReleasePlan have Pipeline Set", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "default",
+							Labels: map[string]string{
+								metadata.AutoReleaseLabel: "true",
+							},
+						},
+						Spec: v1alpha1.ReleasePlanAdmissionSpec{
+							Applications: []string{application.Name},
+							Origin:       "default",
+							Pipeline: &tektonutils.Pipeline{
+								PipelineRef: tektonutils.PipelineRef{
+									Resolver: "cluster",
+									Params: []tektonutils.Param{
+										{Name: "name", Value: "release-pipeline"},
+										{Name: "namespace", Value: "default"},
+										{Name: "kind", Value: "pipeline"},
+									},
+								},
+							},
+							Policy: enterpriseContractPolicy.Name,
+						},
+					},
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							Pipeline:               parameterizedPipeline,
+							ReleaseGracePeriodDays: 6,
+							Target:                 "default",
+						},
+					},
+				},
+			})
+
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return true if only ReleasePlan has Pipeline Set", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							Pipeline:               parameterizedPipeline,
+							ReleaseGracePeriodDays: 6,
+							Target:                 "default",
+						},
+					},
+				},
+			})
+
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return true if only ReleasePlan has Pipeline Set and it has no Target", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							Pipeline:               parameterizedPipeline,
+							ReleaseGracePeriodDays: 6,
+						},
+					},
+				},
+			})
 
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
+		It("should return true if only ReleasePlanAdmission has Pipeline Set", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "default",
+							Labels: map[string]string{
+								metadata.AutoReleaseLabel: "true",
+							},
+						},
+						Spec: v1alpha1.ReleasePlanAdmissionSpec{
+							Applications: []string{application.Name},
+							Origin:       "default",
+							Pipeline: &tektonutils.Pipeline{
+								PipelineRef: tektonutils.PipelineRef{
+									Resolver: "cluster",
+									Params: []tektonutils.Param{
+										{Name: "name", Value: "release-pipeline"},
+										{Name: "namespace", Value: "default"},
+										{Name: "kind", Value: "pipeline"},
+									},
+								},
+							},
+							Policy: enterpriseContractPolicy.Name,
+						},
+					},
+				},
 				{
 					ContextKey: loader.ReleasePlanContextKey,
-					Resource:   releasePlan,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							ReleaseGracePeriodDays: 6,
+							Target:                 "default",
+						},
+					},
 				},
 			})
-		})
 
-		It("returns valid and no error if the release is already attributed", func() {
-			adapter.release.Status.Attribution.Author = "user"
-			result := adapter.validateAuthor()
+			result := adapter.validatePipelineDefined()
 			Expect(result.Valid).To(BeTrue())
 			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
-		It("should return invalid and no error if the ReleasePlan is not found", func() {
+		It("should return false if ReleasePlan has no Pipeline or Target set", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ReleasePlanContextKey,
-					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							ReleaseGracePeriodDays: 6,
+						},
+					},
 				},
 			})
 
-			result := adapter.validateAuthor()
+			result := adapter.validatePipelineDefined()
 			Expect(result.Valid).To(BeFalse())
 			Expect(result.Err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
 		})
 
-		When("the release has the automated label", func() {
-			AfterEach(func() {
-				_ = adapter.client.Delete(ctx, adapter.release)
-			})
-
-			BeforeEach(func() {
-				adapter = createReleaseAndAdapter()
-				adapter.release.Labels = map[string]string{
-					metadata.AutomatedLabel: "true",
-				}
-				adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-					{
-						ContextKey: loader.ReleasePlanContextKey,
-						Resource:   releasePlan,
+		It("should return false if neither ReleasePlanAdmission nor ReleasePlan have Pipeline Set", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "default",
+							Labels: map[string]string{
+								metadata.AutoReleaseLabel: "true",
+							},
+						},
+						Spec: v1alpha1.ReleasePlanAdmissionSpec{
+							Applications: []string{application.Name},
+							Origin:       "default",
+							Policy:       enterpriseContractPolicy.Name,
+						},
 					},
-				})
-			})
-
-			It("returns invalid and an error if automated label is present but is not set in release status", func() {
-				result := adapter.validateAuthor()
-				Expect(result.Valid).To(BeFalse())
-				Expect(result.Err).To(HaveOccurred())
-				for i := range adapter.release.Status.Conditions {
-					if adapter.release.Status.Conditions[i].Type == "Validated" {
-						conditionMsg = adapter.release.Status.Conditions[i].Message
-					}
-				}
-				Expect(conditionMsg).To(Equal("automated not set in status for automated release"))
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							ReleaseGracePeriodDays: 6,
+							Target:                 "default",
+						},
+					},
+				},
 			})
 
-			It("returns invalid if the error appears after 5 minutes of being created", func() {
-				adapter.release.SetCreationTimestamp(metav1.Time{Time: time.Now().Add(-7 * time.Minute)})
-				result := adapter.validateAuthor()
-				Expect(result.Valid).To(BeFalse())
-				Expect(result.Err).NotTo(HaveOccurred())
-				for i := range adapter.release.Status.Conditions {
-					if adapter.release.Status.Conditions[i].Type == "Validated" {
-						conditionMsg = adapter.release.Status.Conditions[i].Message
-					}
-				}
-				Expect(conditionMsg).To(Equal("automated not set in status for automated release"))
-			})
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
 
-			It("returns invalid and an error if the ReleasePlan has no author", func() {
-				adapter.release.Status.Automated = true
-				result := adapter.validateAuthor()
-				Expect(result.Valid).To(BeFalse())
-				Expect(result.Err).NotTo(HaveOccurred())
-				for i := range adapter.release.Status.Conditions {
-					if adapter.release.Status.Conditions[i].Type == "Validated" {
-						conditionMsg = adapter.release.Status.Conditions[i].Message
-					}
-				}
-				Expect(conditionMsg).To(Equal("no author in the ReleasePlan found for automated release"))
+		It("should set the target in the release status to the ReleasePlan namespace if no target is defined", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							Pipeline:               parameterizedPipeline,
+							ReleaseGracePeriodDays: 6,
+						},
+					},
+				},
 			})
 
-			It("properly sets the Attribution data in the release status", func() {
-				adapter.release.Status.Automated = true
-				releasePlan.Labels = map[string]string{
-					metadata.AuthorLabel: "user",
-				}
-				result := adapter.validateAuthor()
-				Expect(result.Valid).To(BeTrue())
-				Expect(result.Err).NotTo(HaveOccurred())
-				Expect(adapter.release.Status.Attribution.StandingAuthorization).To(BeTrue())
-				Expect(adapter.release.Status.Attribution.Author).To(Equal("user"))
-			})
+			_ = adapter.validatePipelineDefined()
+			Expect(adapter.release.Status.Target).To(Equal("default"))
 		})
 
-		It("returns invalid and an error if the Release has the automated label and no author", func() {
-			adapter.release.Labels = map[string]string{
-				metadata.AutomatedLabel: "false",
-				metadata.AuthorLabel:    "",
-			}
-			result := adapter.validateAuthor()
-			Expect(result.Valid).To(BeFalse())
-			Expect(result.Err).NotTo(HaveOccurred())
-			for i := range adapter.release.Status.Conditions {
-				if adapter.release.Status.Conditions[i].Type == "Validated" {
-					conditionMsg = adapter.release.Status.Conditions[i].Message
-				}
-			}
-			Expect(conditionMsg).To(Equal("no author found for manual release"))
-		})
+		It("should set the target in the release status to the ReleasePlan target if one is defined", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application:            application.Name,
+							ReleaseGracePeriodDays: 6,
+							Target:                 "foo",
+						},
+					},
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
 
-		It("properly sets the Attribution author in the manual release status", func() {
-			adapter.release.Labels = map[string]string{
-				metadata.AuthorLabel: "user",
-			}
-			result := adapter.validateAuthor()
-			Expect(result.Valid).To(BeTrue())
-			Expect(result.Err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.Attribution.StandingAuthorization).To(BeFalse())
-			Expect(adapter.release.Status.Attribution.Author).To(Equal("user"))
+			_ = adapter.validatePipelineDefined()
+			Expect(adapter.release.Status.Target).To(Equal("foo"))
 		})
 	})
 
-	When("validateProcessingResources is called", func() {
+	When("validateSnapshotAge is called", func() {
 		var adapter *adapter
+		var newReleasePlan *v1alpha1.ReleasePlan
+		var staleSnapshot *applicationapiv1alpha1.Snapshot
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -1955,89 +6792,108 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
-			adapter.release.MarkReleasing("")
+
+			newReleasePlan = releasePlan.DeepCopy()
+			newReleasePlan.Spec.SnapshotMaxAge = &v1alpha1.SnapshotMaxAge{
+				Action: v1alpha1.SnapshotMaxAgeActionReject,
+				Days:   7,
+			}
+
+			staleSnapshot = snapshot.DeepCopy()
+			staleSnapshot.CreationTimestamp = metav1.NewTime(adapter.release.CreationTimestamp.Add(-10 * 24 * time.Hour))
 		})
 
-		It("should return valid and no error if all the resources are found", func() {
+		It("should return valid if the ReleasePlan doesn't limit the Snapshot age", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ProcessingResourcesContextKey,
-					Resource: &loader.ProcessingResources{
-						EnterpriseContractConfigMap: enterpriseContractConfigMap,
-						EnterpriseContractPolicy:    enterpriseContractPolicy,
-						ReleasePlanAdmission:        releasePlanAdmission,
-						Snapshot:                    snapshot,
-					},
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   staleSnapshot,
 				},
 			})
 
-			result := adapter.validateProcessingResources()
+			result := adapter.validateSnapshotAge()
 			Expect(result.Valid).To(BeTrue())
 			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
-		It("should return invalid and no error if any of the resources are not found", func() {
+		It("should return valid if the Snapshot is not older than the maximum age", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ProcessingResourcesContextKey,
-					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
 				},
 			})
 
-			result := adapter.validateProcessingResources()
-			Expect(result.Valid).To(BeFalse())
+			result := adapter.validateSnapshotAge()
+			Expect(result.Valid).To(BeTrue())
 			Expect(result.Err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.IsSnapshotStale()).To(BeFalse())
 		})
 
-		It("should return invalid and no error if the ReleasePlanAdmission is found to be disabled", func() {
+		It("should return invalid if the Snapshot is older than the maximum age", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        fmt.Errorf("auto-release label set to false"),
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   staleSnapshot,
 				},
 			})
 
-			result := adapter.validateProcessingResources()
+			result := adapter.validateSnapshotAge()
 			Expect(result.Valid).To(BeFalse())
 			Expect(result.Err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.Status.Validation.Causes).To(ContainElement(HaveField("Field", "spec.snapshot")))
 		})
 
-		It("should return invalid and no error if multiple ReleasePlanAdmissions exist", func() {
+		It("should only flag the Release if the ReleasePlan action is Warn", func() {
+			newReleasePlan.Spec.SnapshotMaxAge.Action = v1alpha1.SnapshotMaxAgeActionWarn
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        fmt.Errorf("multiple ReleasePlanAdmissions found"),
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   staleSnapshot,
 				},
 			})
 
-			result := adapter.validateProcessingResources()
-			Expect(result.Valid).To(BeFalse())
+			result := adapter.validateSnapshotAge()
+			Expect(result.Valid).To(BeTrue())
 			Expect(result.Err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.IsSnapshotStale()).To(BeTrue())
 		})
 
-		It("should return invalid and an error if some other type of error occurs", func() {
+		It("should return invalid if the Snapshot is not found", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ProcessingResourcesContextKey,
-					Err:        fmt.Errorf("internal error"),
-					Resource: &loader.ProcessingResources{
-						ReleasePlanAdmission: releasePlanAdmission,
-						ReleasePlan:          releasePlan,
-					},
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
 				},
 			})
 
-			result := adapter.validateProcessingResources()
+			result := adapter.validateSnapshotAge()
 			Expect(result.Valid).To(BeFalse())
-			Expect(result.Err).To(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
 		})
 	})
 
-	When("validatePipelineSource is called", func() {
+	When("getCustomValidation is called", func() {
 		var adapter *adapter
 
 		AfterEach(func() {
@@ -2046,107 +6902,39 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
-			releaseServiceConfig.Spec = v1alpha1.ReleaseServiceConfigSpec{}
-			adapter.releaseServiceConfig = releaseServiceConfig
 		})
 
-		It("should return invalid and no error if the ReleasePlanAdmission is not found", func() {
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
-				},
-			})
-
-			result := adapter.validatePipelineSource()
-			Expect(result.Valid).To(BeFalse())
+		It("should return valid if the validator doesn't return a cause", func() {
+			result := adapter.getCustomValidation(&mockValidator{})()
+			Expect(result.Valid).To(BeTrue())
 			Expect(result.Err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
 		})
 
-		It("should return invalid and an error if some other type of error occurs when retrieving the ReleasePlanAdmission", func() {
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        fmt.Errorf("internal error"),
-					Resource:   releasePlanAdmission,
-				},
-			})
-
-			result := adapter.validatePipelineSource()
+		It("should return the error if the validator fails", func() {
+			result := adapter.getCustomValidation(&mockValidator{err: fmt.Errorf("error")})()
 			Expect(result.Valid).To(BeFalse())
 			Expect(result.Err).To(HaveOccurred())
-			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.Status.Validation.Causes).To(BeEmpty())
 		})
 
-		It("returns invalid and no error if debug is false and the PipelineRef uses a cluster resolver", func() {
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource: &v1alpha1.ReleasePlanAdmission{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan-admission",
-							Namespace: "default",
-							Labels: map[string]string{
-								metadata.AutoReleaseLabel: "true",
-							},
-						},
-						Spec: v1alpha1.ReleasePlanAdmissionSpec{
-							Applications: []string{application.Name},
-							Origin:       "default",
-							Pipeline: &tektonutils.Pipeline{
-								PipelineRef: tektonutils.PipelineRef{
-									Resolver: "cluster",
-									Params: []tektonutils.Param{
-										{Name: "name", Value: "release-pipeline"},
-										{Name: "namespace", Value: "default"},
-										{Name: "kind", Value: "pipeline"},
-									},
-								},
-							},
-							Policy: enterpriseContractPolicy.Name,
-						},
-					},
-				},
-			})
-			adapter.releaseServiceConfig.Spec.Debug = false
-
-			result := adapter.validatePipelineSource()
+		It("should mark the validation as failed with the cause returned by the validator", func() {
+			result := adapter.getCustomValidation(&mockValidator{cause: &v1alpha1.ValidationCause{
+				Message: "the release is embargoed",
+				Reason:  metav1.CauseTypeForbidden,
+			}})()
 			Expect(result.Valid).To(BeFalse())
-			Expect(result.Err).To(BeNil())
+			Expect(result.Err).NotTo(HaveOccurred())
 			Expect(adapter.release.IsValid()).To(BeFalse())
-		})
-
-		It("returns valid and no error if debug mode is enabled in the ReleaseServiceConfig", func() {
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
-				},
-			})
-			adapter.releaseServiceConfig.Spec.Debug = true
-
-			result := adapter.validatePipelineSource()
-			Expect(result.Valid).To(BeTrue())
-			Expect(result.Err).To(BeNil())
-		})
-
-		It("returns valid and no error if debug mode is disabled and the PipelineRef uses a bundle resolver", func() {
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
-				},
-			})
-			result := adapter.validatePipelineSource()
-			Expect(result.Valid).To(BeTrue())
-			Expect(result.Err).To(BeNil())
+			Expect(adapter.release.Status.Validation.Causes).To(HaveLen(1))
+			Expect(adapter.release.Status.Validation.Causes[0].DocsKey).To(Equal("mock"))
+			Expect(adapter.release.Status.Validation.Causes[0].Message).To(Equal("the release is embargoed"))
 		})
 	})
 
-	When("validatePipelineDefined is called", func() {
+	When("validateHold is called", func() {
 		var adapter *adapter
-		var parameterizedPipeline *tektonutils.ParameterizedPipeline
+		var heldApplication *applicationapiv1alpha1.Application
+		var heldSnapshot *applicationapiv1alpha1.Snapshot
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -2154,280 +6942,382 @@ var _ = Describe("Release adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
-			parameterizedPipeline = &tektonutils.ParameterizedPipeline{}
-			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
-				Resolver: "git",
-				Params: []tektonutils.Param{
-					{Name: "url", Value: "my-url"},
-					{Name: "revision", Value: "my-revision"},
-					{Name: "pathInRepo", Value: "my-path"},
+			adapter.release.Labels = map[string]string{metadata.AutomatedLabel: "true"}
+
+			heldApplication = application.DeepCopy()
+			heldApplication.Labels = map[string]string{metadata.HoldLabel: "true"}
+			heldSnapshot = snapshot.DeepCopy()
+			heldSnapshot.Labels = map[string]string{metadata.HoldLabel: "true"}
+		})
+
+		It("should return valid if the Release is not automated", func() {
+			adapter.release.Labels = map[string]string{}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   heldSnapshot,
 				},
-			}
-			parameterizedPipeline.Params = []tektonutils.Param{
-				{Name: "parameter1", Value: "value1"},
-				{Name: "parameter2", Value: "value2"},
-			}
-			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
-				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
-			}
+			})
+
+			result := adapter.validateHold()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
-		It("should return true if ReleasePlanAdmission and ReleasePlan have Pipeline Set", func() {
+		It("should return valid if neither the Snapshot nor the Application are on hold", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource: &v1alpha1.ReleasePlanAdmission{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan-admission",
-							Namespace: "default",
-							Labels: map[string]string{
-								metadata.AutoReleaseLabel: "true",
-							},
-						},
-						Spec: v1alpha1.ReleasePlanAdmissionSpec{
-							Applications: []string{application.Name},
-							Origin:       "default",
-							Pipeline: &tektonutils.Pipeline{
-								PipelineRef: tektonutils.PipelineRef{
-									Resolver: "cluster",
-									Params: []tektonutils.Param{
-										{Name: "name", Value: "release-pipeline"},
-										{Name: "namespace", Value: "default"},
-										{Name: "kind", Value: "pipeline"},
-									},
-								},
-							},
-							Policy: enterpriseContractPolicy.Name,
-						},
-					},
+					ContextKey: loader.ApplicationContextKey,
+					Resource:   application,
 				},
 				{
 					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							Pipeline:               parameterizedPipeline,
-							ReleaseGracePeriodDays: 6,
-							Target:                 "default",
-						},
-					},
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
 				},
 			})
 
-			result := adapter.validatePipelineDefined()
+			result := adapter.validateHold()
 			Expect(result.Valid).To(BeTrue())
 			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
-		It("should return true if only ReleasePlan has Pipeline Set", func() {
+		It("should return invalid if the Snapshot is on hold", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							Pipeline:               parameterizedPipeline,
-							ReleaseGracePeriodDays: 6,
-							Target:                 "default",
-						},
-					},
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   heldSnapshot,
 				},
 			})
 
-			result := adapter.validatePipelineDefined()
-			Expect(result.Valid).To(BeTrue())
+			result := adapter.validateHold()
+			Expect(result.Valid).To(BeFalse())
 			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Validation.Causes).To(ContainElement(HaveField("DocsKey", "snapshot.hold-label")))
 		})
 
-		It("should return true if only ReleasePlan has Pipeline Set and it has no Target", func() {
+		It("should return invalid if the Application is on hold", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ApplicationContextKey,
+					Resource:   heldApplication,
+				},
 				{
 					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							Pipeline:               parameterizedPipeline,
-							ReleaseGracePeriodDays: 6,
-						},
-					},
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result := adapter.validateHold()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Validation.Causes).To(ContainElement(HaveField("DocsKey", "application.hold-label")))
+		})
+
+		It("should requeue with error if loading the Snapshot fails", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Err:        fmt.Errorf("some error"),
 				},
 			})
 
-			result := adapter.validatePipelineDefined()
-			Expect(result.Valid).To(BeTrue())
-			Expect(result.Err).NotTo(HaveOccurred())
+			result := adapter.validateHold()
+			Expect(result.Err).To(HaveOccurred())
 		})
+	})
 
-		It("should return true if only ReleasePlanAdmission has Pipeline Set", func() {
+	When("validateSkippedTasks is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Annotations = map[string]string{metadata.SkipTasksAnnotation: "verify,sign"}
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.SkippableTasks = []string{"sign", "verify"}
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource: &v1alpha1.ReleasePlanAdmission{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan-admission",
-							Namespace: "default",
-							Labels: map[string]string{
-								metadata.AutoReleaseLabel: "true",
-							},
-						},
-						Spec: v1alpha1.ReleasePlanAdmissionSpec{
-							Applications: []string{application.Name},
-							Origin:       "default",
-							Pipeline: &tektonutils.Pipeline{
-								PipelineRef: tektonutils.PipelineRef{
-									Resolver: "cluster",
-									Params: []tektonutils.Param{
-										{Name: "name", Value: "release-pipeline"},
-										{Name: "namespace", Value: "default"},
-										{Name: "kind", Value: "pipeline"},
-									},
-								},
-							},
-							Policy: enterpriseContractPolicy.Name,
-						},
-					},
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
 				},
 				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							ReleaseGracePeriodDays: 6,
-							Target:                 "default",
-						},
-					},
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
 				},
 			})
+		})
 
-			result := adapter.validatePipelineDefined()
+		It("should return valid if the Release doesn't skip any task", func() {
+			adapter.release.Annotations = nil
+
+			result := adapter.validateSkippedTasks()
 			Expect(result.Valid).To(BeTrue())
 			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
-		It("should return false if ReleasePlan has no Pipeline or Target set", func() {
+		It("should return valid if the ReleasePlanAdmission allows skipping the tasks", func() {
+			result := adapter.validateSkippedTasks()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return invalid if the ReleasePlanAdmission doesn't allow skipping some task", func() {
+			newReleasePlanAdmission.Spec.SkippableTasks = []string{"sign"}
+
+			result := adapter.validateSkippedTasks()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.Status.Validation.Causes).To(HaveLen(1))
+			Expect(adapter.release.Status.Validation.Causes[0].Message).To(ContainSubstring("skipping the tasks verify"))
+		})
+
+		It("should return invalid if the skip-tasks annotation is malformed", func() {
+			adapter.release.Annotations[metadata.SkipTasksAnnotation] = "Verify"
+
+			result := adapter.validateSkippedTasks()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("should return invalid if the ReleasePlan has no target", func() {
+			newReleasePlan := releasePlan.DeepCopy()
+			newReleasePlan.Spec.Target = ""
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							ReleaseGracePeriodDays: 6,
-						},
-					},
+					Resource:   newReleasePlan,
 				},
 			})
 
-			result := adapter.validatePipelineDefined()
+			result := adapter.validateSkippedTasks()
 			Expect(result.Valid).To(BeFalse())
 			Expect(result.Err).NotTo(HaveOccurred())
 		})
+	})
 
-		It("should return false if neither ReleasePlanAdmission nor ReleasePlan have Pipeline Set", func() {
+	When("validatePinnedImages is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+		var newSnapshot *applicationapiv1alpha1.Snapshot
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.RequirePinnedImages = true
+			newSnapshot = snapshot.DeepCopy()
+			newSnapshot.Spec.Components = []applicationapiv1alpha1.SnapshotComponent{
+				{Name: "foo", ContainerImage: "quay.io/org/foo@sha256:" + strings.Repeat("a", 64)},
+				{Name: "bar", ContainerImage: "quay.io/org/bar:1.0"},
+			}
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
 				{
 					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource: &v1alpha1.ReleasePlanAdmission{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan-admission",
-							Namespace: "default",
-							Labels: map[string]string{
-								metadata.AutoReleaseLabel: "true",
-							},
-						},
-						Spec: v1alpha1.ReleasePlanAdmissionSpec{
-							Applications: []string{application.Name},
-							Origin:       "default",
-							Policy:       enterpriseContractPolicy.Name,
-						},
-					},
+					Resource:   newReleasePlanAdmission,
 				},
 				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							ReleaseGracePeriodDays: 6,
-							Target:                 "default",
-						},
-					},
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   newSnapshot,
 				},
 			})
+		})
 
-			result := adapter.validatePipelineDefined()
+		It("should return valid if the ReleasePlanAdmission doesn't require pinned images", func() {
+			newReleasePlanAdmission.Spec.RequirePinnedImages = false
+
+			result := adapter.validatePinnedImages()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return invalid if a component image is referenced by tag", func() {
+			result := adapter.validatePinnedImages()
 			Expect(result.Valid).To(BeFalse())
 			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(adapter.release.Status.Validation.Causes).To(HaveLen(1))
+			Expect(adapter.release.Status.Validation.Causes[0].Message).To(ContainSubstring("component bar"))
+		})
+
+		It("should only check the components the Release releases", func() {
+			adapter.release.Annotations = map[string]string{metadata.ComponentsAnnotation: "foo"}
+
+			result := adapter.validatePinnedImages()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
-		It("should set the target in the release status to the ReleasePlan namespace if no target is defined", func() {
+		It("should return valid if the ReleasePlan has no target", func() {
+			newReleasePlan := releasePlan.DeepCopy()
+			newReleasePlan.Spec.Target = ""
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							Pipeline:               parameterizedPipeline,
-							ReleaseGracePeriodDays: 6,
-						},
-					},
+					Resource:   newReleasePlan,
 				},
 			})
 
-			_ = adapter.validatePipelineDefined()
-			Expect(adapter.release.Status.Target).To(Equal("default"))
+			result := adapter.validatePinnedImages()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
 		})
+	})
 
-		It("should set the target in the release status to the ReleasePlan target if one is defined", func() {
-			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
-				{
-					ContextKey: loader.ReleasePlanContextKey,
-					Resource: &v1alpha1.ReleasePlan{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "release-plan",
-							Namespace: "default",
-						},
-						Spec: v1alpha1.ReleasePlanSpec{
-							Application:            application.Name,
-							ReleaseGracePeriodDays: 6,
-							Target:                 "foo",
-						},
+	When("isPinnedByDigest is called", func() {
+		It("should return true for images referenced by digest", func() {
+			Expect(isPinnedByDigest("quay.io/org/repo@sha256:abc")).To(BeTrue())
+			Expect(isPinnedByDigest("quay.io/org/repo:1.0@sha256:abc")).To(BeTrue())
+		})
+
+		It("should return false for images referenced by tag", func() {
+			Expect(isPinnedByDigest("quay.io/org/repo")).To(BeFalse())
+			Expect(isPinnedByDigest("quay.io/org/repo:1.0")).To(BeFalse())
+			Expect(isPinnedByDigest("quay.io/org/repo@sha256")).To(BeFalse())
+		})
+	})
+
+	When("getComponentsParams is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return no params if the Release releases the whole Snapshot", func() {
+			Expect(adapter.getComponentsParams()).To(BeNil())
+		})
+
+		It("should return the components as an array param", func() {
+			adapter.release.Annotations = map[string]string{metadata.ComponentsAnnotation: "foo, bar"}
+
+			params := adapter.getComponentsParams()
+			Expect(params).To(HaveLen(1))
+			Expect(params[0].Name).To(Equal(componentsParamName))
+			Expect(params[0].Value.ArrayVal).To(Equal([]string{"foo", "bar"}))
+		})
+	})
+
+	When("getReleaseLinkParams is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig = releaseServiceConfig.DeepCopy()
+		})
+
+		It("should return the name, namespace and workspace of the Release", func() {
+			params := adapter.getReleaseLinkParams("application")
+			Expect(params).To(HaveLen(3))
+			Expect(params).To(ContainElement(HaveField("Name", releaseNameParamName)))
+			Expect(params).To(ContainElement(HaveField("Value.StringVal", adapter.release.Name)))
+			Expect(params).To(ContainElement(HaveField("Name", tenantWorkspaceParamName)))
+			Expect(params).NotTo(ContainElement(HaveField("Name", releaseURLParamName)))
+		})
+
+		It("should return the URL of the Release if the console URL is set", func() {
+			adapter.releaseServiceConfig.Spec.ConsoleURL = "https://console/ns/{namespace}/applications/{application}/releases/{release}"
+
+			params := adapter.getReleaseLinkParams("application")
+			Expect(params).To(HaveLen(4))
+			Expect(params[3].Name).To(Equal(releaseURLParamName))
+			Expect(params[3].Value.StringVal).To(Equal(fmt.Sprintf("https://console/ns/%s/applications/application/releases/%s",
+				adapter.release.Namespace, adapter.release.Name)))
+		})
+
+		It("should strip the tenant suffix from the namespace to get the workspace", func() {
+			namespace := adapter.release.Namespace
+			adapter.release.Namespace = "team-tenant"
+			params := adapter.getReleaseLinkParams("application")
+			adapter.release.Namespace = namespace
+
+			Expect(params[2].Name).To(Equal(tenantWorkspaceParamName))
+			Expect(params[2].Value.StringVal).To(Equal("team"))
+		})
+	})
+
+	When("getReleasePlanAdmissionDefaultsParams is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return no params if no ReleasePlanAdmissionDefaults were applied", func() {
+			Expect(adapter.getReleasePlanAdmissionDefaultsParams(&loader.ProcessingResources{})).To(BeNil())
+		})
+
+		It("should return the reference to the applied ReleasePlanAdmissionDefaults", func() {
+			params := adapter.getReleasePlanAdmissionDefaultsParams(&loader.ProcessingResources{
+				ReleasePlanAdmissionDefaults: &v1alpha1.ReleasePlanAdmissionDefaults{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      v1alpha1.ReleasePlanAdmissionDefaultsResourceName,
+						Namespace: "managed",
 					},
 				},
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
-				},
 			})
+			Expect(params).To(HaveLen(1))
+			Expect(params[0].Name).To(Equal(releasePlanAdmissionDefaultsParamName))
+			Expect(params[0].Value.StringVal).To(Equal("managed/" + v1alpha1.ReleasePlanAdmissionDefaultsResourceName))
+		})
+	})
 
-			_ = adapter.validatePipelineDefined()
-			Expect(adapter.release.Status.Target).To(Equal("foo"))
+	When("getSkippedTasksParams is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return no params if the Release doesn't skip any task", func() {
+			Expect(adapter.getSkippedTasksParams()).To(BeNil())
+		})
+
+		It("should return the skipped tasks as an array param", func() {
+			adapter.release.Annotations = map[string]string{metadata.SkipTasksAnnotation: "verify, sign"}
+
+			params := adapter.getSkippedTasksParams()
+			Expect(params).To(HaveLen(1))
+			Expect(params[0].Name).To(Equal(skipTasksParamName))
+			Expect(params[0].Value.ArrayVal).To(Equal([]string{"verify", "sign"}))
 		})
 	})
 
@@ -2590,3 +7480,81 @@ var _ = Describe("Release adapter", Ordered, func() {
 	}
 
 })
+
+// mockDecrypter returns the encrypted value prefixed with "decrypted " as its decrypted value.
+type mockDecrypter struct{}
+
+func (d *mockDecrypter) Decrypt(_ context.Context, value string) ([]byte, error) {
+	return []byte("decrypted " + value), nil
+}
+
+// mockBroker issues credentials named after the requested name, recording the issued repositories and the revoked
+// credentials.
+type mockBroker struct {
+	issued  []string
+	revoked []string
+}
+
+func (b *mockBroker) Issue(_ context.Context, name string, repositories []string) (*credentials.Credentials, error) {
+	b.issued = append(b.issued, repositories...)
+
+	return &credentials.Credentials{ID: name, Registry: "quay.io", Username: name, Password: "password"}, nil
+}
+
+func (b *mockBroker) Revoke(_ context.Context, revoked *credentials.Credentials) error {
+	b.revoked = append(b.revoked, revoked.ID)
+
+	return nil
+}
+
+// mockPodLogsGetter returns the container name as the logs of every container.
+type mockPodLogsGetter struct{}
+
+func (g *mockPodLogsGetter) GetLogs(_ context.Context, _, _, container string, _ int64) ([]byte, error) {
+	return []byte(container), nil
+}
+
+// mockEventsGetter returns a single event reporting the failure of the object.
+type mockEventsGetter struct{}
+
+func (g *mockEventsGetter) GetEvents(_ context.Context, namespace, name string) ([]corev1.Event, error) {
+	return []corev1.Event{
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: name + ".event", Namespace: namespace},
+			InvolvedObject: corev1.ObjectReference{Name: name, Namespace: namespace},
+			Reason:         "ReleaseFailed",
+		},
+	}, nil
+}
+
+// mockValidator is a ReleaseValidator returning the cause and error it holds.
+type mockValidator struct {
+	cause *v1alpha1.ValidationCause
+	err   error
+}
+
+func (v *mockValidator) Name() string {
+	return "mock"
+}
+
+func (v *mockValidator) Validate(_ context.Context, _ client.Client, _ *v1alpha1.Release) (*v1alpha1.ValidationCause, error) {
+	return v.cause, v.err
+}
+
+// mockPodGetter returns an evicted Pod running on the failed-node node for every Pod.
+type mockPodGetter struct{}
+
+func (g *mockPodGetter) GetPod(_ context.Context, namespace, name string) (*corev1.Pod, error) {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       corev1.PodSpec{NodeName: "failed-node"},
+		Status:     corev1.PodStatus{Reason: "Evicted"},
+	}, nil
+}
+
+// mockPlatformsGetter returns linux/amd64 and linux/arm64 as the platforms of every image.
+type mockPlatformsGetter struct{}
+
+func (g *mockPlatformsGetter) GetPlatforms(_ context.Context, _ string, _ authn.Keychain) ([]string, error) {
+	return []string{"linux/arm64", "linux/amd64"}, nil
+}
@@ -18,40 +18,98 @@ package release
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
 
 	"github.com/konflux-ci/operator-toolkit/controller"
-	"github.com/konflux-ci/operator-toolkit/predicates"
+	toolkitpredicates "github.com/konflux-ci/operator-toolkit/predicates"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backoff"
 	"github.com/konflux-ci/release-service/cache"
+	"github.com/konflux-ci/release-service/controllers/utils/predicates"
+	"github.com/konflux-ci/release-service/controllers/utils/recovery"
 	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/platforms"
+	"github.com/konflux-ci/release-service/support"
 	"github.com/konflux-ci/release-service/tekton"
+	"github.com/konflux-ci/release-service/throttle"
 	libhandler "github.com/operator-framework/operator-lib/handler"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// FullMode is the mode in which the controller takes care of the whole Release processing
+	FullMode = "full"
+
+	// TenantMode is the mode in which the controller only validates Releases and runs their tenant pipelines. It
+	// is meant to be deployed in the tenant cluster along with an instance running in ManagedMode.
+	TenantMode = "tenant"
+
+	// ManagedMode is the mode in which the controller only runs the managed pipelines of Releases that were
+	// validated and whose tenant processing finished. It is meant to be deployed in the managed cluster along with
+	// an instance running in TenantMode.
+	ManagedMode = "managed"
+
+	// platformsRequestTimeout is the timeout of the requests sent to image registries to get the image platforms
+	platformsRequestTimeout = 30 * time.Second
+
+	// panicQuarantineThreshold is the number of consecutive reconcile panics after which a Release is quarantined
+	panicQuarantineThreshold = 3
 )
 
 // Controller reconciles a Release object
 type Controller struct {
-	client client.Client
-	log    logr.Logger
+	backoff         *backoff.Backoff
+	client          client.Client
+	eventsGetter    support.EventsGetter
+	log             logr.Logger
+	mode            string
+	platformsGetter platforms.Getter
+	podGetter       tekton.PodGetter
+	podLogsGetter   tekton.PodLogsGetter
+	recorder        record.EventRecorder
+	throttle        *throttle.Throttle
 }
 
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases/finalizers,verbs=update
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=applications/finalizers,verbs=update
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=enterprisecontractpolicies,verbs=get;list;watch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=enterprisecontractpolicies/status,verbs=get
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseserviceconfigs,verbs=get;list;watch
-//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releasepipelinecatalogs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplanadmissiondefaults,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;patch;delete
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;list;patch
+//+kubebuilder:rbac:groups=core,resources=limitranges,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get
+//+kubebuilder:rbac:groups=core,resources=pods/log,verbs=get
+//+kubebuilder:rbac:groups=core,resources=resourcequotas,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;patch;delete
+//+kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch
+//+kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;delete
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=internalrequests,verbs=create;delete;get;list;watch
 //InternalRequests RBAC is required to prevent `forbidden: user system:serviceaccount:release-service:release-service-controller-manager
@@ -66,6 +124,8 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	err := c.client.Get(ctx, req.NamespacedName, release)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			c.backoff.Forget(req.String())
+			c.throttle.Forget(req.String())
 			return ctrl.Result{}, nil
 		}
 
@@ -73,39 +133,220 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	adapter := newAdapter(ctx, c.client, release, loader.NewLoader(), &logger)
+	adapter.backoff = c.backoff
+	adapter.throttle = c.throttle
+	adapter.eventsGetter = c.eventsGetter
+	adapter.platformsGetter = c.platformsGetter
+	adapter.podGetter = c.podGetter
+	adapter.podLogsGetter = c.podLogsGetter
+	adapter.recorder = c.recorder
 
-	return controller.ReconcileHandler([]controller.Operation{
+	result, err := controller.ReconcileHandler(c.getOperations(adapter))
+	if errors.IsConflict(err) {
+		logger.Info("Release changed while being processed, retrying with backoff")
+		return ctrl.Result{RequeueAfter: adapter.getBackoffDelay(backoff.ConflictCategory)}, nil
+	}
+	if err == nil {
+		c.backoff.Reset(backoff.ConflictCategory, req.String())
+	}
+
+	return result, err
+}
+
+// getOperations returns the operations to execute for the given adapter depending on the mode of the controller.
+// In TenantMode, the Release is processed until its tenant pipeline finishes. In ManagedMode, processing only starts
+// once the tenant instance has reported in the Release status that it is valid and its tenant pipeline finished. The
+// deployment of released Snapshots happens in the managed namespace, so it's handled outside TenantMode only. Failed
+// components are only retried and failed verifications only rolled back in FullMode, as the follow-up and rollback
+// Releases have to be created in the tenant namespace.
+func (c *Controller) getOperations(adapter *adapter) []controller.Operation {
+	switch c.mode {
+	case TenantMode:
+		return []controller.Operation{
+			adapter.EnsureRedactionIsConfigured, // This operation sets the redactor in the adapter to be used in other operations.
+			adapter.EnsureFinalizersAreCalled,
+			adapter.EnsureConfigIsLoaded, // This operation sets the config in the adapter to be used in other operations.
+			adapter.EnsureSupportBundleIsGenerated,
+			adapter.EnsureReleaseIsRecordedInSnapshot,
+			adapter.EnsureReleaseIsRunning,
+			adapter.EnsureReleaseIsValid,
+			adapter.EnsureFinalizerIsAdded,
+			adapter.EnsureDeprecatedFieldsAreReported,
+			adapter.EnsureReleaseExpirationTimeIsAdded,
+			adapter.EnsureReleaseProvenanceIsRecorded,
+			adapter.EnsureSnapshotTestsHavePassed,
+			adapter.EnsureSnapshotReleasableTimeIsRecorded,
+			adapter.EnsureReleaseDependencyIsMet,
+			adapter.EnsureSupersededReleaseIsSkipped,
+			adapter.EnsureTenantPipelineIsProcessed,
+			adapter.EnsureTenantPipelineProcessingIsTracked,
+		}
+	case ManagedMode:
+		return []controller.Operation{
+			adapter.EnsureRedactionIsConfigured, // This operation sets the redactor in the adapter to be used in other operations.
+			adapter.EnsureSnapshotEnvironmentBindingsAreCreated,
+			adapter.EnsureSnapshotEnvironmentBindingsAreTracked,
+			adapter.EnsureReleaseIsReadyForManagedProcessing,
+			adapter.EnsureConfigIsLoaded, // This operation sets the config in the adapter to be used in other operations.
+			adapter.EnsureDeletedRegistryCredentialsAreRevoked,
+			adapter.EnsureReleaseIsRunning,
+			adapter.EnsureReleaseIsApproved,
+			adapter.EnsureReleaseWindowIsOpen,
+			adapter.EnsureSupersededReleaseIsSkipped,
+			adapter.EnsureFanOutPipelinesAreProcessed,
+			adapter.EnsureManagedPipelineIsProcessed,
+			adapter.EnsureFanOutPipelinesProcessingIsTracked,
+			adapter.EnsureManagedPipelineProcessingIsTracked,
+			adapter.EnsureVerificationPipelineIsProcessed,
+			adapter.EnsureVerificationPipelineProcessingIsTracked,
+			adapter.EnsureReleaseProcessingResourcesAreCleanedUp,
+			adapter.EnsureReleaseIsCompleted,
+		}
+	}
+
+	return []controller.Operation{
+		adapter.EnsureRedactionIsConfigured, // This operation sets the redactor in the adapter to be used in other operations.
 		adapter.EnsureFinalizersAreCalled,
 		adapter.EnsureConfigIsLoaded, // This operation sets the config in the adapter to be used in other operations.
+		adapter.EnsureDeletedRegistryCredentialsAreRevoked,
+		adapter.EnsureSnapshotEnvironmentBindingsAreCreated,
+		adapter.EnsureSnapshotEnvironmentBindingsAreTracked,
+		adapter.EnsureFailedComponentsAreRetried,
+		adapter.EnsureFailedVerificationIsRolledBack,
+		adapter.EnsureSupportBundleIsGenerated,
+		adapter.EnsureReleaseIsRecordedInSnapshot,
 		adapter.EnsureReleaseIsRunning,
 		adapter.EnsureReleaseIsValid,
 		adapter.EnsureFinalizerIsAdded,
+		adapter.EnsureDeprecatedFieldsAreReported,
 		adapter.EnsureReleaseExpirationTimeIsAdded,
+		adapter.EnsureReleaseProvenanceIsRecorded,
+		adapter.EnsureSnapshotTestsHavePassed,
+		adapter.EnsureSnapshotReleasableTimeIsRecorded,
+		adapter.EnsureReleaseIsApproved,
+		adapter.EnsureReleaseWindowIsOpen,
+		adapter.EnsureReleaseDependencyIsMet,
+		adapter.EnsureSupersededReleaseIsSkipped,
 		adapter.EnsureTenantPipelineIsProcessed,
 		adapter.EnsureTenantPipelineProcessingIsTracked,
+		adapter.EnsureFanOutPipelinesAreProcessed,
 		adapter.EnsureManagedPipelineIsProcessed,
+		adapter.EnsureFanOutPipelinesProcessingIsTracked,
 		adapter.EnsureManagedPipelineProcessingIsTracked,
+		adapter.EnsureVerificationPipelineIsProcessed,
+		adapter.EnsureVerificationPipelineProcessingIsTracked,
 		adapter.EnsureReleaseProcessingResourcesAreCleanedUp,
 		adapter.EnsureReleaseIsCompleted,
-	})
+	}
 }
 
-// Register registers the controller with the passed manager and log. This controller ignores Release status updates and
-// also watches for PipelineRuns and SnapshotEnvironmentBindings that are created by the adapter and owned by the
-// Releases so the owner gets reconciled on changes.
+// Register registers the controller with the passed manager and log. The mode of the controller is read from the
+// RELEASE_MODE environment variable, defaulting to FullMode, and decides which Release status updates are reconciled.
+// The PipelineRuns, SnapshotEnvironmentBindings and registry credentials Secrets created for the Releases and the
+// integration test results of their Snapshots are watched too. Panicking Releases are quarantined.
 func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.backoff = backoff.New()
 	c.client = mgr.GetClient()
 	c.log = log.WithName("release")
+	c.recorder = mgr.GetEventRecorderFor("release-controller")
+	c.throttle = throttle.New()
+
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	c.eventsGetter = support.NewEventsGetter(clientset)
+	c.podGetter = tekton.NewPodGetter(clientset)
+	c.podLogsGetter = tekton.NewPodLogsGetter(clientset)
+	c.platformsGetter = platforms.NewRegistryGetter(&http.Client{Timeout: platformsRequestTimeout})
+
+	c.mode = os.Getenv("RELEASE_MODE")
+	if c.mode == "" {
+		c.mode = FullMode
+	}
+
+	// Status updates are ignored unless they hand the Release over to another stage of its processing: recording it in
+	// its Snapshot once released, retrying its failed components, generating its support bundle or, in ManagedMode,
+	// starting its managed processing
+	releasePredicate := predicate.Or(predicate.GenerationChangedPredicate{}, predicates.ReleaseApprovedPredicate(),
+		predicates.ReleaseEmergencyBypassedPredicate())
+	switch c.mode {
+	case FullMode:
+		releasePredicate = predicate.Or(releasePredicate, predicates.ReleaseSucceededPredicate(),
+			predicates.ReleasePartiallyReleasedPredicate(), predicates.ReleaseSupportBundleRequestedPredicate())
+	case TenantMode:
+		releasePredicate = predicate.Or(releasePredicate, predicates.ReleaseSucceededPredicate(),
+			predicates.ReleaseSupportBundleRequestedPredicate())
+	case ManagedMode:
+		releasePredicate = predicate.Or(releasePredicate, predicates.ReleaseReadyForManagedProcessingPredicate(),
+			predicates.ReleaseSucceededPredicate())
+	default:
+		return fmt.Errorf("unknown release controller mode '%s'", c.mode)
+	}
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.Release{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}, predicates.IgnoreBackups{})).
+		For(&v1alpha1.Release{}, builder.WithPredicates(releasePredicate, toolkitpredicates.IgnoreBackups{})).
 		Watches(&tektonv1.PipelineRun{}, &libhandler.EnqueueRequestForAnnotation{
 			Type: schema.GroupKind{
 				Kind:  "Release",
 				Group: "appstudio.redhat.com",
 			},
 		}, builder.WithPredicates(tekton.ReleasePipelineRunSucceededPredicate())).
-		Complete(c)
+		Watches(&applicationapiv1alpha1.SnapshotEnvironmentBinding{}, &libhandler.EnqueueRequestForAnnotation{
+			Type: schema.GroupKind{
+				Kind:  "Release",
+				Group: "appstudio.redhat.com",
+			},
+		}, builder.WithPredicates(predicates.SnapshotEnvironmentBindingDeploymentStatusChangedPredicate())).
+		Watches(&applicationapiv1alpha1.Snapshot{}, handler.EnqueueRequestsFromMapFunc(c.getReleasesAwaitingTestResults),
+			builder.WithPredicates(predicates.SnapshotTestStatusChangedPredicate())).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(getTrackedRelease),
+			builder.WithPredicates(predicates.DeletionRequestedPredicate(),
+				predicate.NewPredicateFuncs(isRegistryCredentialsSecret))).
+		Complete(metrics.NewInstrumentedReconciler("release", recovery.NewRecoveringReconciler(c.client, "release",
+			func() client.Object { return &v1alpha1.Release{} }, c, panicQuarantineThreshold)))
+}
+
+// getTrackedRelease returns a reconcile request for the Release the given object was created for, as set in its
+// tracking labels.
+func getTrackedRelease(_ context.Context, object client.Object) []reconcile.Request {
+	release, found := metadata.GetTrackedRelease(object)
+	if !found {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: release}}
+}
+
+// isRegistryCredentialsSecret returns whether the given object is a Secret holding registry credentials minted for a
+// Release.
+func isRegistryCredentialsSecret(object client.Object) bool {
+	_, found := object.GetAnnotations()[metadata.RegistryCredentialsAnnotation]
+
+	return found
+}
+
+// getReleasesAwaitingTestResults returns a reconcile request for each Release of the given Snapshot that is waiting
+// for its integration tests.
+func (c *Controller) getReleasesAwaitingTestResults(ctx context.Context, object client.Object) []reconcile.Request {
+	releases := &v1alpha1.ReleaseList{}
+	err := c.client.List(ctx, releases,
+		client.InNamespace(object.GetNamespace()),
+		client.MatchingFields{"spec.snapshot": object.GetName()})
+	if err != nil {
+		c.log.Error(err, "Failed to list the Releases of a Snapshot", "Snapshot.Name", object.GetName(),
+			"Snapshot.Namespace", object.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range releases.Items {
+		if releases.Items[i].IsAwaitingTestResults() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&releases.Items[i])})
+		}
+	}
+
+	return requests
 }
 
 // SetupCache indexes fields for each of the resources used in the release adapter in those cases where filtering by
@@ -117,8 +358,17 @@ func (c *Controller) SetupCache(mgr ctrl.Manager) error {
 	if err := cache.SetupReleaseCache(mgr); err != nil {
 		return err
 	}
+	if err := cache.SetupReleaseSnapshotCache(mgr); err != nil {
+		return err
+	}
+	if err := cache.SetupReleaseTargetCache(mgr); err != nil {
+		return err
+	}
+	if err := cache.SetupSnapshotEnvironmentBindingCache(mgr); err != nil {
+		return err
+	}
 
-	// NOTE: Both the release and releaseplan controller need this ReleasePlanAdmission cache. However, it only needs to be added
-	// once to the manager, so only one controller should add it. If it is removed here, it should be added to the ReleasePlan controller.
+	// NOTE: Both the release and releaseplan controller need this ReleasePlanAdmission cache. Conflicts are ignored
+	// when adding it, so both controllers can add it and be enabled independently.
 	return cache.SetupReleasePlanAdmissionCache(mgr)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package release
 
 import (
+	"os"
 	"reflect"
 
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -74,6 +75,10 @@ var _ = Describe("Release Controller", Ordered, func() {
 
 	When("Register is called", func() {
 
+		AfterEach(func() {
+			Expect(os.Unsetenv("RELEASE_MODE")).To(Succeed())
+		})
+
 		It("should setup the controller successfully", func() {
 			controller := &Controller{
 				client: k8sClient,
@@ -88,6 +93,61 @@ var _ = Describe("Release Controller", Ordered, func() {
 				LeaderElection: false,
 			})
 			Expect(controller.Register(mgr, &ctrl.Log, nil)).To(Succeed())
+			Expect(controller.mode).To(Equal(FullMode))
+		})
+
+		It("should setup the controller in the mode set in the environment", func() {
+			Expect(os.Setenv("RELEASE_MODE", ManagedMode)).To(Succeed())
+			controller := &Controller{
+				client: k8sClient,
+				log:    ctrl.Log,
+			}
+
+			mgr, _ := ctrl.NewManager(cfg, ctrl.Options{
+				Scheme: scheme.Scheme,
+				Metrics: server.Options{
+					BindAddress: "0", // disables metrics
+				},
+				LeaderElection: false,
+			})
+			Expect(controller.Register(mgr, &ctrl.Log, nil)).To(Succeed())
+			Expect(controller.mode).To(Equal(ManagedMode))
+		})
+
+		It("should fail if the mode set in the environment is unknown", func() {
+			Expect(os.Setenv("RELEASE_MODE", "foo")).To(Succeed())
+			controller := &Controller{
+				client: k8sClient,
+				log:    ctrl.Log,
+			}
+
+			mgr, _ := ctrl.NewManager(cfg, ctrl.Options{
+				Scheme: scheme.Scheme,
+				Metrics: server.Options{
+					BindAddress: "0", // disables metrics
+				},
+				LeaderElection: false,
+			})
+			err := controller.Register(mgr, &ctrl.Log, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown release controller mode 'foo'"))
+		})
+	})
+
+	When("getOperations is called", func() {
+		It("should return all the operations in full mode", func() {
+			controller := &Controller{mode: FullMode}
+			Expect(controller.getOperations(&adapter{})).To(HaveLen(32))
+		})
+
+		It("should return only the tenant operations in tenant mode", func() {
+			controller := &Controller{mode: TenantMode}
+			Expect(controller.getOperations(&adapter{})).To(HaveLen(17))
+		})
+
+		It("should return only the managed operations in managed mode", func() {
+			controller := &Controller{mode: ManagedMode}
+			Expect(controller.getOperations(&adapter{})).To(HaveLen(18))
 		})
 	})
 
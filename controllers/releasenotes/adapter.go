@@ -0,0 +1,125 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/releasenotes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// publisherFactory creates the Publisher committing to the given release notes repository with the given token.
+type publisherFactory func(repository *v1alpha1.ReleaseNotesRepository, token string) (releasenotes.Publisher, error)
+
+// adapter holds the objects needed to reconcile a Release to publish its release notes.
+type adapter struct {
+	client       client.Client
+	ctx          context.Context
+	loader       loader.ObjectLoader
+	logger       *logr.Logger
+	newPublisher publisherFactory
+	release      *v1alpha1.Release
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, release *v1alpha1.Release, newPublisher publisherFactory, loader loader.ObjectLoader, logger *logr.Logger) *adapter {
+	return &adapter{
+		client:       client,
+		ctx:          ctx,
+		loader:       loader,
+		logger:       logger,
+		newPublisher: newPublisher,
+		release:      release,
+	}
+}
+
+// EnsureReleaseNotesArePublished is an operation that will ensure that the release notes of a successful Release are
+// committed to the release notes repository set in its ReleasePlanAdmission. Once committed, the Release is annotated
+// with the path of its release notes so they are not published again.
+func (a *adapter) EnsureReleaseNotesArePublished() (controller.OperationResult, error) {
+	if !a.release.IsReleased() || a.release.GetAnnotations()[metadata.ReleaseNotesAnnotation] != "" {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.ContinueProcessing()
+		}
+		return controller.RequeueWithError(err)
+	}
+
+	releasePlanAdmission, err := a.loader.GetMatchingReleasePlanAdmission(a.ctx, a.client, releasePlan)
+	if err != nil {
+		if errors.IsNotFound(err) || strings.Contains(err.Error(), "no ReleasePlanAdmission") {
+			return controller.ContinueProcessing()
+		}
+		return controller.RequeueWithError(err)
+	}
+
+	repository := releasePlanAdmission.Spec.ReleaseNotes
+	if repository == nil {
+		return controller.ContinueProcessing()
+	}
+
+	secret, err := a.loader.GetSecret(a.ctx, a.client, repository.Secret, releasePlanAdmission.Namespace)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	notes, err := releasenotes.NewReleaseNotes(a.release, snapshot)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	publisher, err := a.newPublisher(repository, string(secret.Data[releasenotes.TokenSecretKey]))
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	path := releasenotes.GetPath(repository.Path, snapshot.Spec.Application, a.release)
+	err = publisher.Publish(a.ctx, path, notes,
+		fmt.Sprintf("Add release notes for %s/%s", a.release.Namespace, a.release.Name))
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	metadata.AddAnnotations(a.release, map[string]string{metadata.ReleaseNotesAnnotation: path})
+	err = a.client.Patch(a.ctx, a.release, patch)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Published release notes", "Path", path)
+
+	return controller.ContinueProcessing()
+}
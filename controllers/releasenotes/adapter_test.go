@@ -0,0 +1,231 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/releasenotes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Release notes adapter", Ordered, func() {
+	var (
+		createReleaseAndAdapter func() *adapter
+		publisher               *mockPublisher
+		releasePlanAdmission    *v1alpha1.ReleasePlanAdmission
+	)
+
+	When("newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, nil, loader.NewLoader(), &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+		})
+	})
+
+	When("EnsureReleaseNotesArePublished is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			publisher = &mockPublisher{}
+			releasePlanAdmission = &v1alpha1.ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "releaseplanadmission",
+					Namespace: "managed",
+				},
+				Spec: v1alpha1.ReleasePlanAdmissionSpec{
+					ReleaseNotes: &v1alpha1.ReleaseNotesRepository{
+						Branch: "main",
+						Path:   "releases",
+						Secret: "release-notes",
+						URL:    "https://github.com/foo/bar",
+					},
+				},
+			}
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should not publish the release notes of a Release that didn't succeed", func() {
+			result, err := adapter.EnsureReleaseNotesArePublished()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher.paths).To(BeEmpty())
+		})
+
+		It("should not publish release notes that were already published", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			metadata.AddAnnotations(adapter.release, map[string]string{metadata.ReleaseNotesAnnotation: "notes.md"})
+
+			result, err := adapter.EnsureReleaseNotesArePublished()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher.paths).To(BeEmpty())
+		})
+
+		It("should not publish release notes if the ReleasePlanAdmission doesn't set a repository", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			releasePlanAdmission.Spec.ReleaseNotes = nil
+			adapter.ctx = getMockedContext(releasePlanAdmission, nil)
+
+			result, err := adapter.EnsureReleaseNotesArePublished()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher.paths).To(BeEmpty())
+		})
+
+		It("should not publish release notes if the ReleasePlanAdmission no longer exists", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   &v1alpha1.ReleasePlan{},
+				},
+				{
+					ContextKey: loader.MatchedReleasePlanAdmissionContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseNotesArePublished()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher.paths).To(BeEmpty())
+		})
+
+		It("should publish the release notes of a successful Release and annotate it", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.ctx = getMockedContext(releasePlanAdmission, nil)
+
+			result, err := adapter.EnsureReleaseNotesArePublished()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher.token).To(Equal("token"))
+			Expect(publisher.paths).To(HaveLen(1))
+			Expect(publisher.paths[0]).To(HavePrefix("releases/application/"))
+			Expect(adapter.release.GetAnnotations()[metadata.ReleaseNotesAnnotation]).To(Equal(publisher.paths[0]))
+		})
+
+		It("should requeue if the repository Secret can't be loaded", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.ctx = getMockedContext(releasePlanAdmission, errors.NewNotFound(schema.GroupResource{}, ""))
+
+			result, err := adapter.EnsureReleaseNotesArePublished()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(publisher.paths).To(BeEmpty())
+		})
+
+		It("should requeue if the release notes can't be published", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.ctx = getMockedContext(releasePlanAdmission, nil)
+			publisher.err = fmt.Errorf("publish failed")
+
+			result, err := adapter.EnsureReleaseNotesArePublished()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.ReleaseNotesAnnotation]).To(BeEmpty())
+		})
+	})
+
+	createReleaseAndAdapter = func() *adapter {
+		release := &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "release-",
+				Namespace:    "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: "releaseplan",
+				Snapshot:    "snapshot",
+			},
+		}
+		Expect(k8sClient.Create(ctx, release)).To(Succeed())
+
+		newPublisher := func(_ *v1alpha1.ReleaseNotesRepository, token string) (releasenotes.Publisher, error) {
+			publisher.token = token
+			return publisher, nil
+		}
+
+		return newAdapter(ctx, k8sClient, release, newPublisher, loader.NewMockLoader(), &ctrl.Log)
+	}
+})
+
+// getMockedContext returns a context mocking the resources needed to publish release notes with the given
+// ReleasePlanAdmission. If an error is passed, it's returned when loading the repository Secret.
+func getMockedContext(releasePlanAdmission *v1alpha1.ReleasePlanAdmission, secretErr error) context.Context {
+	return toolkit.GetMockedContext(ctx, []toolkit.MockData{
+		{
+			ContextKey: loader.MatchedReleasePlanAdmissionContextKey,
+			Resource:   releasePlanAdmission,
+		},
+		{
+			ContextKey: loader.ReleasePlanContextKey,
+			Resource:   &v1alpha1.ReleasePlan{},
+		},
+		{
+			ContextKey: loader.SecretContextKey,
+			Resource: &corev1.Secret{
+				Data: map[string][]byte{releasenotes.TokenSecretKey: []byte("token")},
+			},
+			Err: secretErr,
+		},
+		{
+			ContextKey: loader.SnapshotContextKey,
+			Resource: &applicationapiv1alpha1.Snapshot{
+				Spec: applicationapiv1alpha1.SnapshotSpec{Application: "application"},
+			},
+		},
+	})
+}
+
+// mockPublisher is a releasenotes.Publisher keeping the paths of the published release notes in memory.
+type mockPublisher struct {
+	err   error
+	paths []string
+	token string
+}
+
+func (p *mockPublisher) Publish(_ context.Context, path string, _ []byte, _ string) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.paths = append(p.paths, path)
+
+	return nil
+}
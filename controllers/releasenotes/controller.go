@@ -0,0 +1,98 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/releasenotes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Controller reconciles successful Releases to publish their release notes
+type Controller struct {
+	client client.Client
+	log    logr.Logger
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans,verbs=get;list;watch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplanadmissions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=snapshots,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("Release", req.NamespacedName)
+
+	release := &v1alpha1.Release{}
+	err := c.client.Get(ctx, req.NamespacedName, release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	adapter := newAdapter(ctx, c.client, release, newPublisher, loader.NewLoader(), &logger)
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureReleaseNotesArePublished,
+	})
+}
+
+// Register registers the controller with the passed manager and log. This controller only reacts to successful
+// Releases whose release notes were not published yet.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.client = mgr.GetClient()
+	c.log = log.WithName("releasenotes")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("releasenotes").
+		For(&v1alpha1.Release{}, builder.WithPredicates(predicate.NewPredicateFuncs(isPendingReleaseNotes))).
+		Complete(metrics.NewInstrumentedReconciler("releasenotes", c))
+}
+
+// isPendingReleaseNotes returns whether the given object is a successful Release whose release notes were not
+// published yet.
+func isPendingReleaseNotes(object client.Object) bool {
+	release, ok := object.(*v1alpha1.Release)
+	if !ok {
+		return false
+	}
+
+	return release.IsReleased() && release.GetAnnotations()[metadata.ReleaseNotesAnnotation] == ""
+}
+
+// newPublisher creates and returns a Publisher committing to the given release notes repository with the given token.
+func newPublisher(repository *v1alpha1.ReleaseNotesRepository, token string) (releasenotes.Publisher, error) {
+	return releasenotes.NewPublisher(repository, token, http.DefaultClient)
+}
@@ -19,21 +19,28 @@ package releaseplan
 import (
 	"context"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/operator-toolkit/controller"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backoff"
 	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
 	"github.com/konflux-ci/release-service/syncer"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // adapter holds the objects needed to reconcile a ReleasePlan.
 type adapter struct {
+	backoff     *backoff.Backoff
 	client      client.Client
 	ctx         context.Context
 	loader      loader.ObjectLoader
@@ -54,9 +61,146 @@ func newAdapter(ctx context.Context, client client.Client, releasePlan *v1alpha1
 	}
 }
 
+// EnsureFinalizersAreCalled is an operation that will ensure that the Releases created with the ReleasePlan being
+// processed are handled according to its deletion policy whenever it's marked for deletion. Running Releases either
+// block the deletion until they finish or are deleted along with the ReleasePlan, while finished Releases are flagged
+// with the PlanDeleted condition. Once no running Releases remain, the finalizer will be removed and the ReleasePlan
+// will go back to the queue, so it gets deleted.
+func (a *adapter) EnsureFinalizersAreCalled() (controller.OperationResult, error) {
+	// Check if the ReleasePlan is marked for deletion and continue processing other operations otherwise
+	if a.releasePlan.GetDeletionTimestamp() == nil {
+		return controller.ContinueProcessing()
+	}
+
+	if controllerutil.ContainsFinalizer(a.releasePlan, metadata.ReleasePlanFinalizer) {
+		runningReleases, err := a.finalizeReleasePlan()
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+
+		if len(runningReleases) > 0 {
+			if a.releasePlan.Spec.DeletionPolicy != v1alpha1.DeletionPolicyCascade {
+				a.logger.Info("ReleasePlan deletion blocked by running Releases", "Releases", runningReleases)
+				patch := client.MergeFrom(a.releasePlan.DeepCopy())
+				a.releasePlan.MarkDeletionBlocked(runningReleases)
+				err = a.client.Status().Patch(a.ctx, a.releasePlan, patch)
+				if err != nil && !errors.IsNotFound(err) {
+					return controller.RequeueWithError(err)
+				}
+			}
+
+			return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+		}
+		a.backoff.Reset(backoff.DependencyCategory, client.ObjectKeyFromObject(a.releasePlan).String())
+
+		patch := client.MergeFrom(a.releasePlan.DeepCopy())
+		controllerutil.RemoveFinalizer(a.releasePlan, metadata.ReleasePlanFinalizer)
+		err = a.client.Patch(a.ctx, a.releasePlan, patch)
+		if err != nil && !errors.IsNotFound(err) {
+			return controller.RequeueWithError(err)
+		}
+	}
+
+	// Requeue the ReleasePlan again so it gets deleted and other operations are not executed
+	return controller.Requeue()
+}
+
+// EnsureDeprecatedFieldsAreReported is an operation that will ensure that the DeprecatedFieldsUsed condition of the
+// ReleasePlan reports the deprecated fields it sets, so they are migrated before the v1beta1 cutover. The reported fields
+// are also registered in the metrics.
+func (a *adapter) EnsureDeprecatedFieldsAreReported() (controller.OperationResult, error) {
+	fields := a.releasePlan.GetDeprecatedFields()
+	if a.releasePlan.HasDeprecatedFieldsReported(fields) {
+		return controller.ContinueProcessing()
+	}
+
+	patch := client.MergeFrom(a.releasePlan.DeepCopy())
+	a.releasePlan.MarkDeprecatedFieldsUsed(fields)
+	err := a.client.Status().Patch(a.ctx, a.releasePlan, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	for _, field := range fields {
+		metrics.RegisterDeprecatedFieldUsed("ReleasePlan", field.Field)
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureFinalizerIsAdded is an operation that will ensure that the ReleasePlan being processed contains a finalizer.
+func (a *adapter) EnsureFinalizerIsAdded() (controller.OperationResult, error) {
+	if controllerutil.ContainsFinalizer(a.releasePlan, metadata.ReleasePlanFinalizer) {
+		return controller.ContinueProcessing()
+	}
+
+	a.logger.Info("Adding Finalizer to the ReleasePlan")
+	patch := client.MergeFrom(a.releasePlan.DeepCopy())
+	controllerutil.AddFinalizer(a.releasePlan, metadata.ReleasePlanFinalizer)
+
+	return controller.RequeueOnErrorOrContinue(a.client.Patch(a.ctx, a.releasePlan, patch))
+}
+
+// EnsureTransferIsProcessed is an operation that will ensure that a ReleasePlan is transferred to the application set
+// in its transfer-to annotation once the transfer is accepted by setting the same application in its transfer-accepted
+// annotation. Until then, the transfer is reported as pending. Before transferring the ReleasePlan, its existing
+// Releases are annotated with the application it belonged to, so they keep pointing to the original ReleasePlan. The
+// owner reference to the previous application is removed, so it's set again to the new one.
+func (a *adapter) EnsureTransferIsProcessed() (controller.OperationResult, error) {
+	target := a.releasePlan.GetTransferTarget()
+	if target == "" {
+		return controller.ContinueProcessing()
+	}
+
+	if !a.releasePlan.IsTransferAccepted() {
+		if a.releasePlan.IsTransferPending(target) {
+			return controller.ContinueProcessing()
+		}
+
+		patch := client.MergeFrom(a.releasePlan.DeepCopy())
+		a.releasePlan.MarkTransferPending(target)
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.releasePlan, patch))
+	}
+
+	transferredReleasePlan := a.releasePlan.DeepCopy()
+	transferredReleasePlan.Spec.Application = target
+	_, err := a.loader.GetApplication(a.ctx, a.client, transferredReleasePlan)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
+		}
+		return controller.RequeueWithError(err)
+	}
+	a.backoff.Reset(backoff.DependencyCategory, client.ObjectKeyFromObject(a.releasePlan).String())
+
+	err = a.annotateReleasesWithApplication()
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	source := a.releasePlan.Spec.Application
+	patch := client.MergeFrom(a.releasePlan.DeepCopy())
+	a.releasePlan.Spec.Application = target
+	a.releasePlan.OwnerReferences = slices.DeleteFunc(a.releasePlan.OwnerReferences, func(ref metav1.OwnerReference) bool {
+		return ref.Kind == "Application"
+	})
+	delete(a.releasePlan.Annotations, metadata.TransferAcceptedAnnotation)
+	delete(a.releasePlan.Annotations, metadata.TransferToAnnotation)
+	err = a.client.Patch(a.ctx, a.releasePlan, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("ReleasePlan transferred", "From", source, "To", target)
+
+	patch = client.MergeFrom(a.releasePlan.DeepCopy())
+	a.releasePlan.MarkTransferred(source, target)
+	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.releasePlan, patch))
+}
+
 // EnsureOwnerReferenceIsSet is an operation that will ensure that the owner reference is set.
 // If the Application who owns the ReleasePlan is not found, the error will be ignored and the
-// ReleasePlan will be reconciled again after a minute.
+// ReleasePlan will be reconciled again following the Dependency backoff policy.
 func (a *adapter) EnsureOwnerReferenceIsSet() (controller.OperationResult, error) {
 	if len(a.releasePlan.OwnerReferences) > 0 {
 		return controller.ContinueProcessing()
@@ -65,10 +209,11 @@ func (a *adapter) EnsureOwnerReferenceIsSet() (controller.OperationResult, error
 	application, err := a.loader.GetApplication(a.ctx, a.client, a.releasePlan)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return controller.RequeueAfter(time.Minute, nil)
+			return controller.RequeueAfter(a.getBackoffDelay(backoff.DependencyCategory), nil)
 		}
 		return controller.RequeueWithError(err)
 	}
+	a.backoff.Reset(backoff.DependencyCategory, client.ObjectKeyFromObject(a.releasePlan).String())
 
 	patch := client.MergeFrom(a.releasePlan.DeepCopy())
 	err = ctrl.SetControllerReference(application, a.releasePlan, a.client.Scheme())
@@ -108,3 +253,85 @@ func (a *adapter) EnsureMatchingInformationIsSet() (controller.OperationResult,
 
 	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.releasePlan, patch))
 }
+
+// finalizeReleasePlan handles the Releases created with the ReleasePlan before it gets deleted. Finished Releases are
+// flagged with the PlanDeleted condition, so they keep being reconciled without it. If the deletion policy of the
+// ReleasePlan is Cascade, the running Releases are deleted. The names of the Releases that are still running are
+// returned, as the ReleasePlan can't be deleted until they are gone.
+func (a *adapter) finalizeReleasePlan() ([]string, error) {
+	releases, err := a.loader.GetReleases(a.ctx, a.client, a.releasePlan.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var runningReleases []string
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Spec.ReleasePlan != a.releasePlan.Name {
+			continue
+		}
+
+		if !release.HasReleaseFinished() {
+			runningReleases = append(runningReleases, release.Name)
+			if a.releasePlan.Spec.DeletionPolicy == v1alpha1.DeletionPolicyCascade && release.GetDeletionTimestamp() == nil {
+				err = a.client.Delete(a.ctx, release)
+				if err != nil && !errors.IsNotFound(err) {
+					return nil, err
+				}
+				a.logger.Info("Deleted running Release along with its ReleasePlan", "Release.Name", release.Name)
+			}
+			continue
+		}
+
+		if release.IsPlanDeleted() {
+			continue
+		}
+
+		patch := client.MergeFrom(release.DeepCopy())
+		release.MarkPlanDeleted(a.releasePlan.Name)
+		err = a.client.Status().Patch(a.ctx, release, patch)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return runningReleases, nil
+}
+
+// getBackoffDelay records a new attempt of the given category of operations on the ReleasePlan being processed and
+// returns the delay the backoff policy of the category defines before retrying it.
+func (a *adapter) getBackoffDelay(category backoff.Category) time.Duration {
+	return a.backoff.Next(category, client.ObjectKeyFromObject(a.releasePlan).String(),
+		backoff.LoadPolicy(a.ctx, a.client, a.loader, category))
+}
+
+// annotateReleasesWithApplication annotates the Releases created with the ReleasePlan with the application it belongs
+// to. Releases that are already annotated keep their value, as it points to the application the ReleasePlan belonged
+// to when they were created.
+func (a *adapter) annotateReleasesWithApplication() error {
+	releases, err := a.loader.GetReleases(a.ctx, a.client, a.releasePlan.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Spec.ReleasePlan != a.releasePlan.Name {
+			continue
+		}
+		if _, found := release.GetAnnotations()[metadata.ReleasePlanApplicationAnnotation]; found {
+			continue
+		}
+
+		patch := client.MergeFrom(release.DeepCopy())
+		metadata.AddAnnotations(release, map[string]string{
+			metadata.ReleasePlanApplicationAnnotation: a.releasePlan.Spec.Application,
+		})
+		err = a.client.Patch(a.ctx, release, patch)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
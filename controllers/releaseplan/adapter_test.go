@@ -23,6 +23,7 @@ import (
 	toolkit "github.com/konflux-ci/operator-toolkit/loader"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -34,6 +35,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 var _ = Describe("ReleasePlan adapter", Ordered, func() {
@@ -60,6 +63,235 @@ var _ = Describe("ReleasePlan adapter", Ordered, func() {
 		})
 	})
 
+	Context("When EnsureFinalizersAreCalled is called", func() {
+		var adapter *adapter
+		var release *v1alpha1.Release
+
+		AfterEach(func() {
+			_ = k8sClient.Delete(ctx, release)
+			patch := client.MergeFrom(adapter.releasePlan.DeepCopy())
+			controllerutil.RemoveFinalizer(adapter.releasePlan, metadata.ReleasePlanFinalizer)
+			_ = k8sClient.Patch(ctx, adapter.releasePlan, patch)
+			_ = k8sClient.Delete(ctx, adapter.releasePlan)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleasePlanAndAdapter()
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "release-",
+					Namespace:    "default",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					Snapshot:    "snapshot",
+					ReleasePlan: adapter.releasePlan.Name,
+				},
+			}
+			Expect(k8sClient.Create(ctx, release)).To(Succeed())
+		})
+
+		deleteReleasePlan := func() {
+			_, err := adapter.EnsureFinalizerIsAdded()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Delete(ctx, adapter.releasePlan)).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(adapter.releasePlan), adapter.releasePlan)).To(Succeed())
+			Expect(adapter.releasePlan.GetDeletionTimestamp()).NotTo(BeNil())
+		}
+
+		It("should continue if the ReleasePlan is not marked for deletion", func() {
+			result, err := adapter.EnsureFinalizersAreCalled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should block the deletion while the Releases of the ReleasePlan are running", func() {
+			deleteReleasePlan()
+
+			result, err := adapter.EnsureFinalizersAreCalled()
+			Expect(result.RequeueRequest && result.RequeueDelay > 0).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.IsDeletionBlocked()).To(BeTrue())
+			Expect(adapter.releasePlan.GetFinalizers()).To(ContainElement(metadata.ReleasePlanFinalizer))
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release)).To(Succeed())
+		})
+
+		It("should delete the running Releases if the deletion policy is Cascade", func() {
+			adapter.releasePlan.Spec.DeletionPolicy = v1alpha1.DeletionPolicyCascade
+			Expect(k8sClient.Update(ctx, adapter.releasePlan)).To(Succeed())
+			deleteReleasePlan()
+
+			result, err := adapter.EnsureFinalizersAreCalled()
+			Expect(result.RequeueRequest && result.RequeueDelay > 0).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.IsDeletionBlocked()).To(BeFalse())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release))).To(BeTrue())
+
+			result, err = adapter.EnsureFinalizersAreCalled()
+			Expect(result.RequeueRequest && result.RequeueDelay == 0).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.GetFinalizers()).NotTo(ContainElement(metadata.ReleasePlanFinalizer))
+		})
+
+		It("should flag the finished Releases and remove the finalizer", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			Expect(k8sClient.Status().Update(ctx, release)).To(Succeed())
+			deleteReleasePlan()
+
+			result, err := adapter.EnsureFinalizersAreCalled()
+			Expect(result.RequeueRequest && result.RequeueDelay == 0).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.GetFinalizers()).NotTo(ContainElement(metadata.ReleasePlanFinalizer))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release)).To(Succeed())
+			Expect(release.IsPlanDeleted()).To(BeTrue())
+		})
+	})
+
+	Context("When EnsureDeprecatedFieldsAreReported is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = k8sClient.Delete(ctx, adapter.releasePlan)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleasePlanAndAdapter()
+		})
+
+		It("should not add the condition if no deprecated fields are set", func() {
+			result, err := adapter.EnsureDeprecatedFieldsAreReported()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(meta.FindStatusCondition(adapter.releasePlan.Status.Conditions, "DeprecatedFieldsUsed")).To(BeNil())
+		})
+
+		It("should report the deprecated fields set in the ReleasePlan", func() {
+			adapter.releasePlan.Spec.Pipeline.PipelineRef.Bundle = "quay.io/foo/bar:baz"
+
+			result, err := adapter.EnsureDeprecatedFieldsAreReported()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			condition := meta.FindStatusCondition(adapter.releasePlan.Status.Conditions, "DeprecatedFieldsUsed")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("spec.pipeline.pipelineRef.bundle is deprecated"))
+		})
+	})
+
+	Context("When EnsureFinalizerIsAdded is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			patch := client.MergeFrom(adapter.releasePlan.DeepCopy())
+			controllerutil.RemoveFinalizer(adapter.releasePlan, metadata.ReleasePlanFinalizer)
+			_ = k8sClient.Patch(ctx, adapter.releasePlan, patch)
+			_ = k8sClient.Delete(ctx, adapter.releasePlan)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleasePlanAndAdapter()
+		})
+
+		It("should add the finalizer to the ReleasePlan", func() {
+			result, err := adapter.EnsureFinalizerIsAdded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.GetFinalizers()).To(ContainElement(metadata.ReleasePlanFinalizer))
+		})
+	})
+
+	Context("When EnsureTransferIsProcessed is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.releasePlan)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleasePlanAndAdapter()
+		})
+
+		It("should continue if no transfer was requested", func() {
+			result, err := adapter.EnsureTransferIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should mark the transfer as pending if it wasn't accepted", func() {
+			adapter.releasePlan.Annotations = map[string]string{metadata.TransferToAnnotation: "other-application"}
+
+			result, err := adapter.EnsureTransferIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.IsTransferPending("other-application")).To(BeTrue())
+			Expect(adapter.releasePlan.Spec.Application).To(Equal(application.Name))
+		})
+
+		It("should requeue after a minute if the application to transfer to does not exist", func() {
+			adapter.releasePlan.Annotations = map[string]string{
+				metadata.TransferAcceptedAnnotation: "other-application",
+				metadata.TransferToAnnotation:       "other-application",
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ApplicationContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+
+			result, err := adapter.EnsureTransferIsProcessed()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", 30*time.Second, 6*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.Spec.Application).To(Equal(application.Name))
+		})
+
+		It("should transfer the ReleasePlan and annotate its Releases once the transfer is accepted", func() {
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "release-",
+					Namespace:    "default",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					Snapshot:    "snapshot",
+					ReleasePlan: adapter.releasePlan.Name,
+				},
+			}
+			Expect(k8sClient.Create(ctx, release)).To(Succeed())
+			defer func() {
+				_ = k8sClient.Delete(ctx, release)
+			}()
+
+			patch := client.MergeFrom(adapter.releasePlan.DeepCopy())
+			adapter.releasePlan.Annotations = map[string]string{
+				metadata.TransferAcceptedAnnotation: "other-application",
+				metadata.TransferToAnnotation:       "other-application",
+			}
+			Expect(k8sClient.Patch(ctx, adapter.releasePlan, patch)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ApplicationContextKey,
+					Resource:   application,
+				},
+			})
+
+			result, err := adapter.EnsureTransferIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlan.Spec.Application).To(Equal("other-application"))
+			Expect(adapter.releasePlan.GetAnnotations()).NotTo(HaveKey(metadata.TransferToAnnotation))
+			Expect(adapter.releasePlan.Status.Transfers).To(HaveLen(1))
+			Expect(adapter.releasePlan.Status.Transfers[0].From).To(Equal(application.Name))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release)).To(Succeed())
+			Expect(release.GetAnnotations()).To(HaveKeyWithValue(metadata.ReleasePlanApplicationAnnotation,
+				application.Name))
+		})
+	})
+
 	Context("When EnsureOwnerReferenceIsSet is called", func() {
 		var adapter *adapter
 
@@ -81,7 +313,7 @@ var _ = Describe("ReleasePlan adapter", Ordered, func() {
 
 			result, err := adapter.EnsureOwnerReferenceIsSet()
 			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(result.RequeueDelay).To(Equal(time.Minute))
+			Expect(result.RequeueDelay).To(BeNumerically("~", 30*time.Second, 6*time.Second))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(adapter.releasePlan.OwnerReferences).To(HaveLen(0))
 		})
@@ -24,9 +24,12 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backoff"
+	"github.com/konflux-ci/release-service/cache"
 	"github.com/konflux-ci/release-service/controllers/utils/handlers"
 	"github.com/konflux-ci/release-service/controllers/utils/predicates"
 	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -36,11 +39,14 @@ import (
 
 // Controller reconciles a ReleasePlan object
 type Controller struct {
-	client client.Client
-	log    logr.Logger
+	backoff *backoff.Backoff
+	client  client.Client
+	log     logr.Logger
 }
 
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplanadmissions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch;patch;delete
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans/finalizers,verbs=update
@@ -54,6 +60,7 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	err := c.client.Get(ctx, req.NamespacedName, releasePlan)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			c.backoff.Forget(req.String())
 			return ctrl.Result{}, nil
 		}
 
@@ -61,28 +68,41 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	adapter := newAdapter(ctx, c.client, releasePlan, loader.NewLoader(), &logger)
+	adapter.backoff = c.backoff
 
 	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureFinalizersAreCalled,
+		adapter.EnsureFinalizerIsAdded,
+		adapter.EnsureTransferIsProcessed,
 		adapter.EnsureMatchingInformationIsSet,
+		adapter.EnsureDeprecatedFieldsAreReported,
 		adapter.EnsureOwnerReferenceIsSet,
 	})
 }
 
-// Register registers the controller with the passed manager and log.
+// Register registers the controller with the passed manager and log. Changes in the transfer annotations of
+// ReleasePlans are also watched, so transfers are processed as soon as they are requested or accepted, as well as
+// deletion requests, so the Releases of deleted ReleasePlans are handled according to their deletion policy, and
+// changes in the deprecated fields they set.
 func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.backoff = backoff.New()
 	c.client = mgr.GetClient()
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.ReleasePlan{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}, predicates.MatchPredicate())).
+		For(&v1alpha1.ReleasePlan{}, builder.WithPredicates(predicate.Or(
+			predicate.And(predicate.GenerationChangedPredicate{}, predicates.MatchPredicate()),
+			predicates.DeprecatedFieldsChangedPredicate(),
+			predicates.ReleasePlanTransferPredicate(),
+			predicates.DeletionRequestedPredicate()))).
 		Watches(&v1alpha1.ReleasePlanAdmission{}, &handlers.EnqueueRequestForMatchedResource{},
 			builder.WithPredicates(predicates.MatchPredicate())).
-		Complete(c)
+		Complete(metrics.NewInstrumentedReconciler("releaseplan", c))
 }
 
 // SetupCache indexes fields for each of the resources used in the releaseplan adapter in those cases where filtering by
 // field is required.
-// NOTE: Both the release and releaseplan controller need this ReleasePlanAdmission cache. However, it only needs to be added
-// once to the manager, so only one controller should add it. If it is removed from the Release controller, it should be added here.
+// NOTE: Both the release and releaseplan controller need this ReleasePlanAdmission cache. Conflicts are ignored
+// when adding it, so both controllers can add it and be enabled independently.
 func (c *Controller) SetupCache(mgr ctrl.Manager) error {
-	return nil
+	return cache.SetupReleasePlanAdmissionCache(mgr)
 }
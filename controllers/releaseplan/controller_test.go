@@ -18,6 +18,8 @@ package releaseplan
 
 import (
 	"reflect"
+
+	"github.com/konflux-ci/release-service/cache"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -53,6 +55,41 @@ var _ = Describe("ReleasePlan Controller", Ordered, func() {
 		})
 	})
 
+	When("SetupCache is called", func() {
+		It("should setup the cache successfully", func() {
+			controller := &Controller{
+				client: k8sClient,
+				log:    ctrl.Log,
+			}
+
+			manager, _ := ctrl.NewManager(cfg, ctrl.Options{
+				Scheme: scheme.Scheme,
+				Metrics: server.Options{
+					BindAddress: "0", // disables metrics
+				},
+				LeaderElection: false,
+			})
+			Expect(controller.SetupCache(manager)).To(Succeed())
+		})
+
+		It("should not fail if the cache was already setup by another controller", func() {
+			controller := &Controller{
+				client: k8sClient,
+				log:    ctrl.Log,
+			}
+
+			manager, _ := ctrl.NewManager(cfg, ctrl.Options{
+				Scheme: scheme.Scheme,
+				Metrics: server.Options{
+					BindAddress: "0", // disables metrics
+				},
+				LeaderElection: false,
+			})
+			Expect(cache.SetupReleasePlanAdmissionCache(manager)).To(Succeed())
+			Expect(controller.SetupCache(manager)).To(Succeed())
+		})
+	})
+
 	When("Register is called", func() {
 		It("should setup the controller successfully", func() {
 			controller := &Controller{
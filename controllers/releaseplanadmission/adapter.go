@@ -18,22 +18,40 @@ package releaseplanadmission
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/operator-toolkit/controller"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backoff"
 	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/tekton"
+	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+// originAccessSuffix is the suffix of the name of the Role and RoleBinding granting the origin namespace of a
+// ReleasePlanAdmission access to its namespace
+const originAccessSuffix = "-origin-access"
+
 // adapter holds the objects needed to reconcile a ReleasePlanAdmission.
 type adapter struct {
+	backoff              *backoff.Backoff
 	client               client.Client
 	ctx                  context.Context
 	loader               loader.ObjectLoader
 	logger               *logr.Logger
+	pipelineChecker      tekton.PipelineChecker
 	releasePlanAdmission *v1alpha1.ReleasePlanAdmission
 }
 
@@ -48,6 +66,29 @@ func newAdapter(ctx context.Context, client client.Client, releasePlanAdmission
 	}
 }
 
+// EnsureDeprecatedFieldsAreReported is an operation that will ensure that the DeprecatedFieldsUsed condition of the
+// ReleasePlanAdmission reports the deprecated fields it sets, so they are migrated before the v1beta1 cutover. The reported fields
+// are also registered in the metrics.
+func (a *adapter) EnsureDeprecatedFieldsAreReported() (controller.OperationResult, error) {
+	fields := a.releasePlanAdmission.GetDeprecatedFields()
+	if a.releasePlanAdmission.HasDeprecatedFieldsReported(fields) {
+		return controller.ContinueProcessing()
+	}
+
+	patch := client.MergeFrom(a.releasePlanAdmission.DeepCopy())
+	a.releasePlanAdmission.MarkDeprecatedFieldsUsed(fields)
+	err := a.client.Status().Patch(a.ctx, a.releasePlanAdmission, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	for _, field := range fields {
+		metrics.RegisterDeprecatedFieldUsed("ReleasePlanAdmission", field.Field)
+	}
+
+	return controller.ContinueProcessing()
+}
+
 // EnsureMatchingInformationIsSet is an operation that will ensure that the ReleasePlanAdmission has updated matching
 // information in its status.
 func (a *adapter) EnsureMatchingInformationIsSet() (controller.OperationResult, error) {
@@ -74,3 +115,174 @@ func (a *adapter) EnsureMatchingInformationIsSet() (controller.OperationResult,
 
 	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.releasePlanAdmission, patch))
 }
+
+// EnsurePipelinesAreResolved is an operation that will ensure that the managed Pipelines referenced by the
+// ReleasePlanAdmission, including its catalog Pipeline, are checked every time it changes, reporting whether they can be
+// resolved in its status. Pipelines that can't be resolved are checked again following the PipelineResolution backoff
+// policy. If the adapter can't check Pipelines, no action will be taken.
+func (a *adapter) EnsurePipelinesAreResolved() (controller.OperationResult, error) {
+	if a.pipelineChecker == nil ||
+		(a.releasePlanAdmission.HasPipelineResolutionFinished() && a.releasePlanAdmission.IsPipelineResolved()) {
+		return controller.ContinueProcessing()
+	}
+
+	var failures []string
+	if a.releasePlanAdmission.Spec.CatalogPipeline != nil {
+		err := a.checkCatalogPipeline()
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("catalog pipeline: %s", err))
+		}
+	}
+	if a.releasePlanAdmission.Spec.Pipeline != nil {
+		err := a.pipelineChecker.Check(a.ctx, a.releasePlanAdmission.Spec.Pipeline)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("pipeline: %s", err))
+		}
+	}
+	for _, strategy := range a.releasePlanAdmission.Spec.Strategies {
+		if strategy.Pipeline == nil {
+			continue
+		}
+
+		err := a.pipelineChecker.Check(a.ctx, strategy.Pipeline)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("strategy %s: %s", strategy.Name, err))
+		}
+	}
+
+	patch := client.MergeFrom(a.releasePlanAdmission.DeepCopy())
+	if len(failures) == 0 {
+		a.backoff.Reset(backoff.PipelineResolutionCategory, client.ObjectKeyFromObject(a.releasePlanAdmission).String())
+		a.releasePlanAdmission.MarkPipelineResolved()
+		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.releasePlanAdmission, patch))
+	}
+
+	a.logger.Info("Failed to resolve the managed Pipelines", "Failures", failures)
+	a.releasePlanAdmission.MarkPipelineResolutionFailed(strings.Join(failures, "; "))
+	err := a.client.Status().Patch(a.ctx, a.releasePlanAdmission, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	policy := backoff.LoadPolicy(a.ctx, a.client, a.loader, backoff.PipelineResolutionCategory)
+	return controller.RequeueAfter(a.backoff.Next(backoff.PipelineResolutionCategory,
+		client.ObjectKeyFromObject(a.releasePlanAdmission).String(), policy), nil)
+}
+
+// EnsureOriginAccessIsProvisioned is an operation that will ensure that the ServiceAccounts the tenant Pipelines of the
+// matching ReleasePlans run as in the origin namespace of the ReleasePlanAdmission are granted the minimal permissions
+// needed in the managed namespace to read back the status of the managed PipelineRuns and to propagate Secrets. No
+// other workload of the origin namespace is granted access. The Role and RoleBinding are owned by the
+// ReleasePlanAdmission, so they are removed when it is deleted.
+func (a *adapter) EnsureOriginAccessIsProvisioned() (controller.OperationResult, error) {
+	releasePlans, err := a.loader.GetMatchingReleasePlans(a.ctx, a.client, a.releasePlanAdmission)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	role := &rbac.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.releasePlanAdmission.Name + originAccessSuffix,
+			Namespace: a.releasePlanAdmission.Namespace,
+		},
+	}
+	err = a.createOrUpdate(role, func() error {
+		metadata.AddLabels(role, map[string]string{metadata.ManagedByLabel: metadata.ManagedByLabelValue})
+		role.Rules = []rbac.PolicyRule{
+			{
+				APIGroups: []string{"tekton.dev"},
+				Resources: []string{"pipelineruns", "taskruns"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"secrets"},
+				Verbs:     []string{"create"},
+			},
+		}
+
+		return ctrl.SetControllerReference(a.releasePlanAdmission, role, a.client.Scheme())
+	})
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	roleBinding := &rbac.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.releasePlanAdmission.Name + originAccessSuffix,
+			Namespace: a.releasePlanAdmission.Namespace,
+		},
+	}
+	err = a.createOrUpdate(roleBinding, func() error {
+		metadata.AddLabels(roleBinding, map[string]string{metadata.ManagedByLabel: metadata.ManagedByLabelValue})
+		// The RoleRef of a RoleBinding is immutable, but it never changes as the Role name is fixed
+		roleBinding.RoleRef = rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = getOriginAccessSubjects(a.releasePlanAdmission.Spec.Origin, releasePlans)
+
+		return ctrl.SetControllerReference(a.releasePlanAdmission, roleBinding, a.client.Scheme())
+	})
+
+	return controller.RequeueOnErrorOrContinue(err)
+}
+
+// checkCatalogPipeline checks whether the managed Pipeline the ReleasePlanAdmission references in a ReleasePipelineCatalog
+// can be resolved. An error is returned if the ReleasePipelineCatalog can't be loaded, if it doesn't contain the
+// Pipeline or if the Pipeline can't be resolved.
+func (a *adapter) checkCatalogPipeline() error {
+	releasePipelineCatalog, err := a.loader.GetReleasePipelineCatalog(a.ctx, a.client,
+		a.releasePlanAdmission.Spec.CatalogPipeline.Catalog, a.releasePlanAdmission.Namespace)
+	if err != nil {
+		return err
+	}
+
+	pipeline, err := a.releasePlanAdmission.DeepCopy().ResolveCatalogPipeline(releasePipelineCatalog)
+	if err != nil {
+		return err
+	}
+
+	return a.pipelineChecker.Check(a.ctx, &pipeline.Pipeline)
+}
+
+// createOrUpdate creates the given object or updates it with the given mutate function. Only the Roles and RoleBindings
+// labelled as managed by the Release Service are cached, so the ones created before they were labelled can't be found
+// and are overwritten instead.
+func (a *adapter) createOrUpdate(object client.Object, mutate controllerutil.MutateFn) error {
+	_, err := controllerutil.CreateOrUpdate(a.ctx, a.client, object, mutate)
+	if errors.IsAlreadyExists(err) {
+		return a.client.Update(a.ctx, object)
+	}
+
+	return err
+}
+
+// getOriginAccessSubjects returns the ServiceAccounts the tenant Pipelines of the given ReleasePlans run as in the given
+// origin namespace, sorted by name and without duplicates. ReleasePlans without a tenant Pipeline or without a
+// ServiceAccount set in it are skipped.
+func getOriginAccessSubjects(origin string, releasePlans *v1alpha1.ReleasePlanList) []rbac.Subject {
+	serviceAccounts := []string{}
+	for _, releasePlan := range releasePlans.Items {
+		if releasePlan.Spec.Pipeline == nil || releasePlan.Spec.Pipeline.ServiceAccountName == "" {
+			continue
+		}
+
+		if !slices.Contains(serviceAccounts, releasePlan.Spec.Pipeline.ServiceAccountName) {
+			serviceAccounts = append(serviceAccounts, releasePlan.Spec.Pipeline.ServiceAccountName)
+		}
+	}
+	slices.Sort(serviceAccounts)
+
+	subjects := []rbac.Subject{}
+	for _, serviceAccount := range serviceAccounts {
+		subjects = append(subjects, rbac.Subject{
+			Kind:      rbac.ServiceAccountKind,
+			Name:      serviceAccount,
+			Namespace: origin,
+		})
+	}
+
+	return subjects
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package releaseplanadmission
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
@@ -29,11 +30,22 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	rbac "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+// mockPipelineChecker returns the given error for every Pipeline.
+type mockPipelineChecker struct {
+	err error
+}
+
+func (c *mockPipelineChecker) Check(_ context.Context, _ *tektonutils.Pipeline) error {
+	return c.err
+}
+
 var _ = Describe("ReleasePlanAdmission adapter", Ordered, func() {
 	var (
 		createReleasePlanAdmissionAndAdapter func() *adapter
@@ -57,6 +69,38 @@ var _ = Describe("ReleasePlanAdmission adapter", Ordered, func() {
 		})
 	})
 
+	Context("When EnsureDeprecatedFieldsAreReported is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.releasePlanAdmission)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleasePlanAdmissionAndAdapter()
+		})
+
+		It("should not add the condition if no deprecated fields are set", func() {
+			result, err := adapter.EnsureDeprecatedFieldsAreReported()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(meta.FindStatusCondition(adapter.releasePlanAdmission.Status.Conditions, "DeprecatedFieldsUsed")).To(BeNil())
+		})
+
+		It("should report the deprecated fields set in the ReleasePlanAdmission", func() {
+			adapter.releasePlanAdmission.Spec.Environment = "environment"
+
+			result, err := adapter.EnsureDeprecatedFieldsAreReported()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			condition := meta.FindStatusCondition(adapter.releasePlanAdmission.Status.Conditions, "DeprecatedFieldsUsed")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("spec.environment is deprecated"))
+		})
+	})
+
 	Context("When EnsureMatchingInformationIsSet is called", func() {
 		var adapter *adapter
 
@@ -201,6 +245,188 @@ var _ = Describe("ReleasePlanAdmission adapter", Ordered, func() {
 		})
 	})
 
+	Context("When EnsurePipelinesAreResolved is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.releasePlanAdmission)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleasePlanAdmissionAndAdapter()
+		})
+
+		It("should do nothing if the adapter can't check Pipelines", func() {
+			result, err := adapter.EnsurePipelinesAreResolved()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlanAdmission.HasPipelineResolutionFinished()).To(BeFalse())
+		})
+
+		It("should do nothing if the Pipelines of the current generation were resolved", func() {
+			adapter.pipelineChecker = &mockPipelineChecker{err: fmt.Errorf("not found")}
+			adapter.releasePlanAdmission.MarkPipelineResolved()
+
+			result, err := adapter.EnsurePipelinesAreResolved()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlanAdmission.IsPipelineResolved()).To(BeTrue())
+		})
+
+		It("should mark the Pipelines as resolved if all of them can be resolved", func() {
+			adapter.pipelineChecker = &mockPipelineChecker{}
+
+			result, err := adapter.EnsurePipelinesAreResolved()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlanAdmission.HasPipelineResolutionFinished()).To(BeTrue())
+			Expect(adapter.releasePlanAdmission.IsPipelineResolved()).To(BeTrue())
+		})
+
+		It("should mark the Pipelines as not resolved and requeue if one of them can't be resolved", func() {
+			adapter.pipelineChecker = &mockPipelineChecker{err: fmt.Errorf("not found")}
+			adapter.releasePlanAdmission.Spec.Strategies = []v1alpha1.ReleaseStrategy{
+				{Name: "ga", Pipeline: adapter.releasePlanAdmission.Spec.Pipeline},
+			}
+
+			result, err := adapter.EnsurePipelinesAreResolved()
+			Expect(result.RequeueDelay).To(BeNumerically("~", time.Minute, 12*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlanAdmission.HasPipelineResolutionFinished()).To(BeTrue())
+			Expect(adapter.releasePlanAdmission.IsPipelineResolved()).To(BeFalse())
+
+			condition := meta.FindStatusCondition(adapter.releasePlanAdmission.Status.Conditions, "PipelineResolved")
+			Expect(condition.Message).To(Equal("pipeline: not found; strategy ga: not found"))
+		})
+
+		It("should mark the Pipelines as not resolved if the catalog Pipeline is not in the catalog", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineCatalogContextKey,
+					Resource: &v1alpha1.ReleasePipelineCatalog{
+						ObjectMeta: metav1.ObjectMeta{Name: "catalog", Namespace: "default"},
+					},
+				},
+			})
+			adapter.pipelineChecker = &mockPipelineChecker{}
+			adapter.releasePlanAdmission.Spec.Pipeline = nil
+			adapter.releasePlanAdmission.Spec.CatalogPipeline = &v1alpha1.CatalogPipelineReference{
+				Catalog: "catalog",
+				Name:    "push",
+			}
+
+			result, err := adapter.EnsurePipelinesAreResolved()
+			Expect(result.RequeueDelay).To(BeNumerically(">", 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.releasePlanAdmission.IsPipelineResolved()).To(BeFalse())
+
+			condition := meta.FindStatusCondition(adapter.releasePlanAdmission.Status.Conditions, "PipelineResolved")
+			Expect(condition.Message).To(Equal("catalog pipeline: pipeline push is not in the ReleasePipelineCatalog catalog"))
+		})
+	})
+
+	Context("When EnsureOriginAccessIsProvisioned is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.releasePlanAdmission)
+			_ = adapter.client.Delete(ctx, &rbac.Role{ObjectMeta: metav1.ObjectMeta{
+				Name: adapter.releasePlanAdmission.Name + originAccessSuffix, Namespace: "default"}})
+			_ = adapter.client.Delete(ctx, &rbac.RoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name: adapter.releasePlanAdmission.Name + originAccessSuffix, Namespace: "default"}})
+		})
+
+		BeforeEach(func() {
+			adapter = createReleasePlanAdmissionAndAdapter()
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.MatchedReleasePlansContextKey,
+					Resource: &v1alpha1.ReleasePlanList{
+						Items: []v1alpha1.ReleasePlan{
+							{Spec: v1alpha1.ReleasePlanSpec{Pipeline: &tektonutils.ParameterizedPipeline{
+								Pipeline: tektonutils.Pipeline{ServiceAccountName: "tenant-pipeline"},
+							}}},
+							{Spec: v1alpha1.ReleasePlanSpec{Pipeline: &tektonutils.ParameterizedPipeline{
+								Pipeline: tektonutils.Pipeline{ServiceAccountName: "tenant-pipeline"},
+							}}},
+							{Spec: v1alpha1.ReleasePlanSpec{}},
+						},
+					},
+				},
+			})
+		})
+
+		It("should create a Role and a RoleBinding granting access to the tenant Pipeline ServiceAccount", func() {
+			result, err := adapter.EnsureOriginAccessIsProvisioned()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			role := &rbac.Role{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name: adapter.releasePlanAdmission.Name + originAccessSuffix, Namespace: "default"}, role)).To(Succeed())
+			Expect(role.Rules).To(HaveLen(2))
+			Expect(role.Labels).To(HaveKeyWithValue(metadata.ManagedByLabel, metadata.ManagedByLabelValue))
+			Expect(metav1.IsControlledBy(role, adapter.releasePlanAdmission)).To(BeTrue())
+
+			roleBinding := &rbac.RoleBinding{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name: adapter.releasePlanAdmission.Name + originAccessSuffix, Namespace: "default"}, roleBinding)).To(Succeed())
+			Expect(roleBinding.RoleRef.Name).To(Equal(role.Name))
+			Expect(roleBinding.Subjects).To(Equal([]rbac.Subject{
+				{Kind: rbac.ServiceAccountKind, Name: "tenant-pipeline", Namespace: "default"},
+			}))
+			Expect(roleBinding.Labels).To(HaveKeyWithValue(metadata.ManagedByLabel, metadata.ManagedByLabelValue))
+			Expect(metav1.IsControlledBy(roleBinding, adapter.releasePlanAdmission)).To(BeTrue())
+		})
+
+		It("should not bind any ServiceAccount if no matching ReleasePlan has a tenant Pipeline ServiceAccount", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.MatchedReleasePlansContextKey,
+					Resource:   &v1alpha1.ReleasePlanList{},
+				},
+			})
+
+			result, err := adapter.EnsureOriginAccessIsProvisioned()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			roleBinding := &rbac.RoleBinding{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name: adapter.releasePlanAdmission.Name + originAccessSuffix, Namespace: "default"}, roleBinding)).To(Succeed())
+			Expect(roleBinding.Subjects).To(BeEmpty())
+		})
+
+		It("should requeue with error if the matching ReleasePlans can't be loaded", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.MatchedReleasePlansContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureOriginAccessIsProvisioned()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should update the RoleBinding when the origin changes", func() {
+			_, err := adapter.EnsureOriginAccessIsProvisioned()
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.releasePlanAdmission.Spec.Origin = "other"
+			result, err := adapter.EnsureOriginAccessIsProvisioned()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			roleBinding := &rbac.RoleBinding{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name: adapter.releasePlanAdmission.Name + originAccessSuffix, Namespace: "default"}, roleBinding)).To(Succeed())
+			Expect(roleBinding.Subjects).To(HaveLen(1))
+			Expect(roleBinding.Subjects[0].Namespace).To(Equal("other"))
+		})
+	})
+
 	createReleasePlanAdmissionAndAdapter = func() *adapter {
 		releasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
 			ObjectMeta: metav1.ObjectMeta{
@@ -18,31 +18,48 @@ package releaseplanadmission
 
 import (
 	"context"
+	"net/http"
+	"os"
+	"time"
 
 	"github.com/konflux-ci/operator-toolkit/controller"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/backoff"
 	"github.com/konflux-ci/release-service/cache"
 	"github.com/konflux-ci/release-service/controllers/utils/handlers"
 	"github.com/konflux-ci/release-service/controllers/utils/predicates"
 	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/tekton"
+	rbac "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// pipelineCheckerRequestTimeout is the timeout of the requests sent to check whether the managed Pipelines can be
+// resolved
+const pipelineCheckerRequestTimeout = 30 * time.Second
+
 // Controller reconciles a ReleasePlanAdmission object
 type Controller struct {
-	client client.Client
-	log    logr.Logger
+	backoff         *backoff.Backoff
+	client          client.Client
+	log             logr.Logger
+	pipelineChecker tekton.PipelineChecker
 }
 
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplans,verbs=get;list;watch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplansadmissions,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseplanadmissions/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releasepipelinecatalogs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -53,6 +70,7 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	err := c.client.Get(ctx, req.NamespacedName, releasePlanAdmission)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			c.backoff.Forget(req.String())
 			return ctrl.Result{}, nil
 		}
 
@@ -60,21 +78,38 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	adapter := newAdapter(ctx, c.client, releasePlanAdmission, loader.NewLoader(), &logger)
+	adapter.backoff = c.backoff
+	adapter.pipelineChecker = c.pipelineChecker
 
 	return controller.ReconcileHandler([]controller.Operation{
 		adapter.EnsureMatchingInformationIsSet,
+		adapter.EnsureDeprecatedFieldsAreReported,
+		adapter.EnsurePipelinesAreResolved,
+		adapter.EnsureOriginAccessIsProvisioned,
 	})
 }
 
-// Register registers the controller with the passed manager and log.
+// Register registers the controller with the passed manager and log. If the PIPELINE_RESOLUTION_CHECKS environment
+// variable is set to true, the managed Pipelines referenced by each ReleasePlanAdmission are checked every time its
+// spec changes and the outcome is reported in its status. The Role and RoleBinding granting the origin namespace access
+// are watched, so they are restored if they are modified or deleted, and so are the matched ReleasePlans, so the
+// access follows the ServiceAccounts of their tenant Pipelines.
 func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.backoff = backoff.New()
 	c.client = mgr.GetClient()
 
+	if os.Getenv("PIPELINE_RESOLUTION_CHECKS") == "true" {
+		c.pipelineChecker = tekton.NewPipelineChecker(&http.Client{Timeout: pipelineCheckerRequestTimeout})
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.ReleasePlanAdmission{}, builder.WithPredicates(predicates.MatchPredicate())).
+		For(&v1alpha1.ReleasePlanAdmission{}, builder.WithPredicates(
+			predicate.Or(predicates.MatchPredicate(), predicate.GenerationChangedPredicate{}))).
 		Watches(&v1alpha1.ReleasePlan{}, &handlers.EnqueueRequestForMatchedResource{},
-			builder.WithPredicates(predicates.MatchPredicate())).
-		Complete(c)
+			builder.WithPredicates(predicate.Or(predicates.MatchPredicate(), predicate.GenerationChangedPredicate{}))).
+		Owns(&rbac.Role{}).
+		Owns(&rbac.RoleBinding{}).
+		Complete(metrics.NewInstrumentedReconciler("releaseplanadmission", c))
 }
 
 // SetupCache indexes fields for each of the resources used in the releaseplanadmission adapter in those cases where filtering by
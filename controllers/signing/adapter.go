@@ -0,0 +1,82 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/signing"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// adapter holds the objects needed to reconcile a Release to sign it.
+type adapter struct {
+	client  client.Client
+	ctx     context.Context
+	logger  *logr.Logger
+	release *v1alpha1.Release
+	signer  signing.Signer
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, release *v1alpha1.Release, signer signing.Signer, logger *logr.Logger) *adapter {
+	return &adapter{
+		client:  client,
+		ctx:     ctx,
+		logger:  logger,
+		release: release,
+		signer:  signer,
+	}
+}
+
+// EnsureReleaseIsSigned is an operation that will ensure that the payload describing a completed Release is signed.
+// Both the payload and its signature are stored as annotations in the Release, so the record can be verified later on.
+func (a *adapter) EnsureReleaseIsSigned() (controller.OperationResult, error) {
+	if !a.release.HasReleaseFinished() || a.release.GetAnnotations()[metadata.SignatureAnnotation] != "" {
+		return controller.ContinueProcessing()
+	}
+
+	payload, err := signing.NewReleasePayload(a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	signature, err := a.signer.Sign(a.ctx, payload)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	metadata.AddAnnotations(a.release, map[string]string{
+		metadata.SignatureAnnotation:     base64.StdEncoding.EncodeToString(signature),
+		metadata.SignedPayloadAnnotation: string(payload),
+	})
+	err = a.client.Patch(a.ctx, a.release, patch)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	a.logger.Info("Signed Release")
+
+	return controller.ContinueProcessing()
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/signing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Signing adapter", Ordered, func() {
+	var (
+		createReleaseAndAdapter func() *adapter
+		key                     *ecdsa.PrivateKey
+		signer                  signing.Signer
+	)
+
+	BeforeAll(func() {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		signer, err = signing.NewKeySigner(key)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, nil, &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+		})
+	})
+
+	When("EnsureReleaseIsSigned is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should not sign a Release that didn't finish", func() {
+			result, err := adapter.EnsureReleaseIsSigned()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.SignatureAnnotation]).To(BeEmpty())
+		})
+
+		It("should not sign a Release that was already signed", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			metadata.AddAnnotations(adapter.release, map[string]string{metadata.SignatureAnnotation: "signature"})
+
+			result, err := adapter.EnsureReleaseIsSigned()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.SignatureAnnotation]).To(Equal("signature"))
+			Expect(adapter.release.GetAnnotations()[metadata.SignedPayloadAnnotation]).To(BeEmpty())
+		})
+
+		It("should sign a completed Release and annotate it", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+
+			result, err := adapter.EnsureReleaseIsSigned()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			payload, err := signing.NewReleasePayload(adapter.release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.SignedPayloadAnnotation]).To(Equal(string(payload)))
+
+			signature, err := base64.StdEncoding.DecodeString(adapter.release.GetAnnotations()[metadata.SignatureAnnotation])
+			Expect(err).NotTo(HaveOccurred())
+			digest := sha256.Sum256(payload)
+			Expect(ecdsa.VerifyASN1(&key.PublicKey, digest[:], signature)).To(BeTrue())
+		})
+
+		It("should requeue if the payload can't be signed", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			adapter.signer = &mockSigner{err: fmt.Errorf("sign failed")}
+
+			result, err := adapter.EnsureReleaseIsSigned()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.GetAnnotations()[metadata.SignatureAnnotation]).To(BeEmpty())
+		})
+	})
+
+	createReleaseAndAdapter = func() *adapter {
+		release := &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "release-",
+				Namespace:    "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: "releaseplan",
+				Snapshot:    "snapshot",
+			},
+		}
+		Expect(k8sClient.Create(ctx, release)).To(Succeed())
+
+		return newAdapter(ctx, k8sClient, release, signer, &ctrl.Log)
+	}
+})
+
+// mockSigner is a signing.Signer failing with the given error.
+type mockSigner struct {
+	err error
+}
+
+func (s *mockSigner) Sign(_ context.Context, _ []byte) ([]byte, error) {
+	return nil, s.err
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/signing"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Controller reconciles completed Releases to sign the payload describing them
+type Controller struct {
+	client client.Client
+	log    logr.Logger
+	signer signing.Signer
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("Release", req.NamespacedName)
+
+	release := &v1alpha1.Release{}
+	err := c.client.Get(ctx, req.NamespacedName, release)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	adapter := newAdapter(ctx, c.client, release, c.signer, &logger)
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureReleaseIsSigned,
+	})
+}
+
+// Register registers the controller with the passed manager and log. The signer is created from the key reference set
+// in the RELEASE_SIGNING_KEY environment variable, which can be either the path of a PEM private key file or a
+// hashivault:// KMS reference. This controller only reacts to completed Releases that were not signed yet.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	keyRef := os.Getenv("RELEASE_SIGNING_KEY")
+	if keyRef == "" {
+		return fmt.Errorf("the RELEASE_SIGNING_KEY environment variable is required by the signing controller")
+	}
+
+	signer, err := signing.NewSigner(keyRef, http.DefaultClient)
+	if err != nil {
+		return err
+	}
+
+	c.client = mgr.GetClient()
+	c.log = log.WithName("signing")
+	c.signer = signer
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("signing").
+		For(&v1alpha1.Release{}, builder.WithPredicates(predicate.NewPredicateFuncs(isPendingSignature))).
+		Complete(metrics.NewInstrumentedReconciler("signing", c))
+}
+
+// isPendingSignature returns whether the given object is a completed Release that was not signed yet.
+func isPendingSignature(object client.Object) bool {
+	release, ok := object.(*v1alpha1.Release)
+	if !ok {
+		return false
+	}
+
+	return release.HasReleaseFinished() && release.GetAnnotations()[metadata.SignatureAnnotation] == ""
+}
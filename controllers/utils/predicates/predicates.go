@@ -21,6 +21,7 @@ import (
 
 	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/metadata"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +52,44 @@ func MatchPredicate() predicate.Predicate {
 	}
 }
 
+// SnapshotTestStatusChangedPredicate returns a predicate which returns true only when the condition reporting the
+// result of the integration tests of a Snapshot changes.
+func SnapshotTestStatusChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasSnapshotTestConditionChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
+// SnapshotEnvironmentBindingDeploymentStatusChangedPredicate returns a predicate which returns true only when the
+// conditions reporting the deployment status of a SnapshotEnvironmentBinding change.
+func SnapshotEnvironmentBindingDeploymentStatusChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasDeploymentStatusChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
 // hasConditionChanged returns true if one, but not both, of the conditions
 // are nil or if both are not nil and have different lastTransitionTimes.
 func hasConditionChanged(conditionOld, conditionNew *metav1.Condition) bool {
@@ -88,6 +127,19 @@ func haveApplicationsChanged(objectOld, objectNew client.Object) bool {
 	return false
 }
 
+// hasDeploymentStatusChanged returns true if the passed objects are SnapshotEnvironmentBindings and their binding or
+// component deployment conditions are different between them.
+func hasDeploymentStatusChanged(objectOld, objectNew client.Object) bool {
+	if bindingOld, ok := objectOld.(*applicationapiv1alpha1.SnapshotEnvironmentBinding); ok {
+		if bindingNew, ok := objectNew.(*applicationapiv1alpha1.SnapshotEnvironmentBinding); ok {
+			return !reflect.DeepEqual(bindingOld.Status.BindingConditions, bindingNew.Status.BindingConditions) ||
+				!reflect.DeepEqual(bindingOld.Status.ComponentDeploymentConditions, bindingNew.Status.ComponentDeploymentConditions)
+		}
+	}
+
+	return false
+}
+
 // hasMatchConditionChanged returns true if the lastTransitionTime of the Matched condition
 // is different between the two objects or if one (but not both) of the objects is missing
 // the Matched condition.
@@ -112,6 +164,119 @@ func hasMatchConditionChanged(objectOld, objectNew client.Object) bool {
 	return false
 }
 
+// hasSnapshotTestConditionChanged returns true if the passed objects are Snapshots and the status or the
+// lastTransitionTime of the condition reporting the result of their integration tests is different between them.
+func hasSnapshotTestConditionChanged(objectOld, objectNew client.Object) bool {
+	if snapshotOld, ok := objectOld.(*applicationapiv1alpha1.Snapshot); ok {
+		if snapshotNew, ok := objectNew.(*applicationapiv1alpha1.Snapshot); ok {
+			oldCondition := meta.FindStatusCondition(snapshotOld.Status.Conditions,
+				v1alpha1.SnapshotTestSucceededConditionType)
+			newCondition := meta.FindStatusCondition(snapshotNew.Status.Conditions,
+				v1alpha1.SnapshotTestSucceededConditionType)
+			return hasConditionChanged(oldCondition, newCondition) ||
+				(oldCondition != nil && newCondition != nil && oldCondition.Status != newCondition.Status)
+		}
+	}
+
+	return false
+}
+
+// isReleaseReadyForManagedProcessing returns true if the passed object is a Release that is valid and has finished
+// its tenant pipeline processing.
+func isReleaseReadyForManagedProcessing(object client.Object) bool {
+	if release, ok := object.(*v1alpha1.Release); ok {
+		return release.IsValid() && release.HasTenantPipelineProcessingFinished()
+	}
+
+	return false
+}
+
+// isReleaseFailed returns true if the passed object is a Release that failed.
+func isReleaseFailed(object client.Object) bool {
+	if release, ok := object.(*v1alpha1.Release); ok {
+		return release.IsFailed()
+	}
+
+	return false
+}
+
+// isReleaseReleased returns true if the passed object is a Release that was successfully released.
+func isReleaseReleased(object client.Object) bool {
+	if release, ok := object.(*v1alpha1.Release); ok {
+		return release.IsReleased()
+	}
+
+	return false
+}
+
+// hasApproverChanged returns true if the approved-by annotation differs between the given objects. The annotation is
+// set by the author webhook every time a Release is approved.
+func hasApproverChanged(objectOld, objectNew client.Object) bool {
+	return objectOld.GetAnnotations()[metadata.ApprovedByAnnotation] != objectNew.GetAnnotations()[metadata.ApprovedByAnnotation]
+}
+
+// hasEmergencyBypassChanged returns true if the emergency bypass expiration annotation differs between the given objects.
+// The annotation is set by the author webhook every time an emergency bypass is authorized.
+func hasEmergencyBypassChanged(objectOld, objectNew client.Object) bool {
+	return objectOld.GetAnnotations()[metadata.EmergencyBypassExpirationAnnotation] !=
+		objectNew.GetAnnotations()[metadata.EmergencyBypassExpirationAnnotation]
+}
+
+// hasReleasePhaseChanged returns true if the objects are Releases and their phase differs between the two.
+func hasReleasePhaseChanged(objectOld, objectNew client.Object) bool {
+	if releaseOld, ok := objectOld.(*v1alpha1.Release); ok {
+		if releaseNew, ok := objectNew.(*v1alpha1.Release); ok {
+			return releaseOld.Status.Summary.Phase != releaseNew.Status.Summary.Phase
+		}
+	}
+
+	return false
+}
+
+// haveTransferAnnotationsChanged returns true if the transfer-to or transfer-accepted annotations differ between the
+// given objects.
+func haveTransferAnnotationsChanged(objectOld, objectNew client.Object) bool {
+	return objectOld.GetAnnotations()[metadata.TransferToAnnotation] != objectNew.GetAnnotations()[metadata.TransferToAnnotation] ||
+		objectOld.GetAnnotations()[metadata.TransferAcceptedAnnotation] != objectNew.GetAnnotations()[metadata.TransferAcceptedAnnotation]
+}
+
+// haveDeprecatedFieldsChanged returns true if the passed objects are Releases, ReleasePlans or ReleasePlanAdmissions and
+// the deprecated fields they set are different between them.
+func haveDeprecatedFieldsChanged(objectOld, objectNew client.Object) bool {
+	type deprecatedFieldsGetter interface {
+		GetDeprecatedFields() []v1alpha1.DeprecatedField
+	}
+
+	getterOld, okOld := objectOld.(deprecatedFieldsGetter)
+	getterNew, okNew := objectNew.(deprecatedFieldsGetter)
+	if !okOld || !okNew {
+		return false
+	}
+
+	return !reflect.DeepEqual(getterOld.GetDeprecatedFields(), getterNew.GetDeprecatedFields())
+}
+
+// isDeletionRequested returns true if the given new object is marked for deletion while the old one wasn't.
+func isDeletionRequested(objectOld, objectNew client.Object) bool {
+	return objectOld.GetDeletionTimestamp() == nil && objectNew.GetDeletionTimestamp() != nil
+}
+
+// isReleasePartiallyReleased returns true if the passed object is a Release in which some of the components failed to
+// be released.
+func isReleasePartiallyReleased(object client.Object) bool {
+	if release, ok := object.(*v1alpha1.Release); ok {
+		return release.IsPartiallyReleased()
+	}
+
+	return false
+}
+
+// isSupportBundleRequested returns true if the passed object is annotated to request the generation of its support
+// bundle.
+func isSupportBundleRequested(object client.Object) bool {
+	return object.GetAnnotations()[metadata.SupportBundleAnnotation] == "true"
+}
+
 // hasSourceChanged returns true if the objects are ReleasePlans and the Spec.Target value is
 // different between the two objects or if the objects are ReleasePlanAdmissions and the
 // Spec.Origin value is different between the two.
@@ -130,3 +295,196 @@ func hasSourceChanged(objectOld, objectNew client.Object) bool {
 
 	return false
 }
+
+// ReleaseReadyForManagedProcessingPredicate returns a predicate which returns true when a Release status is updated
+// so that the Release is valid and its tenant pipeline processing has finished. Only update events are considered.
+func ReleaseReadyForManagedProcessingPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !isReleaseReadyForManagedProcessing(e.ObjectOld) && isReleaseReadyForManagedProcessing(e.ObjectNew)
+		},
+	}
+}
+
+// ReleaseSucceededPredicate returns a predicate which returns true when a Release status is updated so that the
+// Release is marked as released. Only update events are considered.
+func ReleaseSucceededPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !isReleaseReleased(e.ObjectOld) && isReleaseReleased(e.ObjectNew)
+		},
+	}
+}
+
+// ReleasePartiallyReleasedPredicate returns a predicate which returns true when a Release status is updated so that
+// the Release is marked as partially released. Only update events are considered.
+func ReleasePartiallyReleasedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !isReleasePartiallyReleased(e.ObjectOld) && isReleasePartiallyReleased(e.ObjectNew)
+		},
+	}
+}
+
+// ReleaseSupportBundleRequestedPredicate returns a predicate which returns true when a Release is updated so that it
+// is marked as failed or is annotated to request the generation of its support bundle. Only update events are
+// considered.
+func ReleaseSupportBundleRequestedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return (!isReleaseFailed(e.ObjectOld) && isReleaseFailed(e.ObjectNew)) ||
+				(!isSupportBundleRequested(e.ObjectOld) && isSupportBundleRequested(e.ObjectNew))
+		},
+	}
+}
+
+// ReleaseApprovedPredicate returns a predicate which returns true when a Release is approved, so Releases waiting for
+// their two-person review are reconciled. Only update events are considered.
+func ReleaseApprovedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasApproverChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
+// ReleaseEmergencyBypassedPredicate returns a predicate which returns true when an emergency bypass is authorized for a
+// Release, so Releases waiting for the gates it bypasses are reconciled. Only update events are considered.
+func ReleaseEmergencyBypassedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasEmergencyBypassChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
+// ReleasePhaseChangedPredicate returns a predicate which returns true when a Release is created or deleted, or when
+// its phase changes.
+func ReleasePhaseChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return true
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasReleasePhaseChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
+// ReleasePlanTransferPredicate returns a predicate which returns true when the annotations requesting or accepting the
+// transfer of a ReleasePlan to another application change. Only update events are considered.
+func ReleasePlanTransferPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return haveTransferAnnotationsChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
+// DeprecatedFieldsChangedPredicate returns a predicate which returns true when the deprecated fields set in a Release,
+// ReleasePlan or ReleasePlanAdmission change, so the condition reporting them is kept up to date. Only update events
+// are considered.
+func DeprecatedFieldsChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return haveDeprecatedFieldsChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
+// DeletionRequestedPredicate returns a predicate which returns true when an object is marked for deletion, so its
+// finalizers can be called. Only update events are considered.
+func DeletionRequestedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isDeletionRequested(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
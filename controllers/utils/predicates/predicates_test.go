@@ -25,6 +25,7 @@ import (
 	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/metadata"
 	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -354,6 +355,105 @@ var _ = Describe("Predicates", Ordered, func() {
 		})
 	})
 
+	When("calling SnapshotTestStatusChangedPredicate", func() {
+		var snapshot, snapshotPassed, snapshotFailed *applicationapiv1alpha1.Snapshot
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			transitionTime := metav1.Time{Time: time.Now()}
+			snapshot = &applicationapiv1alpha1.Snapshot{}
+			snapshotPassed = &applicationapiv1alpha1.Snapshot{
+				Status: applicationapiv1alpha1.SnapshotStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               v1alpha1.SnapshotTestSucceededConditionType,
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: transitionTime,
+						},
+					},
+				},
+			}
+			snapshotFailed = snapshotPassed.DeepCopy()
+			snapshotFailed.Status.Conditions[0].Status = metav1.ConditionFalse
+
+			instance = SnapshotTestStatusChangedPredicate()
+		})
+
+		It("should ignore creating events", func() {
+			Expect(instance.Create(event.CreateEvent{Object: snapshotPassed})).To(BeFalse())
+		})
+
+		It("should ignore deleting events", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: snapshotPassed})).To(BeFalse())
+		})
+
+		It("should ignore generic events", func() {
+			Expect(instance.Generic(event.GenericEvent{Object: snapshotPassed})).To(BeFalse())
+		})
+
+		It("returns true when the test condition is added", func() {
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: snapshot, ObjectNew: snapshotPassed})).To(BeTrue())
+		})
+
+		It("returns true when the test condition status changes", func() {
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: snapshotFailed, ObjectNew: snapshotPassed})).To(BeTrue())
+		})
+
+		It("returns false when the test condition doesn't change", func() {
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: snapshotPassed, ObjectNew: snapshotPassed})).To(BeFalse())
+		})
+
+		It("returns false when objects other than Snapshots are passed", func() {
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: &corev1.Pod{}, ObjectNew: &corev1.Pod{}})).To(BeFalse())
+		})
+	})
+
+	When("calling SnapshotEnvironmentBindingDeploymentStatusChangedPredicate", func() {
+		var binding, bindingDeployed *applicationapiv1alpha1.SnapshotEnvironmentBinding
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			binding = &applicationapiv1alpha1.SnapshotEnvironmentBinding{}
+			bindingDeployed = &applicationapiv1alpha1.SnapshotEnvironmentBinding{
+				Status: applicationapiv1alpha1.SnapshotEnvironmentBindingStatus{
+					ComponentDeploymentConditions: []metav1.Condition{
+						{
+							Type:               applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.Time{Time: time.Now()},
+						},
+					},
+				},
+			}
+
+			instance = SnapshotEnvironmentBindingDeploymentStatusChangedPredicate()
+		})
+
+		It("should ignore creating events", func() {
+			Expect(instance.Create(event.CreateEvent{Object: bindingDeployed})).To(BeFalse())
+		})
+
+		It("should ignore deleting events", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: bindingDeployed})).To(BeFalse())
+		})
+
+		It("should ignore generic events", func() {
+			Expect(instance.Generic(event.GenericEvent{Object: bindingDeployed})).To(BeFalse())
+		})
+
+		It("returns true when the deployment conditions change", func() {
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: binding, ObjectNew: bindingDeployed})).To(BeTrue())
+		})
+
+		It("returns false when the deployment conditions don't change", func() {
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: bindingDeployed, ObjectNew: bindingDeployed})).To(BeFalse())
+		})
+
+		It("returns false when objects other than SnapshotEnvironmentBindings are passed", func() {
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: &corev1.Pod{}, ObjectNew: &corev1.Pod{}})).To(BeFalse())
+		})
+	})
+
 	When("calling hasConditionChanged", func() {
 		It("returns false when both conditions are nil", func() {
 			Expect(hasConditionChanged(nil, nil)).To(BeFalse())
@@ -440,4 +540,407 @@ var _ = Describe("Predicates", Ordered, func() {
 			Expect(hasAutoReleaseLabelChanged(podMissing, podMissing)).To(BeFalse())
 		})
 	})
+
+	When("calling ReleaseReadyForManagedProcessingPredicate", func() {
+		var pendingRelease, readyRelease *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			pendingRelease = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			readyRelease = pendingRelease.DeepCopy()
+			readyRelease.MarkValidated()
+			readyRelease.MarkTenantPipelineProcessingSkipped()
+			instance = ReleaseReadyForManagedProcessingPredicate()
+		})
+
+		It("returns false when a Release is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: readyRelease})).To(BeFalse())
+		})
+
+		It("returns false when a Release is deleted", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: readyRelease})).To(BeFalse())
+		})
+
+		It("returns true when a Release becomes ready for managed processing", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: pendingRelease,
+				ObjectNew: readyRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns false when a Release was already ready for managed processing", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: readyRelease,
+				ObjectNew: readyRelease,
+			})).To(BeFalse())
+		})
+
+		It("returns false when a Release is not ready for managed processing", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: pendingRelease,
+				ObjectNew: pendingRelease,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling ReleaseSucceededPredicate", func() {
+		var releasingRelease, releasedRelease *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			releasingRelease = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			releasingRelease.MarkReleasing("")
+			releasedRelease = releasingRelease.DeepCopy()
+			releasedRelease.MarkReleased()
+			instance = ReleaseSucceededPredicate()
+		})
+
+		It("returns false when a Release is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: releasedRelease})).To(BeFalse())
+		})
+
+		It("returns false when a Release is deleted", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: releasedRelease})).To(BeFalse())
+		})
+
+		It("returns true when a Release is marked as released", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: releasingRelease,
+				ObjectNew: releasedRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns false when a Release was already released", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: releasedRelease,
+				ObjectNew: releasedRelease,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling ReleasePartiallyReleasedPredicate", func() {
+		var releasedRelease, partiallyReleasedRelease *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			releasedRelease = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			releasedRelease.MarkReleasing("")
+			releasedRelease.MarkReleased()
+			partiallyReleasedRelease = releasedRelease.DeepCopy()
+			partiallyReleasedRelease.MarkPartiallyReleased("")
+			instance = ReleasePartiallyReleasedPredicate()
+		})
+
+		It("returns false when a Release is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: partiallyReleasedRelease})).To(BeFalse())
+		})
+
+		It("returns false when a Release is deleted", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: partiallyReleasedRelease})).To(BeFalse())
+		})
+
+		It("returns true when a Release is marked as partially released", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: releasedRelease,
+				ObjectNew: partiallyReleasedRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns false when a Release was already partially released", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: partiallyReleasedRelease,
+				ObjectNew: partiallyReleasedRelease,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling ReleaseSupportBundleRequestedPredicate", func() {
+		var failedRelease, release, requestedRelease *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			release.MarkReleasing("")
+			failedRelease = release.DeepCopy()
+			failedRelease.MarkReleaseFailed("")
+			requestedRelease = release.DeepCopy()
+			requestedRelease.Annotations = map[string]string{metadata.SupportBundleAnnotation: "true"}
+			instance = ReleaseSupportBundleRequestedPredicate()
+		})
+
+		It("returns false when a Release is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: failedRelease})).To(BeFalse())
+		})
+
+		It("returns false when a Release is deleted", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: failedRelease})).To(BeFalse())
+		})
+
+		It("returns true when a Release is marked as failed", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: release,
+				ObjectNew: failedRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns true when a Release is annotated to request its support bundle", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: release,
+				ObjectNew: requestedRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns false when a Release was already failed", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: failedRelease,
+				ObjectNew: failedRelease,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling ReleaseApprovedPredicate", func() {
+		var approvedRelease, release *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			approvedRelease = release.DeepCopy()
+			approvedRelease.Annotations = map[string]string{
+				metadata.ApprovedByAnnotation: "user",
+			}
+			instance = ReleaseApprovedPredicate()
+		})
+
+		It("returns false when a Release is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: approvedRelease})).To(BeFalse())
+		})
+
+		It("returns true when a Release is approved", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: release,
+				ObjectNew: approvedRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns false when the approver of a Release doesn't change", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: approvedRelease,
+				ObjectNew: approvedRelease,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling ReleaseEmergencyBypassedPredicate", func() {
+		var bypassedRelease, release *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			bypassedRelease = release.DeepCopy()
+			bypassedRelease.Annotations = map[string]string{
+				metadata.EmergencyBypassExpirationAnnotation: "2024-01-01T00:00:00Z",
+			}
+			instance = ReleaseEmergencyBypassedPredicate()
+		})
+
+		It("returns false when a Release is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: bypassedRelease})).To(BeFalse())
+		})
+
+		It("returns true when an emergency bypass is authorized for a Release", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: release,
+				ObjectNew: bypassedRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns false when the emergency bypass of a Release doesn't change", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: bypassedRelease,
+				ObjectNew: bypassedRelease,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling ReleasePhaseChangedPredicate", func() {
+		var release, releasingRelease *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			releasingRelease = release.DeepCopy()
+			releasingRelease.MarkReleasing("")
+			instance = ReleasePhaseChangedPredicate()
+		})
+
+		It("returns true when a Release is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: release})).To(BeTrue())
+		})
+
+		It("returns true when a Release is deleted", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: release})).To(BeTrue())
+		})
+
+		It("returns true when the phase of a Release changes", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: release,
+				ObjectNew: releasingRelease,
+			})).To(BeTrue())
+		})
+
+		It("returns false when the phase of a Release doesn't change", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: releasingRelease,
+				ObjectNew: releasingRelease,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling ReleasePlanTransferPredicate", func() {
+		var releasePlan, transferredReleasePlan *v1alpha1.ReleasePlan
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			releasePlan = &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "releaseplan",
+					Namespace: namespace,
+				},
+			}
+			transferredReleasePlan = releasePlan.DeepCopy()
+			transferredReleasePlan.Annotations = map[string]string{metadata.TransferToAnnotation: "application"}
+			instance = ReleasePlanTransferPredicate()
+		})
+
+		It("returns false when a ReleasePlan is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: transferredReleasePlan})).To(BeFalse())
+		})
+
+		It("returns false when a ReleasePlan is deleted", func() {
+			Expect(instance.Delete(event.DeleteEvent{Object: transferredReleasePlan})).To(BeFalse())
+		})
+
+		It("returns true when a transfer annotation changes", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: releasePlan,
+				ObjectNew: transferredReleasePlan,
+			})).To(BeTrue())
+		})
+
+		It("returns false when the transfer annotations don't change", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: transferredReleasePlan,
+				ObjectNew: transferredReleasePlan,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling DeletionRequestedPredicate", func() {
+		var releasePlan, deletedReleasePlan *v1alpha1.ReleasePlan
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			releasePlan = &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "releaseplan",
+					Namespace: namespace,
+				},
+			}
+			deletedReleasePlan = releasePlan.DeepCopy()
+			deletedReleasePlan.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			instance = DeletionRequestedPredicate()
+		})
+
+		It("returns false when a ReleasePlan is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: deletedReleasePlan})).To(BeFalse())
+		})
+
+		It("returns true when a ReleasePlan is marked for deletion", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: releasePlan,
+				ObjectNew: deletedReleasePlan,
+			})).To(BeTrue())
+		})
+
+		It("returns false when a ReleasePlan was already marked for deletion", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: deletedReleasePlan,
+				ObjectNew: deletedReleasePlan,
+			})).To(BeFalse())
+		})
+	})
+
+	When("calling DeprecatedFieldsChangedPredicate", func() {
+		var releasePlanAdmission, deprecatedReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			releasePlanAdmission = &v1alpha1.ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "releaseplanadmission",
+					Namespace: namespace,
+				},
+			}
+			deprecatedReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			deprecatedReleasePlanAdmission.Spec.Environment = "production"
+			instance = DeprecatedFieldsChangedPredicate()
+		})
+
+		It("returns false when a ReleasePlanAdmission is created", func() {
+			Expect(instance.Create(event.CreateEvent{Object: deprecatedReleasePlanAdmission})).To(BeFalse())
+		})
+
+		It("returns true when a deprecated field is set or removed", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: releasePlanAdmission,
+				ObjectNew: deprecatedReleasePlanAdmission,
+			})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: deprecatedReleasePlanAdmission,
+				ObjectNew: releasePlanAdmission,
+			})).To(BeTrue())
+		})
+
+		It("returns false when the deprecated fields don't change", func() {
+			Expect(instance.Update(event.UpdateEvent{
+				ObjectOld: deprecatedReleasePlanAdmission,
+				ObjectNew: deprecatedReleasePlanAdmission,
+			})).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,182 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Quarantinable is implemented by the objects able to report in their status that they were quarantined.
+type Quarantinable interface {
+	client.Object
+	IsQuarantined() bool
+	MarkQuarantined(message string)
+	MarkUnquarantined()
+}
+
+// RecoveringReconciler wraps a reconciler to recover from the panics raised while reconciling an object. Objects
+// causing a number of consecutive panics equal to the threshold are quarantined, so they don't keep wedging the
+// workqueue. Quarantined objects are annotated and skipped until the annotation is removed. Objects reporting the
+// quarantine in their status are only skipped if the status agrees with the annotation, as users able to edit the
+// object can't write its status, and objects being deleted are never skipped so their finalizers can be removed.
+type RecoveringReconciler struct {
+	client     client.Client
+	controller string
+	newObject  func() client.Object
+	reconciler reconcile.Reconciler
+	threshold  int
+
+	mutex  sync.Mutex
+	panics map[reconcile.Request]int
+}
+
+var _ reconcile.Reconciler = &RecoveringReconciler{}
+
+// NewRecoveringReconciler creates and returns a RecoveringReconciler wrapping the given reconciler. The newObject
+// function should return an empty object of the kind reconciled by the controller with the given name.
+func NewRecoveringReconciler(cli client.Client, controller string, newObject func() client.Object,
+	reconciler reconcile.Reconciler, threshold int) *RecoveringReconciler {
+	return &RecoveringReconciler{
+		client:     cli,
+		controller: controller,
+		newObject:  newObject,
+		reconciler: reconciler,
+		threshold:  threshold,
+		panics:     map[reconcile.Request]int{},
+	}
+}
+
+// Reconcile calls the wrapped reconciler unless the object is quarantined. Panics are recovered and returned as
+// errors, so the request is retried, until the object reaches the threshold and gets quarantined.
+func (r *RecoveringReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	object := r.newObject()
+	err := r.client.Get(ctx, req.NamespacedName, object)
+	found := err == nil
+	if found && object.GetDeletionTimestamp() == nil && isQuarantined(object) {
+		logger.Info("Skipping quarantined object", "Object", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if found && object.GetAnnotations()[metadata.QuarantinedAnnotation] != "true" {
+		if err := r.unquarantine(ctx, object); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	result, recovered, err := r.reconcile(ctx, req)
+	if recovered == nil {
+		r.resetPanics(req)
+		return result, err
+	}
+
+	metrics.RegisterReconcilePanic(r.controller)
+	panicErr := fmt.Errorf("recovered from panic while reconciling %s: %v", req.NamespacedName, recovered)
+	logger.Error(panicErr, "Reconcile panicked", "Object", req.NamespacedName)
+
+	if r.registerPanic(req) < r.threshold || !found {
+		return reconcile.Result{}, panicErr
+	}
+
+	r.resetPanics(req)
+	logger.Info("Quarantining object after repeated panics", "Object", req.NamespacedName)
+
+	return reconcile.Result{}, r.quarantine(ctx, object, fmt.Sprintf(
+		"reconcile panicked %d consecutive times (last panic: %v). Remove the %s annotation to reconcile it again",
+		r.threshold, recovered, metadata.QuarantinedAnnotation))
+}
+
+// quarantine annotates the given object so it's no longer reconciled and, if the object supports it, reports the
+// given message in its status.
+func (r *RecoveringReconciler) quarantine(ctx context.Context, object client.Object, message string) error {
+	patch := client.MergeFrom(object.DeepCopyObject().(client.Object))
+	metadata.AddAnnotations(object, map[string]string{metadata.QuarantinedAnnotation: "true"})
+	if err := r.client.Patch(ctx, object, patch); err != nil {
+		return err
+	}
+
+	if quarantinable, ok := object.(Quarantinable); ok {
+		patch = client.MergeFrom(quarantinable.DeepCopyObject().(client.Object))
+		quarantinable.MarkQuarantined(message)
+		return r.client.Status().Patch(ctx, quarantinable, patch)
+	}
+
+	return nil
+}
+
+// unquarantine clears the quarantine reported in the status of the given object once its annotation is removed, so the
+// object is reconciled again.
+func (r *RecoveringReconciler) unquarantine(ctx context.Context, object client.Object) error {
+	quarantinable, ok := object.(Quarantinable)
+	if !ok || !quarantinable.IsQuarantined() {
+		return nil
+	}
+
+	patch := client.MergeFrom(quarantinable.DeepCopyObject().(client.Object))
+	quarantinable.MarkUnquarantined()
+	return r.client.Status().Patch(ctx, quarantinable, patch)
+}
+
+// isQuarantined returns a boolean indicating whether the given object is quarantined. Objects reporting the quarantine
+// in their status must also report it there, so setting the annotation on its own doesn't stop their reconciliation.
+func isQuarantined(object client.Object) bool {
+	if object.GetAnnotations()[metadata.QuarantinedAnnotation] != "true" {
+		return false
+	}
+
+	if quarantinable, ok := object.(Quarantinable); ok {
+		return quarantinable.IsQuarantined()
+	}
+
+	return true
+}
+
+// reconcile calls the wrapped reconciler, returning the value passed to panic if it panics.
+func (r *RecoveringReconciler) reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, recovered interface{}, err error) {
+	defer func() {
+		recovered = recover()
+	}()
+
+	result, err = r.reconciler.Reconcile(ctx, req)
+	return result, nil, err
+}
+
+// registerPanic registers a panic raised while reconciling the given request and returns the number of consecutive
+// panics raised for it.
+func (r *RecoveringReconciler) registerPanic(req reconcile.Request) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.panics[req]++
+	return r.panics[req]
+}
+
+// resetPanics forgets the panics raised while reconciling the given request.
+func (r *RecoveringReconciler) resetPanics(req reconcile.Request) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.panics, req)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recovery
+
+import (
+	"context"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("RecoveringReconciler", Ordered, func() {
+	var (
+		calls      int
+		panicking  bool
+		reconciler *RecoveringReconciler
+		release    *v1alpha1.Release
+		request    reconcile.Request
+	)
+
+	BeforeAll(func() {
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "release-",
+				Namespace:    "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				Snapshot:    "snapshot",
+				ReleasePlan: "releaseplan",
+			},
+		}
+		Expect(k8sClient.Create(ctx, release)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      release.Name,
+			Namespace: release.Namespace,
+		}}
+	})
+
+	AfterAll(func() {
+		_ = k8sClient.Delete(ctx, release)
+	})
+
+	BeforeEach(func() {
+		calls = 0
+		panicking = false
+		metrics.ReconcilePanicsTotal.Reset()
+
+		reconciler = NewRecoveringReconciler(k8sClient, "test", func() client.Object { return &v1alpha1.Release{} },
+			reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				calls++
+				if panicking {
+					panic("foo")
+				}
+				return reconcile.Result{}, nil
+			}), 2)
+	})
+
+	It("calls the wrapped reconciler", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(1))
+	})
+
+	It("recovers from panics and returns an error", func() {
+		panicking = true
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("recovered from panic"))
+		Expect(testutil.ToFloat64(metrics.ReconcilePanicsTotal.WithLabelValues("test"))).To(Equal(float64(1)))
+		Expect(reconciler.panics[request]).To(Equal(1))
+	})
+
+	It("forgets the panics after a successful reconcile", func() {
+		panicking = true
+		_, _ = reconciler.Reconcile(ctx, request)
+
+		panicking = false
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciler.panics).NotTo(HaveKey(request))
+	})
+
+	It("quarantines the object once the threshold is reached", func() {
+		panicking = true
+		_, _ = reconciler.Reconcile(ctx, request)
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, request.NamespacedName, release)).To(Succeed())
+		Expect(release.GetAnnotations()).To(HaveKeyWithValue(metadata.QuarantinedAnnotation, "true"))
+		Expect(release.IsQuarantined()).To(BeTrue())
+		Expect(release.Status.Summary.Phase).To(Equal(v1alpha1.ReleasePhaseStalled))
+	})
+
+	It("skips quarantined objects", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(0))
+	})
+
+	It("lifts the quarantine once the annotation is removed", func() {
+		Expect(k8sClient.Get(ctx, request.NamespacedName, release)).To(Succeed())
+		patch := client.MergeFrom(release.DeepCopy())
+		delete(release.Annotations, metadata.QuarantinedAnnotation)
+		Expect(k8sClient.Patch(ctx, release, patch)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(1))
+
+		Expect(k8sClient.Get(ctx, request.NamespacedName, release)).To(Succeed())
+		Expect(release.IsQuarantined()).To(BeFalse())
+	})
+
+	It("doesn't skip objects annotated as quarantined if their status doesn't report it", func() {
+		Expect(k8sClient.Get(ctx, request.NamespacedName, release)).To(Succeed())
+		patch := client.MergeFrom(release.DeepCopy())
+		metadata.AddAnnotations(release, map[string]string{metadata.QuarantinedAnnotation: "true"})
+		Expect(k8sClient.Patch(ctx, release, patch)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(1))
+	})
+
+	It("doesn't skip quarantined objects being deleted", func() {
+		panicking = true
+		_, _ = reconciler.Reconcile(ctx, request)
+		_, _ = reconciler.Reconcile(ctx, request)
+		Expect(k8sClient.Get(ctx, request.NamespacedName, release)).To(Succeed())
+		Expect(release.IsQuarantined()).To(BeTrue())
+
+		patch := client.MergeFrom(release.DeepCopy())
+		controllerutil.AddFinalizer(release, metadata.ReleaseFinalizer)
+		Expect(k8sClient.Patch(ctx, release, patch)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, release)).To(Succeed())
+		defer func() {
+			Expect(k8sClient.Get(ctx, request.NamespacedName, release)).To(Succeed())
+			patch := client.MergeFrom(release.DeepCopy())
+			controllerutil.RemoveFinalizer(release, metadata.ReleaseFinalizer)
+			Expect(k8sClient.Patch(ctx, release, patch)).To(Succeed())
+		}()
+
+		calls = 0
+		panicking = false
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(1))
+	})
+})
@@ -0,0 +1,139 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+)
+
+const (
+	// QuayBrokerType is the type of the brokers creating Quay robot accounts
+	QuayBrokerType = "Quay"
+
+	// TokenExchangeBrokerType is the type of the brokers exchanging OAuth tokens
+	TokenExchangeBrokerType = "TokenExchange"
+)
+
+// ErrNoBroker is returned when registry credentials are requested but no credentials broker is configured.
+var ErrNoBroker = errors.New("no credentials broker is configured")
+
+// Credentials are registry credentials minted by a Broker for a single Release.
+type Credentials struct {
+	// ID identifies the credentials in the broker, so they can be revoked
+	ID string
+
+	// Registry is the host of the registry the credentials are valid for
+	Registry string
+
+	// Username is the username to log in to the registry with. Credentials without username are bearer tokens
+	Username string
+
+	// Password is the password or token to log in to the registry with
+	Password string
+}
+
+// Broker is an interface to mint short-lived registry credentials scoped to the repositories a Release pushes to and
+// to revoke them once the Release no longer needs them.
+type Broker interface {
+	Issue(ctx context.Context, name string, repositories []string) (*Credentials, error)
+	Revoke(ctx context.Context, credentials *Credentials) error
+}
+
+// dockerConfigAuth is an entry of the auths section of a .dockerconfigjson file.
+type dockerConfigAuth struct {
+	Auth          string `json:"auth,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+// dockerConfig is the content of a .dockerconfigjson file.
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+// NewBroker creates and returns the Broker defined by the given configuration, authenticating with the broker using
+// the given token and reaching it with the given http client.
+func NewBroker(broker *v1alpha1.CredentialsBroker, token string, httpClient *http.Client) (Broker, error) {
+	brokerUrl, err := url.Parse(broker.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch broker.Type {
+	case QuayBrokerType:
+		return NewQuayBroker(brokerUrl, token, httpClient), nil
+	case TokenExchangeBrokerType:
+		var revocationUrl *url.URL
+		if broker.RevocationURL != "" {
+			revocationUrl, err = url.Parse(broker.RevocationURL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return NewTokenExchangeBroker(brokerUrl, revocationUrl, token, httpClient), nil
+	default:
+		return nil, fmt.Errorf("unsupported credentials broker type '%s'", broker.Type)
+	}
+}
+
+// GetDockerConfigJson returns the content of a .dockerconfigjson file holding the given credentials.
+func GetDockerConfigJson(credentials *Credentials) ([]byte, error) {
+	auth := dockerConfigAuth{}
+	if credentials.Username != "" {
+		auth.Auth = base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password))
+	} else {
+		auth.RegistryToken = credentials.Password
+	}
+
+	return json.Marshal(&dockerConfig{
+		Auths: map[string]dockerConfigAuth{credentials.Registry: auth},
+	})
+}
+
+// splitRepositories returns the registry hosting the given repositories and their paths within it. An error is returned
+// if the repositories are not hosted in the same registry.
+func splitRepositories(repositories []string) (string, []string, error) {
+	if len(repositories) == 0 {
+		return "", nil, fmt.Errorf("no repositories to scope the credentials to")
+	}
+
+	var registry string
+	var paths []string
+	for _, repository := range repositories {
+		host, path, found := strings.Cut(repository, "/")
+		if !found || path == "" {
+			return "", nil, fmt.Errorf("the repository '%s' doesn't include the registry host", repository)
+		}
+
+		if registry != "" && host != registry {
+			return "", nil, fmt.Errorf("the repositories are hosted in different registries (%s and %s)", registry, host)
+		}
+		registry = host
+		paths = append(paths, path)
+	}
+
+	return registry, paths, nil
+}
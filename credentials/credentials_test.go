@@ -0,0 +1,107 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credentials", func() {
+	When("NewBroker is called", func() {
+		It("should return a quay broker for Quay brokers", func() {
+			broker, err := NewBroker(&v1alpha1.CredentialsBroker{
+				Type: QuayBrokerType,
+				URL:  "https://quay.io",
+			}, "token", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(broker).To(BeAssignableToTypeOf(&quayBroker{}))
+			Expect(broker.(*quayBroker).quayUrl.Host).To(Equal("quay.io"))
+		})
+
+		It("should return a token exchange broker for TokenExchange brokers", func() {
+			broker, err := NewBroker(&v1alpha1.CredentialsBroker{
+				RevocationURL: "https://sso/revoke",
+				Type:          TokenExchangeBrokerType,
+				URL:           "https://sso/token",
+			}, "token", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(broker).To(BeAssignableToTypeOf(&tokenExchangeBroker{}))
+			Expect(broker.(*tokenExchangeBroker).revocationUrl.Path).To(Equal("/revoke"))
+		})
+
+		It("should fail for unsupported broker types", func() {
+			_, err := NewBroker(&v1alpha1.CredentialsBroker{Type: "foo", URL: "https://broker"}, "", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported credentials broker type"))
+		})
+	})
+
+	When("GetDockerConfigJson is called", func() {
+		It("should encode the username and password in the auth of the registry", func() {
+			data, err := GetDockerConfigJson(&Credentials{Registry: "quay.io", Username: "org+robot", Password: "pass"})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &dockerConfig{}
+			Expect(json.Unmarshal(data, config)).To(Succeed())
+			Expect(config.Auths).To(HaveKey("quay.io"))
+			Expect(config.Auths["quay.io"].Auth).To(Equal(base64.StdEncoding.EncodeToString([]byte("org+robot:pass"))))
+		})
+
+		It("should use the password as registry token if there is no username", func() {
+			data, err := GetDockerConfigJson(&Credentials{Registry: "registry.io", Password: "token"})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &dockerConfig{}
+			Expect(json.Unmarshal(data, config)).To(Succeed())
+			Expect(config.Auths["registry.io"].RegistryToken).To(Equal("token"))
+			Expect(config.Auths["registry.io"].Auth).To(BeEmpty())
+		})
+	})
+
+	When("splitRepositories is called", func() {
+		It("should return the registry and the paths of the repositories", func() {
+			registry, paths, err := splitRepositories([]string{"quay.io/org/foo", "quay.io/org/bar"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry).To(Equal("quay.io"))
+			Expect(paths).To(Equal([]string{"org/foo", "org/bar"}))
+		})
+
+		It("should fail if the repositories are hosted in different registries", func() {
+			_, _, err := splitRepositories([]string{"quay.io/org/foo", "registry.io/org/bar"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("different registries"))
+		})
+
+		It("should fail if a repository doesn't include the registry", func() {
+			_, _, err := splitRepositories([]string{"foo"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't include the registry host"))
+		})
+
+		It("should fail if there are no repositories", func() {
+			_, _, err := splitRepositories(nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
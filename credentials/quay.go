@@ -0,0 +1,162 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// quayBroker mints registry credentials by creating a robot account in the Quay organization of the repositories and
+// granting it write permissions on them. The credentials are revoked by deleting the robot account.
+type quayBroker struct {
+	httpClient *http.Client
+	quayUrl    *url.URL
+	token      string
+}
+
+// quayRobotRequest is the body sent to the Quay robot account creation endpoint.
+type quayRobotRequest struct {
+	Description string `json:"description"`
+}
+
+// quayRobotResponse is the body returned by the Quay robot account creation endpoint.
+type quayRobotResponse struct {
+	Name  string `json:"name"`
+	Token string `json:"token"`
+}
+
+// quayPermissionRequest is the body sent to the Quay repository permissions endpoint.
+type quayPermissionRequest struct {
+	Role string `json:"role"`
+}
+
+// NewQuayBroker creates and returns a Broker managing robot accounts in the Quay instance at the given url. The given
+// token is sent in every request to authenticate with Quay, so it needs to be allowed to administer the organizations.
+func NewQuayBroker(quayUrl *url.URL, token string, httpClient *http.Client) Broker {
+	return &quayBroker{
+		httpClient: httpClient,
+		quayUrl:    quayUrl,
+		token:      token,
+	}
+}
+
+// Issue creates a robot account with the given name in the organization of the given repositories and grants it write
+// permissions on each of them. All the repositories have to belong to the same organization. If any permission can't
+// be granted, the robot account is deleted.
+func (b *quayBroker) Issue(ctx context.Context, name string, repositories []string) (*Credentials, error) {
+	registry, paths, err := splitRepositories(repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	var organization string
+	for _, path := range paths {
+		pathOrganization, _, _ := strings.Cut(path, "/")
+		if organization != "" && pathOrganization != organization {
+			return nil, fmt.Errorf("the repositories belong to different Quay organizations (%s and %s)",
+				organization, pathOrganization)
+		}
+		organization = pathOrganization
+	}
+
+	robot := &quayRobotResponse{}
+	err = b.do(ctx, http.MethodPut, &quayRobotRequest{Description: "Release Service credentials for " + name},
+		robot, "api", "v1", "organization", organization, "robots", name)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := &Credentials{
+		ID:       robot.Name,
+		Registry: registry,
+		Username: robot.Name,
+		Password: robot.Token,
+	}
+
+	for _, path := range paths {
+		_, repository, _ := strings.Cut(path, "/")
+		err = b.do(ctx, http.MethodPut, &quayPermissionRequest{Role: "write"}, nil,
+			"api", "v1", "repository", organization, repository, "permissions", "user", robot.Name)
+		if err != nil {
+			_ = b.Revoke(ctx, credentials)
+			return nil, err
+		}
+	}
+
+	return credentials, nil
+}
+
+// Revoke deletes the robot account of the given credentials. Robot accounts that no longer exist are ignored.
+func (b *quayBroker) Revoke(ctx context.Context, credentials *Credentials) error {
+	organization, name, found := strings.Cut(credentials.ID, "+")
+	if !found {
+		return fmt.Errorf("'%s' is not the name of a Quay robot account", credentials.ID)
+	}
+
+	err := b.do(ctx, http.MethodDelete, nil, nil, "api", "v1", "organization", organization, "robots", name)
+	if err != nil && !strings.Contains(err.Error(), "unexpected status 404") {
+		return err
+	}
+
+	return nil
+}
+
+// do sends a request with the given method and body to the Quay API endpoint at the given path, decoding the response
+// in the given output if it's not nil.
+func (b *quayBroker) do(ctx context.Context, method string, body, output any, path ...string) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, b.quayUrl.JoinPath(path...).String(), reader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if b.token != "" {
+		request.Header.Set("Authorization", "Bearer "+b.token)
+	}
+
+	response, err := b.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d calling %s %s in quay", response.StatusCode, method,
+			strings.Join(path, "/"))
+	}
+
+	if output == nil {
+		return nil
+	}
+
+	return json.NewDecoder(response.Body).Decode(output)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quay broker", func() {
+	var (
+		mutex    sync.Mutex
+		requests []string
+		server   *httptest.Server
+		status   map[string]int
+	)
+
+	BeforeEach(func() {
+		requests = []string{}
+		status = map[string]int{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+			request := r.Method + " " + r.URL.Path
+			requests = append(requests, request)
+			if code, found := status[request]; found {
+				w.WriteHeader(code)
+				return
+			}
+			if r.Method == http.MethodPut && r.URL.Path == "/api/v1/organization/org/robots/release_foo" {
+				_, _ = w.Write([]byte(`{"name":"org+release_foo","token":"robot-token"}`))
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newBroker := func() Broker {
+		quayUrl, _ := url.Parse(server.URL)
+		return NewQuayBroker(quayUrl, "token", server.Client())
+	}
+
+	It("should create a robot account with write permissions on the repositories", func() {
+		credentials, err := newBroker().Issue(context.TODO(), "release_foo", []string{"quay.io/org/foo", "quay.io/org/bar"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(credentials).To(Equal(&Credentials{
+			ID:       "org+release_foo",
+			Registry: "quay.io",
+			Username: "org+release_foo",
+			Password: "robot-token",
+		}))
+		Expect(requests).To(Equal([]string{
+			"PUT /api/v1/organization/org/robots/release_foo",
+			"PUT /api/v1/repository/org/foo/permissions/user/org+release_foo",
+			"PUT /api/v1/repository/org/bar/permissions/user/org+release_foo",
+		}))
+	})
+
+	It("should delete the robot account if a permission can't be granted", func() {
+		status["PUT /api/v1/repository/org/bar/permissions/user/org+release_foo"] = http.StatusForbidden
+
+		_, err := newBroker().Issue(context.TODO(), "release_foo", []string{"quay.io/org/foo", "quay.io/org/bar"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status 403"))
+		Expect(requests).To(ContainElement("DELETE /api/v1/organization/org/robots/release_foo"))
+	})
+
+	It("should fail if the repositories belong to different organizations", func() {
+		_, err := newBroker().Issue(context.TODO(), "release_foo", []string{"quay.io/org/foo", "quay.io/other/bar"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("different Quay organizations"))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should delete the robot account when revoking the credentials", func() {
+		Expect(newBroker().Revoke(context.TODO(), &Credentials{ID: "org+release_foo"})).To(Succeed())
+		Expect(requests).To(Equal([]string{"DELETE /api/v1/organization/org/robots/release_foo"}))
+	})
+
+	It("should ignore robot accounts that no longer exist when revoking the credentials", func() {
+		status["DELETE /api/v1/organization/org/robots/release_foo"] = http.StatusNotFound
+
+		Expect(newBroker().Revoke(context.TODO(), &Credentials{ID: "org+release_foo"})).To(Succeed())
+	})
+
+	It("should fail to revoke credentials that don't belong to a robot account", func() {
+		err := newBroker().Revoke(context.TODO(), &Credentials{ID: "foo"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not the name of a Quay robot account"))
+	})
+})
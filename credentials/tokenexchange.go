@@ -0,0 +1,143 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// tokenExchangeGrantType is the OAuth grant type of the token exchange requests (RFC 8693)
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+	// accessTokenType is the OAuth token type of the exchanged and minted tokens
+	accessTokenType = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// tokenExchangeBroker mints registry credentials by exchanging its token for an OAuth access token scoped to push to
+// the repositories. The minted tokens are revoked using the OAuth token revocation endpoint, if there is one.
+type tokenExchangeBroker struct {
+	httpClient    *http.Client
+	revocationUrl *url.URL
+	token         string
+	tokenUrl      *url.URL
+}
+
+// tokenExchangeResponse is the body returned by the OAuth token exchange endpoint.
+type tokenExchangeResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+// NewTokenExchangeBroker creates and returns a Broker exchanging the given token at the OAuth token endpoint at the
+// given url. If the revocation url is nil, the minted tokens are left to expire.
+func NewTokenExchangeBroker(tokenUrl, revocationUrl *url.URL, token string, httpClient *http.Client) Broker {
+	return &tokenExchangeBroker{
+		httpClient:    httpClient,
+		revocationUrl: revocationUrl,
+		token:         token,
+		tokenUrl:      tokenUrl,
+	}
+}
+
+// Issue exchanges the broker token for an access token allowed to pull from and push to the given repositories, using
+// the registry hosting them as audience. The given name is ignored, as the minted tokens are anonymous.
+func (b *tokenExchangeBroker) Issue(ctx context.Context, _ string, repositories []string) (*Credentials, error) {
+	registry, paths, err := splitRepositories(repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	var scopes []string
+	for _, path := range paths {
+		scopes = append(scopes, fmt.Sprintf("repository:%s:pull,push", path))
+	}
+
+	response, err := b.post(ctx, b.tokenUrl, url.Values{
+		"audience":             {registry},
+		"grant_type":           {tokenExchangeGrantType},
+		"requested_token_type": {accessTokenType},
+		"scope":                {strings.Join(scopes, " ")},
+		"subject_token":        {b.token},
+		"subject_token_type":   {accessTokenType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	exchangeResponse := &tokenExchangeResponse{}
+	err = json.NewDecoder(response.Body).Decode(exchangeResponse)
+	if err != nil {
+		return nil, err
+	}
+	if exchangeResponse.AccessToken == "" {
+		return nil, fmt.Errorf("the token exchange response doesn't contain an access token")
+	}
+
+	return &Credentials{
+		Registry: registry,
+		Password: exchangeResponse.AccessToken,
+	}, nil
+}
+
+// Revoke revokes the access token of the given credentials at the OAuth token revocation endpoint (RFC 7009). Nothing
+// is done if the broker has no revocation endpoint.
+func (b *tokenExchangeBroker) Revoke(ctx context.Context, credentials *Credentials) error {
+	if b.revocationUrl == nil {
+		return nil
+	}
+
+	response, err := b.post(ctx, b.revocationUrl, url.Values{
+		"token":           {credentials.Password},
+		"token_type_hint": {"access_token"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return response.Body.Close()
+}
+
+// post sends the given form to the given endpoint, authenticating with the broker token. An error is returned if the
+// endpoint doesn't answer with a 2xx status.
+func (b *tokenExchangeBroker) post(ctx context.Context, endpoint *url.URL, form url.Values) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if b.token != "" {
+		request.Header.Set("Authorization", "Bearer "+b.token)
+	}
+
+	response, err := b.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		response.Body.Close()
+		return nil, fmt.Errorf("unexpected status %d calling %s", response.StatusCode, endpoint.Redacted())
+	}
+
+	return response, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token exchange broker", func() {
+	var (
+		forms  map[string]url.Values
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		forms = map[string]url.Values{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).To(Succeed())
+			forms[r.URL.Path] = r.PostForm
+			switch r.URL.Path {
+			case "/token":
+				_, _ = w.Write([]byte(`{"access_token":"minted","expires_in":3600}`))
+			case "/error":
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newBroker := func(tokenPath, revocationPath string) Broker {
+		tokenUrl, _ := url.Parse(server.URL + tokenPath)
+		var revocationUrl *url.URL
+		if revocationPath != "" {
+			revocationUrl, _ = url.Parse(server.URL + revocationPath)
+		}
+		return NewTokenExchangeBroker(tokenUrl, revocationUrl, "token", server.Client())
+	}
+
+	It("should exchange the broker token for a token scoped to the repositories", func() {
+		credentials, err := newBroker("/token", "").Issue(context.TODO(), "release_foo",
+			[]string{"registry.io/org/foo", "registry.io/org/bar"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(credentials).To(Equal(&Credentials{Registry: "registry.io", Password: "minted"}))
+
+		form := forms["/token"]
+		Expect(form.Get("grant_type")).To(Equal(tokenExchangeGrantType))
+		Expect(form.Get("subject_token")).To(Equal("token"))
+		Expect(form.Get("audience")).To(Equal("registry.io"))
+		Expect(form.Get("scope")).To(Equal("repository:org/foo:pull,push repository:org/bar:pull,push"))
+	})
+
+	It("should fail if the token endpoint returns an error", func() {
+		_, err := newBroker("/error", "").Issue(context.TODO(), "release_foo", []string{"registry.io/org/foo"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status 401"))
+	})
+
+	It("should revoke the minted token at the revocation endpoint", func() {
+		Expect(newBroker("/token", "/revoke").Revoke(context.TODO(), &Credentials{Password: "minted"})).To(Succeed())
+		Expect(forms["/revoke"].Get("token")).To(Equal("minted"))
+	})
+
+	It("should not revoke anything if there is no revocation endpoint", func() {
+		Expect(newBroker("/token", "").Revoke(context.TODO(), &Credentials{Password: "minted"})).To(Succeed())
+		Expect(forms).To(BeEmpty())
+	})
+})
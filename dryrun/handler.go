@@ -0,0 +1,132 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MatchPath is the path the MatchHandler is meant to be served on.
+const MatchPath = "/releaseplanadmissions/match"
+
+// MatchResponse describes the ReleasePlanAdmission that would be selected for a given application and origin.
+type MatchResponse struct {
+	// Active indicates whether the ReleasePlanAdmission is set to auto-release or not
+	Active bool `json:"active"`
+
+	// Error contains the reason why no ReleasePlanAdmission would be selected
+	Error string `json:"error,omitempty"`
+
+	// Pipeline contains the information about the managed Pipeline that would run
+	Pipeline *tektonutils.Pipeline `json:"pipeline,omitempty"`
+
+	// ReleasePlanAdmission contains the namespaced name of the ReleasePlanAdmission that would be selected
+	ReleasePlanAdmission string `json:"releasePlanAdmission,omitempty"`
+}
+
+// MatchHandler is an http.Handler that returns the ReleasePlanAdmission that would be selected for a ReleasePlan
+// with the given application, origin and target, without having to create any resource. The values are passed with
+// the "application", "origin" and "target" query parameters. Optionally, the "releasePlanAdmission" parameter can be
+// used to emulate a ReleasePlan designating a specific ReleasePlanAdmission and the "strategy" parameter can be used to
+// emulate a Release selecting one of the strategies of the ReleasePlanAdmission.
+type MatchHandler struct {
+	client client.Client
+	loader loader.ObjectLoader
+	log    logr.Logger
+}
+
+// NewMatchHandler creates and returns a MatchHandler using the given client and logger.
+func NewMatchHandler(client client.Client, log logr.Logger) *MatchHandler {
+	return &MatchHandler{
+		client: client,
+		loader: loader.NewLoader(),
+		log:    log.WithName("match"),
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *MatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeResponse(w, http.StatusMethodNotAllowed, &MatchResponse{Error: "only GET requests are supported"})
+		return
+	}
+
+	query := r.URL.Query()
+	releasePlan := &v1alpha1.ReleasePlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: query.Get("origin"),
+		},
+		Spec: v1alpha1.ReleasePlanSpec{
+			Application: query.Get("application"),
+			Target:      query.Get("target"),
+		},
+	}
+	if releasePlan.Namespace == "" || releasePlan.Spec.Application == "" || releasePlan.Spec.Target == "" {
+		h.writeResponse(w, http.StatusBadRequest, &MatchResponse{
+			Error: "the application, origin and target parameters are required",
+		})
+		return
+	}
+	if name := query.Get("releasePlanAdmission"); name != "" {
+		releasePlan.Labels = map[string]string{metadata.ReleasePlanAdmissionLabel: name}
+	}
+
+	releasePlanAdmission, err := h.loader.GetMatchingReleasePlanAdmission(r.Context(), h.client, releasePlan)
+	if err != nil {
+		status := http.StatusNotFound
+		if errors.ReasonForError(err) != metav1.StatusReasonUnknown && !errors.IsNotFound(err) {
+			status = http.StatusInternalServerError
+		}
+		h.writeResponse(w, status, &MatchResponse{Error: err.Error()})
+		return
+	}
+
+	pipeline, err := releasePlanAdmission.GetPipeline(query.Get("strategy"))
+	if err != nil {
+		h.writeResponse(w, http.StatusNotFound, &MatchResponse{Error: err.Error()})
+		return
+	}
+
+	releasePlan.MarkMatched(releasePlanAdmission)
+	h.writeResponse(w, http.StatusOK, &MatchResponse{
+		Active:               releasePlan.Status.ReleasePlanAdmission.Active,
+		Pipeline:             pipeline,
+		ReleasePlanAdmission: fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name),
+	})
+}
+
+// writeResponse writes the given MatchResponse as JSON with the given status code.
+func (h *MatchHandler) writeResponse(w http.ResponseWriter, status int, response *MatchResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		h.log.Error(err, "unable to write response")
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("MatchHandler", func() {
+	var handler *MatchHandler
+	var releasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+	serve := func(ctx context.Context, method, query string) (*httptest.ResponseRecorder, *MatchResponse) {
+		request := httptest.NewRequest(method, MatchPath+query, nil).WithContext(ctx)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		response := &MatchResponse{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+
+		return recorder, response
+	}
+
+	BeforeEach(func() {
+		handler = NewMatchHandler(nil, ctrl.Log)
+		handler.loader = loader.NewMockLoader()
+
+		releasePlanAdmission = &v1alpha1.ReleasePlanAdmission{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "releaseplanadmission",
+				Namespace: "managed",
+				Labels: map[string]string{
+					metadata.AutoReleaseLabel: "true",
+				},
+			},
+			Spec: v1alpha1.ReleasePlanAdmissionSpec{
+				Applications: []string{"application"},
+				Origin:       "default",
+				Pipeline: &tektonutils.Pipeline{
+					PipelineRef: tektonutils.PipelineRef{
+						Resolver: "bundles",
+					},
+				},
+			},
+		}
+	})
+
+	It("should fail if the method is not GET", func() {
+		recorder, response := serve(context.TODO(), http.MethodPost, "?application=application&origin=default&target=managed")
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(response.Error).NotTo(BeEmpty())
+	})
+
+	It("should fail if a parameter is missing", func() {
+		recorder, response := serve(context.TODO(), http.MethodGet, "?application=application&origin=default")
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(response.Error).To(ContainSubstring("parameters are required"))
+	})
+
+	It("should return the matching ReleasePlanAdmission and its pipeline", func() {
+		ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+			{
+				ContextKey: loader.MatchedReleasePlanAdmissionContextKey,
+				Resource:   releasePlanAdmission,
+			},
+		})
+
+		recorder, response := serve(ctx, http.MethodGet, "?application=application&origin=default&target=managed")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(response.ReleasePlanAdmission).To(Equal("managed/releaseplanadmission"))
+		Expect(response.Active).To(BeTrue())
+		Expect(response.Pipeline).NotTo(BeNil())
+		Expect(response.Pipeline.PipelineRef.Resolver).To(Equal("bundles"))
+	})
+
+	It("should return the pipeline of the given strategy", func() {
+		releasePlanAdmission.Spec.Strategies = []v1alpha1.ReleaseStrategy{
+			{
+				Name: "hotfix",
+				Pipeline: &tektonutils.Pipeline{
+					PipelineRef: tektonutils.PipelineRef{
+						Resolver: "git",
+					},
+				},
+			},
+		}
+		ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+			{
+				ContextKey: loader.MatchedReleasePlanAdmissionContextKey,
+				Resource:   releasePlanAdmission,
+			},
+		})
+
+		recorder, response := serve(ctx, http.MethodGet, "?application=application&origin=default&target=managed&strategy=hotfix")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(response.Pipeline).NotTo(BeNil())
+		Expect(response.Pipeline.PipelineRef.Resolver).To(Equal("git"))
+	})
+
+	It("should fail if the given strategy is not defined", func() {
+		ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+			{
+				ContextKey: loader.MatchedReleasePlanAdmissionContextKey,
+				Resource:   releasePlanAdmission,
+			},
+		})
+
+		recorder, response := serve(ctx, http.MethodGet, "?application=application&origin=default&target=managed&strategy=hotfix")
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		Expect(response.Error).To(ContainSubstring("strategy hotfix is not defined"))
+	})
+
+	It("should return the reason if no ReleasePlanAdmission matches", func() {
+		ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+			{
+				ContextKey: loader.MatchedReleasePlanAdmissionContextKey,
+				Err:        fmt.Errorf("no ReleasePlanAdmission found"),
+			},
+		})
+
+		recorder, response := serve(ctx, http.MethodGet, "?application=application&origin=default&target=managed")
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		Expect(response.Error).To(Equal("no ReleasePlanAdmission found"))
+		Expect(response.ReleasePlanAdmission).To(BeEmpty())
+	})
+})
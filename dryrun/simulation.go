@@ -0,0 +1,280 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SimulationPath is the path the SimulationHandler is meant to be served on.
+const SimulationPath = "/releaseplans/simulate"
+
+// SimulationRequest contains the proposed change to simulate. Only one of the fields can be set.
+type SimulationRequest struct {
+	// ReleasePlan is the proposed ReleasePlan. If a ReleasePlan with the same name exists, it's replaced by this one
+	ReleasePlan *v1alpha1.ReleasePlan `json:"releasePlan,omitempty"`
+
+	// ReleasePlanAdmission is the proposed ReleasePlanAdmission. If a ReleasePlanAdmission with the same name exists,
+	// it's replaced by this one
+	ReleasePlanAdmission *v1alpha1.ReleasePlanAdmission `json:"releasePlanAdmission,omitempty"`
+}
+
+// SimulationResponse describes the ReleasePlans whose matching would change if the proposed change was applied.
+type SimulationResponse struct {
+	// Changes contains the ReleasePlans that would be matched to a different ReleasePlanAdmission
+	Changes []MatchChange `json:"changes"`
+
+	// Error contains the reason why the change couldn't be simulated
+	Error string `json:"error,omitempty"`
+}
+
+// MatchChange describes the change in the matching of a ReleasePlan.
+type MatchChange struct {
+	// After contains the namespaced name of the ReleasePlanAdmission matched after the change, if any
+	After string `json:"after,omitempty"`
+
+	// Application is the application released by the ReleasePlan
+	Application string `json:"application"`
+
+	// Before contains the namespaced name of the ReleasePlanAdmission matched before the change, if any
+	Before string `json:"before,omitempty"`
+
+	// ReleasePlan contains the namespaced name of the ReleasePlan
+	ReleasePlan string `json:"releasePlan"`
+
+	// Target is the namespace the ReleasePlan releases to
+	Target string `json:"target"`
+}
+
+// SimulationHandler is an http.Handler that evaluates a proposed ReleasePlan or ReleasePlanAdmission against the
+// existing ones and returns the ReleasePlans that would be matched to a different ReleasePlanAdmission, without
+// applying the change. The proposed object is passed as JSON in the body of a POST request.
+type SimulationHandler struct {
+	client client.Client
+	loader loader.ObjectLoader
+	log    logr.Logger
+}
+
+// NewSimulationHandler creates and returns a SimulationHandler using the given client and logger.
+func NewSimulationHandler(client client.Client, log logr.Logger) *SimulationHandler {
+	return &SimulationHandler{
+		client: client,
+		loader: loader.NewLoader(),
+		log:    log.WithName("simulation"),
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *SimulationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeResponse(w, http.StatusMethodNotAllowed, &SimulationResponse{Error: "only POST requests are supported"})
+		return
+	}
+
+	request := &SimulationRequest{}
+	err := json.NewDecoder(r.Body).Decode(request)
+	if err != nil {
+		h.writeResponse(w, http.StatusBadRequest, &SimulationResponse{Error: err.Error()})
+		return
+	}
+
+	var changes []MatchChange
+	switch {
+	case request.ReleasePlan != nil && request.ReleasePlanAdmission == nil && hasNamespacedName(request.ReleasePlan):
+		changes, err = h.simulateReleasePlan(r, request.ReleasePlan)
+	case request.ReleasePlanAdmission != nil && request.ReleasePlan == nil && hasNamespacedName(request.ReleasePlanAdmission):
+		changes, err = h.simulateReleasePlanAdmission(r, request.ReleasePlanAdmission)
+	default:
+		h.writeResponse(w, http.StatusBadRequest, &SimulationResponse{
+			Error: "either a releasePlan or a releasePlanAdmission with a name and namespace is required",
+		})
+		return
+	}
+	if err != nil {
+		h.writeResponse(w, http.StatusInternalServerError, &SimulationResponse{Error: err.Error()})
+		return
+	}
+
+	if changes == nil {
+		changes = []MatchChange{}
+	}
+	h.writeResponse(w, http.StatusOK, &SimulationResponse{Changes: changes})
+}
+
+// simulateReleasePlan returns the change in the matching of the given ReleasePlan compared to the existing
+// ReleasePlan with the same name, if any.
+func (h *SimulationHandler) simulateReleasePlan(r *http.Request, releasePlan *v1alpha1.ReleasePlan) ([]MatchChange, error) {
+	releasePlans, err := h.loader.GetReleasePlans(r.Context(), h.client, releasePlan.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var before string
+	for i := range releasePlans.Items {
+		if releasePlans.Items[i].Name != releasePlan.Name {
+			continue
+		}
+
+		before, err = h.getMatchingReleasePlanAdmission(r, &releasePlans.Items[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	after, err := h.getMatchingReleasePlanAdmission(r, releasePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	if before == after {
+		return nil, nil
+	}
+
+	return []MatchChange{newMatchChange(releasePlan, before, after)}, nil
+}
+
+// simulateReleasePlanAdmission returns the changes in the matching of the ReleasePlans in the origin namespaces of
+// the given ReleasePlanAdmission and the existing ReleasePlanAdmission with the same name, if any.
+func (h *SimulationHandler) simulateReleasePlanAdmission(r *http.Request, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) ([]MatchChange, error) {
+	releasePlanAdmissions, err := h.loader.GetReleasePlanAdmissions(r.Context(), h.client, releasePlanAdmission.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := []string{releasePlanAdmission.Spec.Origin}
+	proposed := []v1alpha1.ReleasePlanAdmission{*releasePlanAdmission}
+	for _, existingReleasePlanAdmission := range releasePlanAdmissions.Items {
+		if existingReleasePlanAdmission.Name == releasePlanAdmission.Name {
+			if !slices.Contains(origins, existingReleasePlanAdmission.Spec.Origin) {
+				origins = append(origins, existingReleasePlanAdmission.Spec.Origin)
+			}
+			continue
+		}
+		proposed = append(proposed, existingReleasePlanAdmission)
+	}
+
+	var changes []MatchChange
+	for _, origin := range origins {
+		releasePlans, err := h.loader.GetReleasePlans(r.Context(), h.client, origin)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range releasePlans.Items {
+			releasePlan := &releasePlans.Items[i]
+			if releasePlan.Spec.Target != releasePlanAdmission.Namespace {
+				continue
+			}
+
+			before := getMatchingReleasePlanAdmission(releasePlan, releasePlanAdmissions.Items)
+			after := getMatchingReleasePlanAdmission(releasePlan, proposed)
+			if before != after {
+				changes = append(changes, newMatchChange(releasePlan, before, after))
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// getMatchingReleasePlanAdmission returns the namespaced name of the ReleasePlanAdmission the given ReleasePlan
+// would be matched to among the existing ReleasePlanAdmissions in its target namespace.
+func (h *SimulationHandler) getMatchingReleasePlanAdmission(r *http.Request, releasePlan *v1alpha1.ReleasePlan) (string, error) {
+	if releasePlan.Spec.Target == "" {
+		return "", nil
+	}
+
+	releasePlanAdmissions, err := h.loader.GetReleasePlanAdmissions(r.Context(), h.client, releasePlan.Spec.Target)
+	if err != nil {
+		return "", err
+	}
+
+	return getMatchingReleasePlanAdmission(releasePlan, releasePlanAdmissions.Items), nil
+}
+
+// writeResponse writes the given SimulationResponse as JSON with the given status code.
+func (h *SimulationHandler) writeResponse(w http.ResponseWriter, status int, response *SimulationResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		h.log.Error(err, "unable to write response")
+	}
+}
+
+// getMatchingReleasePlanAdmission returns the namespaced name of the ReleasePlanAdmission among the given ones that
+// the given ReleasePlan would be matched to, following the same rules as the loader. An empty string is returned if
+// no ReleasePlanAdmission or more than one would match.
+func getMatchingReleasePlanAdmission(releasePlan *v1alpha1.ReleasePlan, releasePlanAdmissions []v1alpha1.ReleasePlanAdmission) string {
+	designatedReleasePlanAdmissionName := releasePlan.GetLabels()[metadata.ReleasePlanAdmissionLabel]
+
+	var found *v1alpha1.ReleasePlanAdmission
+	for i, releasePlanAdmission := range releasePlanAdmissions {
+		if releasePlanAdmission.Namespace != releasePlan.Spec.Target {
+			continue
+		}
+
+		if designatedReleasePlanAdmissionName != "" {
+			if releasePlanAdmission.Name == designatedReleasePlanAdmissionName {
+				found = &releasePlanAdmissions[i]
+				break
+			}
+			continue
+		}
+
+		if releasePlanAdmission.Spec.Origin != releasePlan.Namespace ||
+			!slices.Contains(releasePlanAdmission.Spec.Applications, releasePlan.Spec.Application) {
+			continue
+		}
+
+		if found != nil {
+			return ""
+		}
+		found = &releasePlanAdmissions[i]
+	}
+
+	if found == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%c%s", found.Namespace, types.Separator, found.Name)
+}
+
+// hasNamespacedName returns whether the given object has both a name and a namespace.
+func hasNamespacedName(object client.Object) bool {
+	return object.GetName() != "" && object.GetNamespace() != ""
+}
+
+// newMatchChange creates and returns a MatchChange for the given ReleasePlan.
+func newMatchChange(releasePlan *v1alpha1.ReleasePlan, before, after string) MatchChange {
+	return MatchChange{
+		After:       after,
+		Application: releasePlan.Spec.Application,
+		Before:      before,
+		ReleasePlan: fmt.Sprintf("%s%c%s", releasePlan.Namespace, types.Separator, releasePlan.Name),
+		Target:      releasePlan.Spec.Target,
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("SimulationHandler", func() {
+	var (
+		handler               *SimulationHandler
+		releasePlan           *v1alpha1.ReleasePlan
+		releasePlanAdmissions *v1alpha1.ReleasePlanAdmissionList
+		mockedContext         func() context.Context
+	)
+
+	serve := func(ctx context.Context, method string, body interface{}) (*httptest.ResponseRecorder, *SimulationResponse) {
+		data, err := json.Marshal(body)
+		Expect(err).NotTo(HaveOccurred())
+		request := httptest.NewRequest(method, SimulationPath, bytes.NewReader(data)).WithContext(ctx)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		response := &SimulationResponse{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+		return recorder, response
+	}
+
+	BeforeEach(func() {
+		handler = NewSimulationHandler(nil, ctrl.Log)
+		handler.loader = loader.NewMockLoader()
+
+		releasePlan = &v1alpha1.ReleasePlan{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "releaseplan",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.ReleasePlanSpec{
+				Application: "application",
+				Target:      "managed",
+			},
+		}
+		releasePlanAdmissions = &v1alpha1.ReleasePlanAdmissionList{
+			Items: []v1alpha1.ReleasePlanAdmission{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "releaseplanadmission",
+						Namespace: "managed",
+					},
+					Spec: v1alpha1.ReleasePlanAdmissionSpec{
+						Applications: []string{"application"},
+						Origin:       "default",
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-releaseplanadmission",
+						Namespace: "managed",
+					},
+					Spec: v1alpha1.ReleasePlanAdmissionSpec{
+						Applications: []string{"other-application"},
+						Origin:       "default",
+					},
+				},
+			},
+		}
+
+		mockedContext = func() context.Context {
+			return toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionsContextKey,
+					Resource:   releasePlanAdmissions,
+				},
+				{
+					ContextKey: loader.ReleasePlansContextKey,
+					Resource:   &v1alpha1.ReleasePlanList{Items: []v1alpha1.ReleasePlan{*releasePlan}},
+				},
+			})
+		}
+	})
+
+	It("should fail if the method is not POST", func() {
+		recorder, response := serve(context.TODO(), http.MethodGet, &SimulationRequest{})
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(response.Error).NotTo(BeEmpty())
+	})
+
+	It("should fail if no object is passed", func() {
+		recorder, response := serve(context.TODO(), http.MethodPost, &SimulationRequest{})
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(response.Error).To(ContainSubstring("is required"))
+	})
+
+	It("should fail if the object has no name", func() {
+		releasePlan.Name = ""
+		recorder, response := serve(context.TODO(), http.MethodPost, &SimulationRequest{ReleasePlan: releasePlan})
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(response.Error).To(ContainSubstring("name and namespace is required"))
+	})
+
+	It("should report the change in the matching of a proposed ReleasePlan", func() {
+		ctx := mockedContext()
+		proposed := releasePlan.DeepCopy()
+		proposed.Spec.Application = "other-application"
+
+		recorder, response := serve(ctx, http.MethodPost, &SimulationRequest{ReleasePlan: proposed})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(response.Changes).To(HaveLen(1))
+		Expect(response.Changes[0].ReleasePlan).To(Equal("default/releaseplan"))
+		Expect(response.Changes[0].Application).To(Equal("other-application"))
+		Expect(response.Changes[0].Before).To(Equal("managed/releaseplanadmission"))
+		Expect(response.Changes[0].After).To(Equal("managed/other-releaseplanadmission"))
+	})
+
+	It("should report no changes if the matching of the proposed ReleasePlan doesn't change", func() {
+		ctx := mockedContext()
+		proposed := releasePlan.DeepCopy()
+		proposed.Spec.Data = nil
+
+		recorder, response := serve(ctx, http.MethodPost, &SimulationRequest{ReleasePlan: proposed})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(response.Changes).To(BeEmpty())
+	})
+
+	It("should report the ReleasePlans that would no longer match a proposed ReleasePlanAdmission", func() {
+		ctx := mockedContext()
+		proposed := releasePlanAdmissions.Items[0].DeepCopy()
+		proposed.Spec.Applications = []string{"another-application"}
+
+		recorder, response := serve(ctx, http.MethodPost, &SimulationRequest{ReleasePlanAdmission: proposed})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(response.Changes).To(HaveLen(1))
+		Expect(response.Changes[0].ReleasePlan).To(Equal("default/releaseplan"))
+		Expect(response.Changes[0].Before).To(Equal("managed/releaseplanadmission"))
+		Expect(response.Changes[0].After).To(BeEmpty())
+	})
+
+	It("should report the ReleasePlans that would match more than one ReleasePlanAdmission", func() {
+		ctx := mockedContext()
+		proposed := releasePlanAdmissions.Items[0].DeepCopy()
+		proposed.Name = "new-releaseplanadmission"
+
+		recorder, response := serve(ctx, http.MethodPost, &SimulationRequest{ReleasePlanAdmission: proposed})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(response.Changes).To(HaveLen(1))
+		Expect(response.Changes[0].Before).To(Equal("managed/releaseplanadmission"))
+		Expect(response.Changes[0].After).To(BeEmpty())
+	})
+
+	It("should fail if the existing objects can't be loaded", func() {
+		ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+			{
+				ContextKey: loader.ReleasePlanAdmissionsContextKey,
+				Err:        fmt.Errorf("list failed"),
+			},
+		})
+
+		recorder, response := serve(ctx, http.MethodPost, &SimulationRequest{ReleasePlanAdmission: &releasePlanAdmissions.Items[0]})
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		Expect(response.Error).To(Equal("list failed"))
+	})
+})
@@ -0,0 +1,101 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// Decrypter is an interface to decrypt the values encrypted in the Release data, so they are only revealed to the
+// managed Pipeline and never stored in plain text in tenant-readable objects.
+type Decrypter interface {
+	Decrypt(ctx context.Context, value string) ([]byte, error)
+}
+
+// KeyUnwrapper is an interface to decrypt the data keys of the encrypted values using a key stored in a KMS.
+type KeyUnwrapper interface {
+	UnwrapKey(ctx context.Context, wrappedKey string) ([]byte, error)
+}
+
+// envelopeDecrypter decrypts envelope encrypted values using the data key unwrapped by a KMS.
+type envelopeDecrypter struct {
+	keyUnwrapper KeyUnwrapper
+}
+
+// unsupportedKMSSchemes contains the schemes of the KMS references that can't be used to decrypt the Release data.
+var unsupportedKMSSchemes = []string{"awskms", "azurekms", "gcpkms"}
+
+// NewDecrypter creates and returns the Decrypter matching the given KMS key reference, following the cosign
+// conventions. The hashivault scheme references a key in the Vault transit engine, reached with the address and token
+// set in the VAULT_ADDR and VAULT_TOKEN environment variables using the given http client.
+func NewDecrypter(keyRef string, httpClient *http.Client) (Decrypter, error) {
+	scheme, name, found := strings.Cut(keyRef, "://")
+	if !found {
+		return nil, fmt.Errorf("the key reference '%s' doesn't reference a KMS key", keyRef)
+	}
+
+	switch scheme {
+	case "hashivault":
+		vaultAddr := os.Getenv("VAULT_ADDR")
+		if vaultAddr == "" {
+			return nil, fmt.Errorf("the VAULT_ADDR environment variable is required to decrypt with %s", keyRef)
+		}
+
+		vaultUrl, err := url.Parse(vaultAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEnvelopeDecrypter(NewVaultKeyUnwrapper(vaultUrl, name, os.Getenv("VAULT_TOKEN"), httpClient)), nil
+	default:
+		for _, unsupportedScheme := range unsupportedKMSSchemes {
+			if scheme == unsupportedScheme {
+				return nil, fmt.Errorf("the %s KMS is not supported to decrypt the Release data", scheme)
+			}
+		}
+
+		return nil, fmt.Errorf("unsupported encryption key reference scheme '%s'", scheme)
+	}
+}
+
+// NewEnvelopeDecrypter creates and returns a Decrypter opening the envelopes of the encrypted values with the data key
+// unwrapped by the given KeyUnwrapper.
+func NewEnvelopeDecrypter(keyUnwrapper KeyUnwrapper) Decrypter {
+	return &envelopeDecrypter{
+		keyUnwrapper: keyUnwrapper,
+	}
+}
+
+// Decrypt parses the envelope of the given value, unwraps its data key and returns the decrypted value.
+func (d *envelopeDecrypter) Decrypt(ctx context.Context, value string) ([]byte, error) {
+	envelope, err := ParseEnvelope(value)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := d.keyUnwrapper.UnwrapKey(ctx, envelope.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return envelope.Open(dataKey)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockKeyUnwrapper returns the same data key for every wrapped key, failing if it doesn't match the expected one.
+type mockKeyUnwrapper struct {
+	dataKey    []byte
+	wrappedKey string
+}
+
+func (u *mockKeyUnwrapper) UnwrapKey(_ context.Context, wrappedKey string) ([]byte, error) {
+	if wrappedKey != u.wrappedKey {
+		return nil, fmt.Errorf("unknown wrapped key")
+	}
+
+	return u.dataKey, nil
+}
+
+var _ = Describe("Encryption", func() {
+	When("NewDecrypter is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("VAULT_ADDR")
+		})
+
+		It("should return an envelope decrypter using vault for hashivault references", func() {
+			os.Setenv("VAULT_ADDR", "https://vault")
+			decrypter, err := NewDecrypter("hashivault://release", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypter).To(BeAssignableToTypeOf(&envelopeDecrypter{}))
+			Expect(decrypter.(*envelopeDecrypter).keyUnwrapper.(*vaultKeyUnwrapper).keyName).To(Equal("release"))
+		})
+
+		It("should fail for hashivault references if the vault address is not set", func() {
+			_, err := NewDecrypter("hashivault://release", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("VAULT_ADDR"))
+		})
+
+		It("should fail for unsupported KMS references", func() {
+			_, err := NewDecrypter("gcpkms://projects/release/keys/release", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("gcpkms KMS is not supported"))
+		})
+
+		It("should fail for references without a scheme", func() {
+			_, err := NewDecrypter("/path/to/key", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't reference a KMS key"))
+		})
+	})
+
+	When("an envelope decrypter is used", func() {
+		dataKey := make([]byte, 32)
+
+		It("should decrypt the value with the unwrapped data key", func() {
+			value, err := SealEnvelope(dataKey, "vault:v1:key", []byte("secret"))
+			Expect(err).NotTo(HaveOccurred())
+
+			decrypter := NewEnvelopeDecrypter(&mockKeyUnwrapper{dataKey: dataKey, wrappedKey: "vault:v1:key"})
+			Expect(decrypter.Decrypt(context.TODO(), value)).To(Equal([]byte("secret")))
+		})
+
+		It("should fail if the data key can't be unwrapped", func() {
+			value, err := SealEnvelope(dataKey, "vault:v1:other", []byte("secret"))
+			Expect(err).NotTo(HaveOccurred())
+
+			decrypter := NewEnvelopeDecrypter(&mockKeyUnwrapper{dataKey: dataKey, wrappedKey: "vault:v1:key"})
+			_, err = decrypter.Decrypt(context.TODO(), value)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown wrapped key"))
+		})
+
+		It("should fail if the value is not an envelope", func() {
+			decrypter := NewEnvelopeDecrypter(&mockKeyUnwrapper{dataKey: dataKey})
+			_, err := decrypter.Decrypt(context.TODO(), "secret")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,116 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// envelopePrefix is the prefix of the envelope encrypted values. It's followed by the base64 encoded data key wrapped
+// by the KMS and the base64 encoded AES-GCM nonce and ciphertext, separated by a colon.
+const envelopePrefix = "envelope:v1:"
+
+// Envelope is a value encrypted with a data key that is itself encrypted (wrapped) by a key stored in a KMS.
+type Envelope struct {
+	// WrappedKey is the data key as encrypted by the KMS
+	WrappedKey string
+
+	// Ciphertext is the AES-GCM nonce followed by the value encrypted with the data key
+	Ciphertext []byte
+}
+
+// ParseEnvelope parses the given envelope encrypted value. An error is returned if the value is not a well-formed
+// envelope.
+func ParseEnvelope(value string) (*Envelope, error) {
+	encoded, found := strings.CutPrefix(value, envelopePrefix)
+	if !found {
+		return nil, fmt.Errorf("the encrypted value doesn't start with %s", envelopePrefix)
+	}
+
+	encodedKey, encodedCiphertext, found := strings.Cut(encoded, ":")
+	if !found || encodedKey == "" || encodedCiphertext == "" {
+		return nil, fmt.Errorf("the encrypted value must contain the wrapped data key and the ciphertext")
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("the wrapped data key of the encrypted value is not base64 encoded: %w", err)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encodedCiphertext)
+	if err != nil {
+		return nil, fmt.Errorf("the ciphertext of the encrypted value is not base64 encoded: %w", err)
+	}
+
+	return &Envelope{
+		WrappedKey: string(wrappedKey),
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+// SealEnvelope encrypts the given value with the given data key and returns the envelope including the given wrapped
+// version of the data key, so it can be decrypted by anyone allowed to use the KMS key that wrapped it.
+func SealEnvelope(dataKey []byte, wrappedKey string, value []byte) (string, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return envelopePrefix + base64.StdEncoding.EncodeToString([]byte(wrappedKey)) + ":" +
+		base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, value, nil)), nil
+}
+
+// Open decrypts the envelope ciphertext with the given data key, which must be the unwrapped version of the envelope
+// wrapped key.
+func (e *Envelope) Open(dataKey []byte) ([]byte, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(e.Ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("the ciphertext of the encrypted value is too short")
+	}
+
+	nonce, ciphertext := e.Ciphertext[:gcm.NonceSize()], e.Ciphertext[gcm.NonceSize():]
+	value, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the encrypted value: %w", err)
+	}
+
+	return value, nil
+}
+
+// newGCM returns an AES-GCM cipher using the given data key.
+func newGCM(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
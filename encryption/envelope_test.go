@@ -0,0 +1,78 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Envelope", func() {
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+
+	It("should open a sealed envelope with the same data key", func() {
+		value, err := SealEnvelope(dataKey, "vault:v1:key", []byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(HavePrefix(envelopePrefix))
+
+		envelope, err := ParseEnvelope(value)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(envelope.WrappedKey).To(Equal("vault:v1:key"))
+		Expect(envelope.Open(dataKey)).To(Equal([]byte("secret")))
+	})
+
+	It("should fail to open an envelope with another data key", func() {
+		value, err := SealEnvelope(dataKey, "vault:v1:key", []byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+
+		envelope, err := ParseEnvelope(value)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = envelope.Open([]byte("fedcba9876543210fedcba9876543210"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to decrypt"))
+	})
+
+	It("should fail to open an envelope with a truncated ciphertext", func() {
+		envelope := &Envelope{WrappedKey: "vault:v1:key", Ciphertext: []byte("short")}
+		_, err := envelope.Open(dataKey)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("too short"))
+	})
+
+	It("should fail to seal a value with an invalid data key", func() {
+		_, err := SealEnvelope([]byte("short"), "vault:v1:key", []byte("secret"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to parse values that are not envelopes", func() {
+		_, err := ParseEnvelope("secret")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("doesn't start with"))
+
+		_, err = ParseEnvelope(envelopePrefix + "a2V5")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must contain the wrapped data key and the ciphertext"))
+
+		_, err = ParseEnvelope(envelopePrefix + "!!!:a2V5")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("wrapped data key"))
+
+		_, err = ParseEnvelope(envelopePrefix + "a2V5:!!!")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ciphertext"))
+	})
+})
@@ -0,0 +1,98 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// vaultKeyUnwrapper decrypts data keys using a key stored in the Vault transit secrets engine, so the key encrypting
+// them never leaves Vault.
+type vaultKeyUnwrapper struct {
+	httpClient *http.Client
+	keyName    string
+	token      string
+	vaultUrl   *url.URL
+}
+
+// vaultDecryptRequest is the body sent to the Vault transit decrypt endpoint.
+type vaultDecryptRequest struct {
+	Ciphertext string `json:"ciphertext"`
+}
+
+// vaultDecryptResponse is the body returned by the Vault transit decrypt endpoint.
+type vaultDecryptResponse struct {
+	Data struct {
+		Plaintext string `json:"plaintext"`
+	} `json:"data"`
+}
+
+// NewVaultKeyUnwrapper creates and returns a KeyUnwrapper using the transit key with the given name in the Vault server
+// at the given url. The given token is sent in every request to authenticate with Vault.
+func NewVaultKeyUnwrapper(vaultUrl *url.URL, keyName, token string, httpClient *http.Client) KeyUnwrapper {
+	return &vaultKeyUnwrapper{
+		httpClient: httpClient,
+		keyName:    keyName,
+		token:      token,
+		vaultUrl:   vaultUrl,
+	}
+}
+
+// UnwrapKey sends the given wrapped data key (e.g. vault:v1:<ciphertext>) to Vault to be decrypted and returns the
+// decoded data key.
+func (u *vaultKeyUnwrapper) UnwrapKey(ctx context.Context, wrappedKey string) ([]byte, error) {
+	data, err := json.Marshal(&vaultDecryptRequest{
+		Ciphertext: wrappedKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		u.vaultUrl.JoinPath("v1", "transit", "decrypt", u.keyName).String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if u.token != "" {
+		request.Header.Set("X-Vault-Token", u.token)
+	}
+
+	response, err := u.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status %d decrypting with vault key %s", response.StatusCode, u.keyName)
+	}
+
+	decryptResponse := &vaultDecryptResponse{}
+	err = json.NewDecoder(response.Body).Decode(decryptResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(decryptResponse.Data.Plaintext)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Vault key unwrapper", func() {
+	It("should decrypt the wrapped data key with the transit key", func() {
+		var path, token string
+		request := &vaultDecryptRequest{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path, token = r.URL.Path, r.Header.Get("X-Vault-Token")
+			Expect(json.NewDecoder(r.Body).Decode(request)).To(Succeed())
+			_, _ = w.Write([]byte(`{"data":{"plaintext":"` + base64.StdEncoding.EncodeToString([]byte("key")) + `"}}`))
+		}))
+		defer server.Close()
+
+		vaultUrl, _ := url.Parse(server.URL)
+		keyUnwrapper := NewVaultKeyUnwrapper(vaultUrl, "release", "token", server.Client())
+
+		dataKey, err := keyUnwrapper.UnwrapKey(context.TODO(), "vault:v1:wrapped")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dataKey).To(Equal([]byte("key")))
+		Expect(path).To(Equal("/v1/transit/decrypt/release"))
+		Expect(token).To(Equal("token"))
+		Expect(request.Ciphertext).To(Equal("vault:v1:wrapped"))
+	})
+
+	It("should fail if vault returns an error", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		vaultUrl, _ := url.Parse(server.URL)
+		keyUnwrapper := NewVaultKeyUnwrapper(vaultUrl, "release", "", server.Client())
+
+		_, err := keyUnwrapper.UnwrapKey(context.TODO(), "vault:v1:wrapped")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status 403"))
+	})
+})
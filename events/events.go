@@ -0,0 +1,124 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+)
+
+// Version is the version of the schemas of the event payloads. Within a version, fields are only ever added to the
+// payloads, so they can always be unmarshalled with the types of the version they declare.
+const Version = "v1"
+
+// Type is the type of an event concerning a Release.
+type Type string
+
+const (
+	// ReleaseStartedType is the type of the events sent when a Release starts being processed
+	ReleaseStartedType Type = "release.started"
+
+	// ReleaseSucceededType is the type of the events sent when a Release succeeds
+	ReleaseSucceededType Type = "release.succeeded"
+
+	// ReleaseFailedType is the type of the events sent when a Release fails
+	ReleaseFailedType Type = "release.failed"
+)
+
+// Types contains every type of event concerning a Release.
+var Types = []Type{ReleaseStartedType, ReleaseSucceededType, ReleaseFailedType}
+
+// ReleaseEvent is the payload of the events concerning a Release.
+type ReleaseEvent struct {
+	// Version is the version of the schema of the payload
+	Version string `json:"version"`
+
+	// Type is the type of the event
+	Type Type `json:"type"`
+
+	// Namespace is the namespace of the Release
+	Namespace string `json:"namespace"`
+
+	// Release is the name of the Release
+	Release string `json:"release"`
+
+	// ReleasePlan is the name of the ReleasePlan used by the Release
+	ReleasePlan string `json:"releasePlan"`
+
+	// Snapshot is the name of the Snapshot released by the Release
+	Snapshot string `json:"snapshot"`
+
+	// Target is the namespace the Release is processed in
+	Target string `json:"target,omitempty"`
+
+	// Author is the user the Release is attributed to
+	Author string `json:"author,omitempty"`
+
+	// Automated indicates whether the Release was created automatically
+	Automated bool `json:"automated,omitempty"`
+
+	// Phase is the overall phase of the Release
+	Phase string `json:"phase,omitempty"`
+
+	// Message is a human-readable message describing the phase of the Release
+	Message string `json:"message,omitempty"`
+
+	// StartTime is the time the Release started being processed
+	StartTime *time.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the Release finished
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+}
+
+// NewReleaseEvent creates and returns the payload of the event matching the current phase of the given Release. The
+// returned boolean is false if the phase doesn't match any type of event, e.g. when the Release is superseded.
+func NewReleaseEvent(release *v1alpha1.Release) (*ReleaseEvent, bool) {
+	var eventType Type
+	switch {
+	case release.IsReleased():
+		eventType = ReleaseSucceededType
+	case release.IsFailed():
+		eventType = ReleaseFailedType
+	case release.IsReleasing():
+		eventType = ReleaseStartedType
+	default:
+		return nil, false
+	}
+
+	event := &ReleaseEvent{
+		Version:     Version,
+		Type:        eventType,
+		Namespace:   release.Namespace,
+		Release:     release.Name,
+		ReleasePlan: release.Spec.ReleasePlan,
+		Snapshot:    release.Spec.Snapshot,
+		Target:      release.Status.Target,
+		Author:      release.Status.Attribution.Author,
+		Automated:   release.Status.Automated,
+		Phase:       string(release.Status.Summary.Phase),
+		Message:     release.Status.Summary.Message,
+	}
+	if release.Status.StartTime != nil {
+		event.StartTime = &release.Status.StartTime.Time
+	}
+	if release.Status.CompletionTime != nil {
+		event.CompletionTime = &release.Status.CompletionTime.Time
+	}
+
+	return event, true
+}
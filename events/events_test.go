@@ -0,0 +1,103 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Events", func() {
+	var release *v1alpha1.Release
+
+	BeforeEach(func() {
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: "releaseplan",
+				Snapshot:    "snapshot",
+			},
+		}
+	})
+
+	When("NewReleaseEvent is called", func() {
+		It("should return false if the Release didn't start", func() {
+			_, ok := NewReleaseEvent(release)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return a release.started event for running Releases", func() {
+			release.MarkReleasing("")
+
+			event, ok := NewReleaseEvent(release)
+			Expect(ok).To(BeTrue())
+			Expect(event.Type).To(Equal(ReleaseStartedType))
+			Expect(event.Version).To(Equal(Version))
+			Expect(event.Namespace).To(Equal("default"))
+			Expect(event.Release).To(Equal("release"))
+			Expect(event.ReleasePlan).To(Equal("releaseplan"))
+			Expect(event.Snapshot).To(Equal("snapshot"))
+		})
+
+		It("should return a release.succeeded event for released Releases", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+
+			event, ok := NewReleaseEvent(release)
+			Expect(ok).To(BeTrue())
+			Expect(event.Type).To(Equal(ReleaseSucceededType))
+			Expect(event.CompletionTime).NotTo(BeNil())
+		})
+
+		It("should return a release.failed event for failed Releases", func() {
+			release.MarkReleasing("")
+			release.MarkReleaseFailed("foo")
+
+			event, ok := NewReleaseEvent(release)
+			Expect(ok).To(BeTrue())
+			Expect(event.Type).To(Equal(ReleaseFailedType))
+			Expect(event.Phase).To(Equal(string(v1alpha1.ReleasePhaseFailed)))
+		})
+
+		It("should return false for superseded Releases", func() {
+			release.MarkReleasing("")
+			release.MarkSuperseded("foo")
+
+			_, ok := NewReleaseEvent(release)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should marshal the optional fields only when they are set", func() {
+			release.MarkReleasing("")
+
+			event, _ := NewReleaseEvent(release)
+			data, err := json.Marshal(event)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring("completionTime"))
+			Expect(string(data)).To(ContainSubstring(`"type":"release.started"`))
+		})
+	})
+})
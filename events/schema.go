@@ -0,0 +1,102 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// schemaDialect is the JSON Schema dialect the generated schemas conform to.
+const schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// GenerateSchema returns the indented JSON Schema of the payload of the events of the given type. The schema is
+// generated from the ReleaseEvent type, so it always matches the payloads that are sent.
+func GenerateSchema(eventType Type) ([]byte, error) {
+	schema := getTypeSchema(reflect.TypeOf(ReleaseEvent{}))
+	schema["$schema"] = schemaDialect
+	schema["title"] = string(eventType)
+	schema["description"] = fmt.Sprintf("Payload of the %s events, version %s", eventType, Version)
+
+	properties := schema["properties"].(map[string]any)
+	properties["type"].(map[string]any)["const"] = string(eventType)
+	properties["version"].(map[string]any)["const"] = Version
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// GetSchemaFileName returns the name of the file the schema of the payload of the events of the given type is
+// published in.
+func GetSchemaFileName(eventType Type) string {
+	return string(eventType) + ".json"
+}
+
+// getTypeSchema returns the JSON Schema of the given Go type. Struct fields are named after their json tag and are
+// required unless they are tagged with omitempty.
+func getTypeSchema(t reflect.Type) map[string]any {
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return getTypeSchema(t.Elem())
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": getTypeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": getTypeSchema(t.Elem())}
+	case reflect.Struct:
+		properties := map[string]any{}
+		required := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+
+			properties[name] = getTypeSchema(field.Type)
+			if !strings.Contains(options, "omitempty") {
+				required = append(required, name)
+			}
+		}
+
+		return map[string]any{"type": "object", "properties": properties, "required": required}
+	}
+
+	return map[string]any{}
+}
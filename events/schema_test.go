@@ -0,0 +1,65 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schema", func() {
+	When("GenerateSchema is called", func() {
+		It("should set the event type and version as constants", func() {
+			data, err := GenerateSchema(ReleaseFailedType)
+			Expect(err).NotTo(HaveOccurred())
+
+			var schema map[string]any
+			Expect(json.Unmarshal(data, &schema)).To(Succeed())
+			Expect(schema["title"]).To(Equal("release.failed"))
+
+			properties := schema["properties"].(map[string]any)
+			Expect(properties["type"]).To(HaveKeyWithValue("const", "release.failed"))
+			Expect(properties["version"]).To(HaveKeyWithValue("const", Version))
+			Expect(properties["startTime"]).To(HaveKeyWithValue("format", "date-time"))
+		})
+
+		It("should only require the fields that are always set", func() {
+			data, err := GenerateSchema(ReleaseStartedType)
+			Expect(err).NotTo(HaveOccurred())
+
+			var schema map[string]any
+			Expect(json.Unmarshal(data, &schema)).To(Succeed())
+			Expect(schema["required"]).To(ConsistOf("version", "type", "namespace", "release", "releasePlan", "snapshot"))
+		})
+
+		It("should match the published schemas", func() {
+			for _, eventType := range Types {
+				data, err := GenerateSchema(eventType)
+				Expect(err).NotTo(HaveOccurred())
+
+				published, err := os.ReadFile(filepath.Join("schemas", Version, GetSchemaFileName(eventType)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(data)).To(Equal(string(published)),
+					"the published schemas are outdated, run 'make event-schemas' to regenerate them")
+			}
+		})
+	})
+})
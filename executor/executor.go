@@ -0,0 +1,100 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TektonExecutorName is the name of the default Executor, which runs the Release pipelines as Tekton PipelineRuns
+const TektonExecutorName = "tekton"
+
+// Executor defines the interface of the backends executing the Release pipelines. The pipelines are described as
+// Tekton PipelineRuns, which backends for environments without Tekton (e.g. Argo Workflows or a remote job API) are
+// expected to translate into their own resources. Releases are tracked through the PipelineRuns, so backends are also
+// expected to create them and to keep their status up to date.
+type Executor interface {
+	// Execute creates the given PipelineRun and starts its execution
+	Execute(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun) error
+}
+
+var (
+	// registry contains the registered executors indexed by name
+	registry = map[string]Executor{
+		TektonExecutorName: &tektonExecutor{},
+	}
+
+	// registryMutex guards the registry, as executors can be registered from the init functions of several packages
+	registryMutex sync.RWMutex
+)
+
+// Register registers the given executor with the given name, so ReleasePlanAdmissions can select it. It panics if an
+// executor with the same name was already registered, as it's a programming error of the distribution.
+func Register(name string, executor Executor) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, found := registry[name]; found {
+		panic(fmt.Sprintf("release pipeline executor %s registered twice", name))
+	}
+
+	registry[name] = executor
+}
+
+// Get returns the executor registered with the given name, defaulting to the Tekton executor if no name is given. An
+// error listing the registered executors is returned if no executor was registered with the given name.
+func Get(name string) (Executor, error) {
+	if name == "" {
+		name = TektonExecutorName
+	}
+
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	executor, found := registry[name]
+	if !found {
+		return nil, fmt.Errorf("unknown release pipeline executor %s, the available executors are %v",
+			name, getNames())
+	}
+
+	return executor, nil
+}
+
+// getNames returns the sorted names of the registered executors. The registry is expected to be locked already.
+func getNames() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// tektonExecutor is an Executor creating the PipelineRuns in the cluster, so Tekton executes them.
+type tektonExecutor struct{}
+
+// Execute creates the given PipelineRun.
+func (e *tektonExecutor) Execute(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun) error {
+	return cli.Create(ctx, pipelineRun)
+}
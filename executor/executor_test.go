@@ -0,0 +1,69 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// noopExecutor is an Executor that doesn't execute anything.
+type noopExecutor struct{}
+
+func (e *noopExecutor) Execute(_ context.Context, _ client.Client, _ *tektonv1.PipelineRun) error {
+	return nil
+}
+
+var _ = Describe("Executor", func() {
+	AfterEach(func() {
+		registryMutex.Lock()
+		delete(registry, "noop")
+		registryMutex.Unlock()
+	})
+
+	When("Get is called", func() {
+		It("should return the Tekton executor if no name is given", func() {
+			executor, err := Get("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(executor).To(BeAssignableToTypeOf(&tektonExecutor{}))
+		})
+
+		It("should return the executor registered with the given name", func() {
+			Register("noop", &noopExecutor{})
+
+			executor, err := Get("noop")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(executor).To(BeAssignableToTypeOf(&noopExecutor{}))
+		})
+
+		It("should fail listing the available executors if the name is unknown", func() {
+			_, err := Get("argo")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the available executors are [tekton]"))
+		})
+	})
+
+	When("Register is called", func() {
+		It("should panic if an executor with the same name was already registered", func() {
+			Expect(func() { Register(TektonExecutorName, &noopExecutor{}) }).To(Panic())
+		})
+	})
+})
@@ -0,0 +1,75 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrails
+
+import (
+	"context"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// guardedClient is a client.Client reading from the cache unless the Monitor degraded the reads, in which case they are
+// served directly by the API server. Writes are always sent to the API server.
+type guardedClient struct {
+	client.Client
+	direct  client.Reader
+	monitor *Monitor
+}
+
+// NewClient creates and returns a client.Client whose reads are served directly by the API server while the Monitor
+// is degraded. It matches the client.NewClientFunc signature, so it can be set as the NewClient function of a manager.
+func (m *Monitor) NewClient(config *rest.Config, options client.Options) (client.Client, error) {
+	cachedClient, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	options.Cache = nil
+	directClient, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &guardedClient{
+		Client:  cachedClient,
+		direct:  directClient,
+		monitor: m,
+	}, nil
+}
+
+// Get retrieves the object with the given key from the cache, or from the API server while the Monitor is degraded.
+func (c *guardedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if c.monitor.IsDegraded() {
+		return c.direct.Get(ctx, key, obj, opts...)
+	}
+
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves the list of objects matching the given options from the cache, or from the API server while the
+// Monitor is degraded. Lists selecting objects by field keep using the cache, as the fields indexed by the cache can't
+// be selected by the API server.
+func (c *guardedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOptions := &client.ListOptions{}
+	listOptions.ApplyOptions(opts)
+	if c.monitor.IsDegraded() && (listOptions.FieldSelector == nil || listOptions.FieldSelector.Empty()) {
+		return c.direct.List(ctx, list, opts...)
+	}
+
+	return c.Client.List(ctx, list, opts...)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrails
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metrics"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// cacheFilteringHint is the action suggested when the cache holds too many objects.
+const cacheFilteringHint = "enable cache filtering with the --scoped-cache or --namespaces flags"
+
+// monitoredObject describes a kind whose cached objects are counted.
+type monitoredObject struct {
+	kind    string
+	newList func() client.ObjectList
+}
+
+// monitoredObjects contains the kinds whose cached objects are counted. They are all watched by the controllers, so
+// counting them doesn't start informers that wouldn't be started otherwise.
+var monitoredObjects = []monitoredObject{
+	{kind: "PipelineRun", newList: func() client.ObjectList { return &tektonv1.PipelineRunList{} }},
+	{kind: "Release", newList: func() client.ObjectList { return &v1alpha1.ReleaseList{} }},
+	{kind: "ReleasePlan", newList: func() client.ObjectList { return &v1alpha1.ReleasePlanList{} }},
+	{kind: "ReleasePlanAdmission", newList: func() client.ObjectList { return &v1alpha1.ReleasePlanAdmissionList{} }},
+}
+
+// Options configures the thresholds checked by a Monitor.
+type Options struct {
+	// CachedObjectsLimit is the number of cached objects above which the reads are served directly by the API server.
+	// The reads are never degraded if it's 0
+	CachedObjectsLimit int
+
+	// CachedObjectsWarningThreshold is the number of cached objects above which a warning is logged. No warning is
+	// logged if it's 0
+	CachedObjectsWarningThreshold int
+
+	// Interval is the time between checks
+	Interval time.Duration
+
+	// MemoryWarningThreshold is the resident memory in bytes above which a warning is logged. No warning is logged if
+	// it's 0
+	MemoryWarningThreshold int64
+}
+
+// Monitor periodically counts the objects held in the informer cache and measures the resident memory of the
+// controller, exporting them as metrics and logging a warning when they cross their thresholds. When the number of
+// cached objects crosses the hard limit, the clients created by the Monitor read directly from the API server until it
+// goes back under it.
+type Monitor struct {
+	cache   client.Reader
+	logger  logr.Logger
+	options Options
+
+	cachedObjectsWarned bool
+	degraded            atomic.Bool
+	memoryWarned        bool
+}
+
+var _ manager.LeaderElectionRunnable = &Monitor{}
+
+// NewMonitor creates and returns a new Monitor checking the given thresholds and logging with the given logger.
+func NewMonitor(options Options, logger logr.Logger) *Monitor {
+	return &Monitor{
+		logger:  logger,
+		options: options,
+	}
+}
+
+// SetCache sets the informer cache whose objects are counted. It has to be set before the Monitor is started.
+func (m *Monitor) SetCache(cache client.Reader) {
+	m.cache = cache
+}
+
+// IsDegraded checks whether the reads are served directly by the API server.
+func (m *Monitor) IsDegraded() bool {
+	return m.degraded.Load()
+}
+
+// NeedLeaderElection returns false, as every replica has to monitor its own cache and memory.
+func (m *Monitor) NeedLeaderElection() bool {
+	return false
+}
+
+// Start checks the thresholds every interval until the given context is done.
+func (m *Monitor) Start(ctx context.Context) error {
+	if m.cache == nil {
+		return fmt.Errorf("the guardrails monitor has no cache to check")
+	}
+
+	ticker := time.NewTicker(m.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Check(ctx); err != nil {
+			m.logger.Error(err, "Failed to check the guardrails")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check counts the cached objects and measures the resident memory once, registering them in the metrics, logging a
+// warning for each threshold crossed since the last check and degrading or restoring the cached reads.
+func (m *Monitor) Check(ctx context.Context) error {
+	cachedObjects := 0
+	for _, object := range monitoredObjects {
+		list := object.newList()
+		err := m.cache.List(ctx, list, client.UnsafeDisableDeepCopy)
+		if err != nil {
+			return err
+		}
+
+		count := meta.LenList(list)
+		metrics.RegisterCachedObjects(object.kind, count)
+		cachedObjects += count
+	}
+
+	if m.options.CachedObjectsWarningThreshold > 0 {
+		exceeded := cachedObjects > m.options.CachedObjectsWarningThreshold
+		if exceeded && !m.cachedObjectsWarned {
+			m.logger.Info("The cache holds more objects than the warning threshold, "+cacheFilteringHint,
+				"CachedObjects", cachedObjects, "Threshold", m.options.CachedObjectsWarningThreshold)
+		}
+		m.cachedObjectsWarned = exceeded
+	}
+
+	if m.options.CachedObjectsLimit > 0 {
+		exceeded := cachedObjects > m.options.CachedObjectsLimit
+		if exceeded != m.degraded.Load() {
+			if exceeded {
+				m.logger.Info("The cache holds more objects than the limit, reading directly from the API server "+
+					"until it goes back under it. To keep using the cache, "+cacheFilteringHint,
+					"CachedObjects", cachedObjects, "Limit", m.options.CachedObjectsLimit)
+			} else {
+				m.logger.Info("The cache holds fewer objects than the limit, reading from the cache again",
+					"CachedObjects", cachedObjects, "Limit", m.options.CachedObjectsLimit)
+			}
+			m.degraded.Store(exceeded)
+		}
+	}
+	metrics.RegisterCacheDegraded(m.degraded.Load())
+
+	residentMemory := getResidentMemory()
+	metrics.RegisterMemoryResident(residentMemory)
+	if m.options.MemoryWarningThreshold > 0 {
+		exceeded := residentMemory > m.options.MemoryWarningThreshold
+		if exceeded && !m.memoryWarned {
+			m.logger.Info("The resident memory is above the warning threshold, "+cacheFilteringHint+
+				" or raise the memory limit of the controller",
+				"ResidentBytes", residentMemory, "Threshold", m.options.MemoryWarningThreshold)
+		}
+		m.memoryWarned = exceeded
+	}
+
+	return nil
+}
+
+// getResidentMemory returns the resident memory of the process in bytes, as reported by /proc/self/statm. On systems
+// without it, the memory obtained by the Go runtime from the operating system is returned instead.
+func getResidentMemory() int64 {
+	data, err := os.ReadFile("/proc/self/statm")
+	if err == nil {
+		fields := strings.Fields(string(data))
+		var pages int64
+		if len(fields) > 1 {
+			if _, err = fmt.Sscan(fields[1], &pages); err == nil {
+				return pages * int64(os.Getpagesize())
+			}
+		}
+	}
+
+	memStats := &runtime.MemStats{}
+	runtime.ReadMemStats(memStats)
+
+	return int64(memStats.Sys)
+}
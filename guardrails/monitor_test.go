@@ -0,0 +1,131 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrails
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// failingReader is a client.Reader failing every read, standing for a cache that can't be used.
+type failingReader struct{}
+
+func (r failingReader) Get(_ context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return fmt.Errorf("cache read")
+}
+
+func (r failingReader) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return fmt.Errorf("cache read")
+}
+
+var _ = Describe("Monitor", func() {
+	var pipelineRuns []*tektonv1.PipelineRun
+
+	AfterEach(func() {
+		for _, pipelineRun := range pipelineRuns {
+			_ = k8sClient.Delete(ctx, pipelineRun)
+		}
+	})
+
+	BeforeEach(func() {
+		pipelineRuns = nil
+		for i := 0; i < 2; i++ {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    testNamespace,
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			pipelineRuns = append(pipelineRuns, pipelineRun)
+		}
+	})
+
+	When("Check is called", func() {
+		It("registers the number of cached objects and the resident memory", func() {
+			monitor := NewMonitor(Options{}, logr.Discard())
+			monitor.SetCache(k8sClient)
+
+			Expect(monitor.Check(ctx)).To(Succeed())
+			Expect(testutil.ToFloat64(metrics.CachedObjects.WithLabelValues("PipelineRun"))).To(Equal(float64(2)))
+			Expect(testutil.ToFloat64(metrics.MemoryResidentBytes)).To(BeNumerically(">", 0))
+			Expect(monitor.IsDegraded()).To(BeFalse())
+		})
+
+		It("degrades the reads when the cache holds more objects than the limit", func() {
+			monitor := NewMonitor(Options{CachedObjectsLimit: 1}, logr.Discard())
+			monitor.SetCache(k8sClient)
+
+			Expect(monitor.Check(ctx)).To(Succeed())
+			Expect(monitor.IsDegraded()).To(BeTrue())
+			Expect(testutil.ToFloat64(metrics.CacheDegraded)).To(Equal(float64(1)))
+		})
+
+		It("restores the reads when the cache goes back under the limit", func() {
+			monitor := NewMonitor(Options{CachedObjectsLimit: 1}, logr.Discard())
+			monitor.SetCache(k8sClient)
+			Expect(monitor.Check(ctx)).To(Succeed())
+			Expect(monitor.IsDegraded()).To(BeTrue())
+
+			Expect(k8sClient.Delete(ctx, pipelineRuns[0])).To(Succeed())
+			Expect(monitor.Check(ctx)).To(Succeed())
+			Expect(monitor.IsDegraded()).To(BeFalse())
+			Expect(testutil.ToFloat64(metrics.CacheDegraded)).To(Equal(float64(0)))
+		})
+
+		It("fails if the cached objects can't be counted", func() {
+			monitor := NewMonitor(Options{}, logr.Discard())
+			monitor.SetCache(failingReader{})
+
+			Expect(monitor.Check(ctx)).NotTo(Succeed())
+		})
+	})
+
+	When("NewClient is called", func() {
+		It("returns a client reading from the cache unless the reads are degraded", func() {
+			monitor := NewMonitor(Options{CachedObjectsLimit: 1}, logr.Discard())
+			monitor.SetCache(k8sClient)
+
+			guardedClient, err := monitor.NewClient(cfg, client.Options{
+				Cache:  &client.CacheOptions{Reader: failingReader{}},
+				Scheme: scheme.Scheme,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun := &tektonv1.PipelineRun{}
+			key := client.ObjectKeyFromObject(pipelineRuns[0])
+			Expect(guardedClient.Get(ctx, key, pipelineRun)).NotTo(Succeed())
+
+			Expect(monitor.Check(ctx)).To(Succeed())
+			Expect(guardedClient.Get(ctx, key, pipelineRun)).To(Succeed())
+			Expect(guardedClient.List(ctx, &tektonv1.PipelineRunList{})).To(Succeed())
+			Expect(guardedClient.List(ctx, &tektonv1.PipelineRunList{},
+				client.MatchingFields{"metadata.release": "default/release"})).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,55 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Check is an interface to check whether a service the Release pipelines depend on, like the registry the released
+// content is pushed to, is healthy.
+type Check interface {
+	Check(ctx context.Context) error
+}
+
+// CheckBuilder is a function creating a Check for the service at the given url using the given http client.
+type CheckBuilder func(url string, httpClient *http.Client) Check
+
+// checkBuilders contains the CheckBuilder of each type of Check, indexed by the name of the type.
+var checkBuilders = map[string]CheckBuilder{
+	"HTTP":     NewHTTPCheck,
+	"Registry": NewRegistryCheck,
+}
+
+// NewCheck creates and returns a Check of the given type for the service at the given url. An error is returned if
+// no CheckBuilder is registered for the given type.
+func NewCheck(checkType, url string, httpClient *http.Client) (Check, error) {
+	builder, found := checkBuilders[checkType]
+	if !found {
+		return nil, fmt.Errorf("unsupported health check type '%s'", checkType)
+	}
+
+	return builder(url, httpClient), nil
+}
+
+// RegisterCheckBuilder registers the given CheckBuilder for the given type of Check, replacing any CheckBuilder
+// previously registered for it.
+func RegisterCheckBuilder(checkType string, builder CheckBuilder) {
+	checkBuilders[checkType] = builder
+}
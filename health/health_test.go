@@ -0,0 +1,67 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockCheck always succeeds.
+type mockCheck struct {
+	url string
+}
+
+func (c *mockCheck) Check(_ context.Context) error {
+	return nil
+}
+
+var _ = Describe("Health", func() {
+	When("NewCheck is called", func() {
+		It("should return a check of the given type", func() {
+			check, err := NewCheck("Registry", "https://quay.io", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(check).To(BeAssignableToTypeOf(&httpCheck{}))
+			Expect(check.(*httpCheck).url).To(Equal("https://quay.io/v2/"))
+		})
+
+		It("should fail for unsupported types", func() {
+			_, err := NewCheck("DNS", "quay.io", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported health check type 'DNS'"))
+		})
+	})
+
+	When("RegisterCheckBuilder is called", func() {
+		AfterEach(func() {
+			delete(checkBuilders, "Mock")
+		})
+
+		It("should make the registered type available", func() {
+			RegisterCheckBuilder("Mock", func(url string, _ *http.Client) Check {
+				return &mockCheck{url: url}
+			})
+
+			check, err := NewCheck("Mock", "https://example.com", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(check).To(Equal(&mockCheck{url: "https://example.com"}))
+		})
+	})
+})
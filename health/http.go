@@ -0,0 +1,77 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// httpCheck checks that a service answers requests to a url with an expected status.
+type httpCheck struct {
+	httpClient *http.Client
+	isHealthy  func(status int) bool
+	url        string
+}
+
+// NewHTTPCheck creates and returns a Check succeeding if the service at the given url answers GET requests with a 2xx
+// status. It can be used to check APIs exposing a status endpoint, like Pyxis, or CDNs.
+func NewHTTPCheck(url string, httpClient *http.Client) Check {
+	return &httpCheck{
+		httpClient: httpClient,
+		isHealthy: func(status int) bool {
+			return status >= 200 && status <= 299
+		},
+		url: url,
+	}
+}
+
+// NewRegistryCheck creates and returns a Check succeeding if the container registry at the given url answers the
+// version check endpoint of the distribution API. As anonymous requests to it are often rejected, unauthorized
+// answers also mean the registry is healthy.
+func NewRegistryCheck(url string, httpClient *http.Client) Check {
+	return &httpCheck{
+		httpClient: httpClient,
+		isHealthy: func(status int) bool {
+			return status == http.StatusOK || status == http.StatusUnauthorized
+		},
+		url: strings.TrimSuffix(url, "/") + "/v2/",
+	}
+}
+
+// Check sends a GET request to the url of the service and returns an error if it fails or the service answers with
+// an unexpected status.
+func (c *httpCheck) Check(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if !c.isHealthy(response.StatusCode) {
+		return fmt.Errorf("unexpected status %d checking %s", response.StatusCode, c.url)
+	}
+
+	return nil
+}
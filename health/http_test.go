@@ -0,0 +1,82 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTP checks", func() {
+	var (
+		path   string
+		server *httptest.Server
+		status int
+	)
+
+	BeforeEach(func() {
+		path, status = "", http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			w.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	When("an HTTP check is used", func() {
+		It("should succeed if the service answers with a 2xx status", func() {
+			Expect(NewHTTPCheck(server.URL+"/ping", server.Client()).Check(context.TODO())).To(Succeed())
+			Expect(path).To(Equal("/ping"))
+		})
+
+		It("should fail if the service answers with another status", func() {
+			status = http.StatusServiceUnavailable
+			err := NewHTTPCheck(server.URL, server.Client()).Check(context.TODO())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unexpected status 503"))
+		})
+
+		It("should fail if the service can't be reached", func() {
+			server.Close()
+			Expect(NewHTTPCheck(server.URL, server.Client()).Check(context.TODO())).NotTo(Succeed())
+		})
+	})
+
+	When("a registry check is used", func() {
+		It("should succeed if the registry answers the version check endpoint", func() {
+			Expect(NewRegistryCheck(server.URL+"/", server.Client()).Check(context.TODO())).To(Succeed())
+			Expect(path).To(Equal("/v2/"))
+		})
+
+		It("should succeed if the registry rejects anonymous requests", func() {
+			status = http.StatusUnauthorized
+			Expect(NewRegistryCheck(server.URL, server.Client()).Check(context.TODO())).To(Succeed())
+		})
+
+		It("should fail if the registry answers with another status", func() {
+			status = http.StatusBadGateway
+			Expect(NewRegistryCheck(server.URL, server.Client()).Check(context.TODO())).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,318 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReleasesPath is the path the ReleasesHandler is meant to be served on.
+	ReleasesPath = "/history/releases"
+
+	// StatsPath is the path the StatsHandler is meant to be served on.
+	StatsPath = "/history/stats"
+)
+
+// ReleaseRecord describes a Release in the release history.
+type ReleaseRecord struct {
+	// Application is the application released, taken from the ReleasePlan of the Release
+	Application string `json:"application,omitempty"`
+
+	// Artifacts contains the data produced by the Release. It's only set when a single Release is requested
+	Artifacts *runtime.RawExtension `json:"artifacts,omitempty"`
+
+	// CompletionTime is the time when the Release was completed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message is the human-readable summary of the Release status
+	Message string `json:"message,omitempty"`
+
+	// Name is the name of the Release
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Release
+	Namespace string `json:"namespace"`
+
+	// Phase is the phase the Release is in
+	Phase v1alpha1.ReleasePhase `json:"phase,omitempty"`
+
+	// ReleasePlan is the name of the ReleasePlan used by the Release
+	ReleasePlan string `json:"releasePlan"`
+
+	// Snapshot is the name of the Snapshot released
+	Snapshot string `json:"snapshot"`
+
+	// StartTime is the time when the Release started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Target is the namespace the Release was sent to
+	Target string `json:"target,omitempty"`
+}
+
+// ReleasesResponse contains the Releases matching a request to the ReleasesHandler.
+type ReleasesResponse struct {
+	// Error contains the reason why the Releases couldn't be returned
+	Error string `json:"error,omitempty"`
+
+	// Releases contains the matching Releases, sorted from newest to oldest
+	Releases []ReleaseRecord `json:"releases,omitempty"`
+}
+
+// StatsResponse contains the release statistics returned by the StatsHandler.
+type StatsResponse struct {
+	// Error contains the reason why the statistics couldn't be computed
+	Error string `json:"error,omitempty"`
+
+	// Failed is the number of Releases that failed
+	Failed int `json:"failed"`
+
+	// InProgress is the number of Releases that haven't finished yet
+	InProgress int `json:"inProgress"`
+
+	// Succeeded is the number of Releases that succeeded
+	Succeeded int `json:"succeeded"`
+
+	// Superseded is the number of automated Releases skipped in favor of newer ones. They are not counted as finished
+	Superseded int `json:"superseded"`
+
+	// SuccessRate is the ratio of succeeded Releases over the finished ones. It's 0 if no Release has finished
+	SuccessRate float64 `json:"successRate"`
+
+	// Total is the number of Releases
+	Total int `json:"total"`
+}
+
+// reader reads the release history using a client expected to be backed by the informer cache, so the Kubernetes API
+// is not hit on every request.
+type reader struct {
+	client client.Client
+	loader loader.ObjectLoader
+	log    logr.Logger
+}
+
+// ReleasesHandler is an http.Handler that returns the Releases in the namespace passed in the "namespace" query
+// parameter. The list can be restricted to a single application with the "application" parameter. If the "name"
+// parameter is passed, only the Release with that name is returned, including its artifacts.
+type ReleasesHandler struct {
+	reader
+}
+
+// StatsHandler is an http.Handler that returns the release statistics of the namespace passed in the "namespace" query
+// parameter. The statistics can be restricted to a single application with the "application" parameter.
+type StatsHandler struct {
+	reader
+}
+
+// NewReleasesHandler creates and returns a ReleasesHandler using the given client and logger.
+func NewReleasesHandler(client client.Client, log logr.Logger) *ReleasesHandler {
+	return &ReleasesHandler{
+		reader: reader{
+			client: client,
+			loader: loader.NewLoader(),
+			log:    log.WithName("history"),
+		},
+	}
+}
+
+// NewStatsHandler creates and returns a StatsHandler using the given client and logger.
+func NewStatsHandler(client client.Client, log logr.Logger) *StatsHandler {
+	return &StatsHandler{
+		reader: reader{
+			client: client,
+			loader: loader.NewLoader(),
+			log:    log.WithName("history"),
+		},
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *ReleasesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeResponse(w, http.StatusMethodNotAllowed, &ReleasesResponse{Error: "only GET requests are supported"})
+		return
+	}
+
+	query := r.URL.Query()
+	namespace := query.Get("namespace")
+	if namespace == "" {
+		h.writeResponse(w, http.StatusBadRequest, &ReleasesResponse{Error: "the namespace parameter is required"})
+		return
+	}
+
+	if name := query.Get("name"); name != "" {
+		record, err := h.getReleaseRecord(r.Context(), name, namespace)
+		if err != nil {
+			h.writeResponse(w, getStatus(err), &ReleasesResponse{Error: err.Error()})
+			return
+		}
+
+		h.writeResponse(w, http.StatusOK, &ReleasesResponse{Releases: []ReleaseRecord{*record}})
+		return
+	}
+
+	records, err := h.getReleaseRecords(r.Context(), namespace, query.Get("application"))
+	if err != nil {
+		h.writeResponse(w, getStatus(err), &ReleasesResponse{Error: err.Error()})
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, &ReleasesResponse{Releases: records})
+}
+
+// ServeHTTP implements http.Handler.
+func (h *StatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeResponse(w, http.StatusMethodNotAllowed, &StatsResponse{Error: "only GET requests are supported"})
+		return
+	}
+
+	query := r.URL.Query()
+	namespace := query.Get("namespace")
+	if namespace == "" {
+		h.writeResponse(w, http.StatusBadRequest, &StatsResponse{Error: "the namespace parameter is required"})
+		return
+	}
+
+	records, err := h.getReleaseRecords(r.Context(), namespace, query.Get("application"))
+	if err != nil {
+		h.writeResponse(w, getStatus(err), &StatsResponse{Error: err.Error()})
+		return
+	}
+
+	response := &StatsResponse{Total: len(records)}
+	for _, record := range records {
+		switch record.Phase {
+		case v1alpha1.ReleasePhaseSucceeded:
+			response.Succeeded++
+		case v1alpha1.ReleasePhaseFailed:
+			response.Failed++
+		case v1alpha1.ReleasePhaseSuperseded:
+			response.Superseded++
+		default:
+			response.InProgress++
+		}
+	}
+	if finished := response.Succeeded + response.Failed; finished > 0 {
+		response.SuccessRate = float64(response.Succeeded) / float64(finished)
+	}
+
+	h.writeResponse(w, http.StatusOK, response)
+}
+
+// getReleaseRecord returns the ReleaseRecord of the Release with the given name and namespace, including its artifacts.
+func (r *reader) getReleaseRecord(ctx context.Context, name, namespace string) (*ReleaseRecord, error) {
+	release, err := r.loader.GetRelease(ctx, r.client, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var application string
+	releasePlan, err := r.loader.GetReleasePlan(ctx, r.client, release)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		application = releasePlan.Spec.Application
+	}
+
+	record := NewReleaseRecord(release, application)
+	record.Artifacts = release.Status.Artifacts
+
+	return record, nil
+}
+
+// getReleaseRecords returns the ReleaseRecords of the Releases in the given namespace, sorted from newest to oldest.
+// If an application is given, only the Releases of that application are returned.
+func (r *reader) getReleaseRecords(ctx context.Context, namespace, application string) ([]ReleaseRecord, error) {
+	releasePlans, err := r.loader.GetReleasePlans(ctx, r.client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	applications := map[string]string{}
+	for _, releasePlan := range releasePlans.Items {
+		applications[releasePlan.Name] = releasePlan.Spec.Application
+	}
+
+	releases, err := r.loader.GetReleases(ctx, r.client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(releases.Items, func(i, j int) bool {
+		return releases.Items[j].CreationTimestamp.Before(&releases.Items[i].CreationTimestamp)
+	})
+
+	records := []ReleaseRecord{}
+	for i := range releases.Items {
+		releaseApplication := applications[releases.Items[i].Spec.ReleasePlan]
+		if application != "" && releaseApplication != application {
+			continue
+		}
+
+		records = append(records, *NewReleaseRecord(&releases.Items[i], releaseApplication))
+	}
+
+	return records, nil
+}
+
+// writeResponse writes the given response as JSON with the given status code.
+func (r *reader) writeResponse(w http.ResponseWriter, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		r.log.Error(err, "unable to write response")
+	}
+}
+
+// getStatus returns the HTTP status code matching the given error.
+func getStatus(err error) int {
+	if errors.IsNotFound(err) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
+
+// NewReleaseRecord creates and returns a ReleaseRecord for the given Release and application.
+func NewReleaseRecord(release *v1alpha1.Release, application string) *ReleaseRecord {
+	return &ReleaseRecord{
+		Application:    application,
+		CompletionTime: release.Status.CompletionTime,
+		Message:        release.Status.Summary.Message,
+		Name:           release.Name,
+		Namespace:      release.Namespace,
+		Phase:          release.Status.Summary.Phase,
+		ReleasePlan:    release.Spec.ReleasePlan,
+		Snapshot:       release.Spec.Snapshot,
+		StartTime:      release.Status.StartTime,
+		Target:         release.Status.Target,
+	}
+}
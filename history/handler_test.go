@@ -0,0 +1,252 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("History handlers", func() {
+	var releasePlans *v1alpha1.ReleasePlanList
+	var releases *v1alpha1.ReleaseList
+
+	newRelease := func(name, releasePlan string, phase v1alpha1.ReleasePhase, age time.Duration) v1alpha1.Release {
+		return v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: releasePlan,
+				Snapshot:    "snapshot",
+			},
+			Status: v1alpha1.ReleaseStatus{
+				Summary: v1alpha1.ReleaseSummary{
+					Phase: phase,
+				},
+			},
+		}
+	}
+
+	getMockedContext := func() context.Context {
+		return toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+			{
+				ContextKey: loader.ReleasePlansContextKey,
+				Resource:   releasePlans,
+			},
+			{
+				ContextKey: loader.ReleasesContextKey,
+				Resource:   releases,
+			},
+		})
+	}
+
+	BeforeEach(func() {
+		releasePlans = &v1alpha1.ReleasePlanList{
+			Items: []v1alpha1.ReleasePlan{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "releaseplan", Namespace: "default"},
+					Spec:       v1alpha1.ReleasePlanSpec{Application: "application"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-releaseplan", Namespace: "default"},
+					Spec:       v1alpha1.ReleasePlanSpec{Application: "other-application"},
+				},
+			},
+		}
+		releases = &v1alpha1.ReleaseList{
+			Items: []v1alpha1.Release{
+				newRelease("oldest", "releaseplan", v1alpha1.ReleasePhaseFailed, 3*time.Hour),
+				newRelease("newest", "releaseplan", v1alpha1.ReleasePhaseProgressing, time.Hour),
+				newRelease("middle", "releaseplan", v1alpha1.ReleasePhaseSucceeded, 2*time.Hour),
+				newRelease("other", "other-releaseplan", v1alpha1.ReleasePhaseSucceeded, time.Hour),
+			},
+		}
+	})
+
+	Context("When using the ReleasesHandler", func() {
+		var handler *ReleasesHandler
+
+		serve := func(ctx context.Context, method, query string) (*httptest.ResponseRecorder, *ReleasesResponse) {
+			request := httptest.NewRequest(method, ReleasesPath+query, nil).WithContext(ctx)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			response := &ReleasesResponse{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+
+			return recorder, response
+		}
+
+		BeforeEach(func() {
+			handler = NewReleasesHandler(nil, ctrl.Log)
+			handler.loader = loader.NewMockLoader()
+		})
+
+		It("should fail if the method is not GET", func() {
+			recorder, response := serve(context.TODO(), http.MethodPost, "?namespace=default")
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+			Expect(response.Error).NotTo(BeEmpty())
+		})
+
+		It("should fail if the namespace is missing", func() {
+			recorder, response := serve(context.TODO(), http.MethodGet, "?application=application")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(response.Error).To(ContainSubstring("namespace parameter is required"))
+		})
+
+		It("should return the releases of the application sorted from newest to oldest", func() {
+			recorder, response := serve(getMockedContext(), http.MethodGet, "?namespace=default&application=application")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(response.Releases).To(HaveLen(3))
+			Expect(response.Releases[0].Name).To(Equal("newest"))
+			Expect(response.Releases[1].Name).To(Equal("middle"))
+			Expect(response.Releases[2].Name).To(Equal("oldest"))
+			Expect(response.Releases[0].Application).To(Equal("application"))
+			Expect(response.Releases[0].Phase).To(Equal(v1alpha1.ReleasePhaseProgressing))
+			Expect(response.Releases[0].Artifacts).To(BeNil())
+		})
+
+		It("should return the releases of every application if none is given", func() {
+			recorder, response := serve(getMockedContext(), http.MethodGet, "?namespace=default")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(response.Releases).To(HaveLen(4))
+		})
+
+		It("should return a single release with its artifacts if a name is given", func() {
+			release := releases.Items[2].DeepCopy()
+			release.Status.Artifacts = &runtime.RawExtension{Raw: []byte(`{"images":[]}`)}
+			ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   release,
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   &releasePlans.Items[0],
+				},
+			})
+
+			recorder, response := serve(ctx, http.MethodGet, "?namespace=default&name=middle")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(response.Releases).To(HaveLen(1))
+			Expect(response.Releases[0].Name).To(Equal("middle"))
+			Expect(response.Releases[0].Application).To(Equal("application"))
+			Expect(response.Releases[0].Artifacts).NotTo(BeNil())
+			Expect(string(response.Releases[0].Artifacts.Raw)).To(Equal(`{"images":[]}`))
+		})
+
+		It("should return a not found error if the release doesn't exist", func() {
+			ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, "missing"),
+				},
+			})
+
+			recorder, response := serve(ctx, http.MethodGet, "?namespace=default&name=missing")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			Expect(response.Error).NotTo(BeEmpty())
+		})
+
+		It("should return an internal error if the releases can't be listed", func() {
+			ctx := toolkit.GetMockedContext(context.TODO(), []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlansContextKey,
+					Resource:   releasePlans,
+				},
+				{
+					ContextKey: loader.ReleasesContextKey,
+					Err:        fmt.Errorf("list failed"),
+				},
+			})
+
+			recorder, response := serve(ctx, http.MethodGet, "?namespace=default")
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(response.Error).To(Equal("list failed"))
+		})
+	})
+
+	Context("When using the StatsHandler", func() {
+		var handler *StatsHandler
+
+		serve := func(ctx context.Context, method, query string) (*httptest.ResponseRecorder, *StatsResponse) {
+			request := httptest.NewRequest(method, StatsPath+query, nil).WithContext(ctx)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			response := &StatsResponse{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+
+			return recorder, response
+		}
+
+		BeforeEach(func() {
+			handler = NewStatsHandler(nil, ctrl.Log)
+			handler.loader = loader.NewMockLoader()
+		})
+
+		It("should fail if the method is not GET", func() {
+			recorder, response := serve(context.TODO(), http.MethodPost, "?namespace=default")
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+			Expect(response.Error).NotTo(BeEmpty())
+		})
+
+		It("should fail if the namespace is missing", func() {
+			recorder, response := serve(context.TODO(), http.MethodGet, "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(response.Error).To(ContainSubstring("namespace parameter is required"))
+		})
+
+		It("should return the stats of the application", func() {
+			recorder, response := serve(getMockedContext(), http.MethodGet, "?namespace=default&application=application")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(response.Total).To(Equal(3))
+			Expect(response.Succeeded).To(Equal(1))
+			Expect(response.Failed).To(Equal(1))
+			Expect(response.InProgress).To(Equal(1))
+			Expect(response.SuccessRate).To(Equal(0.5))
+		})
+
+		It("should return a zero success rate if no release finished", func() {
+			releases.Items = releases.Items[1:2]
+
+			recorder, response := serve(getMockedContext(), http.MethodGet, "?namespace=default")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(response.Total).To(Equal(1))
+			Expect(response.InProgress).To(Equal(1))
+			Expect(response.SuccessRate).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,145 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listeners
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// DisabledAddress is the address disabling an optional listener.
+const DisabledAddress = "0"
+
+// Listener is a server of the service bound to the address set in a flag.
+type Listener struct {
+	// Flag is the name of the flag setting the address of the listener
+	Flag string
+
+	// Address is the address the listener binds to, in the host:port form. IPv6 hosts have to be enclosed in brackets
+	// (e.g. [::1]:8080), while an empty host binds to all the IPv4 and IPv6 addresses of the host
+	Address string
+
+	// Optional indicates whether the listener is disabled when its address is empty or DisabledAddress
+	Optional bool
+}
+
+// IsDisabled checks whether the listener is optional and its address disables it.
+func (l *Listener) IsDisabled() bool {
+	return l.Optional && (l.Address == "" || l.Address == DisabledAddress)
+}
+
+// IsLoopback checks whether the listener only binds to a loopback address (e.g. localhost, 127.0.0.1 or [::1]), so it's
+// only reachable from the host or Pod it runs in.
+func (l *Listener) IsLoopback() bool {
+	host, _, err := SplitHostPort(l.Address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(host)
+
+	return err == nil && addr.IsLoopback()
+}
+
+// SplitHostPort returns the host and the port of the given address. An error is returned if the address is not in the
+// host:port form, if the host is neither an IP address nor a DNS name or if the port is not between 1 and 65535.
+func SplitHostPort(address string) (string, int, error) {
+	host, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		if strings.Count(address, ":") > 1 && !strings.HasPrefix(address, "[") {
+			return "", 0, fmt.Errorf("invalid address '%s': IPv6 hosts have to be enclosed in brackets, e.g. [::]:8080",
+				address)
+		}
+
+		return "", 0, fmt.Errorf("invalid address '%s': %w", address, err)
+	}
+
+	if host != "" {
+		if _, err := netip.ParseAddr(host); err != nil && len(validation.IsDNS1123Subdomain(host)) > 0 {
+			return "", 0, fmt.Errorf("invalid address '%s': '%s' is neither an IP address nor a DNS name",
+				address, host)
+		}
+	}
+
+	port, err := strconv.Atoi(portString)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid address '%s': the port has to be between 1 and 65535", address)
+	}
+
+	return host, port, nil
+}
+
+// Validate checks that the addresses of the given listeners are valid and that no two enabled listeners bind to the
+// same port on overlapping hosts. All the invalid addresses and conflicts are reported in the returned error.
+func Validate(listeners ...Listener) error {
+	type boundListener struct {
+		flag string
+		host string
+		port int
+	}
+
+	var errs []error
+	var boundListeners []boundListener
+	for _, listener := range listeners {
+		if listener.IsDisabled() {
+			continue
+		}
+
+		host, port, err := SplitHostPort(listener.Address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("--%s: %w", listener.Flag, err))
+			continue
+		}
+
+		for _, boundListener := range boundListeners {
+			if boundListener.port == port && areHostsOverlapping(boundListener.host, host) {
+				errs = append(errs, fmt.Errorf("--%s and --%s both bind to port %d", boundListener.flag,
+					listener.Flag, port))
+			}
+		}
+		boundListeners = append(boundListeners, boundListener{flag: listener.Flag, host: host, port: port})
+	}
+
+	return errors.Join(errs...)
+}
+
+// areHostsOverlapping returns true if the given hosts are equal or if any of them binds to all the addresses of the
+// host, regardless of their IP family.
+func areHostsOverlapping(host, otherHost string) bool {
+	return host == otherHost || isUnspecified(host) || isUnspecified(otherHost)
+}
+
+// isUnspecified returns true if the given host binds to all the addresses of the host (e.g. an empty host, 0.0.0.0 or
+// [::]).
+func isUnspecified(host string) bool {
+	if host == "" {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(host)
+
+	return err == nil && addr.IsUnspecified()
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listeners
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Listeners", func() {
+	When("IsLoopback is called", func() {
+		It("should return true for the loopback addresses", func() {
+			Expect((&Listener{Address: "localhost:8080"}).IsLoopback()).To(BeTrue())
+			Expect((&Listener{Address: "127.0.0.1:8080"}).IsLoopback()).To(BeTrue())
+			Expect((&Listener{Address: "[::1]:8080"}).IsLoopback()).To(BeTrue())
+		})
+
+		It("should return false for the other addresses", func() {
+			Expect((&Listener{Address: ":8080"}).IsLoopback()).To(BeFalse())
+			Expect((&Listener{Address: "0.0.0.0:8080"}).IsLoopback()).To(BeFalse())
+			Expect((&Listener{Address: "10.0.0.1:8080"}).IsLoopback()).To(BeFalse())
+			Expect((&Listener{Address: "8080"}).IsLoopback()).To(BeFalse())
+		})
+	})
+
+	When("SplitHostPort is called", func() {
+		It("should split IPv4, IPv6 and wildcard addresses", func() {
+			host, port, err := SplitHostPort("127.0.0.1:8080")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(host).To(Equal("127.0.0.1"))
+			Expect(port).To(Equal(8080))
+
+			host, port, err = SplitHostPort("[::1]:9443")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(host).To(Equal("::1"))
+			Expect(port).To(Equal(9443))
+
+			host, _, err = SplitHostPort(":8081")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(host).To(BeEmpty())
+		})
+
+		It("should accept DNS names", func() {
+			host, _, err := SplitHostPort("localhost:8080")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(host).To(Equal("localhost"))
+		})
+
+		It("should suggest enclosing IPv6 hosts in brackets", func() {
+			_, _, err := SplitHostPort("::1:8080")
+			Expect(err).To(MatchError(ContainSubstring("enclosed in brackets")))
+		})
+
+		It("should fail if the port is out of range", func() {
+			_, _, err := SplitHostPort(":0")
+			Expect(err).To(MatchError(ContainSubstring("between 1 and 65535")))
+
+			_, _, err = SplitHostPort(":70000")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the host is invalid", func() {
+			_, _, err := SplitHostPort("not_a_host:8080")
+			Expect(err).To(MatchError(ContainSubstring("neither an IP address nor a DNS name")))
+		})
+	})
+
+	When("Validate is called", func() {
+		It("should succeed if the listeners don't conflict", func() {
+			Expect(Validate(
+				Listener{Flag: "metrics-bind-address", Address: "[::]:8080"},
+				Listener{Flag: "health-probe-bind-address", Address: ":8081"},
+				Listener{Flag: "webhook-bind-address", Address: ":9443"},
+			)).To(Succeed())
+		})
+
+		It("should ignore the disabled optional listeners", func() {
+			Expect(Validate(
+				Listener{Flag: "metrics-bind-address", Address: DisabledAddress, Optional: true},
+				Listener{Flag: "pprof-bind-address", Address: "", Optional: true},
+				Listener{Flag: "webhook-bind-address", Address: ":9443"},
+			)).To(Succeed())
+		})
+
+		It("should fail if a required listener is disabled", func() {
+			Expect(Validate(Listener{Flag: "webhook-bind-address", Address: DisabledAddress})).NotTo(Succeed())
+		})
+
+		It("should fail if two listeners bind to the same port on overlapping hosts", func() {
+			err := Validate(
+				Listener{Flag: "metrics-bind-address", Address: "[::1]:8080"},
+				Listener{Flag: "pprof-bind-address", Address: "0.0.0.0:8080", Optional: true},
+			)
+			Expect(err).To(MatchError(ContainSubstring("--metrics-bind-address and --pprof-bind-address both bind to port 8080")))
+		})
+
+		It("should succeed if two listeners bind to the same port on different hosts", func() {
+			Expect(Validate(
+				Listener{Flag: "metrics-bind-address", Address: "127.0.0.1:8080"},
+				Listener{Flag: "pprof-bind-address", Address: "[::1]:8080", Optional: true},
+			)).To(Succeed())
+		})
+
+		It("should report every invalid address", func() {
+			err := Validate(
+				Listener{Flag: "metrics-bind-address", Address: "8080"},
+				Listener{Flag: "webhook-bind-address", Address: "::1:9443"},
+			)
+			Expect(err).To(MatchError(ContainSubstring("--metrics-bind-address")))
+			Expect(err).To(MatchError(ContainSubstring("--webhook-bind-address")))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -16,6 +17,7 @@ import (
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/metadata"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -28,17 +30,33 @@ type ObjectLoader interface {
 	GetActiveReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error)
 	GetActiveReleasePlanAdmissionFromRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlanAdmission, error)
 	GetApplication(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*applicationapiv1alpha1.Application, error)
+	GetConfigMap(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ConfigMap, error)
 	GetEnterpriseContractConfigMap(ctx context.Context, cli client.Client) (*corev1.ConfigMap, error)
 	GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*ecapiv1alpha1.EnterpriseContractPolicy, error)
+	GetEnvironment(ctx context.Context, cli client.Client, name, namespace string) (*applicationapiv1alpha1.Environment, error)
+	GetLimitRanges(ctx context.Context, cli client.Client, namespace string) (*corev1.LimitRangeList, error)
 	GetMatchingReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error)
+	GetMatchingReleasePlanAdmissions(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmissionList, error)
 	GetMatchingReleasePlans(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleasePlanList, error)
 	GetPreviousRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.Release, error)
 	GetRelease(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.Release, error)
+	GetReleaseWithIdempotencyKey(ctx context.Context, cli client.Client, idempotencyKey, namespace string) (*v1alpha1.Release, error)
 	GetRoleBindingFromReleaseStatus(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*rbac.RoleBinding, error)
 	GetReleasePipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release, pipelineType string) (*tektonv1.PipelineRun, error)
+	GetReleasePipelineCatalog(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleasePipelineCatalog, error)
 	GetReleasePlan(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlan, error)
+	GetReleasePlanAdmissionDefaults(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanAdmissionDefaults, error)
+	GetReleasePlanAdmissions(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanAdmissionList, error)
+	GetReleasePlans(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanList, error)
+	GetReleases(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleaseList, error)
+	GetReleasesWithTarget(ctx context.Context, cli client.Client, target string) (*v1alpha1.ReleaseList, error)
 	GetReleaseServiceConfig(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseServiceConfig, error)
+	GetResourceQuotas(ctx context.Context, cli client.Client, namespace string) (*corev1.ResourceQuotaList, error)
+	GetSecret(ctx context.Context, cli client.Client, name, namespace string) (*corev1.Secret, error)
+	GetServiceAccount(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ServiceAccount, error)
 	GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error)
+	GetSnapshotEnvironmentBinding(ctx context.Context, cli client.Client, application, environment, namespace string) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error)
+	GetSnapshotEnvironmentBindingsFromReleaseStatus(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.SnapshotEnvironmentBindingList, error)
 	GetProcessingResources(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*ProcessingResources, error)
 }
 
@@ -87,6 +105,13 @@ func (l *loader) GetApplication(ctx context.Context, cli client.Client, releaseP
 	return application, toolkit.GetObject(releasePlan.Spec.Application, releasePlan.Namespace, cli, ctx, application)
 }
 
+// GetConfigMap returns the ConfigMap with the given name and namespace. If the ConfigMap is not found or the Get
+// operation fails, an error will be returned.
+func (l *loader) GetConfigMap(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	return configMap, toolkit.GetObject(name, namespace, cli, ctx, configMap)
+}
+
 // GetEnterpriseContractPolicy returns the EnterpriseContractPolicy referenced by the given ReleasePlanAdmission. If the
 // EnterpriseContractPolicy is not found or the Get operation fails, an error is returned.
 func (l *loader) GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*ecapiv1alpha1.EnterpriseContractPolicy, error) {
@@ -109,9 +134,24 @@ func (l *loader) GetEnterpriseContractConfigMap(ctx context.Context, cli client.
 
 }
 
+// GetEnvironment returns the Environment with the given name and namespace. If the Environment is not found or the Get
+// operation fails, an error will be returned.
+func (l *loader) GetEnvironment(ctx context.Context, cli client.Client, name, namespace string) (*applicationapiv1alpha1.Environment, error) {
+	environment := &applicationapiv1alpha1.Environment{}
+	return environment, toolkit.GetObject(name, namespace, cli, ctx, environment)
+}
+
+// GetLimitRanges returns all the LimitRanges in the given namespace. If the List operation fails, an error will be
+// returned.
+func (l *loader) GetLimitRanges(ctx context.Context, cli client.Client, namespace string) (*corev1.LimitRangeList, error) {
+	limitRanges := &corev1.LimitRangeList{}
+	return limitRanges, cli.List(ctx, limitRanges, client.InNamespace(namespace))
+}
+
 // GetMatchingReleasePlanAdmission returns the ReleasePlanAdmission targeted by the given ReleasePlan.
 // If a matching ReleasePlanAdmission is not found or the List operation fails, an error will be returned.
-// If more than one matching ReleasePlanAdmission objects are found, an error will be returned.
+// If more than one matching ReleasePlanAdmission objects are found, an error will be returned unless the ReleasePlan
+// sets a fan-out policy, in which case the first of them by name is returned.
 func (l *loader) GetMatchingReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error) {
 	designatedReleasePlanAdmissionName := releasePlan.GetLabels()[metadata.ReleasePlanAdmissionLabel]
 
@@ -120,6 +160,28 @@ func (l *loader) GetMatchingReleasePlanAdmission(ctx context.Context, cli client
 		return releasePlanAdmission, toolkit.GetObject(designatedReleasePlanAdmissionName, releasePlan.Spec.Target, cli, ctx, releasePlanAdmission)
 	}
 
+	releasePlanAdmissions, err := l.GetMatchingReleasePlanAdmissions(ctx, cli, releasePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(releasePlanAdmissions.Items) > 1 && releasePlan.Spec.FanOutPolicy == "" {
+		return nil, fmt.Errorf("multiple ReleasePlanAdmissions found in namespace (%+s) with the origin (%+s) for application '%s'",
+			releasePlan.Spec.Target, releasePlan.Namespace, releasePlan.Spec.Application)
+	}
+
+	if len(releasePlanAdmissions.Items) == 0 {
+		return nil, fmt.Errorf("no ReleasePlanAdmission found in namespace (%+s) with the origin (%+s) for application '%s'",
+			releasePlan.Spec.Target, releasePlan.Namespace, releasePlan.Spec.Application)
+	}
+
+	return &releasePlanAdmissions.Items[0], nil
+}
+
+// GetMatchingReleasePlanAdmissions returns all the ReleasePlanAdmissions in the target namespace of the given
+// ReleasePlan that have its namespace as origin and include its application, sorted by name. If the ReleasePlan has
+// no target or the List operation fails, an error will be returned.
+func (l *loader) GetMatchingReleasePlanAdmissions(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmissionList, error) {
 	if releasePlan.Spec.Target == "" {
 		return nil, fmt.Errorf("releasePlan has no target, so no ReleasePlanAdmissions can be found")
 	}
@@ -132,27 +194,18 @@ func (l *loader) GetMatchingReleasePlanAdmission(ctx context.Context, cli client
 		return nil, err
 	}
 
-	var foundReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
-
-	for i, releasePlanAdmission := range releasePlanAdmissions.Items {
-		if !slices.Contains(releasePlanAdmission.Spec.Applications, releasePlan.Spec.Application) {
-			continue
+	matchingReleasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
+	for _, releasePlanAdmission := range releasePlanAdmissions.Items {
+		if slices.Contains(releasePlanAdmission.Spec.Applications, releasePlan.Spec.Application) {
+			matchingReleasePlanAdmissions.Items = append(matchingReleasePlanAdmissions.Items, releasePlanAdmission)
 		}
-
-		if foundReleasePlanAdmission != nil {
-			return nil, fmt.Errorf("multiple ReleasePlanAdmissions found in namespace (%+s) with the origin (%+s) for application '%s'",
-				releasePlan.Spec.Target, releasePlan.Namespace, releasePlan.Spec.Application)
-		}
-
-		foundReleasePlanAdmission = &releasePlanAdmissions.Items[i]
 	}
 
-	if foundReleasePlanAdmission == nil {
-		return nil, fmt.Errorf("no ReleasePlanAdmission found in namespace (%+s) with the origin (%+s) for application '%s'",
-			releasePlan.Spec.Target, releasePlan.Namespace, releasePlan.Spec.Application)
-	}
+	sort.Slice(matchingReleasePlanAdmissions.Items, func(i, j int) bool {
+		return matchingReleasePlanAdmissions.Items[i].Name < matchingReleasePlanAdmissions.Items[j].Name
+	})
 
-	return foundReleasePlanAdmission, nil
+	return matchingReleasePlanAdmissions, nil
 }
 
 // GetMatchingReleasePlans returns a list of all ReleasePlans that target the given ReleasePlanAdmission's
@@ -221,6 +274,28 @@ func (l *loader) GetRelease(ctx context.Context, cli client.Client, name, namesp
 	return release, toolkit.GetObject(name, namespace, cli, ctx, release)
 }
 
+// GetReleaseWithIdempotencyKey returns the Release with the given idempotency key in the given namespace. If no Release
+// is found, a NotFound error is returned. If the List operation fails, an error will be returned.
+func (l *loader) GetReleaseWithIdempotencyKey(ctx context.Context, cli client.Client, idempotencyKey, namespace string) (*v1alpha1.Release, error) {
+	releases := &v1alpha1.ReleaseList{}
+	err := cli.List(ctx, releases,
+		client.InNamespace(namespace),
+		client.MatchingFields{"spec.idempotencyKey": idempotencyKey})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(releases.Items) == 0 {
+		return nil, errors.NewNotFound(
+			schema.GroupResource{
+				Group:    v1alpha1.GroupVersion.Group,
+				Resource: "Release",
+			}, idempotencyKey)
+	}
+
+	return &releases.Items[0], nil
+}
+
 // GetRoleBindingFromReleaseStatus returns the RoleBinding associated with the given Release. That association is defined
 // by the namespaced name stored in the Release's status.
 func (l *loader) GetRoleBindingFromReleaseStatus(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*rbac.RoleBinding, error) {
@@ -245,18 +320,16 @@ func (l *loader) GetRoleBindingFromReleaseStatus(ctx context.Context, cli client
 // GetReleasePipelineRun returns the Release PipelineRun of the specified type referenced by the given Release
 // or nil if it's not found. In the case the List operation fails, an error will be returned.
 func (l *loader) GetReleasePipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release, pipelineType string) (*tektonv1.PipelineRun, error) {
-	if pipelineType != metadata.ManagedPipelineType && pipelineType != metadata.TenantPipelineType {
+	if pipelineType != metadata.ManagedPipelineType && pipelineType != metadata.TenantPipelineType &&
+		pipelineType != metadata.VerificationPipelineType {
 		return nil, fmt.Errorf("cannot fetch Release PipelineRun with invalid type %s", pipelineType)
 	}
 
+	labels := metadata.GetReleaseTrackingLabels(release.Name, release.Namespace)
+	labels[metadata.PipelinesTypeLabel] = pipelineType
+
 	pipelineRuns := &tektonv1.PipelineRunList{}
-	err := cli.List(ctx, pipelineRuns,
-		client.Limit(1),
-		client.MatchingLabels{
-			metadata.ReleaseNameLabel:      release.Name,
-			metadata.ReleaseNamespaceLabel: release.Namespace,
-			metadata.PipelinesTypeLabel:    pipelineType,
-		})
+	err := cli.List(ctx, pipelineRuns, client.Limit(1), client.MatchingLabels(labels))
 	if err == nil && len(pipelineRuns.Items) > 0 {
 		return &pipelineRuns.Items[0], nil
 	}
@@ -264,6 +337,13 @@ func (l *loader) GetReleasePipelineRun(ctx context.Context, cli client.Client, r
 	return nil, err
 }
 
+// GetReleasePipelineCatalog returns the ReleasePipelineCatalog with the given name and namespace. If the
+// ReleasePipelineCatalog is not found or the Get operation fails, an error will be returned.
+func (l *loader) GetReleasePipelineCatalog(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleasePipelineCatalog, error) {
+	releasePipelineCatalog := &v1alpha1.ReleasePipelineCatalog{}
+	return releasePipelineCatalog, toolkit.GetObject(name, namespace, cli, ctx, releasePipelineCatalog)
+}
+
 // GetReleasePlan returns the ReleasePlan referenced by the given Release. If the ReleasePlan is not found or
 // the Get operation fails, an error will be returned.
 func (l *loader) GetReleasePlan(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlan, error) {
@@ -271,6 +351,40 @@ func (l *loader) GetReleasePlan(ctx context.Context, cli client.Client, release
 	return releasePlan, toolkit.GetObject(release.Spec.ReleasePlan, release.Namespace, cli, ctx, releasePlan)
 }
 
+// GetReleasePlanAdmissionDefaults returns the ReleasePlanAdmissionDefaults of the given namespace. If the
+// ReleasePlanAdmissionDefaults is not found or the Get operation fails, an error will be returned.
+func (l *loader) GetReleasePlanAdmissionDefaults(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanAdmissionDefaults, error) {
+	releasePlanAdmissionDefaults := &v1alpha1.ReleasePlanAdmissionDefaults{}
+	return releasePlanAdmissionDefaults, toolkit.GetObject(v1alpha1.ReleasePlanAdmissionDefaultsResourceName, namespace, cli, ctx, releasePlanAdmissionDefaults)
+}
+
+// GetReleasePlanAdmissions returns all the ReleasePlanAdmissions in the given namespace. If the List operation fails, an
+// error will be returned.
+func (l *loader) GetReleasePlanAdmissions(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanAdmissionList, error) {
+	releasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
+	return releasePlanAdmissions, cli.List(ctx, releasePlanAdmissions, client.InNamespace(namespace))
+}
+
+// GetReleasePlans returns all the ReleasePlans in the given namespace. If the List operation fails, an error will be
+// returned.
+func (l *loader) GetReleasePlans(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanList, error) {
+	releasePlans := &v1alpha1.ReleasePlanList{}
+	return releasePlans, cli.List(ctx, releasePlans, client.InNamespace(namespace))
+}
+
+// GetReleases returns all the Releases in the given namespace. If the List operation fails, an error will be returned.
+func (l *loader) GetReleases(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleaseList, error) {
+	releases := &v1alpha1.ReleaseList{}
+	return releases, cli.List(ctx, releases, client.InNamespace(namespace))
+}
+
+// GetReleasesWithTarget returns all the Releases targeting the given namespace, regardless of the namespace they were
+// created in. If the List operation fails, an error will be returned.
+func (l *loader) GetReleasesWithTarget(ctx context.Context, cli client.Client, target string) (*v1alpha1.ReleaseList, error) {
+	releases := &v1alpha1.ReleaseList{}
+	return releases, cli.List(ctx, releases, client.MatchingFields{"status.target": target})
+}
+
 // GetReleaseServiceConfig returns the ReleaseServiceConfig with the given name and namespace. If the ReleaseServiceConfig is not
 // found or the Get operation fails, an error will be returned.
 func (l *loader) GetReleaseServiceConfig(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseServiceConfig, error) {
@@ -278,6 +392,27 @@ func (l *loader) GetReleaseServiceConfig(ctx context.Context, cli client.Client,
 	return releaseServiceConfig, toolkit.GetObject(name, namespace, cli, ctx, releaseServiceConfig)
 }
 
+// GetResourceQuotas returns all the ResourceQuotas in the given namespace. If the List operation fails, an error will
+// be returned.
+func (l *loader) GetResourceQuotas(ctx context.Context, cli client.Client, namespace string) (*corev1.ResourceQuotaList, error) {
+	resourceQuotas := &corev1.ResourceQuotaList{}
+	return resourceQuotas, cli.List(ctx, resourceQuotas, client.InNamespace(namespace))
+}
+
+// GetSecret returns the Secret with the given name and namespace. If the Secret is not found or the Get operation
+// fails, an error will be returned.
+func (l *loader) GetSecret(ctx context.Context, cli client.Client, name, namespace string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	return secret, toolkit.GetObject(name, namespace, cli, ctx, secret)
+}
+
+// GetServiceAccount returns the ServiceAccount with the given name and namespace. If the ServiceAccount is not found or
+// the Get operation fails, an error will be returned.
+func (l *loader) GetServiceAccount(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ServiceAccount, error) {
+	serviceAccount := &corev1.ServiceAccount{}
+	return serviceAccount, toolkit.GetObject(name, namespace, cli, ctx, serviceAccount)
+}
+
 // GetSnapshot returns the Snapshot referenced by the given Release. If the Snapshot is not found or the Get
 // operation fails, an error is returned.
 func (l *loader) GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error) {
@@ -285,13 +420,63 @@ func (l *loader) GetSnapshot(ctx context.Context, cli client.Client, release *v1
 	return snapshot, toolkit.GetObject(release.Spec.Snapshot, release.Namespace, cli, ctx, snapshot)
 }
 
+// GetSnapshotEnvironmentBinding returns the SnapshotEnvironmentBinding binding the given application to the given
+// Environment in the given namespace. If no SnapshotEnvironmentBinding is found, a NotFound error is returned. If the
+// List operation fails, an error will be returned.
+func (l *loader) GetSnapshotEnvironmentBinding(ctx context.Context, cli client.Client, application, environment, namespace string) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error) {
+	bindings := &applicationapiv1alpha1.SnapshotEnvironmentBindingList{}
+	err := cli.List(ctx, bindings,
+		client.InNamespace(namespace),
+		client.MatchingFields{"spec.environment": environment})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range bindings.Items {
+		if bindings.Items[i].Spec.Application == application {
+			return &bindings.Items[i], nil
+		}
+	}
+
+	return nil, errors.NewNotFound(
+		schema.GroupResource{
+			Group:    applicationapiv1alpha1.GroupVersion.Group,
+			Resource: "SnapshotEnvironmentBinding",
+		}, fmt.Sprintf("%s/%s", application, environment))
+}
+
+// GetSnapshotEnvironmentBindingsFromReleaseStatus returns the SnapshotEnvironmentBindings referenced in the deployment
+// status of the given Release. If any of them is not found or a Get operation fails, an error will be returned.
+func (l *loader) GetSnapshotEnvironmentBindingsFromReleaseStatus(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.SnapshotEnvironmentBindingList, error) {
+	bindings := &applicationapiv1alpha1.SnapshotEnvironmentBindingList{}
+
+	for _, binding := range release.Status.Deployment.SnapshotEnvironmentBindings {
+		bindingNamespacedName := strings.Split(binding, string(types.Separator))
+		if len(bindingNamespacedName) != 2 {
+			return nil, fmt.Errorf("release contains an invalid reference to a SnapshotEnvironmentBinding ('%s')", binding)
+		}
+
+		snapshotEnvironmentBinding := &applicationapiv1alpha1.SnapshotEnvironmentBinding{}
+		err := toolkit.GetObject(bindingNamespacedName[1], bindingNamespacedName[0], cli, ctx, snapshotEnvironmentBinding)
+		if err != nil {
+			return nil, err
+		}
+
+		bindings.Items = append(bindings.Items, *snapshotEnvironmentBinding)
+	}
+
+	return bindings, nil
+}
+
 // ProcessingResources contains the required resources to process the Release.
 type ProcessingResources struct {
-	EnterpriseContractConfigMap *corev1.ConfigMap
-	EnterpriseContractPolicy    *ecapiv1alpha1.EnterpriseContractPolicy
-	ReleasePlan                 *v1alpha1.ReleasePlan
-	ReleasePlanAdmission        *v1alpha1.ReleasePlanAdmission
-	Snapshot                    *applicationapiv1alpha1.Snapshot
+	CatalogPipeline              *tektonutils.ParameterizedPipeline
+	EnterpriseContractConfigMap  *corev1.ConfigMap
+	EnterpriseContractPolicy     *ecapiv1alpha1.EnterpriseContractPolicy
+	ReleasePlan                  *v1alpha1.ReleasePlan
+	ReleasePlanAdmission         *v1alpha1.ReleasePlanAdmission
+	ReleasePlanAdmissionDefaults *v1alpha1.ReleasePlanAdmissionDefaults
+	Snapshot                     *applicationapiv1alpha1.Snapshot
 }
 
 // GetProcessingResources returns all the resources required to process the Release. If any of those resources cannot
@@ -310,6 +495,16 @@ func (l *loader) GetProcessingResources(ctx context.Context, cli client.Client,
 		return resources, err
 	}
 
+	resources.CatalogPipeline, err = l.resolveCatalogPipeline(ctx, cli, resources.ReleasePlanAdmission)
+	if err != nil {
+		return resources, err
+	}
+
+	resources.ReleasePlanAdmissionDefaults, err = l.applyReleasePlanAdmissionDefaults(ctx, cli, resources.ReleasePlanAdmission)
+	if err != nil {
+		return resources, err
+	}
+
 	resources.EnterpriseContractConfigMap, err = l.GetEnterpriseContractConfigMap(ctx, cli)
 	if err != nil {
 		return resources, err
@@ -327,3 +522,35 @@ func (l *loader) GetProcessingResources(ctx context.Context, cli client.Client,
 
 	return resources, nil
 }
+
+// resolveCatalogPipeline resolves the managed Pipeline the given ReleasePlanAdmission references in a
+// ReleasePipelineCatalog, setting it as the Pipeline of the ReleasePlanAdmission, and returns it along with its params.
+// If the ReleasePlanAdmission doesn't reference a catalog Pipeline, nil is returned. If the ReleasePipelineCatalog is
+// not found or doesn't contain the Pipeline, an error will be returned.
+func (l *loader) resolveCatalogPipeline(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*tektonutils.ParameterizedPipeline, error) {
+	if releasePlanAdmission.Spec.CatalogPipeline == nil {
+		return nil, nil
+	}
+
+	releasePipelineCatalog, err := l.GetReleasePipelineCatalog(ctx, cli, releasePlanAdmission.Spec.CatalogPipeline.Catalog,
+		releasePlanAdmission.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return releasePlanAdmission.ResolveCatalogPipeline(releasePipelineCatalog)
+}
+
+// applyReleasePlanAdmissionDefaults applies the ReleasePlanAdmissionDefaults of the namespace of the given
+// ReleasePlanAdmission to it and returns them. If the namespace has no ReleasePlanAdmissionDefaults, nil is returned.
+func (l *loader) applyReleasePlanAdmissionDefaults(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleasePlanAdmissionDefaults, error) {
+	releasePlanAdmissionDefaults, err := l.GetReleasePlanAdmissionDefaults(ctx, cli, releasePlanAdmission.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return releasePlanAdmissionDefaults, releasePlanAdmission.ApplyDefaults(releasePlanAdmissionDefaults)
+}
@@ -17,19 +17,35 @@ import (
 const (
 	ApplicationComponentsContextKey toolkit.ContextKey = iota
 	ApplicationContextKey
+	ConfigMapContextKey
 	EnterpriseContractConfigMapContextKey
 	EnterpriseContractPolicyContextKey
+	EnvironmentContextKey
+	LimitRangesContextKey
 	MatchedReleasePlansContextKey
 	MatchedReleasePlanAdmissionContextKey
+	MatchedReleasePlanAdmissionsContextKey
 	PreviousReleaseContextKey
 	ProcessingResourcesContextKey
 	ReleaseContextKey
+	ReleaseWithIdempotencyKeyContextKey
+	ReleasePipelineCatalogContextKey
 	ReleasePipelineRunContextKey
 	ReleasePlanAdmissionContextKey
+	ReleasePlanAdmissionDefaultsContextKey
+	ReleasePlanAdmissionsContextKey
 	ReleasePlanContextKey
+	ReleasePlansContextKey
+	ReleasesContextKey
+	ReleasesWithTargetContextKey
 	ReleaseServiceConfigContextKey
+	ResourceQuotasContextKey
 	RoleBindingContextKey
+	SecretContextKey
+	ServiceAccountContextKey
 	SnapshotContextKey
+	SnapshotEnvironmentBindingContextKey
+	SnapshotEnvironmentBindingsContextKey
 )
 
 type mockLoader struct {
@@ -66,6 +82,14 @@ func (l *mockLoader) GetApplication(ctx context.Context, cli client.Client, rele
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ApplicationContextKey, &applicationapiv1alpha1.Application{})
 }
 
+// GetConfigMap returns the resource and error passed as values of the context.
+func (l *mockLoader) GetConfigMap(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ConfigMap, error) {
+	if ctx.Value(ConfigMapContextKey) == nil {
+		return l.loader.GetConfigMap(ctx, cli, name, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ConfigMapContextKey, &corev1.ConfigMap{})
+}
+
 // GetEnterpriseContractPolicy returns the resource and error passed as values of the context.
 func (l *mockLoader) GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*ecapiv1alpha1.EnterpriseContractPolicy, error) {
 	if ctx.Value(EnterpriseContractPolicyContextKey) == nil {
@@ -82,6 +106,22 @@ func (l *mockLoader) GetEnterpriseContractConfigMap(ctx context.Context, cli cli
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, EnterpriseContractConfigMapContextKey, &corev1.ConfigMap{})
 }
 
+// GetEnvironment returns the resource and error passed as values of the context.
+func (l *mockLoader) GetEnvironment(ctx context.Context, cli client.Client, name, namespace string) (*applicationapiv1alpha1.Environment, error) {
+	if ctx.Value(EnvironmentContextKey) == nil {
+		return l.loader.GetEnvironment(ctx, cli, name, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, EnvironmentContextKey, &applicationapiv1alpha1.Environment{})
+}
+
+// GetLimitRanges returns the resource and error passed as values of the context.
+func (l *mockLoader) GetLimitRanges(ctx context.Context, cli client.Client, namespace string) (*corev1.LimitRangeList, error) {
+	if ctx.Value(LimitRangesContextKey) == nil {
+		return l.loader.GetLimitRanges(ctx, cli, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, LimitRangesContextKey, &corev1.LimitRangeList{})
+}
+
 // GetMatchingReleasePlanAdmission returns the resource and error passed as values of the context.
 func (l *mockLoader) GetMatchingReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error) {
 	if ctx.Value(MatchedReleasePlanAdmissionContextKey) == nil {
@@ -90,6 +130,14 @@ func (l *mockLoader) GetMatchingReleasePlanAdmission(ctx context.Context, cli cl
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, MatchedReleasePlanAdmissionContextKey, &v1alpha1.ReleasePlanAdmission{})
 }
 
+// GetMatchingReleasePlanAdmissions returns the resource and error passed as values of the context.
+func (l *mockLoader) GetMatchingReleasePlanAdmissions(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmissionList, error) {
+	if ctx.Value(MatchedReleasePlanAdmissionsContextKey) == nil {
+		return l.loader.GetMatchingReleasePlanAdmissions(ctx, cli, releasePlan)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, MatchedReleasePlanAdmissionsContextKey, &v1alpha1.ReleasePlanAdmissionList{})
+}
+
 // GetMatchingReleasePlans returns the resource and error passed as values of the context.
 func (l *mockLoader) GetMatchingReleasePlans(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleasePlanList, error) {
 	if ctx.Value(MatchedReleasePlansContextKey) == nil {
@@ -114,6 +162,14 @@ func (l *mockLoader) GetRelease(ctx context.Context, cli client.Client, name, na
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleaseContextKey, &v1alpha1.Release{})
 }
 
+// GetReleaseWithIdempotencyKey returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleaseWithIdempotencyKey(ctx context.Context, cli client.Client, idempotencyKey, namespace string) (*v1alpha1.Release, error) {
+	if ctx.Value(ReleaseWithIdempotencyKeyContextKey) == nil {
+		return l.loader.GetReleaseWithIdempotencyKey(ctx, cli, idempotencyKey, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleaseWithIdempotencyKeyContextKey, &v1alpha1.Release{})
+}
+
 // GetRoleBindingFromReleaseStatus returns the resource and error passed as values of the context.
 func (l *mockLoader) GetRoleBindingFromReleaseStatus(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*rbac.RoleBinding, error) {
 	if ctx.Value(RoleBindingContextKey) == nil {
@@ -130,6 +186,14 @@ func (l *mockLoader) GetReleasePipelineRun(ctx context.Context, cli client.Clien
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePipelineRunContextKey, &tektonv1.PipelineRun{})
 }
 
+// GetReleasePipelineCatalog returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePipelineCatalog(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleasePipelineCatalog, error) {
+	if ctx.Value(ReleasePipelineCatalogContextKey) == nil {
+		return l.loader.GetReleasePipelineCatalog(ctx, cli, name, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePipelineCatalogContextKey, &v1alpha1.ReleasePipelineCatalog{})
+}
+
 // GetReleasePlan returns the resource and error passed as values of the context.
 func (l *mockLoader) GetReleasePlan(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlan, error) {
 	if ctx.Value(ReleasePlanContextKey) == nil {
@@ -138,6 +202,46 @@ func (l *mockLoader) GetReleasePlan(ctx context.Context, cli client.Client, rele
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePlanContextKey, &v1alpha1.ReleasePlan{})
 }
 
+// GetReleasePlanAdmissionDefaults returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePlanAdmissionDefaults(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanAdmissionDefaults, error) {
+	if ctx.Value(ReleasePlanAdmissionDefaultsContextKey) == nil {
+		return l.loader.GetReleasePlanAdmissionDefaults(ctx, cli, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePlanAdmissionDefaultsContextKey, &v1alpha1.ReleasePlanAdmissionDefaults{})
+}
+
+// GetReleasePlanAdmissions returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePlanAdmissions(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanAdmissionList, error) {
+	if ctx.Value(ReleasePlanAdmissionsContextKey) == nil {
+		return l.loader.GetReleasePlanAdmissions(ctx, cli, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePlanAdmissionsContextKey, &v1alpha1.ReleasePlanAdmissionList{})
+}
+
+// GetReleasePlans returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePlans(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleasePlanList, error) {
+	if ctx.Value(ReleasePlansContextKey) == nil {
+		return l.loader.GetReleasePlans(ctx, cli, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePlansContextKey, &v1alpha1.ReleasePlanList{})
+}
+
+// GetReleases returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleases(ctx context.Context, cli client.Client, namespace string) (*v1alpha1.ReleaseList, error) {
+	if ctx.Value(ReleasesContextKey) == nil {
+		return l.loader.GetReleases(ctx, cli, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasesContextKey, &v1alpha1.ReleaseList{})
+}
+
+// GetReleasesWithTarget returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasesWithTarget(ctx context.Context, cli client.Client, target string) (*v1alpha1.ReleaseList, error) {
+	if ctx.Value(ReleasesWithTargetContextKey) == nil {
+		return l.loader.GetReleasesWithTarget(ctx, cli, target)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasesWithTargetContextKey, &v1alpha1.ReleaseList{})
+}
+
 // GetReleaseServiceConfig returns the resource and error passed as values of the context.
 func (l *mockLoader) GetReleaseServiceConfig(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseServiceConfig, error) {
 	if ctx.Value(ReleaseServiceConfigContextKey) == nil {
@@ -146,6 +250,30 @@ func (l *mockLoader) GetReleaseServiceConfig(ctx context.Context, cli client.Cli
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleaseServiceConfigContextKey, &v1alpha1.ReleaseServiceConfig{})
 }
 
+// GetResourceQuotas returns the resource and error passed as values of the context.
+func (l *mockLoader) GetResourceQuotas(ctx context.Context, cli client.Client, namespace string) (*corev1.ResourceQuotaList, error) {
+	if ctx.Value(ResourceQuotasContextKey) == nil {
+		return l.loader.GetResourceQuotas(ctx, cli, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ResourceQuotasContextKey, &corev1.ResourceQuotaList{})
+}
+
+// GetSecret returns the resource and error passed as values of the context.
+func (l *mockLoader) GetSecret(ctx context.Context, cli client.Client, name, namespace string) (*corev1.Secret, error) {
+	if ctx.Value(SecretContextKey) == nil {
+		return l.loader.GetSecret(ctx, cli, name, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, SecretContextKey, &corev1.Secret{})
+}
+
+// GetServiceAccount returns the resource and error passed as values of the context.
+func (l *mockLoader) GetServiceAccount(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ServiceAccount, error) {
+	if ctx.Value(ServiceAccountContextKey) == nil {
+		return l.loader.GetServiceAccount(ctx, cli, name, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ServiceAccountContextKey, &corev1.ServiceAccount{})
+}
+
 // GetSnapshot returns the resource and error passed as values of the context.
 func (l *mockLoader) GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error) {
 	if ctx.Value(SnapshotContextKey) == nil {
@@ -154,6 +282,22 @@ func (l *mockLoader) GetSnapshot(ctx context.Context, cli client.Client, release
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, SnapshotContextKey, &applicationapiv1alpha1.Snapshot{})
 }
 
+// GetSnapshotEnvironmentBinding returns the resource and error passed as values of the context.
+func (l *mockLoader) GetSnapshotEnvironmentBinding(ctx context.Context, cli client.Client, application, environment, namespace string) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error) {
+	if ctx.Value(SnapshotEnvironmentBindingContextKey) == nil {
+		return l.loader.GetSnapshotEnvironmentBinding(ctx, cli, application, environment, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, SnapshotEnvironmentBindingContextKey, &applicationapiv1alpha1.SnapshotEnvironmentBinding{})
+}
+
+// GetSnapshotEnvironmentBindingsFromReleaseStatus returns the resource and error passed as values of the context.
+func (l *mockLoader) GetSnapshotEnvironmentBindingsFromReleaseStatus(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.SnapshotEnvironmentBindingList, error) {
+	if ctx.Value(SnapshotEnvironmentBindingsContextKey) == nil {
+		return l.loader.GetSnapshotEnvironmentBindingsFromReleaseStatus(ctx, cli, release)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, SnapshotEnvironmentBindingsContextKey, &applicationapiv1alpha1.SnapshotEnvironmentBindingList{})
+}
+
 // Composite functions
 
 // GetProcessingResources returns the resource and error passed as values of the context.
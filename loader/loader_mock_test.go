@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 )
 
@@ -67,6 +68,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetConfigMap", func() {
+		It("returns the resource and error from the context", func() {
+			configMap := &corev1.ConfigMap{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ConfigMapContextKey,
+					Resource:   configMap,
+				},
+			})
+			resource, err := loader.GetConfigMap(mockContext, nil, "", "")
+			Expect(resource).To(Equal(configMap))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetEnterpriseContractPolicy", func() {
 		It("returns the resource and error from the context", func() {
 			enterpriseContractPolicy := &v1alpha12.EnterpriseContractPolicy{}
@@ -82,6 +98,36 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetEnvironment", func() {
+		It("returns the resource and error from the context", func() {
+			environment := &applicationapiv1alpha1.Environment{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: EnvironmentContextKey,
+					Resource:   environment,
+				},
+			})
+			resource, err := loader.GetEnvironment(mockContext, nil, "", "")
+			Expect(resource).To(Equal(environment))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetLimitRanges", func() {
+		It("returns the resource and error from the context", func() {
+			limitRanges := &corev1.LimitRangeList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: LimitRangesContextKey,
+					Resource:   limitRanges,
+				},
+			})
+			resource, err := loader.GetLimitRanges(mockContext, nil, "")
+			Expect(resource).To(Equal(limitRanges))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetMatchingReleasePlanAdmission", func() {
 		It("returns the resource and error from the context", func() {
 			releasePlanAdmission := &v1alpha1.ReleasePlanAdmission{}
@@ -97,6 +143,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetMatchingReleasePlanAdmissions", func() {
+		It("returns the resource and error from the context", func() {
+			releasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: MatchedReleasePlanAdmissionsContextKey,
+					Resource:   releasePlanAdmissions,
+				},
+			})
+			resource, err := loader.GetMatchingReleasePlanAdmissions(mockContext, nil, nil)
+			Expect(resource).To(Equal(releasePlanAdmissions))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetMatchingReleasePlans", func() {
 		It("returns the resource and error from the context", func() {
 			releasePlans := &v1alpha1.ReleasePlanList{}
@@ -142,6 +203,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleaseWithIdempotencyKey", func() {
+		It("returns the resource and error from the context", func() {
+			release := &v1alpha1.Release{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleaseWithIdempotencyKeyContextKey,
+					Resource:   release,
+				},
+			})
+			resource, err := loader.GetReleaseWithIdempotencyKey(mockContext, nil, "", "")
+			Expect(resource).To(Equal(release))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetRoleBindingFromReleaseStatus", func() {
 		It("returns the resource and error from the context", func() {
 			roleBinding := &rbac.RoleBinding{}
@@ -187,6 +263,96 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleasePlanAdmissionDefaults", func() {
+		It("returns the resource and error from the context", func() {
+			releasePlanAdmissionDefaults := &v1alpha1.ReleasePlanAdmissionDefaults{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasePlanAdmissionDefaultsContextKey,
+					Resource:   releasePlanAdmissionDefaults,
+				},
+			})
+			resource, err := loader.GetReleasePlanAdmissionDefaults(mockContext, nil, "")
+			Expect(resource).To(Equal(releasePlanAdmissionDefaults))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetReleasePlanAdmissions", func() {
+		It("returns the resource and error from the context", func() {
+			releasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasePlanAdmissionsContextKey,
+					Resource:   releasePlanAdmissions,
+				},
+			})
+			resource, err := loader.GetReleasePlanAdmissions(mockContext, nil, "")
+			Expect(resource).To(Equal(releasePlanAdmissions))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetReleasePlans", func() {
+		It("returns the resource and error from the context", func() {
+			releasePlans := &v1alpha1.ReleasePlanList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasePlansContextKey,
+					Resource:   releasePlans,
+				},
+			})
+			resource, err := loader.GetReleasePlans(mockContext, nil, "")
+			Expect(resource).To(Equal(releasePlans))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetReleases", func() {
+		It("returns the resource and error from the context", func() {
+			releases := &v1alpha1.ReleaseList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasesContextKey,
+					Resource:   releases,
+				},
+			})
+			resource, err := loader.GetReleases(mockContext, nil, "")
+			Expect(resource).To(Equal(releases))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetReleasesWithTarget", func() {
+		It("returns the resource and error from the context", func() {
+			releases := &v1alpha1.ReleaseList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasesWithTargetContextKey,
+					Resource:   releases,
+				},
+			})
+			resource, err := loader.GetReleasesWithTarget(mockContext, nil, "")
+			Expect(resource).To(Equal(releases))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetReleasePipelineCatalog", func() {
+		It("returns the resource and error from the context", func() {
+			releasePipelineCatalog := &v1alpha1.ReleasePipelineCatalog{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasePipelineCatalogContextKey,
+					Resource:   releasePipelineCatalog,
+				},
+			})
+			resource, err := loader.GetReleasePipelineCatalog(mockContext, nil, "", "")
+			Expect(resource).To(Equal(releasePipelineCatalog))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetReleaseServiceConfig", func() {
 		It("returns the resource and error from the context", func() {
 			releaseServiceConfig := &v1alpha1.ReleaseServiceConfig{}
@@ -202,6 +368,51 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetResourceQuotas", func() {
+		It("returns the resource and error from the context", func() {
+			resourceQuotas := &corev1.ResourceQuotaList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ResourceQuotasContextKey,
+					Resource:   resourceQuotas,
+				},
+			})
+			resource, err := loader.GetResourceQuotas(mockContext, nil, "")
+			Expect(resource).To(Equal(resourceQuotas))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetSecret", func() {
+		It("returns the resource and error from the context", func() {
+			secret := &corev1.Secret{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: SecretContextKey,
+					Resource:   secret,
+				},
+			})
+			resource, err := loader.GetSecret(mockContext, nil, "", "")
+			Expect(resource).To(Equal(secret))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetServiceAccount", func() {
+		It("returns the resource and error from the context", func() {
+			serviceAccount := &corev1.ServiceAccount{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ServiceAccountContextKey,
+					Resource:   serviceAccount,
+				},
+			})
+			resource, err := loader.GetServiceAccount(mockContext, nil, "", "")
+			Expect(resource).To(Equal(serviceAccount))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetSnapshot", func() {
 		It("returns the resource and error from the context", func() {
 			snapshot := &applicationapiv1alpha1.Snapshot{}
@@ -217,6 +428,36 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetSnapshotEnvironmentBinding", func() {
+		It("returns the resource and error from the context", func() {
+			snapshotEnvironmentBinding := &applicationapiv1alpha1.SnapshotEnvironmentBinding{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: SnapshotEnvironmentBindingContextKey,
+					Resource:   snapshotEnvironmentBinding,
+				},
+			})
+			resource, err := loader.GetSnapshotEnvironmentBinding(mockContext, nil, "", "", "")
+			Expect(resource).To(Equal(snapshotEnvironmentBinding))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetSnapshotEnvironmentBindingsFromReleaseStatus", func() {
+		It("returns the resource and error from the context", func() {
+			snapshotEnvironmentBindings := &applicationapiv1alpha1.SnapshotEnvironmentBindingList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: SnapshotEnvironmentBindingsContextKey,
+					Resource:   snapshotEnvironmentBindings,
+				},
+			})
+			resource, err := loader.GetSnapshotEnvironmentBindingsFromReleaseStatus(mockContext, nil, nil)
+			Expect(resource).To(Equal(snapshotEnvironmentBindings))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	// Composite functions
 
 	When("calling GetProcessingResources", func() {
@@ -34,6 +34,7 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		component                   *applicationapiv1alpha1.Component
 		enterpriseContractConfigMap *corev1.ConfigMap
 		enterpriseContractPolicy    *ecapiv1alpha1.EnterpriseContractPolicy
+		environment                 *applicationapiv1alpha1.Environment
 		managedPipelineRun          *tektonv1.PipelineRun
 		tenantPipelineRun           *tektonv1.PipelineRun
 		release                     *v1alpha1.Release
@@ -41,7 +42,10 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		releasePlanAdmission        *v1alpha1.ReleasePlanAdmission
 		releaseServiceConfig        *v1alpha1.ReleaseServiceConfig
 		roleBinding                 *rbac.RoleBinding
+		secret                      *corev1.Secret
+		serviceAccount              *corev1.ServiceAccount
 		snapshot                    *applicationapiv1alpha1.Snapshot
+		snapshotEnvironmentBinding  *applicationapiv1alpha1.SnapshotEnvironmentBinding
 	)
 
 	AfterAll(func() {
@@ -110,6 +114,15 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetConfigMap", func() {
+		It("returns the requested ConfigMap", func() {
+			returnedObject, err := loader.GetConfigMap(ctx, k8sClient, enterpriseContractConfigMap.Name, enterpriseContractConfigMap.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).NotTo(Equal(&corev1.ConfigMap{}))
+			Expect(returnedObject.Name).To(Equal(enterpriseContractConfigMap.Name))
+		})
+	})
+
 	When("calling GetEnterpriseContractConfigMap", func() {
 		It("returns nil when the ENTERPRISE_CONTRACT_CONFIG_MAP variable is not set", func() {
 			os.Unsetenv("ENTERPRISE_CONTRACT_CONFIG_MAP")
@@ -136,6 +149,23 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetEnvironment", func() {
+		It("returns the requested environment", func() {
+			returnedObject, err := loader.GetEnvironment(ctx, k8sClient, environment.Name, environment.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).NotTo(Equal(&applicationapiv1alpha1.Environment{}))
+			Expect(returnedObject.Name).To(Equal(environment.Name))
+		})
+	})
+
+	When("calling GetLimitRanges", func() {
+		It("returns an empty list if there are no LimitRanges in the namespace", func() {
+			returnedObject, err := loader.GetLimitRanges(ctx, k8sClient, "default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(BeEmpty())
+		})
+	})
+
 	When("calling GetMatchingReleasePlanAdmission", func() {
 		It("returns a release plan admission", func() {
 			returnedObject, err := loader.GetMatchingReleasePlanAdmission(ctx, k8sClient, releasePlan)
@@ -208,6 +238,51 @@ var _ = Describe("Release Adapter", Ordered, func() {
 
 			Expect(k8sClient.Delete(ctx, newReleasePlanAdmission)).To(Succeed())
 		})
+
+		It("returns the first matching release plan admission if the ReleasePlan fans out", func() {
+			modifiedReleasePlan := releasePlan.DeepCopy()
+			modifiedReleasePlan.Spec.FanOutPolicy = v1alpha1.FanOutPolicyAll
+
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Name = "zz-release-plan-admission"
+			newReleasePlanAdmission.ResourceVersion = ""
+			Expect(k8sClient.Create(ctx, newReleasePlanAdmission)).To(Succeed())
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetMatchingReleasePlanAdmission(ctx, k8sClient, modifiedReleasePlan)
+				return err == nil && returnedObject.Name == releasePlanAdmission.Name
+			})
+
+			Expect(k8sClient.Delete(ctx, newReleasePlanAdmission)).To(Succeed())
+		})
+	})
+
+	When("calling GetMatchingReleasePlanAdmissions", func() {
+		It("returns all the matching release plan admissions sorted by name", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Name = "zz-release-plan-admission"
+			newReleasePlanAdmission.ResourceVersion = ""
+			Expect(k8sClient.Create(ctx, newReleasePlanAdmission)).To(Succeed())
+
+			Eventually(func() bool {
+				returnedObjects, err := loader.GetMatchingReleasePlanAdmissions(ctx, k8sClient, releasePlan)
+				return err == nil && len(returnedObjects.Items) == 2 &&
+					returnedObjects.Items[0].Name == releasePlanAdmission.Name &&
+					returnedObjects.Items[1].Name == newReleasePlanAdmission.Name
+			})
+
+			Expect(k8sClient.Delete(ctx, newReleasePlanAdmission)).To(Succeed())
+		})
+
+		It("fails to return the release plan admissions if the target is nil", func() {
+			modifiedReleasePlan := releasePlan.DeepCopy()
+			modifiedReleasePlan.Spec.Target = ""
+
+			returnedObjects, err := loader.GetMatchingReleasePlanAdmissions(ctx, k8sClient, modifiedReleasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has no target"))
+			Expect(returnedObjects).To(BeNil())
+		})
 	})
 
 	When("calling GetMatchingReleasePlans", func() {
@@ -337,6 +412,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleaseWithIdempotencyKey", func() {
+		It("returns the release with the given idempotency key", func() {
+			Eventually(func() bool {
+				returnedObject, err := loader.GetReleaseWithIdempotencyKey(ctx, k8sClient, "idempotency-key", release.Namespace)
+				return err == nil && returnedObject.Name == release.Name
+			}).Should(BeTrue())
+		})
+
+		It("returns a NotFound error if no release has the given idempotency key", func() {
+			returnedObject, err := loader.GetReleaseWithIdempotencyKey(ctx, k8sClient, "non-existent", release.Namespace)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(returnedObject).To(BeNil())
+		})
+	})
+
 	When("calling GetRoleBindingFromReleaseStatus", func() {
 		It("fails to return a RoleBinding if the reference is not in the release", func() {
 			returnedObject, err := loader.GetRoleBindingFromReleaseStatus(ctx, k8sClient, release)
@@ -399,6 +489,59 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleasePlanAdmissions", func() {
+		It("returns the release plan admissions in the given namespace", func() {
+			Eventually(func() bool {
+				returnedObject, err := loader.GetReleasePlanAdmissions(ctx, k8sClient, releasePlanAdmission.Namespace)
+				return err == nil && len(returnedObject.Items) > 0
+			}).Should(BeTrue())
+		})
+	})
+
+	When("calling GetReleasePlans", func() {
+		It("returns the release plans in the given namespace", func() {
+			Eventually(func() bool {
+				returnedObject, err := loader.GetReleasePlans(ctx, k8sClient, releasePlan.Namespace)
+				return err == nil && len(returnedObject.Items) > 0
+			}).Should(BeTrue())
+		})
+	})
+
+	When("calling GetReleases", func() {
+		It("returns the releases in the given namespace", func() {
+			Eventually(func() bool {
+				returnedObject, err := loader.GetReleases(ctx, k8sClient, release.Namespace)
+				return err == nil && len(returnedObject.Items) > 0
+			}).Should(BeTrue())
+		})
+	})
+
+	When("calling GetReleasesWithTarget", func() {
+		It("returns no releases if none targets the given namespace", func() {
+			returnedObject, err := loader.GetReleasesWithTarget(ctx, k8sClient, "non-existent")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(BeEmpty())
+		})
+	})
+
+	When("calling GetReleasePipelineCatalog", func() {
+		It("returns an error if the ReleasePipelineCatalog doesn't exist", func() {
+			returnedObject, err := loader.GetReleasePipelineCatalog(ctx, k8sClient, "non-existent-catalog", "default")
+			Expect(err).To(HaveOccurred())
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(returnedObject).To(Equal(&v1alpha1.ReleasePipelineCatalog{}))
+		})
+	})
+
+	When("calling GetReleasePlanAdmissionDefaults", func() {
+		It("returns an error if the namespace has no ReleasePlanAdmissionDefaults", func() {
+			returnedObject, err := loader.GetReleasePlanAdmissionDefaults(ctx, k8sClient, "default")
+			Expect(err).To(HaveOccurred())
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(returnedObject).To(Equal(&v1alpha1.ReleasePlanAdmissionDefaults{}))
+		})
+	})
+
 	When("calling GetReleaseServiceConfig", func() {
 		It("returns the requested ReleaseServiceConfig", func() {
 			returnedObject, err := loader.GetReleaseServiceConfig(ctx, k8sClient, releaseServiceConfig.Name, releaseServiceConfig.Namespace)
@@ -408,6 +551,32 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetResourceQuotas", func() {
+		It("returns an empty list if there are no ResourceQuotas in the namespace", func() {
+			returnedObject, err := loader.GetResourceQuotas(ctx, k8sClient, "default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(BeEmpty())
+		})
+	})
+
+	When("calling GetSecret", func() {
+		It("returns the requested secret", func() {
+			returnedObject, err := loader.GetSecret(ctx, k8sClient, secret.Name, secret.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).NotTo(Equal(&corev1.Secret{}))
+			Expect(returnedObject.Data).To(HaveKeyWithValue("token", []byte("foo")))
+		})
+	})
+
+	When("calling GetServiceAccount", func() {
+		It("returns the requested service account", func() {
+			returnedObject, err := loader.GetServiceAccount(ctx, k8sClient, serviceAccount.Name, serviceAccount.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).NotTo(Equal(&corev1.ServiceAccount{}))
+			Expect(returnedObject.ImagePullSecrets).To(Equal(serviceAccount.ImagePullSecrets))
+		})
+	})
+
 	When("calling GetSnapshot", func() {
 		It("returns the requested snapshot", func() {
 			returnedObject, err := loader.GetSnapshot(ctx, k8sClient, release)
@@ -417,6 +586,52 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetSnapshotEnvironmentBinding", func() {
+		It("returns the binding of the given application and environment", func() {
+			Eventually(func() bool {
+				returnedObject, err := loader.GetSnapshotEnvironmentBinding(ctx, k8sClient, application.Name,
+					environment.Name, environment.Namespace)
+				return err == nil && returnedObject.Name == snapshotEnvironmentBinding.Name
+			}).Should(BeTrue())
+		})
+
+		It("returns a NotFound error if no binding exists for the given application", func() {
+			returnedObject, err := loader.GetSnapshotEnvironmentBinding(ctx, k8sClient, "non-existent",
+				environment.Name, environment.Namespace)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(returnedObject).To(BeNil())
+		})
+	})
+
+	When("calling GetSnapshotEnvironmentBindingsFromReleaseStatus", func() {
+		It("returns an empty list if the release has no bindings in its status", func() {
+			returnedObject, err := loader.GetSnapshotEnvironmentBindingsFromReleaseStatus(ctx, k8sClient, release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(BeEmpty())
+		})
+
+		It("fails if a reference is not valid", func() {
+			modifiedRelease := release.DeepCopy()
+			modifiedRelease.Status.Deployment.SnapshotEnvironmentBindings = []string{"foo"}
+
+			returnedObject, err := loader.GetSnapshotEnvironmentBindingsFromReleaseStatus(ctx, k8sClient, modifiedRelease)
+			Expect(returnedObject).To(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid reference to a SnapshotEnvironmentBinding"))
+		})
+
+		It("returns the referenced bindings", func() {
+			modifiedRelease := release.DeepCopy()
+			modifiedRelease.Status.Deployment.SnapshotEnvironmentBindings = []string{
+				fmt.Sprintf("%s%c%s", snapshotEnvironmentBinding.Namespace, types.Separator, snapshotEnvironmentBinding.Name),
+			}
+
+			returnedObject, err := loader.GetSnapshotEnvironmentBindingsFromReleaseStatus(ctx, k8sClient, modifiedRelease)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(HaveLen(1))
+			Expect(returnedObject.Items[0].Name).To(Equal(snapshotEnvironmentBinding.Name))
+		})
+	})
+
 	// Composite functions
 
 	When("calling GetProcessingResources", func() {
@@ -431,6 +646,7 @@ var _ = Describe("Release Adapter", Ordered, func() {
 				"ReleasePlanAdmission":        Not(BeNil()),
 				"Snapshot":                    Not(BeNil()),
 			}))
+			Expect(resources.ReleasePlanAdmissionDefaults).To(BeNil())
 		})
 
 		It("fails if any resource fails to be fetched", func() {
@@ -557,14 +773,61 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		}
 		Expect(k8sClient.Create(ctx, snapshot)).To(Succeed())
 
+		environment = &applicationapiv1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "environment",
+				Namespace: "default",
+			},
+			Spec: applicationapiv1alpha1.EnvironmentSpec{
+				DeploymentStrategy: applicationapiv1alpha1.DeploymentStrategy_Manual,
+				DisplayName:        "environment",
+			},
+		}
+		Expect(k8sClient.Create(ctx, environment)).To(Succeed())
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"token": []byte("foo"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "service-account",
+				Namespace: "default",
+			},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: secret.Name}},
+		}
+		Expect(k8sClient.Create(ctx, serviceAccount)).To(Succeed())
+
+		snapshotEnvironmentBinding = &applicationapiv1alpha1.SnapshotEnvironmentBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "snapshot-environment-binding",
+				Namespace: "default",
+			},
+			Spec: applicationapiv1alpha1.SnapshotEnvironmentBindingSpec{
+				Application: application.Name,
+				Environment: environment.Name,
+				Snapshot:    snapshot.Name,
+				Components:  []applicationapiv1alpha1.BindingComponent{},
+			},
+		}
+		Expect(k8sClient.Create(ctx, snapshotEnvironmentBinding)).To(Succeed())
+
 		release = &v1alpha1.Release{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "release",
 				Namespace: "default",
 			},
 			Spec: v1alpha1.ReleaseSpec{
-				Snapshot:    snapshot.Name,
-				ReleasePlan: releasePlan.Name,
+				Snapshot:       snapshot.Name,
+				ReleasePlan:    releasePlan.Name,
+				IdempotencyKey: "idempotency-key",
 			},
 		}
 		Expect(k8sClient.Create(ctx, release)).To(Succeed())
@@ -606,7 +869,11 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		Expect(k8sClient.Delete(ctx, releasePlan)).To(Succeed())
 		Expect(k8sClient.Delete(ctx, releasePlanAdmission)).To(Succeed())
 		Expect(k8sClient.Delete(ctx, roleBinding)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, serviceAccount)).To(Succeed())
 		Expect(k8sClient.Delete(ctx, snapshot)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, environment)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, snapshotEnvironmentBinding)).To(Succeed())
 	}
 
 })
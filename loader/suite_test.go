@@ -111,8 +111,11 @@ var _ = BeforeSuite(func() {
 
 		Expect(cache.SetupComponentCache(mgr)).To(Succeed())
 		Expect(cache.SetupReleaseCache(mgr)).To(Succeed())
+		Expect(cache.SetupReleaseIdempotencyKeyCache(mgr)).To(Succeed())
+		Expect(cache.SetupReleaseTargetCache(mgr)).To(Succeed())
 		Expect(cache.SetupReleasePlanCache(mgr)).To(Succeed())
 		Expect(cache.SetupReleasePlanAdmissionCache(mgr)).To(Succeed())
+		Expect(cache.SetupSnapshotEnvironmentBindingCache(mgr)).To(Succeed())
 
 		Expect(mgr.Start(ctx)).To(Succeed())
 	}()
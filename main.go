@@ -17,15 +17,21 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
+
+	// Embed the time zone database, so the time zones of the release schedules can be loaded in any base image
+	_ "time/tzdata"
 
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	crwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/konflux-ci/operator-toolkit/controller"
-	"github.com/konflux-ci/operator-toolkit/webhook"
 	"github.com/konflux-ci/release-service/api/v1alpha1/webhooks"
 
 	"go.uber.org/zap/zapcore"
@@ -37,17 +43,34 @@ import (
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 
+	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 
 	appstudiov1alpha1 "github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/cache"
+	"github.com/konflux-ci/release-service/compatibility"
 	"github.com/konflux-ci/release-service/controllers"
+	"github.com/konflux-ci/release-service/dryrun"
+	"github.com/konflux-ci/release-service/guardrails"
+	"github.com/konflux-ci/release-service/history"
+	"github.com/konflux-ci/release-service/listeners"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/metrics"
+	"github.com/konflux-ci/release-service/tlsconfig"
+	"github.com/konflux-ci/release-service/tracking"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -67,16 +90,86 @@ func init() {
 }
 
 func main() {
+	var cachedObjectsLimit int
+	var cachedObjectsWarningThreshold int
+	var guardrailsInterval time.Duration
+	var memoryWarningThreshold string
 	var metricsAddr string
+	var metricsExemplars bool
+	var metricsSecure bool
+	var metricsTargetLabelMode string
+	var metricsTargetLabelBuckets int
+	var enabledControllers string
+	var enableHistoryApi bool
 	var enableHttp2 bool
 	var enableLeaderElection bool
+	var enableScopedCache bool
+	var fipsMode bool
+	var pprofAddr string
 	var probeAddr string
-	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
-	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
-	flag.BoolVar(&enableHttp2, "enable-http2", false, "Enable HTTP/2 for the metrics and webhook servers.")
+	var repairTrackingLabels bool
+	var tlsCipherSuites string
+	var tlsMinVersion string
+	var watchedNamespaces string
+	var webhookAddr string
+	flag.IntVar(&cachedObjectsLimit, "cached-objects-limit", 0,
+		"The number of cached objects above which the reads are served directly by the API server until the cache "+
+			"goes back under it. The reads are never degraded if set to 0.")
+	flag.IntVar(&cachedObjectsWarningThreshold, "cached-objects-warning-threshold", 0,
+		"The number of cached objects above which a warning suggesting to enable cache filtering is logged. No "+
+			"warning is logged if set to 0.")
+	flag.DurationVar(&guardrailsInterval, "guardrails-interval", time.Minute,
+		"The interval between the checks of the number of cached objects and the resident memory.")
+	flag.StringVar(&memoryWarningThreshold, "memory-warning-threshold", "",
+		"The resident memory (e.g. 1Gi) above which a warning suggesting to enable cache filtering is logged. No "+
+			"warning is logged if not set.")
+	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080",
+		"The address the metric endpoint binds to. Set it to 0 to disable the metrics server.")
+	flag.BoolVar(&metricsExemplars, "metrics-exemplars", false,
+		"Attach the trace IDs of the Releases as exemplars to the release duration histograms and serve them in the "+
+			"OpenMetrics format at "+metrics.ExemplarsPath+".")
+	flag.BoolVar(&metricsSecure, "metrics-secure", false,
+		"Serve the metrics over HTTPS, applying the TLS settings of the service.")
+	flag.StringVar(&metricsTargetLabelMode, "metrics-target-label-mode", string(metrics.LabelModeRaw),
+		"How the target label is attached to the release metrics (raw, hashed, dropped).")
+	flag.IntVar(&metricsTargetLabelBuckets, "metrics-target-label-buckets", 16,
+		"The number of values the target label can take when the hashed mode is used.")
+	flag.StringVar(&pprofAddr, "pprof-bind-address", "",
+		"The address the pprof endpoint binds to. The pprof server is disabled if not set.")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081",
+		"The address the probe endpoint binds to. Set it to 0 to disable the probe server.")
+	flag.StringVar(&webhookAddr, "webhook-bind-address", ":9443", "The address the webhook server binds to.")
+	flag.BoolVar(&repairTrackingLabels, "repair-tracking-labels", true,
+		"Add the missing Release tracking labels to the PipelineRuns and workspace claims created by previous "+
+			"versions on startup.")
+	flag.StringVar(&enabledControllers, "controllers", "",
+		"Comma-separated list of controllers to enable (application, applicationreleasestatus, archive, outbox, "+
+			"pipelinerun, release, releasenotes, releaseplan, releaseplanadmission, signing). All the controllers but the "+
+			"optional application, applicationreleasestatus, archive, outbox, releasenotes and signing controllers are "+
+			"enabled if not set.")
+	flag.BoolVar(&enableHistoryApi, "enable-history-api", false,
+		"Serve the read-only release history endpoints in the metrics server.")
+	flag.BoolVar(&enableHttp2, "enable-http2", false,
+		"Enable HTTP/2 for the webhook server, and for the metrics server with --metrics-secure.")
+	flag.BoolVar(&fipsMode, "fips", false,
+		"Refuse to start if the binary doesn't use a FIPS-validated crypto backend in FIPS mode, and restrict the TLS "+
+			"settings of the metrics and webhook servers to the FIPS-approved ones. Requires --metrics-secure unless "+
+			"the metrics server is disabled or only binds to a loopback address.")
+	flag.StringVar(&tlsCipherSuites, "tls-cipher-suites", "",
+		"Comma-separated list of the TLS 1.2 cipher suites allowed by the webhook server, and by the metrics server "+
+			"with --metrics-secure. The Go defaults are used if not set.")
+	flag.StringVar(&tlsMinVersion, "tls-min-version", "",
+		"The minimum TLS version (1.2 or 1.3) accepted by the webhook server, and by the metrics server with "+
+			"--metrics-secure. The Go default is used if not set.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&enableScopedCache, "scoped-cache", false,
+		"Only cache the namespaced objects in the namespaces with ReleasePlans or ReleasePlanAdmissions and the "+
+			"namespaces they point to, reading the rest directly from the API server.")
+	flag.StringVar(&watchedNamespaces, "namespaces", "",
+		"Comma-separated list of namespaces to reconcile. The namespace the service runs in, set in the "+
+			"SERVICE_NAMESPACE environment variable, is always included. All the namespaces are reconciled if not set.")
 	opts := zap.Options{
 		Development: true,
 		TimeEncoder: zapcore.ISO8601TimeEncoder,
@@ -86,22 +179,117 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	// IPv6 hosts are enclosed in brackets (e.g. [::]:8080), while empty hosts bind to all the IPv4 and IPv6 addresses
+	metricsListener := listeners.Listener{Flag: "metrics-bind-address", Address: metricsAddr, Optional: true}
+	err := listeners.Validate(
+		listeners.Listener{Flag: "health-probe-bind-address", Address: probeAddr, Optional: true},
+		metricsListener,
+		listeners.Listener{Flag: "pprof-bind-address", Address: pprofAddr, Optional: true},
+		listeners.Listener{Flag: "webhook-bind-address", Address: webhookAddr},
+	)
+	if err != nil {
+		setupLog.Error(err, "invalid listener configuration")
+		os.Exit(1)
+	}
+	webhookHost, webhookPort, _ := listeners.SplitHostPort(webhookAddr)
+
+	// The TLS settings are shared by all the servers, and FIPS mode refuses to start without a FIPS-validated backend
+	tlsOpts, err := tlsconfig.New(tlsconfig.Options{
+		CipherSuites: tlsCipherSuites,
+		EnableHTTP2:  enableHttp2,
+		FIPS:         fipsMode,
+		MinVersion:   tlsMinVersion,
+	})
+	if err != nil {
+		setupLog.Error(err, "invalid TLS configuration")
+		os.Exit(1)
+	}
+
+	// The TLS settings don't apply to the metrics served over HTTP, which is only allowed in FIPS mode when a proxy in
+	// the Pod terminates TLS
+	if fipsMode && !metricsSecure && !metricsListener.IsDisabled() && !metricsListener.IsLoopback() {
+		setupLog.Error(nil, "the fips flag requires the metrics-secure flag unless the metrics server is disabled or "+
+			"only binds to a loopback address")
+		os.Exit(1)
+	}
+
+	err = metrics.SetTargetLabelMode(metrics.LabelMode(metricsTargetLabelMode), metricsTargetLabelBuckets)
+	if err != nil {
+		setupLog.Error(err, "unable to setup metrics labels")
+		os.Exit(1)
+	}
+	metrics.SetExemplarsEnabled(metricsExemplars)
+
+	defaultNamespaces, err := getDefaultNamespaces(watchedNamespaces, os.Getenv("SERVICE_NAMESPACE"))
+	if err != nil {
+		setupLog.Error(err, "invalid namespaces flag")
+		os.Exit(1)
+	}
+
+	if enableScopedCache && defaultNamespaces != nil {
+		setupLog.Error(nil, "the scoped-cache and namespaces flags can't be used together")
+		os.Exit(1)
+	}
+
+	memoryWarningQuantity := resource.Quantity{}
+	if memoryWarningThreshold != "" {
+		memoryWarningQuantity, err = resource.ParseQuantity(memoryWarningThreshold)
+		if err != nil {
+			setupLog.Error(err, "invalid memory-warning-threshold flag")
+			os.Exit(1)
+		}
+	}
+
+	// The guardrails monitor degrades the reads of the manager client to the API server when the cache grows too big
+	guardrailsMonitor := guardrails.NewMonitor(guardrails.Options{
+		CachedObjectsLimit:            cachedObjectsLimit,
+		CachedObjectsWarningThreshold: cachedObjectsWarningThreshold,
+		Interval:                      guardrailsInterval,
+		MemoryWarningThreshold:        memoryWarningQuantity.Value(),
+	}, ctrl.Log.WithName("guardrails"))
+	var newClient client.NewClientFunc
+	if cachedObjectsLimit > 0 {
+		newClient = guardrailsMonitor.NewClient
+	}
+
+	// The scoped cache adds and removes namespaces as ReleasePlans and ReleasePlanAdmissions change
+	var newCache crcache.NewCacheFunc
+	if enableScopedCache {
+		newCache = cache.NewScopedCacheFunc(os.Getenv("SERVICE_NAMESPACE"))
+	}
+
+	managedBySelector := labels.SelectorFromSet(labels.Set{metadata.ManagedByLabel: metadata.ManagedByLabelValue})
+
+	// The reconcilers recover from their own panics to quarantine the objects causing them. Recovering in the controllers
+	// too keeps the panics raised outside of them from crashing the manager
+	recoverPanic := true
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Controller: config.Controller{
+			RecoverPanic: &recoverPanic,
+		},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "f3d4c01a.redhat.com",
 		Metrics: server.Options{
-			BindAddress: metricsAddr,
+			BindAddress:   metricsAddr,
+			SecureServing: metricsSecure,
+			TLSOpts:       []func(*tls.Config){tlsOpts},
 		},
-		WebhookServer: crwebhook.NewServer(crwebhook.Options{
-			Port: 9443,
-			TLSOpts: []func(*tls.Config){
-				func(c *tls.Config) {
-					if !enableHttp2 {
-						c.NextProtos = []string{"http/1.1"}
-					}
-				},
+		Cache: crcache.Options{
+			// The service only reads the Roles and RoleBindings it manages, so the rest are kept out of the cache
+			ByObject: map[client.Object]crcache.ByObject{
+				&rbac.Role{}:        {Label: managedBySelector},
+				&rbac.RoleBinding{}: {Label: managedBySelector},
 			},
+			DefaultNamespaces: defaultNamespaces,
+		},
+		NewCache:         newCache,
+		NewClient:        newClient,
+		PprofBindAddress: pprofAddr,
+		WebhookServer: crwebhook.NewServer(crwebhook.Options{
+			Host:    webhookHost,
+			Port:    webhookPort,
+			TLSOpts: []func(*tls.Config){tlsOpts},
 		}),
 		Scheme: scheme,
 	})
@@ -137,15 +325,78 @@ func main() {
 		}
 	}
 
-	setUpControllers(mgr)
+	setUpControllers(mgr, enabledControllers)
 	setUpWebhooks(mgr)
 
+	// The match and simulation handlers list ReleasePlanAdmissions by origin, so the index is registered regardless of
+	// the controllers enabled
+	err = cache.SetupReleasePlanAdmissionCache(mgr)
+	if err != nil {
+		setupLog.Error(err, "unable to setup the ReleasePlanAdmission origin index")
+		os.Exit(1)
+	}
+
+	// The match and simulation handlers are served by the metrics server, so they are protected by the same auth proxy
+	err = mgr.AddMetricsServerExtraHandler(dryrun.MatchPath, dryrun.NewMatchHandler(mgr.GetClient(), ctrl.Log))
+	if err != nil {
+		setupLog.Error(err, "unable to setup the ReleasePlanAdmission match handler")
+		os.Exit(1)
+	}
+
+	err = mgr.AddMetricsServerExtraHandler(dryrun.SimulationPath, dryrun.NewSimulationHandler(mgr.GetClient(), ctrl.Log))
+	if err != nil {
+		setupLog.Error(err, "unable to setup the ReleasePlan simulation handler")
+		os.Exit(1)
+	}
+
+	if enableHistoryApi {
+		setUpHistoryApi(mgr)
+	}
+
+	// The default metrics handler doesn't negotiate the OpenMetrics format, so the exemplars are served separately
+	if metricsExemplars {
+		err = mgr.AddMetricsServerExtraHandler(metrics.ExemplarsPath, metrics.NewExemplarsHandler())
+		if err != nil {
+			setupLog.Error(err, "unable to setup the metrics exemplars handler")
+			os.Exit(1)
+		}
+	}
+
 	err = os.Setenv("ENTERPRISE_CONTRACT_CONFIG_MAP", "enterprise-contract-service/ec-defaults")
 	if err != nil {
 		setupLog.Error(err, "unable to setup ENTERPRISE_CONTRACT_CONFIG_MAP environment variable")
 		os.Exit(1)
 	}
 
+	// Register how long it takes the caches to sync from the moment the manager is started
+	startTime := time.Now()
+	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if mgr.GetCache().WaitForCacheSync(ctx) {
+			metrics.RegisterCacheSync(time.Since(startTime))
+		}
+		return nil
+	}))
+	if err != nil {
+		setupLog.Error(err, "unable to setup the cache sync metric")
+		os.Exit(1)
+	}
+
+	guardrailsMonitor.SetCache(mgr.GetCache())
+	err = mgr.Add(guardrailsMonitor)
+	if err != nil {
+		setupLog.Error(err, "unable to setup the guardrails monitor")
+		os.Exit(1)
+	}
+
+	// Objects created by previous versions might miss the labels linking them back to their Release
+	if repairTrackingLabels {
+		err = mgr.Add(tracking.NewRepairer(mgr.GetClient(), ctrl.Log.WithName("tracking")))
+		if err != nil {
+			setupLog.Error(err, "unable to setup the tracking labels repair")
+			os.Exit(1)
+		}
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -157,6 +408,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The service doesn't become ready while the installed CRDs don't match the versions it was built for
+	compatibilityChecker := compatibility.NewChecker(mgr.GetRESTMapper(), compatibility.RequiredKinds...)
+	if err := compatibilityChecker.Check(); err != nil {
+		setupLog.Error(err, "incompatible CRDs found")
+	}
+	if err := mgr.AddReadyzCheck("crd-compatibility", compatibilityChecker.ReadyzCheck); err != nil {
+		setupLog.Error(err, "unable to set up CRD compatibility check")
+		os.Exit(1)
+	}
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
@@ -164,22 +425,69 @@ func main() {
 	}
 }
 
-// setUpControllers sets up controllers.
-func setUpControllers(mgr ctrl.Manager) {
-	err := controller.SetupControllers(mgr, nil, controllers.EnabledControllers...)
+// getDefaultNamespaces returns the cache configuration restricting the objects watched to the given comma-separated
+// list of namespaces and the given namespace the service runs in, as it holds the ReleaseServiceConfig. If the list has
+// no namespaces, nil is returned, so all the namespaces are watched. An error is returned if the list has namespaces
+// but the service namespace is not set, as the ReleaseServiceConfig wouldn't be watched.
+func getDefaultNamespaces(names, serviceNamespace string) (map[string]crcache.Config, error) {
+	namespaces := map[string]crcache.Config{}
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			namespaces[name] = crcache.Config{}
+		}
+	}
+
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+
+	if serviceNamespace == "" {
+		return nil, fmt.Errorf("the SERVICE_NAMESPACE environment variable is required to reconcile a list of namespaces")
+	}
+	namespaces[serviceNamespace] = crcache.Config{}
+
+	return namespaces, nil
+}
+
+// setUpControllers sets up the controllers matching the given comma-separated list of names. If the list is empty,
+// all the non optional controllers are set up.
+func setUpControllers(mgr ctrl.Manager, names string) {
+	enabledControllers, err := controllers.GetEnabledControllers(strings.Split(names, ",")...)
+	if err != nil {
+		setupLog.Error(err, "unable to find controllers")
+		os.Exit(1)
+	}
+
+	err = controller.SetupControllers(mgr, nil, enabledControllers...)
 	if err != nil {
 		setupLog.Error(err, "unable to setup controllers")
 		os.Exit(1)
 	}
 }
 
+// setUpHistoryApi sets up the read-only release history endpoints. They are served by the metrics server, so they
+// are protected by the same auth proxy, and read from the informer cache so dashboards don't hit the Kubernetes API.
+func setUpHistoryApi(mgr ctrl.Manager) {
+	err := mgr.AddMetricsServerExtraHandler(history.ReleasesPath, history.NewReleasesHandler(mgr.GetClient(), ctrl.Log))
+	if err != nil {
+		setupLog.Error(err, "unable to setup the release history handler")
+		os.Exit(1)
+	}
+
+	err = mgr.AddMetricsServerExtraHandler(history.StatsPath, history.NewStatsHandler(mgr.GetClient(), ctrl.Log))
+	if err != nil {
+		setupLog.Error(err, "unable to setup the release stats handler")
+		os.Exit(1)
+	}
+}
+
 // setUpWebhooks sets up webhooks.
 func setUpWebhooks(mgr ctrl.Manager) {
 	if os.Getenv("ENABLE_WEBHOOKS") == "false" {
 		return
 	}
 
-	err := webhook.SetupWebhooks(mgr, webhooks.EnabledWebhooks...)
+	err := webhooks.SetupWebhooks(mgr)
 	if err != nil {
 		setupLog.Error(err, "unable to setup webhooks")
 		os.Exit(1)
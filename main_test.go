@@ -0,0 +1,64 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+var _ = Describe("Main", func() {
+	When("getDefaultNamespaces is called", func() {
+		DescribeTable("should return the namespaces to watch along with the service namespace",
+			func(names string, expected map[string]crcache.Config) {
+				namespaces, err := getDefaultNamespaces(names, "release-service")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(namespaces).To(Equal(expected))
+			},
+			Entry("when the list is empty", "", nil),
+			Entry("when the list only has whitespace", "  ", nil),
+			Entry("when the list only has separators", " , ,", nil),
+			Entry("when the list has namespaces", "foo,bar", map[string]crcache.Config{
+				"foo":             {},
+				"bar":             {},
+				"release-service": {},
+			}),
+			Entry("when the namespaces are surrounded by whitespace", " foo , bar ", map[string]crcache.Config{
+				"foo":             {},
+				"bar":             {},
+				"release-service": {},
+			}),
+			Entry("when the list has duplicated namespaces", "foo,foo,release-service", map[string]crcache.Config{
+				"foo":             {},
+				"release-service": {},
+			}),
+		)
+
+		It("should fail if the list has namespaces but the service namespace is not set", func() {
+			_, err := getDefaultNamespaces("foo", "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("SERVICE_NAMESPACE"))
+		})
+
+		It("should watch all the namespaces if the list is empty and the service namespace is not set", func() {
+			namespaces, err := getDefaultNamespaces("", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(namespaces).To(BeNil())
+		})
+	})
+})
@@ -2,3 +2,6 @@ package metadata
 
 // ReleaseFinalizer is the finalizer name to be added to the Releases
 const ReleaseFinalizer string = "appstudio.redhat.com/release-finalizer"
+
+// ReleasePlanFinalizer is the finalizer name to be added to the ReleasePlans
+const ReleasePlanFinalizer string = "appstudio.redhat.com/releaseplan-finalizer"
@@ -25,6 +25,9 @@ const (
 
 	// MaxLabelLength is the maximum allowed characters in a label value
 	MaxLabelLength = 63
+
+	// ManagedByLabelValue is the value of the ManagedByLabel set on the objects managed by the Release Service
+	ManagedByLabelValue = "release-service"
 )
 
 // Labels used by the release api package
@@ -41,10 +44,136 @@ var (
 	// AutomatedLabel is the label name for marking a Release as automated
 	AutomatedLabel = fmt.Sprintf("release.%s/automated", rhtapDomain)
 
+	// DefaultReleasePlanLabel is the label name for marking a ReleasePlan as created from the Application target annotation
+	DefaultReleasePlanLabel = fmt.Sprintf("release.%s/default-release-plan", rhtapDomain)
+
+	// HistoricalLabel is the label name for marking a Release as a read-only historical record imported from a backup
+	HistoricalLabel = fmt.Sprintf("release.%s/historical", rhtapDomain)
+
+	// HoldLabel is the Snapshot and Application label marking their builds as not meant to be promoted by
+	// automated Releases
+	HoldLabel = fmt.Sprintf("release.%s/hold", rhtapDomain)
+
+	// ManagedByLabel is the label marking the Roles and RoleBindings managed by the Release Service, so only those are
+	// cached
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+
 	// ReleasePlanAdmissionLabel is the ReleasePlan label for the name of the ReleasePlanAdmission to use
 	ReleasePlanAdmissionLabel = fmt.Sprintf("release.%s/releasePlanAdmission", rhtapDomain)
 )
 
+// Annotations used by the release api package
+var (
+	// ApprovedAnnotation is the Release annotation set to true by the user approving a Release that requires a two-person
+	// review
+	ApprovedAnnotation = fmt.Sprintf("release.%s/approved", rhtapDomain)
+
+	// ApprovedByAnnotation is the Release annotation with the user who approved it, in the same form as the author label
+	ApprovedByAnnotation = fmt.Sprintf("release.%s/approved-by", rhtapDomain)
+
+	// ArchivedAnnotation is the Release annotation marking it as stored in the release archive
+	ArchivedAnnotation = fmt.Sprintf("release.%s/archived", rhtapDomain)
+
+	// AttributedAuthorAnnotation is the Release annotation with the user a Release created by a GitOps applier is
+	// attributed to. It's only honored if its signature can be verified
+	AttributedAuthorAnnotation = fmt.Sprintf("release.%s/attributed-author", rhtapDomain)
+
+	// AttributionSignatureAnnotation is the Release annotation with the base64-encoded signature of the attribution of a
+	// Release created by a GitOps applier
+	AttributionSignatureAnnotation = fmt.Sprintf("release.%s/attribution-signature", rhtapDomain)
+
+	// ComponentsAnnotation is the Release annotation with the comma-separated names of the Snapshot components to release
+	ComponentsAnnotation = fmt.Sprintf("release.%s/components", rhtapDomain)
+
+	// EmergencyBypassAnnotation is the Release annotation with the justification of the emergency bypass of its release
+	// window and integration tests gates. It can only be set by users allowed to bypass the gates of Releases
+	EmergencyBypassAnnotation = fmt.Sprintf("release.%s/emergency-bypass", rhtapDomain)
+
+	// EmergencyBypassExpirationAnnotation is the Release annotation with the RFC 3339 time its emergency bypass expires at
+	EmergencyBypassExpirationAnnotation = fmt.Sprintf("release.%s/emergency-bypass-expiration", rhtapDomain)
+
+	// EmergencyBypassUserAnnotation is the Release annotation with the user who set its emergency bypass
+	EmergencyBypassUserAnnotation = fmt.Sprintf("release.%s/emergency-bypass-user", rhtapDomain)
+
+	// FollowUpReleaseAnnotation is the Release annotation with the name of the Release created to retry its failed
+	// components
+	FollowUpReleaseAnnotation = fmt.Sprintf("release.%s/follow-up-release", rhtapDomain)
+
+	// RollbackReleaseAnnotation is the Release annotation with the name of the Release created to roll back the
+	// Snapshot it released when its verification failed
+	RollbackReleaseAnnotation = fmt.Sprintf("release.%s/rollback-release", rhtapDomain)
+
+	// QuarantinedAnnotation is the annotation marking resources that are no longer reconciled after repeatedly
+	// causing panics. Removing it lets the resource be reconciled again
+	QuarantinedAnnotation = fmt.Sprintf("release.%s/quarantined", rhtapDomain)
+
+	// RegistryCredentialsAnnotation is the annotation of the Secrets holding the registry credentials minted for a
+	// Release with the identifier of the credentials in the broker, so they can be revoked
+	RegistryCredentialsAnnotation = fmt.Sprintf("release.%s/registry-credentials", rhtapDomain)
+
+	// ReleaseLockNextHolderAnnotation is the release lock annotation for the name of the queued Release that acquires
+	// the lock once the current holder releases it
+	ReleaseLockNextHolderAnnotation = fmt.Sprintf("release.%s/next-holder", rhtapDomain)
+
+	// ReleaseNotesAnnotation is the Release annotation with the path of its release notes in the release notes repository
+	ReleaseNotesAnnotation = fmt.Sprintf("release.%s/release-notes", rhtapDomain)
+
+	// ReleasePlanApplicationAnnotation is the Release annotation with the application its ReleasePlan belonged to when
+	// the Release was created. It's set on the existing Releases of a ReleasePlan when the ReleasePlan is transferred
+	ReleasePlanApplicationAnnotation = fmt.Sprintf("release.%s/release-plan-application", rhtapDomain)
+
+	// ReleaseTargetAnnotation is the Application annotation for the target of the ReleasePlan created by default
+	ReleaseTargetAnnotation = fmt.Sprintf("release.%s/target", rhtapDomain)
+
+	// SignatureAnnotation is the Release annotation with the base64-encoded signature of the signed payload
+	SignatureAnnotation = fmt.Sprintf("release.%s/signature", rhtapDomain)
+
+	// SignedPayloadAnnotation is the Release annotation with the payload describing the finalized Release that was signed
+	SignedPayloadAnnotation = fmt.Sprintf("release.%s/signed-payload", rhtapDomain)
+
+	// SnapshotReleasesAnnotation is the Snapshot annotation with a JSON summary of the finished Releases of the Snapshot
+	SnapshotReleasesAnnotation = fmt.Sprintf("release.%s/releases", rhtapDomain)
+
+	// SkipTasksAnnotation is the Release annotation with the comma-separated names of the managed Pipeline tasks to skip
+	SkipTasksAnnotation = fmt.Sprintf("release.%s/skip-tasks", rhtapDomain)
+
+	// StrategyAnnotation is the deprecated Release annotation with the name of the ReleasePlanAdmission strategy to use.
+	// It's rewritten into the strategy field of the Release when it's admitted
+	StrategyAnnotation = fmt.Sprintf("release.%s/strategy", rhtapDomain)
+
+	// SupportBundleAnnotation is the Release annotation requesting the generation of its support bundle. It's removed
+	// once the support bundle is generated
+	SupportBundleAnnotation = fmt.Sprintf("release.%s/support-bundle", rhtapDomain)
+
+	// TraceParentAnnotation is the Release annotation with the W3C traceparent of the trace the Release belongs to
+	TraceParentAnnotation = fmt.Sprintf("release.%s/traceparent", rhtapDomain)
+
+	// TransferAcceptedAnnotation is the ReleasePlan annotation set on behalf of the application a ReleasePlan is being
+	// transferred to, accepting the transfer when its value matches the transfer-to annotation
+	TransferAcceptedAnnotation = fmt.Sprintf("release.%s/transfer-accepted", rhtapDomain)
+
+	// TransferToAnnotation is the ReleasePlan annotation set by the owners of a ReleasePlan with the application to
+	// transfer it to
+	TransferToAnnotation = fmt.Sprintf("release.%s/transfer-to", rhtapDomain)
+
+	// WorkspaceUsageCheckedAnnotation is the PipelineRun annotation with the name of the last TaskRun whose reported
+	// workspace usage was handled, so the same report doesn't expand the workspace claims more than once
+	WorkspaceUsageCheckedAnnotation = fmt.Sprintf("release.%s/workspace-usage-checked", rhtapDomain)
+)
+
+// Annotations set on the images released by managed Pipelines
+var (
+	// ImageReleaseAnnotation is the image annotation with the namespaced name of the Release that released the image
+	ImageReleaseAnnotation = fmt.Sprintf("release.%s/release", rhtapDomain)
+
+	// ImageReleaseDateAnnotation is the image annotation with the RFC 3339 time the Release that released the image
+	// started at
+	ImageReleaseDateAnnotation = fmt.Sprintf("release.%s/release-date", rhtapDomain)
+
+	// ImageReleaseTargetAnnotation is the image annotation with the managed namespace the image was released to
+	ImageReleaseTargetAnnotation = fmt.Sprintf("release.%s/release-target", rhtapDomain)
+)
+
 // Prefixes to be used by Release Pipelines labels
 var (
 	// pipelinesLabelPrefix is the prefix of the pipelines label
@@ -65,6 +194,12 @@ var (
 	// TenantPipelineType is the value to be used in the PipelinesTypeLabel for tenant Pipelines
 	TenantPipelineType = "tenant"
 
+	// VerificationPipelineType is the value to be used in the PipelinesTypeLabel for verification Pipelines
+	VerificationPipelineType = "verification"
+
+	// OrphanedLabel is the label used to flag PipelineRuns whose Release no longer exists
+	OrphanedLabel = fmt.Sprintf("%s/%s", releaseLabelPrefix, "orphaned")
+
 	// PipelinesTypeLabel is the label used to describe the type of pipeline
 	PipelinesTypeLabel = fmt.Sprintf("%s/%s", pipelinesLabelPrefix, "type")
 
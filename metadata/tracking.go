@@ -0,0 +1,41 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// GetReleaseTrackingLabels returns the labels linking an object created for the Release with the given name and
+// namespace back to it. OwnerReferences can't point to objects in other namespaces, so these labels are what links the
+// PipelineRuns, workspace claims, Secrets and ConfigMaps created for a Release to it.
+func GetReleaseTrackingLabels(name, namespace string) map[string]string {
+	return map[string]string{
+		ReleaseNameLabel:      name,
+		ReleaseNamespaceLabel: namespace,
+	}
+}
+
+// GetTrackedRelease returns the name and namespace of the Release the given object was created for, as set in its
+// tracking labels. The returned boolean is false if any of the labels is missing.
+func GetTrackedRelease(obj v1.Object) (types.NamespacedName, bool) {
+	labels := obj.GetLabels()
+	release := types.NamespacedName{Name: labels[ReleaseNameLabel], Namespace: labels[ReleaseNamespaceLabel]}
+
+	return release, release.Name != "" && release.Namespace != ""
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Tracking", func() {
+	When("GetReleaseTrackingLabels is called", func() {
+		It("should return the name and namespace labels of the Release", func() {
+			Expect(GetReleaseTrackingLabels("release", "namespace")).To(Equal(map[string]string{
+				ReleaseNameLabel:      "release",
+				ReleaseNamespaceLabel: "namespace",
+			}))
+		})
+	})
+
+	When("GetTrackedRelease is called", func() {
+		It("should return the Release set in the tracking labels", func() {
+			secret := &corev1.Secret{}
+			AddLabels(secret, GetReleaseTrackingLabels("release", "namespace"))
+
+			release, found := GetTrackedRelease(secret)
+			Expect(found).To(BeTrue())
+			Expect(release).To(Equal(types.NamespacedName{Name: "release", Namespace: "namespace"}))
+		})
+
+		It("should return false if a tracking label is missing", func() {
+			secret := &corev1.Secret{}
+			AddLabels(secret, map[string]string{ReleaseNameLabel: "release"})
+
+			_, found := GetTrackedRelease(secret)
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,171 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// The names of these metrics are meant to be stable, as they are used in alerting rules.
+var (
+	CacheSyncDurationSeconds = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "release_service_cache_sync_duration_seconds",
+			Help: "How long in seconds the informer caches took to sync after the manager started",
+		},
+	)
+
+	ReconcileErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_reconcile_errors_total",
+			Help: "Total number of reconciles that returned an error per controller",
+		},
+		[]string{"controller"},
+	)
+
+	ReconcilePanicsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_reconcile_panics_total",
+			Help: "Total number of reconciles that panicked per controller",
+		},
+		[]string{"controller"},
+	)
+
+	ReconcileQueueDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "release_service_reconcile_queue_duration_seconds",
+			Help:    "How long in seconds a request requeued after a delay waits in the queue once the delay expires",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+		},
+		[]string{"controller"},
+	)
+
+	ReconcileRequeuesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_reconcile_requeues_total",
+			Help: "Total number of reconciles that requested a requeue per controller",
+		},
+		[]string{"controller", "type"},
+	)
+
+	ReconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_reconcile_total",
+			Help: "Total number of reconciles per controller",
+		},
+		[]string{"controller"},
+	)
+
+	StatusUpdatesThrottledTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_status_updates_throttled_total",
+			Help: "Total number of status updates deferred to the next allowed one per controller",
+		},
+		[]string{"controller"},
+	)
+)
+
+const (
+	// RequeueTypeDelayed is the value of the type label for requeues after a given delay
+	RequeueTypeDelayed = "delayed"
+
+	// RequeueTypeImmediate is the value of the type label for requeues without a given delay
+	RequeueTypeImmediate = "immediate"
+)
+
+// InstrumentedReconciler wraps a reconciler to register the reconcile metrics of a controller.
+type InstrumentedReconciler struct {
+	controller string
+	reconciler reconcile.Reconciler
+
+	mutex           sync.Mutex
+	requeueDeadline map[reconcile.Request]time.Time
+}
+
+var _ reconcile.Reconciler = &InstrumentedReconciler{}
+
+// NewInstrumentedReconciler creates and returns an InstrumentedReconciler registering the metrics of the given
+// reconciler under the given controller name.
+func NewInstrumentedReconciler(controller string, reconciler reconcile.Reconciler) *InstrumentedReconciler {
+	return &InstrumentedReconciler{
+		controller:      controller,
+		reconciler:      reconciler,
+		requeueDeadline: map[reconcile.Request]time.Time{},
+	}
+}
+
+// Reconcile calls the wrapped reconciler and registers the outcome of the reconcile. Requests requeued after a delay
+// are tracked, so the time they spent in the queue after the delay expired is observed when they are reconciled again.
+func (r *InstrumentedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	r.mutex.Lock()
+	if deadline, found := r.requeueDeadline[req]; found {
+		delete(r.requeueDeadline, req)
+		if queueDuration := time.Since(deadline); queueDuration >= 0 {
+			ReconcileQueueDurationSeconds.WithLabelValues(r.controller).Observe(queueDuration.Seconds())
+		}
+	}
+	r.mutex.Unlock()
+
+	result, err := r.reconciler.Reconcile(ctx, req)
+
+	ReconcileTotal.WithLabelValues(r.controller).Inc()
+	if err != nil {
+		ReconcileErrorsTotal.WithLabelValues(r.controller).Inc()
+	} else if result.RequeueAfter > 0 {
+		ReconcileRequeuesTotal.WithLabelValues(r.controller, RequeueTypeDelayed).Inc()
+		r.mutex.Lock()
+		r.requeueDeadline[req] = time.Now().Add(result.RequeueAfter)
+		r.mutex.Unlock()
+	} else if result.Requeue {
+		ReconcileRequeuesTotal.WithLabelValues(r.controller, RequeueTypeImmediate).Inc()
+	}
+
+	return result, err
+}
+
+// RegisterReconcilePanic registers a panic raised while reconciling an object of the given controller.
+func RegisterReconcilePanic(controller string) {
+	ReconcilePanicsTotal.WithLabelValues(controller).Inc()
+}
+
+// RegisterThrottledStatusUpdate registers a status update of the given controller deferred to the next allowed one.
+func RegisterThrottledStatusUpdate(controller string) {
+	StatusUpdatesThrottledTotal.WithLabelValues(controller).Inc()
+}
+
+// RegisterCacheSync registers the time it took the informer caches to sync.
+func RegisterCacheSync(duration time.Duration) {
+	CacheSyncDurationSeconds.Set(duration.Seconds())
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		CacheSyncDurationSeconds,
+		ReconcileErrorsTotal,
+		ReconcilePanicsTotal,
+		ReconcileQueueDurationSeconds,
+		ReconcileRequeuesTotal,
+		ReconcileTotal,
+		StatusUpdatesThrottledTotal,
+	)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Controller metrics", Ordered, func() {
+	var (
+		request reconcile.Request
+		result  reconcile.Result
+		err     error
+
+		reconciler *InstrumentedReconciler
+	)
+
+	BeforeEach(func() {
+		CacheSyncDurationSeconds.Set(0)
+		ReconcileErrorsTotal.Reset()
+		ReconcilePanicsTotal.Reset()
+		ReconcileQueueDurationSeconds.Reset()
+		ReconcileRequeuesTotal.Reset()
+		ReconcileTotal.Reset()
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: "name", Namespace: "namespace"}}
+		result = reconcile.Result{}
+		err = nil
+
+		reconciler = NewInstrumentedReconciler("test", reconcile.Func(
+			func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				return result, err
+			}))
+	})
+
+	When("Reconcile is called", func() {
+		It("increments ReconcileTotal", func() {
+			_, _ = reconciler.Reconcile(context.TODO(), request)
+			Expect(testutil.ToFloat64(ReconcileTotal.WithLabelValues("test"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(ReconcileErrorsTotal.WithLabelValues("test"))).To(Equal(float64(0)))
+		})
+
+		It("increments ReconcileErrorsTotal if the reconcile fails", func() {
+			err = fmt.Errorf("error")
+			_, returnedErr := reconciler.Reconcile(context.TODO(), request)
+			Expect(returnedErr).To(Equal(err))
+			Expect(testutil.ToFloat64(ReconcileErrorsTotal.WithLabelValues("test"))).To(Equal(float64(1)))
+		})
+
+		It("increments ReconcileRequeuesTotal for immediate requeues", func() {
+			result = reconcile.Result{Requeue: true}
+			_, _ = reconciler.Reconcile(context.TODO(), request)
+			Expect(testutil.ToFloat64(ReconcileRequeuesTotal.WithLabelValues("test", RequeueTypeImmediate))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(ReconcileRequeuesTotal.WithLabelValues("test", RequeueTypeDelayed))).To(Equal(float64(0)))
+		})
+
+		It("increments ReconcileRequeuesTotal for delayed requeues", func() {
+			result = reconcile.Result{RequeueAfter: time.Minute}
+			returnedResult, _ := reconciler.Reconcile(context.TODO(), request)
+			Expect(returnedResult).To(Equal(result))
+			Expect(testutil.ToFloat64(ReconcileRequeuesTotal.WithLabelValues("test", RequeueTypeDelayed))).To(Equal(float64(1)))
+		})
+
+		It("observes the time spent in the queue by requests requeued after a delay", func() {
+			result = reconcile.Result{RequeueAfter: time.Millisecond}
+			_, _ = reconciler.Reconcile(context.TODO(), request)
+			time.Sleep(2 * time.Millisecond)
+
+			result = reconcile.Result{}
+			_, _ = reconciler.Reconcile(context.TODO(), request)
+			Expect(testutil.CollectAndCount(ReconcileQueueDurationSeconds)).To(Equal(1))
+			Expect(reconciler.requeueDeadline).To(BeEmpty())
+		})
+
+		It("doesn't observe the time spent in the queue by requests reconciled before the delay expired", func() {
+			result = reconcile.Result{RequeueAfter: time.Hour}
+			_, _ = reconciler.Reconcile(context.TODO(), request)
+
+			result = reconcile.Result{}
+			_, _ = reconciler.Reconcile(context.TODO(), request)
+			Expect(testutil.CollectAndCount(ReconcileQueueDurationSeconds)).To(Equal(0))
+		})
+	})
+
+	When("RegisterReconcilePanic is called", func() {
+		It("increments ReconcilePanicsTotal", func() {
+			RegisterReconcilePanic("test")
+			Expect(testutil.ToFloat64(ReconcilePanicsTotal.WithLabelValues("test"))).To(Equal(float64(1)))
+		})
+	})
+
+	When("RegisterThrottledStatusUpdate is called", func() {
+		It("increments StatusUpdatesThrottledTotal", func() {
+			RegisterThrottledStatusUpdate("test")
+			Expect(testutil.ToFloat64(StatusUpdatesThrottledTotal.WithLabelValues("test"))).To(Equal(float64(1)))
+		})
+	})
+
+	When("RegisterCacheSync is called", func() {
+		It("sets CacheSyncDurationSeconds", func() {
+			RegisterCacheSync(5 * time.Second)
+			Expect(testutil.ToFloat64(CacheSyncDurationSeconds)).To(Equal(float64(5)))
+		})
+	})
+})
@@ -0,0 +1,74 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// ExemplarsPath is the path of the metrics server serving the metrics in the OpenMetrics format, which is the only
+// format exposing exemplars
+const ExemplarsPath = "/metrics/exemplars"
+
+// traceParentRegex matches W3C traceparent headers, capturing the trace ID
+var traceParentRegex = regexp.MustCompile(`^[0-9a-f]{2}-([0-9a-f]{32})-[0-9a-f]{16}-[0-9a-f]{2}$`)
+
+var exemplarsEnabled = false
+
+// SetExemplarsEnabled sets whether the trace IDs of the Releases are attached as exemplars to the release duration
+// histograms. This function is meant to be called before any metric is registered.
+func SetExemplarsEnabled(enabled bool) {
+	exemplarsEnabled = enabled
+}
+
+// NewExemplarsHandler returns a handler serving the metrics of the controller-runtime registry in the OpenMetrics
+// format, so the exemplars attached to the release duration histograms can be scraped.
+func NewExemplarsHandler() http.Handler {
+	return promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		EnableOpenMetrics: true,
+		ErrorHandling:     promhttp.HTTPErrorOnError,
+	})
+}
+
+// getTraceID returns the trace ID of the given W3C traceparent header or an empty string if it's malformed or the
+// trace ID is invalid.
+func getTraceID(traceParent string) string {
+	matches := traceParentRegex.FindStringSubmatch(traceParent)
+	if matches == nil || matches[1] == "00000000000000000000000000000000" {
+		return ""
+	}
+
+	return matches[1]
+}
+
+// observeWithTrace adds the given observation to the observer, attaching the trace ID of the given W3C traceparent
+// header as an exemplar if exemplars are enabled and the header is valid.
+func observeWithTrace(observer prometheus.Observer, value float64, traceParent string) {
+	traceID := getTraceID(traceParent)
+	exemplarObserver, ok := observer.(prometheus.ExemplarObserver)
+	if !exemplarsEnabled || traceID == "" || !ok {
+		observer.Observe(value)
+		return
+	}
+
+	exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{"trace_id": traceID})
+}
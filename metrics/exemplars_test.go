@@ -0,0 +1,115 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var _ = Describe("Metrics exemplars", Ordered, func() {
+	const (
+		openMetricsContentType = "application/openmetrics-text"
+		traceID                = "4bf92f3577b34da6a3ce929d0e0e4736"
+		traceParent            = "00-" + traceID + "-00f067aa0ba902b7-01"
+	)
+
+	// scrape returns the OpenMetrics exposition of a registry containing the given histogram.
+	scrape := func(histogram prometheus.Histogram) string {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(histogram)
+
+		request := httptest.NewRequest(http.MethodGet, ExemplarsPath, nil)
+		request.Header.Set("Accept", openMetricsContentType)
+		recorder := httptest.NewRecorder()
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{EnableOpenMetrics: true}).ServeHTTP(recorder, request)
+
+		body, err := io.ReadAll(recorder.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	AfterEach(func() {
+		SetExemplarsEnabled(false)
+	})
+
+	When("getTraceID is called", func() {
+		It("returns the trace ID of a valid traceparent", func() {
+			Expect(getTraceID(traceParent)).To(Equal(traceID))
+		})
+
+		It("returns an empty string if the traceparent is malformed", func() {
+			Expect(getTraceID("")).To(BeEmpty())
+			Expect(getTraceID("foo")).To(BeEmpty())
+			Expect(getTraceID("00-" + traceID + "-01")).To(BeEmpty())
+		})
+
+		It("returns an empty string if the trace ID is all zeros", func() {
+			Expect(getTraceID("00-00000000000000000000000000000000-00f067aa0ba902b7-01")).To(BeEmpty())
+		})
+	})
+
+	When("observeWithTrace is called", func() {
+		var histogram prometheus.Histogram
+
+		BeforeEach(func() {
+			histogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+				Name:    "exemplars_test_seconds",
+				Buckets: []float64{60, 600},
+			})
+		})
+
+		It("doesn't attach an exemplar if exemplars are disabled", func() {
+			observeWithTrace(histogram, 30, traceParent)
+			output := scrape(histogram)
+			Expect(output).To(ContainSubstring("exemplars_test_seconds_count 1"))
+			Expect(output).NotTo(ContainSubstring(traceID))
+		})
+
+		It("doesn't attach an exemplar if the traceparent is invalid", func() {
+			SetExemplarsEnabled(true)
+			observeWithTrace(histogram, 30, "foo")
+			output := scrape(histogram)
+			Expect(output).To(ContainSubstring("exemplars_test_seconds_count 1"))
+			Expect(output).NotTo(ContainSubstring("trace_id"))
+		})
+
+		It("attaches the trace ID as an exemplar if exemplars are enabled", func() {
+			SetExemplarsEnabled(true)
+			observeWithTrace(histogram, 30, traceParent)
+			Expect(scrape(histogram)).To(ContainSubstring(`trace_id="` + traceID + `"`))
+		})
+	})
+
+	When("NewExemplarsHandler is called", func() {
+		It("serves the metrics in the OpenMetrics format", func() {
+			request := httptest.NewRequest(http.MethodGet, ExemplarsPath, nil)
+			request.Header.Set("Accept", openMetricsContentType)
+			recorder := httptest.NewRecorder()
+			NewExemplarsHandler().ServeHTTP(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(HavePrefix(openMetricsContentType))
+		})
+	})
+})
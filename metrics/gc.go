@@ -0,0 +1,55 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	GarbageCollectedObjectsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_garbage_collected_objects_total",
+			Help: "Total number of objects deleted by the Release Service per kind and reason, or that would have " +
+				"been deleted when running in dry-run mode",
+		},
+		[]string{"kind", "reason", "dry_run"},
+	)
+)
+
+const (
+	// GarbageCollectionReasonOrphaned is the value of the reason label for objects whose Release no longer exists
+	GarbageCollectionReasonOrphaned = "orphaned"
+
+	// GarbageCollectionReasonReleaseDeleted is the value of the reason label for objects deleted along with their Release
+	GarbageCollectionReasonReleaseDeleted = "release_deleted"
+)
+
+// RegisterGarbageCollectedObject registers the deletion of an object of the given kind for the given reason. If
+// dryRun is set, the object was only reported as it would have been deleted.
+func RegisterGarbageCollectedObject(kind, reason string, dryRun bool) {
+	GarbageCollectedObjectsTotal.WithLabelValues(kind, reason, strconv.FormatBool(dryRun)).Inc()
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		GarbageCollectedObjectsTotal,
+	)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Garbage collection metrics", Ordered, func() {
+	BeforeEach(func() {
+		GarbageCollectedObjectsTotal.Reset()
+	})
+
+	When("RegisterGarbageCollectedObject is called", func() {
+		It("increments GarbageCollectedObjectsTotal for the given kind and reason", func() {
+			RegisterGarbageCollectedObject("PipelineRun", GarbageCollectionReasonOrphaned, false)
+			Expect(testutil.ToFloat64(GarbageCollectedObjectsTotal.WithLabelValues(
+				"PipelineRun", GarbageCollectionReasonOrphaned, "false"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(GarbageCollectedObjectsTotal.WithLabelValues(
+				"PipelineRun", GarbageCollectionReasonOrphaned, "true"))).To(Equal(float64(0)))
+		})
+
+		It("registers dry-run deletions separately", func() {
+			RegisterGarbageCollectedObject("PipelineRun", GarbageCollectionReasonOrphaned, true)
+			Expect(testutil.ToFloat64(GarbageCollectedObjectsTotal.WithLabelValues(
+				"PipelineRun", GarbageCollectionReasonOrphaned, "true"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(GarbageCollectedObjectsTotal.WithLabelValues(
+				"PipelineRun", GarbageCollectionReasonOrphaned, "false"))).To(Equal(float64(0)))
+		})
+	})
+})
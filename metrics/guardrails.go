@@ -0,0 +1,73 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	CacheDegraded = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "release_service_cache_degraded",
+			Help: "Whether the reads are served directly by the API server because the cache crossed its object limit",
+		},
+	)
+
+	CachedObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "release_service_cached_objects",
+			Help: "Number of objects held in the informer cache per kind",
+		},
+		[]string{"kind"},
+	)
+
+	MemoryResidentBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "release_service_memory_resident_bytes",
+			Help: "Resident memory of the controller in bytes",
+		},
+	)
+)
+
+// RegisterCacheDegraded registers whether the reads are served directly by the API server instead of the cache.
+func RegisterCacheDegraded(degraded bool) {
+	if degraded {
+		CacheDegraded.Set(1)
+	} else {
+		CacheDegraded.Set(0)
+	}
+}
+
+// RegisterCachedObjects registers the number of objects of the given kind held in the informer cache.
+func RegisterCachedObjects(kind string, count int) {
+	CachedObjects.WithLabelValues(kind).Set(float64(count))
+}
+
+// RegisterMemoryResident registers the resident memory of the controller in bytes.
+func RegisterMemoryResident(bytes int64) {
+	MemoryResidentBytes.Set(float64(bytes))
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		CacheDegraded,
+		CachedObjects,
+		MemoryResidentBytes,
+	)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Guardrails metrics", Ordered, func() {
+	BeforeEach(func() {
+		CacheDegraded.Set(0)
+		CachedObjects.Reset()
+		MemoryResidentBytes.Set(0)
+	})
+
+	When("RegisterCacheDegraded is called", func() {
+		It("sets CacheDegraded to 1 when the reads are degraded and back to 0 when they are not", func() {
+			RegisterCacheDegraded(true)
+			Expect(testutil.ToFloat64(CacheDegraded)).To(Equal(float64(1)))
+			RegisterCacheDegraded(false)
+			Expect(testutil.ToFloat64(CacheDegraded)).To(Equal(float64(0)))
+		})
+	})
+
+	When("RegisterCachedObjects is called", func() {
+		It("sets CachedObjects for the given kind", func() {
+			RegisterCachedObjects("Release", 10)
+			RegisterCachedObjects("Release", 5)
+			Expect(testutil.ToFloat64(CachedObjects.WithLabelValues("Release"))).To(Equal(float64(5)))
+		})
+	})
+
+	When("RegisterMemoryResident is called", func() {
+		It("sets MemoryResidentBytes", func() {
+			RegisterMemoryResident(1024)
+			Expect(testutil.ToFloat64(MemoryResidentBytes)).To(Equal(float64(1024)))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// LabelMode defines how the value of a high-cardinality label is attached to the release metrics.
+type LabelMode string
+
+const (
+	// LabelModeRaw attaches the label value as is
+	LabelModeRaw LabelMode = "raw"
+
+	// LabelModeHashed attaches a bucket computed by hashing the label value, so the label takes a bounded number of values
+	LabelModeHashed LabelMode = "hashed"
+
+	// LabelModeDropped attaches an empty value, so the label takes a single value
+	LabelModeDropped LabelMode = "dropped"
+)
+
+var (
+	targetLabelMode    = LabelModeRaw
+	targetLabelBuckets = 1
+)
+
+// SetTargetLabelMode sets how the target label is attached to the release metrics. The buckets parameter is the number
+// of values the label can take when using LabelModeHashed. This function is meant to be called before any metric is
+// registered.
+func SetTargetLabelMode(mode LabelMode, buckets int) error {
+	switch mode {
+	case LabelModeRaw, LabelModeDropped:
+	case LabelModeHashed:
+		if buckets <= 0 {
+			return fmt.Errorf("the number of buckets must be greater than zero when hashing labels")
+		}
+	default:
+		return fmt.Errorf("unknown label mode '%s'", mode)
+	}
+
+	targetLabelMode = mode
+	targetLabelBuckets = buckets
+
+	return nil
+}
+
+// getTargetLabelValue returns the value to attach to the target label of the release metrics for the given target.
+func getTargetLabelValue(target string) string {
+	switch targetLabelMode {
+	case LabelModeDropped:
+		return ""
+	case LabelModeHashed:
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(target))
+		return fmt.Sprintf("bucket-%d", hash.Sum32()%uint32(targetLabelBuckets))
+	}
+
+	return target
+}
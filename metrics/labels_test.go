@@ -0,0 +1,66 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics labels", Ordered, func() {
+	AfterEach(func() {
+		Expect(SetTargetLabelMode(LabelModeRaw, 1)).To(Succeed())
+	})
+
+	When("SetTargetLabelMode is called", func() {
+		It("fails if the mode is unknown", func() {
+			err := SetTargetLabelMode("foo", 1)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown label mode 'foo'"))
+		})
+
+		It("fails if the hashed mode is used without buckets", func() {
+			Expect(SetTargetLabelMode(LabelModeHashed, 0)).NotTo(Succeed())
+		})
+
+		It("sets the mode", func() {
+			Expect(SetTargetLabelMode(LabelModeDropped, 0)).To(Succeed())
+			Expect(targetLabelMode).To(Equal(LabelModeDropped))
+		})
+	})
+
+	When("getTargetLabelValue is called", func() {
+		It("returns the target as is in raw mode", func() {
+			Expect(getTargetLabelValue("namespace")).To(Equal("namespace"))
+		})
+
+		It("returns an empty value in dropped mode", func() {
+			Expect(SetTargetLabelMode(LabelModeDropped, 0)).To(Succeed())
+			Expect(getTargetLabelValue("namespace")).To(BeEmpty())
+		})
+
+		It("returns a stable bucket in hashed mode", func() {
+			Expect(SetTargetLabelMode(LabelModeHashed, 1)).To(Succeed())
+			Expect(getTargetLabelValue("namespace")).To(Equal("bucket-0"))
+			Expect(getTargetLabelValue("other-namespace")).To(Equal("bucket-0"))
+
+			Expect(SetTargetLabelMode(LabelModeHashed, 4)).To(Succeed())
+			Expect(getTargetLabelValue("namespace")).To(Equal(getTargetLabelValue("namespace")))
+			Expect(getTargetLabelValue("namespace")).To(MatchRegexp("^bucket-[0-3]$"))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	OutboxDroppedEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_outbox_dropped_events_total",
+			Help: "Total number of outbox events dropped per namespace because the outbox was full",
+		},
+		[]string{"namespace"},
+	)
+)
+
+// RegisterOutboxDroppedEvents registers the given number of events dropped from the outbox of the given namespace.
+func RegisterOutboxDroppedEvents(namespace string, count int) {
+	OutboxDroppedEventsTotal.WithLabelValues(namespace).Add(float64(count))
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		OutboxDroppedEventsTotal,
+	)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Outbox metrics", Ordered, func() {
+	BeforeEach(func() {
+		OutboxDroppedEventsTotal.Reset()
+	})
+
+	When("RegisterOutboxDroppedEvents is called", func() {
+		It("adds the dropped events to OutboxDroppedEventsTotal for the given namespace", func() {
+			RegisterOutboxDroppedEvents("default", 2)
+			RegisterOutboxDroppedEvents("default", 1)
+			Expect(testutil.ToFloat64(OutboxDroppedEventsTotal.WithLabelValues("default"))).To(Equal(float64(3)))
+			Expect(testutil.ToFloat64(OutboxDroppedEventsTotal.WithLabelValues("other"))).To(Equal(float64(0)))
+		})
+	})
+})
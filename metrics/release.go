@@ -47,6 +47,35 @@ var (
 		[]string{},
 	)
 
+	ReleasePipelineCPUCoreSecondsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_pipeline_cpu_core_seconds_total",
+			Help: "Total CPU core-seconds requested by the tasks of release PipelineRuns",
+		},
+		releasePipelineComputeUsageLabels,
+	)
+
+	ReleasePipelineEstimatedCostTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_pipeline_estimated_cost_total",
+			Help: "Total estimated compute cost of release PipelineRuns",
+		},
+		releasePipelineComputeUsageLabels,
+	)
+
+	ReleasePipelineMemoryGiBSecondsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_pipeline_memory_gib_seconds_total",
+			Help: "Total memory GiB-seconds requested by the tasks of release PipelineRuns",
+		},
+		releasePipelineComputeUsageLabels,
+	)
+	releasePipelineComputeUsageLabels = []string{
+		"namespace",
+		"release_plan",
+		"type",
+	}
+
 	ReleasePreProcessingDurationSeconds = prometheus.NewHistogramVec(
 		releasePreProcessingDurationSecondsOpts,
 		releasePreProcessingDurationSecondsLabels,
@@ -95,6 +124,46 @@ var (
 		Buckets: []float64{60, 150, 300, 450, 600, 750, 900, 1050, 1200, 1800, 3600},
 	}
 
+	ReleasePreemptionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_preemptions_total",
+			Help: "Total number of queued releases preempted by urgent releases",
+		},
+		[]string{"target"},
+	)
+
+	ReleaseSnapshotToReleaseStartSeconds = prometheus.NewHistogramVec(
+		releaseSnapshotToReleaseStartSecondsOpts,
+		releaseSnapshotLatencyLabels,
+	)
+	releaseSnapshotToReleaseStartSecondsOpts = prometheus.HistogramOpts{
+		Name:    "release_snapshot_to_release_start_seconds",
+		Help:    "How long in seconds a Release takes to start since its Snapshot became releasable",
+		Buckets: releaseSnapshotLatencyBuckets,
+	}
+
+	ReleaseSnapshotToReleasedSeconds = prometheus.NewHistogramVec(
+		releaseSnapshotToReleasedSecondsOpts,
+		releaseSnapshotLatencyLabels,
+	)
+	releaseSnapshotToReleasedSecondsOpts = prometheus.HistogramOpts{
+		Name:    "release_snapshot_to_released_seconds",
+		Help:    "How long in seconds a Release takes to succeed since its Snapshot became releasable",
+		Buckets: releaseSnapshotLatencyBuckets,
+	}
+	releaseSnapshotLatencyBuckets = []float64{30, 60, 150, 300, 600, 900, 1800, 3600, 7200, 14400, 86400}
+	releaseSnapshotLatencyLabels  = []string{
+		"target",
+	}
+
+	ReleaseSupersededTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_superseded_total",
+			Help: "Total number of automated releases skipped in favor of newer releases of the same components",
+		},
+		[]string{"target"},
+	)
+
 	ReleasePostActionsExecutionDurationSeconds = prometheus.NewHistogramVec(
 		releasePostActionsExecutionDurationSecondsOpts,
 		releasePostActionsExecutionDurationSecondsLabels,
@@ -144,9 +213,11 @@ var (
 
 // RegisterCompletedRelease registers a Release as complete, decreasing the number of concurrent releases, adding a new
 // observation for the Release duration and increasing the total number of releases. If either the startTime or the
-// completionTime parameters are nil, no action will be taken.
+// completionTime parameters are nil, no action will be taken. The trace ID of the given traceParent is attached to the
+// observation as an exemplar when exemplars are enabled.
 func RegisterCompletedRelease(startTime, completionTime *metav1.Time,
-	managedProcessingReason, postActionsReason, releaseReason, target, tenantProcessingReason, validationReason string) {
+	managedProcessingReason, postActionsReason, releaseReason, target, tenantProcessingReason, validationReason,
+	traceParent string) {
 	if startTime == nil || completionTime == nil {
 		return
 	}
@@ -156,51 +227,110 @@ func RegisterCompletedRelease(startTime, completionTime *metav1.Time,
 		"managed_pipeline_processing_reason": managedProcessingReason,
 		"post_actions_reason":                postActionsReason,
 		"release_reason":                     releaseReason,
-		"target":                             target,
+		"target":                             getTargetLabelValue(target),
 		"tenant_pipeline_processing_reason":  tenantProcessingReason,
 		"validation_reason":                  validationReason,
 	}
 	ReleaseConcurrentTotal.WithLabelValues().Dec()
-	ReleaseDurationSeconds.
-		With(labels).
-		Observe(completionTime.Sub(startTime.Time).Seconds())
+	observeWithTrace(ReleaseDurationSeconds.With(labels), completionTime.Sub(startTime.Time).Seconds(), traceParent)
 	ReleaseTotal.With(labels).Inc()
 }
 
 // RegisterCompletedReleasePostActionsExecuted registers a Release post-actions execution as complete, adding a new
 // observation for the Release post-actions execution duration and decreasing the number of concurrent executions.
-// If either the startTime or the completionTime parameters are nil, no action will be taken.
-func RegisterCompletedReleasePostActionsExecuted(startTime, completionTime *metav1.Time, reason string) {
+// If either the startTime or the completionTime parameters are nil, no action will be taken. The trace ID of the given
+// traceParent is attached to the observation as an exemplar when exemplars are enabled.
+func RegisterCompletedReleasePostActionsExecuted(startTime, completionTime *metav1.Time, reason, traceParent string) {
 	if startTime == nil || completionTime == nil {
 		return
 	}
 
-	ReleasePostActionsExecutionDurationSeconds.
-		With(prometheus.Labels{
+	observeWithTrace(
+		ReleasePostActionsExecutionDurationSeconds.With(prometheus.Labels{
 			"reason": reason,
-		}).
-		Observe(completionTime.Sub(startTime.Time).Seconds())
+		}),
+		completionTime.Sub(startTime.Time).Seconds(),
+		traceParent,
+	)
 	ReleaseConcurrentPostActionsExecutionsTotal.WithLabelValues().Dec()
 }
 
 // RegisterCompletedReleasePipelineProcessing registers a Release pipeline processing as complete, adding a
 // new observation for the Release processing duration with the specific type and decreasing the number of
 // concurent processings. If either the startTime or the completionTime parameters are nil, no action will be taken.
-func RegisterCompletedReleasePipelineProcessing(startTime, completionTime *metav1.Time, reason, target, pipelineType string) {
+// The trace ID of the given traceParent is attached to the observation as an exemplar when exemplars are enabled.
+func RegisterCompletedReleasePipelineProcessing(startTime, completionTime *metav1.Time,
+	reason, target, pipelineType, traceParent string) {
 	if startTime == nil || completionTime == nil {
 		return
 	}
 
-	ReleaseProcessingDurationSeconds.
-		With(prometheus.Labels{
+	observeWithTrace(
+		ReleaseProcessingDurationSeconds.With(prometheus.Labels{
 			"reason": reason,
-			"target": target,
+			"target": getTargetLabelValue(target),
 			"type":   pipelineType,
-		}).
-		Observe(completionTime.Sub(startTime.Time).Seconds())
+		}),
+		completionTime.Sub(startTime.Time).Seconds(),
+		traceParent,
+	)
 	ReleaseConcurrentProcessingsTotal.WithLabelValues().Dec()
 }
 
+// RegisterReleasePipelineComputeUsage registers the compute usage and the estimated cost of a finished release
+// PipelineRun of the given type, run for a Release of the given ReleasePlan in the given namespace, so they can be
+// aggregated per ReleasePlan and tenant.
+func RegisterReleasePipelineComputeUsage(namespace, releasePlan, pipelineType string, cpuCoreSeconds, memoryGiBSeconds, cost float64) {
+	labels := prometheus.Labels{
+		"namespace":    namespace,
+		"release_plan": releasePlan,
+		"type":         pipelineType,
+	}
+	ReleasePipelineCPUCoreSecondsTotal.With(labels).Add(cpuCoreSeconds)
+	ReleasePipelineMemoryGiBSecondsTotal.With(labels).Add(memoryGiBSeconds)
+	ReleasePipelineEstimatedCostTotal.With(labels).Add(cost)
+}
+
+// RegisterPreemptedRelease registers a queued Release preempted by an urgent Release to the given target.
+func RegisterPreemptedRelease(target string) {
+	ReleasePreemptionsTotal.WithLabelValues(getTargetLabelValue(target)).Inc()
+}
+
+// RegisterSnapshotToReleaseStart registers the start of a Release to the given target, adding a new observation for
+// the time between its Snapshot becoming releasable and the Release starting, which is never negative. If either the
+// releasableTime or the startTime are nil, no action will be taken.
+func RegisterSnapshotToReleaseStart(releasableTime, startTime *metav1.Time, target string) {
+	if releasableTime == nil || startTime == nil {
+		return
+	}
+
+	ReleaseSnapshotToReleaseStartSeconds.
+		With(prometheus.Labels{
+			"target": getTargetLabelValue(target),
+		}).
+		Observe(max(0, startTime.Sub(releasableTime.Time).Seconds()))
+}
+
+// RegisterSnapshotToReleased registers a succeeded Release to the given target, adding a new observation for the time
+// between its Snapshot becoming releasable and the Release succeeding. If either the releasableTime or the
+// completionTime are nil, no action will be taken.
+func RegisterSnapshotToReleased(releasableTime, completionTime *metav1.Time, target string) {
+	if releasableTime == nil || completionTime == nil {
+		return
+	}
+
+	ReleaseSnapshotToReleasedSeconds.
+		With(prometheus.Labels{
+			"target": getTargetLabelValue(target),
+		}).
+		Observe(completionTime.Sub(releasableTime.Time).Seconds())
+}
+
+// RegisterSupersededRelease registers an automated Release to the given target skipped in favor of a newer Release.
+func RegisterSupersededRelease(target string) {
+	ReleaseSupersededTotal.WithLabelValues(getTargetLabelValue(target)).Inc()
+}
+
 // RegisterValidatedRelease registers a Release as validated, adding a new observation for the
 // Release validated seconds. If either the startTime or the validationTime are nil,
 // no action will be taken.
@@ -212,7 +342,7 @@ func RegisterValidatedRelease(startTime, validationTime *metav1.Time, reason, ta
 	ReleaseValidationDurationSeconds.
 		With(prometheus.Labels{
 			"reason": reason,
-			"target": target,
+			"target": getTargetLabelValue(target),
 		}).
 		Observe(validationTime.Sub(startTime.Time).Seconds())
 }
@@ -233,7 +363,7 @@ func RegisterNewReleasePipelineProcessing(startTime, processingStartTime *metav1
 	ReleasePreProcessingDurationSeconds.
 		With(prometheus.Labels{
 			"reason": reason,
-			"target": target,
+			"target": getTargetLabelValue(target),
 			"type":   pipelineType,
 		}).
 		Observe(processingStartTime.Sub(startTime.Time).Seconds())
@@ -256,7 +386,14 @@ func init() {
 		ReleaseValidationDurationSeconds,
 		ReleaseDurationSeconds,
 		ReleasePostActionsExecutionDurationSeconds,
+		ReleasePipelineCPUCoreSecondsTotal,
+		ReleasePipelineEstimatedCostTotal,
+		ReleasePipelineMemoryGiBSecondsTotal,
+		ReleasePreemptionsTotal,
 		ReleaseProcessingDurationSeconds,
+		ReleaseSnapshotToReleaseStartSeconds,
+		ReleaseSnapshotToReleasedSeconds,
+		ReleaseSupersededTotal,
 		ReleaseTotal,
 	)
 }
@@ -43,19 +43,19 @@ var _ = Describe("Release metrics", Ordered, func() {
 
 		It("does nothing if the start time is nil", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedRelease(nil, completionTime, "", "", "", "", "", "")
+			RegisterCompletedRelease(nil, completionTime, "", "", "", "", "", "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentTotal.WithLabelValues())).To(Equal(float64(0)))
 		})
 
 		It("does nothing if the completion time is nil", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedRelease(startTime, nil, "", "", "", "", "", "")
+			RegisterCompletedRelease(startTime, nil, "", "", "", "", "", "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentTotal.WithLabelValues())).To(Equal(float64(0)))
 		})
 
 		It("decrements ReleaseConcurrentTotal", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedRelease(startTime, completionTime, "", "", "", "", "", "")
+			RegisterCompletedRelease(startTime, completionTime, "", "", "", "", "", "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentTotal.WithLabelValues())).To(Equal(float64(-1)))
 		})
 
@@ -67,6 +67,7 @@ var _ = Describe("Release metrics", Ordered, func() {
 				releaseDurationSecondsLabels[3],
 				releaseDurationSecondsLabels[4],
 				releaseDurationSecondsLabels[5],
+				"",
 			)
 			Expect(testutil.CollectAndCompare(ReleaseDurationSeconds,
 				test.NewHistogramReader(
@@ -84,6 +85,7 @@ var _ = Describe("Release metrics", Ordered, func() {
 				releaseTotalLabels[3],
 				releaseTotalLabels[4],
 				releaseTotalLabels[5],
+				"",
 			)
 			Expect(testutil.CollectAndCompare(ReleaseTotal,
 				test.NewCounterReader(
@@ -105,25 +107,26 @@ var _ = Describe("Release metrics", Ordered, func() {
 
 		It("does nothing if the start time is nil", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentPostActionsExecutionsTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedReleasePostActionsExecuted(nil, completionTime, "")
+			RegisterCompletedReleasePostActionsExecuted(nil, completionTime, "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentPostActionsExecutionsTotal.WithLabelValues())).To(Equal(float64(0)))
 		})
 
 		It("does nothing if the completion time is nil", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentPostActionsExecutionsTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedReleasePostActionsExecuted(startTime, nil, "")
+			RegisterCompletedReleasePostActionsExecuted(startTime, nil, "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentPostActionsExecutionsTotal.WithLabelValues())).To(Equal(float64(0)))
 		})
 
 		It("decrements ReleaseConcurrentPostActionsExecutionsTotal", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentPostActionsExecutionsTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedReleasePostActionsExecuted(startTime, completionTime, "")
+			RegisterCompletedReleasePostActionsExecuted(startTime, completionTime, "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentPostActionsExecutionsTotal.WithLabelValues())).To(Equal(float64(-1)))
 		})
 
 		It("adds an observation to ReleasePostActionsExecutionDurationSeconds", func() {
 			RegisterCompletedReleasePostActionsExecuted(startTime, completionTime,
 				releasePostActionsExecutionDurationSecondsLabels[0],
+				"",
 			)
 			Expect(testutil.CollectAndCompare(ReleasePostActionsExecutionDurationSeconds,
 				test.NewHistogramReader(
@@ -146,19 +149,19 @@ var _ = Describe("Release metrics", Ordered, func() {
 
 		It("does nothing if the start time is nil", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentProcessingsTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedReleasePipelineProcessing(nil, completionTime, "", "", "")
+			RegisterCompletedReleasePipelineProcessing(nil, completionTime, "", "", "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentProcessingsTotal.WithLabelValues())).To(Equal(float64(0)))
 		})
 
 		It("does nothing if the completion time is nil", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentProcessingsTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedReleasePipelineProcessing(startTime, nil, "", "", "")
+			RegisterCompletedReleasePipelineProcessing(startTime, nil, "", "", "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentProcessingsTotal.WithLabelValues())).To(Equal(float64(0)))
 		})
 
 		It("decrements ReleaseConcurrentProcessingsTotal", func() {
 			Expect(testutil.ToFloat64(ReleaseConcurrentProcessingsTotal.WithLabelValues())).To(Equal(float64(0)))
-			RegisterCompletedReleasePipelineProcessing(startTime, completionTime, "", "", "")
+			RegisterCompletedReleasePipelineProcessing(startTime, completionTime, "", "", "", "")
 			Expect(testutil.ToFloat64(ReleaseConcurrentProcessingsTotal.WithLabelValues())).To(Equal(float64(-1)))
 		})
 
@@ -167,6 +170,7 @@ var _ = Describe("Release metrics", Ordered, func() {
 				releaseProcessingDurationSecondsLabels[0],
 				releaseProcessingDurationSecondsLabels[1],
 				releaseProcessingDurationSecondsLabels[2],
+				"",
 			)
 			Expect(testutil.CollectAndCompare(ReleaseProcessingDurationSeconds,
 				test.NewHistogramReader(
@@ -177,6 +181,95 @@ var _ = Describe("Release metrics", Ordered, func() {
 		})
 	})
 
+	When("RegisterPreemptedRelease is called", func() {
+		BeforeEach(func() {
+			initializeMetrics()
+		})
+
+		It("increments ReleasePreemptionsTotal", func() {
+			RegisterPreemptedRelease("target")
+			Expect(testutil.ToFloat64(ReleasePreemptionsTotal.WithLabelValues("target"))).To(Equal(float64(1)))
+		})
+	})
+
+	When("RegisterReleasePipelineComputeUsage is called", func() {
+		BeforeEach(func() {
+			initializeMetrics()
+		})
+
+		It("adds the usage and the cost to the totals of the ReleasePlan", func() {
+			RegisterReleasePipelineComputeUsage("namespace", "release-plan", "managed", 10, 20, 0.5)
+			RegisterReleasePipelineComputeUsage("namespace", "release-plan", "managed", 10, 20, 0.5)
+			labels := []string{"namespace", "release-plan", "managed"}
+			Expect(testutil.ToFloat64(ReleasePipelineCPUCoreSecondsTotal.WithLabelValues(labels...))).To(Equal(float64(20)))
+			Expect(testutil.ToFloat64(ReleasePipelineMemoryGiBSecondsTotal.WithLabelValues(labels...))).To(Equal(float64(40)))
+			Expect(testutil.ToFloat64(ReleasePipelineEstimatedCostTotal.WithLabelValues(labels...))).To(Equal(float64(1)))
+		})
+	})
+
+	When("RegisterSnapshotToReleaseStart is called", func() {
+		var releasableTime, startTime *metav1.Time
+
+		BeforeEach(func() {
+			initializeMetrics()
+
+			startTime = &metav1.Time{}
+			releasableTime = &metav1.Time{Time: startTime.Add(-60 * time.Second)}
+		})
+
+		It("does nothing if the releasable time is nil", func() {
+			RegisterSnapshotToReleaseStart(nil, startTime, "")
+			Expect(testutil.CollectAndCount(ReleaseSnapshotToReleaseStartSeconds)).To(Equal(0))
+		})
+
+		It("adds an observation to ReleaseSnapshotToReleaseStartSeconds", func() {
+			RegisterSnapshotToReleaseStart(releasableTime, startTime, releaseSnapshotLatencyLabels[0])
+			Expect(testutil.CollectAndCompare(ReleaseSnapshotToReleaseStartSeconds,
+				test.NewHistogramReader(
+					releaseSnapshotToReleaseStartSecondsOpts,
+					releaseSnapshotLatencyLabels,
+					releasableTime, startTime,
+				))).To(Succeed())
+		})
+	})
+
+	When("RegisterSnapshotToReleased is called", func() {
+		var completionTime, releasableTime *metav1.Time
+
+		BeforeEach(func() {
+			initializeMetrics()
+
+			completionTime = &metav1.Time{}
+			releasableTime = &metav1.Time{Time: completionTime.Add(-600 * time.Second)}
+		})
+
+		It("does nothing if the completion time is nil", func() {
+			RegisterSnapshotToReleased(releasableTime, nil, "")
+			Expect(testutil.CollectAndCount(ReleaseSnapshotToReleasedSeconds)).To(Equal(0))
+		})
+
+		It("adds an observation to ReleaseSnapshotToReleasedSeconds", func() {
+			RegisterSnapshotToReleased(releasableTime, completionTime, releaseSnapshotLatencyLabels[0])
+			Expect(testutil.CollectAndCompare(ReleaseSnapshotToReleasedSeconds,
+				test.NewHistogramReader(
+					releaseSnapshotToReleasedSecondsOpts,
+					releaseSnapshotLatencyLabels,
+					releasableTime, completionTime,
+				))).To(Succeed())
+		})
+	})
+
+	When("RegisterSupersededRelease is called", func() {
+		BeforeEach(func() {
+			initializeMetrics()
+		})
+
+		It("increments ReleaseSupersededTotal", func() {
+			RegisterSupersededRelease("target")
+			Expect(testutil.ToFloat64(ReleaseSupersededTotal.WithLabelValues("target"))).To(Equal(float64(1)))
+		})
+	})
+
 	When("RegisterValidatedRelease is called", func() {
 		var validationTime, startTime *metav1.Time
 
@@ -289,6 +382,13 @@ var _ = Describe("Release metrics", Ordered, func() {
 		ReleaseDurationSeconds.Reset()
 		ReleaseProcessingDurationSeconds.Reset()
 		ReleasePostActionsExecutionDurationSeconds.Reset()
+		ReleasePipelineCPUCoreSecondsTotal.Reset()
+		ReleasePipelineEstimatedCostTotal.Reset()
+		ReleasePipelineMemoryGiBSecondsTotal.Reset()
+		ReleasePreemptionsTotal.Reset()
+		ReleaseSnapshotToReleaseStartSeconds.Reset()
+		ReleaseSnapshotToReleasedSeconds.Reset()
+		ReleaseSupersededTotal.Reset()
 		ReleaseTotal.Reset()
 	}
 
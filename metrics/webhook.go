@@ -0,0 +1,91 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	DeprecatedFieldsUsedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_deprecated_fields_used_total",
+			Help: "Total number of resources found setting fields that won't be supported in v1beta1 per kind and " +
+				"field",
+		},
+		[]string{"kind", "field"},
+	)
+
+	LegacyFieldsNormalizedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_legacy_fields_normalized_total",
+			Help: "Total number of deprecated fields rewritten into their current form by the mutating webhooks per " +
+				"kind and field",
+		},
+		[]string{"kind", "field"},
+	)
+
+	OversizedDataRejectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_oversized_data_rejected_total",
+			Help: "Total number of resources rejected by the validating webhooks because their data exceeded a limit " +
+				"per kind and limit",
+		},
+		[]string{"kind", "limit"},
+	)
+
+	RateLimitedReleasesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_rate_limited_releases_total",
+			Help: "Total number of Releases rejected by the validating webhook because their creator exceeded the " +
+				"creation rate limit per namespace",
+		},
+		[]string{"namespace"},
+	)
+)
+
+// RegisterDeprecatedFieldUsed registers a resource of the given kind found setting the given deprecated field.
+func RegisterDeprecatedFieldUsed(kind, field string) {
+	DeprecatedFieldsUsedTotal.WithLabelValues(kind, field).Inc()
+}
+
+// RegisterLegacyFieldNormalized registers the rewrite of the given deprecated field in a resource of the given kind.
+func RegisterLegacyFieldNormalized(kind, field string) {
+	LegacyFieldsNormalizedTotal.WithLabelValues(kind, field).Inc()
+}
+
+// RegisterOversizedDataRejected registers the rejection of a resource of the given kind because its data exceeded the
+// given limit.
+func RegisterOversizedDataRejected(kind, limit string) {
+	OversizedDataRejectedTotal.WithLabelValues(kind, limit).Inc()
+}
+
+// RegisterRateLimitedRelease registers the rejection of a Release created in the given namespace because its creator
+// exceeded the creation rate limit.
+func RegisterRateLimitedRelease(namespace string) {
+	RateLimitedReleasesTotal.WithLabelValues(namespace).Inc()
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		DeprecatedFieldsUsedTotal,
+		LegacyFieldsNormalizedTotal,
+		OversizedDataRejectedTotal,
+		RateLimitedReleasesTotal,
+	)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Webhook metrics", Ordered, func() {
+	BeforeEach(func() {
+		DeprecatedFieldsUsedTotal.Reset()
+		LegacyFieldsNormalizedTotal.Reset()
+		OversizedDataRejectedTotal.Reset()
+		RateLimitedReleasesTotal.Reset()
+	})
+
+	When("RegisterDeprecatedFieldUsed is called", func() {
+		It("increments DeprecatedFieldsUsedTotal for the given kind and field", func() {
+			RegisterDeprecatedFieldUsed("ReleasePlanAdmission", "spec.environment")
+			Expect(testutil.ToFloat64(DeprecatedFieldsUsedTotal.WithLabelValues(
+				"ReleasePlanAdmission", "spec.environment"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(DeprecatedFieldsUsedTotal.WithLabelValues(
+				"ReleasePlan", "spec.environment"))).To(Equal(float64(0)))
+		})
+	})
+
+	When("RegisterLegacyFieldNormalized is called", func() {
+		It("increments LegacyFieldsNormalizedTotal for the given kind and field", func() {
+			RegisterLegacyFieldNormalized("ReleasePlan", "spec.pipeline.pipelineRef.bundle")
+			Expect(testutil.ToFloat64(LegacyFieldsNormalizedTotal.WithLabelValues(
+				"ReleasePlan", "spec.pipeline.pipelineRef.bundle"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(LegacyFieldsNormalizedTotal.WithLabelValues(
+				"ReleasePlanAdmission", "spec.pipeline.pipelineRef.bundle"))).To(Equal(float64(0)))
+		})
+	})
+
+	When("RegisterOversizedDataRejected is called", func() {
+		It("increments OversizedDataRejectedTotal for the given kind and limit", func() {
+			RegisterOversizedDataRejected("Release", "size")
+			Expect(testutil.ToFloat64(OversizedDataRejectedTotal.WithLabelValues("Release", "size"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(OversizedDataRejectedTotal.WithLabelValues("Release", "depth"))).To(Equal(float64(0)))
+		})
+	})
+
+	When("RegisterRateLimitedRelease is called", func() {
+		It("increments RateLimitedReleasesTotal for the given namespace", func() {
+			RegisterRateLimitedRelease("tenant")
+			Expect(testutil.ToFloat64(RateLimitedReleasesTotal.WithLabelValues("tenant"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(RateLimitedReleasesTotal.WithLabelValues("other-tenant"))).To(Equal(float64(0)))
+		})
+	})
+})
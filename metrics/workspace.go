@@ -0,0 +1,56 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	WorkspaceUsageThresholdExceededTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_service_workspace_usage_threshold_exceeded_total",
+			Help: "Total number of times a release workspace was reported above the usage threshold per action taken",
+		},
+		[]string{"action"},
+	)
+)
+
+const (
+	// WorkspaceActionAlerted is the value of the action label when the workspace usage is only reported
+	WorkspaceActionAlerted = "alerted"
+
+	// WorkspaceActionExpanded is the value of the action label when the workspace claim is expanded
+	WorkspaceActionExpanded = "expanded"
+
+	// WorkspaceActionExpansionFailed is the value of the action label when the workspace claim can't be expanded,
+	// e.g. because its storage class doesn't allow volume expansion
+	WorkspaceActionExpansionFailed = "expansion_failed"
+)
+
+// RegisterWorkspaceUsageThresholdExceeded registers a release workspace reported above the usage threshold and the
+// action taken on it.
+func RegisterWorkspaceUsageThresholdExceeded(action string) {
+	WorkspaceUsageThresholdExceededTotal.WithLabelValues(action).Inc()
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		WorkspaceUsageThresholdExceededTotal,
+	)
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/events"
+	"github.com/konflux-ci/release-service/redaction"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigMapNamePrefix is the prefix of the names of the ConfigMaps holding the outboxes. It's followed by the
+	// namespace whose Releases the outbox tracks
+	ConfigMapNamePrefix = "release-outbox-"
+
+	// FinishedReleaseRetention is the time the conditions recorded for a Release are kept after it finished. Past it,
+	// the Release can't change anymore, so its conditions are forgotten to keep the outbox from growing with every
+	// Release of the namespace
+	FinishedReleaseRetention = 10 * time.Minute
+
+	// MaxPendingEvents is the maximum number of events pending delivery in an outbox. Once reached, the oldest events
+	// are dropped to make room for the new ones, so the ConfigMap stays well below the 1MiB object size limit
+	MaxPendingEvents = 250
+
+	// droppedKey is the ConfigMap key holding the number of events dropped because the outbox was full
+	droppedKey = "dropped"
+
+	// eventKeyPrefix is the prefix of the ConfigMap keys holding the events pending delivery
+	eventKeyPrefix = "event."
+
+	// sequenceKey is the ConfigMap key holding the sequence number of the last event appended to the outbox
+	sequenceKey = "sequence"
+
+	// recordedKey is the ConfigMap key holding the time the Releases of the namespace were last recorded
+	recordedKey = "recorded"
+
+	// releasedConditionType is the type of the condition tracking the overall status of a Release
+	releasedConditionType = "Released"
+
+	// stateKeyPrefix is the prefix of the ConfigMap keys holding the last conditions recorded for each Release
+	stateKeyPrefix = "state."
+)
+
+// Event is a change in the status or reason of a Release condition, as delivered to the outbox sinks.
+type Event struct {
+	// ID identifies the event, so sinks can discard events delivered more than once
+	ID string `json:"id"`
+
+	// Namespace is the namespace of the Release
+	Namespace string `json:"namespace"`
+
+	// Release is the name of the Release
+	Release string `json:"release"`
+
+	// Condition is the type of the condition that changed
+	Condition string `json:"condition"`
+
+	// Status is the new status of the condition
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason is the new reason of the condition
+	Reason string `json:"reason"`
+
+	// Message is the message of the condition
+	Message string `json:"message,omitempty"`
+
+	// Time is the time the condition last transitioned
+	Time metav1.Time `json:"time"`
+
+	// Type is the type of the Release event the change matches, if any (e.g. release.succeeded)
+	Type events.Type `json:"type,omitempty"`
+
+	// Payload is the typed payload of the Release event the change matches, if any. Its schema is published in the
+	// events package
+	Payload *events.ReleaseEvent `json:"payload,omitempty"`
+
+	// key is the ConfigMap key holding the event
+	key string
+}
+
+// Outbox is the durable queue of the Release condition changes of a namespace pending delivery. It's stored in a
+// ConfigMap along with the conditions last recorded for each Release, so recording a change and queueing its event
+// happen in a single write and no change is lost or queued twice when the controller restarts. The ConfigMap lives in
+// the namespace of the Release Service, so tenants can't forge events or tamper with the recorded conditions.
+type Outbox struct {
+	configMap *corev1.ConfigMap
+}
+
+// GetConfigMapName returns the name of the ConfigMap holding the Outbox of the given namespace.
+func GetConfigMapName(namespace string) string {
+	return ConfigMapNamePrefix + namespace
+}
+
+// Load returns the Outbox of the given namespace from the given service namespace. If its ConfigMap doesn't exist yet,
+// an empty Outbox is returned and the ConfigMap is created when the Outbox is saved.
+func Load(ctx context.Context, cli client.Client, serviceNamespace, namespace string) (*Outbox, error) {
+	configMap := &corev1.ConfigMap{}
+	err := cli.Get(ctx, client.ObjectKey{Name: GetConfigMapName(namespace), Namespace: serviceNamespace}, configMap)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GetConfigMapName(namespace),
+				Namespace: serviceNamespace,
+			},
+		}
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+
+	return &Outbox{configMap: configMap}, nil
+}
+
+// Forget removes the conditions recorded for the Release with the given name, so the Outbox doesn't grow with
+// Releases that were deleted.
+func (o *Outbox) Forget(release string) {
+	delete(o.configMap.Data, stateKeyPrefix+release)
+}
+
+// GetRecordedReleases returns the names of the Releases whose conditions are recorded in the Outbox.
+func (o *Outbox) GetRecordedReleases() []string {
+	var releases []string
+	for key := range o.configMap.Data {
+		if strings.HasPrefix(key, stateKeyPrefix) {
+			releases = append(releases, strings.TrimPrefix(key, stateKeyPrefix))
+		}
+	}
+	sort.Strings(releases)
+
+	return releases
+}
+
+// GetDroppedEvents returns the number of events dropped because the Outbox was full when they were appended.
+func (o *Outbox) GetDroppedEvents() uint64 {
+	dropped, _ := strconv.ParseUint(o.configMap.Data[droppedKey], 10, 64)
+
+	return dropped
+}
+
+// GetPendingEvents returns the events pending delivery in the order they were appended. An error is returned if an
+// event can't be parsed.
+func (o *Outbox) GetPendingEvents() ([]Event, error) {
+	keys := o.getPendingEventKeys()
+
+	pendingEvents := make([]Event, 0, len(keys))
+	for _, key := range keys {
+		var event Event
+		err := json.Unmarshal([]byte(o.configMap.Data[key]), &event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the outbox event %s: %w", key, err)
+		}
+		event.key = key
+		pendingEvents = append(pendingEvents, event)
+	}
+
+	return pendingEvents, nil
+}
+
+// Record appends an event to the Outbox for each condition of the given Release whose status or reason changed since
+// the last time the Release was recorded. The sensitive values known to the given redactor are redacted from the
+// messages of the events. The conditions of a Release that finished more than FinishedReleaseRetention before the
+// given time are forgotten once recorded, and such a Release is skipped if it finished that long before the Releases
+// were last recorded, as its changes were already recorded then. The returned boolean indicates whether the Outbox
+// changed.
+func (o *Outbox) Record(release *v1alpha1.Release, redactor *redaction.Redactor, now time.Time) (bool, error) {
+	stateKey := stateKeyPrefix + release.Name
+	state := map[string]string{}
+	value, found := o.configMap.Data[stateKey]
+	if found {
+		if err := json.Unmarshal([]byte(value), &state); err != nil {
+			return false, fmt.Errorf("failed to parse the outbox state of release %s: %w", release.Name, err)
+		}
+	} else if hasFinishedBefore(release, o.GetRecordedTime().Add(-FinishedReleaseRetention)) {
+		return false, nil
+	}
+
+	conditions := make([]metav1.Condition, len(release.Status.Conditions))
+	copy(conditions, release.Status.Conditions)
+	sort.SliceStable(conditions, func(i, j int) bool {
+		return conditions[i].LastTransitionTime.Before(&conditions[j].LastTransitionTime)
+	})
+
+	recorded := false
+	for _, condition := range conditions {
+		fingerprint := fmt.Sprintf("%s/%s", condition.Status, condition.Reason)
+		if state[condition.Type] == fingerprint {
+			continue
+		}
+		state[condition.Type] = fingerprint
+
+		event := &Event{
+			Namespace: release.Namespace,
+			Release:   release.Name,
+			Condition: condition.Type,
+			Status:    condition.Status,
+			Reason:    condition.Reason,
+			Message:   redactor.Redact(condition.Message),
+			Time:      condition.LastTransitionTime,
+		}
+		if condition.Type == releasedConditionType {
+			if payload, ok := events.NewReleaseEvent(release); ok {
+				payload.Message = redactor.Redact(payload.Message)
+				event.Type = payload.Type
+				event.Payload = payload
+			}
+		}
+
+		err := o.append(event)
+		if err != nil {
+			return false, err
+		}
+		recorded = true
+	}
+
+	if hasFinishedBefore(release, now.Add(-FinishedReleaseRetention)) {
+		o.Forget(release.Name)
+
+		return recorded || found, nil
+	}
+
+	newValue, err := json.Marshal(state)
+	if err != nil {
+		return false, err
+	}
+	o.configMap.Data[stateKey] = string(newValue)
+
+	return recorded || string(newValue) != value, nil
+}
+
+// GetRecordedTime returns the time the Releases of the namespace were last recorded, or the zero time if they never
+// were.
+func (o *Outbox) GetRecordedTime() time.Time {
+	recordedTime, _ := time.Parse(time.RFC3339, o.configMap.Data[recordedKey])
+
+	return recordedTime
+}
+
+// SetRecordedTime sets the time the Releases of the namespace were last recorded. It has to be a time before the
+// Releases were listed, so the Releases that finished after it are recorded again if their conditions were not.
+func (o *Outbox) SetRecordedTime(recordedTime time.Time) {
+	o.configMap.Data[recordedKey] = recordedTime.UTC().Format(time.RFC3339)
+}
+
+// Remove removes the given event from the Outbox once it was delivered.
+func (o *Outbox) Remove(event *Event) {
+	delete(o.configMap.Data, event.key)
+}
+
+// Save stores the Outbox in its ConfigMap, creating it if it doesn't exist yet. Concurrent modifications of the
+// ConfigMap make the save fail with a conflict error, so the Outbox has to be loaded again.
+func (o *Outbox) Save(ctx context.Context, cli client.Client) error {
+	if o.configMap.ResourceVersion == "" {
+		return cli.Create(ctx, o.configMap)
+	}
+
+	return cli.Update(ctx, o.configMap)
+}
+
+// append assigns the next sequence number to the given event and adds it to the Outbox. If the Outbox is full, the
+// oldest pending events are dropped to make room for it.
+func (o *Outbox) append(event *Event) error {
+	sequence, _ := strconv.ParseUint(o.configMap.Data[sequenceKey], 10, 64)
+	sequence++
+
+	event.ID = fmt.Sprintf("%s-%d", event.Namespace, sequence)
+	event.key = fmt.Sprintf("%s%020d", eventKeyPrefix, sequence)
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	keys := o.getPendingEventKeys()
+	if overflow := len(keys) - MaxPendingEvents + 1; overflow > 0 {
+		for _, key := range keys[:overflow] {
+			delete(o.configMap.Data, key)
+		}
+		o.configMap.Data[droppedKey] = strconv.FormatUint(o.GetDroppedEvents()+uint64(overflow), 10)
+	}
+
+	o.configMap.Data[event.key] = string(value)
+	o.configMap.Data[sequenceKey] = strconv.FormatUint(sequence, 10)
+
+	return nil
+}
+
+// hasFinishedBefore returns whether the given Release finished before the given time.
+func hasFinishedBefore(release *v1alpha1.Release, t time.Time) bool {
+	if !release.HasReleaseFinished() {
+		return false
+	}
+
+	condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType)
+
+	return condition.LastTransitionTime.Time.Before(t)
+}
+
+// getPendingEventKeys returns the ConfigMap keys of the events pending delivery in the order they were appended.
+func (o *Outbox) getPendingEventKeys() []string {
+	var keys []string
+	for key := range o.configMap.Data {
+		if strings.HasPrefix(key, eventKeyPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	// Keys are zero-padded, so sorting them sorts the events by sequence number
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/redaction"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Outbox", func() {
+	var outbox *Outbox
+	var release *v1alpha1.Release
+	now := time.Unix(1700000200, 0)
+
+	BeforeEach(func() {
+		outbox = &Outbox{configMap: &corev1.ConfigMap{Data: map[string]string{}}}
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: "default",
+			},
+			Status: v1alpha1.ReleaseStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               "Released",
+						Status:             metav1.ConditionUnknown,
+						Reason:             "Progressing",
+						LastTransitionTime: metav1.NewTime(time.Unix(1700000100, 0)),
+					},
+					{
+						Type:               "Validated",
+						Status:             metav1.ConditionTrue,
+						Reason:             "Succeeded",
+						LastTransitionTime: metav1.NewTime(time.Unix(1700000000, 0)),
+					},
+				},
+			},
+		}
+	})
+
+	When("Record is called", func() {
+		It("should append an event for each condition in the order they transitioned", func() {
+			recorded, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(BeTrue())
+
+			events, err := outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].ID).To(Equal("default-1"))
+			Expect(events[0].Condition).To(Equal("Validated"))
+			Expect(events[1].ID).To(Equal("default-2"))
+			Expect(events[1].Condition).To(Equal("Released"))
+			Expect(events[1].Status).To(Equal(metav1.ConditionUnknown))
+		})
+
+		It("should not append events for conditions that didn't change", func() {
+			_, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			release.Status.Conditions[1].Message = "new message"
+			recorded, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(BeFalse())
+		})
+
+		It("should append an event when the reason of a condition changes", func() {
+			_, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			release.Status.Conditions[0].Reason = "Succeeded"
+			recorded, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(BeTrue())
+
+			events, err := outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(3))
+			Expect(events[2].Condition).To(Equal("Released"))
+			Expect(events[2].Reason).To(Equal("Succeeded"))
+		})
+
+		It("should attach the typed payload to the changes of the Released condition", func() {
+			_, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			release.Status.Conditions[0].Reason = "Succeeded"
+			_, err = outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events[0].Payload).To(BeNil())
+			Expect(string(events[2].Type)).To(Equal("release.succeeded"))
+			Expect(events[2].Payload).NotTo(BeNil())
+			Expect(events[2].Payload.Release).To(Equal("release"))
+		})
+
+		It("should redact the sensitive values from the messages", func() {
+			release.Status.Conditions[0].Message = "failed to log in with s3cr3t"
+			release.Status.Conditions[0].Status = metav1.ConditionFalse
+			release.Status.Conditions[0].Reason = "Failed"
+			release.Status.Summary.Message = "failed to log in with s3cr3t"
+			_, err := outbox.Record(release, redaction.NewRedactor("s3cr3t"), now)
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events[1].Message).To(Equal("failed to log in with " + redaction.Placeholder))
+			Expect(events[1].Payload).NotTo(BeNil())
+			Expect(events[1].Payload.Message).NotTo(ContainSubstring("s3cr3t"))
+		})
+
+		It("should keep the conditions of the Releases that finished recently", func() {
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			release.Status.Conditions[0].Reason = "Succeeded"
+			_, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outbox.GetRecordedReleases()).To(Equal([]string{"release"}))
+		})
+
+		It("should forget the conditions of the Releases that finished before the retention once recorded", func() {
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			release.Status.Conditions[0].Reason = "Succeeded"
+			recorded, err := outbox.Record(release, nil, now.Add(FinishedReleaseRetention))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(BeTrue())
+			Expect(outbox.GetRecordedReleases()).To(BeEmpty())
+
+			events, err := outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(string(events[1].Type)).To(Equal("release.succeeded"))
+		})
+
+		It("should skip the Releases that finished before the retention preceding the last recording", func() {
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			release.Status.Conditions[0].Reason = "Succeeded"
+			outbox.SetRecordedTime(now.Add(FinishedReleaseRetention))
+
+			recorded, err := outbox.Record(release, nil, now.Add(FinishedReleaseRetention))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(BeFalse())
+
+			events, err := outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("should record the Releases that finished after the retention preceding the last recording", func() {
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			release.Status.Conditions[0].Reason = "Succeeded"
+			outbox.SetRecordedTime(now)
+
+			recorded, err := outbox.Record(release, nil, now.Add(FinishedReleaseRetention))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(BeTrue())
+		})
+
+		It("should drop the oldest events once the outbox is full", func() {
+			for i := 0; i < MaxPendingEvents; i++ {
+				release.Status.Conditions[1].Reason = fmt.Sprintf("Reason%d", i)
+				_, err := outbox.Record(release, nil, now)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(outbox.GetDroppedEvents()).To(Equal(uint64(1)))
+
+			events, err := outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(MaxPendingEvents))
+			Expect(events[0].ID).To(Equal("default-2"))
+			Expect(events[MaxPendingEvents-1].ID).To(Equal(fmt.Sprintf("default-%d", MaxPendingEvents+1)))
+		})
+	})
+
+	When("Remove is called", func() {
+		It("should remove the event while keeping the sequence", func() {
+			_, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			events, _ := outbox.GetPendingEvents()
+			outbox.Remove(&events[0])
+			outbox.Remove(&events[1])
+
+			release.Status.Conditions[0].Status = metav1.ConditionTrue
+			_, err = outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err = outbox.GetPendingEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].ID).To(Equal("default-3"))
+		})
+	})
+
+	When("Forget is called", func() {
+		It("should remove the conditions recorded for the Release", func() {
+			_, err := outbox.Record(release, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outbox.GetRecordedReleases()).To(Equal([]string{"release"}))
+
+			outbox.Forget("release")
+			Expect(outbox.GetRecordedReleases()).To(BeEmpty())
+		})
+	})
+
+	When("SetRecordedTime is called", func() {
+		It("should set the time returned by GetRecordedTime", func() {
+			Expect(outbox.GetRecordedTime()).To(BeZero())
+
+			outbox.SetRecordedTime(now)
+			Expect(outbox.GetRecordedTime().Equal(now)).To(BeTrue())
+		})
+	})
+
+	When("GetPendingEvents is called", func() {
+		It("should fail if an event can't be parsed", func() {
+			outbox.configMap.Data[eventKeyPrefix+"1"] = "{"
+			_, err := outbox.GetPendingEvents()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
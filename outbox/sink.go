@@ -0,0 +1,104 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Sink is an interface to deliver the Release condition changes queued in an Outbox to an external system. Events are
+// delivered at least once, so sinks should use the event ID to discard duplicates.
+type Sink interface {
+	Deliver(ctx context.Context, event *Event) error
+}
+
+// webhookSink delivers each event as a JSON document posted to a webhook.
+type webhookSink struct {
+	httpClient *http.Client
+	token      string
+	url        string
+}
+
+// NewWebhookSink creates and returns a Sink posting the events to the given url with the given http client. If a
+// token is given, it's sent as a bearer token in every request.
+func NewWebhookSink(url, token string, httpClient *http.Client) Sink {
+	return &webhookSink{
+		httpClient: httpClient,
+		token:      token,
+		url:        url,
+	}
+}
+
+// NewWebhookSinks creates and returns a webhook Sink for each url in the given comma-separated list. An error is
+// returned if any of the urls is not an http or https url.
+func NewWebhookSinks(urls, token string, httpClient *http.Client) ([]Sink, error) {
+	var sinks []Sink
+	for _, sinkUrl := range strings.Split(urls, ",") {
+		sinkUrl = strings.TrimSpace(sinkUrl)
+		if sinkUrl == "" {
+			continue
+		}
+
+		parsedUrl, err := url.Parse(sinkUrl)
+		if err != nil {
+			return nil, err
+		}
+		if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+			return nil, fmt.Errorf("unsupported outbox sink scheme '%s'", parsedUrl.Scheme)
+		}
+
+		sinks = append(sinks, NewWebhookSink(sinkUrl, token, httpClient))
+	}
+
+	return sinks, nil
+}
+
+// Deliver posts the given event to the webhook, failing if it doesn't reply with a successful status.
+func (s *webhookSink) Deliver(ctx context.Context, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d delivering the outbox event %s", response.StatusCode, event.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhook sink", func() {
+	event := &Event{
+		ID:        "default-1",
+		Namespace: "default",
+		Release:   "release",
+		Condition: "Released",
+	}
+
+	It("should post the event as a JSON document", func() {
+		var method, authorization string
+		delivered := &Event{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, authorization = r.Method, r.Header.Get("Authorization")
+			Expect(json.NewDecoder(r.Body).Decode(delivered)).To(Succeed())
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		sink := NewWebhookSink(server.URL, "token", server.Client())
+
+		Expect(sink.Deliver(context.TODO(), event)).To(Succeed())
+		Expect(method).To(Equal(http.MethodPost))
+		Expect(authorization).To(Equal("Bearer token"))
+		Expect(delivered.ID).To(Equal("default-1"))
+		Expect(delivered.Condition).To(Equal("Released"))
+	})
+
+	It("should fail if the webhook returns an error", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := NewWebhookSink(server.URL, "", server.Client()).Deliver(context.TODO(), event)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status 503"))
+	})
+
+	When("NewWebhookSinks is called", func() {
+		It("should create a sink for each url", func() {
+			sinks, err := NewWebhookSinks("https://foo.example.com, http://bar.example.com,", "", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sinks).To(HaveLen(2))
+		})
+
+		It("should fail if a url is not an http url", func() {
+			_, err := NewWebhookSinks("https://foo.example.com,ftp://bar.example.com", "", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported outbox sink scheme 'ftp'"))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platforms
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// secretKeychain resolves the credentials of a registry from the docker config of pull secrets.
+type secretKeychain struct {
+	auths map[string]authn.AuthConfig
+}
+
+// NewKeychain creates and returns a Keychain resolving the credentials of the registries from the given pull secrets,
+// like the kubelet does for the pull secrets of a ServiceAccount. Secrets that are not of the dockerconfigjson or
+// dockercfg types or can't be parsed are ignored, and the first secret listing a registry takes precedence. Registries
+// not listed in any secret are accessed anonymously.
+func NewKeychain(secrets []corev1.Secret) authn.Keychain {
+	keychain := &secretKeychain{
+		auths: map[string]authn.AuthConfig{},
+	}
+
+	for _, secret := range secrets {
+		auths := map[string]authn.AuthConfig{}
+		switch secret.Type {
+		case corev1.SecretTypeDockerConfigJson:
+			config := &struct {
+				Auths map[string]authn.AuthConfig `json:"auths"`
+			}{}
+			if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], config); err != nil {
+				continue
+			}
+			auths = config.Auths
+		case corev1.SecretTypeDockercfg:
+			if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &auths); err != nil {
+				continue
+			}
+		default:
+			continue
+		}
+
+		for key, auth := range auths {
+			key = normalizeRegistryKey(key)
+			if _, found := keychain.auths[key]; !found {
+				keychain.auths[key] = auth
+			}
+		}
+	}
+
+	return keychain
+}
+
+// Resolve returns the credentials of the given registry or repository. Keys listing a repository path take precedence
+// over the keys listing its registry, and the longest matching path wins.
+func (k *secretKeychain) Resolve(resource authn.Resource) (authn.Authenticator, error) {
+	target := resource.String()
+	matchingKey := ""
+	for key := range k.auths {
+		if (target == key || strings.HasPrefix(target, key+"/")) && len(key) > len(matchingKey) {
+			matchingKey = key
+		}
+	}
+
+	if matchingKey == "" {
+		return authn.Anonymous, nil
+	}
+
+	return authn.FromConfig(k.auths[matchingKey]), nil
+}
+
+// normalizeRegistryKey returns the given docker config key without its scheme, the path of the legacy Docker Hub
+// endpoint and trailing slashes, so it can be compared with the registries and repositories of the images.
+func normalizeRegistryKey(key string) string {
+	key = strings.TrimPrefix(key, "https://")
+	key = strings.TrimPrefix(key, "http://")
+	key = strings.TrimSuffix(key, "/")
+	key = strings.TrimSuffix(key, "/v1")
+	key = strings.TrimSuffix(key, "/v2")
+
+	if key == "docker.io" || strings.HasPrefix(key, "docker.io/") {
+		return name.DefaultRegistry + strings.TrimPrefix(key, "docker.io")
+	}
+
+	return key
+}
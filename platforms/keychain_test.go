@@ -0,0 +1,129 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platforms
+
+import (
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Keychain", func() {
+	// resolve returns the credentials resolved by the given keychain for the repository of the given image.
+	resolve := func(keychain authn.Keychain, image string) *authn.AuthConfig {
+		reference, err := name.ParseReference(image)
+		Expect(err).NotTo(HaveOccurred())
+
+		authenticator, err := keychain.Resolve(reference.Context())
+		Expect(err).NotTo(HaveOccurred())
+		authConfig, err := authenticator.Authorization()
+		Expect(err).NotTo(HaveOccurred())
+
+		return authConfig
+	}
+
+	When("NewKeychain is called", func() {
+		It("resolves the credentials of the registries listed in dockerconfigjson secrets", func() {
+			keychain := NewKeychain([]corev1.Secret{
+				{
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{"https://quay.io":{"username":"user","password":"pass"}}}`),
+					},
+				},
+			})
+
+			authConfig := resolve(keychain, "quay.io/foo/bar:1.0")
+			Expect(authConfig.Username).To(Equal("user"))
+			Expect(authConfig.Password).To(Equal("pass"))
+		})
+
+		It("resolves the credentials of the registries listed in dockercfg secrets", func() {
+			keychain := NewKeychain([]corev1.Secret{
+				{
+					Type: corev1.SecretTypeDockercfg,
+					Data: map[string][]byte{
+						corev1.DockerConfigKey: []byte(`{"https://index.docker.io/v1/":{"auth":"dXNlcjpwYXNz"}}`),
+					},
+				},
+			})
+
+			Expect(resolve(keychain, "foo/bar:1.0").Auth).To(Equal("dXNlcjpwYXNz"))
+		})
+
+		It("prefers the credentials of the longest matching repository path", func() {
+			keychain := NewKeychain([]corev1.Secret{
+				{
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{
+							"quay.io":{"username":"registry"},
+							"quay.io/foo":{"username":"organization"}
+						}}`),
+					},
+				},
+			})
+
+			Expect(resolve(keychain, "quay.io/foo/bar:1.0").Username).To(Equal("organization"))
+			Expect(resolve(keychain, "quay.io/foobar/bar:1.0").Username).To(Equal("registry"))
+		})
+
+		It("gives precedence to the first secret listing a registry", func() {
+			keychain := NewKeychain([]corev1.Secret{
+				{
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"username":"first"}}}`),
+					},
+				},
+				{
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"username":"second"}}}`),
+					},
+				},
+			})
+
+			Expect(resolve(keychain, "quay.io/foo/bar:1.0").Username).To(Equal("first"))
+		})
+
+		It("ignores the secrets of other types or that can't be parsed", func() {
+			keychain := NewKeychain([]corev1.Secret{
+				{
+					Type: corev1.SecretTypeOpaque,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"username":"opaque"}}}`),
+					},
+				},
+				{
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{`),
+					},
+				},
+			})
+
+			Expect(resolve(keychain, "quay.io/foo/bar:1.0")).To(Equal(&authn.AuthConfig{}))
+		})
+
+		It("accesses the registries not listed in any secret anonymously", func() {
+			Expect(resolve(NewKeychain(nil), "registry.io/foo/bar:1.0")).To(Equal(&authn.AuthConfig{}))
+		})
+	})
+})
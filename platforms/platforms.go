@@ -0,0 +1,85 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platforms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+// ParamName is the name of the pipeline parameter containing the platforms of each component
+const ParamName = "componentPlatforms"
+
+// Getter is an interface to get the platforms an image is available for, using the credentials resolved by the given
+// keychain to access its registry.
+type Getter interface {
+	GetPlatforms(ctx context.Context, image string, keychain authn.Keychain) ([]string, error)
+}
+
+// ComponentPlatforms contains the platforms the image of a component is available for.
+type ComponentPlatforms struct {
+	// Name is the name of the component
+	Name string `json:"name"`
+
+	// Platforms is the list of platforms in the os/architecture[/variant] format
+	Platforms []string `json:"platforms"`
+}
+
+// GetComponentPlatforms returns the platforms the image of each component in the given Snapshot is available for,
+// accessing the registries with the credentials resolved by the given keychain. Components whose platforms can't be
+// determined are not included in the returned list, but an error containing the name of each of them is returned along
+// with the platforms of the rest of components.
+func GetComponentPlatforms(ctx context.Context, getter Getter, keychain authn.Keychain, snapshot *applicationapiv1alpha1.Snapshot) ([]ComponentPlatforms, error) {
+	componentPlatforms := []ComponentPlatforms{}
+	var failedComponents []string
+
+	for _, component := range snapshot.Spec.Components {
+		platforms, err := getter.GetPlatforms(ctx, component.ContainerImage, keychain)
+		if err != nil {
+			failedComponents = append(failedComponents, component.Name)
+			continue
+		}
+
+		sort.Strings(platforms)
+		componentPlatforms = append(componentPlatforms, ComponentPlatforms{
+			Name:      component.Name,
+			Platforms: platforms,
+		})
+	}
+
+	if len(failedComponents) > 0 {
+		return componentPlatforms, fmt.Errorf("failed to get the platforms of components %v", failedComponents)
+	}
+
+	return componentPlatforms, nil
+}
+
+// ToJson returns the JSON representation of the given list of component platforms, wrapped in a components key so
+// the pipeline parameter can be extended in the future.
+func ToJson(componentPlatforms []ComponentPlatforms) (string, error) {
+	jsonData, err := json.Marshal(map[string][]ComponentPlatforms{"components": componentPlatforms})
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonData), nil
+}
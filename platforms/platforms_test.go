@@ -0,0 +1,98 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platforms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+// mockGetter returns the platforms stored for each image, failing for unknown images.
+type mockGetter map[string][]string
+
+func (m mockGetter) GetPlatforms(_ context.Context, image string, _ authn.Keychain) ([]string, error) {
+	if platforms, found := m[image]; found {
+		return platforms, nil
+	}
+
+	return nil, fmt.Errorf("unknown image")
+}
+
+var _ = Describe("Platforms", func() {
+	var snapshot *applicationapiv1alpha1.Snapshot
+
+	BeforeEach(func() {
+		snapshot = &applicationapiv1alpha1.Snapshot{
+			Spec: applicationapiv1alpha1.SnapshotSpec{
+				Components: []applicationapiv1alpha1.SnapshotComponent{
+					{Name: "component-a", ContainerImage: "quay.io/foo/a@sha256:aaa"},
+					{Name: "component-b", ContainerImage: "quay.io/foo/b@sha256:bbb"},
+				},
+			},
+		}
+	})
+
+	When("GetComponentPlatforms is called", func() {
+		It("returns the sorted platforms of every component", func() {
+			getter := mockGetter{
+				"quay.io/foo/a@sha256:aaa": {"windows/amd64", "linux/arm64", "linux/amd64"},
+				"quay.io/foo/b@sha256:bbb": {"linux/amd64"},
+			}
+
+			componentPlatforms, err := GetComponentPlatforms(context.TODO(), getter, nil, snapshot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(componentPlatforms).To(Equal([]ComponentPlatforms{
+				{Name: "component-a", Platforms: []string{"linux/amd64", "linux/arm64", "windows/amd64"}},
+				{Name: "component-b", Platforms: []string{"linux/amd64"}},
+			}))
+		})
+
+		It("leaves out the components whose platforms can't be determined", func() {
+			getter := mockGetter{
+				"quay.io/foo/b@sha256:bbb": {"linux/amd64"},
+			}
+
+			componentPlatforms, err := GetComponentPlatforms(context.TODO(), getter, nil, snapshot)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("component-a"))
+			Expect(componentPlatforms).To(Equal([]ComponentPlatforms{
+				{Name: "component-b", Platforms: []string{"linux/amd64"}},
+			}))
+		})
+	})
+
+	When("ToJson is called", func() {
+		It("returns the platforms wrapped in a components key", func() {
+			value, err := ToJson([]ComponentPlatforms{
+				{Name: "component-a", Platforms: []string{"linux/amd64"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(MatchJSON(`{"components":[{"name":"component-a","platforms":["linux/amd64"]}]}`))
+		})
+
+		It("returns an empty list if no platforms are passed", func() {
+			value, err := ToJson([]ComponentPlatforms{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(MatchJSON(`{"components":[]}`))
+		})
+	})
+})
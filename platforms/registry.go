@@ -0,0 +1,171 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platforms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// maxCachedImages is the maximum number of image digests whose platforms are cached. The cache is cleared once it's
+// reached, so it doesn't grow with every image released
+const maxCachedImages = 1000
+
+// registryGetter gets the platforms of an image by querying its registry, caching them per image digest.
+type registryGetter struct {
+	cache     map[string][]string
+	mutex     sync.Mutex
+	timeout   time.Duration
+	transport http.RoundTripper
+}
+
+// NewRegistryGetter creates and returns a Getter querying image registries with the transport and timeout of the given
+// client. As the platforms of an image never change, they are cached per image digest.
+func NewRegistryGetter(httpClient *http.Client) Getter {
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &registryGetter{
+		cache:     map[string][]string{},
+		timeout:   httpClient.Timeout,
+		transport: transport,
+	}
+}
+
+// GetPlatforms returns the platforms the given image is available for, authenticating to its registry with the
+// credentials resolved by the given keychain. For image indexes, the platforms are taken from the index itself,
+// ignoring entries with an unknown platform such as attestations. For single manifests, the platform is taken from the
+// image config. Images referenced by digest are served from the cache without querying the registry.
+func (g *registryGetter) GetPlatforms(ctx context.Context, image string, keychain authn.Keychain) ([]string, error) {
+	reference, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest, ok := reference.(name.Digest); ok {
+		if platforms, found := g.getCachedPlatforms(digest.DigestStr()); found {
+			return platforms, nil
+		}
+	}
+
+	if keychain == nil {
+		keychain = authn.NewMultiKeychain()
+	}
+	if g.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.timeout)
+		defer cancel()
+	}
+
+	descriptor, err := remote.Get(reference,
+		remote.WithAuthFromKeychain(keychain),
+		remote.WithContext(ctx),
+		remote.WithTransport(g.transport),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := descriptor.Digest.String()
+	if platforms, found := g.getCachedPlatforms(digest); found {
+		return platforms, nil
+	}
+
+	var platforms []string
+	if descriptor.MediaType.IsIndex() {
+		index, err := descriptor.ImageIndex()
+		if err != nil {
+			return nil, err
+		}
+
+		indexManifest, err := index.IndexManifest()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range indexManifest.Manifests {
+			if entry.Platform == nil || entry.Platform.OS == "unknown" || entry.Platform.OS == "" {
+				continue
+			}
+			platforms = append(platforms, formatPlatform(entry.Platform))
+		}
+	} else {
+		img, err := descriptor.Image()
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := img.ConfigFile()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the config of image %s: %w", image, err)
+		}
+
+		platforms = []string{formatPlatform(&v1.Platform{
+			Architecture: config.Architecture,
+			OS:           config.OS,
+			Variant:      config.Variant,
+		})}
+	}
+
+	g.cachePlatforms(digest, platforms)
+
+	return platforms, nil
+}
+
+// cachePlatforms caches the platforms of the image with the given digest, clearing the cache first if it's full.
+func (g *registryGetter) cachePlatforms(digest string, platforms []string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if len(g.cache) >= maxCachedImages {
+		g.cache = map[string][]string{}
+	}
+	g.cache[digest] = platforms
+}
+
+// getCachedPlatforms returns a copy of the cached platforms of the image with the given digest. The returned boolean
+// indicates whether the image was found in the cache.
+func (g *registryGetter) getCachedPlatforms(digest string) ([]string, bool) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	platforms, found := g.cache[digest]
+	if !found {
+		return nil, false
+	}
+
+	return append([]string{}, platforms...), true
+}
+
+// formatPlatform returns the given platform in the os/architecture[/variant] format.
+func formatPlatform(platform *v1.Platform) string {
+	if platform.Variant != "" {
+		return fmt.Sprintf("%s/%s/%s", platform.OS, platform.Architecture, platform.Variant)
+	}
+
+	return fmt.Sprintf("%s/%s", platform.OS, platform.Architecture)
+}
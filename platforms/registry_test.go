@@ -0,0 +1,181 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platforms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Registry getter", func() {
+	var (
+		authRequired bool
+		getter       Getter
+		requests     atomic.Int32
+		server       *httptest.Server
+	)
+
+	// newImage returns a random image whose config has the given platform.
+	newImage := func(platform v1.Platform) v1.Image {
+		img, err := random.Image(64, 1)
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err := img.ConfigFile()
+		Expect(err).NotTo(HaveOccurred())
+		config.OS = platform.OS
+		config.Architecture = platform.Architecture
+		config.Variant = platform.Variant
+
+		img, err = mutate.ConfigFile(img, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		return img
+	}
+
+	// getReference returns the reference of the given repository and tag in the test registry.
+	getReference := func(repository, tag string) name.Reference {
+		reference, err := name.ParseReference(fmt.Sprintf("%s/%s:%s", strings.TrimPrefix(server.URL, "http://"),
+			repository, tag))
+		Expect(err).NotTo(HaveOccurred())
+
+		return reference
+	}
+
+	BeforeEach(func() {
+		authRequired = false
+		requests.Store(0)
+
+		registryHandler := registry.New()
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			if username, password, ok := r.BasicAuth(); authRequired && (!ok || username != "user" || password != "pass") {
+				w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			registryHandler.ServeHTTP(w, r)
+		}))
+		getter = NewRegistryGetter(server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	When("GetPlatforms is called", func() {
+		It("returns the platforms of an image index ignoring unknown platforms", func() {
+			var index v1.ImageIndex = empty.Index
+			for _, platform := range []v1.Platform{
+				{OS: "linux", Architecture: "amd64"},
+				{OS: "linux", Architecture: "arm64", Variant: "v8"},
+				{OS: "windows", Architecture: "amd64"},
+				{OS: "unknown", Architecture: "unknown"},
+			} {
+				platform := platform
+				index = mutate.AppendManifests(index, mutate.IndexAddendum{
+					Add:        newImage(platform),
+					Descriptor: v1.Descriptor{Platform: &platform},
+				})
+			}
+			reference := getReference("foo/bar", "1.0")
+			Expect(remote.WriteIndex(reference, index)).To(Succeed())
+
+			platforms, err := getter.GetPlatforms(context.TODO(), reference.String(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(platforms).To(Equal([]string{"linux/amd64", "linux/arm64/v8", "windows/amd64"}))
+		})
+
+		It("returns the platform of a single manifest from its config", func() {
+			reference := getReference("foo/bar", "1.0")
+			Expect(remote.Write(reference, newImage(v1.Platform{OS: "linux", Architecture: "s390x"}))).To(Succeed())
+
+			platforms, err := getter.GetPlatforms(context.TODO(), reference.String(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(platforms).To(Equal([]string{"linux/s390x"}))
+		})
+
+		It("authenticates with the credentials resolved by the keychain", func() {
+			reference := getReference("foo/bar", "1.0")
+			Expect(remote.Write(reference, newImage(v1.Platform{OS: "linux", Architecture: "amd64"}))).To(Succeed())
+			authRequired = true
+
+			_, err := getter.GetPlatforms(context.TODO(), reference.String(), nil)
+			Expect(err).To(HaveOccurred())
+
+			keychain := NewKeychain([]corev1.Secret{
+				{
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(fmt.Sprintf(
+							`{"auths":{"%s":{"username":"user","password":"pass"}}}`, reference.Context().RegistryStr())),
+					},
+				},
+			})
+			platforms, err := getter.GetPlatforms(context.TODO(), reference.String(), keychain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(platforms).To(Equal([]string{"linux/amd64"}))
+		})
+
+		It("serves the platforms of images referenced by digest from the cache", func() {
+			img := newImage(v1.Platform{OS: "linux", Architecture: "ppc64le"})
+			reference := getReference("foo/bar", "1.0")
+			Expect(remote.Write(reference, img)).To(Succeed())
+			digest, err := img.Digest()
+			Expect(err).NotTo(HaveOccurred())
+			image := reference.Context().Digest(digest.String()).String()
+
+			platforms, err := getter.GetPlatforms(context.TODO(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(platforms).To(Equal([]string{"linux/ppc64le"}))
+
+			requests.Store(0)
+			platforms, err = getter.GetPlatforms(context.TODO(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(platforms).To(Equal([]string{"linux/ppc64le"}))
+			Expect(requests.Load()).To(BeZero())
+		})
+
+		It("fails if the manifest is not found", func() {
+			reference := getReference("foo/bar", "2.0")
+			Expect(remote.Write(reference, newImage(v1.Platform{OS: "linux", Architecture: "amd64"}))).To(Succeed())
+
+			_, err := getter.GetPlatforms(context.TODO(), getReference("foo/bar", "1.0").String(), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("MANIFEST_UNKNOWN"))
+		})
+
+		It("fails if the image reference is invalid", func() {
+			_, err := getter.GetPlatforms(context.TODO(), "INVALID::image", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
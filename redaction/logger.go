@@ -0,0 +1,73 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redaction
+
+import (
+	"github.com/go-logr/logr"
+)
+
+// logSink is a logr.LogSink redacting the messages, values and errors logged before passing them to the wrapped sink.
+type logSink struct {
+	redactor *Redactor
+	sink     logr.LogSink
+}
+
+// NewLogger returns a copy of the given logger redacting the sensitive values of the given Redactor from every
+// message, value and error it logs.
+func NewLogger(logger logr.Logger, redactor *Redactor) logr.Logger {
+	sink := logger.GetSink()
+	if sink == nil {
+		return logger
+	}
+
+	// The wrapper adds a frame to the stack, which would otherwise be reported as the caller
+	if callDepthSink, ok := sink.(logr.CallDepthLogSink); ok {
+		sink = callDepthSink.WithCallDepth(1)
+	}
+
+	return logger.WithSink(&logSink{redactor: redactor, sink: sink})
+}
+
+// Init implements logr.LogSink.
+func (s *logSink) Init(info logr.RuntimeInfo) {
+	s.sink.Init(info)
+}
+
+// Enabled implements logr.LogSink.
+func (s *logSink) Enabled(level int) bool {
+	return s.sink.Enabled(level)
+}
+
+// Info implements logr.LogSink.
+func (s *logSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	s.sink.Info(level, s.redactor.Redact(msg), s.redactor.redactValues(keysAndValues)...)
+}
+
+// Error implements logr.LogSink.
+func (s *logSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.sink.Error(s.redactor.redactError(err), s.redactor.Redact(msg), s.redactor.redactValues(keysAndValues)...)
+}
+
+// WithValues implements logr.LogSink.
+func (s *logSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &logSink{redactor: s.redactor, sink: s.sink.WithValues(s.redactor.redactValues(keysAndValues)...)}
+}
+
+// WithName implements logr.LogSink.
+func (s *logSink) WithName(name string) logr.LogSink {
+	return &logSink{redactor: s.redactor, sink: s.sink.WithName(name)}
+}
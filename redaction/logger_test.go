@@ -0,0 +1,58 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redaction
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logger", func() {
+	var (
+		logger logr.Logger
+		lines  []string
+	)
+
+	BeforeEach(func() {
+		lines = nil
+		logger = NewLogger(funcr.New(func(prefix, args string) {
+			lines = append(lines, prefix+" "+args)
+		}, funcr.Options{}), NewRedactor("s3cr3t"))
+	})
+
+	It("should redact the messages and values logged", func() {
+		logger.WithName("release").WithValues("password", "s3cr3t").Info("using s3cr3t", "token", "s3cr3t")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring("release"))
+		Expect(lines[0]).NotTo(ContainSubstring("s3cr3t"))
+		Expect(lines[0]).To(ContainSubstring(Placeholder))
+	})
+
+	It("should redact the errors logged", func() {
+		logger.Error(fmt.Errorf("invalid s3cr3t"), "failure")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).NotTo(ContainSubstring("s3cr3t"))
+	})
+
+	It("should return the same logger if it has no sink", func() {
+		Expect(NewLogger(logr.Logger{}, NewRedactor("s3cr3t"))).To(Equal(logr.Logger{}))
+	})
+})
@@ -0,0 +1,58 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redaction
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// eventRecorder is a record.EventRecorder redacting the messages of the events before passing them to the wrapped
+// recorder.
+type eventRecorder struct {
+	recorder record.EventRecorder
+	redactor *Redactor
+}
+
+// NewEventRecorder returns a record.EventRecorder redacting the sensitive values of the given Redactor from the
+// messages of the events recorded with the given recorder. If the given recorder is nil, nil is returned.
+func NewEventRecorder(recorder record.EventRecorder, redactor *Redactor) record.EventRecorder {
+	if recorder == nil {
+		return nil
+	}
+
+	return &eventRecorder{recorder: recorder, redactor: redactor}
+}
+
+// Event implements record.EventRecorder.
+func (r *eventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.recorder.Event(object, eventtype, reason, r.redactor.Redact(message))
+}
+
+// Eventf implements record.EventRecorder.
+func (r *eventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.recorder.Event(object, eventtype, reason, r.redactor.Redact(fmt.Sprintf(messageFmt, args...)))
+}
+
+// AnnotatedEventf implements record.EventRecorder.
+func (r *eventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason,
+	messageFmt string, args ...interface{}) {
+	r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s",
+		r.redactor.Redact(fmt.Sprintf(messageFmt, args...)))
+}
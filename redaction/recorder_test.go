@@ -0,0 +1,41 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redaction
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("EventRecorder", func() {
+	It("should redact the messages of the events", func() {
+		fakeRecorder := record.NewFakeRecorder(2)
+		recorder := NewEventRecorder(fakeRecorder, NewRedactor("s3cr3t"))
+
+		recorder.Event(&corev1.Pod{}, corev1.EventTypeNormal, "Reason", "using s3cr3t")
+		recorder.Eventf(&corev1.Pod{}, corev1.EventTypeWarning, "Reason", "using %s", "s3cr3t")
+
+		Expect(<-fakeRecorder.Events).To(Equal("Normal Reason using " + Placeholder))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning Reason using " + Placeholder))
+	})
+
+	It("should return nil if the recorder is nil", func() {
+		Expect(NewEventRecorder(nil, NewRedactor("s3cr3t"))).To(BeNil())
+	})
+})
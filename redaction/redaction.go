@@ -0,0 +1,130 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redaction
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+const (
+	// Placeholder is the text sensitive values are replaced with
+	Placeholder = "[REDACTED]"
+
+	// minValueLength is the minimum length of the values redacted. Shorter values are ignored, as redacting them
+	// would mangle most of the text they appear in while barely protecting them
+	minValueLength = 4
+)
+
+// Redactor replaces the occurrences of a set of sensitive values in text, like the values of the Release data sourced
+// from Secrets or marked as sensitive.
+type Redactor struct {
+	values []string
+}
+
+// NewRedactor creates and returns a Redactor for the given sensitive values. Values are also redacted when they are
+// escaped as JSON strings, so they are not revealed by the JSON representation of the objects containing them.
+func NewRedactor(values ...string) *Redactor {
+	uniqueValues := map[string]bool{}
+	for _, value := range values {
+		if len(value) < minValueLength {
+			continue
+		}
+
+		uniqueValues[value] = true
+		if escapedValue, err := json.Marshal(value); err == nil {
+			uniqueValues[string(escapedValue[1:len(escapedValue)-1])] = true
+		}
+	}
+
+	redactor := &Redactor{}
+	for value := range uniqueValues {
+		redactor.values = append(redactor.values, value)
+	}
+
+	// Longer values are replaced first, so values containing others are fully redacted
+	sort.Slice(redactor.values, func(i, j int) bool {
+		if len(redactor.values[i]) != len(redactor.values[j]) {
+			return len(redactor.values[i]) > len(redactor.values[j])
+		}
+		return redactor.values[i] < redactor.values[j]
+	})
+
+	return redactor
+}
+
+// Redact returns the given text after replacing every occurrence of the sensitive values with the Placeholder. A nil
+// Redactor returns the text unchanged.
+func (r *Redactor) Redact(text string) string {
+	if r == nil {
+		return text
+	}
+
+	for _, value := range r.values {
+		text = strings.ReplaceAll(text, value, Placeholder)
+	}
+
+	return text
+}
+
+// redactValues returns the given log values after redacting the strings and errors among them.
+func (r *Redactor) redactValues(keysAndValues []interface{}) []interface{} {
+	if r == nil || len(r.values) == 0 {
+		return keysAndValues
+	}
+
+	redactedKeysAndValues := make([]interface{}, len(keysAndValues))
+	for i, value := range keysAndValues {
+		switch typedValue := value.(type) {
+		case string:
+			redactedKeysAndValues[i] = r.Redact(typedValue)
+		case error:
+			redactedKeysAndValues[i] = r.redactError(typedValue)
+		default:
+			redactedKeysAndValues[i] = value
+		}
+	}
+
+	return redactedKeysAndValues
+}
+
+// redactError returns an error whose message is the redacted message of the given error. If the message doesn't
+// contain any sensitive value, the given error is returned.
+func (r *Redactor) redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	message := err.Error()
+	redactedMessage := r.Redact(message)
+	if redactedMessage == message {
+		return err
+	}
+
+	return &redactedError{message: redactedMessage}
+}
+
+// redactedError is an error whose message was redacted.
+type redactedError struct {
+	message string
+}
+
+// Error implements error.
+func (e *redactedError) Error() string {
+	return e.message
+}
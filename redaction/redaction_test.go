@@ -0,0 +1,74 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redaction
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Redactor", func() {
+	When("Redact is called", func() {
+		It("should replace every occurrence of the sensitive values", func() {
+			redactor := NewRedactor("s3cr3t", "t0k3n")
+			Expect(redactor.Redact("password=s3cr3t token=t0k3n again=s3cr3t")).To(Equal(
+				fmt.Sprintf("password=%s token=%s again=%s", Placeholder, Placeholder, Placeholder)))
+		})
+
+		It("should fully redact values containing other values", func() {
+			redactor := NewRedactor("s3cr3t", "my-s3cr3t-value")
+			Expect(redactor.Redact("my-s3cr3t-value")).To(Equal(Placeholder))
+		})
+
+		It("should ignore values too short to be redacted", func() {
+			redactor := NewRedactor("abc", "")
+			Expect(redactor.Redact("abc")).To(Equal("abc"))
+		})
+
+		It("should redact the values escaped in JSON", func() {
+			redactor := NewRedactor(`say "hi"`)
+			data, err := json.Marshal(map[string]string{"greeting": `say "hi"`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(redactor.Redact(string(data))).To(Equal(fmt.Sprintf(`{"greeting":"%s"}`, Placeholder)))
+		})
+
+		It("should return the text unchanged if the redactor is nil", func() {
+			var redactor *Redactor
+			Expect(redactor.Redact("s3cr3t")).To(Equal("s3cr3t"))
+		})
+	})
+
+	When("redactValues is called", func() {
+		It("should redact the strings and errors", func() {
+			redactor := NewRedactor("s3cr3t")
+			values := redactor.redactValues([]interface{}{"key", "s3cr3t", "error", fmt.Errorf("bad s3cr3t"), "count", 1})
+			Expect(values[1]).To(Equal(Placeholder))
+			Expect(values[3].(error).Error()).To(Equal("bad " + Placeholder))
+			Expect(values[5]).To(Equal(1))
+		})
+	})
+
+	When("redactError is called", func() {
+		It("should return the same error if it contains no sensitive value", func() {
+			err := fmt.Errorf("failure")
+			Expect(NewRedactor("s3cr3t").redactError(err)).To(BeIdenticalTo(err))
+		})
+	})
+})
@@ -0,0 +1,232 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package releaseconditions provides helpers to read the conditions the release-service sets in the status of Releases
+// and ReleasePlans. The helpers only take the conditions, so integration-service, UI backends and CLIs can use them
+// with typed objects, unstructured objects or their own copies of the API types without parsing the conditions on
+// their own.
+package releaseconditions
+
+import (
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ReleasedConditionType is the type of the condition tracking the overall status of a Release
+	ReleasedConditionType = "Released"
+
+	// ValidatedConditionType is the type of the condition tracking the validation of a Release
+	ValidatedConditionType = "Validated"
+
+	// SnapshotTestedConditionType is the type of the condition tracking whether the integration tests of the Snapshot
+	// of a Release passed
+	SnapshotTestedConditionType = "SnapshotTested"
+
+	// TenantPipelineProcessedConditionType is the type of the condition tracking the tenant Pipeline of a Release
+	TenantPipelineProcessedConditionType = "TenantPipelineProcessed"
+
+	// ManagedPipelineProcessedConditionType is the type of the condition tracking the managed Pipeline of a Release
+	ManagedPipelineProcessedConditionType = "ManagedPipelineProcessed"
+
+	// VerifiedConditionType is the type of the condition tracking the verification of the artifacts of a Release
+	VerifiedConditionType = "Verified"
+
+	// PostActionsExecutedConditionType is the type of the condition tracking the post-actions of a Release
+	PostActionsExecutedConditionType = "PostActionsExecuted"
+)
+
+const (
+	// progressingReason is the reason of the conditions of the Release steps in progress
+	progressingReason = "Progressing"
+
+	// supersededReason is the reason of the Released condition of the Releases skipped in favor of a newer Release
+	supersededReason = "Superseded"
+)
+
+// Step is one of the steps a Release goes through.
+type Step string
+
+const (
+	// ValidationStep is the step validating the Release and the resources it references
+	ValidationStep Step = "Validation"
+
+	// TenantPipelineStep is the step running the tenant Pipeline in the tenant namespace
+	TenantPipelineStep Step = "TenantPipeline"
+
+	// ManagedPipelineStep is the step running the managed Pipeline in the managed namespace
+	ManagedPipelineStep Step = "ManagedPipeline"
+
+	// VerificationStep is the step verifying the released artifacts
+	VerificationStep Step = "Verification"
+)
+
+// steps contains the steps of a Release in the order they run, mapped to the type of the condition tracking them.
+var steps = []struct {
+	step          Step
+	conditionType string
+}{
+	{ValidationStep, ValidatedConditionType},
+	{TenantPipelineStep, TenantPipelineProcessedConditionType},
+	{ManagedPipelineStep, ManagedPipelineProcessedConditionType},
+	{VerificationStep, VerifiedConditionType},
+}
+
+// failedStepConditionTypes contains the types of the conditions checked for failures, in the order the steps they
+// track run.
+var failedStepConditionTypes = []string{
+	ValidatedConditionType,
+	SnapshotTestedConditionType,
+	TenantPipelineProcessedConditionType,
+	ManagedPipelineProcessedConditionType,
+	VerifiedConditionType,
+	PostActionsExecutedConditionType,
+}
+
+// Progress describes how far a Release went through its steps.
+type Progress struct {
+	// Completed is the number of steps that finished successfully or were skipped
+	Completed int
+
+	// Current is the step being run, empty if the Release didn't start or finished
+	Current Step
+
+	// Failed is the step that failed, empty if no step failed
+	Failed Step
+
+	// Total is the number of steps
+	Total int
+}
+
+// IsReleasing checks whether the Release with the given conditions is in progress.
+func IsReleasing(conditions []metav1.Condition) bool {
+	return isProgressing(conditions, ReleasedConditionType)
+}
+
+// IsFinished checks whether the Release with the given conditions finished, regardless of the result.
+func IsFinished(conditions []metav1.Condition) bool {
+	return hasFinished(conditions, ReleasedConditionType)
+}
+
+// IsReleased checks whether the Release with the given conditions succeeded.
+func IsReleased(conditions []metav1.Condition) bool {
+	return meta.IsStatusConditionTrue(conditions, ReleasedConditionType)
+}
+
+// IsSuperseded checks whether the Release with the given conditions was skipped in favor of a newer Release of the
+// same components.
+func IsSuperseded(conditions []metav1.Condition) bool {
+	condition := meta.FindStatusCondition(conditions, ReleasedConditionType)
+
+	return condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == supersededReason
+}
+
+// IsFailed checks whether the Release with the given conditions failed. Superseded Releases are not considered failed.
+func IsFailed(conditions []metav1.Condition) bool {
+	return IsFinished(conditions) && !IsReleased(conditions) && !IsSuperseded(conditions)
+}
+
+// GetFailureReason returns the reason why the Release with the given conditions failed, or an empty string if it
+// didn't fail. The reason of the first failed step is returned when it's one of the stable reasons of the reasons
+// package, falling back to the reason of the Released condition otherwise. Consumers should treat reasons not found in
+// the reasons package as reasons.Failed.
+func GetFailureReason(conditions []metav1.Condition) string {
+	if !IsFailed(conditions) {
+		return ""
+	}
+
+	if condition := getFailedStepCondition(conditions); condition != nil {
+		return condition.Reason
+	}
+
+	return meta.FindStatusCondition(conditions, ReleasedConditionType).Reason
+}
+
+// GetFailureMessage returns the message describing why the Release with the given conditions failed, or an empty
+// string if it didn't fail. The message of the first failed step is preferred over the message of the Released
+// condition.
+func GetFailureMessage(conditions []metav1.Condition) string {
+	if !IsFailed(conditions) {
+		return ""
+	}
+
+	if condition := getFailedStepCondition(conditions); condition != nil && condition.Message != "" {
+		return condition.Message
+	}
+
+	return meta.FindStatusCondition(conditions, ReleasedConditionType).Message
+}
+
+// GetProgress returns how far the Release with the given conditions went through its steps. Steps are completed once
+// their condition is true, which includes the skipped ones, and the current step is the first one not completed while
+// the Release is in progress.
+func GetProgress(conditions []metav1.Condition) Progress {
+	progress := Progress{Total: len(steps)}
+
+	for _, step := range steps {
+		condition := meta.FindStatusCondition(conditions, step.conditionType)
+		switch {
+		case condition != nil && condition.Status == metav1.ConditionTrue:
+			progress.Completed++
+		case hasFinished(conditions, step.conditionType):
+			if progress.Failed == "" {
+				progress.Failed = step.step
+			}
+		case progress.Current == "" && IsReleasing(conditions):
+			progress.Current = step.step
+		}
+	}
+
+	return progress
+}
+
+// getFailedStepCondition returns the condition of the first failed Release step whose reason is one of the stable
+// reasons of the reasons package, or nil if there is none.
+func getFailedStepCondition(conditions []metav1.Condition) *metav1.Condition {
+	for _, conditionType := range failedStepConditionTypes {
+		condition := meta.FindStatusCondition(conditions, conditionType)
+		if condition == nil || condition.Status != metav1.ConditionFalse {
+			continue
+		}
+		if _, found := reasons.Lookup(condition.Reason); found {
+			return condition
+		}
+	}
+
+	return nil
+}
+
+// hasFinished checks whether the condition with the given type reports a finished step, regardless of the result.
+func hasFinished(conditions []metav1.Condition, conditionType string) bool {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+
+	switch {
+	case condition == nil:
+		return false
+	case condition.Status == metav1.ConditionTrue:
+		return true
+	default:
+		return condition.Status == metav1.ConditionFalse && condition.Reason != progressingReason
+	}
+}
+
+// isProgressing checks whether the condition with the given type reports a step in progress.
+func isProgressing(conditions []metav1.Condition, conditionType string) bool {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+
+	return condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == progressingReason
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releaseconditions
+
+import (
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Release conditions", func() {
+	var release *v1alpha1.Release
+
+	BeforeEach(func() {
+		release = &v1alpha1.Release{}
+	})
+
+	When("the Release didn't start", func() {
+		It("should report it as neither releasing nor finished", func() {
+			Expect(IsReleasing(release.Status.Conditions)).To(BeFalse())
+			Expect(IsFinished(release.Status.Conditions)).To(BeFalse())
+			Expect(IsFailed(release.Status.Conditions)).To(BeFalse())
+			Expect(GetProgress(release.Status.Conditions)).To(Equal(Progress{Total: 4}))
+		})
+	})
+
+	When("the Release is in progress", func() {
+		BeforeEach(func() {
+			release.MarkReleasing("")
+			release.MarkValidated()
+			release.MarkTenantPipelineProcessingSkipped()
+			release.MarkManagedPipelineProcessing()
+		})
+
+		It("should report it as releasing", func() {
+			Expect(IsReleasing(release.Status.Conditions)).To(Equal(release.IsReleasing()))
+			Expect(IsReleasing(release.Status.Conditions)).To(BeTrue())
+			Expect(IsFinished(release.Status.Conditions)).To(BeFalse())
+		})
+
+		It("should report the completed steps and the step being run", func() {
+			Expect(GetProgress(release.Status.Conditions)).To(Equal(Progress{
+				Completed: 2,
+				Current:   ManagedPipelineStep,
+				Total:     4,
+			}))
+		})
+
+		It("should not report a failure reason", func() {
+			Expect(GetFailureReason(release.Status.Conditions)).To(BeEmpty())
+			Expect(GetFailureMessage(release.Status.Conditions)).To(BeEmpty())
+		})
+	})
+
+	When("the Release succeeded", func() {
+		BeforeEach(func() {
+			release.MarkReleasing("")
+			release.MarkValidated()
+			release.MarkTenantPipelineProcessingSkipped()
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessed()
+			release.MarkVerificationSkipped()
+			release.MarkReleased()
+		})
+
+		It("should report it as released", func() {
+			Expect(IsReleased(release.Status.Conditions)).To(BeTrue())
+			Expect(IsFinished(release.Status.Conditions)).To(BeTrue())
+			Expect(IsFailed(release.Status.Conditions)).To(BeFalse())
+		})
+
+		It("should report every step as completed", func() {
+			Expect(GetProgress(release.Status.Conditions)).To(Equal(Progress{Completed: 4, Total: 4}))
+		})
+	})
+
+	When("the Release failed", func() {
+		BeforeEach(func() {
+			release.MarkReleasing("")
+			release.MarkValidated()
+			release.MarkTenantPipelineProcessingSkipped()
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailedWithReason(reasons.Timeout, "the PipelineRun timed out")
+			release.MarkReleaseFailed("Release processing failed on managed Pipeline")
+		})
+
+		It("should report it as failed", func() {
+			Expect(IsFailed(release.Status.Conditions)).To(Equal(release.IsFailed()))
+			Expect(IsFailed(release.Status.Conditions)).To(BeTrue())
+			Expect(IsReleased(release.Status.Conditions)).To(BeFalse())
+		})
+
+		It("should report the reason and message of the failed step", func() {
+			Expect(GetFailureReason(release.Status.Conditions)).To(Equal(reasons.Timeout.String()))
+			Expect(GetFailureMessage(release.Status.Conditions)).To(Equal("the PipelineRun timed out"))
+		})
+
+		It("should report the failed step", func() {
+			Expect(GetProgress(release.Status.Conditions)).To(Equal(Progress{
+				Completed: 2,
+				Failed:    ManagedPipelineStep,
+				Total:     4,
+			}))
+		})
+	})
+
+	When("the Release failed without a failed step", func() {
+		It("should report the reason and message of the Released condition", func() {
+			conditions := []metav1.Condition{
+				{Type: ReleasedConditionType, Status: metav1.ConditionFalse, Reason: "Failed", Message: "expired"},
+			}
+			Expect(GetFailureReason(conditions)).To(Equal("Failed"))
+			Expect(GetFailureMessage(conditions)).To(Equal("expired"))
+		})
+	})
+
+	When("the Release was superseded", func() {
+		BeforeEach(func() {
+			release.MarkReleasing("")
+			release.MarkSuperseded("superseded by a newer Release")
+		})
+
+		It("should report it as superseded but not as failed", func() {
+			Expect(IsSuperseded(release.Status.Conditions)).To(BeTrue())
+			Expect(IsFinished(release.Status.Conditions)).To(BeTrue())
+			Expect(IsFailed(release.Status.Conditions)).To(BeFalse())
+			Expect(GetFailureReason(release.Status.Conditions)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,63 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releaseconditions
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MatchedConditionType is the type of the condition tracking whether a ReleasePlan matches a ReleasePlanAdmission
+	MatchedConditionType = "Matched"
+
+	// DeletionBlockedConditionType is the type of the condition tracking whether the deletion of a ReleasePlan waits
+	// for its Releases to finish
+	DeletionBlockedConditionType = "DeletionBlocked"
+
+	// TransferredConditionType is the type of the condition tracking the transfer of a ReleasePlan to another
+	// application
+	TransferredConditionType = "Transferred"
+)
+
+// transferPendingReason is the reason of the Transferred condition of the ReleasePlans waiting for their transfer to be
+// accepted
+const transferPendingReason = "TransferPending"
+
+// IsMatched checks whether the ReleasePlan with the given conditions matches a ReleasePlanAdmission.
+func IsMatched(conditions []metav1.Condition) bool {
+	return meta.IsStatusConditionTrue(conditions, MatchedConditionType)
+}
+
+// IsDeletionBlocked checks whether the deletion of the ReleasePlan with the given conditions waits for its running
+// Releases to finish.
+func IsDeletionBlocked(conditions []metav1.Condition) bool {
+	return meta.IsStatusConditionTrue(conditions, DeletionBlockedConditionType)
+}
+
+// IsTransferPending checks whether the ReleasePlan with the given conditions waits for its transfer to another
+// application to be accepted.
+func IsTransferPending(conditions []metav1.Condition) bool {
+	condition := meta.FindStatusCondition(conditions, TransferredConditionType)
+
+	return condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == transferPendingReason
+}
+
+// IsTransferred checks whether the ReleasePlan with the given conditions was transferred to another application.
+func IsTransferred(conditions []metav1.Condition) bool {
+	return meta.IsStatusConditionTrue(conditions, TransferredConditionType)
+}
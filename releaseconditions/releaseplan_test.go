@@ -0,0 +1,65 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releaseconditions
+
+import (
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReleasePlan conditions", func() {
+	var releasePlan *v1alpha1.ReleasePlan
+
+	BeforeEach(func() {
+		releasePlan = &v1alpha1.ReleasePlan{}
+	})
+
+	It("should report nothing for a ReleasePlan without conditions", func() {
+		Expect(IsMatched(releasePlan.Status.Conditions)).To(BeFalse())
+		Expect(IsDeletionBlocked(releasePlan.Status.Conditions)).To(BeFalse())
+		Expect(IsTransferPending(releasePlan.Status.Conditions)).To(BeFalse())
+		Expect(IsTransferred(releasePlan.Status.Conditions)).To(BeFalse())
+	})
+
+	It("should report matched ReleasePlans", func() {
+		releasePlan.MarkMatched(&v1alpha1.ReleasePlanAdmission{
+			ObjectMeta: metav1.ObjectMeta{Name: "rpa", Namespace: "managed"},
+		})
+		Expect(IsMatched(releasePlan.Status.Conditions)).To(BeTrue())
+
+		releasePlan.MarkUnmatched()
+		Expect(IsMatched(releasePlan.Status.Conditions)).To(BeFalse())
+	})
+
+	It("should report ReleasePlans whose deletion is blocked", func() {
+		releasePlan.MarkDeletionBlocked([]string{"release"})
+		Expect(IsDeletionBlocked(releasePlan.Status.Conditions)).To(BeTrue())
+	})
+
+	It("should report pending and completed transfers", func() {
+		releasePlan.MarkTransferPending("application")
+		Expect(IsTransferPending(releasePlan.Status.Conditions)).To(BeTrue())
+		Expect(IsTransferred(releasePlan.Status.Conditions)).To(BeFalse())
+
+		releasePlan.MarkTransferred("old-application", "application")
+		Expect(IsTransferPending(releasePlan.Status.Conditions)).To(BeFalse())
+		Expect(IsTransferred(releasePlan.Status.Conditions)).To(BeTrue())
+	})
+})
@@ -0,0 +1,142 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// githubPublisher is a Publisher committing release notes through the contents API of GitHub or GitHub Enterprise.
+type githubPublisher struct {
+	apiURL     string
+	branch     string
+	httpClient *http.Client
+	token      string
+}
+
+// githubContentRequest is the body of the requests creating files through the contents API of GitHub.
+type githubContentRequest struct {
+	Branch  string `json:"branch,omitempty"`
+	Content string `json:"content"`
+	Message string `json:"message"`
+}
+
+// NewGitHubPublisher creates and returns a Publisher committing to the branch of the GitHub repository with the given
+// URL. Repositories outside github.com are assumed to be hosted in GitHub Enterprise, which serves its API under the
+// /api/v3 path.
+func NewGitHubPublisher(repositoryURL, branch, token string, httpClient *http.Client) (Publisher, error) {
+	parsedURL, err := url.Parse(repositoryURL)
+	if err != nil {
+		return nil, err
+	}
+	if parsedURL.Scheme == "" || parsedURL.Host == "" {
+		return nil, fmt.Errorf("invalid release notes repository URL %s", repositoryURL)
+	}
+
+	repository := strings.TrimSuffix(strings.Trim(parsedURL.Path, "/"), ".git")
+	if strings.Count(repository, "/") != 1 {
+		return nil, fmt.Errorf("release notes repository URL %s doesn't point to a repository", repositoryURL)
+	}
+
+	apiURL := fmt.Sprintf("%s://%s/api/v3", parsedURL.Scheme, parsedURL.Host)
+	if parsedURL.Host == "github.com" {
+		apiURL = "https://api.github.com"
+	}
+
+	return &githubPublisher{
+		apiURL:     fmt.Sprintf("%s/repos/%s/contents/", apiURL, repository),
+		branch:     branch,
+		httpClient: httpClient,
+		token:      token,
+	}, nil
+}
+
+// Publish commits the given content to the file in the given path unless it already exists in the branch.
+func (p *githubPublisher) Publish(ctx context.Context, path string, content []byte, message string) error {
+	exists, err := p.exists(ctx, path)
+	if err != nil || exists {
+		return err
+	}
+
+	body, err := json.Marshal(&githubContentRequest{
+		Branch:  p.branch,
+		Content: base64.StdEncoding.EncodeToString(content),
+		Message: message,
+	})
+	if err != nil {
+		return err
+	}
+
+	response, err := p.do(ctx, http.MethodPut, p.apiURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("failed to commit release notes %s: %s: %s", path, response.Status, responseBody)
+	}
+
+	return nil
+}
+
+// do sends an authenticated request to the GitHub API and returns its response.
+func (p *githubPublisher) do(ctx context.Context, method, url string, body io.Reader) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/vnd.github+json")
+	request.Header.Set("Authorization", "Bearer "+p.token)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	return p.httpClient.Do(request)
+}
+
+// exists returns whether the file in the given path exists in the branch of the repository.
+func (p *githubPublisher) exists(ctx context.Context, path string) (bool, error) {
+	fileURL := p.apiURL + path
+	if p.branch != "" {
+		fileURL += "?ref=" + url.QueryEscape(p.branch)
+	}
+
+	response, err := p.do(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check the release notes %s: %s", path, response.Status)
+	}
+}
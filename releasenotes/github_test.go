@@ -0,0 +1,129 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GitHub publisher", func() {
+	When("NewGitHubPublisher is called", func() {
+		It("should use the public API for repositories in github.com", func() {
+			publisher, err := NewGitHubPublisher("https://github.com/foo/bar.git", "main", "token", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher.(*githubPublisher).apiURL).To(Equal("https://api.github.com/repos/foo/bar/contents/"))
+		})
+
+		It("should use the GitHub Enterprise API for repositories in other hosts", func() {
+			publisher, err := NewGitHubPublisher("https://git.example.com/foo/bar/", "main", "token", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher.(*githubPublisher).apiURL).To(Equal("https://git.example.com/api/v3/repos/foo/bar/contents/"))
+		})
+
+		It("should fail if the URL doesn't point to a repository", func() {
+			_, err := NewGitHubPublisher("https://github.com/foo", "main", "token", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't point to a repository"))
+		})
+	})
+
+	When("Publish is called", func() {
+		It("should commit the file if it doesn't exist", func() {
+			request := &githubContentRequest{}
+			var methods []string
+			var path, ref, authorization string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				authorization = r.Header.Get("Authorization")
+				if r.Method == http.MethodGet {
+					ref = r.URL.Query().Get("ref")
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				path = r.URL.Path
+				Expect(json.NewDecoder(r.Body).Decode(request)).To(Succeed())
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			publisher, err := NewGitHubPublisher(server.URL+"/foo/bar", "main", "token", server.Client())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(publisher.Publish(context.TODO(), "releases/app/notes.md", []byte("notes"), "Add notes")).To(Succeed())
+			Expect(methods).To(Equal([]string{http.MethodGet, http.MethodPut}))
+			Expect(path).To(Equal("/api/v3/repos/foo/bar/contents/releases/app/notes.md"))
+			Expect(ref).To(Equal("main"))
+			Expect(authorization).To(Equal("Bearer token"))
+			Expect(request.Branch).To(Equal("main"))
+			Expect(request.Message).To(Equal("Add notes"))
+			Expect(request.Content).To(Equal(base64.StdEncoding.EncodeToString([]byte("notes"))))
+		})
+
+		It("should not commit the file if it already exists", func() {
+			var methods []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+			}))
+			defer server.Close()
+
+			publisher, err := NewGitHubPublisher(server.URL+"/foo/bar", "main", "token", server.Client())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(publisher.Publish(context.TODO(), "notes.md", []byte("notes"), "Add notes")).To(Succeed())
+			Expect(methods).To(Equal([]string{http.MethodGet}))
+		})
+
+		It("should fail if the file can't be checked", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			}))
+			defer server.Close()
+
+			publisher, err := NewGitHubPublisher(server.URL+"/foo/bar", "main", "token", server.Client())
+			Expect(err).NotTo(HaveOccurred())
+
+			err = publisher.Publish(context.TODO(), "notes.md", []byte("notes"), "Add notes")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("401"))
+		})
+
+		It("should fail if the file can't be committed", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusUnprocessableEntity)
+			}))
+			defer server.Close()
+
+			publisher, err := NewGitHubPublisher(server.URL+"/foo/bar", "main", "token", server.Client())
+			Expect(err).NotTo(HaveOccurred())
+
+			err = publisher.Publish(context.TODO(), "notes.md", []byte("notes"), "Add notes")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to commit release notes"))
+		})
+	})
+})
@@ -0,0 +1,90 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+// NewReleaseNotes returns the release notes of the given Release in Markdown. They describe the Release and list the
+// components of the released Snapshot, followed by the artifacts reported by the managed Pipeline, if any.
+func NewReleaseNotes(release *v1alpha1.Release, snapshot *applicationapiv1alpha1.Snapshot) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	fmt.Fprintf(&buffer, "# %s %s\n\n", snapshot.Spec.Application, release.Name)
+	fmt.Fprintf(&buffer, "- Release: %s/%s\n", release.Namespace, release.Name)
+	fmt.Fprintf(&buffer, "- Snapshot: %s\n", release.Spec.Snapshot)
+	fmt.Fprintf(&buffer, "- Target: %s\n", release.Status.Target)
+	if release.Status.CompletionTime != nil {
+		fmt.Fprintf(&buffer, "- Completed: %s\n", release.Status.CompletionTime.UTC().Format(time.RFC3339))
+	}
+
+	if len(snapshot.Spec.Components) > 0 {
+		buffer.WriteString("\n## Components\n\n| Name | Image | Source |\n| --- | --- | --- |\n")
+		for _, component := range snapshot.Spec.Components {
+			fmt.Fprintf(&buffer, "| %s | %s | %s |\n", component.Name, component.ContainerImage, getSource(component))
+		}
+	}
+
+	if release.Status.Artifacts != nil && len(release.Status.Artifacts.Raw) > 0 {
+		var artifacts bytes.Buffer
+		err := json.Indent(&artifacts, release.Status.Artifacts.Raw, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.WriteString("\n## Artifacts\n\n```json\n")
+		buffer.Write(artifacts.Bytes())
+		buffer.WriteString("\n```\n")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// GetPath returns the path of the release notes of the given Release for the given application inside the given
+// directory. Files are prefixed with the completion date of the Release, so they are listed in release order.
+func GetPath(directory, application string, release *v1alpha1.Release) string {
+	completionTime := release.CreationTimestamp.Time
+	if release.Status.CompletionTime != nil {
+		completionTime = release.Status.CompletionTime.Time
+	}
+
+	return path.Join(directory, application,
+		fmt.Sprintf("%s-%s.md", completionTime.UTC().Format("2006-01-02"), release.Name))
+}
+
+// getSource returns the Git URL and revision the given component was built from, or an empty string if the component
+// has no Git source.
+func getSource(component applicationapiv1alpha1.SnapshotComponent) string {
+	gitSource := component.Source.GitSource
+	if gitSource == nil {
+		return ""
+	}
+
+	if gitSource.Revision == "" {
+		return gitSource.URL
+	}
+
+	return fmt.Sprintf("%s@%s", gitSource.URL, gitSource.Revision)
+}
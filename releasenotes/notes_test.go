@@ -0,0 +1,114 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("Release notes", func() {
+	var (
+		release  *v1alpha1.Release
+		snapshot *applicationapiv1alpha1.Snapshot
+	)
+
+	BeforeEach(func() {
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "release",
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: "releaseplan",
+				Snapshot:    "snapshot",
+			},
+			Status: v1alpha1.ReleaseStatus{
+				CompletionTime: &metav1.Time{Time: time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)},
+				Target:         "managed",
+			},
+		}
+		snapshot = &applicationapiv1alpha1.Snapshot{
+			Spec: applicationapiv1alpha1.SnapshotSpec{
+				Application: "application",
+				Components: []applicationapiv1alpha1.SnapshotComponent{
+					{
+						Name:           "component",
+						ContainerImage: "quay.io/foo/bar@sha256:0123",
+						Source: applicationapiv1alpha1.ComponentSource{
+							ComponentSourceUnion: applicationapiv1alpha1.ComponentSourceUnion{
+								GitSource: &applicationapiv1alpha1.GitSource{
+									URL:      "https://github.com/foo/bar",
+									Revision: "abc",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	When("NewReleaseNotes is called", func() {
+		It("should describe the Release and list its components", func() {
+			notes, err := NewReleaseNotes(release, snapshot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(notes)).To(HavePrefix("# application release\n"))
+			Expect(string(notes)).To(ContainSubstring("- Release: default/release\n"))
+			Expect(string(notes)).To(ContainSubstring("- Snapshot: snapshot\n"))
+			Expect(string(notes)).To(ContainSubstring("- Target: managed\n"))
+			Expect(string(notes)).To(ContainSubstring("- Completed: 2024-05-02T10:00:00Z\n"))
+			Expect(string(notes)).To(ContainSubstring(
+				"| component | quay.io/foo/bar@sha256:0123 | https://github.com/foo/bar@abc |\n"))
+			Expect(string(notes)).NotTo(ContainSubstring("## Artifacts"))
+		})
+
+		It("should include the artifacts reported by the managed Pipeline", func() {
+			release.Status.Artifacts = &runtime.RawExtension{Raw: []byte(`{"advisory":{"url":"https://advisory"}}`)}
+
+			notes, err := NewReleaseNotes(release, snapshot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(notes)).To(ContainSubstring("## Artifacts"))
+			Expect(string(notes)).To(ContainSubstring(`"url": "https://advisory"`))
+		})
+
+		It("should fail if the artifacts are not valid JSON", func() {
+			release.Status.Artifacts = &runtime.RawExtension{Raw: []byte(`{`)}
+
+			_, err := NewReleaseNotes(release, snapshot)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("GetPath is called", func() {
+		It("should return a path prefixed with the completion date of the Release", func() {
+			Expect(GetPath("releases", "application", release)).To(Equal("releases/application/2024-05-02-release.md"))
+		})
+
+		It("should fall back to the creation date if the Release has no completion time", func() {
+			release.Status.CompletionTime = nil
+			Expect(GetPath("releases", "application", release)).To(Equal("releases/application/2024-05-01-release.md"))
+		})
+	})
+})
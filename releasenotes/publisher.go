@@ -0,0 +1,39 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+)
+
+// TokenSecretKey is the key of the release notes repository Secret holding the token used to commit to it.
+const TokenSecretKey = "token"
+
+// Publisher commits release notes to a Git repository.
+type Publisher interface {
+	// Publish commits the given content to the file in the given path with the given commit message. Files that
+	// already exist are left untouched, so publishing the same release notes more than once is safe.
+	Publish(ctx context.Context, path string, content []byte, message string) error
+}
+
+// NewPublisher creates and returns a Publisher committing to the given repository with the given token.
+func NewPublisher(repository *v1alpha1.ReleaseNotesRepository, token string, httpClient *http.Client) (Publisher, error) {
+	return NewGitHubPublisher(repository.URL, repository.Branch, token, httpClient)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasenotes
+
+import (
+	"net/http"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Publisher", func() {
+	When("NewPublisher is called", func() {
+		It("should return a GitHub publisher for the repository", func() {
+			publisher, err := NewPublisher(&v1alpha1.ReleaseNotesRepository{
+				Branch: "main",
+				URL:    "https://github.com/foo/bar",
+			}, "token", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publisher).To(BeAssignableToTypeOf(&githubPublisher{}))
+		})
+
+		It("should fail if the repository URL is invalid", func() {
+			_, err := NewPublisher(&v1alpha1.ReleaseNotesRepository{URL: "github.com"}, "token", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,158 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// keySigner signs payloads with a private key held in memory. The signatures match the ones produced by
+// cosign sign-blob, so they can be verified with cosign verify-blob.
+type keySigner struct {
+	key crypto.Signer
+}
+
+// NewKeySigner creates and returns a Signer using the given private key. Only ECDSA, RSA and Ed25519 keys are
+// supported.
+func NewKeySigner(key crypto.PrivateKey) (Signer, error) {
+	switch key := key.(type) {
+	case *ecdsa.PrivateKey, *rsa.PrivateKey, ed25519.PrivateKey:
+		return &keySigner{key: key.(crypto.Signer)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// LoadKeySigner creates and returns a Signer using the private key stored in the file at the given path. The file is
+// expected to contain an unencrypted PEM private key in the PKCS#8, SEC 1 or PKCS#1 formats. Keys encrypted by cosign
+// generate-key-pair are not supported and have to be exported without a password first.
+func LoadKeySigner(path string) (Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+
+	var key crypto.PrivateKey
+	switch {
+	case block.Type == "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case block.Type == "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case block.Type == "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case strings.HasPrefix(block.Type, "ENCRYPTED"):
+		return nil, fmt.Errorf("the private key in %s is encrypted", path)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type '%s' in %s", block.Type, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeySigner(key)
+}
+
+// Sign signs the SHA-256 digest of the given payload, except for Ed25519 keys which sign the payload itself.
+func (s *keySigner) Sign(_ context.Context, payload []byte) ([]byte, error) {
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		return s.key.Sign(rand.Reader, payload, crypto.Hash(0))
+	}
+
+	digest := sha256.Sum256(payload)
+
+	return s.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+// keyVerifier verifies signatures with a public key held in memory. It accepts the signatures produced by keySigner and
+// cosign sign-blob.
+type keyVerifier struct {
+	key crypto.PublicKey
+}
+
+// NewKeyVerifier creates and returns a Verifier using the given public key. Only ECDSA, RSA and Ed25519 keys are
+// supported.
+func NewKeyVerifier(key crypto.PublicKey) (Verifier, error) {
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return &keyVerifier{key: key}, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// LoadKeyVerifier creates and returns a Verifier using the public key stored in the file at the given path. The file is
+// expected to contain a PEM public key in the PKIX format, like the ones generated by cosign generate-key-pair.
+func LoadKeyVerifier(path string) (Verifier, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unsupported PEM block type '%s' in %s", block.Type, path)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeyVerifier(key)
+}
+
+// Verify verifies the signature of the SHA-256 digest of the given payload, except for Ed25519 keys which verify the
+// signature of the payload itself. An error is returned if the signature is invalid.
+func (v *keyVerifier) Verify(payload, signature []byte) error {
+	digest := sha256.Sum256(payload)
+
+	var valid bool
+	switch key := v.key.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest[:], signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, payload, signature)
+	}
+
+	if !valid {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
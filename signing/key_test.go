@@ -0,0 +1,181 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Key signer", func() {
+	var (
+		payload      = []byte(`{"name":"release"}`)
+		writeKeyFile func(blockType string, data []byte) string
+	)
+
+	It("should sign the payload digest with ECDSA keys", func() {
+		key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		signer, err := NewKeySigner(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		signature, err := signer.Sign(context.TODO(), payload)
+		Expect(err).NotTo(HaveOccurred())
+		digest := sha256.Sum256(payload)
+		Expect(ecdsa.VerifyASN1(&key.PublicKey, digest[:], signature)).To(BeTrue())
+	})
+
+	It("should sign the payload digest with RSA keys", func() {
+		key, _ := rsa.GenerateKey(rand.Reader, 2048)
+		signer, err := NewKeySigner(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		signature, err := signer.Sign(context.TODO(), payload)
+		Expect(err).NotTo(HaveOccurred())
+		digest := sha256.Sum256(payload)
+		Expect(rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature)).To(Succeed())
+	})
+
+	It("should sign the payload itself with Ed25519 keys", func() {
+		publicKey, key, _ := ed25519.GenerateKey(rand.Reader)
+		signer, err := NewKeySigner(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		signature, err := signer.Sign(context.TODO(), payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ed25519.Verify(publicKey, payload, signature)).To(BeTrue())
+	})
+
+	It("should fail for unsupported keys", func() {
+		_, err := NewKeySigner("key")
+		Expect(err).To(HaveOccurred())
+	})
+
+	When("LoadKeySigner is called", func() {
+		It("should load PKCS#8 keys", func() {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			data, _ := x509.MarshalPKCS8PrivateKey(key)
+
+			signer, err := LoadKeySigner(writeKeyFile("PRIVATE KEY", data))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.(*keySigner).key).To(Equal(key))
+		})
+
+		It("should load SEC 1 keys", func() {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			data, _ := x509.MarshalECPrivateKey(key)
+
+			_, err := LoadKeySigner(writeKeyFile("EC PRIVATE KEY", data))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail for encrypted keys", func() {
+			_, err := LoadKeySigner(writeKeyFile("ENCRYPTED SIGSTORE PRIVATE KEY", []byte("data")))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is encrypted"))
+		})
+
+		It("should fail for files without PEM data", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "cosign.key")
+			Expect(os.WriteFile(path, []byte("key"), 0600)).To(Succeed())
+
+			_, err := LoadKeySigner(path)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no PEM data"))
+		})
+	})
+
+	writeKeyFile = func(blockType string, data []byte) string {
+		path := filepath.Join(GinkgoT().TempDir(), "cosign.key")
+		Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0600)).To(Succeed())
+
+		return path
+	}
+})
+
+var _ = Describe("Key verifier", func() {
+	var payload = []byte(`{"name":"release"}`)
+
+	It("should verify the signatures of the key signer", func() {
+		for _, generateKey := range []func() crypto.Signer{
+			func() crypto.Signer {
+				key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				return key
+			},
+			func() crypto.Signer {
+				key, _ := rsa.GenerateKey(rand.Reader, 2048)
+				return key
+			},
+			func() crypto.Signer {
+				_, key, _ := ed25519.GenerateKey(rand.Reader)
+				return key
+			},
+		} {
+			key := generateKey()
+			signer, err := NewKeySigner(key)
+			Expect(err).NotTo(HaveOccurred())
+			verifier, err := NewKeyVerifier(key.Public())
+			Expect(err).NotTo(HaveOccurred())
+
+			signature, err := signer.Sign(context.TODO(), payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(verifier.Verify(payload, signature)).To(Succeed())
+			Expect(verifier.Verify([]byte("other"), signature)).NotTo(Succeed())
+		}
+	})
+
+	It("should fail for unsupported keys", func() {
+		_, err := NewKeyVerifier("key")
+		Expect(err).To(HaveOccurred())
+	})
+
+	When("LoadKeyVerifier is called", func() {
+		It("should load PKIX public keys", func() {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			data, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			path := filepath.Join(GinkgoT().TempDir(), "cosign.pub")
+			Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data}), 0600)).To(Succeed())
+
+			verifier, err := LoadKeyVerifier(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(verifier.(*keyVerifier).key).To(Equal(&key.PublicKey))
+		})
+
+		It("should fail for private keys", func() {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			data, _ := x509.MarshalPKCS8PrivateKey(key)
+			path := filepath.Join(GinkgoT().TempDir(), "cosign.key")
+			Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: data}), 0600)).To(Succeed())
+
+			_, err := LoadKeyVerifier(path)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported PEM block type"))
+		})
+	})
+})
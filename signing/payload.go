@@ -0,0 +1,81 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"encoding/json"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ReleasePayload describes the finalized state of a Release. It's the document signed to prove that the record of a
+// Release wasn't tampered with after it finished.
+type ReleasePayload struct {
+	// CompletionTime is the time when the Release was completed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ManagedPipelineRun is the namespaced name of the managed PipelineRun executed as part of the Release
+	ManagedPipelineRun string `json:"managedPipelineRun,omitempty"`
+
+	// Name is the name of the Release
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Release
+	Namespace string `json:"namespace"`
+
+	// Phase is the phase the Release finished in
+	Phase v1alpha1.ReleasePhase `json:"phase,omitempty"`
+
+	// ReleasePlan is the name of the ReleasePlan used by the Release
+	ReleasePlan string `json:"releasePlan"`
+
+	// Snapshot is the name of the Snapshot released
+	Snapshot string `json:"snapshot"`
+
+	// StartTime is the time when the Release started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Target is the namespace the Release was sent to
+	Target string `json:"target,omitempty"`
+
+	// TenantPipelineRun is the namespaced name of the tenant PipelineRun executed as part of the Release
+	TenantPipelineRun string `json:"tenantPipelineRun,omitempty"`
+
+	// UID is the unique identifier of the Release, so the payload can't be reused for a Release recreated with the
+	// same name
+	UID types.UID `json:"uid"`
+}
+
+// NewReleasePayload creates and returns the serialized payload describing the given Release. The fields are always
+// serialized in the same order, so the payload of a Release doesn't change as long as the Release doesn't.
+func NewReleasePayload(release *v1alpha1.Release) ([]byte, error) {
+	return json.Marshal(&ReleasePayload{
+		CompletionTime:     release.Status.CompletionTime,
+		ManagedPipelineRun: release.Status.ManagedProcessing.PipelineRun,
+		Name:               release.Name,
+		Namespace:          release.Namespace,
+		Phase:              release.Status.Summary.Phase,
+		ReleasePlan:        release.Spec.ReleasePlan,
+		Snapshot:           release.Spec.Snapshot,
+		StartTime:          release.Status.StartTime,
+		Target:             release.Status.Target,
+		TenantPipelineRun:  release.Status.TenantProcessing.PipelineRun,
+		UID:                release.UID,
+	})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"encoding/json"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Release payload", func() {
+	var release *v1alpha1.Release
+
+	BeforeEach(func() {
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: "default",
+				UID:       "uid",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: "releaseplan",
+				Snapshot:    "snapshot",
+			},
+		}
+		release.Status.Target = "managed"
+		release.Status.ManagedProcessing.PipelineRun = "managed/pipelinerun"
+		release.MarkReleasing("")
+		release.MarkReleased()
+	})
+
+	It("should describe the finalized Release", func() {
+		data, err := NewReleasePayload(release)
+		Expect(err).NotTo(HaveOccurred())
+
+		payload := &ReleasePayload{}
+		Expect(json.Unmarshal(data, payload)).To(Succeed())
+		Expect(payload.Name).To(Equal("release"))
+		Expect(payload.Namespace).To(Equal("default"))
+		Expect(string(payload.UID)).To(Equal("uid"))
+		Expect(payload.ReleasePlan).To(Equal("releaseplan"))
+		Expect(payload.Snapshot).To(Equal("snapshot"))
+		Expect(payload.Target).To(Equal("managed"))
+		Expect(payload.ManagedPipelineRun).To(Equal("managed/pipelinerun"))
+		Expect(payload.Phase).To(Equal(v1alpha1.ReleasePhaseSucceeded))
+	})
+
+	It("should return the same payload for the same Release", func() {
+		first, err := NewReleasePayload(release)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := NewReleasePayload(release.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(Equal(second))
+	})
+})
@@ -0,0 +1,74 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// Signer is an interface to sign the payloads describing finalized Releases, so the records can be verified later on
+// by the audit tooling using the public key matching the signing key.
+type Signer interface {
+	Sign(ctx context.Context, payload []byte) ([]byte, error)
+}
+
+// Verifier is an interface to verify payloads signed with the private key matching a public key.
+type Verifier interface {
+	Verify(payload, signature []byte) error
+}
+
+// unsupportedKMSSchemes contains the schemes of the cosign KMS references that can't be used to sign Releases.
+var unsupportedKMSSchemes = []string{"awskms", "azurekms", "gcpkms"}
+
+// NewSigner creates and returns the Signer matching the given key reference, following the cosign conventions. The
+// hashivault scheme references a key in the Vault transit engine, reached with the address and token set in the
+// VAULT_ADDR and VAULT_TOKEN environment variables using the given http client. Any other reference is read as the
+// path of a file containing an unencrypted PEM private key.
+func NewSigner(keyRef string, httpClient *http.Client) (Signer, error) {
+	scheme, name, found := strings.Cut(keyRef, "://")
+	if !found {
+		return LoadKeySigner(keyRef)
+	}
+
+	switch scheme {
+	case "hashivault":
+		vaultAddr := os.Getenv("VAULT_ADDR")
+		if vaultAddr == "" {
+			return nil, fmt.Errorf("the VAULT_ADDR environment variable is required to sign with %s", keyRef)
+		}
+
+		vaultUrl, err := url.Parse(vaultAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewVaultSigner(vaultUrl, name, os.Getenv("VAULT_TOKEN"), httpClient), nil
+	default:
+		for _, unsupportedScheme := range unsupportedKMSSchemes {
+			if scheme == unsupportedScheme {
+				return nil, fmt.Errorf("the %s KMS is not supported to sign Releases", scheme)
+			}
+		}
+
+		return nil, fmt.Errorf("unsupported signing key reference scheme '%s'", scheme)
+	}
+}
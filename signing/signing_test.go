@@ -0,0 +1,65 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signing", func() {
+	When("NewSigner is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("VAULT_ADDR")
+		})
+
+		It("should return a vault signer for hashivault references", func() {
+			os.Setenv("VAULT_ADDR", "https://vault")
+			signer, err := NewSigner("hashivault://release", http.DefaultClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer).To(BeAssignableToTypeOf(&vaultSigner{}))
+			Expect(signer.(*vaultSigner).keyName).To(Equal("release"))
+		})
+
+		It("should fail for hashivault references if the vault address is not set", func() {
+			_, err := NewSigner("hashivault://release", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("VAULT_ADDR"))
+		})
+
+		It("should fail for unsupported KMS references", func() {
+			_, err := NewSigner("awskms:///arn:aws:kms:us-east-1:123456789012:key/release", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("awskms KMS is not supported"))
+		})
+
+		It("should fail for unknown schemes", func() {
+			_, err := NewSigner("ftp://key", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported signing key reference scheme"))
+		})
+
+		It("should load the key file for references without a scheme", func() {
+			_, err := NewSigner("/non/existent/cosign.key", http.DefaultClient)
+			Expect(err).To(HaveOccurred())
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,109 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// vaultSigner signs payloads using a key stored in the Vault transit secrets engine, so the private key never leaves
+// Vault.
+type vaultSigner struct {
+	httpClient *http.Client
+	keyName    string
+	token      string
+	vaultUrl   *url.URL
+}
+
+// vaultSignRequest is the body sent to the Vault transit sign endpoint.
+type vaultSignRequest struct {
+	Input     string `json:"input"`
+	Prehashed bool   `json:"prehashed"`
+}
+
+// vaultSignResponse is the body returned by the Vault transit sign endpoint.
+type vaultSignResponse struct {
+	Data struct {
+		Signature string `json:"signature"`
+	} `json:"data"`
+}
+
+// NewVaultSigner creates and returns a Signer using the transit key with the given name in the Vault server at the
+// given url. The given token is sent in every request to authenticate with Vault.
+func NewVaultSigner(vaultUrl *url.URL, keyName, token string, httpClient *http.Client) Signer {
+	return &vaultSigner{
+		httpClient: httpClient,
+		keyName:    keyName,
+		token:      token,
+		vaultUrl:   vaultUrl,
+	}
+}
+
+// Sign sends the SHA-256 digest of the given payload to Vault to be signed and returns the decoded signature.
+func (s *vaultSigner) Sign(ctx context.Context, payload []byte) ([]byte, error) {
+	digest := sha256.Sum256(payload)
+	data, err := json.Marshal(&vaultSignRequest{
+		Input:     base64.StdEncoding.EncodeToString(digest[:]),
+		Prehashed: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		s.vaultUrl.JoinPath("v1", "transit", "sign", s.keyName, "sha2-256").String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		request.Header.Set("X-Vault-Token", s.token)
+	}
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status %d signing with vault key %s", response.StatusCode, s.keyName)
+	}
+
+	signResponse := &vaultSignResponse{}
+	err = json.NewDecoder(response.Body).Decode(signResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	// Vault signatures are prefixed with the key version, e.g. vault:v1:<signature>
+	parts := strings.Split(signResponse.Data.Signature, ":")
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, fmt.Errorf("unexpected signature format returned by vault key %s", s.keyName)
+	}
+
+	return base64.StdEncoding.DecodeString(parts[2])
+}
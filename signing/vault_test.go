@@ -0,0 +1,85 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Vault signer", func() {
+	payload := []byte(`{"name":"release"}`)
+
+	It("should sign the payload digest with the transit key", func() {
+		var path, token string
+		request := &vaultSignRequest{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path, token = r.URL.Path, r.Header.Get("X-Vault-Token")
+			Expect(json.NewDecoder(r.Body).Decode(request)).To(Succeed())
+			_, _ = w.Write([]byte(`{"data":{"signature":"vault:v1:` + base64.StdEncoding.EncodeToString([]byte("signature")) + `"}}`))
+		}))
+		defer server.Close()
+
+		vaultUrl, _ := url.Parse(server.URL)
+		signer := NewVaultSigner(vaultUrl, "release", "token", server.Client())
+
+		signature, err := signer.Sign(context.TODO(), payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signature).To(Equal([]byte("signature")))
+		Expect(path).To(Equal("/v1/transit/sign/release/sha2-256"))
+		Expect(token).To(Equal("token"))
+		digest := sha256.Sum256(payload)
+		Expect(request.Input).To(Equal(base64.StdEncoding.EncodeToString(digest[:])))
+		Expect(request.Prehashed).To(BeTrue())
+	})
+
+	It("should fail if vault returns an error", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		vaultUrl, _ := url.Parse(server.URL)
+		signer := NewVaultSigner(vaultUrl, "release", "", server.Client())
+
+		_, err := signer.Sign(context.TODO(), payload)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status 403"))
+	})
+
+	It("should fail if the signature format is unexpected", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"signature":"signature"}}`))
+		}))
+		defer server.Close()
+
+		vaultUrl, _ := url.Parse(server.URL)
+		signer := NewVaultSigner(vaultUrl, "release", "", server.Client())
+
+		_, err := signer.Sign(context.TODO(), payload)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected signature format"))
+	})
+})
@@ -0,0 +1,119 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package support
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/konflux-ci/release-service/redaction"
+)
+
+const (
+	// BundleMaxSize is the maximum number of bytes of the files of a support bundle, so it fits in a ConfigMap
+	BundleMaxSize = 768 * 1024
+
+	// ControllerLogsTailLines is the number of lines read from the end of the controller logs to look for entries
+	// related to a Release
+	ControllerLogsTailLines = 5000
+)
+
+// Bundle collects the files of a support bundle, capping their total size to BundleMaxSize.
+type Bundle struct {
+	files    map[string]string
+	redactor *redaction.Redactor
+	size     int
+}
+
+// NewBundle creates and returns an empty Bundle.
+func NewBundle() *Bundle {
+	return &Bundle{
+		files: map[string]string{},
+	}
+}
+
+// WithRedactor sets the Redactor used to redact the sensitive values from the files added to the Bundle and returns
+// the Bundle.
+func (b *Bundle) WithRedactor(redactor *redaction.Redactor) *Bundle {
+	b.redactor = redactor
+
+	return b
+}
+
+// AddObject adds the JSON representation of the given object to the Bundle as a file with the given name.
+func (b *Bundle) AddObject(name string, object any) error {
+	data, err := json.MarshalIndent(object, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	b.AddText(name, string(data))
+
+	return nil
+}
+
+// AddText adds the given text to the Bundle as a file with the given name, after redacting it. If the text doesn't fit
+// in the Bundle, only its end is kept, as it tends to be the most relevant part of logs.
+func (b *Bundle) AddText(name, text string) {
+	text = b.redactor.Redact(text)
+
+	available := BundleMaxSize - b.size - len(name)
+	if available <= 0 {
+		return
+	}
+
+	if len(text) > available {
+		text = text[len(text)-available:]
+	}
+
+	b.files[name] = text
+	b.size += len(name) + len(text)
+}
+
+// Data returns the files of the Bundle indexed by name.
+func (b *Bundle) Data() map[string]string {
+	return b.files
+}
+
+// FilterLogs returns the lines of the given logs containing all the given terms.
+func FilterLogs(logs []byte, terms ...string) string {
+	var builder strings.Builder
+
+	for _, line := range strings.Split(string(logs), "\n") {
+		if containsAll(line, terms) {
+			_, _ = fmt.Fprintln(&builder, line)
+		}
+	}
+
+	return builder.String()
+}
+
+// containsAll returns whether the given line contains all the given terms.
+func containsAll(line string, terms []string) bool {
+	if line == "" {
+		return false
+	}
+
+	for _, term := range terms {
+		if !strings.Contains(line, term) {
+			return false
+		}
+	}
+
+	return true
+}
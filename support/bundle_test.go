@@ -0,0 +1,82 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package support
+
+import (
+	"strings"
+
+	"github.com/konflux-ci/release-service/redaction"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bundle", func() {
+	When("AddObject is called", func() {
+		It("should add the JSON representation of the object", func() {
+			bundle := NewBundle()
+			Expect(bundle.AddObject("object.json", map[string]string{"foo": "bar"})).To(Succeed())
+			Expect(bundle.Data()).To(HaveKeyWithValue("object.json", "{\n  \"foo\": \"bar\"\n}"))
+		})
+
+		It("should fail if the object can't be represented as JSON", func() {
+			bundle := NewBundle()
+			Expect(bundle.AddObject("object.json", func() {})).NotTo(Succeed())
+			Expect(bundle.Data()).To(BeEmpty())
+		})
+	})
+
+	When("AddText is called", func() {
+		It("should add the text", func() {
+			bundle := NewBundle()
+			bundle.AddText("file.log", "text")
+			Expect(bundle.Data()).To(HaveKeyWithValue("file.log", "text"))
+		})
+
+		It("should redact the sensitive values of the text", func() {
+			bundle := NewBundle().WithRedactor(redaction.NewRedactor("s3cr3t"))
+			bundle.AddText("file.log", "using s3cr3t")
+			Expect(bundle.Data()).To(HaveKeyWithValue("file.log", "using "+redaction.Placeholder))
+		})
+
+		It("should only keep the end of the text if it doesn't fit in the bundle", func() {
+			bundle := NewBundle()
+			bundle.AddText("first.log", strings.Repeat("a", BundleMaxSize-100))
+			bundle.AddText("second.log", strings.Repeat("a", 100)+"end")
+			Expect(bundle.Data()["second.log"]).To(HaveSuffix("end"))
+			Expect(len(bundle.Data()["first.log"]) + len(bundle.Data()["second.log"]) +
+				len("first.log") + len("second.log")).To(Equal(BundleMaxSize))
+		})
+
+		It("should not add the text if the bundle is full", func() {
+			bundle := NewBundle()
+			bundle.AddText("first.log", strings.Repeat("a", BundleMaxSize))
+			bundle.AddText("second.log", "text")
+			Expect(bundle.Data()).NotTo(HaveKey("second.log"))
+		})
+	})
+
+	When("FilterLogs is called", func() {
+		It("should only return the lines containing all the terms", func() {
+			logs := []byte("release foo in default\nrelease bar in default\nrelease foo in other\n")
+			Expect(FilterLogs(logs, "foo", "default")).To(Equal("release foo in default\n"))
+		})
+
+		It("should return an empty string if no line matches", func() {
+			Expect(FilterLogs([]byte("release bar in default\n"), "foo")).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,55 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package support
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// EventsGetter defines the interface to retrieve the events of an object.
+type EventsGetter interface {
+	GetEvents(ctx context.Context, namespace, name string) ([]corev1.Event, error)
+}
+
+// eventsGetter is an EventsGetter using a Kubernetes clientset to retrieve the events, so they are not cached.
+type eventsGetter struct {
+	clientset kubernetes.Interface
+}
+
+// NewEventsGetter creates and returns an EventsGetter using the given Kubernetes clientset.
+func NewEventsGetter(clientset kubernetes.Interface) EventsGetter {
+	return &eventsGetter{
+		clientset: clientset,
+	}
+}
+
+// GetEvents returns the events involving the object with the given name and namespace.
+func (g *eventsGetter) GetEvents(ctx context.Context, namespace, name string) ([]corev1.Event, error) {
+	events, err := g.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events.Items, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetPipelineRunComputeUsage returns the CPU millicores and the memory MiB requested by the finished TaskRuns of the
+// given PipelineRun, each multiplied by the seconds the TaskRun ran. The requests of a TaskRun are taken from its
+// compute resources or, if it doesn't set them, from the steps of its resolved Task spec. Limits are used for the
+// resources without requests. An error is returned if a TaskRun can't be found.
+func GetPipelineRunComputeUsage(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun) (int64, int64, error) {
+	var cpuMillicoreSeconds, memoryMiBSeconds int64
+
+	for _, childReference := range pipelineRun.Status.ChildReferences {
+		if childReference.Kind != "TaskRun" {
+			continue
+		}
+
+		taskRun := &tektonv1.TaskRun{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: pipelineRun.Namespace, Name: childReference.Name}, taskRun)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if taskRun.Status.StartTime == nil || taskRun.Status.CompletionTime == nil {
+			continue
+		}
+		seconds := int64(taskRun.Status.CompletionTime.Sub(taskRun.Status.StartTime.Time).Seconds())
+
+		cpuMillicores, memoryMiB := getTaskRunRequests(taskRun)
+		cpuMillicoreSeconds += cpuMillicores * seconds
+		memoryMiBSeconds += memoryMiB * seconds
+	}
+
+	return cpuMillicoreSeconds, memoryMiBSeconds, nil
+}
+
+// getTaskRunRequests returns the CPU millicores and the memory MiB requested by the given TaskRun.
+func getTaskRunRequests(taskRun *tektonv1.TaskRun) (int64, int64) {
+	if taskRun.Spec.ComputeResources != nil {
+		return getRequests(taskRun.Spec.ComputeResources)
+	}
+
+	var cpuMillicores, memoryMiB int64
+	if taskRun.Status.TaskSpec != nil {
+		for i := range taskRun.Status.TaskSpec.Steps {
+			stepCPUMillicores, stepMemoryMiB := getRequests(&taskRun.Status.TaskSpec.Steps[i].ComputeResources)
+			cpuMillicores += stepCPUMillicores
+			memoryMiB += stepMemoryMiB
+		}
+	}
+
+	return cpuMillicores, memoryMiB
+}
+
+// getRequests returns the CPU millicores and the memory MiB requested in the given resource requirements, falling
+// back to their limits for the resources without requests.
+func getRequests(resources *corev1.ResourceRequirements) (int64, int64) {
+	cpu, found := resources.Requests[corev1.ResourceCPU]
+	if !found {
+		cpu = resources.Limits[corev1.ResourceCPU]
+	}
+
+	memory, found := resources.Requests[corev1.ResourceMemory]
+	if !found {
+		memory = resources.Limits[corev1.ResourceMemory]
+	}
+
+	return cpu.MilliValue(), memory.Value() / (1024 * 1024)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("Cost", Ordered, func() {
+	var finishedTaskRun, runningTaskRun *tektonv1.TaskRun
+	var pipelineRun *tektonv1.PipelineRun
+
+	BeforeAll(func() {
+		startTime := time.Now().Add(-time.Hour)
+
+		finishedTaskRun = &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "finished-cost-taskrun",
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, finishedTaskRun)).To(Succeed())
+		finishedTaskRun.Status.StartTime = &metav1.Time{Time: startTime}
+		finishedTaskRun.Status.CompletionTime = &metav1.Time{Time: startTime.Add(100 * time.Second)}
+		finishedTaskRun.Status.TaskSpec = &tektonv1.TaskSpec{
+			Steps: []tektonv1.Step{
+				{
+					Name: "requests",
+					ComputeResources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+				{
+					Name: "limits",
+					ComputeResources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Status().Update(ctx, finishedTaskRun)).To(Succeed())
+
+		runningTaskRun = &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "running-cost-taskrun",
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, runningTaskRun)).To(Succeed())
+
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cost-pipeline-run",
+				Namespace: "default",
+			},
+		}
+		pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+			{
+				TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+				Name:     finishedTaskRun.Name,
+			},
+			{
+				TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+				Name:     runningTaskRun.Name,
+			},
+		}
+	})
+
+	AfterAll(func() {
+		Expect(k8sClient.Delete(ctx, finishedTaskRun)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, runningTaskRun)).To(Succeed())
+	})
+
+	When("GetPipelineRunComputeUsage is called", func() {
+		It("should add up the requests of the finished TaskRuns over their duration", func() {
+			cpuMillicoreSeconds, memoryMiBSeconds, err := GetPipelineRunComputeUsage(ctx, k8sClient, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpuMillicoreSeconds).To(Equal(int64(150000)))
+			Expect(memoryMiBSeconds).To(Equal(int64(153600)))
+		})
+
+		It("should fail if a TaskRun can't be found", func() {
+			missingPipelineRun := pipelineRun.DeepCopy()
+			missingPipelineRun.Status.ChildReferences[0].Name = "missing"
+			_, _, err := GetPipelineRunComputeUsage(ctx, k8sClient, missingPipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("getTaskRunRequests is called", func() {
+		It("should use the compute resources of the TaskRun if it sets them", func() {
+			taskRun := finishedTaskRun.DeepCopy()
+			taskRun.Spec.ComputeResources = &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			}
+
+			cpuMillicores, memoryMiB := getTaskRunRequests(taskRun)
+			Expect(cpuMillicores).To(Equal(int64(2000)))
+			Expect(memoryMiB).To(Equal(int64(4096)))
+		})
+	})
+})
@@ -0,0 +1,195 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a fake PipelineRun reconciler that replaces the Tekton controller in envtest environments, so
+// scenarios like retries, timeouts and cancellations can be tested without running pipelines in a real cluster.
+package fake
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Outcome defines how the fake reconciler finishes a PipelineRun.
+type Outcome struct {
+	// Delay is the time the PipelineRun keeps running before finishing
+	Delay time.Duration
+
+	// Message is the message of the Succeeded condition set when the PipelineRun finishes
+	Message string
+
+	// Results are the results reported by the PipelineRun when it finishes
+	Results []tektonv1.PipelineRunResult
+
+	// Succeeded indicates whether the PipelineRun succeeds or fails
+	Succeeded bool
+}
+
+// Script returns the Outcome of the given PipelineRun. If nil is returned, the PipelineRun keeps running until it's
+// cancelled or times out.
+type Script func(pipelineRun *tektonv1.PipelineRun) *Outcome
+
+// SucceedAfter returns a Script succeeding every PipelineRun after the given delay.
+func SucceedAfter(delay time.Duration) Script {
+	return func(*tektonv1.PipelineRun) *Outcome {
+		return &Outcome{Delay: delay, Succeeded: true}
+	}
+}
+
+// FailAfter returns a Script failing every PipelineRun after the given delay with the given message.
+func FailAfter(delay time.Duration, message string) Script {
+	return func(*tektonv1.PipelineRun) *Outcome {
+		return &Outcome{Delay: delay, Message: message}
+	}
+}
+
+// RunForever returns a Script keeping every PipelineRun running until it's cancelled or times out.
+func RunForever() Script {
+	return func(*tektonv1.PipelineRun) *Outcome {
+		return nil
+	}
+}
+
+// Sequence returns a Script assigning the given outcomes to PipelineRuns in the order they are first reconciled, so a
+// retried PipelineRun can finish differently than the original one. Once the outcomes are exhausted, the last one is
+// assigned to the remaining PipelineRuns. A nil outcome keeps the PipelineRun running.
+func Sequence(outcomes ...*Outcome) Script {
+	var mutex sync.Mutex
+	assigned := map[types.UID]*Outcome{}
+
+	return func(pipelineRun *tektonv1.PipelineRun) *Outcome {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if outcome, found := assigned[pipelineRun.UID]; found {
+			return outcome
+		}
+
+		var outcome *Outcome
+		if len(outcomes) > 0 {
+			outcome = outcomes[len(outcomes)-1]
+			if len(assigned) < len(outcomes) {
+				outcome = outcomes[len(assigned)]
+			}
+		}
+		assigned[pipelineRun.UID] = outcome
+
+		return outcome
+	}
+}
+
+// PipelineRunReconciler reconciles PipelineRuns the way the Tekton controller would, finishing them as its Script says
+// instead of running their tasks. PipelineRuns are started when first reconciled, cancelled when their spec status
+// requests it and timed out once their pipeline timeout is exceeded.
+type PipelineRunReconciler struct {
+	client client.Client
+	script Script
+}
+
+// NewPipelineRunReconciler creates and returns a PipelineRunReconciler finishing PipelineRuns following the given Script.
+func NewPipelineRunReconciler(cli client.Client, script Script) *PipelineRunReconciler {
+	return &PipelineRunReconciler{
+		client: cli,
+		script: script,
+	}
+}
+
+// Reconcile moves the given PipelineRun one step forward, requeueing it while it's running.
+func (r *PipelineRunReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	pipelineRun := &tektonv1.PipelineRun{}
+	err := r.client.Get(ctx, req.NamespacedName, pipelineRun)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if pipelineRun.IsDone() {
+		return ctrl.Result{}, nil
+	}
+
+	if pipelineRun.Status.StartTime == nil {
+		pipelineRun.Status.StartTime = &metav1.Time{Time: time.Now()}
+		pipelineRun.Status.MarkRunning(tektonv1.PipelineRunReasonRunning.String(), "")
+		return ctrl.Result{Requeue: true}, r.updateStatus(ctx, pipelineRun)
+	}
+
+	elapsed := time.Since(pipelineRun.Status.StartTime.Time)
+
+	if pipelineRun.IsCancelled() || pipelineRun.Spec.Status == tektonv1.PipelineRunSpecStatusCancelledRunFinally ||
+		pipelineRun.Spec.Status == tektonv1.PipelineRunSpecStatusStoppedRunFinally {
+		pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonCancelled.String(), "PipelineRun was cancelled")
+		return r.finish(ctx, pipelineRun)
+	}
+
+	timeout := pipelineRun.PipelineTimeout(ctx)
+	if timeout > 0 && elapsed >= timeout {
+		pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonTimedOut.String(), "PipelineRun timed out")
+		return r.finish(ctx, pipelineRun)
+	}
+
+	outcome := r.script(pipelineRun)
+	if outcome == nil {
+		if timeout > 0 {
+			return ctrl.Result{RequeueAfter: timeout - elapsed}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if elapsed < outcome.Delay {
+		return ctrl.Result{RequeueAfter: outcome.Delay - elapsed}, nil
+	}
+
+	pipelineRun.Status.Results = outcome.Results
+	if outcome.Succeeded {
+		pipelineRun.Status.MarkSucceeded(tektonv1.PipelineRunReasonSuccessful.String(), "%s", outcome.Message)
+	} else {
+		pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonFailed.String(), "%s", outcome.Message)
+	}
+
+	return r.finish(ctx, pipelineRun)
+}
+
+// SetupWithManager registers the reconciler with the given manager.
+func (r *PipelineRunReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("fake-tekton").
+		For(&tektonv1.PipelineRun{}).
+		Complete(r)
+}
+
+// finish sets the completion time of the given PipelineRun and updates its status.
+func (r *PipelineRunReconciler) finish(ctx context.Context, pipelineRun *tektonv1.PipelineRun) (ctrl.Result, error) {
+	pipelineRun.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+
+	return ctrl.Result{}, r.updateStatus(ctx, pipelineRun)
+}
+
+// updateStatus updates the status of the given PipelineRun, ignoring PipelineRuns that were deleted meanwhile.
+func (r *PipelineRunReconciler) updateStatus(ctx context.Context, pipelineRun *tektonv1.PipelineRun) error {
+	err := r.client.Status().Update(ctx, pipelineRun)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
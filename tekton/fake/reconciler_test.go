@@ -0,0 +1,147 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Fake PipelineRun reconciler", func() {
+	var pipelineRun *tektonv1.PipelineRun
+
+	createPipelineRun := func(name string, timeout time.Duration, outcome *Outcome) {
+		outcomes.Store(name, outcome)
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: tektonv1.PipelineRunSpec{
+				PipelineRef: &tektonv1.PipelineRef{Name: "pipeline"},
+			},
+		}
+		if timeout > 0 {
+			pipelineRun.Spec.Timeouts = &tektonv1.TimeoutFields{Pipeline: &metav1.Duration{Duration: timeout}}
+		}
+		Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+	}
+
+	getSucceededCondition := func() *apis.Condition {
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pipelineRun), pipelineRun)).To(Succeed())
+		return pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	}
+
+	AfterEach(func() {
+		_ = k8sClient.Delete(ctx, pipelineRun)
+	})
+
+	It("should succeed the PipelineRun reporting its results", func() {
+		createPipelineRun("succeeded", 0, &Outcome{
+			Succeeded: true,
+			Results: []tektonv1.PipelineRunResult{
+				{Name: "result", Value: *tektonv1.NewStructuredValues("value")},
+			},
+		})
+
+		Eventually(func() bool {
+			condition := getSucceededCondition()
+			return condition != nil && condition.Status == corev1.ConditionTrue
+		}).Should(BeTrue())
+		Expect(pipelineRun.Status.StartTime).NotTo(BeNil())
+		Expect(pipelineRun.Status.CompletionTime).NotTo(BeNil())
+		Expect(pipelineRun.Status.Results).To(HaveLen(1))
+	})
+
+	It("should fail the PipelineRun once its delay elapses", func() {
+		createPipelineRun("failed", 0, &Outcome{Delay: time.Second, Message: "task failed"})
+
+		Consistently(func() bool {
+			return getSucceededCondition().IsUnknown()
+		}, "500ms").Should(BeTrue())
+		Eventually(func() bool {
+			return getSucceededCondition().IsFalse()
+		}).Should(BeTrue())
+		Expect(getSucceededCondition().Reason).To(Equal(tektonv1.PipelineRunReasonFailed.String()))
+		Expect(getSucceededCondition().Message).To(Equal("task failed"))
+	})
+
+	It("should time out a PipelineRun exceeding its timeout", func() {
+		createPipelineRun("timed-out", time.Second, nil)
+
+		Eventually(func() bool {
+			return getSucceededCondition().IsFalse()
+		}).Should(BeTrue())
+		Expect(getSucceededCondition().Reason).To(Equal(tektonv1.PipelineRunReasonTimedOut.String()))
+	})
+
+	It("should cancel a PipelineRun whose spec status requests it", func() {
+		createPipelineRun("cancelled", 0, nil)
+
+		Eventually(func() bool {
+			return getSucceededCondition() != nil
+		}).Should(BeTrue())
+
+		patch := client.MergeFrom(pipelineRun.DeepCopy())
+		pipelineRun.Spec.Status = tektonv1.PipelineRunSpecStatusCancelled
+		Expect(k8sClient.Patch(ctx, pipelineRun, patch)).To(Succeed())
+
+		Eventually(func() bool {
+			return getSucceededCondition().IsFalse()
+		}).Should(BeTrue())
+		Expect(getSucceededCondition().Reason).To(Equal(tektonv1.PipelineRunReasonCancelled.String()))
+	})
+})
+
+var _ = Describe("Scripts", func() {
+	When("Sequence is called", func() {
+		It("should assign the outcomes in order and repeat the last one", func() {
+			first, second := &Outcome{}, &Outcome{Succeeded: true}
+			script := Sequence(first, second)
+
+			newPipelineRun := func(uid string) *tektonv1.PipelineRun {
+				return &tektonv1.PipelineRun{ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid)}}
+			}
+
+			Expect(script(newPipelineRun("a"))).To(BeIdenticalTo(first))
+			Expect(script(newPipelineRun("b"))).To(BeIdenticalTo(second))
+			Expect(script(newPipelineRun("a"))).To(BeIdenticalTo(first))
+			Expect(script(newPipelineRun("c"))).To(BeIdenticalTo(second))
+		})
+
+		It("should keep the PipelineRuns running if there are no outcomes", func() {
+			Expect(Sequence()(&tektonv1.PipelineRun{})).To(BeNil())
+		})
+	})
+
+	When("SucceedAfter, FailAfter and RunForever are called", func() {
+		It("should return scripts with the expected outcomes", func() {
+			Expect(SucceedAfter(time.Second)(nil)).To(Equal(&Outcome{Delay: time.Second, Succeeded: true}))
+			Expect(FailAfter(time.Second, "failed")(nil)).To(Equal(&Outcome{Delay: time.Second, Message: "failed"}))
+			Expect(RunForever()(nil)).To(BeNil())
+		})
+	})
+})
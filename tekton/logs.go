@@ -0,0 +1,123 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+	"fmt"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// FailureLogsTailLines is the number of lines captured from the end of the logs of each failed step
+	FailureLogsTailLines = 100
+
+	// FailureLogsMaxSize is the maximum number of bytes captured from the logs of all the failed steps of a PipelineRun
+	FailureLogsMaxSize = 256 * 1024
+)
+
+// PodLogsGetter defines the interface to retrieve the logs of the containers of a Pod.
+type PodLogsGetter interface {
+	GetLogs(ctx context.Context, namespace, pod, container string, tailLines int64) ([]byte, error)
+}
+
+// podLogsGetter is a PodLogsGetter using a Kubernetes clientset to retrieve the logs.
+type podLogsGetter struct {
+	clientset kubernetes.Interface
+}
+
+// NewPodLogsGetter creates and returns a PodLogsGetter using the given Kubernetes clientset.
+func NewPodLogsGetter(clientset kubernetes.Interface) PodLogsGetter {
+	return &podLogsGetter{
+		clientset: clientset,
+	}
+}
+
+// GetLogs returns the last lines of the logs of the given container.
+func (g *podLogsGetter) GetLogs(ctx context.Context, namespace, pod, container string, tailLines int64) ([]byte, error) {
+	return g.clientset.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &tailLines,
+	}).DoRaw(ctx)
+}
+
+// GetFailureLogs returns the tail of the logs of the failed steps of every failed TaskRun in the given PipelineRun,
+// indexed by "<pipeline task>.<step>.log". The total size of the logs is capped to FailureLogsMaxSize, so logs are
+// truncated or omitted once the limit is reached.
+func GetFailureLogs(ctx context.Context, cli client.Client, logsGetter PodLogsGetter, pipelineRun *tektonv1.PipelineRun) (map[string]string, error) {
+	logs := map[string]string{}
+	size := 0
+
+	for _, childReference := range pipelineRun.Status.ChildReferences {
+		if childReference.Kind != "TaskRun" {
+			continue
+		}
+
+		taskRun := &tektonv1.TaskRun{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: pipelineRun.Namespace, Name: childReference.Name}, taskRun)
+		if err != nil {
+			return nil, err
+		}
+
+		if !taskRun.Status.GetCondition(apis.ConditionSucceeded).IsFalse() || taskRun.Status.PodName == "" {
+			continue
+		}
+
+		for _, step := range getFailedSteps(taskRun) {
+			if size >= FailureLogsMaxSize {
+				return logs, nil
+			}
+
+			stepLogs, err := logsGetter.GetLogs(ctx, taskRun.Namespace, taskRun.Status.PodName, step.Container,
+				FailureLogsTailLines)
+			if err != nil {
+				return nil, err
+			}
+
+			if size+len(stepLogs) > FailureLogsMaxSize {
+				stepLogs = stepLogs[len(stepLogs)-(FailureLogsMaxSize-size):]
+			}
+			size += len(stepLogs)
+
+			logs[fmt.Sprintf("%s.%s.log", childReference.PipelineTaskName, step.Name)] = string(stepLogs)
+		}
+	}
+
+	return logs, nil
+}
+
+// getFailedSteps returns the steps of the given TaskRun that terminated with a non-zero exit code. If no step
+// terminated that way (e.g. the TaskRun timed out), all the steps are returned.
+func getFailedSteps(taskRun *tektonv1.TaskRun) []tektonv1.StepState {
+	var failedSteps []tektonv1.StepState
+	for _, step := range taskRun.Status.Steps {
+		if step.Terminated != nil && step.Terminated.ExitCode != 0 {
+			failedSteps = append(failedSteps, step)
+		}
+	}
+
+	if len(failedSteps) == 0 {
+		return taskRun.Status.Steps
+	}
+
+	return failedSteps
+}
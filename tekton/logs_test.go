@@ -0,0 +1,150 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+// mockPodLogsGetter returns the container name followed by the given logs as the logs of every container.
+type mockPodLogsGetter struct {
+	logs string
+}
+
+func (g *mockPodLogsGetter) GetLogs(_ context.Context, _, _, container string, _ int64) ([]byte, error) {
+	return []byte(container + g.logs), nil
+}
+
+var _ = Describe("Logs", Ordered, func() {
+	var failedTaskRun, succeededTaskRun *tektonv1.TaskRun
+	var pipelineRun *tektonv1.PipelineRun
+
+	BeforeAll(func() {
+		failedTaskRun = &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "failed-taskrun",
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, failedTaskRun)).To(Succeed())
+		failedTaskRun.Status.PodName = "failed-pod"
+		failedTaskRun.Status.MarkResourceFailed(tektonv1.TaskRunReasonFailed, nil)
+		failedTaskRun.Status.Steps = []tektonv1.StepState{
+			{
+				Name:      "ok",
+				Container: "step-ok",
+				ContainerState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 0},
+				},
+			},
+			{
+				Name:      "ko",
+				Container: "step-ko",
+				ContainerState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 1},
+				},
+			},
+		}
+		Expect(k8sClient.Status().Update(ctx, failedTaskRun)).To(Succeed())
+
+		succeededTaskRun = &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "succeeded-taskrun",
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, succeededTaskRun)).To(Succeed())
+		succeededTaskRun.Status.PodName = "succeeded-pod"
+		succeededTaskRun.Status.SetCondition(&apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionTrue,
+		})
+		Expect(k8sClient.Status().Update(ctx, succeededTaskRun)).To(Succeed())
+
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pipeline-run",
+				Namespace: "default",
+			},
+		}
+		pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+			{
+				TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+				Name:             failedTaskRun.Name,
+				PipelineTaskName: "failed-task",
+			},
+			{
+				TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+				Name:             succeededTaskRun.Name,
+				PipelineTaskName: "succeeded-task",
+			},
+		}
+	})
+
+	AfterAll(func() {
+		Expect(k8sClient.Delete(ctx, failedTaskRun)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, succeededTaskRun)).To(Succeed())
+	})
+
+	When("GetFailureLogs is called", func() {
+		It("should return the logs of the failed steps of the failed TaskRuns", func() {
+			logs, err := GetFailureLogs(ctx, k8sClient, &mockPodLogsGetter{logs: " failed"}, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logs).To(Equal(map[string]string{
+				"failed-task.ko.log": "step-ko failed",
+			}))
+		})
+
+		It("should cap the size of the logs", func() {
+			logsGetter := &mockPodLogsGetter{logs: strings.Repeat("a", FailureLogsMaxSize)}
+			logs, err := GetFailureLogs(ctx, k8sClient, logsGetter, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logs["failed-task.ko.log"]).To(HaveLen(FailureLogsMaxSize))
+		})
+
+		It("should fail if a TaskRun can't be found", func() {
+			missingPipelineRun := pipelineRun.DeepCopy()
+			missingPipelineRun.Status.ChildReferences[0].Name = "missing"
+			_, err := GetFailureLogs(ctx, k8sClient, &mockPodLogsGetter{}, missingPipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("getFailedSteps is called", func() {
+		It("should return only the steps that failed", func() {
+			steps := getFailedSteps(failedTaskRun)
+			Expect(steps).To(HaveLen(1))
+			Expect(steps[0].Name).To(Equal("ko"))
+		})
+
+		It("should return all the steps if none of them failed", func() {
+			taskRun := failedTaskRun.DeepCopy()
+			taskRun.Status.Steps[1].Terminated.ExitCode = 0
+			Expect(getFailedSteps(taskRun)).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+	"slices"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// nodeFailureReasons are the Pod status reasons set when a Pod fails because of its node
+var nodeFailureReasons = []string{"Evicted", "NodeLost", "NodeShutdown", "Shutdown", "Terminated"}
+
+// PodGetter defines the interface to retrieve Pods.
+type PodGetter interface {
+	GetPod(ctx context.Context, namespace, name string) (*corev1.Pod, error)
+}
+
+// podGetter is a PodGetter using a Kubernetes clientset to retrieve the Pods, so they are not cached.
+type podGetter struct {
+	clientset kubernetes.Interface
+}
+
+// NewPodGetter creates and returns a PodGetter using the given Kubernetes clientset.
+func NewPodGetter(clientset kubernetes.Interface) PodGetter {
+	return &podGetter{
+		clientset: clientset,
+	}
+}
+
+// GetPod returns the Pod with the given name and namespace.
+func (g *podGetter) GetPod(ctx context.Context, namespace, name string) (*corev1.Pod, error) {
+	return g.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// GetFailedNodes returns the names of the nodes the failed TaskRuns of the given PipelineRun ran on when they failed
+// because of their node, e.g. because their Pod was evicted or lost with the node. TaskRuns whose Pod no longer exists
+// are ignored, as the node can't be derived from them. An error is returned if a TaskRun can't be found.
+func GetFailedNodes(ctx context.Context, cli client.Client, getter PodGetter, pipelineRun *tektonv1.PipelineRun) ([]string, error) {
+	var nodes []string
+
+	for _, childReference := range pipelineRun.Status.ChildReferences {
+		if childReference.Kind != "TaskRun" {
+			continue
+		}
+
+		taskRun := &tektonv1.TaskRun{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: pipelineRun.Namespace, Name: childReference.Name}, taskRun)
+		if err != nil {
+			return nil, err
+		}
+
+		if !taskRun.Status.GetCondition(apis.ConditionSucceeded).IsFalse() || taskRun.Status.PodName == "" {
+			continue
+		}
+
+		pod, err := getter.GetPod(ctx, taskRun.Namespace, taskRun.Status.PodName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if isNodeFailure(pod) && pod.Spec.NodeName != "" && !slices.Contains(nodes, pod.Spec.NodeName) {
+			nodes = append(nodes, pod.Spec.NodeName)
+		}
+	}
+
+	return nodes, nil
+}
+
+// isNodeFailure returns whether the given Pod failed because of its node, either because of the reason set in its
+// status or because it was disrupted by the node lifecycle controller or the kubelet.
+func isNodeFailure(pod *corev1.Pod) bool {
+	if slices.Contains(nodeFailureReasons, pod.Status.Reason) {
+		return true
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.DisruptionTarget && condition.Status == corev1.ConditionTrue &&
+			(condition.Reason == "DeletionByTaintManager" || condition.Reason == "TerminationByKubelet") {
+			return true
+		}
+	}
+
+	return false
+}
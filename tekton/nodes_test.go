@@ -0,0 +1,133 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+// mockPodGetter returns the Pods it holds indexed by name.
+type mockPodGetter struct {
+	pods map[string]*corev1.Pod
+}
+
+func (g *mockPodGetter) GetPod(_ context.Context, _, name string) (*corev1.Pod, error) {
+	pod, found := g.pods[name]
+	if !found {
+		return nil, errors.NewNotFound(schema.GroupResource{Resource: "pods"}, name)
+	}
+
+	return pod, nil
+}
+
+var _ = Describe("Nodes", Ordered, func() {
+	var evictedTaskRun, failedTaskRun, lostTaskRun *tektonv1.TaskRun
+	var getter *mockPodGetter
+	var pipelineRun *tektonv1.PipelineRun
+
+	createFailedTaskRun := func(name string) *tektonv1.TaskRun {
+		taskRun := &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, taskRun)).To(Succeed())
+		taskRun.Status.PodName = name + "-pod"
+		taskRun.Status.SetCondition(&apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionFalse,
+		})
+		Expect(k8sClient.Status().Update(ctx, taskRun)).To(Succeed())
+
+		return taskRun
+	}
+
+	BeforeAll(func() {
+		evictedTaskRun = createFailedTaskRun("evicted-taskrun")
+		failedTaskRun = createFailedTaskRun("failed-taskrun")
+		lostTaskRun = createFailedTaskRun("lost-taskrun")
+
+		getter = &mockPodGetter{pods: map[string]*corev1.Pod{
+			"evicted-taskrun-pod": {
+				Spec:   corev1.PodSpec{NodeName: "node-a"},
+				Status: corev1.PodStatus{Reason: "Evicted"},
+			},
+			"failed-taskrun-pod": {
+				Spec: corev1.PodSpec{NodeName: "node-b"},
+			},
+		}}
+
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "nodes-pipeline-run",
+				Namespace: "default",
+			},
+		}
+		for _, taskRun := range []*tektonv1.TaskRun{evictedTaskRun, failedTaskRun, lostTaskRun} {
+			pipelineRun.Status.ChildReferences = append(pipelineRun.Status.ChildReferences, tektonv1.ChildStatusReference{
+				TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+				Name:     taskRun.Name,
+			})
+		}
+	})
+
+	AfterAll(func() {
+		Expect(k8sClient.Delete(ctx, evictedTaskRun)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, failedTaskRun)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, lostTaskRun)).To(Succeed())
+	})
+
+	When("GetFailedNodes is called", func() {
+		It("should only return the nodes of the Pods that failed because of their node", func() {
+			nodes, err := GetFailedNodes(ctx, k8sClient, getter, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodes).To(Equal([]string{"node-a"}))
+		})
+
+		It("should fail if a TaskRun can't be found", func() {
+			missingPipelineRun := pipelineRun.DeepCopy()
+			missingPipelineRun.Status.ChildReferences[0].Name = "missing"
+			_, err := GetFailedNodes(ctx, k8sClient, getter, missingPipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("isNodeFailure is called", func() {
+		It("should return true if the Pod was disrupted by the kubelet", func() {
+			pod := &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+				{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: "TerminationByKubelet"},
+			}}}
+			Expect(isNodeFailure(pod)).To(BeTrue())
+		})
+
+		It("should return false if the Pod failed for any other reason", func() {
+			Expect(isNodeFailure(&corev1.Pod{})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,252 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/konflux-ci/release-service/tekton/utils"
+)
+
+// manifestMediaTypes contains the media types accepted when requesting the manifest of a bundle
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// commitRegex matches full git commit SHAs
+var commitRegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// PipelineChecker defines the interface to check whether the Pipeline referenced by a PipelineRef can be resolved.
+type PipelineChecker interface {
+	Check(ctx context.Context, pipeline *utils.Pipeline) error
+}
+
+// pipelineChecker is a PipelineChecker querying image registries and git servers anonymously.
+type pipelineChecker struct {
+	httpClient *http.Client
+}
+
+// NewPipelineChecker creates and returns a PipelineChecker sending its requests with the given client.
+func NewPipelineChecker(httpClient *http.Client) PipelineChecker {
+	return &pipelineChecker{
+		httpClient: httpClient,
+	}
+}
+
+// Check returns an error if the given Pipeline can't be resolved. For the bundles resolver, the manifest of the bundle
+// has to exist in its registry. For the git resolver, the pinned revision or the revision param of the PipelineRef
+// and the revision being rolled out, if any, have to be branches or tags of the repository. As git servers only
+// advertise the commits at the tip of each ref, revisions which are full commit SHAs are only checked for the
+// repository to be reachable. Pipelines using other resolvers are not checked.
+func (c *pipelineChecker) Check(ctx context.Context, pipeline *utils.Pipeline) error {
+	params := map[string]string{}
+	for _, param := range pipeline.PipelineRef.Params {
+		params[param.Name] = param.Value
+	}
+
+	switch pipeline.PipelineRef.Resolver {
+	case "bundles":
+		return c.checkBundle(ctx, params["bundle"])
+	case "git":
+		if params["url"] == "" {
+			// Repositories referenced through the SCM API can't be checked anonymously
+			return nil
+		}
+
+		revisions := []string{params["revision"]}
+		if pipeline.Revision != "" {
+			revisions[0] = pipeline.Revision
+		}
+		if pipeline.Rollout != nil {
+			revisions = append(revisions, pipeline.Rollout.Revision)
+		}
+
+		for _, revision := range revisions {
+			if err := c.checkGitRevision(ctx, params["url"], revision); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkBundle returns an error if the manifest of the given bundle can't be found in its registry.
+func (c *pipelineChecker) checkBundle(ctx context.Context, bundle string) error {
+	reference, err := name.ParseReference(bundle)
+	if err != nil {
+		return fmt.Errorf("invalid bundle reference %s: %w", bundle, err)
+	}
+
+	repository := reference.Context()
+	manifestUrl := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", repository.Registry.Scheme(), repository.RegistryStr(),
+		repository.RepositoryStr(), reference.Identifier())
+
+	response, err := c.do(ctx, http.MethodHead, manifestUrl, manifestMediaTypes, "")
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode == http.StatusUnauthorized {
+		token, err := c.getToken(ctx, response.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return err
+		}
+
+		response, err = c.do(ctx, http.MethodHead, manifestUrl, manifestMediaTypes, token)
+		if err != nil {
+			return err
+		}
+		response.Body.Close()
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("bundle %s not found", bundle)
+	}
+
+	return fmt.Errorf("unexpected status %d checking bundle %s", response.StatusCode, bundle)
+}
+
+// checkGitRevision returns an error if the given revision is not a branch or tag of the given git repository. The
+// refs are listed using the git smart HTTP protocol.
+func (c *pipelineChecker) checkGitRevision(ctx context.Context, repository, revision string) error {
+	refsUrl := fmt.Sprintf("%s/info/refs?service=git-upload-pack", strings.TrimSuffix(repository, "/"))
+	response, err := c.do(ctx, http.MethodGet, refsUrl, nil, "")
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d listing the refs of git repository %s", response.StatusCode, repository)
+	}
+
+	if revision == "" || commitRegex.MatchString(revision) {
+		return nil
+	}
+
+	candidates := []string{revision, "refs/heads/" + revision, "refs/tags/" + revision}
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		// Each advertised ref is a pkt-line in the "<length><sha> <ref>[\0<capabilities>]" format
+		line := scanner.Text()
+		if len(line) < 4 {
+			continue
+		}
+		_, ref, found := strings.Cut(line[4:], " ")
+		if !found {
+			continue
+		}
+		ref, _, _ = strings.Cut(ref, "\x00")
+
+		for _, candidate := range candidates {
+			if ref == candidate {
+				return nil
+			}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("revision %s not found in git repository %s", revision, repository)
+}
+
+// getToken requests an anonymous bearer token using the information in the given WWW-Authenticate header.
+func (c *pipelineChecker) getToken(ctx context.Context, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported authentication challenge '%s'", challenge)
+	}
+
+	params := map[string]string{}
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if found {
+			params[key] = strings.Trim(value, "\"")
+		}
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid realm in authentication challenge '%s'", challenge)
+	}
+
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	response, err := c.do(ctx, http.MethodGet, realm.String(), nil, "")
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d requesting a token", response.StatusCode)
+	}
+
+	tokenResponse := &struct {
+		AccessToken string `json:"access_token"`
+		Token       string `json:"token"`
+	}{}
+	if err = json.NewDecoder(response.Body).Decode(tokenResponse); err != nil {
+		return "", err
+	}
+
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+
+	return tokenResponse.AccessToken, nil
+}
+
+// do sends a request with the given method to the given url, setting the given accepted media types and bearer token
+// if any.
+func (c *pipelineChecker) do(ctx context.Context, method, requestUrl string, accept []string, token string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, requestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accept) > 0 {
+		request.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return c.httpClient.Do(request)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/konflux-ci/release-service/tekton/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline checker", func() {
+	var (
+		checker PipelineChecker
+		server  *httptest.Server
+	)
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	When("Check is called with a Pipeline using the bundles resolver", func() {
+		var pipeline *utils.Pipeline
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/token":
+					_, _ = w.Write([]byte(`{"token":"foo"}`))
+				case r.Header.Get("Authorization") != "Bearer foo":
+					w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token"`, "http://"+r.Host))
+					w.WriteHeader(http.StatusUnauthorized)
+				case r.URL.Path == "/v2/foo/bundle/manifests/1.0":
+					Expect(r.Method).To(Equal(http.MethodHead))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			checker = NewPipelineChecker(server.Client())
+
+			pipeline = &utils.Pipeline{
+				PipelineRef: utils.PipelineRef{
+					Resolver: "bundles",
+					Params: []utils.Param{
+						{Name: "name", Value: "release"},
+						{Name: "kind", Value: "pipeline"},
+					},
+				},
+			}
+		})
+
+		It("succeeds if the bundle manifest exists", func() {
+			pipeline.PipelineRef.Params = append(pipeline.PipelineRef.Params, utils.Param{
+				Name: "bundle", Value: strings.TrimPrefix(server.URL, "http://") + "/foo/bundle:1.0",
+			})
+			Expect(checker.Check(context.TODO(), pipeline)).To(Succeed())
+		})
+
+		It("fails if the bundle manifest doesn't exist", func() {
+			pipeline.PipelineRef.Params = append(pipeline.PipelineRef.Params, utils.Param{
+				Name: "bundle", Value: strings.TrimPrefix(server.URL, "http://") + "/foo/bundle:2.0",
+			})
+			err := checker.Check(context.TODO(), pipeline)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not found"))
+		})
+	})
+
+	When("Check is called with a Pipeline using the git resolver", func() {
+		var pipeline *utils.Pipeline
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/org/repo.git/info/refs" || r.URL.Query().Get("service") != "git-upload-pack" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				sha := strings.Repeat("a", 40)
+				_, _ = w.Write([]byte("001e# service=git-upload-pack\n0000" +
+					"0045" + sha + " HEAD\x00multi_ack\n" +
+					"003f" + sha + " refs/heads/main\n" +
+					"003f" + sha + " refs/tags/v1.0\n" +
+					"0000"))
+			}))
+			checker = NewPipelineChecker(server.Client())
+
+			pipeline = &utils.Pipeline{
+				PipelineRef: utils.PipelineRef{
+					Resolver: "git",
+					Params: []utils.Param{
+						{Name: "url", Value: server.URL + "/org/repo.git"},
+						{Name: "revision", Value: "main"},
+						{Name: "pathInRepo", Value: "pipeline.yaml"},
+					},
+				},
+			}
+		})
+
+		It("succeeds if the revision is a branch of the repository", func() {
+			Expect(checker.Check(context.TODO(), pipeline)).To(Succeed())
+		})
+
+		It("succeeds if the pinned revision is a tag of the repository", func() {
+			pipeline.Revision = "v1.0"
+			Expect(checker.Check(context.TODO(), pipeline)).To(Succeed())
+		})
+
+		It("succeeds if the revision is a commit SHA", func() {
+			pipeline.Revision = strings.Repeat("b", 40)
+			Expect(checker.Check(context.TODO(), pipeline)).To(Succeed())
+		})
+
+		It("fails if the revision doesn't exist in the repository", func() {
+			pipeline.Revision = "foo"
+			err := checker.Check(context.TODO(), pipeline)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("revision foo not found"))
+		})
+
+		It("fails if the revision being rolled out doesn't exist in the repository", func() {
+			pipeline.Rollout = &utils.PipelineRollout{Revision: "foo"}
+			err := checker.Check(context.TODO(), pipeline)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("revision foo not found"))
+		})
+
+		It("fails if the repository can't be reached", func() {
+			pipeline.PipelineRef.Params[0].Value = server.URL + "/org/missing.git"
+			Expect(checker.Check(context.TODO(), pipeline)).NotTo(Succeed())
+		})
+	})
+
+	When("Check is called with a Pipeline using another resolver", func() {
+		It("succeeds without checking the Pipeline", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Fail("no requests expected")
+			}))
+			checker = NewPipelineChecker(server.Client())
+
+			Expect(checker.Check(context.TODO(), &utils.Pipeline{
+				PipelineRef: utils.PipelineRef{Resolver: "cluster"},
+			})).To(Succeed())
+		})
+	})
+})
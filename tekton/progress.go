@@ -0,0 +1,57 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetPipelineRunProgress returns the number of tasks of the given PipelineRun that finished running or were skipped,
+// along with its total number of tasks. The total is taken from the Pipeline spec resolved in the PipelineRun status or,
+// if it's not resolved yet, from the TaskRuns already created. An error is returned if a TaskRun can't be found.
+func GetPipelineRunProgress(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun) (int, int, error) {
+	completed := len(pipelineRun.Status.SkippedTasks)
+	taskRuns := 0
+
+	for _, childReference := range pipelineRun.Status.ChildReferences {
+		if childReference.Kind != "TaskRun" {
+			continue
+		}
+		taskRuns++
+
+		taskRun := &tektonv1.TaskRun{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: pipelineRun.Namespace, Name: childReference.Name}, taskRun)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if !taskRun.Status.GetCondition(apis.ConditionSucceeded).IsUnknown() {
+			completed++
+		}
+	}
+
+	total := taskRuns + len(pipelineRun.Status.SkippedTasks)
+	if pipelineSpec := pipelineRun.Status.PipelineSpec; pipelineSpec != nil {
+		total = max(total, len(pipelineSpec.Tasks)+len(pipelineSpec.Finally))
+	}
+
+	return completed, total, nil
+}
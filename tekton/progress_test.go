@@ -0,0 +1,108 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+var _ = Describe("Progress", Ordered, func() {
+	var completedTaskRun, runningTaskRun *tektonv1.TaskRun
+	var pipelineRun *tektonv1.PipelineRun
+
+	BeforeAll(func() {
+		completedTaskRun = &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "completed-taskrun",
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, completedTaskRun)).To(Succeed())
+		completedTaskRun.Status.SetCondition(&apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionTrue,
+		})
+		Expect(k8sClient.Status().Update(ctx, completedTaskRun)).To(Succeed())
+
+		runningTaskRun = &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "running-taskrun",
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, runningTaskRun)).To(Succeed())
+
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "progress-pipeline-run",
+				Namespace: "default",
+			},
+		}
+		pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+			{
+				TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+				Name:     completedTaskRun.Name,
+			},
+			{
+				TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+				Name:     runningTaskRun.Name,
+			},
+		}
+	})
+
+	AfterAll(func() {
+		Expect(k8sClient.Delete(ctx, completedTaskRun)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, runningTaskRun)).To(Succeed())
+	})
+
+	When("GetPipelineRunProgress is called", func() {
+		It("should count the TaskRuns if the Pipeline spec is not resolved", func() {
+			completed, total, err := GetPipelineRunProgress(ctx, k8sClient, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(Equal(1))
+			Expect(total).To(Equal(2))
+		})
+
+		It("should count the tasks of the resolved Pipeline spec and the skipped tasks", func() {
+			resolvedPipelineRun := pipelineRun.DeepCopy()
+			resolvedPipelineRun.Status.PipelineSpec = &tektonv1.PipelineSpec{
+				Tasks:   []tektonv1.PipelineTask{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+				Finally: []tektonv1.PipelineTask{{Name: "d"}},
+			}
+			resolvedPipelineRun.Status.SkippedTasks = []tektonv1.SkippedTask{{Name: "c"}}
+
+			completed, total, err := GetPipelineRunProgress(ctx, k8sClient, resolvedPipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(Equal(2))
+			Expect(total).To(Equal(4))
+		})
+
+		It("should fail if a TaskRun can't be found", func() {
+			missingPipelineRun := pipelineRun.DeepCopy()
+			missingPipelineRun.Status.ChildReferences[0].Name = "missing"
+			_, _, err := GetPipelineRunProgress(ctx, k8sClient, missingPipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
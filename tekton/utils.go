@@ -17,13 +17,26 @@ limitations under the License.
 package tekton
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/konflux-ci/operator-toolkit/conditions"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
 	"github.com/konflux-ci/release-service/metadata"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"knative.dev/pkg/apis"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// isReleasePipelineRun returns a boolean indicating whether the object passed is a Managed or a Tenant Release PipelineRun.
+// ComponentResultsResultName is the name of the managed Pipeline result reporting the outcome of the release of each
+// component as a JSON array of objects with the name, phase and message of the component.
+const ComponentResultsResultName = "componentResults"
+
+// isReleasePipelineRun returns a boolean indicating whether the object passed is a Managed, a Tenant or a Verification
+// Release PipelineRun.
 func isReleasePipelineRun(object client.Object) bool {
 	_, ok := object.(*tektonv1.PipelineRun)
 	if !ok {
@@ -32,7 +45,8 @@ func isReleasePipelineRun(object client.Object) bool {
 
 	labelValue, found := object.GetLabels()[metadata.PipelinesTypeLabel]
 
-	return found && (labelValue == metadata.ManagedPipelineType || labelValue == metadata.TenantPipelineType)
+	return found && (labelValue == metadata.ManagedPipelineType || labelValue == metadata.TenantPipelineType ||
+		labelValue == metadata.VerificationPipelineType)
 }
 
 // hasPipelineSucceeded returns a boolean indicating whether the PipelineRun succeeded or not.
@@ -44,3 +58,123 @@ func hasPipelineSucceeded(object client.Object) bool {
 
 	return false
 }
+
+// GetComponentResults returns the outcome of the release of each component reported by the given PipelineRun in its
+// componentResults result. If the PipelineRun doesn't report it, nil will be returned. An error is returned if the
+// result can't be parsed or reports a component without name or with an unknown phase.
+func GetComponentResults(pipelineRun *tektonv1.PipelineRun) ([]v1alpha1.ComponentStatus, error) {
+	for _, result := range pipelineRun.Status.Results {
+		if result.Name != ComponentResultsResultName {
+			continue
+		}
+
+		var components []v1alpha1.ComponentStatus
+		err := json.Unmarshal([]byte(result.Value.StringVal), &components)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the %s result: %w", ComponentResultsResultName, err)
+		}
+
+		for _, component := range components {
+			if component.Name == "" {
+				return nil, fmt.Errorf("the %s result reports a component without name", ComponentResultsResultName)
+			}
+			if component.Phase != v1alpha1.ComponentPhaseSucceeded && component.Phase != v1alpha1.ComponentPhaseFailed {
+				return nil, fmt.Errorf("the %s result reports the unknown phase '%s' for the component %s",
+					ComponentResultsResultName, component.Phase, component.Name)
+			}
+		}
+
+		return components, nil
+	}
+
+	return nil, nil
+}
+
+// GetLastProgressTime returns the last time the given PipelineRun reported any status progress. That is the most recent
+// of its start or creation time and the transition times of its conditions, along with the start times, step start and
+// finish times and condition transition times of the TaskRuns in its child references. A running TaskRun only counts as
+// progress when one of its steps starts or finishes, so a hung step is reported as the lack of progress it is. An error
+// is returned if a TaskRun can't be found.
+func GetLastProgressTime(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun) (time.Time, error) {
+	lastProgressTime := pipelineRun.CreationTimestamp.Time
+	if pipelineRun.Status.StartTime != nil && pipelineRun.Status.StartTime.Time.After(lastProgressTime) {
+		lastProgressTime = pipelineRun.Status.StartTime.Time
+	}
+
+	for _, condition := range pipelineRun.Status.Conditions {
+		if condition.LastTransitionTime.Inner.Time.After(lastProgressTime) {
+			lastProgressTime = condition.LastTransitionTime.Inner.Time
+		}
+	}
+
+	for _, childReference := range pipelineRun.Status.ChildReferences {
+		if childReference.Kind != "TaskRun" {
+			continue
+		}
+
+		taskRun := &tektonv1.TaskRun{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: pipelineRun.Namespace, Name: childReference.Name}, taskRun)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		progressTimes := []time.Time{}
+		if taskRun.Status.StartTime != nil {
+			progressTimes = append(progressTimes, taskRun.Status.StartTime.Time)
+		}
+		for _, condition := range taskRun.Status.Conditions {
+			progressTimes = append(progressTimes, condition.LastTransitionTime.Inner.Time)
+		}
+		for _, step := range taskRun.Status.Steps {
+			if step.Running != nil {
+				progressTimes = append(progressTimes, step.Running.StartedAt.Time)
+			}
+			if step.Terminated != nil {
+				progressTimes = append(progressTimes, step.Terminated.FinishedAt.Time)
+			}
+		}
+
+		for _, progressTime := range progressTimes {
+			if progressTime.After(lastProgressTime) {
+				lastProgressTime = progressTime
+			}
+		}
+	}
+
+	return lastProgressTime, nil
+}
+
+// IsPipelineRunStalled returns a boolean indicating whether the given PipelineRun is still running but neither it nor
+// its TaskRuns reported any status progress for longer than the given timeout. An error is returned if a TaskRun can't
+// be found.
+func IsPipelineRunStalled(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun, timeout time.Duration) (bool, error) {
+	if pipelineRun.IsDone() || timeout <= 0 {
+		return false, nil
+	}
+
+	lastProgressTime, err := GetLastProgressTime(ctx, cli, pipelineRun)
+	if err != nil {
+		return false, err
+	}
+
+	return time.Since(lastProgressTime) > timeout, nil
+}
+
+// GetPipelineRunFailureReason returns the reason describing why the given PipelineRun failed. PipelineRuns timing out
+// or failing to resolve their Pipeline or tasks are reported with their own reasons and any other failure is reported
+// as a generic Pipeline failure.
+func GetPipelineRunFailureReason(pipelineRun *tektonv1.PipelineRun) conditions.ConditionReason {
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition == nil {
+		return reasons.PipelineFailed
+	}
+
+	switch condition.Reason {
+	case tektonv1.PipelineRunReasonTimedOut.String():
+		return reasons.Timeout
+	case tektonv1.PipelineRunReasonCouldntGetPipeline.String(), tektonv1.PipelineRunReasonCouldntGetTask.String():
+		return reasons.PipelineResolutionFailed
+	default:
+		return reasons.PipelineFailed
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// PipelineMirrorCatalogKey is the key of the ConfigMap data holding the pipeline mirror catalog.
+const PipelineMirrorCatalogKey = "catalog.yaml"
+
+// PipelineMirrorCatalog lists the Pipelines mirrored to a registry reachable from a disconnected cluster.
+type PipelineMirrorCatalog struct {
+	// Pipelines contains the mirrored Pipelines
+	Pipelines []PipelineMirror `json:"pipelines"`
+}
+
+// PipelineMirror maps the reference to a Pipeline to the bundle mirroring it.
+type PipelineMirror struct {
+	// Bundle is the bundle holding the mirrored Pipeline. It must be pinned by digest
+	Bundle string `json:"bundle"`
+
+	// Name is the name of the Pipeline in the bundle
+	Name string `json:"name"`
+
+	// Source is the Pipeline reference as set in ReleasePlans and ReleasePlanAdmissions
+	Source PipelineRef `json:"source"`
+}
+
+// NewPipelineMirrorCatalog parses the given YAML or JSON data and returns the pipeline mirror catalog it contains. An
+// error is returned if any of the mirrored bundles is not pinned by digest.
+func NewPipelineMirrorCatalog(data string) (*PipelineMirrorCatalog, error) {
+	catalog := &PipelineMirrorCatalog{}
+	err := yaml.Unmarshal([]byte(data), catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mirror := range catalog.Pipelines {
+		if !strings.Contains(mirror.Bundle, "@sha256:") {
+			return nil, fmt.Errorf("mirrored bundle %s is not pinned by digest", mirror.Bundle)
+		}
+		if mirror.Name == "" {
+			return nil, fmt.Errorf("mirrored bundle %s has no pipeline name", mirror.Bundle)
+		}
+	}
+
+	return catalog, nil
+}
+
+// GetMirroredPipelineRef returns a PipelineRef resolving the mirror of the Pipeline referenced by the given
+// PipelineRef with the bundles resolver. A mirror matches if it has the same resolver and params, regardless of their
+// order. If no mirror matches, an error will be returned.
+func (c *PipelineMirrorCatalog) GetMirroredPipelineRef(pipelineRef *tektonv1.PipelineRef) (*tektonv1.PipelineRef, error) {
+	for _, mirror := range c.Pipelines {
+		if !mirror.matches(pipelineRef) {
+			continue
+		}
+
+		mirroredPipelineRef := &PipelineRef{
+			Resolver: "bundles",
+			Params: []Param{
+				{Name: "bundle", Value: mirror.Bundle},
+				{Name: "kind", Value: "pipeline"},
+				{Name: "name", Value: mirror.Name},
+			},
+		}
+
+		return mirroredPipelineRef.ToTektonPipelineRef(), nil
+	}
+
+	return nil, fmt.Errorf("the Pipeline using the %s resolver is not in the pipeline mirror catalog",
+		pipelineRef.Resolver)
+}
+
+// matches returns whether the source of the PipelineMirror is the same as the given PipelineRef.
+func (m *PipelineMirror) matches(pipelineRef *tektonv1.PipelineRef) bool {
+	if string(pipelineRef.Resolver) != m.Source.Resolver || len(pipelineRef.Params) != len(m.Source.Params) {
+		return false
+	}
+
+	params := map[string]string{}
+	for _, param := range m.Source.Params {
+		params[param.Name] = param.Value
+	}
+
+	for _, param := range pipelineRef.Params {
+		value, found := params[param.Name]
+		if !found || value != param.Value.StringVal {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+var _ = Describe("Pipeline mirror catalog", func() {
+	const catalogData = `
+pipelines:
+  - source:
+      resolver: git
+      params:
+        - name: url
+          value: https://github.com/konflux-ci/release-service-catalog.git
+        - name: revision
+          value: production
+        - name: pathInRepo
+          value: pipelines/push-to-external-registry/push-to-external-registry.yaml
+    bundle: registry.internal/release/push-to-external-registry@sha256:0123456789abcdef
+    name: push-to-external-registry
+`
+
+	When("NewPipelineMirrorCatalog is called", func() {
+		It("should parse the catalog", func() {
+			catalog, err := NewPipelineMirrorCatalog(catalogData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(catalog.Pipelines).To(HaveLen(1))
+			Expect(catalog.Pipelines[0].Source.Resolver).To(Equal("git"))
+			Expect(catalog.Pipelines[0].Name).To(Equal("push-to-external-registry"))
+		})
+
+		It("should fail if a bundle is not pinned by digest", func() {
+			_, err := NewPipelineMirrorCatalog(`{"pipelines": [{"bundle": "registry.internal/release/pipeline:latest", "name": "pipeline"}]}`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not pinned by digest"))
+		})
+
+		It("should fail if a pipeline name is missing", func() {
+			_, err := NewPipelineMirrorCatalog(`{"pipelines": [{"bundle": "registry.internal/release/pipeline@sha256:0123"}]}`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has no pipeline name"))
+		})
+
+		It("should fail if the data is malformed", func() {
+			_, err := NewPipelineMirrorCatalog(`pipelines: foo`)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("GetMirroredPipelineRef is called", func() {
+		var catalog *PipelineMirrorCatalog
+
+		BeforeEach(func() {
+			var err error
+			catalog, err = NewPipelineMirrorCatalog(catalogData)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return a bundles reference to the mirrored pipeline regardless of the params order", func() {
+			pipelineRef := &PipelineRef{
+				Resolver: "git",
+				Params: []Param{
+					{Name: "pathInRepo", Value: "pipelines/push-to-external-registry/push-to-external-registry.yaml"},
+					{Name: "revision", Value: "production"},
+					{Name: "url", Value: "https://github.com/konflux-ci/release-service-catalog.git"},
+				},
+			}
+
+			mirroredPipelineRef, err := catalog.GetMirroredPipelineRef(pipelineRef.ToTektonPipelineRef())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mirroredPipelineRef.Resolver).To(Equal(tektonv1.ResolverName("bundles")))
+			Expect(mirroredPipelineRef.Params).To(ContainElement(tektonv1.Param{
+				Name:  "bundle",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "registry.internal/release/push-to-external-registry@sha256:0123456789abcdef"},
+			}))
+			Expect(mirroredPipelineRef.Params).To(ContainElement(tektonv1.Param{
+				Name:  "name",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "push-to-external-registry"},
+			}))
+		})
+
+		It("should fail if the pipeline is not mirrored", func() {
+			pipelineRef := &PipelineRef{
+				Resolver: "git",
+				Params: []Param{
+					{Name: "pathInRepo", Value: "pipelines/push-to-external-registry/push-to-external-registry.yaml"},
+					{Name: "revision", Value: "development"},
+					{Name: "url", Value: "https://github.com/konflux-ci/release-service-catalog.git"},
+				},
+			}
+
+			_, err := catalog.GetMirroredPipelineRef(pipelineRef.ToTektonPipelineRef())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not in the pipeline mirror catalog"))
+		})
+	})
+})
@@ -0,0 +1,56 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// OverflowPathParamSuffix is the suffix of the name of the params carrying the path of the file holding the value of
+// a param that was too big to be passed inline
+const OverflowPathParamSuffix = "Path"
+
+// OverflowParams moves the values of the string params of the given PipelineRun bigger than maxSize out of it, so the
+// PipelineRun stays within safe sizes. Each oversized param is kept with an empty value and a new param named after it
+// with the OverflowPathParamSuffix suffix carries the path of the file holding its value, relative to the workspace
+// the returned values are mounted in. The returned map contains the values of the oversized params keyed by their
+// paths and is empty if no param is bigger than maxSize.
+func OverflowParams(pipelineRun *tektonv1.PipelineRun, maxSize int) map[string]string {
+	overflow := map[string]string{}
+
+	var pathParams []tektonv1.Param
+	for i := range pipelineRun.Spec.Params {
+		param := &pipelineRun.Spec.Params[i]
+		if param.Value.Type != tektonv1.ParamTypeString || len(param.Value.StringVal) <= maxSize {
+			continue
+		}
+
+		overflow[param.Name] = param.Value.StringVal
+		param.Value.StringVal = ""
+		pathParams = append(pathParams, tektonv1.Param{
+			Name: param.Name + OverflowPathParamSuffix,
+			Value: tektonv1.ParamValue{
+				Type:      tektonv1.ParamTypeString,
+				StringVal: param.Name,
+			},
+		})
+	}
+
+	pipelineRun.Spec.Params = append(pipelineRun.Spec.Params, pathParams...)
+
+	return overflow
+}
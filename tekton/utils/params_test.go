@@ -0,0 +1,76 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+var _ = Describe("Params", func() {
+	When("OverflowParams is called", func() {
+		var pipelineRun *tektonv1.PipelineRun
+
+		BeforeEach(func() {
+			pipelineRun = &tektonv1.PipelineRun{
+				Spec: tektonv1.PipelineRunSpec{
+					Params: []tektonv1.Param{
+						{
+							Name:  "small",
+							Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value"},
+						},
+						{
+							Name:  "big",
+							Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: strings.Repeat("a", 11)},
+						},
+						{
+							Name:  "array",
+							Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeArray, ArrayVal: []string{strings.Repeat("a", 11)}},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return no values if every param fits", func() {
+			Expect(OverflowParams(pipelineRun, 20)).To(BeEmpty())
+			Expect(pipelineRun.Spec.Params).To(HaveLen(3))
+		})
+
+		It("should move the values of the oversized string params out of the PipelineRun", func() {
+			overflow := OverflowParams(pipelineRun, 10)
+			Expect(overflow).To(Equal(map[string]string{"big": strings.Repeat("a", 11)}))
+			Expect(pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name:  "big",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: ""},
+			}))
+			Expect(pipelineRun.Spec.Params).To(ContainElement(HaveField("Value.StringVal", "value")))
+		})
+
+		It("should add a param with the path of the file holding each oversized value", func() {
+			OverflowParams(pipelineRun, 10)
+			Expect(pipelineRun.Spec.Params).To(HaveLen(4))
+			Expect(pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name:  "big" + OverflowPathParamSuffix,
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "big"},
+			}))
+		})
+	})
+})
@@ -16,7 +16,18 @@ limitations under the License.
 
 package utils
 
-import tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// revisionParamName is the name of the resolver parameter used to specify the revision of the Pipeline
+const revisionParamName = "revision"
 
 // Param defines the parameters for a given resolver in PipelineRef
 type Param struct {
@@ -30,6 +41,11 @@ type Param struct {
 // PipelineRef represents a reference to a Pipeline using a resolver.
 // +kubebuilder:object:generate=true
 type PipelineRef struct {
+	// Bundle is the reference to the Pipeline in the deprecated <bundle>#<pipeline name> format. It's rewritten into a
+	// reference using the bundles resolver when the resource is admitted
+	// +optional
+	Bundle string `json:"bundle,omitempty"`
+
 	// Resolver is the name of a Tekton resolver to be used (e.g. git)
 	Resolver string `json:"resolver"`
 
@@ -43,6 +59,19 @@ type Pipeline struct {
 	// PipelineRef is the reference to the Pipeline
 	PipelineRef PipelineRef `json:"pipelineRef"`
 
+	// ResourceRequests declares the compute resources the Pipeline needs to run. Managed Pipelines declaring them are
+	// only started once the ResourceQuotas and LimitRanges of the managed namespace have room for them
+	// +optional
+	ResourceRequests corev1.ResourceList `json:"resourceRequests,omitempty"`
+
+	// Revision pins the Pipeline to the given revision, overriding the revision param of the PipelineRef if any
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// Rollout defines a revision of the Pipeline to be used only by a subset of the ReleasePlans
+	// +optional
+	Rollout *PipelineRollout `json:"rollout,omitempty"`
+
 	// ServiceAccountName is the ServiceAccount to use during the execution of the Pipeline
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
@@ -53,6 +82,27 @@ type Pipeline struct {
 	Timeouts tektonv1.TimeoutFields `json:"timeouts,omitempty"`
 }
 
+// PipelineRollout defines a revision of a Pipeline that is only used for a subset of the ReleasePlans, so a new
+// version of the Pipeline can be tested on some of them before switching everyone.
+// +kubebuilder:object:generate=true
+type PipelineRollout struct {
+	// Revision is the revision of the Pipeline being rolled out
+	// +required
+	Revision string `json:"revision"`
+
+	// Percentage is the percentage of ReleasePlans using the revision being rolled out. ReleasePlans are selected
+	// based on their namespaced name, so the same ReleasePlans are selected as long as the percentage doesn't change
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage int `json:"percentage,omitempty"`
+
+	// ReleasePlans is a list of ReleasePlans using the revision being rolled out regardless of the percentage. Each
+	// entry can be either the name of a ReleasePlan or its namespaced name in the namespace/name format
+	// +optional
+	ReleasePlans []string `json:"releasePlans,omitempty"`
+}
+
 // ParameterizedPipeline is an extension of the Pipeline struct, adding an array of parameters that will be passed to
 // the Pipeline.
 // +kubebuilder:object:generate=true
@@ -64,6 +114,34 @@ type ParameterizedPipeline struct {
 	Params []Param `json:"params,omitempty"`
 }
 
+// NormalizeBundle rewrites the deprecated bundle reference of the PipelineRef, if any, into a reference using the
+// bundles resolver and clears it. The returned boolean indicates whether the PipelineRef was rewritten. An error is
+// returned if the bundle reference is malformed or if the PipelineRef also sets a resolver.
+func (pr *PipelineRef) NormalizeBundle() (bool, error) {
+	if pr.Bundle == "" {
+		return false, nil
+	}
+
+	if pr.Resolver != "" {
+		return false, fmt.Errorf("the bundle field is deprecated and can't be combined with the %s resolver", pr.Resolver)
+	}
+
+	bundle, name, found := strings.Cut(pr.Bundle, "#")
+	if !found || bundle == "" || name == "" {
+		return false, fmt.Errorf("bundle reference %s is not in the <bundle>#<pipeline name> format", pr.Bundle)
+	}
+
+	pr.Bundle = ""
+	pr.Resolver = "bundles"
+	pr.Params = []Param{
+		{Name: "bundle", Value: bundle},
+		{Name: "kind", Value: "pipeline"},
+		{Name: "name", Value: name},
+	}
+
+	return true, nil
+}
+
 // ToTektonPipelineRef converts a PipelineRef object to Tekton's own PipelineRef type and returns it.
 func (pr *PipelineRef) ToTektonPipelineRef() *tektonv1.PipelineRef {
 	params := tektonv1.Params{}
@@ -88,6 +166,64 @@ func (pr *PipelineRef) ToTektonPipelineRef() *tektonv1.PipelineRef {
 	return tektonPipelineRef
 }
 
+// GetTektonPipelineRef returns the PipelineRef of the Pipeline as Tekton's own PipelineRef type, setting its revision
+// param to the revision the given ReleasePlan should use. The revision being rolled out is used if the ReleasePlan is
+// selected by the rollout. Otherwise, the pinned revision is used if set. If neither applies, the PipelineRef is
+// returned untouched.
+func (p *Pipeline) GetTektonPipelineRef(namespace, releasePlan string) *tektonv1.PipelineRef {
+	tektonPipelineRef := p.PipelineRef.ToTektonPipelineRef()
+
+	revision := p.GetRevision(namespace, releasePlan)
+	if revision == "" {
+		return tektonPipelineRef
+	}
+
+	for i := range tektonPipelineRef.Params {
+		if tektonPipelineRef.Params[i].Name == revisionParamName {
+			tektonPipelineRef.Params[i].Value.StringVal = revision
+			return tektonPipelineRef
+		}
+	}
+
+	tektonPipelineRef.Params = append(tektonPipelineRef.Params, tektonv1.Param{
+		Name: revisionParamName,
+		Value: tektonv1.ParamValue{
+			Type:      tektonv1.ParamTypeString,
+			StringVal: revision,
+		},
+	})
+
+	return tektonPipelineRef
+}
+
+// GetRevision returns the revision of the Pipeline the given ReleasePlan should use. An empty string is returned if
+// the Pipeline is neither pinned nor being rolled out to the ReleasePlan.
+func (p *Pipeline) GetRevision(namespace, releasePlan string) string {
+	if p.Rollout != nil && p.Rollout.Includes(namespace, releasePlan) {
+		return p.Rollout.Revision
+	}
+
+	return p.Revision
+}
+
+// Includes returns whether the given ReleasePlan is selected by the rollout, either because it's explicitly listed or
+// because it falls within the rollout percentage.
+func (r *PipelineRollout) Includes(namespace, releasePlan string) bool {
+	namespacedName := fmt.Sprintf("%s/%s", namespace, releasePlan)
+	if slices.Contains(r.ReleasePlans, releasePlan) || slices.Contains(r.ReleasePlans, namespacedName) {
+		return true
+	}
+
+	if r.Percentage <= 0 {
+		return false
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(namespacedName))
+
+	return int(hash.Sum32()%100) < r.Percentage
+}
+
 // GetTektonParams returns the ParameterizedPipeline []Param as []tektonv1.Param.
 func (prp *ParameterizedPipeline) GetTektonParams() []tektonv1.Param {
 	params := []tektonv1.Param{}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	libhandler "github.com/operator-framework/operator-lib/handler"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -91,6 +92,37 @@ func (b *PipelineRunBuilder) WithLabels(labels map[string]string) *PipelineRunBu
 	return b
 }
 
+// WithNodeAntiAffinity sets a node affinity in the PipelineRun's TaskRunTemplate keeping the Pods of its tasks away
+// from the nodes with the given names. If no names are passed, the PipelineRun is not modified.
+func (b *PipelineRunBuilder) WithNodeAntiAffinity(nodes []string) *PipelineRunBuilder {
+	if len(nodes) == 0 {
+		return b
+	}
+
+	if b.pipelineRun.Spec.TaskRunTemplate.PodTemplate == nil {
+		b.pipelineRun.Spec.TaskRunTemplate.PodTemplate = &pod.PodTemplate{}
+	}
+	b.pipelineRun.Spec.TaskRunTemplate.PodTemplate.Affinity = &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchFields: []corev1.NodeSelectorRequirement{
+							{
+								Key:      "metadata.name",
+								Operator: corev1.NodeSelectorOpNotIn,
+								Values:   nodes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return b
+}
+
 // WithObjectReferences constructs tektonv1.Param entries for each of the provided client.Objects.
 // Each param name is derived from the object's Kind (with the first letter made lowercase) and
 // the value is a combination of the object's Namespace and Name.
@@ -239,6 +271,62 @@ func (b *PipelineRunBuilder) WithTimeouts(timeouts, defaultTimeouts *tektonv1.Ti
 	return b
 }
 
+// WithWorkspaceFromConfigMap creates and adds a workspace binding to the PipelineRun's spec using the provided
+// workspace name and mounting the ConfigMap with the given name.
+func (b *PipelineRunBuilder) WithWorkspaceFromConfigMap(name, configMapName string) *PipelineRunBuilder {
+	if b.pipelineRun.Spec.Workspaces == nil {
+		b.pipelineRun.Spec.Workspaces = []tektonv1.WorkspaceBinding{}
+	}
+
+	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, tektonv1.WorkspaceBinding{
+		Name: name,
+		ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: configMapName,
+			},
+		},
+	})
+
+	return b
+}
+
+// WithWorkspaceFromSecret creates and adds a workspace binding to the PipelineRun's spec using the provided workspace
+// name and mounting the Secret with the given name.
+func (b *PipelineRunBuilder) WithWorkspaceFromSecret(name, secretName string) *PipelineRunBuilder {
+	if b.pipelineRun.Spec.Workspaces == nil {
+		b.pipelineRun.Spec.Workspaces = []tektonv1.WorkspaceBinding{}
+	}
+
+	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, tektonv1.WorkspaceBinding{
+		Name: name,
+		Secret: &corev1.SecretVolumeSource{
+			SecretName: secretName,
+		},
+	})
+
+	return b
+}
+
+// WithWorkspaceLabels adds the given labels to the volume claim templates of the workspaces added to the PipelineRun's
+// spec so far, so they are set on the PersistentVolumeClaims Tekton creates from them.
+func (b *PipelineRunBuilder) WithWorkspaceLabels(labels map[string]string) *PipelineRunBuilder {
+	for i := range b.pipelineRun.Spec.Workspaces {
+		template := b.pipelineRun.Spec.Workspaces[i].VolumeClaimTemplate
+		if template == nil {
+			continue
+		}
+
+		if template.Labels == nil {
+			template.Labels = make(map[string]string)
+		}
+		for key, value := range labels {
+			template.Labels[key] = value
+		}
+	}
+
+	return b
+}
+
 // WithWorkspaceFromVolumeTemplate creates and adds a workspace binding to the PipelineRun's spec using
 // the provided workspace name and volume size.
 func (b *PipelineRunBuilder) WithWorkspaceFromVolumeTemplate(name, size string) *PipelineRunBuilder {
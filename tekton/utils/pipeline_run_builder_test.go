@@ -355,6 +355,28 @@ var _ = Describe("PipelineRun builder", func() {
 		})
 	})
 
+	When("WithNodeAntiAffinity method is called", func() {
+		It("should keep the Pods of the tasks away from the given nodes", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithNodeAntiAffinity([]string{"node-a", "node-b"})
+			podTemplate := builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate
+			Expect(podTemplate).NotTo(BeNil())
+			terms := podTemplate.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].MatchFields).To(ContainElement(corev1.NodeSelectorRequirement{
+				Key:      "metadata.name",
+				Operator: corev1.NodeSelectorOpNotIn,
+				Values:   []string{"node-a", "node-b"},
+			}))
+		})
+
+		It("should not set a Pod template if no nodes are given", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithNodeAntiAffinity(nil)
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).To(BeNil())
+		})
+	})
+
 	When("WithServiceAccount method is called", func() {
 		It("should set the ServiceAccountName for the PipelineRun's TaskRunTemplate", func() {
 			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
@@ -388,6 +410,30 @@ var _ = Describe("PipelineRun builder", func() {
 		})
 	})
 
+	When("WithWorkspaceFromConfigMap method is called", func() {
+		It("should add a new workspace binding to the PipelineRun's spec mounting the given ConfigMap", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithWorkspaceFromConfigMap("sampleWorkspace", "sampleConfigMap")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(1))
+
+			workspaceBinding := builder.pipelineRun.Spec.Workspaces[0]
+			Expect(workspaceBinding.Name).To(Equal("sampleWorkspace"))
+			Expect(workspaceBinding.ConfigMap.Name).To(Equal("sampleConfigMap"))
+		})
+	})
+
+	When("WithWorkspaceFromSecret method is called", func() {
+		It("should add a new workspace binding to the PipelineRun's spec mounting the given Secret", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithWorkspaceFromSecret("sampleWorkspace", "sampleSecret")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(1))
+
+			workspaceBinding := builder.pipelineRun.Spec.Workspaces[0]
+			Expect(workspaceBinding.Name).To(Equal("sampleWorkspace"))
+			Expect(workspaceBinding.Secret.SecretName).To(Equal("sampleSecret"))
+		})
+	})
+
 	When("WithWorkspaceFromVolumeTemplate method is called", func() {
 		var (
 			builder *PipelineRunBuilder
@@ -417,4 +463,17 @@ var _ = Describe("PipelineRun builder", func() {
 			Expect(err.Error()).To(ContainSubstring("invalid size format"))
 		})
 	})
+
+	When("WithWorkspaceLabels method is called", func() {
+		It("should add the labels to the volume claim templates of the workspaces", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithWorkspaceFromVolumeTemplate("volume", "1Gi").
+				WithWorkspaceFromSecret("secret", "secretName").
+				WithWorkspaceLabels(map[string]string{"foo": "bar"})
+
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(2))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].VolumeClaimTemplate.Labels).To(HaveKeyWithValue("foo", "bar"))
+			Expect(builder.pipelineRun.Spec.Workspaces[1].VolumeClaimTemplate).To(BeNil())
+		})
+	})
 })
@@ -22,6 +22,7 @@ import (
 
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 
+	"fmt"
 	"reflect"
 )
 
@@ -59,6 +60,45 @@ var _ = Describe("Pipeline", func() {
 		}
 	})
 
+	When("NormalizeBundle method is called", func() {
+		It("should do nothing if the PipelineRef has no bundle reference", func() {
+			ref := gitRef
+			normalized, err := ref.NormalizeBundle()
+			Expect(normalized).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref).To(Equal(gitRef))
+		})
+
+		It("should rewrite the bundle reference into a reference using the bundles resolver", func() {
+			ref := PipelineRef{Bundle: "quay.io/org/bundle@sha256:abc#my-pipeline"}
+			normalized, err := ref.NormalizeBundle()
+			Expect(normalized).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref.Bundle).To(BeEmpty())
+			Expect(ref.Resolver).To(Equal("bundles"))
+			Expect(ref.Params).To(Equal([]Param{
+				{Name: "bundle", Value: "quay.io/org/bundle@sha256:abc"},
+				{Name: "kind", Value: "pipeline"},
+				{Name: "name", Value: "my-pipeline"},
+			}))
+		})
+
+		It("should fail if the bundle reference has no pipeline name", func() {
+			ref := PipelineRef{Bundle: "quay.io/org/bundle:latest"}
+			_, err := ref.NormalizeBundle()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not in the <bundle>#<pipeline name> format"))
+		})
+
+		It("should fail if the PipelineRef also sets a resolver", func() {
+			ref := gitRef
+			ref.Bundle = "quay.io/org/bundle:latest#my-pipeline"
+			_, err := ref.NormalizeBundle()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't be combined with the git resolver"))
+		})
+	})
+
 	When("ToTektonPipelineRef method is called", func() {
 		It("should return Tekton PipelineRef representation of the PipelineRef", func() {
 			ref := clusterRef.ToTektonPipelineRef()
@@ -112,6 +152,107 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	When("GetTektonPipelineRef method is called", func() {
+		It("should return the PipelineRef untouched if the Pipeline is neither pinned nor rolled out", func() {
+			pipeline := Pipeline{PipelineRef: gitRef}
+			Expect(pipeline.GetTektonPipelineRef("namespace", "release-plan")).To(Equal(gitRef.ToTektonPipelineRef()))
+		})
+
+		It("should override the revision param with the pinned revision", func() {
+			pipeline := Pipeline{PipelineRef: gitRef, Revision: "pinned-revision"}
+			tektonPipelineRef := pipeline.GetTektonPipelineRef("namespace", "release-plan")
+			Expect(tektonPipelineRef.Params).To(HaveLen(3))
+			Expect(tektonPipelineRef.Params[1].Name).To(Equal("revision"))
+			Expect(tektonPipelineRef.Params[1].Value.StringVal).To(Equal("pinned-revision"))
+		})
+
+		It("should add the revision param if the PipelineRef doesn't have one", func() {
+			pipeline := Pipeline{PipelineRef: bundleRef, Revision: "pinned-revision"}
+			tektonPipelineRef := pipeline.GetTektonPipelineRef("namespace", "release-plan")
+			Expect(tektonPipelineRef.Params).To(HaveLen(4))
+			Expect(tektonPipelineRef.Params[3].Name).To(Equal("revision"))
+			Expect(tektonPipelineRef.Params[3].Value.StringVal).To(Equal("pinned-revision"))
+		})
+
+		It("should use the revision being rolled out if the ReleasePlan is selected", func() {
+			pipeline := Pipeline{
+				PipelineRef: gitRef,
+				Revision:    "pinned-revision",
+				Rollout: &PipelineRollout{
+					Revision:     "new-revision",
+					ReleasePlans: []string{"release-plan"},
+				},
+			}
+			tektonPipelineRef := pipeline.GetTektonPipelineRef("namespace", "release-plan")
+			Expect(tektonPipelineRef.Params[1].Value.StringVal).To(Equal("new-revision"))
+		})
+	})
+
+	When("GetRevision method is called", func() {
+		It("should return an empty string if the Pipeline is neither pinned nor rolled out", func() {
+			pipeline := Pipeline{PipelineRef: gitRef}
+			Expect(pipeline.GetRevision("namespace", "release-plan")).To(BeEmpty())
+		})
+
+		It("should return the pinned revision if the ReleasePlan is not selected by the rollout", func() {
+			pipeline := Pipeline{
+				PipelineRef: gitRef,
+				Revision:    "pinned-revision",
+				Rollout:     &PipelineRollout{Revision: "new-revision"},
+			}
+			Expect(pipeline.GetRevision("namespace", "release-plan")).To(Equal("pinned-revision"))
+		})
+
+		It("should return the revision being rolled out if the ReleasePlan is selected by the rollout", func() {
+			pipeline := Pipeline{
+				PipelineRef: gitRef,
+				Revision:    "pinned-revision",
+				Rollout:     &PipelineRollout{Revision: "new-revision", Percentage: 100},
+			}
+			Expect(pipeline.GetRevision("namespace", "release-plan")).To(Equal("new-revision"))
+		})
+	})
+
+	When("Includes method is called", func() {
+		It("should return true for ReleasePlans listed by name", func() {
+			rollout := PipelineRollout{Revision: "new-revision", ReleasePlans: []string{"release-plan"}}
+			Expect(rollout.Includes("namespace", "release-plan")).To(BeTrue())
+		})
+
+		It("should return true for ReleasePlans listed by namespaced name", func() {
+			rollout := PipelineRollout{Revision: "new-revision", ReleasePlans: []string{"namespace/release-plan"}}
+			Expect(rollout.Includes("namespace", "release-plan")).To(BeTrue())
+			Expect(rollout.Includes("other-namespace", "release-plan")).To(BeFalse())
+		})
+
+		It("should return false for unlisted ReleasePlans if the percentage is not set", func() {
+			rollout := PipelineRollout{Revision: "new-revision"}
+			Expect(rollout.Includes("namespace", "release-plan")).To(BeFalse())
+		})
+
+		It("should return true for every ReleasePlan if the percentage is 100", func() {
+			rollout := PipelineRollout{Revision: "new-revision", Percentage: 100}
+			for i := 0; i < 10; i++ {
+				Expect(rollout.Includes("namespace", fmt.Sprintf("release-plan-%d", i))).To(BeTrue())
+			}
+		})
+
+		It("should select a stable subset of the ReleasePlans", func() {
+			rollout := PipelineRollout{Revision: "new-revision", Percentage: 50}
+			selected := 0
+			for i := 0; i < 100; i++ {
+				releasePlan := fmt.Sprintf("release-plan-%d", i)
+				included := rollout.Includes("namespace", releasePlan)
+				Expect(rollout.Includes("namespace", releasePlan)).To(Equal(included))
+				if included {
+					selected++
+				}
+			}
+			Expect(selected).To(BeNumerically(">", 0))
+			Expect(selected).To(BeNumerically("<", 100))
+		})
+	})
+
 	When("IsClusterScoped method is called", func() {
 		It("should return true for a cluster pipeline", func() {
 			Expect(clusterRef.IsClusterScoped()).To(BeTrue())
@@ -20,7 +20,9 @@ limitations under the License.
 
 package utils
 
-import ()
+import (
+	"k8s.io/api/core/v1"
+)
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterizedPipeline) DeepCopyInto(out *ParameterizedPipeline) {
@@ -47,6 +49,18 @@ func (in *ParameterizedPipeline) DeepCopy() *ParameterizedPipeline {
 func (in *Pipeline) DeepCopyInto(out *Pipeline) {
 	*out = *in
 	in.PipelineRef.DeepCopyInto(&out.PipelineRef)
+	if in.ResourceRequests != nil {
+		in, out := &in.ResourceRequests, &out.ResourceRequests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(PipelineRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Timeouts.DeepCopyInto(&out.Timeouts)
 }
 
@@ -79,3 +93,23 @@ func (in *PipelineRef) DeepCopy() *PipelineRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRollout) DeepCopyInto(out *PipelineRollout) {
+	*out = *in
+	if in.ReleasePlans != nil {
+		in, out := &in.ReleasePlans, &out.ReleasePlans
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRollout.
+func (in *PipelineRollout) DeepCopy() *PipelineRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRollout)
+	in.DeepCopyInto(out)
+	return out
+}
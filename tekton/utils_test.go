@@ -17,10 +17,19 @@ limitations under the License.
 package tekton
 
 import (
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1/reasons"
 	"github.com/konflux-ci/release-service/metadata"
 	"github.com/konflux-ci/release-service/tekton/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
 )
 
 var _ = Describe("Utils", Ordered, func() {
@@ -46,6 +55,14 @@ var _ = Describe("Utils", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
 		})
+
+		It("should return true when the PipelineRun is of type 'verification'", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.VerificationPipelineType}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
+		})
 	})
 
 	When("hasPipelineSucceeded is called", func() {
@@ -62,4 +79,254 @@ var _ = Describe("Utils", Ordered, func() {
 			Expect(hasPipelineSucceeded(pipelineRun)).To(BeTrue())
 		})
 	})
+
+	When("GetComponentResults is called", func() {
+		var pipelineRun *tektonv1.PipelineRun
+
+		BeforeEach(func() {
+			var err error
+			pipelineRun, err = utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		setComponentResults := func(value string) {
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{Name: ComponentResultsResultName, Value: *tektonv1.NewStructuredValues(value)},
+			}
+		}
+
+		It("should return nil if the PipelineRun doesn't report component results", func() {
+			components, err := GetComponentResults(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(components).To(BeNil())
+		})
+
+		It("should return the reported component results", func() {
+			setComponentResults(`[{"name": "foo", "phase": "Succeeded"}, {"name": "bar", "phase": "Failed", "message": "push failed"}]`)
+
+			components, err := GetComponentResults(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(components).To(Equal([]v1alpha1.ComponentStatus{
+				{Name: "foo", Phase: v1alpha1.ComponentPhaseSucceeded},
+				{Name: "bar", Phase: v1alpha1.ComponentPhaseFailed, Message: "push failed"},
+			}))
+		})
+
+		It("should fail if the result can't be parsed", func() {
+			setComponentResults(`{"name": "foo"}`)
+
+			_, err := GetComponentResults(pipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if a component has no name", func() {
+			setComponentResults(`[{"phase": "Succeeded"}]`)
+
+			_, err := GetComponentResults(pipelineRun)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("without name"))
+		})
+
+		It("should fail if a component has an unknown phase", func() {
+			setComponentResults(`[{"name": "foo", "phase": "Skipped"}]`)
+
+			_, err := GetComponentResults(pipelineRun)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown phase 'Skipped'"))
+		})
+	})
+
+	When("GetLastProgressTime is called", func() {
+		var taskRun *tektonv1.TaskRun
+
+		BeforeEach(func() {
+			taskRun = &tektonv1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "last-progress-taskrun",
+					Namespace: "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, taskRun)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, taskRun)).To(Succeed())
+		})
+
+		It("should return the creation time when the PipelineRun has no status", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+
+			lastProgressTime, err := GetLastProgressTime(ctx, k8sClient, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastProgressTime).To(Equal(pipelineRun.CreationTimestamp.Time))
+		})
+
+		It("should return the most recent condition transition time", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			pipelineRun.Status.StartTime = &metav1.Time{Time: time.Now().Add(-30 * time.Minute)}
+			transitionTime := time.Now().Add(-10 * time.Minute)
+			pipelineRun.Status.SetCondition(&apis.Condition{
+				Type:               apis.ConditionSucceeded,
+				Status:             "Unknown",
+				LastTransitionTime: apis.VolatileTime{Inner: metav1.NewTime(transitionTime)},
+			})
+
+			lastProgressTime, err := GetLastProgressTime(ctx, k8sClient, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastProgressTime).To(BeTemporally("~", transitionTime, time.Second))
+		})
+
+		It("should return the most recent step finish time of the TaskRuns", func() {
+			finishTime := time.Now().Add(-5 * time.Minute)
+			taskRun.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			taskRun.Status.Steps = []tektonv1.StepState{
+				{
+					ContainerState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(finishTime)},
+					},
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, taskRun)).To(Succeed())
+
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{TypeMeta: runtime.TypeMeta{Kind: "TaskRun"}, Name: taskRun.Name},
+			}
+
+			lastProgressTime, err := GetLastProgressTime(ctx, k8sClient, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastProgressTime).To(BeTemporally("~", finishTime, time.Second))
+		})
+
+		It("should return the start time of the running step rather than the current time", func() {
+			stepStartTime := time.Now().Add(-20 * time.Minute)
+			taskRun.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			taskRun.Status.SetCondition(&apis.Condition{
+				Type:               apis.ConditionSucceeded,
+				Status:             corev1.ConditionUnknown,
+				Reason:             tektonv1.TaskRunReasonRunning.String(),
+				LastTransitionTime: apis.VolatileTime{Inner: metav1.NewTime(time.Now().Add(-time.Hour))},
+			})
+			taskRun.Status.Steps = []tektonv1.StepState{
+				{
+					ContainerState: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(stepStartTime)},
+					},
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, taskRun)).To(Succeed())
+
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{TypeMeta: runtime.TypeMeta{Kind: "TaskRun"}, Name: taskRun.Name},
+			}
+
+			lastProgressTime, err := GetLastProgressTime(ctx, k8sClient, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastProgressTime).To(BeTemporally("~", stepStartTime, time.Second))
+		})
+
+		It("should fail if a TaskRun can't be found", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{TypeMeta: runtime.TypeMeta{Kind: "TaskRun"}, Name: "missing"},
+			}
+
+			_, err = GetLastProgressTime(ctx, k8sClient, pipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("IsPipelineRunStalled is called", func() {
+		It("should return false when the PipelineRun is done", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			pipelineRun.Status.MarkSucceeded("", "")
+			Expect(IsPipelineRunStalled(ctx, k8sClient, pipelineRun, time.Minute)).To(BeFalse())
+		})
+
+		It("should return false when the timeout is not positive", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			Expect(IsPipelineRunStalled(ctx, k8sClient, pipelineRun, 0)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun progressed within the timeout", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now())
+			Expect(IsPipelineRunStalled(ctx, k8sClient, pipelineRun, time.Hour)).To(BeFalse())
+		})
+
+		It("should return true when the PipelineRun hasn't progressed within the timeout", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			Expect(IsPipelineRunStalled(ctx, k8sClient, pipelineRun, time.Minute)).To(BeTrue())
+		})
+
+		It("should return true when a running task hasn't progressed within the timeout", func() {
+			taskRun := &tektonv1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "hung-taskrun",
+					Namespace: "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, taskRun)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, taskRun)).To(Succeed())
+			}()
+			taskRun.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			taskRun.Status.SetCondition(&apis.Condition{
+				Type:               apis.ConditionSucceeded,
+				Status:             corev1.ConditionUnknown,
+				Reason:             tektonv1.TaskRunReasonRunning.String(),
+				LastTransitionTime: apis.VolatileTime{Inner: metav1.NewTime(time.Now().Add(-time.Hour))},
+			})
+			Expect(k8sClient.Status().Update(ctx, taskRun)).To(Succeed())
+
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			pipelineRun.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+				{TypeMeta: runtime.TypeMeta{Kind: "TaskRun"}, Name: taskRun.Name},
+			}
+			Expect(IsPipelineRunStalled(ctx, k8sClient, pipelineRun, time.Minute)).To(BeTrue())
+		})
+	})
+
+	When("GetPipelineRunFailureReason is called", func() {
+		It("should return the Timeout reason when the PipelineRun timed out", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonTimedOut.String(), "timed out")
+			Expect(GetPipelineRunFailureReason(pipelineRun)).To(Equal(reasons.Timeout))
+		})
+
+		It("should return the PipelineResolutionFailed reason when the Pipeline couldn't be resolved", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonCouldntGetPipeline.String(), "not found")
+			Expect(GetPipelineRunFailureReason(pipelineRun)).To(Equal(reasons.PipelineResolutionFailed))
+		})
+
+		It("should return the PipelineFailed reason for any other failure", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonFailed.String(), "failed")
+			Expect(GetPipelineRunFailureReason(pipelineRun)).To(Equal(reasons.PipelineFailed))
+		})
+	})
 })
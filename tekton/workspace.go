@@ -0,0 +1,76 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WorkspaceUsageResultName is the name of the task result reporting the usage percentage of the release workspace.
+const WorkspaceUsageResultName = "workspaceUsage"
+
+// GetWorkspaceUsage returns the usage percentage of the release workspace reported in the workspaceUsage result of the
+// most recently completed TaskRun of the given PipelineRun, along with the name of that TaskRun. If no TaskRun reports
+// it, an empty name is returned. Results that are not a percentage are ignored. An error is returned if a TaskRun
+// can't be found.
+func GetWorkspaceUsage(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun) (int, string, error) {
+	var latestTaskRun *tektonv1.TaskRun
+	var usage int
+
+	for _, childReference := range pipelineRun.Status.ChildReferences {
+		if childReference.Kind != "TaskRun" {
+			continue
+		}
+
+		taskRun := &tektonv1.TaskRun{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: pipelineRun.Namespace, Name: childReference.Name}, taskRun)
+		if err != nil {
+			return 0, "", err
+		}
+
+		if taskRun.Status.CompletionTime == nil {
+			continue
+		}
+		if latestTaskRun != nil && !taskRun.Status.CompletionTime.After(latestTaskRun.Status.CompletionTime.Time) {
+			continue
+		}
+
+		for _, result := range taskRun.Status.Results {
+			if result.Name != WorkspaceUsageResultName {
+				continue
+			}
+
+			value, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(result.Value.StringVal), "%"))
+			if err != nil || value < 0 || value > 100 {
+				continue
+			}
+			latestTaskRun = taskRun
+			usage = value
+		}
+	}
+
+	if latestTaskRun == nil {
+		return 0, "", nil
+	}
+
+	return usage, latestTaskRun.Name, nil
+}
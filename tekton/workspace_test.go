@@ -0,0 +1,103 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("Workspace", Ordered, func() {
+	var earlierTaskRun, laterTaskRun *tektonv1.TaskRun
+	var pipelineRun *tektonv1.PipelineRun
+
+	createTaskRun := func(name, usage string, completionTime time.Time) *tektonv1.TaskRun {
+		taskRun := &tektonv1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, taskRun)).To(Succeed())
+		taskRun.Status.CompletionTime = &metav1.Time{Time: completionTime}
+		taskRun.Status.Results = []tektonv1.TaskRunResult{
+			{
+				Name:  WorkspaceUsageResultName,
+				Value: *tektonv1.NewStructuredValues(usage),
+			},
+		}
+		Expect(k8sClient.Status().Update(ctx, taskRun)).To(Succeed())
+
+		return taskRun
+	}
+
+	BeforeAll(func() {
+		earlierTaskRun = createTaskRun("earlier-taskrun", "90", time.Now().Add(-time.Hour))
+		laterTaskRun = createTaskRun("later-taskrun", "40%", time.Now())
+
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "workspace-pipeline-run",
+				Namespace: "default",
+			},
+		}
+		pipelineRun.Status.ChildReferences = []tektonv1.ChildStatusReference{
+			{
+				TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+				Name:     earlierTaskRun.Name,
+			},
+			{
+				TypeMeta: runtime.TypeMeta{Kind: "TaskRun"},
+				Name:     laterTaskRun.Name,
+			},
+		}
+	})
+
+	AfterAll(func() {
+		Expect(k8sClient.Delete(ctx, earlierTaskRun)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, laterTaskRun)).To(Succeed())
+	})
+
+	When("GetWorkspaceUsage is called", func() {
+		It("should return the usage reported by the most recently completed TaskRun", func() {
+			usage, taskRun, err := GetWorkspaceUsage(ctx, k8sClient, pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(40))
+			Expect(taskRun).To(Equal(laterTaskRun.Name))
+		})
+
+		It("should return an empty TaskRun name if no TaskRun reports the usage", func() {
+			usage, taskRun, err := GetWorkspaceUsage(ctx, k8sClient, &tektonv1.PipelineRun{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(BeZero())
+			Expect(taskRun).To(BeEmpty())
+		})
+
+		It("should fail if a TaskRun can't be found", func() {
+			missingPipelineRun := pipelineRun.DeepCopy()
+			missingPipelineRun.Status.ChildReferences[0].Name = "missing"
+			_, _, err := GetWorkspaceUsage(ctx, k8sClient, missingPipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
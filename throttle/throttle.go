@@ -0,0 +1,120 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultInterval is the minimum time between two throttled updates of the status of an object used when the
+// ReleaseServiceConfig doesn't define one.
+const DefaultInterval = 30 * time.Second
+
+// GetInterval returns the minimum time between two throttled status updates the given ReleaseServiceConfig defines.
+// If the config is nil or doesn't define one, DefaultInterval is returned.
+func GetInterval(releaseServiceConfig *v1alpha1.ReleaseServiceConfig) time.Duration {
+	if releaseServiceConfig != nil && releaseServiceConfig.Spec.StatusUpdateInterval != nil {
+		return releaseServiceConfig.Spec.StatusUpdateInterval.Duration
+	}
+
+	return DefaultInterval
+}
+
+// LoadInterval returns the minimum time between two throttled status updates the ReleaseServiceConfig of the namespace
+// set in the SERVICE_NAMESPACE environment variable defines. If the config can't be loaded, DefaultInterval is
+// returned, so status updates are never blocked by the config.
+func LoadInterval(ctx context.Context, cli client.Client, objectLoader loader.ObjectLoader) time.Duration {
+	releaseServiceConfig, err := objectLoader.GetReleaseServiceConfig(ctx, cli,
+		v1alpha1.ReleaseServiceConfigResourceName, os.Getenv("SERVICE_NAMESPACE"))
+	if err != nil {
+		return DefaultInterval
+	}
+
+	return GetInterval(releaseServiceConfig)
+}
+
+// Throttle tracks the last status update of each object to limit the updates that can be deferred, like progress
+// reports, to one per interval. It's safe for concurrent use, so a single Throttle can be shared by all the reconciles
+// of a controller. A nil Throttle never defers updates.
+type Throttle struct {
+	lastUpdates map[string]time.Time
+	mutex       sync.Mutex
+	now         func() time.Time
+}
+
+// New creates and returns a new Throttle.
+func New() *Throttle {
+	return &Throttle{
+		lastUpdates: make(map[string]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Delay returns how long the status update of the object with the given key has to be deferred so it happens at least
+// the given interval after the previous one. A zero delay means the update can be sent right away.
+func (t *Throttle) Delay(key string, interval time.Duration) time.Duration {
+	if t == nil {
+		return 0
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	lastUpdate, found := t.lastUpdates[key]
+	if !found {
+		return 0
+	}
+
+	if delay := lastUpdate.Add(interval).Sub(t.now()); delay > 0 {
+		return delay
+	}
+
+	return 0
+}
+
+// Record records a status update of the object with the given key, so the next update that can be deferred waits for a
+// whole interval.
+func (t *Throttle) Record(key string) {
+	if t == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.lastUpdates[key] = t.now()
+}
+
+// Forget forgets the last status update of the object with the given key. It should be called once the object is
+// deleted.
+func (t *Throttle) Forget(key string) {
+	if t == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	delete(t.lastUpdates, key)
+}
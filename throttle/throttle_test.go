@@ -0,0 +1,88 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Throttle", func() {
+	var (
+		now      time.Time
+		throttle *Throttle
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		throttle = New()
+		throttle.now = func() time.Time { return now }
+	})
+
+	When("GetInterval is called", func() {
+		It("should return the default interval if no config is passed", func() {
+			Expect(GetInterval(nil)).To(Equal(DefaultInterval))
+		})
+
+		It("should return the default interval if the config doesn't define one", func() {
+			Expect(GetInterval(&v1alpha1.ReleaseServiceConfig{})).To(Equal(DefaultInterval))
+		})
+
+		It("should return the interval defined in the config", func() {
+			releaseServiceConfig := &v1alpha1.ReleaseServiceConfig{
+				Spec: v1alpha1.ReleaseServiceConfigSpec{
+					StatusUpdateInterval: &metav1.Duration{Duration: time.Minute},
+				},
+			}
+			Expect(GetInterval(releaseServiceConfig)).To(Equal(time.Minute))
+		})
+	})
+
+	When("Delay is called", func() {
+		It("should not defer the first update of an object", func() {
+			Expect(throttle.Delay("foo", time.Minute)).To(BeZero())
+		})
+
+		It("should defer the updates until the interval elapses", func() {
+			throttle.Record("foo")
+			now = now.Add(20 * time.Second)
+			Expect(throttle.Delay("foo", time.Minute)).To(Equal(40 * time.Second))
+			Expect(throttle.Delay("bar", time.Minute)).To(BeZero())
+
+			now = now.Add(40 * time.Second)
+			Expect(throttle.Delay("foo", time.Minute)).To(BeZero())
+		})
+
+		It("should never defer updates if the throttle is nil", func() {
+			var nilThrottle *Throttle
+			nilThrottle.Record("foo")
+			Expect(nilThrottle.Delay("foo", time.Minute)).To(BeZero())
+		})
+	})
+
+	When("Forget is called", func() {
+		It("should forget the last update of the object", func() {
+			throttle.Record("foo")
+			throttle.Forget("foo")
+			Expect(throttle.Delay("foo", time.Minute)).To(BeZero())
+		})
+	})
+})
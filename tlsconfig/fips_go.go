@@ -0,0 +1,27 @@
+//go:build go1.24 && !boringcrypto
+
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsconfig
+
+import "crypto/fips140"
+
+// fipsEnabled checks whether the binary runs the Go Cryptographic Module in FIPS 140-3 mode, e.g. because it was built
+// with GOFIPS140 set or runs with GODEBUG=fips140=on.
+func fipsEnabled() bool {
+	return fips140.Enabled()
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlsconfig builds the TLS configuration shared by the servers of the service, so government deployments can
+// enforce a minimum TLS version, restrict the cipher suites and require a FIPS-validated crypto backend.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrFIPSUnavailable is returned when FIPS mode is required but the binary doesn't use a FIPS-validated crypto backend.
+var ErrFIPSUnavailable = errors.New("FIPS mode is required but the binary isn't built with a FIPS-validated crypto " +
+	"backend or it isn't enabled")
+
+// versions contains the TLS versions that can be set as minimum version, mapped to their names.
+var versions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// fipsCipherSuites contains the TLS 1.2 cipher suites approved for FIPS mode.
+var fipsCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+}
+
+// fipsCurves contains the elliptic curves approved for FIPS mode.
+var fipsCurves = []tls.CurveID{
+	tls.CurveP256,
+	tls.CurveP384,
+}
+
+// Options defines the TLS settings of the servers of the service.
+type Options struct {
+	// CipherSuites is a comma-separated list of the names of the cipher suites allowed for TLS 1.2 connections, e.g.
+	// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256. TLS 1.3 cipher suites are not configurable. The Go defaults, or the
+	// FIPS-approved cipher suites in FIPS mode, are used if empty
+	CipherSuites string
+
+	// EnableHTTP2 indicates whether HTTP/2 is negotiated. Only HTTP/1.1 is served otherwise
+	EnableHTTP2 bool
+
+	// FIPS indicates whether a FIPS-validated crypto backend is required, restricting the TLS settings to the
+	// FIPS-approved ones
+	FIPS bool
+
+	// MinVersion is the minimum TLS version accepted (1.2 or 1.3). The Go default is used if empty
+	MinVersion string
+}
+
+// New returns a function applying the given options to the TLS configuration of a server. An error is returned if any
+// of the options is invalid, or if FIPS mode is required and the binary doesn't use a FIPS-validated crypto backend.
+func New(opts Options) (func(*tls.Config), error) {
+	var minVersion uint16
+	if opts.MinVersion != "" {
+		version, found := versions[opts.MinVersion]
+		if !found {
+			return nil, fmt.Errorf("invalid TLS version '%s': the minimum version has to be 1.2 or 1.3", opts.MinVersion)
+		}
+		minVersion = version
+	}
+
+	cipherSuites, err := parseCipherSuites(opts.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	var curves []tls.CurveID
+	if opts.FIPS {
+		if !IsFIPSEnabled() {
+			return nil, ErrFIPSUnavailable
+		}
+
+		for _, cipherSuite := range cipherSuites {
+			if !isFIPSCipherSuite(cipherSuite) {
+				return nil, fmt.Errorf("cipher suite '%s' is not approved for FIPS mode", tls.CipherSuiteName(cipherSuite))
+			}
+		}
+		if len(cipherSuites) == 0 {
+			cipherSuites = fipsCipherSuites
+		}
+		if minVersion == 0 {
+			minVersion = tls.VersionTLS12
+		}
+		curves = fipsCurves
+	}
+
+	return func(c *tls.Config) {
+		if !opts.EnableHTTP2 {
+			c.NextProtos = []string{"http/1.1"}
+		}
+		if minVersion != 0 {
+			c.MinVersion = minVersion
+		}
+		if len(cipherSuites) > 0 {
+			c.CipherSuites = cipherSuites
+		}
+		if len(curves) > 0 {
+			c.CurvePreferences = curves
+		}
+	}, nil
+}
+
+// IsFIPSEnabled checks whether the binary uses a FIPS-validated crypto backend in FIPS mode.
+func IsFIPSEnabled() bool {
+	return fipsEnabled()
+}
+
+// isFIPSCipherSuite checks whether the given cipher suite is approved for FIPS mode.
+func isFIPSCipherSuite(cipherSuite uint16) bool {
+	for _, fipsCipherSuite := range fipsCipherSuites {
+		if cipherSuite == fipsCipherSuite {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseCipherSuites returns the IDs of the cipher suites in the given comma-separated list of names. An error is
+// returned if any of the names is unknown or refers to an insecure or TLS 1.3 cipher suite.
+func parseCipherSuites(names string) ([]uint16, error) {
+	if strings.TrimSpace(names) == "" {
+		return nil, nil
+	}
+
+	secure := map[string]uint16{}
+	tls13 := map[string]bool{}
+	for _, cipherSuite := range tls.CipherSuites() {
+		if slices.Contains(cipherSuite.SupportedVersions, tls.VersionTLS12) {
+			secure[cipherSuite.Name] = cipherSuite.ID
+		} else {
+			tls13[cipherSuite.Name] = true
+		}
+	}
+	insecure := map[string]bool{}
+	for _, cipherSuite := range tls.InsecureCipherSuites() {
+		insecure[cipherSuite.Name] = true
+	}
+
+	var cipherSuites []uint16
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		id, found := secure[name]
+		if !found {
+			if insecure[name] {
+				return nil, fmt.Errorf("cipher suite '%s' is insecure", name)
+			}
+			if tls13[name] {
+				return nil, fmt.Errorf("cipher suite '%s' is a TLS 1.3 cipher suite, which are not configurable", name)
+			}
+			return nil, fmt.Errorf("unknown cipher suite '%s'", name)
+		}
+		cipherSuites = append(cipherSuites, id)
+	}
+
+	return cipherSuites, nil
+}
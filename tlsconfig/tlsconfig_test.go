@@ -0,0 +1,107 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TLS config", func() {
+	When("New is called", func() {
+		It("should only disable HTTP/2 if no option is set", func() {
+			apply, err := New(Options{})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &tls.Config{}
+			apply(config)
+			Expect(config.NextProtos).To(Equal([]string{"http/1.1"}))
+			Expect(config.MinVersion).To(BeZero())
+			Expect(config.CipherSuites).To(BeEmpty())
+		})
+
+		It("should keep HTTP/2 if it's enabled", func() {
+			apply, err := New(Options{EnableHTTP2: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &tls.Config{NextProtos: []string{"h2", "http/1.1"}}
+			apply(config)
+			Expect(config.NextProtos).To(Equal([]string{"h2", "http/1.1"}))
+		})
+
+		It("should set the minimum version and the cipher suites", func() {
+			apply, err := New(Options{
+				CipherSuites: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+				MinVersion:   "1.3",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &tls.Config{}
+			apply(config)
+			Expect(config.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+			Expect(config.CipherSuites).To(Equal([]uint16{
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			}))
+		})
+
+		It("should fail if the minimum version is not supported", func() {
+			_, err := New(Options{MinVersion: "1.1"})
+			Expect(err).To(MatchError(ContainSubstring("the minimum version has to be 1.2 or 1.3")))
+		})
+
+		It("should fail if a cipher suite is unknown, insecure or a TLS 1.3 one", func() {
+			_, err := New(Options{CipherSuites: "TLS_FOO"})
+			Expect(err).To(MatchError(ContainSubstring("unknown cipher suite 'TLS_FOO'")))
+
+			_, err = New(Options{CipherSuites: "TLS_RSA_WITH_RC4_128_SHA"})
+			Expect(err).To(MatchError(ContainSubstring("is insecure")))
+
+			_, err = New(Options{CipherSuites: "TLS_AES_128_GCM_SHA256"})
+			Expect(err).To(MatchError(ContainSubstring("not configurable")))
+		})
+
+		It("should refuse FIPS mode if the crypto backend isn't FIPS-validated", func() {
+			if IsFIPSEnabled() {
+				Skip("the crypto backend is FIPS-validated")
+			}
+
+			_, err := New(Options{FIPS: true})
+			Expect(err).To(MatchError(ErrFIPSUnavailable))
+		})
+
+		It("should restrict the settings to the FIPS-approved ones in FIPS mode", func() {
+			if !IsFIPSEnabled() {
+				Skip("the crypto backend isn't FIPS-validated")
+			}
+
+			apply, err := New(Options{FIPS: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &tls.Config{}
+			apply(config)
+			Expect(config.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+			Expect(config.CipherSuites).To(Equal(fipsCipherSuites))
+			Expect(config.CurvePreferences).To(Equal(fipsCurves))
+
+			_, err = New(Options{CipherSuites: "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256", FIPS: true})
+			Expect(err).To(MatchError(ContainSubstring("not approved for FIPS mode")))
+		})
+	})
+})
@@ -0,0 +1,156 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	libhandler "github.com/operator-framework/operator-lib/handler"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// releaseOwnerType is the value of the owner type annotation of the objects owned by a Release.
+var releaseOwnerType = v1alpha1.GroupVersion.WithKind("Release").GroupKind().String()
+
+// Repairer adds the missing Release tracking labels to the objects created for Releases by previous versions of the
+// service, so they can be found through the labels like the objects created afterward. PipelineRuns are tracked back
+// to their Release through their owner annotations, and workspace claims through the PipelineRun owning them.
+type Repairer struct {
+	client client.Client
+	logger logr.Logger
+}
+
+var _ manager.LeaderElectionRunnable = &Repairer{}
+
+// NewRepairer creates and returns a new Repairer using the given client and logger.
+func NewRepairer(client client.Client, logger logr.Logger) *Repairer {
+	return &Repairer{
+		client: client,
+		logger: logger,
+	}
+}
+
+// NeedLeaderElection returns true, as only the leader has to repair the labels.
+func (r *Repairer) NeedLeaderElection() bool {
+	return true
+}
+
+// Start repairs the tracking labels once. Failures are only logged, as the objects missing their labels don't prevent
+// the service from working and the repair is attempted again on the next start.
+func (r *Repairer) Start(ctx context.Context) error {
+	repaired, err := r.Repair(ctx)
+	if err != nil {
+		r.logger.Error(err, "Failed to repair the Release tracking labels")
+		return nil
+	}
+
+	if repaired > 0 {
+		r.logger.Info("Repaired the Release tracking labels", "Objects", repaired)
+	}
+
+	return nil
+}
+
+// Repair adds the tracking labels to the PipelineRuns owned by a Release and to the workspace claims owned by those
+// PipelineRuns when they are missing, returning the number of objects repaired.
+func (r *Repairer) Repair(ctx context.Context) (int, error) {
+	pipelineRuns := &tektonv1.PipelineRunList{}
+	err := r.client.List(ctx, pipelineRuns)
+	if err != nil {
+		return 0, err
+	}
+
+	repaired := 0
+	trackedReleases := map[types.UID]types.NamespacedName{}
+	for i := range pipelineRuns.Items {
+		pipelineRun := &pipelineRuns.Items[i]
+		release, found := metadata.GetTrackedRelease(pipelineRun)
+		if !found {
+			release, found = getOwnerRelease(pipelineRun)
+			if !found {
+				continue
+			}
+
+			err = r.addTrackingLabels(ctx, pipelineRun, release)
+			if err != nil {
+				return repaired, err
+			}
+			repaired++
+		}
+
+		trackedReleases[pipelineRun.UID] = release
+	}
+
+	claims := &corev1.PersistentVolumeClaimList{}
+	err = r.client.List(ctx, claims)
+	if err != nil {
+		return repaired, err
+	}
+
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if _, found := metadata.GetTrackedRelease(claim); found {
+			continue
+		}
+
+		for _, ownerReference := range claim.OwnerReferences {
+			release, found := trackedReleases[ownerReference.UID]
+			if !found || ownerReference.Kind != "PipelineRun" {
+				continue
+			}
+
+			err = r.addTrackingLabels(ctx, claim, release)
+			if err != nil {
+				return repaired, err
+			}
+			repaired++
+			break
+		}
+	}
+
+	return repaired, nil
+}
+
+// addTrackingLabels adds the labels tracking the given object back to the given Release. Objects deleted in the
+// meantime are ignored.
+func (r *Repairer) addTrackingLabels(ctx context.Context, object client.Object, release types.NamespacedName) error {
+	patch := client.MergeFrom(object.DeepCopyObject().(client.Object))
+	metadata.AddLabels(object, metadata.GetReleaseTrackingLabels(release.Name, release.Namespace))
+
+	return client.IgnoreNotFound(r.client.Patch(ctx, object, patch))
+}
+
+// getOwnerRelease returns the name and namespace of the Release set in the owner annotations of the given object. The
+// returned boolean is false if the object is not owned by a Release.
+func getOwnerRelease(object client.Object) (types.NamespacedName, bool) {
+	annotations := object.GetAnnotations()
+	if annotations[libhandler.TypeAnnotation] != releaseOwnerType {
+		return types.NamespacedName{}, false
+	}
+
+	namespace, name, found := strings.Cut(annotations[libhandler.NamespacedNameAnnotation], "/")
+
+	return types.NamespacedName{Name: name, Namespace: namespace}, found && name != "" && namespace != ""
+}
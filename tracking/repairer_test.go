@@ -0,0 +1,120 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/konflux-ci/release-service/metadata"
+	libhandler "github.com/operator-framework/operator-lib/handler"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Repairer", func() {
+	var (
+		claim       *corev1.PersistentVolumeClaim
+		pipelineRun *tektonv1.PipelineRun
+		repairer    *Repairer
+	)
+
+	AfterEach(func() {
+		_ = k8sClient.Delete(ctx, claim)
+		_ = k8sClient.Delete(ctx, pipelineRun)
+	})
+
+	BeforeEach(func() {
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "pipeline-run-",
+				Namespace:    testNamespace,
+				Annotations: map[string]string{
+					libhandler.NamespacedNameAnnotation: "tenant/release",
+					libhandler.TypeAnnotation:           releaseOwnerType,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+
+		claim = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "pvc-",
+				Namespace:    testNamespace,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: tektonv1.SchemeGroupVersion.String(),
+						Kind:       "PipelineRun",
+						Name:       pipelineRun.Name,
+						UID:        pipelineRun.UID,
+					},
+				},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, claim)).To(Succeed())
+
+		repairer = NewRepairer(k8sClient, logr.Discard())
+	})
+
+	When("Repair is called", func() {
+		It("should add the tracking labels to the PipelineRuns owned by a Release and their claims", func() {
+			repaired, err := repairer.Repair(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(repaired).To(Equal(2))
+
+			for _, object := range []client.Object{pipelineRun, claim} {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(object), object)).To(Succeed())
+				release, found := metadata.GetTrackedRelease(object)
+				Expect(found).To(BeTrue())
+				Expect(release).To(Equal(types.NamespacedName{Name: "release", Namespace: "tenant"}))
+			}
+
+			repaired, err = repairer.Repair(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(repaired).To(BeZero())
+		})
+
+		It("should not label the PipelineRuns that are not owned by a Release", func() {
+			pipelineRun.Annotations[libhandler.TypeAnnotation] = "Snapshot.appstudio.redhat.com"
+			Expect(k8sClient.Update(ctx, pipelineRun)).To(Succeed())
+
+			repaired, err := repairer.Repair(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(repaired).To(BeZero())
+		})
+	})
+
+	When("getOwnerRelease is called", func() {
+		It("should return false if the owner annotation is malformed", func() {
+			pipelineRun.Annotations[libhandler.NamespacedNameAnnotation] = "release"
+
+			_, found := getOwnerRelease(pipelineRun)
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,77 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReleaseValidator defines the interface of the validation steps executed during the validation phase of every Release
+// in addition to the built-in ones. Downstream distributions can compile in extra steps (e.g. export-control or embargo
+// checks) by registering them from the init function of a package imported by the main package.
+type ReleaseValidator interface {
+	// Name returns the unique name of the validator
+	Name() string
+
+	// Validate checks the given Release, returning a validation cause describing why it's invalid or nil if it's
+	// valid. An error is returned if the check couldn't be completed, so it's retried later.
+	Validate(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ValidationCause, error)
+}
+
+var (
+	// registry contains the registered validators indexed by name
+	registry = map[string]ReleaseValidator{}
+
+	// registryMutex guards the registry, as validators can be registered from the init functions of several packages
+	registryMutex sync.RWMutex
+)
+
+// Register registers the given validator, so it's executed during the validation phase of every Release. It panics if
+// a validator with the same name was already registered, as it's a programming error of the distribution.
+func Register(validator ReleaseValidator) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, found := registry[validator.Name()]; found {
+		panic(fmt.Sprintf("release validator %s registered twice", validator.Name()))
+	}
+
+	registry[validator.Name()] = validator
+}
+
+// GetRegistered returns the registered validators sorted by name, so they are always executed in the same order.
+func GetRegistered() []ReleaseValidator {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	validators := make([]ReleaseValidator, 0, len(registry))
+	for _, validator := range registry {
+		validators = append(validators, validator)
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Name() < validators[j].Name()
+	})
+
+	return validators
+}
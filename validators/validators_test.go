@@ -0,0 +1,74 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// namedValidator is a ReleaseValidator considering every Release valid.
+type namedValidator struct {
+	name string
+}
+
+func (v *namedValidator) Name() string {
+	return v.name
+}
+
+func (v *namedValidator) Validate(_ context.Context, _ client.Client, _ *v1alpha1.Release) (*v1alpha1.ValidationCause, error) {
+	return nil, nil
+}
+
+var _ = Describe("Validators", func() {
+	AfterEach(func() {
+		registry = map[string]ReleaseValidator{}
+	})
+
+	When("Register is called", func() {
+		It("should register the validator", func() {
+			validator := &namedValidator{name: "embargo"}
+			Register(validator)
+			Expect(GetRegistered()).To(Equal([]ReleaseValidator{validator}))
+		})
+
+		It("should panic if a validator with the same name was already registered", func() {
+			Register(&namedValidator{name: "embargo"})
+			Expect(func() { Register(&namedValidator{name: "embargo"}) }).To(Panic())
+		})
+	})
+
+	When("GetRegistered is called", func() {
+		It("should return the validators sorted by name", func() {
+			Register(&namedValidator{name: "export-control"})
+			Register(&namedValidator{name: "embargo"})
+
+			validators := GetRegistered()
+			Expect(validators).To(HaveLen(2))
+			Expect(validators[0].Name()).To(Equal("embargo"))
+			Expect(validators[1].Name()).To(Equal("export-control"))
+		})
+
+		It("should return an empty list if no validator was registered", func() {
+			Expect(GetRegistered()).To(BeEmpty())
+		})
+	})
+})